@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package activity
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type ActivityChainStep to the protobuf v3 wire format
+func (val *ActivityChainStep) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ActivityChainStep from the protobuf v3 wire format
+func (val *ActivityChainStep) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ActivityChainStep) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ActivityChainStep values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ActivityChainStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ActivityChainStep
+	switch t := that.(type) {
+	case *ActivityChainStep:
+		that1 = t
+	case ActivityChainStep:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ActivityChainState to the protobuf v3 wire format
+func (val *ActivityChainState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ActivityChainState from the protobuf v3 wire format
+func (val *ActivityChainState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ActivityChainState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ActivityChainState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ActivityChainState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ActivityChainState
+	switch t := that.(type) {
+	case *ActivityChainState:
+		that1 = t
+	case ActivityChainState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ActivityChainStepRecord to the protobuf v3 wire format
+func (val *ActivityChainStepRecord) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ActivityChainStepRecord from the protobuf v3 wire format
+func (val *ActivityChainStepRecord) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ActivityChainStepRecord) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ActivityChainStepRecord values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ActivityChainStepRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ActivityChainStepRecord
+	switch t := that.(type) {
+	case *ActivityChainStepRecord:
+		that1 = t
+	case ActivityChainStepRecord:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+var (
+	ActivityChainInputMapping_shorthandValue = map[string]int32{
+		"Unspecified":              0,
+		"PreviousResult":           1,
+		"Static":                   2,
+		"StaticThenPreviousResult": 3,
+	}
+)
+
+// ActivityChainInputMappingFromString parses a ActivityChainInputMapping value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ActivityChainInputMapping
+func ActivityChainInputMappingFromString(s string) (ActivityChainInputMapping, error) {
+	if v, ok := ActivityChainInputMapping_value[s]; ok {
+		return ActivityChainInputMapping(v), nil
+	} else if v, ok := ActivityChainInputMapping_shorthandValue[s]; ok {
+		return ActivityChainInputMapping(v), nil
+	}
+	return ActivityChainInputMapping(0), fmt.Errorf("%s is not a valid ActivityChainInputMapping", s)
+}
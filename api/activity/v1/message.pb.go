@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/activity/v1/message.proto
+
+package activity
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	v11 "go.temporal.io/api/activity/v1"
+	v1 "go.temporal.io/api/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How the input of a follow-up activity in a chain is derived.
+type ActivityChainInputMapping int32
+
+const (
+	ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED ActivityChainInputMapping = 0
+	// The result of the previous activity is the input. This is the default.
+	ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT ActivityChainInputMapping = 1
+	// The step's own input is used and the result of the previous activity is discarded.
+	ACTIVITY_CHAIN_INPUT_MAPPING_STATIC ActivityChainInputMapping = 2
+	// The step's own input payloads, followed by the payloads of the previous activity's result.
+	ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT ActivityChainInputMapping = 3
+)
+
+// Enum value maps for ActivityChainInputMapping.
+var (
+	ActivityChainInputMapping_name = map[int32]string{
+		0: "ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED",
+		1: "ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT",
+		2: "ACTIVITY_CHAIN_INPUT_MAPPING_STATIC",
+		3: "ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT",
+	}
+	ActivityChainInputMapping_value = map[string]int32{
+		"ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED":                 0,
+		"ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT":             1,
+		"ACTIVITY_CHAIN_INPUT_MAPPING_STATIC":                      2,
+		"ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT": 3,
+	}
+)
+
+func (x ActivityChainInputMapping) Enum() *ActivityChainInputMapping {
+	p := new(ActivityChainInputMapping)
+	*p = x
+	return p
+}
+
+func (x ActivityChainInputMapping) String() string {
+	switch x {
+	case ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED:
+		return "Unspecified"
+	case ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT:
+		return "PreviousResult"
+	case ACTIVITY_CHAIN_INPUT_MAPPING_STATIC:
+		return "Static"
+	case ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT:
+		return "StaticThenPreviousResult"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ActivityChainInputMapping) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_activity_v1_message_proto_enumTypes[0].Descriptor()
+}
+
+func (ActivityChainInputMapping) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_activity_v1_message_proto_enumTypes[0]
+}
+
+func (x ActivityChainInputMapping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityChainInputMapping.Descriptor instead.
+func (ActivityChainInputMapping) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_activity_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+// A follow-up activity that is scheduled automatically, in the same execution, when the preceding
+// activity of its chain completes successfully.
+type ActivityChainStep struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ActivityType *v1.ActivityType       `protobuf:"bytes,1,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// Task queue, timeouts, retry policy, priority and start delay of the step. Timeouts are
+	// measured from the time the step is scheduled.
+	Options      *v11.ActivityOptions      `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	InputMapping ActivityChainInputMapping `protobuf:"varint,3,opt,name=input_mapping,json=inputMapping,proto3,enum=temporal.server.api.activity.v1.ActivityChainInputMapping" json:"input_mapping,omitempty"`
+	// Ignored if input_mapping is ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT.
+	Input         *v1.Payloads `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityChainStep) Reset() {
+	*x = ActivityChainStep{}
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityChainStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityChainStep) ProtoMessage() {}
+
+func (x *ActivityChainStep) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityChainStep.ProtoReflect.Descriptor instead.
+func (*ActivityChainStep) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activity_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActivityChainStep) GetActivityType() *v1.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return nil
+}
+
+func (x *ActivityChainStep) GetOptions() *v11.ActivityOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ActivityChainStep) GetInputMapping() ActivityChainInputMapping {
+	if x != nil {
+		return x.InputMapping
+	}
+	return ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED
+}
+
+func (x *ActivityChainStep) GetInput() *v1.Payloads {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Progress of a standalone activity that was started with follow-up steps. ActivityState always
+// describes the activity that is currently running; when it completes successfully the next step
+// replaces it, and the execution only closes once the last step closes. Any unsuccessful outcome
+// closes the execution and abandons the remaining steps.
+type ActivityChainState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Follow-up steps, in order.
+	Steps []*ActivityChainStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Activities of the chain that completed successfully, starting with the first one. The next step
+	// to run is steps[len(completed)].
+	Completed     []*ActivityChainStepRecord `protobuf:"bytes,2,rep,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityChainState) Reset() {
+	*x = ActivityChainState{}
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityChainState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityChainState) ProtoMessage() {}
+
+func (x *ActivityChainState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityChainState.ProtoReflect.Descriptor instead.
+func (*ActivityChainState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activity_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityChainState) GetSteps() []*ActivityChainStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ActivityChainState) GetCompleted() []*ActivityChainStepRecord {
+	if x != nil {
+		return x.Completed
+	}
+	return nil
+}
+
+type ActivityChainStepRecord struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ActivityType *v1.ActivityType       `protobuf:"bytes,1,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	TaskQueue    string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Number of attempts the activity took to complete.
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ScheduleTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=schedule_time,json=scheduleTime,proto3" json:"schedule_time,omitempty"`
+	CloseTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityChainStepRecord) Reset() {
+	*x = ActivityChainStepRecord{}
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityChainStepRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityChainStepRecord) ProtoMessage() {}
+
+func (x *ActivityChainStepRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activity_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityChainStepRecord.ProtoReflect.Descriptor instead.
+func (*ActivityChainStepRecord) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activity_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityChainStepRecord) GetActivityType() *v1.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return nil
+}
+
+func (x *ActivityChainStepRecord) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *ActivityChainStepRecord) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ActivityChainStepRecord) GetScheduleTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduleTime
+	}
+	return nil
+}
+
+func (x *ActivityChainStepRecord) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+var File_temporal_server_api_activity_v1_message_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_activity_v1_message_proto_rawDesc = "" +
+	"\n" +
+	"-temporal/server/api/activity/v1/message.proto\x12\x1ftemporal.server.api.activity.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&temporal/api/activity/v1/message.proto\x1a$temporal/api/common/v1/message.proto\"\xbc\x02\n" +
+	"\x11ActivityChainStep\x12I\n" +
+	"\ractivity_type\x18\x01 \x01(\v2$.temporal.api.common.v1.ActivityTypeR\factivityType\x12C\n" +
+	"\aoptions\x18\x02 \x01(\v2).temporal.api.activity.v1.ActivityOptionsR\aoptions\x12_\n" +
+	"\rinput_mapping\x18\x03 \x01(\x0e2:.temporal.server.api.activity.v1.ActivityChainInputMappingR\finputMapping\x126\n" +
+	"\x05input\x18\x04 \x01(\v2 .temporal.api.common.v1.PayloadsR\x05input\"\xb6\x01\n" +
+	"\x12ActivityChainState\x12H\n" +
+	"\x05steps\x18\x01 \x03(\v22.temporal.server.api.activity.v1.ActivityChainStepR\x05steps\x12V\n" +
+	"\tcompleted\x18\x02 \x03(\v28.temporal.server.api.activity.v1.ActivityChainStepRecordR\tcompleted\"\x9b\x02\n" +
+	"\x17ActivityChainStepRecord\x12I\n" +
+	"\ractivity_type\x18\x01 \x01(\v2$.temporal.api.common.v1.ActivityTypeR\factivityType\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12?\n" +
+	"\rschedule_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduleTime\x129\n" +
+	"\n" +
+	"close_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime*\xe2\x01\n" +
+	"\x19ActivityChainInputMapping\x12,\n" +
+	"(ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED\x10\x00\x120\n" +
+	",ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT\x10\x01\x12'\n" +
+	"#ACTIVITY_CHAIN_INPUT_MAPPING_STATIC\x10\x02\x12<\n" +
+	"8ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT\x10\x03B0Z.go.temporal.io/server/api/activity/v1;activityb\x06proto3"
+
+var (
+	file_temporal_server_api_activity_v1_message_proto_rawDescOnce sync.Once
+	file_temporal_server_api_activity_v1_message_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_activity_v1_message_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_activity_v1_message_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_activity_v1_message_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_activity_v1_message_proto_rawDesc), len(file_temporal_server_api_activity_v1_message_proto_rawDesc)))
+	})
+	return file_temporal_server_api_activity_v1_message_proto_rawDescData
+}
+
+var file_temporal_server_api_activity_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_activity_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_temporal_server_api_activity_v1_message_proto_goTypes = []any{
+	(ActivityChainInputMapping)(0),  // 0: temporal.server.api.activity.v1.ActivityChainInputMapping
+	(*ActivityChainStep)(nil),       // 1: temporal.server.api.activity.v1.ActivityChainStep
+	(*ActivityChainState)(nil),      // 2: temporal.server.api.activity.v1.ActivityChainState
+	(*ActivityChainStepRecord)(nil), // 3: temporal.server.api.activity.v1.ActivityChainStepRecord
+	(*v1.ActivityType)(nil),         // 4: temporal.api.common.v1.ActivityType
+	(*v11.ActivityOptions)(nil),     // 5: temporal.api.activity.v1.ActivityOptions
+	(*v1.Payloads)(nil),             // 6: temporal.api.common.v1.Payloads
+	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
+}
+var file_temporal_server_api_activity_v1_message_proto_depIdxs = []int32{
+	4, // 0: temporal.server.api.activity.v1.ActivityChainStep.activity_type:type_name -> temporal.api.common.v1.ActivityType
+	5, // 1: temporal.server.api.activity.v1.ActivityChainStep.options:type_name -> temporal.api.activity.v1.ActivityOptions
+	0, // 2: temporal.server.api.activity.v1.ActivityChainStep.input_mapping:type_name -> temporal.server.api.activity.v1.ActivityChainInputMapping
+	6, // 3: temporal.server.api.activity.v1.ActivityChainStep.input:type_name -> temporal.api.common.v1.Payloads
+	1, // 4: temporal.server.api.activity.v1.ActivityChainState.steps:type_name -> temporal.server.api.activity.v1.ActivityChainStep
+	3, // 5: temporal.server.api.activity.v1.ActivityChainState.completed:type_name -> temporal.server.api.activity.v1.ActivityChainStepRecord
+	4, // 6: temporal.server.api.activity.v1.ActivityChainStepRecord.activity_type:type_name -> temporal.api.common.v1.ActivityType
+	7, // 7: temporal.server.api.activity.v1.ActivityChainStepRecord.schedule_time:type_name -> google.protobuf.Timestamp
+	7, // 8: temporal.server.api.activity.v1.ActivityChainStepRecord.close_time:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_activity_v1_message_proto_init() }
+func file_temporal_server_api_activity_v1_message_proto_init() {
+	if File_temporal_server_api_activity_v1_message_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_activity_v1_message_proto_rawDesc), len(file_temporal_server_api_activity_v1_message_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_activity_v1_message_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_activity_v1_message_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_activity_v1_message_proto_enumTypes,
+		MessageInfos:      file_temporal_server_api_activity_v1_message_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_activity_v1_message_proto = out.File
+	file_temporal_server_api_activity_v1_message_proto_goTypes = nil
+	file_temporal_server_api_activity_v1_message_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package activitybatchservice
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type BatchActivityExecutionFailure to the protobuf v3 wire format
+func (val *BatchActivityExecutionFailure) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchActivityExecutionFailure from the protobuf v3 wire format
+func (val *BatchActivityExecutionFailure) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchActivityExecutionFailure) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchActivityExecutionFailure values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchActivityExecutionFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchActivityExecutionFailure
+	switch t := that.(type) {
+	case *BatchActivityExecutionFailure:
+		that1 = t
+	case BatchActivityExecutionFailure:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchStartActivityExecutionsRequest to the protobuf v3 wire format
+func (val *BatchStartActivityExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchStartActivityExecutionsRequest from the protobuf v3 wire format
+func (val *BatchStartActivityExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchStartActivityExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchStartActivityExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchStartActivityExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchStartActivityExecutionsRequest
+	switch t := that.(type) {
+	case *BatchStartActivityExecutionsRequest:
+		that1 = t
+	case BatchStartActivityExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchStartActivityExecutionsResponse to the protobuf v3 wire format
+func (val *BatchStartActivityExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchStartActivityExecutionsResponse from the protobuf v3 wire format
+func (val *BatchStartActivityExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchStartActivityExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchStartActivityExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchStartActivityExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchStartActivityExecutionsResponse
+	switch t := that.(type) {
+	case *BatchStartActivityExecutionsResponse:
+		that1 = t
+	case BatchStartActivityExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchDescribeActivityExecutionsRequest to the protobuf v3 wire format
+func (val *BatchDescribeActivityExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchDescribeActivityExecutionsRequest from the protobuf v3 wire format
+func (val *BatchDescribeActivityExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchDescribeActivityExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchDescribeActivityExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchDescribeActivityExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchDescribeActivityExecutionsRequest
+	switch t := that.(type) {
+	case *BatchDescribeActivityExecutionsRequest:
+		that1 = t
+	case BatchDescribeActivityExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchDescribeActivityExecutionsResponse to the protobuf v3 wire format
+func (val *BatchDescribeActivityExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchDescribeActivityExecutionsResponse from the protobuf v3 wire format
+func (val *BatchDescribeActivityExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchDescribeActivityExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchDescribeActivityExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchDescribeActivityExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchDescribeActivityExecutionsResponse
+	switch t := that.(type) {
+	case *BatchDescribeActivityExecutionsResponse:
+		that1 = t
+	case BatchDescribeActivityExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchPollActivityExecutionsRequest to the protobuf v3 wire format
+func (val *BatchPollActivityExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchPollActivityExecutionsRequest from the protobuf v3 wire format
+func (val *BatchPollActivityExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchPollActivityExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchPollActivityExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchPollActivityExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchPollActivityExecutionsRequest
+	switch t := that.(type) {
+	case *BatchPollActivityExecutionsRequest:
+		that1 = t
+	case BatchPollActivityExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchPollActivityExecutionsResponse to the protobuf v3 wire format
+func (val *BatchPollActivityExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchPollActivityExecutionsResponse from the protobuf v3 wire format
+func (val *BatchPollActivityExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchPollActivityExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchPollActivityExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchPollActivityExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchPollActivityExecutionsResponse
+	switch t := that.(type) {
+	case *BatchPollActivityExecutionsResponse:
+		that1 = t
+	case BatchPollActivityExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
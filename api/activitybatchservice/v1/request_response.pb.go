@@ -0,0 +1,759 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/activitybatchservice/v1/request_response.proto
+
+package activitybatchservice
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/workflowservice/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BatchActivityExecutionFailure is the error of a single item of a batch activity execution request, as a gRPC status.
+type BatchActivityExecutionFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Details       []*anypb.Any           `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchActivityExecutionFailure) Reset() {
+	*x = BatchActivityExecutionFailure{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchActivityExecutionFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchActivityExecutionFailure) ProtoMessage() {}
+
+func (x *BatchActivityExecutionFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchActivityExecutionFailure.ProtoReflect.Descriptor instead.
+func (*BatchActivityExecutionFailure) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchActivityExecutionFailure) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchActivityExecutionFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchActivityExecutionFailure) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type BatchStartActivityExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The namespace of every request must be the namespace of the batch.
+	Requests      []*v1.StartActivityExecutionRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsRequest) Reset() {
+	*x = BatchStartActivityExecutionsRequest{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchStartActivityExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BatchStartActivityExecutionsRequest) GetRequests() []*v1.StartActivityExecutionRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchStartActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchStartActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsResponse) Reset() {
+	*x = BatchStartActivityExecutionsResponse{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchStartActivityExecutionsResponse) GetResults() []*BatchStartActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDescribeActivityExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The namespace of every request must be the namespace of the batch.
+	Requests      []*v1.DescribeActivityExecutionRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) Reset() {
+	*x = BatchDescribeActivityExecutionsRequest{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) GetRequests() []*v1.DescribeActivityExecutionRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchDescribeActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchDescribeActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) Reset() {
+	*x = BatchDescribeActivityExecutionsResponse{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) GetResults() []*BatchDescribeActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchPollActivityExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The namespace of every request must be the namespace of the batch.
+	Requests      []*v1.PollActivityExecutionRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsRequest) Reset() {
+	*x = BatchPollActivityExecutionsRequest{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchPollActivityExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BatchPollActivityExecutionsRequest) GetRequests() []*v1.PollActivityExecutionRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchPollActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchPollActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsResponse) Reset() {
+	*x = BatchPollActivityExecutionsResponse{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchPollActivityExecutionsResponse) GetResults() []*BatchPollActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchStartActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchStartActivityExecutionsResponse_Result_Response
+	//	*BatchStartActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchStartActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) Reset() {
+	*x = BatchStartActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetOutcome() isBatchStartActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetResponse() *v1.StartActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchStartActivityExecutionsResponse_Result_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetFailure() *BatchActivityExecutionFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchStartActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchStartActivityExecutionsResponse_Result_Outcome interface {
+	isBatchStartActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchStartActivityExecutionsResponse_Result_Response struct {
+	Response *v1.StartActivityExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type BatchStartActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchActivityExecutionFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchStartActivityExecutionsResponse_Result_Response) isBatchStartActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchStartActivityExecutionsResponse_Result_Failure) isBatchStartActivityExecutionsResponse_Result_Outcome() {
+}
+
+type BatchDescribeActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchDescribeActivityExecutionsResponse_Result_Response
+	//	*BatchDescribeActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchDescribeActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) Reset() {
+	*x = BatchDescribeActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetOutcome() isBatchDescribeActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetResponse() *v1.DescribeActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchDescribeActivityExecutionsResponse_Result_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetFailure() *BatchActivityExecutionFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchDescribeActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchDescribeActivityExecutionsResponse_Result_Outcome interface {
+	isBatchDescribeActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchDescribeActivityExecutionsResponse_Result_Response struct {
+	Response *v1.DescribeActivityExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type BatchDescribeActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchActivityExecutionFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result_Response) isBatchDescribeActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result_Failure) isBatchDescribeActivityExecutionsResponse_Result_Outcome() {
+}
+
+type BatchPollActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchPollActivityExecutionsResponse_Result_Response
+	//	*BatchPollActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchPollActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) Reset() {
+	*x = BatchPollActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetOutcome() isBatchPollActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetResponse() *v1.PollActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchPollActivityExecutionsResponse_Result_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetFailure() *BatchActivityExecutionFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchPollActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchPollActivityExecutionsResponse_Result_Outcome interface {
+	isBatchPollActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchPollActivityExecutionsResponse_Result_Response struct {
+	// Empty if the activity had not completed by the long-poll deadline.
+	Response *v1.PollActivityExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type BatchPollActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchActivityExecutionFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchPollActivityExecutionsResponse_Result_Response) isBatchPollActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchPollActivityExecutionsResponse_Result_Failure) isBatchPollActivityExecutionsResponse_Result_Outcome() {
+}
+
+var File_temporal_server_api_activitybatchservice_v1_request_response_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDesc = "" +
+	"\n" +
+	"Btemporal/server/api/activitybatchservice/v1/request_response.proto\x12+temporal.server.api.activitybatchservice.v1\x1a\x19google/protobuf/any.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\"}\n" +
+	"\x1dBatchActivityExecutionFailure\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\adetails\x18\x03 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\x9f\x01\n" +
+	"#BatchStartActivityExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12Z\n" +
+	"\brequests\x18\x02 \x03(\v2>.temporal.api.workflowservice.v1.StartActivityExecutionRequestR\brequests\"\xf7\x02\n" +
+	"$BatchStartActivityExecutionsResponse\x12r\n" +
+	"\aresults\x18\x01 \x03(\v2X.temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.ResultR\aresults\x1a\xda\x01\n" +
+	"\x06Result\x12]\n" +
+	"\bresponse\x18\x01 \x01(\v2?.temporal.api.workflowservice.v1.StartActivityExecutionResponseH\x00R\bresponse\x12f\n" +
+	"\afailure\x18\x02 \x01(\v2J.temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"\xa5\x01\n" +
+	"&BatchDescribeActivityExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12]\n" +
+	"\brequests\x18\x02 \x03(\v2A.temporal.api.workflowservice.v1.DescribeActivityExecutionRequestR\brequests\"\x80\x03\n" +
+	"'BatchDescribeActivityExecutionsResponse\x12u\n" +
+	"\aresults\x18\x01 \x03(\v2[.temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.ResultR\aresults\x1a\xdd\x01\n" +
+	"\x06Result\x12`\n" +
+	"\bresponse\x18\x01 \x01(\v2B.temporal.api.workflowservice.v1.DescribeActivityExecutionResponseH\x00R\bresponse\x12f\n" +
+	"\afailure\x18\x02 \x01(\v2J.temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"\x9d\x01\n" +
+	"\"BatchPollActivityExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12Y\n" +
+	"\brequests\x18\x02 \x03(\v2=.temporal.api.workflowservice.v1.PollActivityExecutionRequestR\brequests\"\xf4\x02\n" +
+	"#BatchPollActivityExecutionsResponse\x12q\n" +
+	"\aresults\x18\x01 \x03(\v2W.temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.ResultR\aresults\x1a\xd9\x01\n" +
+	"\x06Result\x12\\\n" +
+	"\bresponse\x18\x01 \x01(\v2>.temporal.api.workflowservice.v1.PollActivityExecutionResponseH\x00R\bresponse\x12f\n" +
+	"\afailure\x18\x02 \x01(\v2J.temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailureH\x00R\afailureB\t\n" +
+	"\aoutcomeBHZFgo.temporal.io/server/api/activitybatchservice/v1;activitybatchserviceb\x06proto3"
+
+var (
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescOnce sync.Once
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDesc)))
+	})
+	return file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDescData
+}
+
+var file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_temporal_server_api_activitybatchservice_v1_request_response_proto_goTypes = []any{
+	(*BatchActivityExecutionFailure)(nil),                  // 0: temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailure
+	(*BatchStartActivityExecutionsRequest)(nil),            // 1: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsRequest
+	(*BatchStartActivityExecutionsResponse)(nil),           // 2: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse
+	(*BatchDescribeActivityExecutionsRequest)(nil),         // 3: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsRequest
+	(*BatchDescribeActivityExecutionsResponse)(nil),        // 4: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse
+	(*BatchPollActivityExecutionsRequest)(nil),             // 5: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsRequest
+	(*BatchPollActivityExecutionsResponse)(nil),            // 6: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse
+	(*BatchStartActivityExecutionsResponse_Result)(nil),    // 7: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.Result
+	(*BatchDescribeActivityExecutionsResponse_Result)(nil), // 8: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.Result
+	(*BatchPollActivityExecutionsResponse_Result)(nil),     // 9: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.Result
+	(*anypb.Any)(nil),                            // 10: google.protobuf.Any
+	(*v1.StartActivityExecutionRequest)(nil),     // 11: temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	(*v1.DescribeActivityExecutionRequest)(nil),  // 12: temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	(*v1.PollActivityExecutionRequest)(nil),      // 13: temporal.api.workflowservice.v1.PollActivityExecutionRequest
+	(*v1.StartActivityExecutionResponse)(nil),    // 14: temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	(*v1.DescribeActivityExecutionResponse)(nil), // 15: temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	(*v1.PollActivityExecutionResponse)(nil),     // 16: temporal.api.workflowservice.v1.PollActivityExecutionResponse
+}
+var file_temporal_server_api_activitybatchservice_v1_request_response_proto_depIdxs = []int32{
+	10, // 0: temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailure.details:type_name -> google.protobuf.Any
+	11, // 1: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsRequest.requests:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	7,  // 2: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.results:type_name -> temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.Result
+	12, // 3: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsRequest.requests:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	8,  // 4: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.results:type_name -> temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.Result
+	13, // 5: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsRequest.requests:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionRequest
+	9,  // 6: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.results:type_name -> temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.Result
+	14, // 7: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.Result.response:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	0,  // 8: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse.Result.failure:type_name -> temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailure
+	15, // 9: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.Result.response:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	0,  // 10: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse.Result.failure:type_name -> temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailure
+	16, // 11: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.Result.response:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionResponse
+	0,  // 12: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse.Result.failure:type_name -> temporal.server.api.activitybatchservice.v1.BatchActivityExecutionFailure
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_activitybatchservice_v1_request_response_proto_init() }
+func file_temporal_server_api_activitybatchservice_v1_request_response_proto_init() {
+	if File_temporal_server_api_activitybatchservice_v1_request_response_proto != nil {
+		return
+	}
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[7].OneofWrappers = []any{
+		(*BatchStartActivityExecutionsResponse_Result_Response)(nil),
+		(*BatchStartActivityExecutionsResponse_Result_Failure)(nil),
+	}
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[8].OneofWrappers = []any{
+		(*BatchDescribeActivityExecutionsResponse_Result_Response)(nil),
+		(*BatchDescribeActivityExecutionsResponse_Result_Failure)(nil),
+	}
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes[9].OneofWrappers = []any{
+		(*BatchPollActivityExecutionsResponse_Result_Response)(nil),
+		(*BatchPollActivityExecutionsResponse_Result_Failure)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_activitybatchservice_v1_request_response_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_activitybatchservice_v1_request_response_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_activitybatchservice_v1_request_response_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_activitybatchservice_v1_request_response_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_activitybatchservice_v1_request_response_proto = out.File
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_goTypes = nil
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_depIdxs = nil
+}
@@ -0,0 +1,78 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/activitybatchservice/v1/service.proto
+
+package activitybatchservice
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_temporal_server_api_activitybatchservice_v1_service_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_activitybatchservice_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"9temporal/server/api/activitybatchservice/v1/service.proto\x12+temporal.server.api.activitybatchservice.v1\x1aBtemporal/server/api/activitybatchservice/v1/request_response.proto2\xf4\x04\n" +
+	"\x14ActivityBatchService\x12\xc5\x01\n" +
+	"\x1cBatchStartActivityExecutions\x12P.temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsRequest\x1aQ.temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse\"\x00\x12\xce\x01\n" +
+	"\x1fBatchDescribeActivityExecutions\x12S.temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsRequest\x1aT.temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse\"\x00\x12\xc2\x01\n" +
+	"\x1bBatchPollActivityExecutions\x12O.temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsRequest\x1aP.temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse\"\x00BHZFgo.temporal.io/server/api/activitybatchservice/v1;activitybatchserviceb\x06proto3"
+
+var file_temporal_server_api_activitybatchservice_v1_service_proto_goTypes = []any{
+	(*BatchStartActivityExecutionsRequest)(nil),     // 0: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsRequest
+	(*BatchDescribeActivityExecutionsRequest)(nil),  // 1: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsRequest
+	(*BatchPollActivityExecutionsRequest)(nil),      // 2: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsRequest
+	(*BatchStartActivityExecutionsResponse)(nil),    // 3: temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse
+	(*BatchDescribeActivityExecutionsResponse)(nil), // 4: temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse
+	(*BatchPollActivityExecutionsResponse)(nil),     // 5: temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse
+}
+var file_temporal_server_api_activitybatchservice_v1_service_proto_depIdxs = []int32{
+	0, // 0: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchStartActivityExecutions:input_type -> temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsRequest
+	1, // 1: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchDescribeActivityExecutions:input_type -> temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsRequest
+	2, // 2: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchPollActivityExecutions:input_type -> temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsRequest
+	3, // 3: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchStartActivityExecutions:output_type -> temporal.server.api.activitybatchservice.v1.BatchStartActivityExecutionsResponse
+	4, // 4: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchDescribeActivityExecutions:output_type -> temporal.server.api.activitybatchservice.v1.BatchDescribeActivityExecutionsResponse
+	5, // 5: temporal.server.api.activitybatchservice.v1.ActivityBatchService.BatchPollActivityExecutions:output_type -> temporal.server.api.activitybatchservice.v1.BatchPollActivityExecutionsResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_activitybatchservice_v1_service_proto_init() }
+func file_temporal_server_api_activitybatchservice_v1_service_proto_init() {
+	if File_temporal_server_api_activitybatchservice_v1_service_proto != nil {
+		return
+	}
+	file_temporal_server_api_activitybatchservice_v1_request_response_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_activitybatchservice_v1_service_proto_rawDesc), len(file_temporal_server_api_activitybatchservice_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_temporal_server_api_activitybatchservice_v1_service_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_activitybatchservice_v1_service_proto_depIdxs,
+	}.Build()
+	File_temporal_server_api_activitybatchservice_v1_service_proto = out.File
+	file_temporal_server_api_activitybatchservice_v1_service_proto_goTypes = nil
+	file_temporal_server_api_activitybatchservice_v1_service_proto_depIdxs = nil
+}
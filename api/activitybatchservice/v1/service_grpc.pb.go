@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// plugins:
+// - protoc-gen-go-grpc
+// - protoc
+// source: temporal/server/api/activitybatchservice/v1/service.proto
+
+package activitybatchservice
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ActivityBatchService_BatchStartActivityExecutions_FullMethodName    = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchStartActivityExecutions"
+	ActivityBatchService_BatchDescribeActivityExecutions_FullMethodName = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchDescribeActivityExecutions"
+	ActivityBatchService_BatchPollActivityExecutions_FullMethodName     = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchPollActivityExecutions"
+)
+
+// ActivityBatchServiceClient is the client API for ActivityBatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ActivityBatchServiceClient interface {
+	// BatchStartActivityExecutions starts a batch of standalone activity executions in one namespace, with one request
+	// to history per owning shard. Every item has its own result, so an item failing does not fail the batch.
+	BatchStartActivityExecutions(ctx context.Context, in *BatchStartActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchStartActivityExecutionsResponse, error)
+	// BatchDescribeActivityExecutions describes a batch of standalone activity executions in one namespace. Long-poll
+	// tokens are not supported.
+	BatchDescribeActivityExecutions(ctx context.Context, in *BatchDescribeActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchDescribeActivityExecutionsResponse, error)
+	// BatchPollActivityExecutions long-polls for the outcomes of a batch of standalone activity executions in one
+	// namespace. Items without an outcome by the long-poll deadline have an empty response and should be polled again.
+	BatchPollActivityExecutions(ctx context.Context, in *BatchPollActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchPollActivityExecutionsResponse, error)
+}
+
+type activityBatchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActivityBatchServiceClient(cc grpc.ClientConnInterface) ActivityBatchServiceClient {
+	return &activityBatchServiceClient{cc}
+}
+
+func (c *activityBatchServiceClient) BatchStartActivityExecutions(ctx context.Context, in *BatchStartActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchStartActivityExecutionsResponse, error) {
+	out := new(BatchStartActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityBatchService_BatchStartActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityBatchServiceClient) BatchDescribeActivityExecutions(ctx context.Context, in *BatchDescribeActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchDescribeActivityExecutionsResponse, error) {
+	out := new(BatchDescribeActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityBatchService_BatchDescribeActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityBatchServiceClient) BatchPollActivityExecutions(ctx context.Context, in *BatchPollActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchPollActivityExecutionsResponse, error) {
+	out := new(BatchPollActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityBatchService_BatchPollActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActivityBatchServiceServer is the server API for ActivityBatchService service.
+// All implementations must embed UnimplementedActivityBatchServiceServer
+// for forward compatibility
+type ActivityBatchServiceServer interface {
+	// BatchStartActivityExecutions starts a batch of standalone activity executions in one namespace, with one request
+	// to history per owning shard. Every item has its own result, so an item failing does not fail the batch.
+	BatchStartActivityExecutions(context.Context, *BatchStartActivityExecutionsRequest) (*BatchStartActivityExecutionsResponse, error)
+	// BatchDescribeActivityExecutions describes a batch of standalone activity executions in one namespace. Long-poll
+	// tokens are not supported.
+	BatchDescribeActivityExecutions(context.Context, *BatchDescribeActivityExecutionsRequest) (*BatchDescribeActivityExecutionsResponse, error)
+	// BatchPollActivityExecutions long-polls for the outcomes of a batch of standalone activity executions in one
+	// namespace. Items without an outcome by the long-poll deadline have an empty response and should be polled again.
+	BatchPollActivityExecutions(context.Context, *BatchPollActivityExecutionsRequest) (*BatchPollActivityExecutionsResponse, error)
+	mustEmbedUnimplementedActivityBatchServiceServer()
+}
+
+// UnimplementedActivityBatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedActivityBatchServiceServer struct {
+}
+
+func (UnimplementedActivityBatchServiceServer) BatchStartActivityExecutions(context.Context, *BatchStartActivityExecutionsRequest) (*BatchStartActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStartActivityExecutions not implemented")
+}
+func (UnimplementedActivityBatchServiceServer) BatchDescribeActivityExecutions(context.Context, *BatchDescribeActivityExecutionsRequest) (*BatchDescribeActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDescribeActivityExecutions not implemented")
+}
+func (UnimplementedActivityBatchServiceServer) BatchPollActivityExecutions(context.Context, *BatchPollActivityExecutionsRequest) (*BatchPollActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPollActivityExecutions not implemented")
+}
+func (UnimplementedActivityBatchServiceServer) mustEmbedUnimplementedActivityBatchServiceServer() {}
+
+// UnsafeActivityBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActivityBatchServiceServer will
+// result in compilation errors.
+type UnsafeActivityBatchServiceServer interface {
+	mustEmbedUnimplementedActivityBatchServiceServer()
+}
+
+func RegisterActivityBatchServiceServer(s grpc.ServiceRegistrar, srv ActivityBatchServiceServer) {
+	s.RegisterService(&ActivityBatchService_ServiceDesc, srv)
+}
+
+func _ActivityBatchService_BatchStartActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStartActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityBatchServiceServer).BatchStartActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityBatchService_BatchStartActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityBatchServiceServer).BatchStartActivityExecutions(ctx, req.(*BatchStartActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityBatchService_BatchDescribeActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDescribeActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityBatchServiceServer).BatchDescribeActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityBatchService_BatchDescribeActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityBatchServiceServer).BatchDescribeActivityExecutions(ctx, req.(*BatchDescribeActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityBatchService_BatchPollActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPollActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityBatchServiceServer).BatchPollActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityBatchService_BatchPollActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityBatchServiceServer).BatchPollActivityExecutions(ctx, req.(*BatchPollActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ActivityBatchService_ServiceDesc is the grpc.ServiceDesc for ActivityBatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ActivityBatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.activitybatchservice.v1.ActivityBatchService",
+	HandlerType: (*ActivityBatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchStartActivityExecutions",
+			Handler:    _ActivityBatchService_BatchStartActivityExecutions_Handler,
+		},
+		{
+			MethodName: "BatchDescribeActivityExecutions",
+			Handler:    _ActivityBatchService_BatchDescribeActivityExecutions_Handler,
+		},
+		{
+			MethodName: "BatchPollActivityExecutions",
+			Handler:    _ActivityBatchService_BatchPollActivityExecutions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/activitybatchservice/v1/service.proto",
+}
@@ -0,0 +1,10 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api/activitybatchservice/v1/service.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -package activitybatchservicemock -source api/activitybatchservice/v1/service.pb.go -destination api/activitybatchservicemock/v1/service.pb.mock.go
+//
+
+// Package activitybatchservicemock is a generated GoMock package.
+package activitybatchservicemock
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api/activitybatchservice/v1/service_grpc.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -package activitybatchservicemock -source api/activitybatchservice/v1/service_grpc.pb.go -destination api/activitybatchservicemock/v1/service_grpc.pb.mock.go
+//
+
+// Package activitybatchservicemock is a generated GoMock package.
+package activitybatchservicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	activitybatchservice "go.temporal.io/server/api/activitybatchservice/v1"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockActivityBatchServiceClient is a mock of ActivityBatchServiceClient interface.
+type MockActivityBatchServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityBatchServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityBatchServiceClientMockRecorder is the mock recorder for MockActivityBatchServiceClient.
+type MockActivityBatchServiceClientMockRecorder struct {
+	mock *MockActivityBatchServiceClient
+}
+
+// NewMockActivityBatchServiceClient creates a new mock instance.
+func NewMockActivityBatchServiceClient(ctrl *gomock.Controller) *MockActivityBatchServiceClient {
+	mock := &MockActivityBatchServiceClient{ctrl: ctrl}
+	mock.recorder = &MockActivityBatchServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityBatchServiceClient) EXPECT() *MockActivityBatchServiceClientMockRecorder {
+	return m.recorder
+}
+
+// BatchDescribeActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceClient) BatchDescribeActivityExecutions(ctx context.Context, in *activitybatchservice.BatchDescribeActivityExecutionsRequest, opts ...grpc.CallOption) (*activitybatchservice.BatchDescribeActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchDescribeActivityExecutions", varargs...)
+	ret0, _ := ret[0].(*activitybatchservice.BatchDescribeActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeActivityExecutions indicates an expected call of BatchDescribeActivityExecutions.
+func (mr *MockActivityBatchServiceClientMockRecorder) BatchDescribeActivityExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceClient)(nil).BatchDescribeActivityExecutions), varargs...)
+}
+
+// BatchPollActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceClient) BatchPollActivityExecutions(ctx context.Context, in *activitybatchservice.BatchPollActivityExecutionsRequest, opts ...grpc.CallOption) (*activitybatchservice.BatchPollActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchPollActivityExecutions", varargs...)
+	ret0, _ := ret[0].(*activitybatchservice.BatchPollActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPollActivityExecutions indicates an expected call of BatchPollActivityExecutions.
+func (mr *MockActivityBatchServiceClientMockRecorder) BatchPollActivityExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPollActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceClient)(nil).BatchPollActivityExecutions), varargs...)
+}
+
+// BatchStartActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceClient) BatchStartActivityExecutions(ctx context.Context, in *activitybatchservice.BatchStartActivityExecutionsRequest, opts ...grpc.CallOption) (*activitybatchservice.BatchStartActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchStartActivityExecutions", varargs...)
+	ret0, _ := ret[0].(*activitybatchservice.BatchStartActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchStartActivityExecutions indicates an expected call of BatchStartActivityExecutions.
+func (mr *MockActivityBatchServiceClientMockRecorder) BatchStartActivityExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchStartActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceClient)(nil).BatchStartActivityExecutions), varargs...)
+}
+
+// MockActivityBatchServiceServer is a mock of ActivityBatchServiceServer interface.
+type MockActivityBatchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityBatchServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityBatchServiceServerMockRecorder is the mock recorder for MockActivityBatchServiceServer.
+type MockActivityBatchServiceServerMockRecorder struct {
+	mock *MockActivityBatchServiceServer
+}
+
+// NewMockActivityBatchServiceServer creates a new mock instance.
+func NewMockActivityBatchServiceServer(ctrl *gomock.Controller) *MockActivityBatchServiceServer {
+	mock := &MockActivityBatchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockActivityBatchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityBatchServiceServer) EXPECT() *MockActivityBatchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// BatchDescribeActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceServer) BatchDescribeActivityExecutions(arg0 context.Context, arg1 *activitybatchservice.BatchDescribeActivityExecutionsRequest) (*activitybatchservice.BatchDescribeActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDescribeActivityExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*activitybatchservice.BatchDescribeActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeActivityExecutions indicates an expected call of BatchDescribeActivityExecutions.
+func (mr *MockActivityBatchServiceServerMockRecorder) BatchDescribeActivityExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceServer)(nil).BatchDescribeActivityExecutions), arg0, arg1)
+}
+
+// BatchPollActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceServer) BatchPollActivityExecutions(arg0 context.Context, arg1 *activitybatchservice.BatchPollActivityExecutionsRequest) (*activitybatchservice.BatchPollActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchPollActivityExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*activitybatchservice.BatchPollActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPollActivityExecutions indicates an expected call of BatchPollActivityExecutions.
+func (mr *MockActivityBatchServiceServerMockRecorder) BatchPollActivityExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPollActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceServer)(nil).BatchPollActivityExecutions), arg0, arg1)
+}
+
+// BatchStartActivityExecutions mocks base method.
+func (m *MockActivityBatchServiceServer) BatchStartActivityExecutions(arg0 context.Context, arg1 *activitybatchservice.BatchStartActivityExecutionsRequest) (*activitybatchservice.BatchStartActivityExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchStartActivityExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*activitybatchservice.BatchStartActivityExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchStartActivityExecutions indicates an expected call of BatchStartActivityExecutions.
+func (mr *MockActivityBatchServiceServerMockRecorder) BatchStartActivityExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchStartActivityExecutions", reflect.TypeOf((*MockActivityBatchServiceServer)(nil).BatchStartActivityExecutions), arg0, arg1)
+}
+
+// mustEmbedUnimplementedActivityBatchServiceServer mocks base method.
+func (m *MockActivityBatchServiceServer) mustEmbedUnimplementedActivityBatchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedActivityBatchServiceServer")
+}
+
+// mustEmbedUnimplementedActivityBatchServiceServer indicates an expected call of mustEmbedUnimplementedActivityBatchServiceServer.
+func (mr *MockActivityBatchServiceServerMockRecorder) mustEmbedUnimplementedActivityBatchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedActivityBatchServiceServer", reflect.TypeOf((*MockActivityBatchServiceServer)(nil).mustEmbedUnimplementedActivityBatchServiceServer))
+}
+
+// MockUnsafeActivityBatchServiceServer is a mock of UnsafeActivityBatchServiceServer interface.
+type MockUnsafeActivityBatchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeActivityBatchServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeActivityBatchServiceServerMockRecorder is the mock recorder for MockUnsafeActivityBatchServiceServer.
+type MockUnsafeActivityBatchServiceServerMockRecorder struct {
+	mock *MockUnsafeActivityBatchServiceServer
+}
+
+// NewMockUnsafeActivityBatchServiceServer creates a new mock instance.
+func NewMockUnsafeActivityBatchServiceServer(ctrl *gomock.Controller) *MockUnsafeActivityBatchServiceServer {
+	mock := &MockUnsafeActivityBatchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeActivityBatchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeActivityBatchServiceServer) EXPECT() *MockUnsafeActivityBatchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedActivityBatchServiceServer mocks base method.
+func (m *MockUnsafeActivityBatchServiceServer) mustEmbedUnimplementedActivityBatchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedActivityBatchServiceServer")
+}
+
+// mustEmbedUnimplementedActivityBatchServiceServer indicates an expected call of mustEmbedUnimplementedActivityBatchServiceServer.
+func (mr *MockUnsafeActivityBatchServiceServerMockRecorder) mustEmbedUnimplementedActivityBatchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedActivityBatchServiceServer", reflect.TypeOf((*MockUnsafeActivityBatchServiceServer)(nil).mustEmbedUnimplementedActivityBatchServiceServer))
+}
@@ -375,35 +375,35 @@ func (this *CloseShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardRequest to the protobuf v3 wire format
-func (val *GetShardRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MoveHistoryShardRequest to the protobuf v3 wire format
+func (val *MoveHistoryShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
-func (val *GetShardRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveHistoryShardRequest from the protobuf v3 wire format
+func (val *MoveHistoryShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardRequest) Size() int {
+func (val *MoveHistoryShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardRequest values are equivalent by recursively
+// Equal returns whether two MoveHistoryShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardRequest) Equal(that interface{}) bool {
+func (this *MoveHistoryShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardRequest
+	var that1 *MoveHistoryShardRequest
 	switch t := that.(type) {
-	case *GetShardRequest:
+	case *MoveHistoryShardRequest:
 		that1 = t
-	case GetShardRequest:
+	case MoveHistoryShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -412,35 +412,35 @@ func (this *GetShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardResponse to the protobuf v3 wire format
-func (val *GetShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MoveHistoryShardResponse to the protobuf v3 wire format
+func (val *MoveHistoryShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
-func (val *GetShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveHistoryShardResponse from the protobuf v3 wire format
+func (val *MoveHistoryShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardResponse) Size() int {
+func (val *MoveHistoryShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardResponse values are equivalent by recursively
+// Equal returns whether two MoveHistoryShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardResponse) Equal(that interface{}) bool {
+func (this *MoveHistoryShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardResponse
+	var that1 *MoveHistoryShardResponse
 	switch t := that.(type) {
-	case *GetShardResponse:
+	case *MoveHistoryShardResponse:
 		that1 = t
-	case GetShardResponse:
+	case MoveHistoryShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -449,35 +449,35 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type UnpinHistoryShardRequest to the protobuf v3 wire format
+func (val *UnpinHistoryShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UnpinHistoryShardRequest from the protobuf v3 wire format
+func (val *UnpinHistoryShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksRequest) Size() int {
+func (val *UnpinHistoryShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// Equal returns whether two UnpinHistoryShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+func (this *UnpinHistoryShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksRequest
+	var that1 *UnpinHistoryShardRequest
 	switch t := that.(type) {
-	case *ListHistoryTasksRequest:
+	case *UnpinHistoryShardRequest:
 		that1 = t
-	case ListHistoryTasksRequest:
+	case UnpinHistoryShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -486,35 +486,35 @@ func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type UnpinHistoryShardResponse to the protobuf v3 wire format
+func (val *UnpinHistoryShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UnpinHistoryShardResponse from the protobuf v3 wire format
+func (val *UnpinHistoryShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksResponse) Size() int {
+func (val *UnpinHistoryShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// Equal returns whether two UnpinHistoryShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+func (this *UnpinHistoryShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksResponse
+	var that1 *UnpinHistoryShardResponse
 	switch t := that.(type) {
-	case *ListHistoryTasksResponse:
+	case *UnpinHistoryShardResponse:
 		that1 = t
-	case ListHistoryTasksResponse:
+	case UnpinHistoryShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -523,35 +523,35 @@ func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type Task to the protobuf v3 wire format
-func (val *Task) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskSchedulerRequest to the protobuf v3 wire format
+func (val *DescribeTaskSchedulerRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type Task from the protobuf v3 wire format
-func (val *Task) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskSchedulerRequest from the protobuf v3 wire format
+func (val *DescribeTaskSchedulerRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *Task) Size() int {
+func (val *DescribeTaskSchedulerRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two Task values are equivalent by recursively
+// Equal returns whether two DescribeTaskSchedulerRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *Task) Equal(that interface{}) bool {
+func (this *DescribeTaskSchedulerRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *Task
+	var that1 *DescribeTaskSchedulerRequest
 	switch t := that.(type) {
-	case *Task:
+	case *DescribeTaskSchedulerRequest:
 		that1 = t
-	case Task:
+	case DescribeTaskSchedulerRequest:
 		that1 = &t
 	default:
 		return false
@@ -560,35 +560,35 @@ func (this *Task) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveTaskRequest to the protobuf v3 wire format
-func (val *RemoveTaskRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskSchedulerResponse to the protobuf v3 wire format
+func (val *DescribeTaskSchedulerResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveTaskRequest from the protobuf v3 wire format
-func (val *RemoveTaskRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskSchedulerResponse from the protobuf v3 wire format
+func (val *DescribeTaskSchedulerResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveTaskRequest) Size() int {
+func (val *DescribeTaskSchedulerResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveTaskRequest values are equivalent by recursively
+// Equal returns whether two DescribeTaskSchedulerResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
+func (this *DescribeTaskSchedulerResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveTaskRequest
+	var that1 *DescribeTaskSchedulerResponse
 	switch t := that.(type) {
-	case *RemoveTaskRequest:
+	case *DescribeTaskSchedulerResponse:
 		that1 = t
-	case RemoveTaskRequest:
+	case DescribeTaskSchedulerResponse:
 		that1 = &t
 	default:
 		return false
@@ -597,35 +597,35 @@ func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveTaskResponse to the protobuf v3 wire format
-func (val *RemoveTaskResponse) Marshal() ([]byte, error) {
+// Marshal an object of type UpdateTaskSchedulerNamespaceWeightRequest to the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveTaskResponse from the protobuf v3 wire format
-func (val *RemoveTaskResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UpdateTaskSchedulerNamespaceWeightRequest from the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveTaskResponse) Size() int {
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveTaskResponse values are equivalent by recursively
+// Equal returns whether two UpdateTaskSchedulerNamespaceWeightRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
+func (this *UpdateTaskSchedulerNamespaceWeightRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveTaskResponse
+	var that1 *UpdateTaskSchedulerNamespaceWeightRequest
 	switch t := that.(type) {
-	case *RemoveTaskResponse:
+	case *UpdateTaskSchedulerNamespaceWeightRequest:
 		that1 = t
-	case RemoveTaskResponse:
+	case UpdateTaskSchedulerNamespaceWeightRequest:
 		that1 = &t
 	default:
 		return false
@@ -634,35 +634,35 @@ func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryV2Request to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Request) Marshal() ([]byte, error) {
+// Marshal an object of type UpdateTaskSchedulerNamespaceWeightResponse to the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Request from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UpdateTaskSchedulerNamespaceWeightResponse from the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryV2Request) Size() int {
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryV2Request values are equivalent by recursively
+// Equal returns whether two UpdateTaskSchedulerNamespaceWeightResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
+func (this *UpdateTaskSchedulerNamespaceWeightResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryV2Request
+	var that1 *UpdateTaskSchedulerNamespaceWeightResponse
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryV2Request:
+	case *UpdateTaskSchedulerNamespaceWeightResponse:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryV2Request:
+	case UpdateTaskSchedulerNamespaceWeightResponse:
 		that1 = &t
 	default:
 		return false
@@ -671,35 +671,35 @@ func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) boo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryV2Response to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Response) Marshal() ([]byte, error) {
+// Marshal an object of type GetShardRequest to the protobuf v3 wire format
+func (val *GetShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Response from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
+func (val *GetShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryV2Response) Size() int {
+func (val *GetShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryV2Response values are equivalent by recursively
+// Equal returns whether two GetShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
+func (this *GetShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryV2Response
+	var that1 *GetShardRequest
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryV2Response:
+	case *GetShardRequest:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryV2Response:
+	case GetShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -708,35 +708,35 @@ func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryRequest to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetShardResponse to the protobuf v3 wire format
+func (val *GetShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryRequest from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
+func (val *GetShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryRequest) Size() int {
+func (val *GetShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryRequest values are equivalent by recursively
+// Equal returns whether two GetShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool {
+func (this *GetShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryRequest
+	var that1 *GetShardResponse
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryRequest:
+	case *GetShardResponse:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryRequest:
+	case GetShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -745,35 +745,35 @@ func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryResponse to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryResponse from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryResponse) Size() int {
+func (val *ListHistoryTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryResponse values are equivalent by recursively
+// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool {
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryResponse
+	var that1 *ListHistoryTasksRequest
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryResponse:
+	case *ListHistoryTasksRequest:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryResponse:
+	case ListHistoryTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -782,35 +782,35 @@ func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetReplicationMessagesRequest) Size() int {
+func (val *ListHistoryTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetReplicationMessagesRequest
+	var that1 *ListHistoryTasksResponse
 	switch t := that.(type) {
-	case *GetReplicationMessagesRequest:
+	case *ListHistoryTasksResponse:
 		that1 = t
-	case GetReplicationMessagesRequest:
+	case ListHistoryTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -819,35 +819,35 @@ func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type Task to the protobuf v3 wire format
+func (val *Task) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type Task from the protobuf v3 wire format
+func (val *Task) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetReplicationMessagesResponse) Size() int {
+func (val *Task) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two Task values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetReplicationMessagesResponse
+	var that1 *Task
 	switch t := that.(type) {
-	case *GetReplicationMessagesResponse:
+	case *Task:
 		that1 = t
-	case GetReplicationMessagesResponse:
+	case Task:
 		that1 = &t
 	default:
 		return false
@@ -856,35 +856,35 @@ func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskTraceRequest to the protobuf v3 wire format
+func (val *GetTaskTraceRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskTraceRequest from the protobuf v3 wire format
+func (val *GetTaskTraceRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceReplicationMessagesRequest) Size() int {
+func (val *GetTaskTraceRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two GetTaskTraceRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *GetTaskTraceRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceReplicationMessagesRequest
+	var that1 *GetTaskTraceRequest
 	switch t := that.(type) {
-	case *GetNamespaceReplicationMessagesRequest:
+	case *GetTaskTraceRequest:
 		that1 = t
-	case GetNamespaceReplicationMessagesRequest:
+	case GetTaskTraceRequest:
 		that1 = &t
 	default:
 		return false
@@ -893,35 +893,35 @@ func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskTraceResponse to the protobuf v3 wire format
+func (val *GetTaskTraceResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskTraceResponse from the protobuf v3 wire format
+func (val *GetTaskTraceResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceReplicationMessagesResponse) Size() int {
+func (val *GetTaskTraceResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two GetTaskTraceResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *GetTaskTraceResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceReplicationMessagesResponse
+	var that1 *GetTaskTraceResponse
 	switch t := that.(type) {
-	case *GetNamespaceReplicationMessagesResponse:
+	case *GetTaskTraceResponse:
 		that1 = t
-	case GetNamespaceReplicationMessagesResponse:
+	case GetTaskTraceResponse:
 		that1 = &t
 	default:
 		return false
@@ -930,35 +930,35 @@ func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) boo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueueMitigationsRequest to the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueueMitigationsRequest from the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQReplicationMessagesRequest) Size() int {
+func (val *ListQueueMitigationsRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two ListQueueMitigationsRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *ListQueueMitigationsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQReplicationMessagesRequest
+	var that1 *ListQueueMitigationsRequest
 	switch t := that.(type) {
-	case *GetDLQReplicationMessagesRequest:
+	case *ListQueueMitigationsRequest:
 		that1 = t
-	case GetDLQReplicationMessagesRequest:
+	case ListQueueMitigationsRequest:
 		that1 = &t
 	default:
 		return false
@@ -967,35 +967,35 @@ func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueueMitigationsResponse to the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueueMitigationsResponse from the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQReplicationMessagesResponse) Size() int {
+func (val *ListQueueMitigationsResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two ListQueueMitigationsResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *ListQueueMitigationsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQReplicationMessagesResponse
+	var that1 *ListQueueMitigationsResponse
 	switch t := that.(type) {
-	case *GetDLQReplicationMessagesResponse:
+	case *ListQueueMitigationsResponse:
 		that1 = t
-	case GetDLQReplicationMessagesResponse:
+	case ListQueueMitigationsResponse:
 		that1 = &t
 	default:
 		return false
@@ -1004,35 +1004,35 @@ func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ReapplyEventsRequest to the protobuf v3 wire format
-func (val *ReapplyEventsRequest) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveTaskRequest to the protobuf v3 wire format
+func (val *RemoveTaskRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ReapplyEventsRequest from the protobuf v3 wire format
-func (val *ReapplyEventsRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveTaskRequest from the protobuf v3 wire format
+func (val *RemoveTaskRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ReapplyEventsRequest) Size() int {
+func (val *RemoveTaskRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ReapplyEventsRequest values are equivalent by recursively
+// Equal returns whether two RemoveTaskRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ReapplyEventsRequest
+	var that1 *RemoveTaskRequest
 	switch t := that.(type) {
-	case *ReapplyEventsRequest:
+	case *RemoveTaskRequest:
 		that1 = t
-	case ReapplyEventsRequest:
+	case RemoveTaskRequest:
 		that1 = &t
 	default:
 		return false
@@ -1041,35 +1041,35 @@ func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ReapplyEventsResponse to the protobuf v3 wire format
-func (val *ReapplyEventsResponse) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveTaskResponse to the protobuf v3 wire format
+func (val *RemoveTaskResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ReapplyEventsResponse from the protobuf v3 wire format
-func (val *ReapplyEventsResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveTaskResponse from the protobuf v3 wire format
+func (val *RemoveTaskResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ReapplyEventsResponse) Size() int {
+func (val *RemoveTaskResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ReapplyEventsResponse values are equivalent by recursively
+// Equal returns whether two RemoveTaskResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ReapplyEventsResponse
+	var that1 *RemoveTaskResponse
 	switch t := that.(type) {
-	case *ReapplyEventsResponse:
+	case *RemoveTaskResponse:
 		that1 = t
-	case ReapplyEventsResponse:
+	case RemoveTaskResponse:
 		that1 = &t
 	default:
 		return false
@@ -1078,35 +1078,35 @@ func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddSearchAttributesRequest to the protobuf v3 wire format
-func (val *AddSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryV2Request to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Request) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddSearchAttributesRequest from the protobuf v3 wire format
-func (val *AddSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Request from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddSearchAttributesRequest) Size() int {
+func (val *GetWorkflowExecutionRawHistoryV2Request) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryV2Request values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddSearchAttributesRequest
+	var that1 *GetWorkflowExecutionRawHistoryV2Request
 	switch t := that.(type) {
-	case *AddSearchAttributesRequest:
+	case *GetWorkflowExecutionRawHistoryV2Request:
 		that1 = t
-	case AddSearchAttributesRequest:
+	case GetWorkflowExecutionRawHistoryV2Request:
 		that1 = &t
 	default:
 		return false
@@ -1115,35 +1115,35 @@ func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddSearchAttributesResponse to the protobuf v3 wire format
-func (val *AddSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryV2Response to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Response) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddSearchAttributesResponse from the protobuf v3 wire format
-func (val *AddSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Response from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddSearchAttributesResponse) Size() int {
+func (val *GetWorkflowExecutionRawHistoryV2Response) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryV2Response values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddSearchAttributesResponse
+	var that1 *GetWorkflowExecutionRawHistoryV2Response
 	switch t := that.(type) {
-	case *AddSearchAttributesResponse:
+	case *GetWorkflowExecutionRawHistoryV2Response:
 		that1 = t
-	case AddSearchAttributesResponse:
+	case GetWorkflowExecutionRawHistoryV2Response:
 		that1 = &t
 	default:
 		return false
@@ -1152,35 +1152,35 @@ func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveSearchAttributesRequest to the protobuf v3 wire format
-func (val *RemoveSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryRequest to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveSearchAttributesRequest from the protobuf v3 wire format
-func (val *RemoveSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryRequest from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveSearchAttributesRequest) Size() int {
+func (val *GetWorkflowExecutionRawHistoryRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveSearchAttributesRequest
+	var that1 *GetWorkflowExecutionRawHistoryRequest
 	switch t := that.(type) {
-	case *RemoveSearchAttributesRequest:
+	case *GetWorkflowExecutionRawHistoryRequest:
 		that1 = t
-	case RemoveSearchAttributesRequest:
+	case GetWorkflowExecutionRawHistoryRequest:
 		that1 = &t
 	default:
 		return false
@@ -1189,35 +1189,35 @@ func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveSearchAttributesResponse to the protobuf v3 wire format
-func (val *RemoveSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryResponse to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveSearchAttributesResponse from the protobuf v3 wire format
-func (val *RemoveSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryResponse from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveSearchAttributesResponse) Size() int {
+func (val *GetWorkflowExecutionRawHistoryResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveSearchAttributesResponse
+	var that1 *GetWorkflowExecutionRawHistoryResponse
 	switch t := that.(type) {
-	case *RemoveSearchAttributesResponse:
+	case *GetWorkflowExecutionRawHistoryResponse:
 		that1 = t
-	case RemoveSearchAttributesResponse:
+	case GetWorkflowExecutionRawHistoryResponse:
 		that1 = &t
 	default:
 		return false
@@ -1226,35 +1226,35 @@ func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetSearchAttributesRequest to the protobuf v3 wire format
-func (val *GetSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetSearchAttributesRequest from the protobuf v3 wire format
-func (val *GetSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetSearchAttributesRequest) Size() int {
+func (val *GetReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two GetReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetSearchAttributesRequest
+	var that1 *GetReplicationMessagesRequest
 	switch t := that.(type) {
-	case *GetSearchAttributesRequest:
+	case *GetReplicationMessagesRequest:
 		that1 = t
-	case GetSearchAttributesRequest:
+	case GetReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1263,35 +1263,35 @@ func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetSearchAttributesResponse to the protobuf v3 wire format
-func (val *GetSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetSearchAttributesResponse from the protobuf v3 wire format
-func (val *GetSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetSearchAttributesResponse) Size() int {
+func (val *GetReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two GetReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetSearchAttributesResponse
+	var that1 *GetReplicationMessagesResponse
 	switch t := that.(type) {
-	case *GetSearchAttributesResponse:
+	case *GetReplicationMessagesResponse:
 		that1 = t
-	case GetSearchAttributesResponse:
+	case GetReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1300,35 +1300,35 @@ func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeClusterRequest to the protobuf v3 wire format
-func (val *DescribeClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeClusterRequest from the protobuf v3 wire format
-func (val *DescribeClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeClusterRequest) Size() int {
+func (val *GetNamespaceReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeClusterRequest values are equivalent by recursively
+// Equal returns whether two GetNamespaceReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeClusterRequest
+	var that1 *GetNamespaceReplicationMessagesRequest
 	switch t := that.(type) {
-	case *DescribeClusterRequest:
+	case *GetNamespaceReplicationMessagesRequest:
 		that1 = t
-	case DescribeClusterRequest:
+	case GetNamespaceReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1337,35 +1337,35 @@ func (this *DescribeClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeClusterResponse to the protobuf v3 wire format
-func (val *DescribeClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeClusterResponse from the protobuf v3 wire format
-func (val *DescribeClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeClusterResponse) Size() int {
+func (val *GetNamespaceReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeClusterResponse values are equivalent by recursively
+// Equal returns whether two GetNamespaceReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeClusterResponse
+	var that1 *GetNamespaceReplicationMessagesResponse
 	switch t := that.(type) {
-	case *DescribeClusterResponse:
+	case *GetNamespaceReplicationMessagesResponse:
 		that1 = t
-	case DescribeClusterResponse:
+	case GetNamespaceReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1374,35 +1374,35 @@ func (this *DescribeClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClustersRequest to the protobuf v3 wire format
-func (val *ListClustersRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClustersRequest from the protobuf v3 wire format
-func (val *ListClustersRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClustersRequest) Size() int {
+func (val *GetDLQReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClustersRequest values are equivalent by recursively
+// Equal returns whether two GetDLQReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClustersRequest) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClustersRequest
+	var that1 *GetDLQReplicationMessagesRequest
 	switch t := that.(type) {
-	case *ListClustersRequest:
+	case *GetDLQReplicationMessagesRequest:
 		that1 = t
-	case ListClustersRequest:
+	case GetDLQReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1411,35 +1411,35 @@ func (this *ListClustersRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClustersResponse to the protobuf v3 wire format
-func (val *ListClustersResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClustersResponse from the protobuf v3 wire format
-func (val *ListClustersResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClustersResponse) Size() int {
+func (val *GetDLQReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClustersResponse values are equivalent by recursively
+// Equal returns whether two GetDLQReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClustersResponse) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClustersResponse
+	var that1 *GetDLQReplicationMessagesResponse
 	switch t := that.(type) {
-	case *ListClustersResponse:
+	case *GetDLQReplicationMessagesResponse:
 		that1 = t
-	case ListClustersResponse:
+	case GetDLQReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1448,35 +1448,35 @@ func (this *ListClustersResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddOrUpdateRemoteClusterRequest to the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationLagRequest to the protobuf v3 wire format
+func (val *GetReplicationLagRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddOrUpdateRemoteClusterRequest from the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationLagRequest from the protobuf v3 wire format
+func (val *GetReplicationLagRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddOrUpdateRemoteClusterRequest) Size() int {
+func (val *GetReplicationLagRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddOrUpdateRemoteClusterRequest values are equivalent by recursively
+// Equal returns whether two GetReplicationLagRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *GetReplicationLagRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddOrUpdateRemoteClusterRequest
+	var that1 *GetReplicationLagRequest
 	switch t := that.(type) {
-	case *AddOrUpdateRemoteClusterRequest:
+	case *GetReplicationLagRequest:
 		that1 = t
-	case AddOrUpdateRemoteClusterRequest:
+	case GetReplicationLagRequest:
 		that1 = &t
 	default:
 		return false
@@ -1485,35 +1485,35 @@ func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddOrUpdateRemoteClusterResponse to the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationLagResponse to the protobuf v3 wire format
+func (val *GetReplicationLagResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddOrUpdateRemoteClusterResponse from the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationLagResponse from the protobuf v3 wire format
+func (val *GetReplicationLagResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddOrUpdateRemoteClusterResponse) Size() int {
+func (val *GetReplicationLagResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddOrUpdateRemoteClusterResponse values are equivalent by recursively
+// Equal returns whether two GetReplicationLagResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *GetReplicationLagResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddOrUpdateRemoteClusterResponse
+	var that1 *GetReplicationLagResponse
 	switch t := that.(type) {
-	case *AddOrUpdateRemoteClusterResponse:
+	case *GetReplicationLagResponse:
 		that1 = t
-	case AddOrUpdateRemoteClusterResponse:
+	case GetReplicationLagResponse:
 		that1 = &t
 	default:
 		return false
@@ -1522,35 +1522,35 @@ func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveRemoteClusterRequest to the protobuf v3 wire format
-func (val *RemoveRemoteClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ClusterReplicationLag to the protobuf v3 wire format
+func (val *ClusterReplicationLag) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveRemoteClusterRequest from the protobuf v3 wire format
-func (val *RemoveRemoteClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ClusterReplicationLag from the protobuf v3 wire format
+func (val *ClusterReplicationLag) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveRemoteClusterRequest) Size() int {
+func (val *ClusterReplicationLag) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveRemoteClusterRequest values are equivalent by recursively
+// Equal returns whether two ClusterReplicationLag values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *ClusterReplicationLag) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveRemoteClusterRequest
+	var that1 *ClusterReplicationLag
 	switch t := that.(type) {
-	case *RemoveRemoteClusterRequest:
+	case *ClusterReplicationLag:
 		that1 = t
-	case RemoveRemoteClusterRequest:
+	case ClusterReplicationLag:
 		that1 = &t
 	default:
 		return false
@@ -1559,35 +1559,35 @@ func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveRemoteClusterResponse to the protobuf v3 wire format
-func (val *RemoveRemoteClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type NamespaceReplicationLag to the protobuf v3 wire format
+func (val *NamespaceReplicationLag) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveRemoteClusterResponse from the protobuf v3 wire format
-func (val *RemoveRemoteClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type NamespaceReplicationLag from the protobuf v3 wire format
+func (val *NamespaceReplicationLag) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveRemoteClusterResponse) Size() int {
+func (val *NamespaceReplicationLag) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveRemoteClusterResponse values are equivalent by recursively
+// Equal returns whether two NamespaceReplicationLag values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *NamespaceReplicationLag) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveRemoteClusterResponse
+	var that1 *NamespaceReplicationLag
 	switch t := that.(type) {
-	case *RemoveRemoteClusterResponse:
+	case *NamespaceReplicationLag:
 		that1 = t
-	case RemoveRemoteClusterResponse:
+	case NamespaceReplicationLag:
 		that1 = &t
 	default:
 		return false
@@ -1596,22 +1596,614 @@ func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClusterMembersRequest to the protobuf v3 wire format
-func (val *ListClusterMembersRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ReapplyEventsRequest to the protobuf v3 wire format
+func (val *ReapplyEventsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClusterMembersRequest from the protobuf v3 wire format
-func (val *ListClusterMembersRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ReapplyEventsRequest from the protobuf v3 wire format
+func (val *ReapplyEventsRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClusterMembersRequest) Size() int {
+func (val *ReapplyEventsRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClusterMembersRequest values are equivalent by recursively
+// Equal returns whether two ReapplyEventsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReapplyEventsRequest
+	switch t := that.(type) {
+	case *ReapplyEventsRequest:
+		that1 = t
+	case ReapplyEventsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReapplyEventsResponse to the protobuf v3 wire format
+func (val *ReapplyEventsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReapplyEventsResponse from the protobuf v3 wire format
+func (val *ReapplyEventsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReapplyEventsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReapplyEventsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReapplyEventsResponse
+	switch t := that.(type) {
+	case *ReapplyEventsResponse:
+		that1 = t
+	case ReapplyEventsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddSearchAttributesRequest to the protobuf v3 wire format
+func (val *AddSearchAttributesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddSearchAttributesRequest from the protobuf v3 wire format
+func (val *AddSearchAttributesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddSearchAttributesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddSearchAttributesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddSearchAttributesRequest
+	switch t := that.(type) {
+	case *AddSearchAttributesRequest:
+		that1 = t
+	case AddSearchAttributesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddSearchAttributesResponse to the protobuf v3 wire format
+func (val *AddSearchAttributesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddSearchAttributesResponse from the protobuf v3 wire format
+func (val *AddSearchAttributesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddSearchAttributesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddSearchAttributesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddSearchAttributesResponse
+	switch t := that.(type) {
+	case *AddSearchAttributesResponse:
+		that1 = t
+	case AddSearchAttributesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveSearchAttributesRequest to the protobuf v3 wire format
+func (val *RemoveSearchAttributesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveSearchAttributesRequest from the protobuf v3 wire format
+func (val *RemoveSearchAttributesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveSearchAttributesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveSearchAttributesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveSearchAttributesRequest
+	switch t := that.(type) {
+	case *RemoveSearchAttributesRequest:
+		that1 = t
+	case RemoveSearchAttributesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveSearchAttributesResponse to the protobuf v3 wire format
+func (val *RemoveSearchAttributesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveSearchAttributesResponse from the protobuf v3 wire format
+func (val *RemoveSearchAttributesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveSearchAttributesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveSearchAttributesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveSearchAttributesResponse
+	switch t := that.(type) {
+	case *RemoveSearchAttributesResponse:
+		that1 = t
+	case RemoveSearchAttributesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetSearchAttributesRequest to the protobuf v3 wire format
+func (val *GetSearchAttributesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetSearchAttributesRequest from the protobuf v3 wire format
+func (val *GetSearchAttributesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetSearchAttributesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetSearchAttributesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetSearchAttributesRequest
+	switch t := that.(type) {
+	case *GetSearchAttributesRequest:
+		that1 = t
+	case GetSearchAttributesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetSearchAttributesResponse to the protobuf v3 wire format
+func (val *GetSearchAttributesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetSearchAttributesResponse from the protobuf v3 wire format
+func (val *GetSearchAttributesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetSearchAttributesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetSearchAttributesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetSearchAttributesResponse
+	switch t := that.(type) {
+	case *GetSearchAttributesResponse:
+		that1 = t
+	case GetSearchAttributesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeClusterRequest to the protobuf v3 wire format
+func (val *DescribeClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeClusterRequest from the protobuf v3 wire format
+func (val *DescribeClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeClusterRequest
+	switch t := that.(type) {
+	case *DescribeClusterRequest:
+		that1 = t
+	case DescribeClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeClusterResponse to the protobuf v3 wire format
+func (val *DescribeClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeClusterResponse from the protobuf v3 wire format
+func (val *DescribeClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeClusterResponse
+	switch t := that.(type) {
+	case *DescribeClusterResponse:
+		that1 = t
+	case DescribeClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClustersRequest to the protobuf v3 wire format
+func (val *ListClustersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClustersRequest from the protobuf v3 wire format
+func (val *ListClustersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClustersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClustersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClustersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClustersRequest
+	switch t := that.(type) {
+	case *ListClustersRequest:
+		that1 = t
+	case ListClustersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClustersResponse to the protobuf v3 wire format
+func (val *ListClustersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClustersResponse from the protobuf v3 wire format
+func (val *ListClustersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClustersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClustersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClustersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClustersResponse
+	switch t := that.(type) {
+	case *ListClustersResponse:
+		that1 = t
+	case ListClustersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddOrUpdateRemoteClusterRequest to the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddOrUpdateRemoteClusterRequest from the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddOrUpdateRemoteClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddOrUpdateRemoteClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddOrUpdateRemoteClusterRequest
+	switch t := that.(type) {
+	case *AddOrUpdateRemoteClusterRequest:
+		that1 = t
+	case AddOrUpdateRemoteClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddOrUpdateRemoteClusterResponse to the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddOrUpdateRemoteClusterResponse from the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddOrUpdateRemoteClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddOrUpdateRemoteClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddOrUpdateRemoteClusterResponse
+	switch t := that.(type) {
+	case *AddOrUpdateRemoteClusterResponse:
+		that1 = t
+	case AddOrUpdateRemoteClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveRemoteClusterRequest to the protobuf v3 wire format
+func (val *RemoveRemoteClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveRemoteClusterRequest from the protobuf v3 wire format
+func (val *RemoveRemoteClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveRemoteClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveRemoteClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveRemoteClusterRequest
+	switch t := that.(type) {
+	case *RemoveRemoteClusterRequest:
+		that1 = t
+	case RemoveRemoteClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveRemoteClusterResponse to the protobuf v3 wire format
+func (val *RemoveRemoteClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveRemoteClusterResponse from the protobuf v3 wire format
+func (val *RemoveRemoteClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveRemoteClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveRemoteClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveRemoteClusterResponse
+	switch t := that.(type) {
+	case *RemoveRemoteClusterResponse:
+		that1 = t
+	case RemoveRemoteClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClusterMembersRequest to the protobuf v3 wire format
+func (val *ListClusterMembersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClusterMembersRequest from the protobuf v3 wire format
+func (val *ListClusterMembersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClusterMembersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClusterMembersRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
@@ -1620,11 +2212,418 @@ func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
 		return this == nil
 	}
 
-	var that1 *ListClusterMembersRequest
+	var that1 *ListClusterMembersRequest
+	switch t := that.(type) {
+	case *ListClusterMembersRequest:
+		that1 = t
+	case ListClusterMembersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClusterMembersResponse to the protobuf v3 wire format
+func (val *ListClusterMembersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClusterMembersResponse from the protobuf v3 wire format
+func (val *ListClusterMembersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClusterMembersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClusterMembersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClusterMembersResponse
+	switch t := that.(type) {
+	case *ListClusterMembersResponse:
+		that1 = t
+	case ListClusterMembersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDLQMessagesRequest to the protobuf v3 wire format
+func (val *GetDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDLQMessagesRequest from the protobuf v3 wire format
+func (val *GetDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDLQMessagesRequest
+	switch t := that.(type) {
+	case *GetDLQMessagesRequest:
+		that1 = t
+	case GetDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDLQMessagesResponse to the protobuf v3 wire format
+func (val *GetDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDLQMessagesResponse from the protobuf v3 wire format
+func (val *GetDLQMessagesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDLQMessagesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDLQMessagesResponse
+	switch t := that.(type) {
+	case *GetDLQMessagesResponse:
+		that1 = t
+	case GetDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDLQMessagesRequest to the protobuf v3 wire format
+func (val *PurgeDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDLQMessagesRequest from the protobuf v3 wire format
+func (val *PurgeDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDLQMessagesRequest
+	switch t := that.(type) {
+	case *PurgeDLQMessagesRequest:
+		that1 = t
+	case PurgeDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDLQMessagesResponse to the protobuf v3 wire format
+func (val *PurgeDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDLQMessagesResponse from the protobuf v3 wire format
+func (val *PurgeDLQMessagesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDLQMessagesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDLQMessagesResponse
+	switch t := that.(type) {
+	case *PurgeDLQMessagesResponse:
+		that1 = t
+	case PurgeDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MergeDLQMessagesRequest to the protobuf v3 wire format
+func (val *MergeDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MergeDLQMessagesRequest from the protobuf v3 wire format
+func (val *MergeDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MergeDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MergeDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MergeDLQMessagesRequest
+	switch t := that.(type) {
+	case *MergeDLQMessagesRequest:
+		that1 = t
+	case MergeDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MergeDLQMessagesResponse to the protobuf v3 wire format
+func (val *MergeDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MergeDLQMessagesResponse from the protobuf v3 wire format
+func (val *MergeDLQMessagesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MergeDLQMessagesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MergeDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MergeDLQMessagesResponse
+	switch t := that.(type) {
+	case *MergeDLQMessagesResponse:
+		that1 = t
+	case MergeDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RefreshWorkflowTasksRequest to the protobuf v3 wire format
+func (val *RefreshWorkflowTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RefreshWorkflowTasksRequest from the protobuf v3 wire format
+func (val *RefreshWorkflowTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RefreshWorkflowTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RefreshWorkflowTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RefreshWorkflowTasksRequest
+	switch t := that.(type) {
+	case *RefreshWorkflowTasksRequest:
+		that1 = t
+	case RefreshWorkflowTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RefreshWorkflowTasksResponse to the protobuf v3 wire format
+func (val *RefreshWorkflowTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RefreshWorkflowTasksResponse from the protobuf v3 wire format
+func (val *RefreshWorkflowTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RefreshWorkflowTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RefreshWorkflowTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RefreshWorkflowTasksResponse
+	switch t := that.(type) {
+	case *RefreshWorkflowTasksResponse:
+		that1 = t
+	case RefreshWorkflowTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResendReplicationTasksRequest to the protobuf v3 wire format
+func (val *ResendReplicationTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResendReplicationTasksRequest from the protobuf v3 wire format
+func (val *ResendReplicationTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResendReplicationTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResendReplicationTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResendReplicationTasksRequest
+	switch t := that.(type) {
+	case *ResendReplicationTasksRequest:
+		that1 = t
+	case ResendReplicationTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResendReplicationTasksResponse to the protobuf v3 wire format
+func (val *ResendReplicationTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResendReplicationTasksResponse from the protobuf v3 wire format
+func (val *ResendReplicationTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResendReplicationTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResendReplicationTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResendReplicationTasksResponse
 	switch t := that.(type) {
-	case *ListClusterMembersRequest:
+	case *ResendReplicationTasksResponse:
 		that1 = t
-	case ListClusterMembersRequest:
+	case ResendReplicationTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -1633,35 +2632,35 @@ func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClusterMembersResponse to the protobuf v3 wire format
-func (val *ListClusterMembersResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *GetTaskQueueTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClusterMembersResponse from the protobuf v3 wire format
-func (val *ListClusterMembersResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *GetTaskQueueTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClusterMembersResponse) Size() int {
+func (val *GetTaskQueueTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClusterMembersResponse values are equivalent by recursively
+// Equal returns whether two GetTaskQueueTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClusterMembersResponse
+	var that1 *GetTaskQueueTasksRequest
 	switch t := that.(type) {
-	case *ListClusterMembersResponse:
+	case *GetTaskQueueTasksRequest:
 		that1 = t
-	case ListClusterMembersResponse:
+	case GetTaskQueueTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -1670,35 +2669,35 @@ func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQMessagesRequest to the protobuf v3 wire format
-func (val *GetDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *GetTaskQueueTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQMessagesRequest from the protobuf v3 wire format
-func (val *GetDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *GetTaskQueueTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQMessagesRequest) Size() int {
+func (val *GetTaskQueueTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two GetTaskQueueTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQMessagesRequest
+	var that1 *GetTaskQueueTasksResponse
 	switch t := that.(type) {
-	case *GetDLQMessagesRequest:
+	case *GetTaskQueueTasksResponse:
 		that1 = t
-	case GetDLQMessagesRequest:
+	case GetTaskQueueTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -1707,35 +2706,35 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQMessagesResponse to the protobuf v3 wire format
-func (val *GetDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQMessagesResponse from the protobuf v3 wire format
-func (val *GetDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeleteWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQMessagesResponse) Size() int {
+func (val *DeleteWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two DeleteWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQMessagesResponse
+	var that1 *DeleteWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *GetDLQMessagesResponse:
+	case *DeleteWorkflowExecutionRequest:
 		that1 = t
-	case GetDLQMessagesResponse:
+	case DeleteWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -1744,35 +2743,35 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQMessagesRequest to the protobuf v3 wire format
-func (val *PurgeDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DeleteWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQMessagesRequest from the protobuf v3 wire format
-func (val *PurgeDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeleteWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQMessagesRequest) Size() int {
+func (val *DeleteWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two DeleteWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQMessagesRequest
+	var that1 *DeleteWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *PurgeDLQMessagesRequest:
+	case *DeleteWorkflowExecutionResponse:
 		that1 = t
-	case PurgeDLQMessagesRequest:
+	case DeleteWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -1781,35 +2780,35 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQMessagesResponse to the protobuf v3 wire format
-func (val *PurgeDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type StreamWorkflowReplicationMessagesRequest to the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQMessagesResponse from the protobuf v3 wire format
-func (val *PurgeDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StreamWorkflowReplicationMessagesRequest from the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQMessagesResponse) Size() int {
+func (val *StreamWorkflowReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two StreamWorkflowReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQMessagesResponse
+	var that1 *StreamWorkflowReplicationMessagesRequest
 	switch t := that.(type) {
-	case *PurgeDLQMessagesResponse:
+	case *StreamWorkflowReplicationMessagesRequest:
 		that1 = t
-	case PurgeDLQMessagesResponse:
+	case StreamWorkflowReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1818,35 +2817,35 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQMessagesRequest to the protobuf v3 wire format
-func (val *MergeDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type StreamWorkflowReplicationMessagesResponse to the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQMessagesRequest from the protobuf v3 wire format
-func (val *MergeDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StreamWorkflowReplicationMessagesResponse from the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQMessagesRequest) Size() int {
+func (val *StreamWorkflowReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two StreamWorkflowReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQMessagesRequest
+	var that1 *StreamWorkflowReplicationMessagesResponse
 	switch t := that.(type) {
-	case *MergeDLQMessagesRequest:
+	case *StreamWorkflowReplicationMessagesResponse:
 		that1 = t
-	case MergeDLQMessagesRequest:
+	case StreamWorkflowReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1855,35 +2854,183 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQMessagesResponse to the protobuf v3 wire format
-func (val *MergeDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceRequest to the protobuf v3 wire format
+func (val *GetNamespaceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceRequest from the protobuf v3 wire format
+func (val *GetNamespaceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceRequest
+	switch t := that.(type) {
+	case *GetNamespaceRequest:
+		that1 = t
+	case GetNamespaceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNamespaceResponse to the protobuf v3 wire format
+func (val *GetNamespaceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceResponse from the protobuf v3 wire format
+func (val *GetNamespaceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceResponse
+	switch t := that.(type) {
+	case *GetNamespaceResponse:
+		that1 = t
+	case GetNamespaceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNamespaceReplicationFilterRequest to the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFilterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNamespaceReplicationFilterRequest from the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFilterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNamespaceReplicationFilterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNamespaceReplicationFilterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNamespaceReplicationFilterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNamespaceReplicationFilterRequest
+	switch t := that.(type) {
+	case *UpdateNamespaceReplicationFilterRequest:
+		that1 = t
+	case UpdateNamespaceReplicationFilterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNamespaceReplicationFilterResponse to the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFilterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNamespaceReplicationFilterResponse from the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFilterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNamespaceReplicationFilterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNamespaceReplicationFilterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNamespaceReplicationFilterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNamespaceReplicationFilterResponse
+	switch t := that.(type) {
+	case *UpdateNamespaceReplicationFilterResponse:
+		that1 = t
+	case UpdateNamespaceReplicationFilterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDLQTasksRequest to the protobuf v3 wire format
+func (val *GetDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQMessagesResponse from the protobuf v3 wire format
-func (val *MergeDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQTasksRequest from the protobuf v3 wire format
+func (val *GetDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQMessagesResponse) Size() int {
+func (val *GetDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two GetDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQMessagesResponse
+	var that1 *GetDLQTasksRequest
 	switch t := that.(type) {
-	case *MergeDLQMessagesResponse:
+	case *GetDLQTasksRequest:
 		that1 = t
-	case MergeDLQMessagesResponse:
+	case GetDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -1892,35 +3039,35 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RefreshWorkflowTasksRequest to the protobuf v3 wire format
-func (val *RefreshWorkflowTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQTasksResponse to the protobuf v3 wire format
+func (val *GetDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RefreshWorkflowTasksRequest from the protobuf v3 wire format
-func (val *RefreshWorkflowTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQTasksResponse from the protobuf v3 wire format
+func (val *GetDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RefreshWorkflowTasksRequest) Size() int {
+func (val *GetDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RefreshWorkflowTasksRequest values are equivalent by recursively
+// Equal returns whether two GetDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RefreshWorkflowTasksRequest
+	var that1 *GetDLQTasksResponse
 	switch t := that.(type) {
-	case *RefreshWorkflowTasksRequest:
+	case *GetDLQTasksResponse:
 		that1 = t
-	case RefreshWorkflowTasksRequest:
+	case GetDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -1929,35 +3076,35 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RefreshWorkflowTasksResponse to the protobuf v3 wire format
-func (val *RefreshWorkflowTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type PurgeDLQTasksRequest to the protobuf v3 wire format
+func (val *PurgeDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RefreshWorkflowTasksResponse from the protobuf v3 wire format
-func (val *RefreshWorkflowTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PurgeDLQTasksRequest from the protobuf v3 wire format
+func (val *PurgeDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RefreshWorkflowTasksResponse) Size() int {
+func (val *PurgeDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RefreshWorkflowTasksResponse values are equivalent by recursively
+// Equal returns whether two PurgeDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RefreshWorkflowTasksResponse
+	var that1 *PurgeDLQTasksRequest
 	switch t := that.(type) {
-	case *RefreshWorkflowTasksResponse:
+	case *PurgeDLQTasksRequest:
 		that1 = t
-	case RefreshWorkflowTasksResponse:
+	case PurgeDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -1966,35 +3113,35 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ResendReplicationTasksRequest to the protobuf v3 wire format
-func (val *ResendReplicationTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type PurgeDLQTasksResponse to the protobuf v3 wire format
+func (val *PurgeDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ResendReplicationTasksRequest from the protobuf v3 wire format
-func (val *ResendReplicationTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PurgeDLQTasksResponse from the protobuf v3 wire format
+func (val *PurgeDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ResendReplicationTasksRequest) Size() int {
+func (val *PurgeDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ResendReplicationTasksRequest values are equivalent by recursively
+// Equal returns whether two PurgeDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ResendReplicationTasksRequest
+	var that1 *PurgeDLQTasksResponse
 	switch t := that.(type) {
-	case *ResendReplicationTasksRequest:
+	case *PurgeDLQTasksResponse:
 		that1 = t
-	case ResendReplicationTasksRequest:
+	case PurgeDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2003,35 +3150,35 @@ func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ResendReplicationTasksResponse to the protobuf v3 wire format
-func (val *ResendReplicationTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DLQJobToken to the protobuf v3 wire format
+func (val *DLQJobToken) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ResendReplicationTasksResponse from the protobuf v3 wire format
-func (val *ResendReplicationTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DLQJobToken from the protobuf v3 wire format
+func (val *DLQJobToken) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ResendReplicationTasksResponse) Size() int {
+func (val *DLQJobToken) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ResendReplicationTasksResponse values are equivalent by recursively
+// Equal returns whether two DLQJobToken values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *DLQJobToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ResendReplicationTasksResponse
+	var that1 *DLQJobToken
 	switch t := that.(type) {
-	case *ResendReplicationTasksResponse:
+	case *DLQJobToken:
 		that1 = t
-	case ResendReplicationTasksResponse:
+	case DLQJobToken:
 		that1 = &t
 	default:
 		return false
@@ -2040,35 +3187,35 @@ func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueTasksRequest to the protobuf v3 wire format
-func (val *GetTaskQueueTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MergeDLQTasksRequest to the protobuf v3 wire format
+func (val *MergeDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueTasksRequest from the protobuf v3 wire format
-func (val *GetTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MergeDLQTasksRequest from the protobuf v3 wire format
+func (val *MergeDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueTasksRequest) Size() int {
+func (val *MergeDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueTasksRequest values are equivalent by recursively
+// Equal returns whether two MergeDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
+func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueTasksRequest
+	var that1 *MergeDLQTasksRequest
 	switch t := that.(type) {
-	case *GetTaskQueueTasksRequest:
+	case *MergeDLQTasksRequest:
 		that1 = t
-	case GetTaskQueueTasksRequest:
+	case MergeDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2077,35 +3224,35 @@ func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueTasksResponse to the protobuf v3 wire format
-func (val *GetTaskQueueTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MergeDLQTasksResponse to the protobuf v3 wire format
+func (val *MergeDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueTasksResponse from the protobuf v3 wire format
-func (val *GetTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MergeDLQTasksResponse from the protobuf v3 wire format
+func (val *MergeDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueTasksResponse) Size() int {
+func (val *MergeDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueTasksResponse values are equivalent by recursively
+// Equal returns whether two MergeDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
+func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueTasksResponse
+	var that1 *MergeDLQTasksResponse
 	switch t := that.(type) {
-	case *GetTaskQueueTasksResponse:
+	case *MergeDLQTasksResponse:
 		that1 = t
-	case GetTaskQueueTasksResponse:
+	case MergeDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2114,35 +3261,35 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeDLQJobRequest to the protobuf v3 wire format
+func (val *DescribeDLQJobRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeleteWorkflowExecutionRequest from the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeDLQJobRequest from the protobuf v3 wire format
+func (val *DescribeDLQJobRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeleteWorkflowExecutionRequest) Size() int {
+func (val *DescribeDLQJobRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeleteWorkflowExecutionRequest values are equivalent by recursively
+// Equal returns whether two DescribeDLQJobRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeleteWorkflowExecutionRequest
+	var that1 *DescribeDLQJobRequest
 	switch t := that.(type) {
-	case *DeleteWorkflowExecutionRequest:
+	case *DescribeDLQJobRequest:
 		that1 = t
-	case DeleteWorkflowExecutionRequest:
+	case DescribeDLQJobRequest:
 		that1 = &t
 	default:
 		return false
@@ -2151,35 +3298,35 @@ func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeleteWorkflowExecutionResponse to the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeDLQJobResponse to the protobuf v3 wire format
+func (val *DescribeDLQJobResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeleteWorkflowExecutionResponse from the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeDLQJobResponse from the protobuf v3 wire format
+func (val *DescribeDLQJobResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeleteWorkflowExecutionResponse) Size() int {
+func (val *DescribeDLQJobResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeleteWorkflowExecutionResponse values are equivalent by recursively
+// Equal returns whether two DescribeDLQJobResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeleteWorkflowExecutionResponse
+	var that1 *DescribeDLQJobResponse
 	switch t := that.(type) {
-	case *DeleteWorkflowExecutionResponse:
+	case *DescribeDLQJobResponse:
 		that1 = t
-	case DeleteWorkflowExecutionResponse:
+	case DescribeDLQJobResponse:
 		that1 = &t
 	default:
 		return false
@@ -2188,35 +3335,35 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StreamWorkflowReplicationMessagesRequest to the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DLQTaskOutcome to the protobuf v3 wire format
+func (val *DLQTaskOutcome) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StreamWorkflowReplicationMessagesRequest from the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DLQTaskOutcome from the protobuf v3 wire format
+func (val *DLQTaskOutcome) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StreamWorkflowReplicationMessagesRequest) Size() int {
+func (val *DLQTaskOutcome) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StreamWorkflowReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two DLQTaskOutcome values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *DLQTaskOutcome) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StreamWorkflowReplicationMessagesRequest
+	var that1 *DLQTaskOutcome
 	switch t := that.(type) {
-	case *StreamWorkflowReplicationMessagesRequest:
+	case *DLQTaskOutcome:
 		that1 = t
-	case StreamWorkflowReplicationMessagesRequest:
+	case DLQTaskOutcome:
 		that1 = &t
 	default:
 		return false
@@ -2225,35 +3372,35 @@ func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StreamWorkflowReplicationMessagesResponse to the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type CancelDLQJobRequest to the protobuf v3 wire format
+func (val *CancelDLQJobRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StreamWorkflowReplicationMessagesResponse from the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CancelDLQJobRequest from the protobuf v3 wire format
+func (val *CancelDLQJobRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StreamWorkflowReplicationMessagesResponse) Size() int {
+func (val *CancelDLQJobRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StreamWorkflowReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two CancelDLQJobRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StreamWorkflowReplicationMessagesResponse
+	var that1 *CancelDLQJobRequest
 	switch t := that.(type) {
-	case *StreamWorkflowReplicationMessagesResponse:
+	case *CancelDLQJobRequest:
 		that1 = t
-	case StreamWorkflowReplicationMessagesResponse:
+	case CancelDLQJobRequest:
 		that1 = &t
 	default:
 		return false
@@ -2262,35 +3409,35 @@ func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) b
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceRequest to the protobuf v3 wire format
-func (val *GetNamespaceRequest) Marshal() ([]byte, error) {
+// Marshal an object of type CancelDLQJobResponse to the protobuf v3 wire format
+func (val *CancelDLQJobResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceRequest from the protobuf v3 wire format
-func (val *GetNamespaceRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CancelDLQJobResponse from the protobuf v3 wire format
+func (val *CancelDLQJobResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceRequest) Size() int {
+func (val *CancelDLQJobResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceRequest values are equivalent by recursively
+// Equal returns whether two CancelDLQJobResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceRequest) Equal(that interface{}) bool {
+func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceRequest
+	var that1 *CancelDLQJobResponse
 	switch t := that.(type) {
-	case *GetNamespaceRequest:
+	case *CancelDLQJobResponse:
 		that1 = t
-	case GetNamespaceRequest:
+	case CancelDLQJobResponse:
 		that1 = &t
 	default:
 		return false
@@ -2299,35 +3446,35 @@ func (this *GetNamespaceRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceResponse to the protobuf v3 wire format
-func (val *GetNamespaceResponse) Marshal() ([]byte, error) {
+// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
+func (val *AddTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceResponse from the protobuf v3 wire format
-func (val *GetNamespaceResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
+func (val *AddTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceResponse) Size() int {
+func (val *AddTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceResponse values are equivalent by recursively
+// Equal returns whether two AddTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceResponse) Equal(that interface{}) bool {
+func (this *AddTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceResponse
+	var that1 *AddTasksRequest
 	switch t := that.(type) {
-	case *GetNamespaceResponse:
+	case *AddTasksRequest:
 		that1 = t
-	case GetNamespaceResponse:
+	case AddTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2336,35 +3483,35 @@ func (this *GetNamespaceResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQTasksRequest to the protobuf v3 wire format
-func (val *GetDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
+func (val *AddTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQTasksRequest from the protobuf v3 wire format
-func (val *GetDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
+func (val *AddTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQTasksRequest) Size() int {
+func (val *AddTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two AddTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
+func (this *AddTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQTasksRequest
+	var that1 *AddTasksResponse
 	switch t := that.(type) {
-	case *GetDLQTasksRequest:
+	case *AddTasksResponse:
 		that1 = t
-	case GetDLQTasksRequest:
+	case AddTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2373,35 +3520,35 @@ func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQTasksResponse to the protobuf v3 wire format
-func (val *GetDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueuesRequest to the protobuf v3 wire format
+func (val *ListQueuesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQTasksResponse from the protobuf v3 wire format
-func (val *GetDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueuesRequest from the protobuf v3 wire format
+func (val *ListQueuesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQTasksResponse) Size() int {
+func (val *ListQueuesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two ListQueuesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
+func (this *ListQueuesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQTasksResponse
+	var that1 *ListQueuesRequest
 	switch t := that.(type) {
-	case *GetDLQTasksResponse:
+	case *ListQueuesRequest:
 		that1 = t
-	case GetDLQTasksResponse:
+	case ListQueuesRequest:
 		that1 = &t
 	default:
 		return false
@@ -2410,35 +3557,35 @@ func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQTasksRequest to the protobuf v3 wire format
-func (val *PurgeDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueuesResponse to the protobuf v3 wire format
+func (val *ListQueuesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQTasksRequest from the protobuf v3 wire format
-func (val *PurgeDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueuesResponse from the protobuf v3 wire format
+func (val *ListQueuesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQTasksRequest) Size() int {
+func (val *ListQueuesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two ListQueuesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
+func (this *ListQueuesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQTasksRequest
+	var that1 *ListQueuesResponse
 	switch t := that.(type) {
-	case *PurgeDLQTasksRequest:
+	case *ListQueuesResponse:
 		that1 = t
-	case PurgeDLQTasksRequest:
+	case ListQueuesResponse:
 		that1 = &t
 	default:
 		return false
@@ -2447,35 +3594,35 @@ func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQTasksResponse to the protobuf v3 wire format
-func (val *PurgeDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DeepHealthCheckRequest to the protobuf v3 wire format
+func (val *DeepHealthCheckRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQTasksResponse from the protobuf v3 wire format
-func (val *PurgeDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeepHealthCheckRequest from the protobuf v3 wire format
+func (val *DeepHealthCheckRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQTasksResponse) Size() int {
+func (val *DeepHealthCheckRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two DeepHealthCheckRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
+func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQTasksResponse
+	var that1 *DeepHealthCheckRequest
 	switch t := that.(type) {
-	case *PurgeDLQTasksResponse:
+	case *DeepHealthCheckRequest:
 		that1 = t
-	case PurgeDLQTasksResponse:
+	case DeepHealthCheckRequest:
 		that1 = &t
 	default:
 		return false
@@ -2484,35 +3631,35 @@ func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DLQJobToken to the protobuf v3 wire format
-func (val *DLQJobToken) Marshal() ([]byte, error) {
+// Marshal an object of type DeepHealthCheckResponse to the protobuf v3 wire format
+func (val *DeepHealthCheckResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DLQJobToken from the protobuf v3 wire format
-func (val *DLQJobToken) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeepHealthCheckResponse from the protobuf v3 wire format
+func (val *DeepHealthCheckResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DLQJobToken) Size() int {
+func (val *DeepHealthCheckResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DLQJobToken values are equivalent by recursively
+// Equal returns whether two DeepHealthCheckResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DLQJobToken) Equal(that interface{}) bool {
+func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DLQJobToken
+	var that1 *DeepHealthCheckResponse
 	switch t := that.(type) {
-	case *DLQJobToken:
+	case *DeepHealthCheckResponse:
 		that1 = t
-	case DLQJobToken:
+	case DeepHealthCheckResponse:
 		that1 = &t
 	default:
 		return false
@@ -2521,35 +3668,35 @@ func (this *DLQJobToken) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQTasksRequest to the protobuf v3 wire format
-func (val *MergeDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
+func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQTasksRequest from the protobuf v3 wire format
-func (val *MergeDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type SyncWorkflowStateRequest from the protobuf v3 wire format
+func (val *SyncWorkflowStateRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQTasksRequest) Size() int {
+func (val *SyncWorkflowStateRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two SyncWorkflowStateRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
+func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQTasksRequest
+	var that1 *SyncWorkflowStateRequest
 	switch t := that.(type) {
-	case *MergeDLQTasksRequest:
+	case *SyncWorkflowStateRequest:
 		that1 = t
-	case MergeDLQTasksRequest:
+	case SyncWorkflowStateRequest:
 		that1 = &t
 	default:
 		return false
@@ -2558,35 +3705,35 @@ func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQTasksResponse to the protobuf v3 wire format
-func (val *MergeDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type SyncWorkflowStateResponse to the protobuf v3 wire format
+func (val *SyncWorkflowStateResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQTasksResponse from the protobuf v3 wire format
-func (val *MergeDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type SyncWorkflowStateResponse from the protobuf v3 wire format
+func (val *SyncWorkflowStateResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQTasksResponse) Size() int {
+func (val *SyncWorkflowStateResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two SyncWorkflowStateResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
+func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQTasksResponse
+	var that1 *SyncWorkflowStateResponse
 	switch t := that.(type) {
-	case *MergeDLQTasksResponse:
+	case *SyncWorkflowStateResponse:
 		that1 = t
-	case MergeDLQTasksResponse:
+	case SyncWorkflowStateResponse:
 		that1 = &t
 	default:
 		return false
@@ -2595,35 +3742,35 @@ func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeDLQJobRequest to the protobuf v3 wire format
-func (val *DescribeDLQJobRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GenerateLastHistoryReplicationTasksRequest to the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeDLQJobRequest from the protobuf v3 wire format
-func (val *DescribeDLQJobRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GenerateLastHistoryReplicationTasksRequest from the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeDLQJobRequest) Size() int {
+func (val *GenerateLastHistoryReplicationTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeDLQJobRequest values are equivalent by recursively
+// Equal returns whether two GenerateLastHistoryReplicationTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
+func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeDLQJobRequest
+	var that1 *GenerateLastHistoryReplicationTasksRequest
 	switch t := that.(type) {
-	case *DescribeDLQJobRequest:
+	case *GenerateLastHistoryReplicationTasksRequest:
 		that1 = t
-	case DescribeDLQJobRequest:
+	case GenerateLastHistoryReplicationTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2632,35 +3779,35 @@ func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeDLQJobResponse to the protobuf v3 wire format
-func (val *DescribeDLQJobResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GenerateLastHistoryReplicationTasksResponse to the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeDLQJobResponse from the protobuf v3 wire format
-func (val *DescribeDLQJobResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GenerateLastHistoryReplicationTasksResponse from the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeDLQJobResponse) Size() int {
+func (val *GenerateLastHistoryReplicationTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeDLQJobResponse values are equivalent by recursively
+// Equal returns whether two GenerateLastHistoryReplicationTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
+func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeDLQJobResponse
+	var that1 *GenerateLastHistoryReplicationTasksResponse
 	switch t := that.(type) {
-	case *DescribeDLQJobResponse:
+	case *GenerateLastHistoryReplicationTasksResponse:
 		that1 = t
-	case DescribeDLQJobResponse:
+	case GenerateLastHistoryReplicationTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2669,35 +3816,35 @@ func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CancelDLQJobRequest to the protobuf v3 wire format
-func (val *CancelDLQJobRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CancelDLQJobRequest from the protobuf v3 wire format
-func (val *CancelDLQJobRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CancelDLQJobRequest) Size() int {
+func (val *DescribeTaskQueuePartitionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CancelDLQJobRequest values are equivalent by recursively
+// Equal returns whether two DescribeTaskQueuePartitionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
+func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CancelDLQJobRequest
+	var that1 *DescribeTaskQueuePartitionRequest
 	switch t := that.(type) {
-	case *CancelDLQJobRequest:
+	case *DescribeTaskQueuePartitionRequest:
 		that1 = t
-	case CancelDLQJobRequest:
+	case DescribeTaskQueuePartitionRequest:
 		that1 = &t
 	default:
 		return false
@@ -2706,35 +3853,35 @@ func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CancelDLQJobResponse to the protobuf v3 wire format
-func (val *CancelDLQJobResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CancelDLQJobResponse from the protobuf v3 wire format
-func (val *CancelDLQJobResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CancelDLQJobResponse) Size() int {
+func (val *DescribeTaskQueuePartitionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CancelDLQJobResponse values are equivalent by recursively
+// Equal returns whether two DescribeTaskQueuePartitionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
+func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CancelDLQJobResponse
+	var that1 *DescribeTaskQueuePartitionResponse
 	switch t := that.(type) {
-	case *CancelDLQJobResponse:
+	case *DescribeTaskQueuePartitionResponse:
 		that1 = t
-	case CancelDLQJobResponse:
+	case DescribeTaskQueuePartitionResponse:
 		that1 = &t
 	default:
 		return false
@@ -2743,35 +3890,35 @@ func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
-func (val *AddTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ForceUnloadTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
-func (val *AddTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForceUnloadTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddTasksRequest) Size() int {
+func (val *ForceUnloadTaskQueuePartitionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddTasksRequest values are equivalent by recursively
+// Equal returns whether two ForceUnloadTaskQueuePartitionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddTasksRequest) Equal(that interface{}) bool {
+func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddTasksRequest
+	var that1 *ForceUnloadTaskQueuePartitionRequest
 	switch t := that.(type) {
-	case *AddTasksRequest:
+	case *ForceUnloadTaskQueuePartitionRequest:
 		that1 = t
-	case AddTasksRequest:
+	case ForceUnloadTaskQueuePartitionRequest:
 		that1 = &t
 	default:
 		return false
@@ -2780,35 +3927,35 @@ func (this *AddTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
-func (val *AddTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ForceUnloadTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
-func (val *AddTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForceUnloadTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddTasksResponse) Size() int {
+func (val *ForceUnloadTaskQueuePartitionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddTasksResponse values are equivalent by recursively
+// Equal returns whether two ForceUnloadTaskQueuePartitionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddTasksResponse) Equal(that interface{}) bool {
+func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddTasksResponse
+	var that1 *ForceUnloadTaskQueuePartitionResponse
 	switch t := that.(type) {
-	case *AddTasksResponse:
+	case *ForceUnloadTaskQueuePartitionResponse:
 		that1 = t
-	case AddTasksResponse:
+	case ForceUnloadTaskQueuePartitionResponse:
 		that1 = &t
 	default:
 		return false
@@ -2817,35 +3964,35 @@ func (this *AddTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListQueuesRequest to the protobuf v3 wire format
-func (val *ListQueuesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskQueueUserDataRequest to the protobuf v3 wire format
+func (val *GetTaskQueueUserDataRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListQueuesRequest from the protobuf v3 wire format
-func (val *ListQueuesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskQueueUserDataRequest from the protobuf v3 wire format
+func (val *GetTaskQueueUserDataRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListQueuesRequest) Size() int {
+func (val *GetTaskQueueUserDataRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListQueuesRequest values are equivalent by recursively
+// Equal returns whether two GetTaskQueueUserDataRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListQueuesRequest) Equal(that interface{}) bool {
+func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListQueuesRequest
+	var that1 *GetTaskQueueUserDataRequest
 	switch t := that.(type) {
-	case *ListQueuesRequest:
+	case *GetTaskQueueUserDataRequest:
 		that1 = t
-	case ListQueuesRequest:
+	case GetTaskQueueUserDataRequest:
 		that1 = &t
 	default:
 		return false
@@ -2854,35 +4001,35 @@ func (this *ListQueuesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListQueuesResponse to the protobuf v3 wire format
-func (val *ListQueuesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetTaskQueueUserDataResponse to the protobuf v3 wire format
+func (val *GetTaskQueueUserDataResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListQueuesResponse from the protobuf v3 wire format
-func (val *ListQueuesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetTaskQueueUserDataResponse from the protobuf v3 wire format
+func (val *GetTaskQueueUserDataResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListQueuesResponse) Size() int {
+func (val *GetTaskQueueUserDataResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListQueuesResponse values are equivalent by recursively
+// Equal returns whether two GetTaskQueueUserDataResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListQueuesResponse) Equal(that interface{}) bool {
+func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListQueuesResponse
+	var that1 *GetTaskQueueUserDataResponse
 	switch t := that.(type) {
-	case *ListQueuesResponse:
+	case *GetTaskQueueUserDataResponse:
 		that1 = t
-	case ListQueuesResponse:
+	case GetTaskQueueUserDataResponse:
 		that1 = &t
 	default:
 		return false
@@ -2891,35 +4038,35 @@ func (this *ListQueuesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeepHealthCheckRequest to the protobuf v3 wire format
-func (val *DeepHealthCheckRequest) Marshal() ([]byte, error) {
+// Marshal an object of type StartAdminBatchOperationRequest to the protobuf v3 wire format
+func (val *StartAdminBatchOperationRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeepHealthCheckRequest from the protobuf v3 wire format
-func (val *DeepHealthCheckRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StartAdminBatchOperationRequest from the protobuf v3 wire format
+func (val *StartAdminBatchOperationRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeepHealthCheckRequest) Size() int {
+func (val *StartAdminBatchOperationRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeepHealthCheckRequest values are equivalent by recursively
+// Equal returns whether two StartAdminBatchOperationRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
+func (this *StartAdminBatchOperationRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeepHealthCheckRequest
+	var that1 *StartAdminBatchOperationRequest
 	switch t := that.(type) {
-	case *DeepHealthCheckRequest:
+	case *StartAdminBatchOperationRequest:
 		that1 = t
-	case DeepHealthCheckRequest:
+	case StartAdminBatchOperationRequest:
 		that1 = &t
 	default:
 		return false
@@ -2928,35 +4075,35 @@ func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeepHealthCheckResponse to the protobuf v3 wire format
-func (val *DeepHealthCheckResponse) Marshal() ([]byte, error) {
+// Marshal an object of type StartAdminBatchOperationResponse to the protobuf v3 wire format
+func (val *StartAdminBatchOperationResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeepHealthCheckResponse from the protobuf v3 wire format
-func (val *DeepHealthCheckResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StartAdminBatchOperationResponse from the protobuf v3 wire format
+func (val *StartAdminBatchOperationResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeepHealthCheckResponse) Size() int {
+func (val *StartAdminBatchOperationResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeepHealthCheckResponse values are equivalent by recursively
+// Equal returns whether two StartAdminBatchOperationResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
+func (this *StartAdminBatchOperationResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeepHealthCheckResponse
+	var that1 *StartAdminBatchOperationResponse
 	switch t := that.(type) {
-	case *DeepHealthCheckResponse:
+	case *StartAdminBatchOperationResponse:
 		that1 = t
-	case DeepHealthCheckResponse:
+	case StartAdminBatchOperationResponse:
 		that1 = &t
 	default:
 		return false
@@ -2965,35 +4112,35 @@ func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
-func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
+// Marshal an object of type BatchOperationRefreshTasks to the protobuf v3 wire format
+func (val *BatchOperationRefreshTasks) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type SyncWorkflowStateRequest from the protobuf v3 wire format
-func (val *SyncWorkflowStateRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type BatchOperationRefreshTasks from the protobuf v3 wire format
+func (val *BatchOperationRefreshTasks) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *SyncWorkflowStateRequest) Size() int {
+func (val *BatchOperationRefreshTasks) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two SyncWorkflowStateRequest values are equivalent by recursively
+// Equal returns whether two BatchOperationRefreshTasks values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
+func (this *BatchOperationRefreshTasks) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *SyncWorkflowStateRequest
+	var that1 *BatchOperationRefreshTasks
 	switch t := that.(type) {
-	case *SyncWorkflowStateRequest:
+	case *BatchOperationRefreshTasks:
 		that1 = t
-	case SyncWorkflowStateRequest:
+	case BatchOperationRefreshTasks:
 		that1 = &t
 	default:
 		return false
@@ -3002,35 +4149,35 @@ func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type SyncWorkflowStateResponse to the protobuf v3 wire format
-func (val *SyncWorkflowStateResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MigrateScheduleRequest to the protobuf v3 wire format
+func (val *MigrateScheduleRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type SyncWorkflowStateResponse from the protobuf v3 wire format
-func (val *SyncWorkflowStateResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MigrateScheduleRequest from the protobuf v3 wire format
+func (val *MigrateScheduleRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *SyncWorkflowStateResponse) Size() int {
+func (val *MigrateScheduleRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two SyncWorkflowStateResponse values are equivalent by recursively
+// Equal returns whether two MigrateScheduleRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
+func (this *MigrateScheduleRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *SyncWorkflowStateResponse
+	var that1 *MigrateScheduleRequest
 	switch t := that.(type) {
-	case *SyncWorkflowStateResponse:
+	case *MigrateScheduleRequest:
 		that1 = t
-	case SyncWorkflowStateResponse:
+	case MigrateScheduleRequest:
 		that1 = &t
 	default:
 		return false
@@ -3039,35 +4186,35 @@ func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GenerateLastHistoryReplicationTasksRequest to the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MigrateScheduleResponse to the protobuf v3 wire format
+func (val *MigrateScheduleResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GenerateLastHistoryReplicationTasksRequest from the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MigrateScheduleResponse from the protobuf v3 wire format
+func (val *MigrateScheduleResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GenerateLastHistoryReplicationTasksRequest) Size() int {
+func (val *MigrateScheduleResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GenerateLastHistoryReplicationTasksRequest values are equivalent by recursively
+// Equal returns whether two MigrateScheduleResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *MigrateScheduleResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GenerateLastHistoryReplicationTasksRequest
+	var that1 *MigrateScheduleResponse
 	switch t := that.(type) {
-	case *GenerateLastHistoryReplicationTasksRequest:
+	case *MigrateScheduleResponse:
 		that1 = t
-	case GenerateLastHistoryReplicationTasksRequest:
+	case MigrateScheduleResponse:
 		that1 = &t
 	default:
 		return false
@@ -3076,35 +4223,35 @@ func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{})
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GenerateLastHistoryReplicationTasksResponse to the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type CloneWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *CloneWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GenerateLastHistoryReplicationTasksResponse from the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CloneWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *CloneWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GenerateLastHistoryReplicationTasksResponse) Size() int {
+func (val *CloneWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GenerateLastHistoryReplicationTasksResponse values are equivalent by recursively
+// Equal returns whether two CloneWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *CloneWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GenerateLastHistoryReplicationTasksResponse
+	var that1 *CloneWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *GenerateLastHistoryReplicationTasksResponse:
+	case *CloneWorkflowExecutionRequest:
 		that1 = t
-	case GenerateLastHistoryReplicationTasksResponse:
+	case CloneWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -3113,35 +4260,35 @@ func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{})
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeTaskQueuePartitionRequest to the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type CloneWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *CloneWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeTaskQueuePartitionRequest from the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CloneWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *CloneWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeTaskQueuePartitionRequest) Size() int {
+func (val *CloneWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeTaskQueuePartitionRequest values are equivalent by recursively
+// Equal returns whether two CloneWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
+func (this *CloneWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeTaskQueuePartitionRequest
+	var that1 *CloneWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *DescribeTaskQueuePartitionRequest:
+	case *CloneWorkflowExecutionResponse:
 		that1 = t
-	case DescribeTaskQueuePartitionRequest:
+	case CloneWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -3150,35 +4297,35 @@ func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeTaskQueuePartitionResponse to the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DryRunResetWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *DryRunResetWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeTaskQueuePartitionResponse from the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DryRunResetWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *DryRunResetWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeTaskQueuePartitionResponse) Size() int {
+func (val *DryRunResetWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeTaskQueuePartitionResponse values are equivalent by recursively
+// Equal returns whether two DryRunResetWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
+func (this *DryRunResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeTaskQueuePartitionResponse
+	var that1 *DryRunResetWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *DescribeTaskQueuePartitionResponse:
+	case *DryRunResetWorkflowExecutionRequest:
 		that1 = t
-	case DescribeTaskQueuePartitionResponse:
+	case DryRunResetWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -3187,35 +4334,35 @@ func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ForceUnloadTaskQueuePartitionRequest to the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DryRunResetWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *DryRunResetWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ForceUnloadTaskQueuePartitionRequest from the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DryRunResetWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *DryRunResetWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ForceUnloadTaskQueuePartitionRequest) Size() int {
+func (val *DryRunResetWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ForceUnloadTaskQueuePartitionRequest values are equivalent by recursively
+// Equal returns whether two DryRunResetWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
+func (this *DryRunResetWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ForceUnloadTaskQueuePartitionRequest
+	var that1 *DryRunResetWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *ForceUnloadTaskQueuePartitionRequest:
+	case *DryRunResetWorkflowExecutionResponse:
 		that1 = t
-	case ForceUnloadTaskQueuePartitionRequest:
+	case DryRunResetWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -3224,35 +4371,35 @@ func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ForceUnloadTaskQueuePartitionResponse to the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeMutableStateAtEventRequest to the protobuf v3 wire format
+func (val *DescribeMutableStateAtEventRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ForceUnloadTaskQueuePartitionResponse from the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeMutableStateAtEventRequest from the protobuf v3 wire format
+func (val *DescribeMutableStateAtEventRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ForceUnloadTaskQueuePartitionResponse) Size() int {
+func (val *DescribeMutableStateAtEventRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ForceUnloadTaskQueuePartitionResponse values are equivalent by recursively
+// Equal returns whether two DescribeMutableStateAtEventRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool {
+func (this *DescribeMutableStateAtEventRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ForceUnloadTaskQueuePartitionResponse
+	var that1 *DescribeMutableStateAtEventRequest
 	switch t := that.(type) {
-	case *ForceUnloadTaskQueuePartitionResponse:
+	case *DescribeMutableStateAtEventRequest:
 		that1 = t
-	case ForceUnloadTaskQueuePartitionResponse:
+	case DescribeMutableStateAtEventRequest:
 		that1 = &t
 	default:
 		return false
@@ -3261,35 +4408,35 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueUserDataRequest to the protobuf v3 wire format
-func (val *GetTaskQueueUserDataRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeMutableStateAtEventResponse to the protobuf v3 wire format
+func (val *DescribeMutableStateAtEventResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueUserDataRequest from the protobuf v3 wire format
-func (val *GetTaskQueueUserDataRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeMutableStateAtEventResponse from the protobuf v3 wire format
+func (val *DescribeMutableStateAtEventResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueUserDataRequest) Size() int {
+func (val *DescribeMutableStateAtEventResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueUserDataRequest values are equivalent by recursively
+// Equal returns whether two DescribeMutableStateAtEventResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
+func (this *DescribeMutableStateAtEventResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueUserDataRequest
+	var that1 *DescribeMutableStateAtEventResponse
 	switch t := that.(type) {
-	case *GetTaskQueueUserDataRequest:
+	case *DescribeMutableStateAtEventResponse:
 		that1 = t
-	case GetTaskQueueUserDataRequest:
+	case DescribeMutableStateAtEventResponse:
 		that1 = &t
 	default:
 		return false
@@ -3298,35 +4445,35 @@ func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueUserDataResponse to the protobuf v3 wire format
-func (val *GetTaskQueueUserDataResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListWorkflowConflictResolutionsRequest to the protobuf v3 wire format
+func (val *ListWorkflowConflictResolutionsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueUserDataResponse from the protobuf v3 wire format
-func (val *GetTaskQueueUserDataResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListWorkflowConflictResolutionsRequest from the protobuf v3 wire format
+func (val *ListWorkflowConflictResolutionsRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueUserDataResponse) Size() int {
+func (val *ListWorkflowConflictResolutionsRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueUserDataResponse values are equivalent by recursively
+// Equal returns whether two ListWorkflowConflictResolutionsRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
+func (this *ListWorkflowConflictResolutionsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueUserDataResponse
+	var that1 *ListWorkflowConflictResolutionsRequest
 	switch t := that.(type) {
-	case *GetTaskQueueUserDataResponse:
+	case *ListWorkflowConflictResolutionsRequest:
 		that1 = t
-	case GetTaskQueueUserDataResponse:
+	case ListWorkflowConflictResolutionsRequest:
 		that1 = &t
 	default:
 		return false
@@ -3335,35 +4482,35 @@ func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StartAdminBatchOperationRequest to the protobuf v3 wire format
-func (val *StartAdminBatchOperationRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListWorkflowConflictResolutionsResponse to the protobuf v3 wire format
+func (val *ListWorkflowConflictResolutionsResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StartAdminBatchOperationRequest from the protobuf v3 wire format
-func (val *StartAdminBatchOperationRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListWorkflowConflictResolutionsResponse from the protobuf v3 wire format
+func (val *ListWorkflowConflictResolutionsResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StartAdminBatchOperationRequest) Size() int {
+func (val *ListWorkflowConflictResolutionsResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StartAdminBatchOperationRequest values are equivalent by recursively
+// Equal returns whether two ListWorkflowConflictResolutionsResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StartAdminBatchOperationRequest) Equal(that interface{}) bool {
+func (this *ListWorkflowConflictResolutionsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StartAdminBatchOperationRequest
+	var that1 *ListWorkflowConflictResolutionsResponse
 	switch t := that.(type) {
-	case *StartAdminBatchOperationRequest:
+	case *ListWorkflowConflictResolutionsResponse:
 		that1 = t
-	case StartAdminBatchOperationRequest:
+	case ListWorkflowConflictResolutionsResponse:
 		that1 = &t
 	default:
 		return false
@@ -3372,35 +4519,35 @@ func (this *StartAdminBatchOperationRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StartAdminBatchOperationResponse to the protobuf v3 wire format
-func (val *StartAdminBatchOperationResponse) Marshal() ([]byte, error) {
+// Marshal an object of type StartChainedActivityExecutionRequest to the protobuf v3 wire format
+func (val *StartChainedActivityExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StartAdminBatchOperationResponse from the protobuf v3 wire format
-func (val *StartAdminBatchOperationResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StartChainedActivityExecutionRequest from the protobuf v3 wire format
+func (val *StartChainedActivityExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StartAdminBatchOperationResponse) Size() int {
+func (val *StartChainedActivityExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StartAdminBatchOperationResponse values are equivalent by recursively
+// Equal returns whether two StartChainedActivityExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StartAdminBatchOperationResponse) Equal(that interface{}) bool {
+func (this *StartChainedActivityExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StartAdminBatchOperationResponse
+	var that1 *StartChainedActivityExecutionRequest
 	switch t := that.(type) {
-	case *StartAdminBatchOperationResponse:
+	case *StartChainedActivityExecutionRequest:
 		that1 = t
-	case StartAdminBatchOperationResponse:
+	case StartChainedActivityExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -3409,35 +4556,35 @@ func (this *StartAdminBatchOperationResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type BatchOperationRefreshTasks to the protobuf v3 wire format
-func (val *BatchOperationRefreshTasks) Marshal() ([]byte, error) {
+// Marshal an object of type StartChainedActivityExecutionResponse to the protobuf v3 wire format
+func (val *StartChainedActivityExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type BatchOperationRefreshTasks from the protobuf v3 wire format
-func (val *BatchOperationRefreshTasks) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StartChainedActivityExecutionResponse from the protobuf v3 wire format
+func (val *StartChainedActivityExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *BatchOperationRefreshTasks) Size() int {
+func (val *StartChainedActivityExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two BatchOperationRefreshTasks values are equivalent by recursively
+// Equal returns whether two StartChainedActivityExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *BatchOperationRefreshTasks) Equal(that interface{}) bool {
+func (this *StartChainedActivityExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *BatchOperationRefreshTasks
+	var that1 *StartChainedActivityExecutionResponse
 	switch t := that.(type) {
-	case *BatchOperationRefreshTasks:
+	case *StartChainedActivityExecutionResponse:
 		that1 = t
-	case BatchOperationRefreshTasks:
+	case StartChainedActivityExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -3446,35 +4593,35 @@ func (this *BatchOperationRefreshTasks) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MigrateScheduleRequest to the protobuf v3 wire format
-func (val *MigrateScheduleRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeChainedActivityExecutionRequest to the protobuf v3 wire format
+func (val *DescribeChainedActivityExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MigrateScheduleRequest from the protobuf v3 wire format
-func (val *MigrateScheduleRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeChainedActivityExecutionRequest from the protobuf v3 wire format
+func (val *DescribeChainedActivityExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MigrateScheduleRequest) Size() int {
+func (val *DescribeChainedActivityExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MigrateScheduleRequest values are equivalent by recursively
+// Equal returns whether two DescribeChainedActivityExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MigrateScheduleRequest) Equal(that interface{}) bool {
+func (this *DescribeChainedActivityExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MigrateScheduleRequest
+	var that1 *DescribeChainedActivityExecutionRequest
 	switch t := that.(type) {
-	case *MigrateScheduleRequest:
+	case *DescribeChainedActivityExecutionRequest:
 		that1 = t
-	case MigrateScheduleRequest:
+	case DescribeChainedActivityExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -3483,35 +4630,35 @@ func (this *MigrateScheduleRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MigrateScheduleResponse to the protobuf v3 wire format
-func (val *MigrateScheduleResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeChainedActivityExecutionResponse to the protobuf v3 wire format
+func (val *DescribeChainedActivityExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MigrateScheduleResponse from the protobuf v3 wire format
-func (val *MigrateScheduleResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeChainedActivityExecutionResponse from the protobuf v3 wire format
+func (val *DescribeChainedActivityExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MigrateScheduleResponse) Size() int {
+func (val *DescribeChainedActivityExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MigrateScheduleResponse values are equivalent by recursively
+// Equal returns whether two DescribeChainedActivityExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MigrateScheduleResponse) Equal(that interface{}) bool {
+func (this *DescribeChainedActivityExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MigrateScheduleResponse
+	var that1 *DescribeChainedActivityExecutionResponse
 	switch t := that.(type) {
-	case *MigrateScheduleResponse:
+	case *DescribeChainedActivityExecutionResponse:
 		that1 = t
-	case MigrateScheduleResponse:
+	case DescribeChainedActivityExecutionResponse:
 		that1 = &t
 	default:
 		return false
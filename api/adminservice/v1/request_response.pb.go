@@ -19,6 +19,8 @@ import (
 	v115 "go.temporal.io/api/taskqueue/v1"
 	v19 "go.temporal.io/api/version/v1"
 	v17 "go.temporal.io/api/workflow/v1"
+	v116 "go.temporal.io/api/workflowservice/v1"
+	v117 "go.temporal.io/server/api/activity/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
 	v112 "go.temporal.io/server/api/common/v1"
 	v14 "go.temporal.io/server/api/enums/v1"
@@ -100,7 +102,7 @@ func (x MigrateScheduleRequest_SchedulerTarget) Number() protoreflect.EnumNumber
 
 // Deprecated: Use MigrateScheduleRequest_SchedulerTarget.Descriptor instead.
 func (MigrateScheduleRequest_SchedulerTarget) EnumDescriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112, 0}
 }
 
 type RebuildMutableStateRequest struct {
@@ -535,8 +537,14 @@ type DescribeHistoryHostResponse struct {
 	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
 	NamespaceCache *v13.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
 	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Shards pinned to the host, which it owns regardless of membership.
+	PinnedShardIds []int32 `protobuf:"varint,6,rep,packed,name=pinned_shard_ids,json=pinnedShardIds,proto3" json:"pinned_shard_ids,omitempty"`
+	// Number of write requests and tasks per second handled by the shards of the host.
+	Load float64 `protobuf:"fixed64,7,opt,name=load,proto3" json:"load,omitempty"`
+	// Shard of the workflow execution the host was described by, honoring shard pool assignments.
+	WorkflowShardId int32 `protobuf:"varint,8,opt,name=workflow_shard_id,json=workflowShardId,proto3" json:"workflow_shard_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DescribeHistoryHostResponse) Reset() {
@@ -597,6 +605,27 @@ func (x *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (x *DescribeHistoryHostResponse) GetPinnedShardIds() []int32 {
+	if x != nil {
+		return x.PinnedShardIds
+	}
+	return nil
+}
+
+func (x *DescribeHistoryHostResponse) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *DescribeHistoryHostResponse) GetWorkflowShardId() int32 {
+	if x != nil {
+		return x.WorkflowShardId
+	}
+	return 0
+}
+
 type CloseShardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -677,27 +706,29 @@ func (*CloseShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{9}
 }
 
-type GetShardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+type MoveHistoryShardRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Address of the history host to move the shard to (ip:port).
+	TargetHost    string `protobuf:"bytes,2,opt,name=target_host,json=targetHost,proto3" json:"target_host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardRequest) Reset() {
-	*x = GetShardRequest{}
+func (x *MoveHistoryShardRequest) Reset() {
+	*x = MoveHistoryShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardRequest) String() string {
+func (x *MoveHistoryShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardRequest) ProtoMessage() {}
+func (*MoveHistoryShardRequest) ProtoMessage() {}
 
-func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveHistoryShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,39 +740,45 @@ func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
-func (*GetShardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveHistoryShardRequest.ProtoReflect.Descriptor instead.
+func (*MoveHistoryShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetShardRequest) GetShardId() int32 {
+func (x *MoveHistoryShardRequest) GetShardId() int32 {
 	if x != nil {
 		return x.ShardId
 	}
 	return 0
 }
 
-type GetShardResponse struct {
+func (x *MoveHistoryShardRequest) GetTargetHost() string {
+	if x != nil {
+		return x.TargetHost
+	}
+	return ""
+}
+
+type MoveHistoryShardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardInfo     *v12.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardResponse) Reset() {
-	*x = GetShardResponse{}
+func (x *MoveHistoryShardResponse) Reset() {
+	*x = MoveHistoryShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardResponse) String() string {
+func (x *MoveHistoryShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardResponse) ProtoMessage() {}
+func (*MoveHistoryShardResponse) ProtoMessage() {}
 
-func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveHistoryShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,44 +790,32 @@ func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
-func (*GetShardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveHistoryShardResponse.ProtoReflect.Descriptor instead.
+func (*MoveHistoryShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetShardResponse) GetShardInfo() *v12.ShardInfo {
-	if x != nil {
-		return x.ShardInfo
-	}
-	return nil
-}
-
-type ListHistoryTasksRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
-	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type UnpinHistoryShardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryTasksRequest) Reset() {
-	*x = ListHistoryTasksRequest{}
+func (x *UnpinHistoryShardRequest) Reset() {
+	*x = UnpinHistoryShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryTasksRequest) String() string {
+func (x *UnpinHistoryShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksRequest) ProtoMessage() {}
+func (*UnpinHistoryShardRequest) ProtoMessage() {}
 
-func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *UnpinHistoryShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -802,68 +827,38 @@ func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinHistoryShardRequest.ProtoReflect.Descriptor instead.
+func (*UnpinHistoryShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListHistoryTasksRequest) GetShardId() int32 {
+func (x *UnpinHistoryShardRequest) GetShardId() int32 {
 	if x != nil {
 		return x.ShardId
 	}
 	return 0
 }
 
-func (x *ListHistoryTasksRequest) GetCategory() int32 {
-	if x != nil {
-		return x.Category
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
-	if x != nil {
-		return x.TaskRange
-	}
-	return nil
-}
-
-func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type ListHistoryTasksResponse struct {
+type UnpinHistoryShardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryTasksResponse) Reset() {
-	*x = ListHistoryTasksResponse{}
+func (x *UnpinHistoryShardResponse) Reset() {
+	*x = UnpinHistoryShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryTasksResponse) String() string {
+func (x *UnpinHistoryShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksResponse) ProtoMessage() {}
+func (*UnpinHistoryShardResponse) ProtoMessage() {}
 
-func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *UnpinHistoryShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,52 +870,33 @@ func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinHistoryShardResponse.ProtoReflect.Descriptor instead.
+func (*UnpinHistoryShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListHistoryTasksResponse) GetTasks() []*Task {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      v14.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
-	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
-	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+type DescribeTaskSchedulerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the history host to describe (ip:port). All history hosts are described if empty.
+	HostAddress   string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *DescribeTaskSchedulerRequest) Reset() {
+	*x = DescribeTaskSchedulerRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *DescribeTaskSchedulerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*DescribeTaskSchedulerRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *DescribeTaskSchedulerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,86 +908,91 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeTaskSchedulerRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskSchedulerRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Task) GetNamespaceId() string {
+func (x *DescribeTaskSchedulerRequest) GetHostAddress() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.HostAddress
 	}
 	return ""
 }
 
-func (x *Task) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
+type DescribeTaskSchedulerResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Hosts         []*DescribeTaskSchedulerResponse_Host `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetRunId() string {
-	if x != nil {
-		return x.RunId
-	}
-	return ""
+func (x *DescribeTaskSchedulerResponse) Reset() {
+	*x = DescribeTaskSchedulerResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) GetTaskId() int64 {
-	if x != nil {
-		return x.TaskId
-	}
-	return 0
+func (x *DescribeTaskSchedulerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetTaskType() v14.TaskType {
+func (*DescribeTaskSchedulerResponse) ProtoMessage() {}
+
+func (x *DescribeTaskSchedulerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
 	if x != nil {
-		return x.TaskType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return v14.TaskType(0)
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetFireTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FireTime
-	}
-	return nil
+// Deprecated: Use DescribeTaskSchedulerResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskSchedulerResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Task) GetVersion() int64 {
+func (x *DescribeTaskSchedulerResponse) GetHosts() []*DescribeTaskSchedulerResponse_Host {
 	if x != nil {
-		return x.Version
+		return x.Hosts
 	}
-	return 0
+	return nil
 }
 
-type RemoveTaskRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateTaskSchedulerNamespaceWeightRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Multiplier applied to the task channel weights of the namespace on all history hosts. Values above 1 boost the
+	// namespace, values below 1 throttle it.
+	Multiplier float64 `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// How long the multiplier is applied for.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Remove the override of the namespace instead of setting it.
+	Remove        bool `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTaskRequest) Reset() {
-	*x = RemoveTaskRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) Reset() {
+	*x = UpdateTaskSchedulerNamespaceWeightRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskRequest) String() string {
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskRequest) ProtoMessage() {}
+func (*UpdateTaskSchedulerNamespaceWeightRequest) ProtoMessage() {}
 
-func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,60 +1003,63 @@ func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateTaskSchedulerNamespaceWeightRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSchedulerNamespaceWeightRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RemoveTaskRequest) GetShardId() int32 {
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *RemoveTaskRequest) GetCategory() int32 {
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) GetMultiplier() float64 {
 	if x != nil {
-		return x.Category
+		return x.Multiplier
 	}
 	return 0
 }
 
-func (x *RemoveTaskRequest) GetTaskId() int64 {
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.TaskId
+		return x.Duration
 	}
-	return 0
+	return nil
 }
 
-func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+func (x *UpdateTaskSchedulerNamespaceWeightRequest) GetRemove() bool {
 	if x != nil {
-		return x.VisibilityTime
+		return x.Remove
 	}
-	return nil
+	return false
 }
 
-type RemoveTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type UpdateTaskSchedulerNamespaceWeightResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// History hosts the override was applied to.
+	Hosts         []string               `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTaskResponse) Reset() {
-	*x = RemoveTaskResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
+func (x *UpdateTaskSchedulerNamespaceWeightResponse) Reset() {
+	*x = UpdateTaskSchedulerNamespaceWeightResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskResponse) String() string {
+func (x *UpdateTaskSchedulerNamespaceWeightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskResponse) ProtoMessage() {}
+func (*UpdateTaskSchedulerNamespaceWeightResponse) ProtoMessage() {}
 
-func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
+func (x *UpdateTaskSchedulerNamespaceWeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,43 +1070,2081 @@ func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
-func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateTaskSchedulerNamespaceWeightResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSchedulerNamespaceWeightResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{17}
 }
 
-// *
-// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
-// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
-type GetWorkflowExecutionRawHistoryV2Request struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *UpdateTaskSchedulerNamespaceWeightResponse) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *UpdateTaskSchedulerNamespaceWeightResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GetShardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShardRequest) Reset() {
+	*x = GetShardRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShardRequest) ProtoMessage() {}
+
+func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
+func (*GetShardRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetShardRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+type GetShardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardInfo     *v12.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShardResponse) Reset() {
+	*x = GetShardResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShardResponse) ProtoMessage() {}
+
+func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
+func (*GetShardResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetShardResponse) GetShardInfo() *v12.ShardInfo {
+	if x != nil {
+		return x.ShardInfo
+	}
+	return nil
+}
+
+type ListHistoryTasksRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
+	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryTasksRequest) Reset() {
+	*x = ListHistoryTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHistoryTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryTasksRequest) ProtoMessage() {}
+
+func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListHistoryTasksRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
+	if x != nil {
+		return x.TaskRange
+	}
+	return nil
+}
+
+func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListHistoryTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryTasksResponse) Reset() {
+	*x = ListHistoryTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHistoryTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryTasksResponse) ProtoMessage() {}
+
+func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListHistoryTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      v14.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Task) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *Task) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Task) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Task) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *Task) GetTaskType() v14.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v14.TaskType(0)
+}
+
+func (x *Task) GetFireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireTime
+	}
+	return nil
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetTaskTraceRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category      int32 `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskId        int64 `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTraceRequest) Reset() {
+	*x = GetTaskTraceRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTraceRequest) ProtoMessage() {}
+
+func (x *GetTaskTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskTraceRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTaskTraceRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *GetTaskTraceRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *GetTaskTraceRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type GetTaskTraceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trace         *v11.TaskTrace         `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTraceResponse) Reset() {
+	*x = GetTaskTraceResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTraceResponse) ProtoMessage() {}
+
+func (x *GetTaskTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskTraceResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTaskTraceResponse) GetTrace() *v11.TaskTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type ListQueueMitigationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueMitigationsRequest) Reset() {
+	*x = ListQueueMitigationsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueMitigationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueMitigationsRequest) ProtoMessage() {}
+
+func (x *ListQueueMitigationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueMitigationsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueMitigationsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListQueueMitigationsRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+type ListQueueMitigationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	Mitigations   []*v11.QueueMitigation `protobuf:"bytes,1,rep,name=mitigations,proto3" json:"mitigations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueMitigationsResponse) Reset() {
+	*x = ListQueueMitigationsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueMitigationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueMitigationsResponse) ProtoMessage() {}
+
+func (x *ListQueueMitigationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueMitigationsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueMitigationsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListQueueMitigationsResponse) GetMitigations() []*v11.QueueMitigation {
+	if x != nil {
+		return x.Mitigations
+	}
+	return nil
+}
+
+type RemoveTaskRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveTaskRequest) Reset() {
+	*x = RemoveTaskRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskRequest) ProtoMessage() {}
+
+func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveTaskRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisibilityTime
+	}
+	return nil
+}
+
+type RemoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskResponse) Reset() {
+	*x = RemoveTaskResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskResponse) ProtoMessage() {}
+
+func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{28}
+}
+
+// *
+// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
+// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
+type GetWorkflowExecutionRawHistoryV2Request struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Request{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
+	if x != nil {
+		return x.StartEventId
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
+	if x != nil {
+		return x.StartEventVersion
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
+	if x != nil {
+		return x.EndEventVersion
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetWorkflowExecutionRawHistoryV2Response struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Response{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
+	if x != nil {
+		return x.HistoryBatches
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
+	if x != nil {
+		return x.VersionHistory
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
+	if x != nil {
+		return x.HistoryNodeIds
+	}
+	return nil
+}
+
+type GetWorkflowExecutionRawHistoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
+	*x = GetWorkflowExecutionRawHistoryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
+	if x != nil {
+		return x.StartEventId
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
+	if x != nil {
+		return x.StartEventVersion
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
+	if x != nil {
+		return x.EndEventVersion
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetWorkflowExecutionRawHistoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
+	*x = GetWorkflowExecutionRawHistoryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
+	if x != nil {
+		return x.HistoryBatches
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
+	if x != nil {
+		return x.VersionHistory
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
+	if x != nil {
+		return x.HistoryNodeIds
+	}
+	return nil
+}
+
+type GetReplicationMessagesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tokens        []*v15.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicationMessagesRequest) Reset() {
+	*x = GetReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetReplicationMessagesRequest) GetTokens() []*v15.ReplicationToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *GetReplicationMessagesRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetReplicationMessagesResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	ShardMessages map[int32]*v15.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicationMessagesResponse) Reset() {
+	*x = GetReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v15.ReplicationMessages {
+	if x != nil {
+		return x.ShardMessages
+	}
+	return nil
+}
+
+type GetNamespaceReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lastRetrievedMessageId is where the next fetch should begin with.
+	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
+	// lastProcessedMessageId is the last messageId that is processed on the passive side.
+	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
+	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// clusterName is the name of the pulling cluster.
+	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) Reset() {
+	*x = GetNamespaceReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
+	if x != nil {
+		return x.LastRetrievedMessageId
+	}
+	return 0
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetNamespaceReplicationMessagesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Messages      *v15.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceReplicationMessagesResponse) Reset() {
+	*x = GetNamespaceReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.ReplicationMessages {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type GetDLQReplicationMessagesRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	TaskInfos     []*v15.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQReplicationMessagesRequest) Reset() {
+	*x = GetDLQReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTaskInfo {
+	if x != nil {
+		return x.TaskInfos
+	}
+	return nil
+}
+
+type GetDLQReplicationMessagesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationTasks []*v15.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDLQReplicationMessagesResponse) Reset() {
+	*x = GetDLQReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+	if x != nil {
+		return x.ReplicationTasks
+	}
+	return nil
+}
+
+type GetReplicationLagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Remote cluster names to query for. If omitted, all remote clusters are returned.
+	RemoteClusters []string `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty"`
+	// Namespace names to report the lag of. If omitted, all namespaces with unreplicated tasks are reported.
+	Namespaces []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Replication lag under which it's considered safe to fail over. Defaults to zero, i.e. there must be no
+	// unreplicated task.
+	MaxLag *durationpb.Duration `protobuf:"bytes,3,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	// Max number of unreplicated tasks scanned per shard and remote cluster to compute the lag of each namespace.
+	// Defaults to 1000.
+	MaxScannedTasks int32 `protobuf:"varint,4,opt,name=max_scanned_tasks,json=maxScannedTasks,proto3" json:"max_scanned_tasks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReplicationLagRequest) Reset() {
+	*x = GetReplicationLagRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationLagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationLagRequest) ProtoMessage() {}
+
+func (x *GetReplicationLagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationLagRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationLagRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetReplicationLagRequest) GetRemoteClusters() []string {
+	if x != nil {
+		return x.RemoteClusters
+	}
+	return nil
+}
+
+func (x *GetReplicationLagRequest) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *GetReplicationLagRequest) GetMaxLag() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLag
+	}
+	return nil
+}
+
+func (x *GetReplicationLagRequest) GetMaxScannedTasks() int32 {
+	if x != nil {
+		return x.MaxScannedTasks
+	}
+	return 0
+}
+
+type GetReplicationLagResponse struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	Clusters       []*ClusterReplicationLag `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	SafeToFailover bool                     `protobuf:"varint,2,opt,name=safe_to_failover,json=safeToFailover,proto3" json:"safe_to_failover,omitempty"`
+	// Why it's not safe to fail over, if safe_to_failover is false.
+	UnsafeReasons []string `protobuf:"bytes,3,rep,name=unsafe_reasons,json=unsafeReasons,proto3" json:"unsafe_reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicationLagResponse) Reset() {
+	*x = GetReplicationLagResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicationLagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationLagResponse) ProtoMessage() {}
+
+func (x *GetReplicationLagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicationLagResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationLagResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetReplicationLagResponse) GetClusters() []*ClusterReplicationLag {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *GetReplicationLagResponse) GetSafeToFailover() bool {
+	if x != nil {
+		return x.SafeToFailover
+	}
+	return false
+}
+
+func (x *GetReplicationLagResponse) GetUnsafeReasons() []string {
+	if x != nil {
+		return x.UnsafeReasons
+	}
+	return nil
+}
+
+type ClusterReplicationLag struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Max difference between the last replication task ID and the task ID acked by the cluster over all shards.
+	TaskIdLag int64 `protobuf:"varint,2,opt,name=task_id_lag,json=taskIdLag,proto3" json:"task_id_lag,omitempty"`
+	// Max age of the oldest task not acked by the cluster over all shards.
+	Lag *durationpb.Duration `protobuf:"bytes,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	// Number of scanned tasks not acked by the cluster.
+	BacklogTaskCount int64 `protobuf:"varint,4,opt,name=backlog_task_count,json=backlogTaskCount,proto3" json:"backlog_task_count,omitempty"`
+	// Whether some shards have more unacked tasks than max_scanned_tasks, so that backlog_task_count is a lower bound.
+	BacklogTruncated bool `protobuf:"varint,5,opt,name=backlog_truncated,json=backlogTruncated,proto3" json:"backlog_truncated,omitempty"`
+	// Number of tasks replicated from the current cluster that the cluster failed to apply and put in its DLQ.
+	DlqMessageCount int64 `protobuf:"varint,6,opt,name=dlq_message_count,json=dlqMessageCount,proto3" json:"dlq_message_count,omitempty"`
+	// IDs of the shards whose replication stream to the cluster isn't making progress.
+	StuckShardIds []int32                    `protobuf:"varint,7,rep,packed,name=stuck_shard_ids,json=stuckShardIds,proto3" json:"stuck_shard_ids,omitempty"`
+	Namespaces    []*NamespaceReplicationLag `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterReplicationLag) Reset() {
+	*x = ClusterReplicationLag{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterReplicationLag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterReplicationLag) ProtoMessage() {}
+
+func (x *ClusterReplicationLag) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterReplicationLag.ProtoReflect.Descriptor instead.
+func (*ClusterReplicationLag) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ClusterReplicationLag) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterReplicationLag) GetTaskIdLag() int64 {
+	if x != nil {
+		return x.TaskIdLag
+	}
+	return 0
+}
+
+func (x *ClusterReplicationLag) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
+}
+
+func (x *ClusterReplicationLag) GetBacklogTaskCount() int64 {
+	if x != nil {
+		return x.BacklogTaskCount
+	}
+	return 0
+}
+
+func (x *ClusterReplicationLag) GetBacklogTruncated() bool {
+	if x != nil {
+		return x.BacklogTruncated
+	}
+	return false
+}
+
+func (x *ClusterReplicationLag) GetDlqMessageCount() int64 {
+	if x != nil {
+		return x.DlqMessageCount
+	}
+	return 0
+}
+
+func (x *ClusterReplicationLag) GetStuckShardIds() []int32 {
+	if x != nil {
+		return x.StuckShardIds
+	}
+	return nil
+}
+
+func (x *ClusterReplicationLag) GetNamespaces() []*NamespaceReplicationLag {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type NamespaceReplicationLag struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Namespace   string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Number of scanned tasks of the namespace not acked by the cluster.
+	BacklogTaskCount int64 `protobuf:"varint,3,opt,name=backlog_task_count,json=backlogTaskCount,proto3" json:"backlog_task_count,omitempty"`
+	// Age of the oldest task of the namespace not acked by the cluster.
+	Lag *durationpb.Duration `protobuf:"bytes,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	// Workflow execution of the oldest task of the namespace not acked by the cluster.
+	OldestUnreplicatedExecution *v1.WorkflowExecution `protobuf:"bytes,5,opt,name=oldest_unreplicated_execution,json=oldestUnreplicatedExecution,proto3" json:"oldest_unreplicated_execution,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *NamespaceReplicationLag) Reset() {
+	*x = NamespaceReplicationLag{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceReplicationLag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceReplicationLag) ProtoMessage() {}
+
+func (x *NamespaceReplicationLag) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceReplicationLag.ProtoReflect.Descriptor instead.
+func (*NamespaceReplicationLag) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NamespaceReplicationLag) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *NamespaceReplicationLag) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceReplicationLag) GetBacklogTaskCount() int64 {
+	if x != nil {
+		return x.BacklogTaskCount
+	}
+	return 0
+}
+
+func (x *NamespaceReplicationLag) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
+}
+
+func (x *NamespaceReplicationLag) GetOldestUnreplicatedExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.OldestUnreplicatedExecution
+	}
+	return nil
+}
+
+// ReapplyEventsRequest is the request for reapply events API.
+type ReapplyEventsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReapplyEventsRequest) Reset() {
+	*x = ReapplyEventsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReapplyEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyEventsRequest) ProtoMessage() {}
+
+func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReapplyEventsRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.WorkflowExecution
+	}
+	return nil
+}
+
+func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ReapplyEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReapplyEventsResponse) Reset() {
+	*x = ReapplyEventsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReapplyEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyEventsResponse) ProtoMessage() {}
+
+func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{44}
+}
+
+type AddSearchAttributesRequest struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
+	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddSearchAttributesRequest) Reset() {
+	*x = AddSearchAttributesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSearchAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSearchAttributesRequest) ProtoMessage() {}
+
+func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v16.IndexedValueType {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *AddSearchAttributesRequest) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
+	if x != nil {
+		return x.SkipSchemaUpdate
+	}
+	return false
+}
+
+func (x *AddSearchAttributesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type AddSearchAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSearchAttributesResponse) Reset() {
+	*x = AddSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSearchAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSearchAttributesResponse) ProtoMessage() {}
+
+func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{46}
+}
+
+type RemoveSearchAttributesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoveSearchAttributesRequest) Reset() {
+	*x = RemoveSearchAttributesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSearchAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSearchAttributesRequest) ProtoMessage() {}
+
+func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *RemoveSearchAttributesRequest) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *RemoveSearchAttributesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type RemoveSearchAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSearchAttributesResponse) Reset() {
+	*x = RemoveSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSearchAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSearchAttributesResponse) ProtoMessage() {}
+
+func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{48}
+}
+
+type GetSearchAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchAttributesRequest) Reset() {
+	*x = GetSearchAttributesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAttributesRequest) ProtoMessage() {}
+
+func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSearchAttributesRequest) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *GetSearchAttributesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetSearchAttributesResponse struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// State of the workflow that adds search attributes to the system.
+	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetSearchAttributesResponse) Reset() {
+	*x = GetSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAttributesResponse) ProtoMessage() {}
+
+func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v16.IndexedValueType {
+	if x != nil {
+		return x.CustomAttributes
+	}
+	return nil
+}
+
+func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v16.IndexedValueType {
+	if x != nil {
+		return x.SystemAttributes
+	}
+	return nil
+}
+
+func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+	if x != nil {
+		return x.AddWorkflowExecutionInfo
+	}
+	return nil
+}
+
+type DescribeClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeClusterRequest) Reset() {
+	*x = DescribeClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeClusterRequest) ProtoMessage() {}
+
+func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DescribeClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type DescribeClusterResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
+	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
+	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
+	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
+	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
+	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
+	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Request{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
+func (x *DescribeClusterResponse) Reset() {
+	*x = DescribeClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
+func (x *DescribeClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
+func (*DescribeClusterResponse) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
+func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,164 +3155,132 @@ func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
+func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
+func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
 	if x != nil {
-		return x.NamespaceId
+		return x.SupportedClients
+	}
+	return nil
+}
+
+func (x *DescribeClusterResponse) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
 	}
 	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
+func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
 	if x != nil {
-		return x.Execution
+		return x.MembershipInfo
 	}
 	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
+func (x *DescribeClusterResponse) GetClusterId() string {
 	if x != nil {
-		return x.StartEventId
+		return x.ClusterId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
+func (x *DescribeClusterResponse) GetClusterName() string {
 	if x != nil {
-		return x.StartEventVersion
+		return x.ClusterName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
+func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
 	if x != nil {
-		return x.EndEventId
+		return x.HistoryShardCount
 	}
 	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
+func (x *DescribeClusterResponse) GetPersistenceStore() string {
 	if x != nil {
-		return x.EndEventVersion
+		return x.PersistenceStore
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
+func (x *DescribeClusterResponse) GetVisibilityStore() string {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.VisibilityStore
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
+func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
 	if x != nil {
-		return x.NextPageToken
+		return x.VersionInfo
 	}
 	return nil
 }
 
-type GetWorkflowExecutionRawHistoryV2Response struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Response{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
+func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FailoverVersionIncrement
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{18}
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
+func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.InitialFailoverVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
+func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
 	if x != nil {
-		return x.HistoryBatches
+		return x.IsGlobalNamespaceEnabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
+func (x *DescribeClusterResponse) GetTags() map[string]string {
 	if x != nil {
-		return x.VersionHistory
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
+func (x *DescribeClusterResponse) GetHttpAddress() string {
 	if x != nil {
-		return x.HistoryNodeIds
+		return x.HttpAddress
 	}
-	return nil
+	return ""
 }
 
-type GetWorkflowExecutionRawHistoryRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListClustersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
-	*x = GetWorkflowExecutionRawHistoryRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (x *ListClustersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
+func (*ListClustersRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,92 +3291,104 @@ func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
+func (x *ListClustersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NamespaceId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
+func (x *ListClustersRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.Execution
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
-	if x != nil {
-		return x.StartEventId
-	}
-	return 0
+type ListClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*v12.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
-	if x != nil {
-		return x.StartEventVersion
-	}
-	return 0
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
-	if x != nil {
-		return x.EndEventId
-	}
-	return 0
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
 	if x != nil {
-		return x.EndEventVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListClustersResponse) GetClusters() []*v12.ClusterMetadata {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.Clusters
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
+func (x *ListClustersResponse) GetNextPageToken() []byte {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return nil
 }
 
-type GetWorkflowExecutionRawHistoryResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AddOrUpdateRemoteClusterRequest struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
+	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
+	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
+	// enable_replication controls whether replication streams are active.
+	EnableReplication bool `protobuf:"varint,4,opt,name=enable_replication,json=enableReplication,proto3" json:"enable_replication,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
-	*x = GetWorkflowExecutionRawHistoryResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
+func (x *AddOrUpdateRemoteClusterRequest) Reset() {
+	*x = AddOrUpdateRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (x *AddOrUpdateRemoteClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
+func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
+func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,62 +3399,61 @@ func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.FrontendAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
+func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
 	if x != nil {
-		return x.HistoryBatches
+		return x.EnableRemoteClusterConnection
 	}
-	return nil
+	return false
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
+// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
 	if x != nil {
-		return x.VersionHistory
+		return x.FrontendHttpAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
+func (x *AddOrUpdateRemoteClusterRequest) GetEnableReplication() bool {
 	if x != nil {
-		return x.HistoryNodeIds
+		return x.EnableReplication
 	}
-	return nil
+	return false
 }
 
-type GetReplicationMessagesRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Tokens        []*v15.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+type AddOrUpdateRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReplicationMessagesRequest) Reset() {
-	*x = GetReplicationMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
+func (x *AddOrUpdateRemoteClusterResponse) Reset() {
+	*x = AddOrUpdateRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesRequest) String() string {
+func (x *AddOrUpdateRemoteClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesRequest) ProtoMessage() {}
+func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
 
-func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
+func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,47 +3464,33 @@ func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *GetReplicationMessagesRequest) GetTokens() []*v15.ReplicationToken {
-	if x != nil {
-		return x.Tokens
-	}
-	return nil
-}
-
-func (x *GetReplicationMessagesRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
+// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
 }
 
-type GetReplicationMessagesResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	ShardMessages map[int32]*v15.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type RemoveRemoteClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReplicationMessagesResponse) Reset() {
-	*x = GetReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
+func (x *RemoveRemoteClusterRequest) Reset() {
+	*x = RemoveRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesResponse) String() string {
+func (x *RemoveRemoteClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesResponse) ProtoMessage() {}
+func (*RemoveRemoteClusterRequest) ProtoMessage() {}
 
-func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
+func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,46 +3501,39 @@ func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v15.ReplicationMessages {
+func (x *RemoveRemoteClusterRequest) GetClusterName() string {
 	if x != nil {
-		return x.ShardMessages
+		return x.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type GetNamespaceReplicationMessagesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// lastRetrievedMessageId is where the next fetch should begin with.
-	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
-	// lastProcessedMessageId is the last messageId that is processed on the passive side.
-	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
-	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// clusterName is the name of the pulling cluster.
-	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+type RemoveRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) Reset() {
-	*x = GetNamespaceReplicationMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
+func (x *RemoveRemoteClusterResponse) Reset() {
+	*x = RemoveRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) String() string {
+func (x *RemoveRemoteClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
+func (*RemoveRemoteClusterResponse) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
+func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,54 +3544,45 @@ func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
-	if x != nil {
-		return x.LastRetrievedMessageId
-	}
-	return 0
-}
-
-func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
-	if x != nil {
-		return x.LastProcessedMessageId
-	}
-	return 0
-}
-
-func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
+// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
 }
 
-type GetNamespaceReplicationMessagesResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Messages      *v15.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListClusterMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
+	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
+	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Role                v14.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
+	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
+	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) Reset() {
-	*x = GetNamespaceReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
+func (x *ListClusterMembersRequest) Reset() {
+	*x = ListClusterMembersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) String() string {
+func (x *ListClusterMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
+func (*ListClusterMembersRequest) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
+func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,84 +3593,83 @@ func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.ReplicationMessages {
+func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
 	if x != nil {
-		return x.Messages
+		return x.LastHeartbeatWithin
 	}
 	return nil
 }
 
-type GetDLQReplicationMessagesRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	TaskInfos     []*v15.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListClusterMembersRequest) GetRpcAddress() string {
+	if x != nil {
+		return x.RpcAddress
+	}
+	return ""
 }
 
-func (x *GetDLQReplicationMessagesRequest) Reset() {
-	*x = GetDLQReplicationMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListClusterMembersRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
 }
 
-func (x *GetDLQReplicationMessagesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListClusterMembersRequest) GetRole() v14.ClusterMemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return v14.ClusterMemberRole(0)
 }
 
-func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
-
-func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
+func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SessionStartedAfterTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{25}
+func (x *ListClusterMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTaskInfo {
+func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.TaskInfos
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type GetDLQReplicationMessagesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReplicationTasks []*v15.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListClusterMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQReplicationMessagesResponse) Reset() {
-	*x = GetDLQReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
+func (x *ListClusterMembersResponse) Reset() {
+	*x = ListClusterMembersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQReplicationMessagesResponse) String() string {
+func (x *ListClusterMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
+func (*ListClusterMembersResponse) ProtoMessage() {}
 
-func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
+func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,43 +3680,52 @@ func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
 	if x != nil {
-		return x.ReplicationTasks
+		return x.ActiveMembers
 	}
 	return nil
 }
 
-// ReapplyEventsRequest is the request for reapply events API.
-type ReapplyEventsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
 }
 
-func (x *ReapplyEventsRequest) Reset() {
-	*x = ReapplyEventsRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
+type GetDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetDLQMessagesRequest) Reset() {
+	*x = GetDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsRequest) String() string {
+func (x *GetDLQMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsRequest) ProtoMessage() {}
+func (*GetDLQMessagesRequest) ProtoMessage() {}
 
-func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
+func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,53 +3736,78 @@ func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ReapplyEventsRequest) GetNamespaceId() string {
+func (x *GetDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if x != nil {
-		return x.NamespaceId
+		return x.Type
+	}
+	return v14.DeadLetterQueueType(0)
+}
+
+func (x *GetDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
 	}
 	return ""
 }
 
-func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
 	if x != nil {
-		return x.WorkflowExecution
+		return x.InclusiveEndMessageId
 	}
-	return nil
+	return 0
 }
 
-func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
 	if x != nil {
-		return x.Events
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type ReapplyEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetDLQMessagesResponse struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	Type                 v14.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks     []*v15.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ReplicationTasksInfo []*v15.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ReapplyEventsResponse) Reset() {
-	*x = ReapplyEventsResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
+func (x *GetDLQMessagesResponse) Reset() {
+	*x = GetDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsResponse) String() string {
+func (x *GetDLQMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsResponse) ProtoMessage() {}
+func (*GetDLQMessagesResponse) ProtoMessage() {}
 
-func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
+func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,36 +3818,64 @@ func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
 }
 
-type AddSearchAttributesRequest struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
-	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetDLQMessagesResponse) GetType() v14.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v14.DeadLetterQueueType(0)
 }
 
-func (x *AddSearchAttributesRequest) Reset() {
-	*x = AddSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
+func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+	if x != nil {
+		return x.ReplicationTasks
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v15.ReplicationTaskInfo {
+	if x != nil {
+		return x.ReplicationTasksInfo
+	}
+	return nil
+}
+
+type PurgeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PurgeDLQMessagesRequest) Reset() {
+	*x = PurgeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesRequest) String() string {
+func (x *PurgeDLQMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesRequest) ProtoMessage() {}
+func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 
-func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
+func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,60 +3886,60 @@ func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v16.IndexedValueType {
+func (x *PurgeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if x != nil {
-		return x.SearchAttributes
+		return x.Type
 	}
-	return nil
+	return v14.DeadLetterQueueType(0)
 }
 
-func (x *AddSearchAttributesRequest) GetIndexName() string {
+func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
 	if x != nil {
-		return x.IndexName
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
+func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
 	if x != nil {
-		return x.SkipSchemaUpdate
+		return x.SourceCluster
 	}
-	return false
+	return ""
 }
 
-func (x *AddSearchAttributesRequest) GetNamespace() string {
+func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.InclusiveEndMessageId
 	}
-	return ""
+	return 0
 }
 
-type AddSearchAttributesResponse struct {
+type PurgeDLQMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSearchAttributesResponse) Reset() {
-	*x = AddSearchAttributesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
+func (x *PurgeDLQMessagesResponse) Reset() {
+	*x = PurgeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesResponse) String() string {
+func (x *PurgeDLQMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesResponse) ProtoMessage() {}
+func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 
-func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
+func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,35 +3950,38 @@ func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
 }
 
-type RemoveSearchAttributesRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type MergeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *RemoveSearchAttributesRequest) Reset() {
-	*x = RemoveSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+func (x *MergeDLQMessagesRequest) Reset() {
+	*x = MergeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveSearchAttributesRequest) String() string {
+func (x *MergeDLQMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveSearchAttributesRequest) ProtoMessage() {}
+func (*MergeDLQMessagesRequest) ProtoMessage() {}
 
-func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,91 +3992,75 @@ func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
+func (x *MergeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if x != nil {
-		return x.SearchAttributes
+		return x.Type
 	}
-	return nil
+	return v14.DeadLetterQueueType(0)
 }
 
-func (x *RemoveSearchAttributesRequest) GetIndexName() string {
+func (x *MergeDLQMessagesRequest) GetShardId() int32 {
 	if x != nil {
-		return x.IndexName
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoveSearchAttributesRequest) GetNamespace() string {
+func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.SourceCluster
 	}
 	return ""
 }
 
-type RemoveSearchAttributesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RemoveSearchAttributesResponse) Reset() {
-	*x = RemoveSearchAttributesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RemoveSearchAttributesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
 }
 
-func (*RemoveSearchAttributesResponse) ProtoMessage() {}
-
-func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
+func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaximumPageSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{32}
+func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
 }
 
-type GetSearchAttributesRequest struct {
+type MergeDLQMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesRequest) Reset() {
-	*x = GetSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+func (x *MergeDLQMessagesResponse) Reset() {
+	*x = MergeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesRequest) String() string {
+func (x *MergeDLQMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesRequest) ProtoMessage() {}
+func (*MergeDLQMessagesResponse) ProtoMessage() {}
 
-func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,51 +4071,44 @@ func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *GetSearchAttributesRequest) GetIndexName() string {
-	if x != nil {
-		return x.IndexName
-	}
-	return ""
+// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetSearchAttributesRequest) GetNamespace() string {
+func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.Namespace
+		return x.NextPageToken
 	}
-	return ""
+	return nil
 }
 
-type GetSearchAttributesResponse struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// State of the workflow that adds search attributes to the system.
-	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type RefreshWorkflowTasksRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution   *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Archetype   string                 `protobuf:"bytes,4,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	// (-- api-linter: core::0141::forbidden-types=disabled --)
+	ArchetypeId   uint32 `protobuf:"varint,5,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesResponse) Reset() {
-	*x = GetSearchAttributesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+func (x *RefreshWorkflowTasksRequest) Reset() {
+	*x = RefreshWorkflowTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesResponse) String() string {
+func (x *RefreshWorkflowTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesResponse) ProtoMessage() {}
+func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 
-func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,61 +4119,60 @@ func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v16.IndexedValueType {
+func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.CustomAttributes
+		return x.NamespaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v16.IndexedValueType {
+func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.SystemAttributes
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
+func (x *RefreshWorkflowTasksRequest) GetArchetype() string {
 	if x != nil {
-		return x.Mapping
+		return x.Archetype
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+func (x *RefreshWorkflowTasksRequest) GetArchetypeId() uint32 {
 	if x != nil {
-		return x.AddWorkflowExecutionInfo
+		return x.ArchetypeId
 	}
-	return nil
+	return 0
 }
 
-type DescribeClusterRequest struct {
+type RefreshWorkflowTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeClusterRequest) Reset() {
-	*x = DescribeClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+func (x *RefreshWorkflowTasksResponse) Reset() {
+	*x = RefreshWorkflowTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterRequest) String() string {
+func (x *RefreshWorkflowTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterRequest) ProtoMessage() {}
+func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 
-func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,53 +4183,40 @@ func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
-func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *DescribeClusterRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
+// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
 }
 
-type DescribeClusterResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
-	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
-	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
-	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
-	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
-	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
-	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
-	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
-	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
-	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type ResendReplicationTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
+	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeClusterResponse) Reset() {
-	*x = DescribeClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+func (x *ResendReplicationTasksRequest) Reset() {
+	*x = ResendReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterResponse) String() string {
+func (x *ResendReplicationTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterResponse) ProtoMessage() {}
+func (*ResendReplicationTasksRequest) ProtoMessage() {}
 
-func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2229,132 +4227,88 @@ func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
-func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
-	if x != nil {
-		return x.SupportedClients
-	}
-	return nil
-}
-
-func (x *DescribeClusterResponse) GetServerVersion() string {
-	if x != nil {
-		return x.ServerVersion
-	}
-	return ""
-}
-
-func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
-	if x != nil {
-		return x.MembershipInfo
-	}
-	return nil
+// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *DescribeClusterResponse) GetClusterId() string {
+func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.ClusterId
+		return x.NamespaceId
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetClusterName() string {
+func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
 	if x != nil {
-		return x.ClusterName
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
-	if x != nil {
-		return x.HistoryShardCount
-	}
-	return 0
-}
-
-func (x *DescribeClusterResponse) GetPersistenceStore() string {
+func (x *ResendReplicationTasksRequest) GetRunId() string {
 	if x != nil {
-		return x.PersistenceStore
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetVisibilityStore() string {
+func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
 	if x != nil {
-		return x.VisibilityStore
+		return x.RemoteCluster
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
-	if x != nil {
-		return x.VersionInfo
-	}
-	return nil
-}
-
-func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
+func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
 	if x != nil {
-		return x.FailoverVersionIncrement
+		return x.StartEventId
 	}
 	return 0
 }
 
-func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
+func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
 	if x != nil {
-		return x.InitialFailoverVersion
+		return x.StartVersion
 	}
 	return 0
 }
 
-func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
-	if x != nil {
-		return x.IsGlobalNamespaceEnabled
-	}
-	return false
-}
-
-func (x *DescribeClusterResponse) GetTags() map[string]string {
+func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
 	if x != nil {
-		return x.Tags
+		return x.EndEventId
 	}
-	return nil
+	return 0
 }
 
-func (x *DescribeClusterResponse) GetHttpAddress() string {
+func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
 	if x != nil {
-		return x.HttpAddress
+		return x.EndVersion
 	}
-	return ""
+	return 0
 }
 
-type ListClustersRequest struct {
+type ResendReplicationTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClustersRequest) Reset() {
-	*x = ListClustersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *ResendReplicationTasksResponse) Reset() {
+	*x = ResendReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersRequest) String() string {
+func (x *ResendReplicationTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersRequest) ProtoMessage() {}
+func (*ResendReplicationTasksResponse) ProtoMessage() {}
 
-func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,48 +4319,41 @@ func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
-func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *ListClustersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListClustersRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
 }
 
-type ListClustersResponse struct {
+type GetTaskQueueTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clusters      []*v12.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	MinPass       int64                  `protobuf:"varint,9,opt,name=min_pass,json=minPass,proto3" json:"min_pass,omitempty"`
+	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClustersResponse) Reset() {
-	*x = ListClustersResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *GetTaskQueueTasksRequest) Reset() {
+	*x = GetTaskQueueTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersResponse) String() string {
+func (x *GetTaskQueueTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersResponse) ProtoMessage() {}
+func (*GetTaskQueueTasksRequest) ProtoMessage() {}
 
-func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2417,117 +4364,97 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
-func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListClustersResponse) GetClusters() []*v12.ClusterMetadata {
+func (x *GetTaskQueueTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.Clusters
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *ListClustersResponse) GetNextPageToken() []byte {
+func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.TaskQueue
 	}
-	return nil
-}
-
-type AddOrUpdateRemoteClusterRequest struct {
-	state                         protoimpl.MessageState `protogen:"open.v1"`
-	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
-	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
-	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
-	// enable_replication controls whether replication streams are active.
-	EnableReplication bool `protobuf:"varint,4,opt,name=enable_replication,json=enableReplication,proto3" json:"enable_replication,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *AddOrUpdateRemoteClusterRequest) Reset() {
-	*x = AddOrUpdateRemoteClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
 }
 
-func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
-
-func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+func (x *GetTaskQueueTasksRequest) GetMinPass() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MinPass
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
+func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
+	if x != nil {
+		return x.MinTaskId
+	}
+	return 0
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
+func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
 	if x != nil {
-		return x.FrontendAddress
+		return x.MaxTaskId
 	}
-	return ""
+	return 0
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
+func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.EnableRemoteClusterConnection
+		return x.BatchSize
 	}
-	return false
+	return 0
 }
 
-// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
+func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.FrontendHttpAddress
+		return x.NextPageToken
 	}
-	return ""
+	return nil
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) GetEnableReplication() bool {
+func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
 	if x != nil {
-		return x.EnableReplication
+		return x.Subqueue
 	}
-	return false
+	return 0
 }
 
-type AddOrUpdateRemoteClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetTaskQueueTasksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) Reset() {
-	*x = AddOrUpdateRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+func (x *GetTaskQueueTasksResponse) Reset() {
+	*x = GetTaskQueueTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) String() string {
+func (x *GetTaskQueueTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
+func (*GetTaskQueueTasksResponse) ProtoMessage() {}
 
-func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,33 +4465,51 @@ func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
 }
 
-type RemoveRemoteClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+func (x *GetTaskQueueTasksResponse) GetTasks() []*v12.AllocatedTaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Archetype string                 `protobuf:"bytes,3,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	// (-- api-linter: core::0141::forbidden-types=disabled --)
+	ArchetypeId   uint32 `protobuf:"varint,4,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRemoteClusterRequest) Reset() {
-	*x = RemoveRemoteClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+func (x *DeleteWorkflowExecutionRequest) Reset() {
+	*x = DeleteWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRemoteClusterRequest) String() string {
+func (x *DeleteWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRemoteClusterRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2575,39 +4520,61 @@ func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RemoveRemoteClusterRequest) GetClusterName() string {
+func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Namespace
 	}
 	return ""
 }
 
-type RemoveRemoteClusterResponse struct {
+func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetArchetype() string {
+	if x != nil {
+		return x.Archetype
+	}
+	return ""
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetArchetypeId() uint32 {
+	if x != nil {
+		return x.ArchetypeId
+	}
+	return 0
+}
+
+type DeleteWorkflowExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRemoteClusterResponse) Reset() {
-	*x = RemoveRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+func (x *DeleteWorkflowExecutionResponse) Reset() {
+	*x = DeleteWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRemoteClusterResponse) String() string {
+func (x *DeleteWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRemoteClusterResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,45 +4585,43 @@ func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
 }
 
-type ListClusterMembersRequest struct {
+func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StreamWorkflowReplicationMessagesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// (-- api-linter: core::0140::prepositions=disabled
-	//
-	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
-	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
-	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
-	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Role                v14.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
-	// (-- api-linter: core::0140::prepositions=disabled
+	// Types that are valid to be assigned to Attributes:
 	//
-	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
-	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
-	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
+	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersRequest) Reset() {
-	*x = ListClusterMembersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
+	*x = StreamWorkflowReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersRequest) String() string {
+func (x *StreamWorkflowReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersRequest) ProtoMessage() {}
+func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,83 +4632,63 @@ func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
+func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
 	if x != nil {
-		return x.LastHeartbeatWithin
+		return x.Attributes
 	}
 	return nil
 }
 
-func (x *ListClusterMembersRequest) GetRpcAddress() string {
-	if x != nil {
-		return x.RpcAddress
-	}
-	return ""
-}
-
-func (x *ListClusterMembersRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *ListClusterMembersRequest) GetRole() v14.ClusterMemberRole {
+func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
 	if x != nil {
-		return x.Role
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
+			return x.SyncReplicationState
+		}
 	}
-	return v14.ClusterMemberRole(0)
+	return nil
 }
 
-func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SessionStartedAfterTime
-	}
-	return nil
+type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
+	isStreamWorkflowReplicationMessagesRequest_Attributes()
 }
 
-func (x *ListClusterMembersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
+	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
 }
 
-func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
 }
 
-type ListClusterMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type StreamWorkflowReplicationMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesResponse_Messages
+	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersResponse) Reset() {
-	*x = ListClusterMembersResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
+	*x = StreamWorkflowReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersResponse) String() string {
+func (x *StreamWorkflowReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersResponse) ProtoMessage() {}
+func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,52 +4699,64 @@ func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
+func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
 	if x != nil {
-		return x.ActiveMembers
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
+			return x.Messages
+		}
 	}
 	return nil
 }
 
-func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
+	isStreamWorkflowReplicationMessagesResponse_Attributes()
 }
 
-type GetDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type StreamWorkflowReplicationMessagesResponse_Messages struct {
+	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
 }
 
-func (x *GetDLQMessagesRequest) Reset() {
-	*x = GetDLQMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+}
+
+type GetNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*GetNamespaceRequest_Namespace
+	//	*GetNamespaceRequest_Id
+	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesRequest) String() string {
+func (x *GetNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQMessagesRequest) ProtoMessage() {}
+func (*GetNamespaceRequest) ProtoMessage() {}
 
-func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,78 +4767,81 @@ func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
+func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
 	if x != nil {
-		return x.Type
+		return x.Attributes
 	}
-	return v14.DeadLetterQueueType(0)
+	return nil
 }
 
-func (x *GetDLQMessagesRequest) GetShardId() int32 {
+func (x *GetNamespaceRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
+			return x.Namespace
+		}
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDLQMessagesRequest) GetSourceCluster() string {
+func (x *GetNamespaceRequest) GetId() string {
 	if x != nil {
-		return x.SourceCluster
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
+			return x.Id
+		}
 	}
 	return ""
 }
 
-func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
-	if x != nil {
-		return x.InclusiveEndMessageId
-	}
-	return 0
+type isGetNamespaceRequest_Attributes interface {
+	isGetNamespaceRequest_Attributes()
 }
 
-func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
-	if x != nil {
-		return x.MaximumPageSize
-	}
-	return 0
+type GetNamespaceRequest_Namespace struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
 }
 
-func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+type GetNamespaceRequest_Id struct {
+	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
 }
 
-type GetDLQMessagesResponse struct {
-	state                protoimpl.MessageState     `protogen:"open.v1"`
-	Type                 v14.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks     []*v15.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	ReplicationTasksInfo []*v15.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
+
+func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
+
+type GetNamespaceResponse struct {
+	state             protoimpl.MessageState           `protogen:"open.v1"`
+	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
+	ReplicationFilter *v12.ReplicationFilter           `protobuf:"bytes,10,opt,name=replication_filter,json=replicationFilter,proto3" json:"replication_filter,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetDLQMessagesResponse) Reset() {
-	*x = GetDLQMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesResponse) String() string {
+func (x *GetNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQMessagesResponse) ProtoMessage() {}
+func (*GetNamespaceResponse) ProtoMessage() {}
 
-func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,64 +4852,91 @@ func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetDLQMessagesResponse) GetType() v14.DeadLetterQueueType {
+func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
 	if x != nil {
-		return x.Type
+		return x.Info
 	}
-	return v14.DeadLetterQueueType(0)
+	return nil
 }
 
-func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
 	if x != nil {
-		return x.ReplicationTasks
+		return x.Config
 	}
 	return nil
 }
 
-func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
+func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
 	if x != nil {
-		return x.NextPageToken
+		return x.ReplicationConfig
 	}
 	return nil
 }
 
-func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v15.ReplicationTaskInfo {
+func (x *GetNamespaceResponse) GetConfigVersion() int64 {
 	if x != nil {
-		return x.ReplicationTasksInfo
+		return x.ConfigVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+	if x != nil {
+		return x.FailoverVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+	if x != nil {
+		return x.FailoverHistory
 	}
 	return nil
 }
 
-type PurgeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+	if x != nil {
+		return x.IsGlobalNamespace
+	}
+	return false
 }
 
-func (x *PurgeDLQMessagesRequest) Reset() {
-	*x = PurgeDLQMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
+func (x *GetNamespaceResponse) GetReplicationFilter() *v12.ReplicationFilter {
+	if x != nil {
+		return x.ReplicationFilter
+	}
+	return nil
+}
+
+type UpdateNamespaceReplicationFilterRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// An empty filter replicates every workflow.
+	ReplicationFilter *v12.ReplicationFilter `protobuf:"bytes,2,opt,name=replication_filter,json=replicationFilter,proto3" json:"replication_filter,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceReplicationFilterRequest) Reset() {
+	*x = UpdateNamespaceReplicationFilterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesRequest) String() string {
+func (x *UpdateNamespaceReplicationFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesRequest) ProtoMessage() {}
+func (*UpdateNamespaceReplicationFilterRequest) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
+func (x *UpdateNamespaceReplicationFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,60 +4947,46 @@ func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *PurgeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
+// Deprecated: Use UpdateNamespaceReplicationFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceReplicationFilterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
+func (x *UpdateNamespaceReplicationFilterRequest) GetNamespace() string {
 	if x != nil {
-		return x.SourceCluster
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+func (x *UpdateNamespaceReplicationFilterRequest) GetReplicationFilter() *v12.ReplicationFilter {
 	if x != nil {
-		return x.InclusiveEndMessageId
+		return x.ReplicationFilter
 	}
-	return 0
+	return nil
 }
 
-type PurgeDLQMessagesResponse struct {
+type UpdateNamespaceReplicationFilterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQMessagesResponse) Reset() {
-	*x = PurgeDLQMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
+func (x *UpdateNamespaceReplicationFilterResponse) Reset() {
+	*x = UpdateNamespaceReplicationFilterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesResponse) String() string {
+func (x *UpdateNamespaceReplicationFilterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesResponse) ProtoMessage() {}
+func (*UpdateNamespaceReplicationFilterResponse) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
+func (x *UpdateNamespaceReplicationFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,38 +4997,39 @@ func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UpdateNamespaceReplicationFilterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceReplicationFilterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
 }
 
-type MergeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetDLQTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	// page_size must be positive. Up to this many tasks will be read.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// filter selects the returned tasks. When set, a page may contain fewer than page_size tasks even if there are more
+	// results, so callers must rely on next_page_token only.
+	Filter        *v112.HistoryDLQTaskFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeDLQMessagesRequest) Reset() {
-	*x = MergeDLQMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
+func (x *GetDLQTasksRequest) Reset() {
+	*x = GetDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesRequest) String() string {
+func (x *GetDLQTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesRequest) ProtoMessage() {}
+func (*GetDLQTasksRequest) ProtoMessage() {}
 
-func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
+func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3066,75 +5040,68 @@ func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *MergeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *MergeDLQMessagesRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
+// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
+func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
 	if x != nil {
-		return x.SourceCluster
+		return x.DlqKey
 	}
-	return ""
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+func (x *GetDLQTasksRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.InclusiveEndMessageId
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
+func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.NextPageToken
 	}
-	return 0
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+func (x *GetDLQTasksRequest) GetFilter() *v112.HistoryDLQTaskFilter {
 	if x != nil {
-		return x.NextPageToken
+		return x.Filter
 	}
 	return nil
 }
 
-type MergeDLQMessagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetDLQTasksResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
+	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
+	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
+	// there are more results.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// last_read_task_metadata is the metadata of the last task read for this page, including the tasks which didn't
+	// match the filter of the request. It is unset if no task was read.
+	LastReadTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,3,opt,name=last_read_task_metadata,json=lastReadTaskMetadata,proto3" json:"last_read_task_metadata,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *MergeDLQMessagesResponse) Reset() {
-	*x = MergeDLQMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+func (x *GetDLQTasksResponse) Reset() {
+	*x = GetDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesResponse) String() string {
+func (x *GetDLQTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesResponse) ProtoMessage() {}
+func (*GetDLQTasksResponse) ProtoMessage() {}
 
-func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3145,44 +5112,59 @@ func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+	if x != nil {
+		return x.DlqTasks
+	}
+	return nil
+}
+
+func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return nil
 }
 
-type RefreshWorkflowTasksRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution   *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	Archetype   string                 `protobuf:"bytes,4,opt,name=archetype,proto3" json:"archetype,omitempty"`
-	// (-- api-linter: core::0141::forbidden-types=disabled --)
-	ArchetypeId   uint32 `protobuf:"varint,5,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
+func (x *GetDLQTasksResponse) GetLastReadTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.LastReadTaskMetadata
+	}
+	return nil
+}
+
+type PurgeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	// filter selects the purged tasks. The other tasks up to inclusive_max_task_metadata are left in the DLQ.
+	Filter *v112.HistoryDLQTaskFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// dry_run reports the tasks which would be purged, through DescribeDLQJob, without purging them.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshWorkflowTasksRequest) Reset() {
-	*x = RefreshWorkflowTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
+func (x *PurgeDLQTasksRequest) Reset() {
+	*x = PurgeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshWorkflowTasksRequest) String() string {
+func (x *PurgeDLQTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
+func (*PurgeDLQTasksRequest) ProtoMessage() {}
 
-func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
+func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3193,60 +5175,62 @@ func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
+func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
 	if x != nil {
-		return x.NamespaceId
+		return x.DlqKey
 	}
-	return ""
+	return nil
 }
 
-func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
 	if x != nil {
-		return x.Execution
+		return x.InclusiveMaxTaskMetadata
 	}
 	return nil
 }
 
-func (x *RefreshWorkflowTasksRequest) GetArchetype() string {
+func (x *PurgeDLQTasksRequest) GetFilter() *v112.HistoryDLQTaskFilter {
 	if x != nil {
-		return x.Archetype
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *RefreshWorkflowTasksRequest) GetArchetypeId() uint32 {
+func (x *PurgeDLQTasksRequest) GetDryRun() bool {
 	if x != nil {
-		return x.ArchetypeId
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type RefreshWorkflowTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type PurgeDLQTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_token is a token that can be used to query the status of the purge operation.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshWorkflowTasksResponse) Reset() {
-	*x = RefreshWorkflowTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
+func (x *PurgeDLQTasksResponse) Reset() {
+	*x = PurgeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshWorkflowTasksResponse) String() string {
+func (x *PurgeDLQTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
+func (*PurgeDLQTasksResponse) ProtoMessage() {}
 
-func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
+func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3257,40 +5241,109 @@ func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
 }
 
-type ResendReplicationTasksRequest struct {
+func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
+type DLQJobToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
-	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
-	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksRequest) Reset() {
-	*x = ResendReplicationTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
+func (x *DLQJobToken) Reset() {
+	*x = DLQJobToken{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQJobToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQJobToken) ProtoMessage() {}
+
+func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
+func (*DLQJobToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DLQJobToken) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DLQJobToken) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type MergeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
+	// - If this is negative, an error will be returned.
+	// - If this is 0, the default will be used.
+	// - If this is greater than the maximum allowed batch size, an error will be returned.
+	// - Otherwise, the specified batch size will be used.
+	BatchSize int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// filter selects the merged tasks. The other tasks up to inclusive_max_task_metadata are left in the DLQ, unless
+	// purge_unmatched is set.
+	Filter *v112.HistoryDLQTaskFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// skip_closed_workflows purges the selected tasks of closed or deleted workflows instead of merging them.
+	SkipClosedWorkflows bool `protobuf:"varint,5,opt,name=skip_closed_workflows,json=skipClosedWorkflows,proto3" json:"skip_closed_workflows,omitempty"`
+	// purge_unmatched purges the tasks which don't match the filter instead of keeping them in the DLQ.
+	PurgeUnmatched bool `protobuf:"varint,6,opt,name=purge_unmatched,json=purgeUnmatched,proto3" json:"purge_unmatched,omitempty"`
+	// dry_run reports the tasks which would be merged or purged, through DescribeDLQJob, without changing the DLQ.
+	DryRun        bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksRequest) Reset() {
+	*x = MergeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksRequest) String() string {
+func (x *MergeDLQTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksRequest) ProtoMessage() {}
+func (*MergeDLQTasksRequest) ProtoMessage() {}
 
-func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
+func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3301,88 +5354,82 @@ func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
+// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
+func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
 	if x != nil {
-		return x.WorkflowId
+		return x.DlqKey
 	}
-	return ""
+	return nil
 }
 
-func (x *ResendReplicationTasksRequest) GetRunId() string {
+func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
 	if x != nil {
-		return x.RunId
+		return x.InclusiveMaxTaskMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
+func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.RemoteCluster
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
+func (x *MergeDLQTasksRequest) GetFilter() *v112.HistoryDLQTaskFilter {
 	if x != nil {
-		return x.StartEventId
+		return x.Filter
 	}
-	return 0
+	return nil
 }
 
-func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
+func (x *MergeDLQTasksRequest) GetSkipClosedWorkflows() bool {
 	if x != nil {
-		return x.StartVersion
+		return x.SkipClosedWorkflows
 	}
-	return 0
+	return false
 }
 
-func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
+func (x *MergeDLQTasksRequest) GetPurgeUnmatched() bool {
 	if x != nil {
-		return x.EndEventId
+		return x.PurgeUnmatched
 	}
-	return 0
+	return false
 }
 
-func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
+func (x *MergeDLQTasksRequest) GetDryRun() bool {
 	if x != nil {
-		return x.EndVersion
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type ResendReplicationTasksResponse struct {
+type MergeDLQTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksResponse) Reset() {
-	*x = ResendReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+func (x *MergeDLQTasksResponse) Reset() {
+	*x = MergeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksResponse) String() string {
+func (x *MergeDLQTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksResponse) ProtoMessage() {}
+func (*MergeDLQTasksResponse) ProtoMessage() {}
 
-func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3393,41 +5440,41 @@ func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
 }
 
-type GetTaskQueueTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
-	MinPass       int64                  `protobuf:"varint,9,opt,name=min_pass,json=minPass,proto3" json:"min_pass,omitempty"`
-	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
-	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
-	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueTasksRequest) Reset() {
-	*x = GetTaskQueueTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
+func (x *DescribeDLQJobRequest) Reset() {
+	*x = DescribeDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueTasksRequest) String() string {
+func (x *DescribeDLQJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueTasksRequest) ProtoMessage() {}
+func (*DescribeDLQJobRequest) ProtoMessage() {}
 
-func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
+func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3438,152 +5485,199 @@ func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetTaskQueueTasksRequest) GetNamespace() string {
+func (x *DescribeDLQJobRequest) GetJobToken() []byte {
 	if x != nil {
-		return x.Namespace
+		return x.JobToken
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
-	if x != nil {
-		return x.TaskQueue
-	}
-	return ""
+type DescribeDLQJobResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
+	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
+	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
+	// last_processed_message_id is the ID of the last message that has been processed.
+	// For PurgeDLQTasks job, it the ID of the last message that was purged.
+	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
+	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
+	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
+	// dry_run is true if the job only reports the outcome of the tasks without changing the DLQ.
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// messages_matched is the number of messages which matched the filter of the job so far.
+	MessagesMatched int64 `protobuf:"varint,10,opt,name=messages_matched,json=messagesMatched,proto3" json:"messages_matched,omitempty"`
+	// messages_merged is the number of messages re-enqueued so far.
+	MessagesMerged int64 `protobuf:"varint,11,opt,name=messages_merged,json=messagesMerged,proto3" json:"messages_merged,omitempty"`
+	// messages_purged is the number of messages deleted without being re-enqueued so far.
+	MessagesPurged int64 `protobuf:"varint,12,opt,name=messages_purged,json=messagesPurged,proto3" json:"messages_purged,omitempty"`
+	// task_outcomes are the outcomes of the most recent messages which matched the filter of the job. They are only
+	// reported for jobs with a filter, skip_closed_workflows or dry_run.
+	TaskOutcomes  []*DLQTaskOutcome `protobuf:"bytes,13,rep,name=task_outcomes,json=taskOutcomes,proto3" json:"task_outcomes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+func (x *DescribeDLQJobResponse) Reset() {
+	*x = DescribeDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobResponse) ProtoMessage() {}
+
+func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
 	if x != nil {
-		return x.TaskQueueType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return v16.TaskQueueType(0)
+	return mi.MessageOf(x)
 }
 
-func (x *GetTaskQueueTasksRequest) GetMinPass() int64 {
+// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
 	if x != nil {
-		return x.MinPass
+		return x.DlqKey
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
+func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
 	if x != nil {
-		return x.MinTaskId
+		return x.OperationType
 	}
-	return 0
+	return v14.DLQOperationType(0)
 }
 
-func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
+func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
 	if x != nil {
-		return x.MaxTaskId
+		return x.OperationState
 	}
-	return 0
+	return v14.DLQOperationState(0)
 }
 
-func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
+func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BatchSize
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
+func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextPageToken
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
+func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
 	if x != nil {
-		return x.Subqueue
+		return x.MaxMessageId
 	}
 	return 0
 }
 
-type GetTaskQueueTasksResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
 }
 
-func (x *GetTaskQueueTasksResponse) Reset() {
-	*x = GetTaskQueueTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+	if x != nil {
+		return x.MessagesProcessed
+	}
+	return 0
 }
 
-func (x *GetTaskQueueTasksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DescribeDLQJobResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (*GetTaskQueueTasksResponse) ProtoMessage() {}
-
-func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+func (x *DescribeDLQJobResponse) GetMessagesMatched() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MessagesMatched
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
+func (x *DescribeDLQJobResponse) GetMessagesMerged() int64 {
+	if x != nil {
+		return x.MessagesMerged
+	}
+	return 0
 }
 
-func (x *GetTaskQueueTasksResponse) GetTasks() []*v12.AllocatedTaskInfo {
+func (x *DescribeDLQJobResponse) GetMessagesPurged() int64 {
 	if x != nil {
-		return x.Tasks
+		return x.MessagesPurged
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+func (x *DescribeDLQJobResponse) GetTaskOutcomes() []*DLQTaskOutcome {
 	if x != nil {
-		return x.NextPageToken
+		return x.TaskOutcomes
 	}
 	return nil
 }
 
-type DeleteWorkflowExecutionRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	Archetype string                 `protobuf:"bytes,3,opt,name=archetype,proto3" json:"archetype,omitempty"`
-	// (-- api-linter: core::0141::forbidden-types=disabled --)
-	ArchetypeId   uint32 `protobuf:"varint,4,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
+type DLQTaskOutcome struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MessageId   int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ShardId     int32                  `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	NamespaceId string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string                 `protobuf:"bytes,4,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId       string                 `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskType    v14.TaskType           `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	Outcome     v14.DLQTaskOutcome     `protobuf:"varint,7,opt,name=outcome,proto3,enum=temporal.server.api.enums.v1.DLQTaskOutcome" json:"outcome,omitempty"`
+	// reason explains the outcome when the task was not processed as requested, e.g. because its workflow is closed.
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionRequest) Reset() {
-	*x = DeleteWorkflowExecutionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
+func (x *DLQTaskOutcome) Reset() {
+	*x = DLQTaskOutcome{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionRequest) String() string {
+func (x *DLQTaskOutcome) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DLQTaskOutcome) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
+func (x *DLQTaskOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3594,61 +5688,92 @@ func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DLQTaskOutcome.ProtoReflect.Descriptor instead.
+func (*DLQTaskOutcome) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+func (x *DLQTaskOutcome) GetMessageId() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *DLQTaskOutcome) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *DLQTaskOutcome) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
 	}
 	return ""
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (x *DLQTaskOutcome) GetWorkflowId() string {
 	if x != nil {
-		return x.Execution
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetArchetype() string {
+func (x *DLQTaskOutcome) GetRunId() string {
 	if x != nil {
-		return x.Archetype
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetArchetypeId() uint32 {
+func (x *DLQTaskOutcome) GetTaskType() v14.TaskType {
 	if x != nil {
-		return x.ArchetypeId
+		return x.TaskType
 	}
-	return 0
+	return v14.TaskType(0)
 }
 
-type DeleteWorkflowExecutionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+func (x *DLQTaskOutcome) GetOutcome() v14.DLQTaskOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return v14.DLQTaskOutcome(0)
+}
+
+func (x *DLQTaskOutcome) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
+	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	// The reason for cancellation.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionResponse) Reset() {
-	*x = DeleteWorkflowExecutionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
+func (x *CancelDLQJobRequest) Reset() {
+	*x = CancelDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionResponse) String() string {
+func (x *CancelDLQJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*CancelDLQJobRequest) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
+func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3659,43 +5784,49 @@ func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+func (x *CancelDLQJobRequest) GetJobToken() []byte {
 	if x != nil {
-		return x.Warnings
+		return x.JobToken
 	}
 	return nil
 }
 
-type StreamWorkflowReplicationMessagesRequest struct {
+func (x *CancelDLQJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDLQJobResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
-	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+	// This is true if the workflow was successfully terminated by this request and false if
+	// the workflow was already completed or terminated.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
-	*x = StreamWorkflowReplicationMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
+func (x *CancelDLQJobResponse) Reset() {
+	*x = CancelDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+func (x *CancelDLQJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+func (*CancelDLQJobResponse) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
+func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3706,63 +5837,44 @@ func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
+// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+func (x *CancelDLQJobResponse) GetCanceled() bool {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
-			return x.SyncReplicationState
-		}
+		return x.Canceled
 	}
-	return nil
-}
-
-type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
-	isStreamWorkflowReplicationMessagesRequest_Attributes()
-}
-
-type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
-	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
-}
-
-func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+	return false
 }
 
-type StreamWorkflowReplicationMessagesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesResponse_Messages
-	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
+// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
+// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
+type AddTasksRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
-	*x = StreamWorkflowReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *AddTasksRequest) Reset() {
+	*x = AddTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+func (x *AddTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+func (*AddTasksRequest) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3773,64 +5885,85 @@ func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+func (x *AddTasksRequest) GetShardId() int32 {
 	if x != nil {
-		return x.Attributes
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
-			return x.Messages
-		}
+		return x.Tasks
 	}
 	return nil
 }
 
-type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
-	isStreamWorkflowReplicationMessagesResponse_Attributes()
+type AddTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type StreamWorkflowReplicationMessagesResponse_Messages struct {
-	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+func (x *AddTasksResponse) Reset() {
+	*x = AddTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+func (x *AddTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GetNamespaceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*GetNamespaceRequest_Namespace
-	//	*GetNamespaceRequest_Id
-	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+func (*AddTasksResponse) ProtoMessage() {}
+
+func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
+func (*AddTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
+}
+
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceRequest) Reset() {
-	*x = GetNamespaceRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceRequest) String() string {
+func (x *ListQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceRequest) ProtoMessage() {}
+func (*ListQueuesRequest) ProtoMessage() {}
 
-func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3841,80 +5974,55 @@ func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
+func (x *ListQueuesRequest) GetQueueType() int32 {
 	if x != nil {
-		return x.Attributes
+		return x.QueueType
 	}
-	return nil
+	return 0
 }
 
-func (x *GetNamespaceRequest) GetNamespace() string {
+func (x *ListQueuesRequest) GetPageSize() int32 {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
-			return x.Namespace
-		}
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *GetNamespaceRequest) GetId() string {
+func (x *ListQueuesRequest) GetNextPageToken() []byte {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
-			return x.Id
-		}
+		return x.NextPageToken
 	}
-	return ""
-}
-
-type isGetNamespaceRequest_Attributes interface {
-	isGetNamespaceRequest_Attributes()
-}
-
-type GetNamespaceRequest_Namespace struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
-}
-
-type GetNamespaceRequest_Id struct {
-	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+	return nil
 }
 
-func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
-
-func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
-
-type GetNamespaceResponse struct {
-	state             protoimpl.MessageState           `protogen:"open.v1"`
-	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
-	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
-	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceResponse) Reset() {
-	*x = GetNamespaceResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) String() string {
+func (x *ListQueuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceResponse) ProtoMessage() {}
+func (*ListQueuesResponse) ProtoMessage() {}
 
-func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3925,85 +6033,85 @@ func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
 	if x != nil {
-		return x.Info
+		return x.Queues
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+func (x *ListQueuesResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.Config
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
-	if x != nil {
-		return x.ReplicationConfig
-	}
-	return nil
+type DeepHealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceResponse) GetConfigVersion() int64 {
-	if x != nil {
-		return x.ConfigVersion
-	}
-	return 0
+func (x *DeepHealthCheckRequest) Reset() {
+	*x = DeepHealthCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
-	if x != nil {
-		return x.FailoverVersion
-	}
-	return 0
+func (x *DeepHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+func (*DeepHealthCheckRequest) ProtoMessage() {}
+
+func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
 	if x != nil {
-		return x.FailoverHistory
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
-	if x != nil {
-		return x.IsGlobalNamespace
-	}
-	return false
+// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
 }
 
-type GetDLQTasksRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	// page_size must be positive. Up to this many tasks will be returned.
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type DeepHealthCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
+	// Per-service diagnostic details including per-host breakdown.
+	Services      []*v113.ServiceHealthDetail `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksRequest) Reset() {
-	*x = GetDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *DeepHealthCheckResponse) Reset() {
+	*x = DeepHealthCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksRequest) String() string {
+func (x *DeepHealthCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksRequest) ProtoMessage() {}
+func (*DeepHealthCheckResponse) ProtoMessage() {}
 
-func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4014,58 +6122,53 @@ func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
+// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetDLQTasksRequest) GetPageSize() int32 {
+func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
 	if x != nil {
-		return x.PageSize
+		return x.State
 	}
-	return 0
+	return v14.HealthState(0)
 }
 
-func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
+func (x *DeepHealthCheckResponse) GetServices() []*v113.ServiceHealthDetail {
 	if x != nil {
-		return x.NextPageToken
+		return x.Services
 	}
 	return nil
 }
 
-type GetDLQTasksResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
-	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
-	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
-	// there are more results.
-	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type SyncWorkflowStateRequest struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
+	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	// (-- api-linter: core::0141::forbidden-types=disabled --)
+	ArchetypeId   uint32 `protobuf:"varint,6,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksResponse) Reset() {
-	*x = GetDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *SyncWorkflowStateRequest) Reset() {
+	*x = SyncWorkflowStateRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksResponse) String() string {
+func (x *SyncWorkflowStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksResponse) ProtoMessage() {}
+func (*SyncWorkflowStateRequest) ProtoMessage() {}
 
-func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4076,100 +6179,75 @@ func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.DlqTasks
+		return x.NamespaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.NextPageToken
+		return x.Execution
 	}
 	return nil
 }
 
-type PurgeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
-}
-
-func (x *PurgeDLQTasksRequest) Reset() {
-	*x = PurgeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PurgeDLQTasksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PurgeDLQTasksRequest) ProtoMessage() {}
-
-func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VersionedTransition
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+	if x != nil {
+		return x.VersionHistories
+	}
+	return nil
 }
 
-func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
 	if x != nil {
-		return x.DlqKey
+		return x.TargetClusterId
 	}
-	return nil
+	return 0
 }
 
-func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *SyncWorkflowStateRequest) GetArchetypeId() uint32 {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.ArchetypeId
 	}
-	return nil
+	return 0
 }
 
-type PurgeDLQTasksResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// job_token is a token that can be used to query the status of the purge operation.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SyncWorkflowStateResponse struct {
+	state                       protoimpl.MessageState           `protogen:"open.v1"`
+	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksResponse) Reset() {
-	*x = PurgeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *SyncWorkflowStateResponse) Reset() {
+	*x = SyncWorkflowStateResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksResponse) String() string {
+func (x *SyncWorkflowStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksResponse) ProtoMessage() {}
+func (*SyncWorkflowStateResponse) ProtoMessage() {}
 
-func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4180,42 +6258,45 @@ func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
 	if x != nil {
-		return x.JobToken
+		return x.VersionedTransitionArtifact
 	}
 	return nil
 }
 
-// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
-type DLQJobToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+type GenerateLastHistoryReplicationTasksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
+	Archetype      string                 `protobuf:"bytes,4,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	// (-- api-linter: core::0141::forbidden-types=disabled --)
+	ArchetypeId   uint32 `protobuf:"varint,5,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DLQJobToken) Reset() {
-	*x = DLQJobToken{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
+	*x = GenerateLastHistoryReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DLQJobToken) String() string {
+func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DLQJobToken) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 
-func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4226,112 +6307,69 @@ func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
-func (*DLQJobToken) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *DLQJobToken) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
+// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DLQJobToken) GetRunId() string {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.RunId
+		return x.Namespace
 	}
 	return ""
 }
 
-type MergeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
-	// - If this is negative, an error will be returned.
-	// - If this is 0, the default will be used.
-	// - If this is greater than the maximum allowed batch size, an error will be returned.
-	// - Otherwise, the specified batch size will be used.
-	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MergeDLQTasksRequest) Reset() {
-	*x = MergeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MergeDLQTasksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MergeDLQTasksRequest) ProtoMessage() {}
-
-func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Execution
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
+	return nil
 }
 
-func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
 	if x != nil {
-		return x.DlqKey
+		return x.TargetClusters
 	}
 	return nil
 }
 
-func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetArchetype() string {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.Archetype
 	}
-	return nil
+	return ""
 }
 
-func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetArchetypeId() uint32 {
 	if x != nil {
-		return x.BatchSize
+		return x.ArchetypeId
 	}
 	return 0
 }
 
-type MergeDLQTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GenerateLastHistoryReplicationTasksResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksResponse) Reset() {
-	*x = MergeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
+	*x = GenerateLastHistoryReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksResponse) String() string {
+func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksResponse) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 
-func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4342,41 +6380,50 @@ func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
 	if x != nil {
-		return x.JobToken
+		return x.StateTransitionCount
 	}
-	return nil
+	return 0
 }
 
-type DescribeDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
+	if x != nil {
+		return x.HistoryLength
+	}
+	return 0
+}
+
+type DescribeTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v114.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Absent means unversioned queue. Ignored for sticky partitions.
+	BuildIds      *v115.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobRequest) Reset() {
-	*x = DescribeDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *DescribeTaskQueuePartitionRequest) Reset() {
+	*x = DescribeTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobRequest) String() string {
+func (x *DescribeTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobRequest) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4387,52 +6434,56 @@ func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
 	if x != nil {
-		return x.JobToken
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v114.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-type DescribeDLQJobResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
-	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
-	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
-	// last_processed_message_id is the ID of the last message that has been processed.
-	// For PurgeDLQTasks job, it the ID of the last message that was purged.
-	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
-	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
-	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v115.TaskQueueVersionSelection {
+	if x != nil {
+		return x.BuildIds
+	}
+	return nil
+}
+
+type DescribeTaskQueuePartitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
+	VersionsInfoInternal map[string]*v114.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ScaleInfo            *v114.PartitionScaleInfo                      `protobuf:"bytes,2,opt,name=scale_info,json=scaleInfo,proto3" json:"scale_info,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobResponse) Reset() {
-	*x = DescribeDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *DescribeTaskQueuePartitionResponse) Reset() {
+	*x = DescribeTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobResponse) String() string {
+func (x *DescribeTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobResponse) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4443,92 +6494,48 @@ func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return v14.DLQOperationType(0)
-}
-
-func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
-	if x != nil {
-		return x.OperationState
-	}
-	return v14.DLQOperationState(0)
+// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
+func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v114.TaskQueueVersionInfoInternal {
 	if x != nil {
-		return x.StartTime
+		return x.VersionsInfoInternal
 	}
 	return nil
 }
 
-func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
+func (x *DescribeTaskQueuePartitionResponse) GetScaleInfo() *v114.PartitionScaleInfo {
 	if x != nil {
-		return x.EndTime
+		return x.ScaleInfo
 	}
 	return nil
 }
 
-func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
-	if x != nil {
-		return x.MaxMessageId
-	}
-	return 0
-}
-
-func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
-	if x != nil {
-		return x.LastProcessedMessageId
-	}
-	return 0
-}
-
-func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
-	if x != nil {
-		return x.MessagesProcessed
-	}
-	return 0
-}
-
-type CancelDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
-	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	// The reason for cancellation.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ForceUnloadTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v114.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobRequest) Reset() {
-	*x = CancelDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
+	*x = ForceUnloadTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobRequest) String() string {
+func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobRequest) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4539,49 +6546,47 @@ func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *CancelDLQJobRequest) GetJobToken() []byte {
+func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
 	if x != nil {
-		return x.JobToken
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *CancelDLQJobRequest) GetReason() string {
+func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v114.TaskQueuePartition {
 	if x != nil {
-		return x.Reason
+		return x.TaskQueuePartition
 	}
-	return ""
+	return nil
 }
 
-type CancelDLQJobResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is true if the workflow was successfully terminated by this request and false if
-	// the workflow was already completed or terminated.
-	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+type ForceUnloadTaskQueuePartitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobResponse) Reset() {
-	*x = CancelDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
+	*x = ForceUnloadTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobResponse) String() string {
+func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobResponse) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4592,44 +6597,44 @@ func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *CancelDLQJobResponse) GetCanceled() bool {
+func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
 	if x != nil {
-		return x.Canceled
+		return x.WasLoaded
 	}
 	return false
 }
 
-// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
-// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
-// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
-type AddTasksRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+type GetTaskQueueUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// If non-zero, fetch the user data loaded by this partition instead of the root.
+	PartitionId   int32 `protobuf:"varint,4,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksRequest) Reset() {
-	*x = AddTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *GetTaskQueueUserDataRequest) Reset() {
+	*x = GetTaskQueueUserDataRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest) String() string {
+func (x *GetTaskQueueUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest) ProtoMessage() {}
+func (*GetTaskQueueUserDataRequest) ProtoMessage() {}
 
-func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *GetTaskQueueUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4640,46 +6645,62 @@ func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetTaskQueueUserDataRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *AddTasksRequest) GetShardId() int32 {
+func (x *GetTaskQueueUserDataRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+func (x *GetTaskQueueUserDataRequest) GetTaskQueue() string {
 	if x != nil {
-		return x.Tasks
+		return x.TaskQueue
 	}
-	return nil
+	return ""
 }
 
-type AddTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetTaskQueueUserDataRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *GetTaskQueueUserDataRequest) GetPartitionId() int32 {
+	if x != nil {
+		return x.PartitionId
+	}
+	return 0
+}
+
+type GetTaskQueueUserDataResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserData      *v12.TaskQueueTypeUserData `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+	Version       int64                      `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksResponse) Reset() {
-	*x = AddTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *GetTaskQueueUserDataResponse) Reset() {
+	*x = GetTaskQueueUserDataResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksResponse) String() string {
+func (x *GetTaskQueueUserDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksResponse) ProtoMessage() {}
+func (*GetTaskQueueUserDataResponse) ProtoMessage() {}
 
-func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *GetTaskQueueUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4690,35 +6711,68 @@ func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
-func (*AddTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use GetTaskQueueUserDataResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
 }
 
-type ListQueuesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *GetTaskQueueUserDataResponse) GetUserData() *v12.TaskQueueTypeUserData {
+	if x != nil {
+		return x.UserData
+	}
+	return nil
+}
+
+func (x *GetTaskQueueUserDataResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// StartAdminBatchOperationRequest starts an admin batch operation.
+// WARNING: Batch Operations are exposed to all users of the namespace. Admin Batch Operations should be exercised with caution.
+type StartAdminBatchOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace that contains the batch operation.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query defines the group of workflows to apply the batch operation.
+	// This field and `executions` are mutually exclusive.
+	VisibilityQuery string `protobuf:"bytes,2,opt,name=visibility_query,json=visibilityQuery,proto3" json:"visibility_query,omitempty"`
+	// A unique job identifier for this batch operation.
+	JobId string `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// Reason for the operation.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// List of workflow executions to apply the batch operation to.
+	// This field and `visibility_query` are mutually exclusive.
+	Executions []*v1.WorkflowExecution `protobuf:"bytes,5,rep,name=executions,proto3" json:"executions,omitempty"`
+	// The identity of the worker/client.
+	Identity string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	// The admin batch operation to perform.
+	//
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*StartAdminBatchOperationRequest_RefreshTasksOperation
+	Operation     isStartAdminBatchOperationRequest_Operation `protobuf_oneof:"operation"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesRequest) Reset() {
-	*x = ListQueuesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *StartAdminBatchOperationRequest) Reset() {
+	*x = StartAdminBatchOperationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesRequest) String() string {
+func (x *StartAdminBatchOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesRequest) ProtoMessage() {}
+func (*StartAdminBatchOperationRequest) ProtoMessage() {}
 
-func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *StartAdminBatchOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4729,105 +6783,101 @@ func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use StartAdminBatchOperationRequest.ProtoReflect.Descriptor instead.
+func (*StartAdminBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ListQueuesRequest) GetQueueType() int32 {
+func (x *StartAdminBatchOperationRequest) GetNamespace() string {
 	if x != nil {
-		return x.QueueType
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *ListQueuesRequest) GetPageSize() int32 {
+func (x *StartAdminBatchOperationRequest) GetVisibilityQuery() string {
 	if x != nil {
-		return x.PageSize
+		return x.VisibilityQuery
 	}
-	return 0
+	return ""
 }
 
-func (x *ListQueuesRequest) GetNextPageToken() []byte {
+func (x *StartAdminBatchOperationRequest) GetJobId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.JobId
 	}
-	return nil
-}
-
-type ListQueuesResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
-	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListQueuesResponse) Reset() {
-	*x = ListQueuesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ListQueuesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StartAdminBatchOperationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (*ListQueuesResponse) ProtoMessage() {}
-
-func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *StartAdminBatchOperationRequest) GetExecutions() []*v1.WorkflowExecution {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Executions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+func (x *StartAdminBatchOperationRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
 }
 
-func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
+func (x *StartAdminBatchOperationRequest) GetOperation() isStartAdminBatchOperationRequest_Operation {
 	if x != nil {
-		return x.Queues
+		return x.Operation
 	}
 	return nil
 }
 
-func (x *ListQueuesResponse) GetNextPageToken() []byte {
+func (x *StartAdminBatchOperationRequest) GetRefreshTasksOperation() *BatchOperationRefreshTasks {
 	if x != nil {
-		return x.NextPageToken
+		if x, ok := x.Operation.(*StartAdminBatchOperationRequest_RefreshTasksOperation); ok {
+			return x.RefreshTasksOperation
+		}
 	}
 	return nil
 }
 
-type DeepHealthCheckRequest struct {
+type isStartAdminBatchOperationRequest_Operation interface {
+	isStartAdminBatchOperationRequest_Operation()
+}
+
+type StartAdminBatchOperationRequest_RefreshTasksOperation struct {
+	RefreshTasksOperation *BatchOperationRefreshTasks `protobuf:"bytes,10,opt,name=refresh_tasks_operation,json=refreshTasksOperation,proto3,oneof"`
+}
+
+func (*StartAdminBatchOperationRequest_RefreshTasksOperation) isStartAdminBatchOperationRequest_Operation() {
+}
+
+type StartAdminBatchOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckRequest) Reset() {
-	*x = DeepHealthCheckRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *StartAdminBatchOperationResponse) Reset() {
+	*x = StartAdminBatchOperationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckRequest) String() string {
+func (x *StartAdminBatchOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckRequest) ProtoMessage() {}
+func (*StartAdminBatchOperationResponse) ProtoMessage() {}
 
-func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *StartAdminBatchOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4838,35 +6888,34 @@ func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use StartAdminBatchOperationResponse.ProtoReflect.Descriptor instead.
+func (*StartAdminBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
 }
 
-type DeepHealthCheckResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	State v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
-	// Per-service diagnostic details including per-host breakdown.
-	Services      []*v113.ServiceHealthDetail `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+// BatchOperationRefreshTasks refreshes tasks for batch executions.
+// This regenerates all pending tasks for each execution.
+type BatchOperationRefreshTasks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckResponse) Reset() {
-	*x = DeepHealthCheckResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *BatchOperationRefreshTasks) Reset() {
+	*x = BatchOperationRefreshTasks{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckResponse) String() string {
+func (x *BatchOperationRefreshTasks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckResponse) ProtoMessage() {}
+func (*BatchOperationRefreshTasks) ProtoMessage() {}
 
-func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *BatchOperationRefreshTasks) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4877,53 +6926,42 @@ func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
-	if x != nil {
-		return x.State
-	}
-	return v14.HealthState(0)
-}
-
-func (x *DeepHealthCheckResponse) GetServices() []*v113.ServiceHealthDetail {
-	if x != nil {
-		return x.Services
-	}
-	return nil
+// Deprecated: Use BatchOperationRefreshTasks.ProtoReflect.Descriptor instead.
+func (*BatchOperationRefreshTasks) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
 }
 
-type SyncWorkflowStateRequest struct {
-	state               protoimpl.MessageState   `protogen:"open.v1"`
-	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
-	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
-	// (-- api-linter: core::0141::forbidden-types=disabled --)
-	ArchetypeId   uint32 `protobuf:"varint,6,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
+type MigrateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace name.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Schedule ID.
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Target scheduler implementation.
+	Target MigrateScheduleRequest_SchedulerTarget `protobuf:"varint,3,opt,name=target,proto3,enum=temporal.server.api.adminservice.v1.MigrateScheduleRequest_SchedulerTarget" json:"target,omitempty"`
+	// Identity of the caller.
+	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Used for request deduplication.
+	RequestId     string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateRequest) Reset() {
-	*x = SyncWorkflowStateRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *MigrateScheduleRequest) Reset() {
+	*x = MigrateScheduleRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateRequest) String() string {
+func (x *MigrateScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateRequest) ProtoMessage() {}
+func (*MigrateScheduleRequest) ProtoMessage() {}
 
-func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *MigrateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4934,75 +6972,67 @@ func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use MigrateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*MigrateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
+func (x *MigrateScheduleRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
-	if x != nil {
-		return x.Execution
-	}
-	return nil
-}
-
-func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
+func (x *MigrateScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.VersionedTransition
+		return x.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+func (x *MigrateScheduleRequest) GetTarget() MigrateScheduleRequest_SchedulerTarget {
 	if x != nil {
-		return x.VersionHistories
+		return x.Target
 	}
-	return nil
+	return MigrateScheduleRequest_SCHEDULER_TARGET_UNSPECIFIED
 }
 
-func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+func (x *MigrateScheduleRequest) GetIdentity() string {
 	if x != nil {
-		return x.TargetClusterId
+		return x.Identity
 	}
-	return 0
+	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetArchetypeId() uint32 {
+func (x *MigrateScheduleRequest) GetRequestId() string {
 	if x != nil {
-		return x.ArchetypeId
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type SyncWorkflowStateResponse struct {
-	state                       protoimpl.MessageState           `protogen:"open.v1"`
-	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type MigrateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateResponse) Reset() {
-	*x = SyncWorkflowStateResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *MigrateScheduleResponse) Reset() {
+	*x = MigrateScheduleResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateResponse) String() string {
+func (x *MigrateScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateResponse) ProtoMessage() {}
+func (*MigrateScheduleResponse) ProtoMessage() {}
 
-func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *MigrateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5013,45 +7043,46 @@ func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
-	if x != nil {
-		return x.VersionedTransitionArtifact
-	}
-	return nil
-}
-
-type GenerateLastHistoryReplicationTasksRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
-	Archetype      string                 `protobuf:"bytes,4,opt,name=archetype,proto3" json:"archetype,omitempty"`
-	// (-- api-linter: core::0141::forbidden-types=disabled --)
-	ArchetypeId   uint32 `protobuf:"varint,5,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use MigrateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*MigrateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
-	*x = GenerateLastHistoryReplicationTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+type CloneWorkflowExecutionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace of the execution to clone.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Execution to clone. The current run is cloned if run_id is empty.
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Namespace to create the clone in. Defaults to namespace.
+	TargetNamespace string `protobuf:"bytes,3,opt,name=target_namespace,json=targetNamespace,proto3" json:"target_namespace,omitempty"`
+	// Workflow ID of the clone. Must not have a current execution in the target namespace.
+	TargetWorkflowId string `protobuf:"bytes,4,opt,name=target_workflow_id,json=targetWorkflowId,proto3" json:"target_workflow_id,omitempty"`
+	// Last event to copy, inclusive. The whole history is copied if not set. Must be the last event of its event
+	// batch, and no child workflow start, external signal, external cancellation request or Nexus operation may be
+	// pending at it.
+	LastEventId int64 `protobuf:"varint,5,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	// Task queue for the clone's workflow and activity tasks. Defaults to the task queue of the original execution.
+	TargetTaskQueue string `protobuf:"bytes,6,opt,name=target_task_queue,json=targetTaskQueue,proto3" json:"target_task_queue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloneWorkflowExecutionRequest) Reset() {
+	*x = CloneWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
+func (x *CloneWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
+func (*CloneWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *CloneWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5062,69 +7093,78 @@ func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use CloneWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CloneWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
+func (x *CloneWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *CloneWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
 		return x.Execution
 	}
 	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
+func (x *CloneWorkflowExecutionRequest) GetTargetNamespace() string {
 	if x != nil {
-		return x.TargetClusters
+		return x.TargetNamespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetArchetype() string {
+func (x *CloneWorkflowExecutionRequest) GetTargetWorkflowId() string {
 	if x != nil {
-		return x.Archetype
+		return x.TargetWorkflowId
 	}
 	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetArchetypeId() uint32 {
+func (x *CloneWorkflowExecutionRequest) GetLastEventId() int64 {
 	if x != nil {
-		return x.ArchetypeId
+		return x.LastEventId
 	}
 	return 0
 }
 
-type GenerateLastHistoryReplicationTasksResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
-	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *CloneWorkflowExecutionRequest) GetTargetTaskQueue() string {
+	if x != nil {
+		return x.TargetTaskQueue
+	}
+	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
-	*x = GenerateLastHistoryReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+type CloneWorkflowExecutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Run ID of the clone.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// ID of the last event copied into the clone.
+	LastEventId   int64 `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneWorkflowExecutionResponse) Reset() {
+	*x = CloneWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
+func (x *CloneWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
+func (*CloneWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *CloneWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5135,50 +7175,48 @@ func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use CloneWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CloneWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
+func (x *CloneWorkflowExecutionResponse) GetRunId() string {
 	if x != nil {
-		return x.StateTransitionCount
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
+func (x *CloneWorkflowExecutionResponse) GetLastEventId() int64 {
 	if x != nil {
-		return x.HistoryLength
+		return x.LastEventId
 	}
 	return 0
 }
 
-type DescribeTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v114.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	// Absent means unversioned queue. Ignored for sticky partitions.
-	BuildIds      *v115.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+type DryRunResetWorkflowExecutionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reset to preview. The request ID is not required.
+	ResetRequest  *v116.ResetWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=reset_request,json=resetRequest,proto3" json:"reset_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionRequest) Reset() {
-	*x = DescribeTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DryRunResetWorkflowExecutionRequest) Reset() {
+	*x = DryRunResetWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionRequest) String() string {
+func (x *DryRunResetWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
+func (*DryRunResetWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DryRunResetWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5189,56 +7227,40 @@ func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v114.TaskQueuePartition {
-	if x != nil {
-		return x.TaskQueuePartition
-	}
-	return nil
+// Deprecated: Use DryRunResetWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DryRunResetWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v115.TaskQueueVersionSelection {
+func (x *DryRunResetWorkflowExecutionRequest) GetResetRequest() *v116.ResetWorkflowExecutionRequest {
 	if x != nil {
-		return x.BuildIds
+		return x.ResetRequest
 	}
 	return nil
 }
 
-type DescribeTaskQueuePartitionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
-	VersionsInfoInternal map[string]*v114.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ScaleInfo            *v114.PartitionScaleInfo                      `protobuf:"bytes,2,opt,name=scale_info,json=scaleInfo,proto3" json:"scale_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type DryRunResetWorkflowExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *v11.ResetDryRunResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionResponse) Reset() {
-	*x = DescribeTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DryRunResetWorkflowExecutionResponse) Reset() {
+	*x = DryRunResetWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionResponse) String() string {
+func (x *DryRunResetWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
+func (*DryRunResetWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DryRunResetWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5249,48 +7271,44 @@ func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v114.TaskQueueVersionInfoInternal {
-	if x != nil {
-		return x.VersionsInfoInternal
-	}
-	return nil
+// Deprecated: Use DryRunResetWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DryRunResetWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DescribeTaskQueuePartitionResponse) GetScaleInfo() *v114.PartitionScaleInfo {
+func (x *DryRunResetWorkflowExecutionResponse) GetResult() *v11.ResetDryRunResult {
 	if x != nil {
-		return x.ScaleInfo
+		return x.Result
 	}
 	return nil
 }
 
-type ForceUnloadTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v114.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DescribeMutableStateAtEventRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Event to rebuild the mutable state up to. Events are applied by batch, so the whole batch containing this event
+	// is applied.
+	EventId       int64 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
-	*x = ForceUnloadTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *DescribeMutableStateAtEventRequest) Reset() {
+	*x = DescribeMutableStateAtEventRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
+func (x *DescribeMutableStateAtEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
+func (*DescribeMutableStateAtEventRequest) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *DescribeMutableStateAtEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5301,95 +7319,57 @@ func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use DescribeMutableStateAtEventRequest.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateAtEventRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+func (x *DescribeMutableStateAtEventRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v114.TaskQueuePartition {
+func (x *DescribeMutableStateAtEventRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.TaskQueuePartition
+		return x.Execution
 	}
 	return nil
 }
 
-type ForceUnloadTaskQueuePartitionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
-	*x = ForceUnloadTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
-
-func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
+func (x *DescribeMutableStateAtEventRequest) GetEventId() int64 {
 	if x != nil {
-		return x.WasLoaded
+		return x.EventId
 	}
-	return false
+	return 0
 }
 
-type GetTaskQueueUserDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
-	// If non-zero, fetch the user data loaded by this partition instead of the root.
-	PartitionId   int32 `protobuf:"varint,4,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+type DescribeMutableStateAtEventResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShardId     string                 `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HistoryAddr string                 `protobuf:"bytes,2,opt,name=history_addr,json=historyAddr,proto3" json:"history_addr,omitempty"`
+	// Mutable state rebuilt in memory from the history of the workflow.
+	MutableState  *v12.WorkflowMutableState `protobuf:"bytes,3,opt,name=mutable_state,json=mutableState,proto3" json:"mutable_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueUserDataRequest) Reset() {
-	*x = GetTaskQueueUserDataRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *DescribeMutableStateAtEventResponse) Reset() {
+	*x = DescribeMutableStateAtEventResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueUserDataRequest) String() string {
+func (x *DescribeMutableStateAtEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueUserDataRequest) ProtoMessage() {}
+func (*DescribeMutableStateAtEventResponse) ProtoMessage() {}
 
-func (x *GetTaskQueueUserDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *DescribeMutableStateAtEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5400,62 +7380,56 @@ func (x *GetTaskQueueUserDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueUserDataRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use DescribeMutableStateAtEventResponse.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateAtEventResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetTaskQueueUserDataRequest) GetNamespace() string {
+func (x *DescribeMutableStateAtEventResponse) GetShardId() string {
 	if x != nil {
-		return x.Namespace
+		return x.ShardId
 	}
 	return ""
 }
 
-func (x *GetTaskQueueUserDataRequest) GetTaskQueue() string {
+func (x *DescribeMutableStateAtEventResponse) GetHistoryAddr() string {
 	if x != nil {
-		return x.TaskQueue
+		return x.HistoryAddr
 	}
 	return ""
 }
 
-func (x *GetTaskQueueUserDataRequest) GetTaskQueueType() v16.TaskQueueType {
-	if x != nil {
-		return x.TaskQueueType
-	}
-	return v16.TaskQueueType(0)
-}
-
-func (x *GetTaskQueueUserDataRequest) GetPartitionId() int32 {
+func (x *DescribeMutableStateAtEventResponse) GetMutableState() *v12.WorkflowMutableState {
 	if x != nil {
-		return x.PartitionId
+		return x.MutableState
 	}
-	return 0
+	return nil
 }
 
-type GetTaskQueueUserDataResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	UserData      *v12.TaskQueueTypeUserData `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
-	Version       int64                      `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+type ListWorkflowConflictResolutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Run ID is optional, the current run is used if empty.
+	Execution     *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueUserDataResponse) Reset() {
-	*x = GetTaskQueueUserDataResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+func (x *ListWorkflowConflictResolutionsRequest) Reset() {
+	*x = ListWorkflowConflictResolutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueUserDataResponse) String() string {
+func (x *ListWorkflowConflictResolutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueUserDataResponse) ProtoMessage() {}
+func (*ListWorkflowConflictResolutionsRequest) ProtoMessage() {}
 
-func (x *GetTaskQueueUserDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+func (x *ListWorkflowConflictResolutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5466,68 +7440,48 @@ func (x *GetTaskQueueUserDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueUserDataResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListWorkflowConflictResolutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkflowConflictResolutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetTaskQueueUserDataResponse) GetUserData() *v12.TaskQueueTypeUserData {
+func (x *ListWorkflowConflictResolutionsRequest) GetNamespace() string {
 	if x != nil {
-		return x.UserData
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTaskQueueUserDataResponse) GetVersion() int64 {
+func (x *ListWorkflowConflictResolutionsRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.Version
+		return x.Execution
 	}
-	return 0
+	return nil
 }
 
-// StartAdminBatchOperationRequest starts an admin batch operation.
-// WARNING: Batch Operations are exposed to all users of the namespace. Admin Batch Operations should be exercised with caution.
-type StartAdminBatchOperationRequest struct {
+type ListWorkflowConflictResolutionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Namespace that contains the batch operation.
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Visibility query defines the group of workflows to apply the batch operation.
-	// This field and `executions` are mutually exclusive.
-	VisibilityQuery string `protobuf:"bytes,2,opt,name=visibility_query,json=visibilityQuery,proto3" json:"visibility_query,omitempty"`
-	// A unique job identifier for this batch operation.
-	JobId string `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	// Reason for the operation.
-	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	// List of workflow executions to apply the batch operation to.
-	// This field and `visibility_query` are mutually exclusive.
-	Executions []*v1.WorkflowExecution `protobuf:"bytes,5,rep,name=executions,proto3" json:"executions,omitempty"`
-	// The identity of the worker/client.
-	Identity string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
-	// The admin batch operation to perform.
-	//
-	// Types that are valid to be assigned to Operation:
-	//
-	//	*StartAdminBatchOperationRequest_RefreshTasksOperation
-	Operation     isStartAdminBatchOperationRequest_Operation `protobuf_oneof:"operation"`
+	// Oldest first.
+	Records       []*v12.ConflictResolutionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartAdminBatchOperationRequest) Reset() {
-	*x = StartAdminBatchOperationRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+func (x *ListWorkflowConflictResolutionsResponse) Reset() {
+	*x = ListWorkflowConflictResolutionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartAdminBatchOperationRequest) String() string {
+func (x *ListWorkflowConflictResolutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartAdminBatchOperationRequest) ProtoMessage() {}
+func (*ListWorkflowConflictResolutionsResponse) ProtoMessage() {}
 
-func (x *StartAdminBatchOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+func (x *ListWorkflowConflictResolutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5538,101 +7492,102 @@ func (x *StartAdminBatchOperationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartAdminBatchOperationRequest.ProtoReflect.Descriptor instead.
-func (*StartAdminBatchOperationRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ListWorkflowConflictResolutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkflowConflictResolutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *StartAdminBatchOperationRequest) GetNamespace() string {
+func (x *ListWorkflowConflictResolutionsResponse) GetRecords() []*v12.ConflictResolutionRecord {
 	if x != nil {
-		return x.Namespace
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-func (x *StartAdminBatchOperationRequest) GetVisibilityQuery() string {
-	if x != nil {
-		return x.VisibilityQuery
-	}
-	return ""
+type StartChainedActivityExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The first activity of the chain. Its namespace must be the namespace of the request.
+	Request *v116.StartActivityExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Activities to run, in order, after the first activity completes successfully. At least one is required.
+	FollowUps     []*v117.ActivityChainStep `protobuf:"bytes,3,rep,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartAdminBatchOperationRequest) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
+func (x *StartChainedActivityExecutionRequest) Reset() {
+	*x = StartChainedActivityExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartAdminBatchOperationRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+func (x *StartChainedActivityExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartAdminBatchOperationRequest) GetExecutions() []*v1.WorkflowExecution {
+func (*StartChainedActivityExecutionRequest) ProtoMessage() {}
+
+func (x *StartChainedActivityExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	if x != nil {
-		return x.Executions
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StartAdminBatchOperationRequest) GetIdentity() string {
+// Deprecated: Use StartChainedActivityExecutionRequest.ProtoReflect.Descriptor instead.
+func (*StartChainedActivityExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *StartChainedActivityExecutionRequest) GetNamespace() string {
 	if x != nil {
-		return x.Identity
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *StartAdminBatchOperationRequest) GetOperation() isStartAdminBatchOperationRequest_Operation {
+func (x *StartChainedActivityExecutionRequest) GetRequest() *v116.StartActivityExecutionRequest {
 	if x != nil {
-		return x.Operation
+		return x.Request
 	}
 	return nil
 }
 
-func (x *StartAdminBatchOperationRequest) GetRefreshTasksOperation() *BatchOperationRefreshTasks {
+func (x *StartChainedActivityExecutionRequest) GetFollowUps() []*v117.ActivityChainStep {
 	if x != nil {
-		if x, ok := x.Operation.(*StartAdminBatchOperationRequest_RefreshTasksOperation); ok {
-			return x.RefreshTasksOperation
-		}
+		return x.FollowUps
 	}
 	return nil
 }
 
-type isStartAdminBatchOperationRequest_Operation interface {
-	isStartAdminBatchOperationRequest_Operation()
-}
-
-type StartAdminBatchOperationRequest_RefreshTasksOperation struct {
-	RefreshTasksOperation *BatchOperationRefreshTasks `protobuf:"bytes,10,opt,name=refresh_tasks_operation,json=refreshTasksOperation,proto3,oneof"`
-}
-
-func (*StartAdminBatchOperationRequest_RefreshTasksOperation) isStartAdminBatchOperationRequest_Operation() {
-}
-
-type StartAdminBatchOperationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type StartChainedActivityExecutionResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Response      *v116.StartActivityExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartAdminBatchOperationResponse) Reset() {
-	*x = StartAdminBatchOperationResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+func (x *StartChainedActivityExecutionResponse) Reset() {
+	*x = StartChainedActivityExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartAdminBatchOperationResponse) String() string {
+func (x *StartChainedActivityExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartAdminBatchOperationResponse) ProtoMessage() {}
+func (*StartChainedActivityExecutionResponse) ProtoMessage() {}
 
-func (x *StartAdminBatchOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+func (x *StartChainedActivityExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5643,34 +7598,42 @@ func (x *StartAdminBatchOperationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartAdminBatchOperationResponse.ProtoReflect.Descriptor instead.
-func (*StartAdminBatchOperationResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use StartChainedActivityExecutionResponse.ProtoReflect.Descriptor instead.
+func (*StartChainedActivityExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{123}
 }
 
-// BatchOperationRefreshTasks refreshes tasks for batch executions.
-// This regenerates all pending tasks for each execution.
-type BatchOperationRefreshTasks struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *StartChainedActivityExecutionResponse) GetResponse() *v116.StartActivityExecutionResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type DescribeChainedActivityExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Its namespace must be the namespace of the request.
+	Request       *v116.DescribeActivityExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchOperationRefreshTasks) Reset() {
-	*x = BatchOperationRefreshTasks{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+func (x *DescribeChainedActivityExecutionRequest) Reset() {
+	*x = DescribeChainedActivityExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchOperationRefreshTasks) String() string {
+func (x *DescribeChainedActivityExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchOperationRefreshTasks) ProtoMessage() {}
+func (*DescribeChainedActivityExecutionRequest) ProtoMessage() {}
 
-func (x *BatchOperationRefreshTasks) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+func (x *DescribeChainedActivityExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5681,42 +7644,50 @@ func (x *BatchOperationRefreshTasks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchOperationRefreshTasks.ProtoReflect.Descriptor instead.
-func (*BatchOperationRefreshTasks) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use DescribeChainedActivityExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeChainedActivityExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124}
 }
 
-type MigrateScheduleRequest struct {
+func (x *DescribeChainedActivityExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeChainedActivityExecutionRequest) GetRequest() *v116.DescribeActivityExecutionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DescribeChainedActivityExecutionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Namespace name.
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Schedule ID.
-	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	// Target scheduler implementation.
-	Target MigrateScheduleRequest_SchedulerTarget `protobuf:"varint,3,opt,name=target,proto3,enum=temporal.server.api.adminservice.v1.MigrateScheduleRequest_SchedulerTarget" json:"target,omitempty"`
-	// Identity of the caller.
-	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
-	// Used for request deduplication.
-	RequestId     string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Describes the activity of the chain that is currently running, or the last one if the execution is closed.
+	Response *v116.DescribeActivityExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Set if the activity was started with follow-up activities.
+	Chain         *v117.ActivityChainState `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MigrateScheduleRequest) Reset() {
-	*x = MigrateScheduleRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+func (x *DescribeChainedActivityExecutionResponse) Reset() {
+	*x = DescribeChainedActivityExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MigrateScheduleRequest) String() string {
+func (x *DescribeChainedActivityExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MigrateScheduleRequest) ProtoMessage() {}
+func (*DescribeChainedActivityExecutionResponse) ProtoMessage() {}
 
-func (x *MigrateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+func (x *DescribeChainedActivityExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5727,67 +7698,49 @@ func (x *MigrateScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MigrateScheduleRequest.ProtoReflect.Descriptor instead.
-func (*MigrateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *MigrateScheduleRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *MigrateScheduleRequest) GetScheduleId() string {
-	if x != nil {
-		return x.ScheduleId
-	}
-	return ""
-}
-
-func (x *MigrateScheduleRequest) GetTarget() MigrateScheduleRequest_SchedulerTarget {
-	if x != nil {
-		return x.Target
-	}
-	return MigrateScheduleRequest_SCHEDULER_TARGET_UNSPECIFIED
+// Deprecated: Use DescribeChainedActivityExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeChainedActivityExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *MigrateScheduleRequest) GetIdentity() string {
+func (x *DescribeChainedActivityExecutionResponse) GetResponse() *v116.DescribeActivityExecutionResponse {
 	if x != nil {
-		return x.Identity
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-func (x *MigrateScheduleRequest) GetRequestId() string {
+func (x *DescribeChainedActivityExecutionResponse) GetChain() *v117.ActivityChainState {
 	if x != nil {
-		return x.RequestId
+		return x.Chain
 	}
-	return ""
+	return nil
 }
 
-type MigrateScheduleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeTaskSchedulerResponse_Host struct {
+	state                    protoimpl.MessageState                      `protogen:"open.v1"`
+	Address                  string                                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Schedulers               []*v11.TaskSchedulerState                   `protobuf:"bytes,2,rep,name=schedulers,proto3" json:"schedulers,omitempty"`
+	NamespaceWeightOverrides []*v11.TaskSchedulerNamespaceWeightOverride `protobuf:"bytes,3,rep,name=namespace_weight_overrides,json=namespaceWeightOverrides,proto3" json:"namespace_weight_overrides,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *MigrateScheduleResponse) Reset() {
-	*x = MigrateScheduleResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+func (x *DescribeTaskSchedulerResponse_Host) Reset() {
+	*x = DescribeTaskSchedulerResponse_Host{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MigrateScheduleResponse) String() string {
+func (x *DescribeTaskSchedulerResponse_Host) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MigrateScheduleResponse) ProtoMessage() {}
+func (*DescribeTaskSchedulerResponse_Host) ProtoMessage() {}
 
-func (x *MigrateScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+func (x *DescribeTaskSchedulerResponse_Host) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5798,9 +7751,30 @@ func (x *MigrateScheduleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MigrateScheduleResponse.ProtoReflect.Descriptor instead.
-func (*MigrateScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use DescribeTaskSchedulerResponse_Host.ProtoReflect.Descriptor instead.
+func (*DescribeTaskSchedulerResponse_Host) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *DescribeTaskSchedulerResponse_Host) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DescribeTaskSchedulerResponse_Host) GetSchedulers() []*v11.TaskSchedulerState {
+	if x != nil {
+		return x.Schedulers
+	}
+	return nil
+}
+
+func (x *DescribeTaskSchedulerResponse_Host) GetNamespaceWeightOverrides() []*v11.TaskSchedulerNamespaceWeightOverride {
+	if x != nil {
+		return x.NamespaceWeightOverrides
+	}
+	return nil
 }
 
 type AddTasksRequest_Task struct {
@@ -5813,7 +7787,7 @@ type AddTasksRequest_Task struct {
 
 func (x *AddTasksRequest_Task) Reset() {
 	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5825,7 +7799,7 @@ func (x *AddTasksRequest_Task) String() string {
 func (*AddTasksRequest_Task) ProtoMessage() {}
 
 func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5838,7 +7812,7 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
 func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93, 0}
 }
 
 func (x *AddTasksRequest_Task) GetCategoryId() int32 {
@@ -5866,7 +7840,7 @@ type ListQueuesResponse_QueueInfo struct {
 
 func (x *ListQueuesResponse_QueueInfo) Reset() {
 	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5878,7 +7852,7 @@ func (x *ListQueuesResponse_QueueInfo) String() string {
 func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
 
 func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5891,7 +7865,7 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
 func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96, 0}
 }
 
 func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
@@ -5919,7 +7893,7 @@ var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect
 
 const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a-temporal/server/api/activity/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
@@ -5947,15 +7921,47 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x19\n" +
 	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12X\n" +
-	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xde\x01\n" +
+	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xc8\x02\n" +
 	"\x1bDescribeHistoryHostResponse\x12#\n" +
 	"\rshards_number\x18\x01 \x01(\x05R\fshardsNumber\x12\x1b\n" +
 	"\tshard_ids\x18\x02 \x03(\x05R\bshardIds\x12]\n" +
 	"\x0fnamespace_cache\x18\x03 \x01(\v24.temporal.server.api.namespace.v1.NamespaceCacheInfoR\x0enamespaceCache\x12\x18\n" +
-	"\aaddress\x18\x05 \x01(\tR\aaddressJ\x04\b\x04\x10\x05\".\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12(\n" +
+	"\x10pinned_shard_ids\x18\x06 \x03(\x05R\x0epinnedShardIds\x12\x12\n" +
+	"\x04load\x18\a \x01(\x01R\x04load\x12*\n" +
+	"\x11workflow_shard_id\x18\b \x01(\x05R\x0fworkflowShardIdJ\x04\b\x04\x10\x05\".\n" +
 	"\x11CloseShardRequest\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"\x14\n" +
-	"\x12CloseShardResponse\",\n" +
+	"\x12CloseShardResponse\"U\n" +
+	"\x17MoveHistoryShardRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1f\n" +
+	"\vtarget_host\x18\x02 \x01(\tR\n" +
+	"targetHost\"\x1a\n" +
+	"\x18MoveHistoryShardResponse\"5\n" +
+	"\x18UnpinHistoryShardRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"\x1b\n" +
+	"\x19UnpinHistoryShardResponse\"A\n" +
+	"\x1cDescribeTaskSchedulerRequest\x12!\n" +
+	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\"\xfa\x02\n" +
+	"\x1dDescribeTaskSchedulerResponse\x12]\n" +
+	"\x05hosts\x18\x01 \x03(\v2G.temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.HostR\x05hosts\x1a\xf9\x01\n" +
+	"\x04Host\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12R\n" +
+	"\n" +
+	"schedulers\x18\x02 \x03(\v22.temporal.server.api.history.v1.TaskSchedulerStateR\n" +
+	"schedulers\x12\x82\x01\n" +
+	"\x1anamespace_weight_overrides\x18\x03 \x03(\v2D.temporal.server.api.history.v1.TaskSchedulerNamespaceWeightOverrideR\x18namespaceWeightOverrides\"\xb8\x01\n" +
+	")UpdateTaskSchedulerNamespaceWeightRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x16\n" +
+	"\x06remove\x18\x04 \x01(\bR\x06remove\"\x7f\n" +
+	"*UpdateTaskSchedulerNamespaceWeightResponse\x12\x14\n" +
+	"\x05hosts\x18\x01 \x03(\tR\x05hosts\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\",\n" +
 	"\x0fGetShardRequest\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"`\n" +
 	"\x10GetShardResponse\x12L\n" +
@@ -5980,7 +7986,17 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\atask_id\x18\x04 \x01(\x03R\x06taskId\x12C\n" +
 	"\ttask_type\x18\x05 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x127\n" +
 	"\tfire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x18\n" +
-	"\aversion\x18\a \x01(\x03R\aversion\"\xa8\x01\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"e\n" +
+	"\x13GetTaskTraceRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\"W\n" +
+	"\x14GetTaskTraceResponse\x12?\n" +
+	"\x05trace\x18\x01 \x01(\v2).temporal.server.api.history.v1.TaskTraceR\x05trace\"8\n" +
+	"\x1bListQueueMitigationsRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"q\n" +
+	"\x1cListQueueMitigationsResponse\x12Q\n" +
+	"\vmitigations\x18\x01 \x03(\v2/.temporal.server.api.history.v1.QueueMitigationR\vmitigations\"\xa8\x01\n" +
 	"\x11RemoveTaskRequest\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12\x17\n" +
@@ -6035,7 +8051,35 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\n" +
 	"task_infos\x18\x01 \x03(\v27.temporal.server.api.replication.v1.ReplicationTaskInfoR\ttaskInfos\"\x85\x01\n" +
 	"!GetDLQReplicationMessagesResponse\x12`\n" +
-	"\x11replication_tasks\x18\x01 \x03(\v23.temporal.server.api.replication.v1.ReplicationTaskR\x10replicationTasks\"\xd3\x01\n" +
+	"\x11replication_tasks\x18\x01 \x03(\v23.temporal.server.api.replication.v1.ReplicationTaskR\x10replicationTasks\"\xc3\x01\n" +
+	"\x18GetReplicationLagRequest\x12'\n" +
+	"\x0fremote_clusters\x18\x01 \x03(\tR\x0eremoteClusters\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x02 \x03(\tR\n" +
+	"namespaces\x122\n" +
+	"\amax_lag\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06maxLag\x12*\n" +
+	"\x11max_scanned_tasks\x18\x04 \x01(\x05R\x0fmaxScannedTasks\"\xc4\x01\n" +
+	"\x19GetReplicationLagResponse\x12V\n" +
+	"\bclusters\x18\x01 \x03(\v2:.temporal.server.api.adminservice.v1.ClusterReplicationLagR\bclusters\x12(\n" +
+	"\x10safe_to_failover\x18\x02 \x01(\bR\x0esafeToFailover\x12%\n" +
+	"\x0eunsafe_reasons\x18\x03 \x03(\tR\runsafeReasons\"\x94\x03\n" +
+	"\x15ClusterReplicationLag\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1e\n" +
+	"\vtask_id_lag\x18\x02 \x01(\x03R\ttaskIdLag\x12+\n" +
+	"\x03lag\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03lag\x12,\n" +
+	"\x12backlog_task_count\x18\x04 \x01(\x03R\x10backlogTaskCount\x12+\n" +
+	"\x11backlog_truncated\x18\x05 \x01(\bR\x10backlogTruncated\x12*\n" +
+	"\x11dlq_message_count\x18\x06 \x01(\x03R\x0fdlqMessageCount\x12&\n" +
+	"\x0fstuck_shard_ids\x18\a \x03(\x05R\rstuckShardIds\x12\\\n" +
+	"\n" +
+	"namespaces\x18\b \x03(\v2<.temporal.server.api.adminservice.v1.NamespaceReplicationLagR\n" +
+	"namespaces\"\xa4\x02\n" +
+	"\x17NamespaceReplicationLag\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
+	"\x12backlog_task_count\x18\x03 \x01(\x03R\x10backlogTaskCount\x12+\n" +
+	"\x03lag\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03lag\x12m\n" +
+	"\x1doldest_unreplicated_execution\x18\x05 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x1boldestUnreplicatedExecution\"\xd3\x01\n" +
 	"\x14ReapplyEventsRequest\x12!\n" +
 	"\fnamespace_id\x18\x04 \x01(\tR\vnamespaceId\x12X\n" +
 	"\x12workflow_execution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\x128\n" +
@@ -6207,7 +8251,7 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\tnamespace\x18\x01 \x01(\tH\x00R\tnamespace\x12\x10\n" +
 	"\x02id\x18\x02 \x01(\tH\x00R\x02idB\f\n" +
 	"\n" +
-	"attributes\"\xda\x03\n" +
+	"attributes\"\xc0\x04\n" +
 	"\x14GetNamespaceResponse\x12<\n" +
 	"\x04info\x18\x03 \x01(\v2(.temporal.api.namespace.v1.NamespaceInfoR\x04info\x12B\n" +
 	"\x06config\x18\x04 \x01(\v2*.temporal.api.namespace.v1.NamespaceConfigR\x06config\x12f\n" +
@@ -6215,32 +8259,46 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x0econfig_version\x18\x06 \x01(\x03R\rconfigVersion\x12)\n" +
 	"\x10failover_version\x18\a \x01(\x03R\x0ffailoverVersion\x12V\n" +
 	"\x10failover_history\x18\b \x03(\v2+.temporal.api.replication.v1.FailoverStatusR\x0ffailoverHistory\x12.\n" +
-	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\"\xa0\x01\n" +
+	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\x12d\n" +
+	"\x12replication_filter\x18\n" +
+	" \x01(\v25.temporal.server.api.persistence.v1.ReplicationFilterR\x11replicationFilter\"\xad\x01\n" +
+	"'UpdateNamespaceReplicationFilterRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12d\n" +
+	"\x12replication_filter\x18\x02 \x01(\v25.temporal.server.api.persistence.v1.ReplicationFilterR\x11replicationFilter\"*\n" +
+	"(UpdateNamespaceReplicationFilterResponse\"\xed\x01\n" +
 	"\x12GetDLQTasksRequest\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\"\x89\x01\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\x12K\n" +
+	"\x06filter\x18\x04 \x01(\v23.temporal.server.api.common.v1.HistoryDLQTaskFilterR\x06filter\"\xf7\x01\n" +
 	"\x13GetDLQTasksResponse\x12J\n" +
 	"\tdlq_tasks\x18\x01 \x03(\v2-.temporal.server.api.common.v1.HistoryDLQTaskR\bdlqTasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xd3\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\x12l\n" +
+	"\x17last_read_task_metadata\x18\x03 \x01(\v25.temporal.server.api.common.v1.HistoryDLQTaskMetadataR\x14lastReadTaskMetadata\"\xb9\x02\n" +
 	"\x14PurgeDLQTasksRequest\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12t\n" +
-	"\x1binclusive_max_task_metadata\x18\x02 \x01(\v25.temporal.server.api.common.v1.HistoryDLQTaskMetadataR\x18inclusiveMaxTaskMetadata\"4\n" +
+	"\x1binclusive_max_task_metadata\x18\x02 \x01(\v25.temporal.server.api.common.v1.HistoryDLQTaskMetadataR\x18inclusiveMaxTaskMetadata\x12K\n" +
+	"\x06filter\x18\x03 \x01(\v23.temporal.server.api.common.v1.HistoryDLQTaskFilterR\x06filter\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"4\n" +
 	"\x15PurgeDLQTasksResponse\x12\x1b\n" +
 	"\tjob_token\x18\x01 \x01(\fR\bjobToken\"E\n" +
 	"\vDLQJobToken\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\xf2\x01\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\xb5\x03\n" +
 	"\x14MergeDLQTasksRequest\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12t\n" +
 	"\x1binclusive_max_task_metadata\x18\x02 \x01(\v25.temporal.server.api.common.v1.HistoryDLQTaskMetadataR\x18inclusiveMaxTaskMetadata\x12\x1d\n" +
 	"\n" +
-	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"4\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12K\n" +
+	"\x06filter\x18\x04 \x01(\v23.temporal.server.api.common.v1.HistoryDLQTaskFilterR\x06filter\x122\n" +
+	"\x15skip_closed_workflows\x18\x05 \x01(\bR\x13skipClosedWorkflows\x12'\n" +
+	"\x0fpurge_unmatched\x18\x06 \x01(\bR\x0epurgeUnmatched\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"4\n" +
 	"\x15MergeDLQTasksResponse\x12\x1b\n" +
 	"\tjob_token\x18\x01 \x01(\fR\bjobToken\"4\n" +
 	"\x15DescribeDLQJobRequest\x12\x1b\n" +
-	"\tjob_token\x18\x01 \x01(\fR\bjobToken\"\x92\x04\n" +
+	"\tjob_token\x18\x01 \x01(\fR\bjobToken\"\x82\x06\n" +
 	"\x16DescribeDLQJobResponse\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12U\n" +
 	"\x0eoperation_type\x18\x02 \x01(\x0e2..temporal.server.api.enums.v1.DLQOperationTypeR\roperationType\x12X\n" +
@@ -6250,7 +8308,24 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12$\n" +
 	"\x0emax_message_id\x18\x06 \x01(\x03R\fmaxMessageId\x129\n" +
 	"\x19last_processed_message_id\x18\a \x01(\x03R\x16lastProcessedMessageId\x12-\n" +
-	"\x12messages_processed\x18\b \x01(\x03R\x11messagesProcessed\"J\n" +
+	"\x12messages_processed\x18\b \x01(\x03R\x11messagesProcessed\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12)\n" +
+	"\x10messages_matched\x18\n" +
+	" \x01(\x03R\x0fmessagesMatched\x12'\n" +
+	"\x0fmessages_merged\x18\v \x01(\x03R\x0emessagesMerged\x12'\n" +
+	"\x0fmessages_purged\x18\f \x01(\x03R\x0emessagesPurged\x12X\n" +
+	"\rtask_outcomes\x18\r \x03(\v23.temporal.server.api.adminservice.v1.DLQTaskOutcomeR\ftaskOutcomes\"\xca\x02\n" +
+	"\x0eDLQTaskOutcome\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12!\n" +
+	"\fnamespace_id\x18\x03 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x04 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x05 \x01(\tR\x05runId\x12C\n" +
+	"\ttask_type\x18\x06 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x12F\n" +
+	"\aoutcome\x18\a \x01(\x0e2,.temporal.server.api.enums.v1.DLQTaskOutcomeR\aoutcome\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"J\n" +
 	"\x13CancelDLQJobRequest\x12\x1b\n" +
 	"\tjob_token\x18\x01 \x01(\fR\bjobToken\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"2\n" +
@@ -6351,7 +8426,47 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x1cSCHEDULER_TARGET_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16SCHEDULER_TARGET_CHASM\x10\x01\x12\x1d\n" +
 	"\x19SCHEDULER_TARGET_WORKFLOW\x10\x02\"\x19\n" +
-	"\x17MigrateScheduleResponseB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x17MigrateScheduleResponse\"\xaf\x02\n" +
+	"\x1dCloneWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12)\n" +
+	"\x10target_namespace\x18\x03 \x01(\tR\x0ftargetNamespace\x12,\n" +
+	"\x12target_workflow_id\x18\x04 \x01(\tR\x10targetWorkflowId\x12\"\n" +
+	"\rlast_event_id\x18\x05 \x01(\x03R\vlastEventId\x12*\n" +
+	"\x11target_task_queue\x18\x06 \x01(\tR\x0ftargetTaskQueue\"[\n" +
+	"\x1eCloneWorkflowExecutionResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\"\n" +
+	"\rlast_event_id\x18\x02 \x01(\x03R\vlastEventId\"\x8a\x01\n" +
+	"#DryRunResetWorkflowExecutionRequest\x12c\n" +
+	"\rreset_request\x18\x01 \x01(\v2>.temporal.api.workflowservice.v1.ResetWorkflowExecutionRequestR\fresetRequest\"q\n" +
+	"$DryRunResetWorkflowExecutionResponse\x12I\n" +
+	"\x06result\x18\x01 \x01(\v21.temporal.server.api.history.v1.ResetDryRunResultR\x06result\"\xa6\x01\n" +
+	"\"DescribeMutableStateAtEventRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x03R\aeventId\"\xc2\x01\n" +
+	"#DescribeMutableStateAtEventResponse\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\tR\ashardId\x12!\n" +
+	"\fhistory_addr\x18\x02 \x01(\tR\vhistoryAddr\x12]\n" +
+	"\rmutable_state\x18\x03 \x01(\v28.temporal.server.api.persistence.v1.WorkflowMutableStateR\fmutableState\"\x8f\x01\n" +
+	"&ListWorkflowConflictResolutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x81\x01\n" +
+	"'ListWorkflowConflictResolutionsResponse\x12V\n" +
+	"\arecords\x18\x01 \x03(\v2<.temporal.server.api.persistence.v1.ConflictResolutionRecordR\arecords\"\xf1\x01\n" +
+	"$StartChainedActivityExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12X\n" +
+	"\arequest\x18\x02 \x01(\v2>.temporal.api.workflowservice.v1.StartActivityExecutionRequestR\arequest\x12Q\n" +
+	"\n" +
+	"follow_ups\x18\x03 \x03(\v22.temporal.server.api.activity.v1.ActivityChainStepR\tfollowUps\"\x84\x01\n" +
+	"%StartChainedActivityExecutionResponse\x12[\n" +
+	"\bresponse\x18\x01 \x01(\v2?.temporal.api.workflowservice.v1.StartActivityExecutionResponseR\bresponse\"\xa4\x01\n" +
+	"'DescribeChainedActivityExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12[\n" +
+	"\arequest\x18\x02 \x01(\v2A.temporal.api.workflowservice.v1.DescribeActivityExecutionRequestR\arequest\"\xd5\x01\n" +
+	"(DescribeChainedActivityExecutionResponse\x12^\n" +
+	"\bresponse\x18\x01 \x01(\v2B.temporal.api.workflowservice.v1.DescribeActivityExecutionResponseR\bresponse\x12I\n" +
+	"\x05chain\x18\x02 \x01(\v23.temporal.server.api.activity.v1.ActivityChainStateR\x05chainB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -6366,7 +8481,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 }
 
 var file_temporal_server_api_adminservice_v1_request_response_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
 	(MigrateScheduleRequest_SchedulerTarget)(0),         // 0: temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
 	(*RebuildMutableStateRequest)(nil),                  // 1: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
@@ -6379,247 +8494,330 @@ var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []
 	(*DescribeHistoryHostResponse)(nil),                 // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
 	(*CloseShardRequest)(nil),                           // 9: temporal.server.api.adminservice.v1.CloseShardRequest
 	(*CloseShardResponse)(nil),                          // 10: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*GetShardRequest)(nil),                             // 11: temporal.server.api.adminservice.v1.GetShardRequest
-	(*GetShardResponse)(nil),                            // 12: temporal.server.api.adminservice.v1.GetShardResponse
-	(*ListHistoryTasksRequest)(nil),                     // 13: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*ListHistoryTasksResponse)(nil),                    // 14: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*Task)(nil),                                        // 15: temporal.server.api.adminservice.v1.Task
-	(*RemoveTaskRequest)(nil),                           // 16: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*RemoveTaskResponse)(nil),                          // 17: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 21: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesRequest)(nil),               // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetReplicationMessagesResponse)(nil),              // 23: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 25: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesRequest)(nil),            // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*GetDLQReplicationMessagesResponse)(nil),           // 27: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsRequest)(nil),                        // 28: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*ReapplyEventsResponse)(nil),                       // 29: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesRequest)(nil),                  // 30: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*AddSearchAttributesResponse)(nil),                 // 31: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesRequest)(nil),               // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*RemoveSearchAttributesResponse)(nil),              // 33: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesRequest)(nil),                  // 34: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*GetSearchAttributesResponse)(nil),                 // 35: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterRequest)(nil),                      // 36: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),                     // 37: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersRequest)(nil),                         // 38: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),                        // 39: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 41: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterRequest)(nil),                  // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*RemoveRemoteClusterResponse)(nil),                 // 43: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*ListClusterMembersRequest)(nil),                   // 44: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*ListClusterMembersResponse)(nil),                  // 45: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*GetDLQMessagesRequest)(nil),                       // 46: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*GetDLQMessagesResponse)(nil),                      // 47: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesRequest)(nil),                     // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*PurgeDLQMessagesResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesRequest)(nil),                     // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*MergeDLQMessagesResponse)(nil),                    // 51: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksRequest)(nil),                 // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*RefreshWorkflowTasksResponse)(nil),                // 53: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksRequest)(nil),               // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*ResendReplicationTasksResponse)(nil),              // 55: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksRequest)(nil),                    // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*GetTaskQueueTasksResponse)(nil),                   // 57: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 59: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 61: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 62: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 63: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 64: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 65: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 67: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 68: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 69: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 70: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 71: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 72: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 73: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 74: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 75: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 76: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 77: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 78: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 79: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 80: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 84: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 85: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	(*GetTaskQueueUserDataRequest)(nil),                 // 89: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	(*GetTaskQueueUserDataResponse)(nil),                // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	(*StartAdminBatchOperationRequest)(nil),             // 91: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	(*StartAdminBatchOperationResponse)(nil),            // 92: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	(*BatchOperationRefreshTasks)(nil),                  // 93: temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
-	(*MigrateScheduleRequest)(nil),                      // 94: temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	(*MigrateScheduleResponse)(nil),                     // 95: temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	nil,                                                 // 96: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                                 // 97: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                                 // 98: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                                 // 99: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                                 // 100: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                                 // 101: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                                 // 102: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),                        // 103: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),                // 104: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                                 // 105: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),                        // 106: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                                 // 107: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                          // 108: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),                    // 109: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),                      // 110: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                               // 111: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                               // 112: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                                   // 113: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),                       // 114: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),                        // 115: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),                     // 116: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),                     // 117: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),                         // 118: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),                   // 119: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                          // 120: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                             // 121: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),                         // 122: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),                         // 123: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                          // 124: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                           // 125: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),                        // 126: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                              // 127: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),                       // 128: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),                    // 129: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),             // 130: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                          // 131: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),                        // 132: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),             // 133: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),                         // 134: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                          // 135: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),                         // 136: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),                 // 137: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                           // 138: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                          // 139: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                                // 140: temporal.server.api.enums.v1.HealthState
-	(*v113.ServiceHealthDetail)(nil),                    // 141: temporal.server.api.health.v1.ServiceHealthDetail
-	(*v12.VersionedTransition)(nil),                     // 142: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),                        // 143: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),             // 144: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v114.TaskQueuePartition)(nil),                     // 145: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v115.TaskQueueVersionSelection)(nil),              // 146: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.PartitionScaleInfo)(nil),                     // 147: temporal.server.api.taskqueue.v1.PartitionScaleInfo
-	(*v12.TaskQueueTypeUserData)(nil),                   // 148: temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	(v16.IndexedValueType)(0),                           // 149: temporal.api.enums.v1.IndexedValueType
-	(*v114.TaskQueueVersionInfoInternal)(nil),           // 150: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*MoveHistoryShardRequest)(nil),                     // 11: temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	(*MoveHistoryShardResponse)(nil),                    // 12: temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	(*UnpinHistoryShardRequest)(nil),                    // 13: temporal.server.api.adminservice.v1.UnpinHistoryShardRequest
+	(*UnpinHistoryShardResponse)(nil),                   // 14: temporal.server.api.adminservice.v1.UnpinHistoryShardResponse
+	(*DescribeTaskSchedulerRequest)(nil),                // 15: temporal.server.api.adminservice.v1.DescribeTaskSchedulerRequest
+	(*DescribeTaskSchedulerResponse)(nil),               // 16: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse
+	(*UpdateTaskSchedulerNamespaceWeightRequest)(nil),   // 17: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightRequest
+	(*UpdateTaskSchedulerNamespaceWeightResponse)(nil),  // 18: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightResponse
+	(*GetShardRequest)(nil),                             // 19: temporal.server.api.adminservice.v1.GetShardRequest
+	(*GetShardResponse)(nil),                            // 20: temporal.server.api.adminservice.v1.GetShardResponse
+	(*ListHistoryTasksRequest)(nil),                     // 21: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*ListHistoryTasksResponse)(nil),                    // 22: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*Task)(nil),                                        // 23: temporal.server.api.adminservice.v1.Task
+	(*GetTaskTraceRequest)(nil),                         // 24: temporal.server.api.adminservice.v1.GetTaskTraceRequest
+	(*GetTaskTraceResponse)(nil),                        // 25: temporal.server.api.adminservice.v1.GetTaskTraceResponse
+	(*ListQueueMitigationsRequest)(nil),                 // 26: temporal.server.api.adminservice.v1.ListQueueMitigationsRequest
+	(*ListQueueMitigationsResponse)(nil),                // 27: temporal.server.api.adminservice.v1.ListQueueMitigationsResponse
+	(*RemoveTaskRequest)(nil),                           // 28: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*RemoveTaskResponse)(nil),                          // 29: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 30: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 31: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 32: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 33: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesRequest)(nil),               // 34: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetReplicationMessagesResponse)(nil),              // 35: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesRequest)(nil),      // 36: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 37: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesRequest)(nil),            // 38: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetDLQReplicationMessagesResponse)(nil),           // 39: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*GetReplicationLagRequest)(nil),                    // 40: temporal.server.api.adminservice.v1.GetReplicationLagRequest
+	(*GetReplicationLagResponse)(nil),                   // 41: temporal.server.api.adminservice.v1.GetReplicationLagResponse
+	(*ClusterReplicationLag)(nil),                       // 42: temporal.server.api.adminservice.v1.ClusterReplicationLag
+	(*NamespaceReplicationLag)(nil),                     // 43: temporal.server.api.adminservice.v1.NamespaceReplicationLag
+	(*ReapplyEventsRequest)(nil),                        // 44: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*ReapplyEventsResponse)(nil),                       // 45: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesRequest)(nil),                  // 46: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),                 // 47: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesRequest)(nil),               // 48: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*RemoveSearchAttributesResponse)(nil),              // 49: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesRequest)(nil),                  // 50: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*GetSearchAttributesResponse)(nil),                 // 51: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterRequest)(nil),                      // 52: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),                     // 53: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersRequest)(nil),                         // 54: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),                        // 55: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*AddOrUpdateRemoteClusterRequest)(nil),             // 56: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 57: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterRequest)(nil),                  // 58: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*RemoveRemoteClusterResponse)(nil),                 // 59: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*ListClusterMembersRequest)(nil),                   // 60: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*ListClusterMembersResponse)(nil),                  // 61: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*GetDLQMessagesRequest)(nil),                       // 62: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*GetDLQMessagesResponse)(nil),                      // 63: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesRequest)(nil),                     // 64: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*PurgeDLQMessagesResponse)(nil),                    // 65: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesRequest)(nil),                     // 66: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*MergeDLQMessagesResponse)(nil),                    // 67: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksRequest)(nil),                 // 68: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*RefreshWorkflowTasksResponse)(nil),                // 69: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksRequest)(nil),               // 70: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*ResendReplicationTasksResponse)(nil),              // 71: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksRequest)(nil),                    // 72: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*GetTaskQueueTasksResponse)(nil),                   // 73: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionRequest)(nil),              // 74: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),             // 75: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 76: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 77: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                         // 78: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                        // 79: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*UpdateNamespaceReplicationFilterRequest)(nil),     // 80: temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterRequest
+	(*UpdateNamespaceReplicationFilterResponse)(nil),    // 81: temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterResponse
+	(*GetDLQTasksRequest)(nil),                          // 82: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                         // 83: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                        // 84: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                       // 85: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                 // 86: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                        // 87: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                       // 88: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                       // 89: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                      // 90: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*DLQTaskOutcome)(nil),                              // 91: temporal.server.api.adminservice.v1.DLQTaskOutcome
+	(*CancelDLQJobRequest)(nil),                         // 92: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                        // 93: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                             // 94: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                            // 95: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                           // 96: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                          // 97: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                      // 98: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                     // 99: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                    // 100: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                   // 101: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 102: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 103: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 104: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 105: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 106: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 107: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetTaskQueueUserDataRequest)(nil),                 // 108: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	(*GetTaskQueueUserDataResponse)(nil),                // 109: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	(*StartAdminBatchOperationRequest)(nil),             // 110: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	(*StartAdminBatchOperationResponse)(nil),            // 111: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	(*BatchOperationRefreshTasks)(nil),                  // 112: temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
+	(*MigrateScheduleRequest)(nil),                      // 113: temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	(*MigrateScheduleResponse)(nil),                     // 114: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*CloneWorkflowExecutionRequest)(nil),               // 115: temporal.server.api.adminservice.v1.CloneWorkflowExecutionRequest
+	(*CloneWorkflowExecutionResponse)(nil),              // 116: temporal.server.api.adminservice.v1.CloneWorkflowExecutionResponse
+	(*DryRunResetWorkflowExecutionRequest)(nil),         // 117: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionRequest
+	(*DryRunResetWorkflowExecutionResponse)(nil),        // 118: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionResponse
+	(*DescribeMutableStateAtEventRequest)(nil),          // 119: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventRequest
+	(*DescribeMutableStateAtEventResponse)(nil),         // 120: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventResponse
+	(*ListWorkflowConflictResolutionsRequest)(nil),      // 121: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsRequest
+	(*ListWorkflowConflictResolutionsResponse)(nil),     // 122: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsResponse
+	(*StartChainedActivityExecutionRequest)(nil),        // 123: temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest
+	(*StartChainedActivityExecutionResponse)(nil),       // 124: temporal.server.api.adminservice.v1.StartChainedActivityExecutionResponse
+	(*DescribeChainedActivityExecutionRequest)(nil),     // 125: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionRequest
+	(*DescribeChainedActivityExecutionResponse)(nil),    // 126: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse
+	(*DescribeTaskSchedulerResponse_Host)(nil),          // 127: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.Host
+	nil,                                              // 128: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                              // 129: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                              // 130: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                              // 131: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                              // 132: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                              // 133: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                              // 134: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	(*AddTasksRequest_Task)(nil),                     // 135: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),             // 136: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                              // 137: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	(*v1.WorkflowExecution)(nil),                     // 138: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                              // 139: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                       // 140: temporal.server.api.history.v1.VersionHistory
+	(*v12.WorkflowMutableState)(nil),                 // 141: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v13.NamespaceCacheInfo)(nil),                   // 142: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*durationpb.Duration)(nil),                      // 143: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                    // 144: google.protobuf.Timestamp
+	(*v12.ShardInfo)(nil),                            // 145: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                            // 146: temporal.server.api.history.v1.TaskRange
+	(v14.TaskType)(0),                                // 147: temporal.server.api.enums.v1.TaskType
+	(*v11.TaskTrace)(nil),                            // 148: temporal.server.api.history.v1.TaskTrace
+	(*v11.QueueMitigation)(nil),                      // 149: temporal.server.api.history.v1.QueueMitigation
+	(*v15.ReplicationToken)(nil),                     // 150: temporal.server.api.replication.v1.ReplicationToken
+	(*v15.ReplicationMessages)(nil),                  // 151: temporal.server.api.replication.v1.ReplicationMessages
+	(*v15.ReplicationTaskInfo)(nil),                  // 152: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v15.ReplicationTask)(nil),                      // 153: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),                // 154: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                       // 155: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                          // 156: temporal.api.version.v1.VersionInfo
+	(*v12.ClusterMetadata)(nil),                      // 157: temporal.server.api.persistence.v1.ClusterMetadata
+	(v14.ClusterMemberRole)(0),                       // 158: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                        // 159: temporal.server.api.cluster.v1.ClusterMember
+	(v14.DeadLetterQueueType)(0),                     // 160: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v16.TaskQueueType)(0),                           // 161: temporal.api.enums.v1.TaskQueueType
+	(*v12.AllocatedTaskInfo)(nil),                    // 162: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v15.SyncReplicationState)(nil),                 // 163: temporal.server.api.replication.v1.SyncReplicationState
+	(*v15.WorkflowReplicationMessages)(nil),          // 164: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                       // 165: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),                     // 166: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),          // 167: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),                      // 168: temporal.api.replication.v1.FailoverStatus
+	(*v12.ReplicationFilter)(nil),                    // 169: temporal.server.api.persistence.v1.ReplicationFilter
+	(*v112.HistoryDLQKey)(nil),                       // 170: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTaskFilter)(nil),                // 171: temporal.server.api.common.v1.HistoryDLQTaskFilter
+	(*v112.HistoryDLQTask)(nil),                      // 172: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),              // 173: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v14.DLQOperationType)(0),                        // 174: temporal.server.api.enums.v1.DLQOperationType
+	(v14.DLQOperationState)(0),                       // 175: temporal.server.api.enums.v1.DLQOperationState
+	(v14.DLQTaskOutcome)(0),                          // 176: temporal.server.api.enums.v1.DLQTaskOutcome
+	(v14.HealthState)(0),                             // 177: temporal.server.api.enums.v1.HealthState
+	(*v113.ServiceHealthDetail)(nil),                 // 178: temporal.server.api.health.v1.ServiceHealthDetail
+	(*v12.VersionedTransition)(nil),                  // 179: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),                     // 180: temporal.server.api.history.v1.VersionHistories
+	(*v15.VersionedTransitionArtifact)(nil),          // 181: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v114.TaskQueuePartition)(nil),                  // 182: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v115.TaskQueueVersionSelection)(nil),           // 183: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.PartitionScaleInfo)(nil),                  // 184: temporal.server.api.taskqueue.v1.PartitionScaleInfo
+	(*v12.TaskQueueTypeUserData)(nil),                // 185: temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	(*v116.ResetWorkflowExecutionRequest)(nil),       // 186: temporal.api.workflowservice.v1.ResetWorkflowExecutionRequest
+	(*v11.ResetDryRunResult)(nil),                    // 187: temporal.server.api.history.v1.ResetDryRunResult
+	(*v12.ConflictResolutionRecord)(nil),             // 188: temporal.server.api.persistence.v1.ConflictResolutionRecord
+	(*v116.StartActivityExecutionRequest)(nil),       // 189: temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	(*v117.ActivityChainStep)(nil),                   // 190: temporal.server.api.activity.v1.ActivityChainStep
+	(*v116.StartActivityExecutionResponse)(nil),      // 191: temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	(*v116.DescribeActivityExecutionRequest)(nil),    // 192: temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	(*v116.DescribeActivityExecutionResponse)(nil),   // 193: temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	(*v117.ActivityChainState)(nil),                  // 194: temporal.server.api.activity.v1.ActivityChainState
+	(*v11.TaskSchedulerState)(nil),                   // 195: temporal.server.api.history.v1.TaskSchedulerState
+	(*v11.TaskSchedulerNamespaceWeightOverride)(nil), // 196: temporal.server.api.history.v1.TaskSchedulerNamespaceWeightOverride
+	(v16.IndexedValueType)(0),                        // 197: temporal.api.enums.v1.IndexedValueType
+	(*v114.TaskQueueVersionInfoInternal)(nil),        // 198: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	106, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	106, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	109, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	109, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	106, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	110, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	111, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	112, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
-	15,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	113, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	114, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	114, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	106, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	115, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	96,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	116, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	117, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	118, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	106, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	97,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	98,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	99,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	100, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	119, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	101, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	120, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	121, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	102, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	122, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	123, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	124, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	114, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	125, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	126, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	118, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	117, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	126, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	106, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	127, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	128, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	106, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	129, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	130, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	131, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	132, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	133, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	134, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	135, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	136, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	135, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	138, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	139, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	114, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	114, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	103, // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	104, // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	140, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	141, // 72: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
-	106, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	142, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	143, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	144, // 76: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	106, // 77: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	145, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	146, // 79: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	105, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	147, // 81: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.scale_info:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
-	145, // 82: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	127, // 83: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	148, // 84: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	106, // 85: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
-	93,  // 86: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
-	0,   // 87: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
-	116, // 88: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	149, // 89: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	149, // 90: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	149, // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	107, // 92: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	150, // 93: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	94,  // [94:94] is the sub-list for method output_type
-	94,  // [94:94] is the sub-list for method input_type
-	94,  // [94:94] is the sub-list for extension type_name
-	94,  // [94:94] is the sub-list for extension extendee
-	0,   // [0:94] is the sub-list for field type_name
+	138, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	138, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	138, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	141, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	141, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	138, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	142, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	127, // 9: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.hosts:type_name -> temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.Host
+	143, // 10: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightRequest.duration:type_name -> google.protobuf.Duration
+	144, // 11: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightResponse.expire_time:type_name -> google.protobuf.Timestamp
+	145, // 12: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	146, // 13: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	23,  // 14: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
+	147, // 15: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	144, // 16: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	148, // 17: temporal.server.api.adminservice.v1.GetTaskTraceResponse.trace:type_name -> temporal.server.api.history.v1.TaskTrace
+	149, // 18: temporal.server.api.adminservice.v1.ListQueueMitigationsResponse.mitigations:type_name -> temporal.server.api.history.v1.QueueMitigation
+	144, // 19: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	138, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 21: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 22: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	138, // 23: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 24: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 25: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	150, // 26: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	128, // 27: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	151, // 28: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	152, // 29: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	153, // 30: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	143, // 31: temporal.server.api.adminservice.v1.GetReplicationLagRequest.max_lag:type_name -> google.protobuf.Duration
+	42,  // 32: temporal.server.api.adminservice.v1.GetReplicationLagResponse.clusters:type_name -> temporal.server.api.adminservice.v1.ClusterReplicationLag
+	143, // 33: temporal.server.api.adminservice.v1.ClusterReplicationLag.lag:type_name -> google.protobuf.Duration
+	43,  // 34: temporal.server.api.adminservice.v1.ClusterReplicationLag.namespaces:type_name -> temporal.server.api.adminservice.v1.NamespaceReplicationLag
+	143, // 35: temporal.server.api.adminservice.v1.NamespaceReplicationLag.lag:type_name -> google.protobuf.Duration
+	138, // 36: temporal.server.api.adminservice.v1.NamespaceReplicationLag.oldest_unreplicated_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	138, // 37: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 38: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	129, // 39: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	130, // 40: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	131, // 41: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	132, // 42: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	154, // 43: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	133, // 44: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	155, // 45: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	156, // 46: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	134, // 47: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	157, // 48: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	143, // 49: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	158, // 50: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	144, // 51: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	159, // 52: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	160, // 53: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	160, // 54: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	153, // 55: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	152, // 56: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	160, // 57: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	160, // 58: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	138, // 59: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	161, // 60: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	162, // 61: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	138, // 62: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 63: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	164, // 64: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	165, // 65: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	166, // 66: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	167, // 67: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	168, // 68: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	169, // 69: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_filter:type_name -> temporal.server.api.persistence.v1.ReplicationFilter
+	169, // 70: temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterRequest.replication_filter:type_name -> temporal.server.api.persistence.v1.ReplicationFilter
+	170, // 71: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	171, // 72: temporal.server.api.adminservice.v1.GetDLQTasksRequest.filter:type_name -> temporal.server.api.common.v1.HistoryDLQTaskFilter
+	172, // 73: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	173, // 74: temporal.server.api.adminservice.v1.GetDLQTasksResponse.last_read_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	170, // 75: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	173, // 76: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	171, // 77: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.filter:type_name -> temporal.server.api.common.v1.HistoryDLQTaskFilter
+	170, // 78: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	173, // 79: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	171, // 80: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.filter:type_name -> temporal.server.api.common.v1.HistoryDLQTaskFilter
+	170, // 81: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	174, // 82: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	175, // 83: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	144, // 84: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	144, // 85: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	91,  // 86: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.task_outcomes:type_name -> temporal.server.api.adminservice.v1.DLQTaskOutcome
+	147, // 87: temporal.server.api.adminservice.v1.DLQTaskOutcome.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	176, // 88: temporal.server.api.adminservice.v1.DLQTaskOutcome.outcome:type_name -> temporal.server.api.enums.v1.DLQTaskOutcome
+	135, // 89: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	136, // 90: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	177, // 91: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	178, // 92: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
+	138, // 93: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	179, // 94: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	180, // 95: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	181, // 96: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	138, // 97: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	182, // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	183, // 99: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	137, // 100: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	184, // 101: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.scale_info:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
+	182, // 102: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	161, // 103: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	185, // 104: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	138, // 105: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
+	112, // 106: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
+	0,   // 107: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
+	138, // 108: temporal.server.api.adminservice.v1.CloneWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	186, // 109: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionRequest.reset_request:type_name -> temporal.api.workflowservice.v1.ResetWorkflowExecutionRequest
+	187, // 110: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionResponse.result:type_name -> temporal.server.api.history.v1.ResetDryRunResult
+	138, // 111: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	141, // 112: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventResponse.mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	138, // 113: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	188, // 114: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsResponse.records:type_name -> temporal.server.api.persistence.v1.ConflictResolutionRecord
+	189, // 115: temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest.request:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	190, // 116: temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest.follow_ups:type_name -> temporal.server.api.activity.v1.ActivityChainStep
+	191, // 117: temporal.server.api.adminservice.v1.StartChainedActivityExecutionResponse.response:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	192, // 118: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionRequest.request:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	193, // 119: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse.response:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	194, // 120: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse.chain:type_name -> temporal.server.api.activity.v1.ActivityChainState
+	195, // 121: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.Host.schedulers:type_name -> temporal.server.api.history.v1.TaskSchedulerState
+	196, // 122: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse.Host.namespace_weight_overrides:type_name -> temporal.server.api.history.v1.TaskSchedulerNamespaceWeightOverride
+	151, // 123: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	197, // 124: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	197, // 125: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	197, // 126: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	139, // 127: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	198, // 128: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	129, // [129:129] is the sub-list for method output_type
+	129, // [129:129] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6627,17 +8825,17 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 	if File_temporal_server_api_adminservice_v1_request_response_proto != nil {
 		return
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesResponse_Messages)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77].OneofWrappers = []any{
 		(*GetNamespaceRequest_Namespace)(nil),
 		(*GetNamespaceRequest_Id)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109].OneofWrappers = []any{
 		(*StartAdminBatchOperationRequest_RefreshTasksOperation)(nil),
 	}
 	type x struct{}
@@ -6646,7 +8844,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   105,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,7 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xb3:\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xf5M\n" +
 	"\fAdminService\x12\xa0\x01\n" +
 	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
 	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
@@ -35,14 +35,21 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\bGetShard\x124.temporal.server.api.adminservice.v1.GetShardRequest\x1a5.temporal.server.api.adminservice.v1.GetShardResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x85\x01\n" +
 	"\n" +
 	"CloseShard\x126.temporal.server.api.adminservice.v1.CloseShardRequest\x1a7.temporal.server.api.adminservice.v1.CloseShardResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x97\x01\n" +
-	"\x10ListHistoryTasks\x12<.temporal.server.api.adminservice.v1.ListHistoryTasksRequest\x1a=.temporal.server.api.adminservice.v1.ListHistoryTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x85\x01\n" +
+	"\x10MoveHistoryShard\x12<.temporal.server.api.adminservice.v1.MoveHistoryShardRequest\x1a=.temporal.server.api.adminservice.v1.MoveHistoryShardResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x9a\x01\n" +
+	"\x11UnpinHistoryShard\x12=.temporal.server.api.adminservice.v1.UnpinHistoryShardRequest\x1a>.temporal.server.api.adminservice.v1.UnpinHistoryShardResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa6\x01\n" +
+	"\x15DescribeTaskScheduler\x12A.temporal.server.api.adminservice.v1.DescribeTaskSchedulerRequest\x1aB.temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xcd\x01\n" +
+	"\"UpdateTaskSchedulerNamespaceWeight\x12N.temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightRequest\x1aO.temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x97\x01\n" +
+	"\x10ListHistoryTasks\x12<.temporal.server.api.adminservice.v1.ListHistoryTasksRequest\x1a=.temporal.server.api.adminservice.v1.ListHistoryTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x8b\x01\n" +
+	"\fGetTaskTrace\x128.temporal.server.api.adminservice.v1.GetTaskTraceRequest\x1a9.temporal.server.api.adminservice.v1.GetTaskTraceResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
+	"\x14ListQueueMitigations\x12@.temporal.server.api.adminservice.v1.ListQueueMitigationsRequest\x1aA.temporal.server.api.adminservice.v1.ListQueueMitigationsResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x85\x01\n" +
 	"\n" +
 	"RemoveTask\x126.temporal.server.api.adminservice.v1.RemoveTaskRequest\x1a7.temporal.server.api.adminservice.v1.RemoveTaskResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc7\x01\n" +
 	" GetWorkflowExecutionRawHistoryV2\x12L.temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request\x1aM.temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc1\x01\n" +
 	"\x1eGetWorkflowExecutionRawHistory\x12J.temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest\x1aK.temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
 	"\x16GetReplicationMessages\x12B.temporal.server.api.adminservice.v1.GetReplicationMessagesRequest\x1aC.temporal.server.api.adminservice.v1.GetReplicationMessagesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc4\x01\n" +
 	"\x1fGetNamespaceReplicationMessages\x12K.temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest\x1aL.temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb2\x01\n" +
-	"\x19GetDLQReplicationMessages\x12E.temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest\x1aF.temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x8e\x01\n" +
+	"\x19GetDLQReplicationMessages\x12E.temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest\x1aF.temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x9a\x01\n" +
+	"\x11GetReplicationLag\x12=.temporal.server.api.adminservice.v1.GetReplicationLagRequest\x1a>.temporal.server.api.adminservice.v1.GetReplicationLagResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x8e\x01\n" +
 	"\rReapplyEvents\x129.temporal.server.api.adminservice.v1.ReapplyEventsRequest\x1a:.temporal.server.api.adminservice.v1.ReapplyEventsResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa0\x01\n" +
 	"\x13AddSearchAttributes\x12?.temporal.server.api.adminservice.v1.AddSearchAttributesRequest\x1a@.temporal.server.api.adminservice.v1.AddSearchAttributesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
 	"\x16RemoveSearchAttributes\x12B.temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest\x1aC.temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa0\x01\n" +
@@ -61,7 +68,8 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x11GetTaskQueueTasks\x12=.temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest\x1a>.temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
 	"\x17DeleteWorkflowExecution\x12C.temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xce\x01\n" +
 	"!StreamWorkflowReplicationMessages\x12M.temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest\x1aN.temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse\"\x06\x8a\xb5\x18\x02\b\x03(\x010\x01\x12\x8b\x01\n" +
-	"\fGetNamespace\x128.temporal.server.api.adminservice.v1.GetNamespaceRequest\x1a9.temporal.server.api.adminservice.v1.GetNamespaceResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x88\x01\n" +
+	"\fGetNamespace\x128.temporal.server.api.adminservice.v1.GetNamespaceRequest\x1a9.temporal.server.api.adminservice.v1.GetNamespaceResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc7\x01\n" +
+	" UpdateNamespaceReplicationFilter\x12L.temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterRequest\x1aM.temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x88\x01\n" +
 	"\vGetDLQTasks\x127.temporal.server.api.adminservice.v1.GetDLQTasksRequest\x1a8.temporal.server.api.adminservice.v1.GetDLQTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x8e\x01\n" +
 	"\rPurgeDLQTasks\x129.temporal.server.api.adminservice.v1.PurgeDLQTasksRequest\x1a:.temporal.server.api.adminservice.v1.PurgeDLQTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x8e\x01\n" +
 	"\rMergeDLQTasks\x129.temporal.server.api.adminservice.v1.MergeDLQTasksRequest\x1a:.temporal.server.api.adminservice.v1.MergeDLQTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x91\x01\n" +
@@ -76,7 +84,13 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x1aDescribeTaskQueuePartition\x12F.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest\x1aG.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
 	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
 	"\x14GetTaskQueueUserData\x12@.temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest\x1aA.temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x94\x01\n" +
-	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
+	"\x16CloneWorkflowExecution\x12B.temporal.server.api.adminservice.v1.CloneWorkflowExecutionRequest\x1aC.temporal.server.api.adminservice.v1.CloneWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbb\x01\n" +
+	"\x1cDryRunResetWorkflowExecution\x12H.temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionRequest\x1aI.temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb8\x01\n" +
+	"\x1bDescribeMutableStateAtEvent\x12G.temporal.server.api.adminservice.v1.DescribeMutableStateAtEventRequest\x1aH.temporal.server.api.adminservice.v1.DescribeMutableStateAtEventResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc4\x01\n" +
+	"\x1fListWorkflowConflictResolutions\x12K.temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsRequest\x1aL.temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
+	"\x1dStartChainedActivityExecution\x12I.temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest\x1aJ.temporal.server.api.adminservice.v1.StartChainedActivityExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x01\x12\xc7\x01\n" +
+	" DescribeChainedActivityExecution\x12L.temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionRequest\x1aM.temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x01B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
@@ -85,191 +99,247 @@ var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*DescribeHistoryHostRequest)(nil),                  // 3: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
 	(*GetShardRequest)(nil),                             // 4: temporal.server.api.adminservice.v1.GetShardRequest
 	(*CloseShardRequest)(nil),                           // 5: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*ListHistoryTasksRequest)(nil),                     // 6: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*RemoveTaskRequest)(nil),                           // 7: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 8: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 9: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetReplicationMessagesRequest)(nil),               // 10: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 11: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetDLQReplicationMessagesRequest)(nil),            // 12: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*ReapplyEventsRequest)(nil),                        // 13: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*AddSearchAttributesRequest)(nil),                  // 14: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*RemoveSearchAttributesRequest)(nil),               // 15: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*GetSearchAttributesRequest)(nil),                  // 16: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*DescribeClusterRequest)(nil),                      // 17: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*ListClustersRequest)(nil),                         // 18: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClusterMembersRequest)(nil),                   // 19: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 20: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*RemoveRemoteClusterRequest)(nil),                  // 21: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*GetDLQMessagesRequest)(nil),                       // 22: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*PurgeDLQMessagesRequest)(nil),                     // 23: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*MergeDLQMessagesRequest)(nil),                     // 24: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*RefreshWorkflowTasksRequest)(nil),                 // 25: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*StartAdminBatchOperationRequest)(nil),             // 26: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	(*ResendReplicationTasksRequest)(nil),               // 27: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*GetTaskQueueTasksRequest)(nil),                    // 28: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*DeleteWorkflowExecutionRequest)(nil),              // 29: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 30: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*GetNamespaceRequest)(nil),                         // 31: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetDLQTasksRequest)(nil),                          // 32: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*PurgeDLQTasksRequest)(nil),                        // 33: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*MergeDLQTasksRequest)(nil),                        // 34: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*DescribeDLQJobRequest)(nil),                       // 35: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*CancelDLQJobRequest)(nil),                         // 36: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*AddTasksRequest)(nil),                             // 37: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*ListQueuesRequest)(nil),                           // 38: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*DeepHealthCheckRequest)(nil),                      // 39: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*SyncWorkflowStateRequest)(nil),                    // 40: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 41: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 42: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 43: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*GetTaskQueueUserDataRequest)(nil),                 // 44: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	(*MigrateScheduleRequest)(nil),                      // 45: temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	(*RebuildMutableStateResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 47: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 48: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 49: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 50: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 51: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 52: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 53: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 54: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 55: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 56: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 57: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 58: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 59: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 60: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 61: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 62: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 63: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 64: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 65: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 66: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 67: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 68: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 69: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 70: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 71: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*StartAdminBatchOperationResponse)(nil),            // 72: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	(*ResendReplicationTasksResponse)(nil),              // 73: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 74: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 75: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 76: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 77: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 78: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 79: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 81: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 82: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 83: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 84: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 85: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 86: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 87: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 88: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 89: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	(*GetTaskQueueUserDataResponse)(nil),                // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	(*MigrateScheduleResponse)(nil),                     // 91: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*MoveHistoryShardRequest)(nil),                     // 6: temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	(*UnpinHistoryShardRequest)(nil),                    // 7: temporal.server.api.adminservice.v1.UnpinHistoryShardRequest
+	(*DescribeTaskSchedulerRequest)(nil),                // 8: temporal.server.api.adminservice.v1.DescribeTaskSchedulerRequest
+	(*UpdateTaskSchedulerNamespaceWeightRequest)(nil),   // 9: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightRequest
+	(*ListHistoryTasksRequest)(nil),                     // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*GetTaskTraceRequest)(nil),                         // 11: temporal.server.api.adminservice.v1.GetTaskTraceRequest
+	(*ListQueueMitigationsRequest)(nil),                 // 12: temporal.server.api.adminservice.v1.ListQueueMitigationsRequest
+	(*RemoveTaskRequest)(nil),                           // 13: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 14: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetReplicationMessagesRequest)(nil),               // 16: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesRequest)(nil),      // 17: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetDLQReplicationMessagesRequest)(nil),            // 18: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetReplicationLagRequest)(nil),                    // 19: temporal.server.api.adminservice.v1.GetReplicationLagRequest
+	(*ReapplyEventsRequest)(nil),                        // 20: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*AddSearchAttributesRequest)(nil),                  // 21: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*RemoveSearchAttributesRequest)(nil),               // 22: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*GetSearchAttributesRequest)(nil),                  // 23: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*DescribeClusterRequest)(nil),                      // 24: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*ListClustersRequest)(nil),                         // 25: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClusterMembersRequest)(nil),                   // 26: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*AddOrUpdateRemoteClusterRequest)(nil),             // 27: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*RemoveRemoteClusterRequest)(nil),                  // 28: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*GetDLQMessagesRequest)(nil),                       // 29: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*PurgeDLQMessagesRequest)(nil),                     // 30: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*MergeDLQMessagesRequest)(nil),                     // 31: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*RefreshWorkflowTasksRequest)(nil),                 // 32: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*StartAdminBatchOperationRequest)(nil),             // 33: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	(*ResendReplicationTasksRequest)(nil),               // 34: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*GetTaskQueueTasksRequest)(nil),                    // 35: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*DeleteWorkflowExecutionRequest)(nil),              // 36: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 37: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*GetNamespaceRequest)(nil),                         // 38: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*UpdateNamespaceReplicationFilterRequest)(nil),     // 39: temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterRequest
+	(*GetDLQTasksRequest)(nil),                          // 40: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*PurgeDLQTasksRequest)(nil),                        // 41: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*MergeDLQTasksRequest)(nil),                        // 42: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*DescribeDLQJobRequest)(nil),                       // 43: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*CancelDLQJobRequest)(nil),                         // 44: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*AddTasksRequest)(nil),                             // 45: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*ListQueuesRequest)(nil),                           // 46: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*DeepHealthCheckRequest)(nil),                      // 47: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*SyncWorkflowStateRequest)(nil),                    // 48: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 49: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 50: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 51: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*GetTaskQueueUserDataRequest)(nil),                 // 52: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	(*MigrateScheduleRequest)(nil),                      // 53: temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	(*CloneWorkflowExecutionRequest)(nil),               // 54: temporal.server.api.adminservice.v1.CloneWorkflowExecutionRequest
+	(*DryRunResetWorkflowExecutionRequest)(nil),         // 55: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionRequest
+	(*DescribeMutableStateAtEventRequest)(nil),          // 56: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventRequest
+	(*ListWorkflowConflictResolutionsRequest)(nil),      // 57: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsRequest
+	(*StartChainedActivityExecutionRequest)(nil),        // 58: temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest
+	(*DescribeChainedActivityExecutionRequest)(nil),     // 59: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionRequest
+	(*RebuildMutableStateResponse)(nil),                 // 60: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),             // 61: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateResponse)(nil),                // 62: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                 // 63: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                            // 64: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                          // 65: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*MoveHistoryShardResponse)(nil),                    // 66: temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	(*UnpinHistoryShardResponse)(nil),                   // 67: temporal.server.api.adminservice.v1.UnpinHistoryShardResponse
+	(*DescribeTaskSchedulerResponse)(nil),               // 68: temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse
+	(*UpdateTaskSchedulerNamespaceWeightResponse)(nil),  // 69: temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightResponse
+	(*ListHistoryTasksResponse)(nil),                    // 70: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*GetTaskTraceResponse)(nil),                        // 71: temporal.server.api.adminservice.v1.GetTaskTraceResponse
+	(*ListQueueMitigationsResponse)(nil),                // 72: temporal.server.api.adminservice.v1.ListQueueMitigationsResponse
+	(*RemoveTaskResponse)(nil),                          // 73: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 74: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 75: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),              // 76: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 77: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),           // 78: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*GetReplicationLagResponse)(nil),                   // 79: temporal.server.api.adminservice.v1.GetReplicationLagResponse
+	(*ReapplyEventsResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                 // 81: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),              // 82: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                 // 83: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterResponse)(nil),                     // 84: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                        // 85: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                  // 86: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 87: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                 // 88: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                      // 89: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                    // 90: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                    // 91: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                // 92: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*StartAdminBatchOperationResponse)(nil),            // 93: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	(*ResendReplicationTasksResponse)(nil),              // 94: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                   // 95: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionResponse)(nil),             // 96: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 97: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                        // 98: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*UpdateNamespaceReplicationFilterResponse)(nil),    // 99: temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterResponse
+	(*GetDLQTasksResponse)(nil),                         // 100: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                       // 101: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                       // 102: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                      // 103: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                        // 104: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                            // 105: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                          // 106: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                     // 107: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                   // 108: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 109: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 110: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 111: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetTaskQueueUserDataResponse)(nil),                // 112: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	(*MigrateScheduleResponse)(nil),                     // 113: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*CloneWorkflowExecutionResponse)(nil),              // 114: temporal.server.api.adminservice.v1.CloneWorkflowExecutionResponse
+	(*DryRunResetWorkflowExecutionResponse)(nil),        // 115: temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionResponse
+	(*DescribeMutableStateAtEventResponse)(nil),         // 116: temporal.server.api.adminservice.v1.DescribeMutableStateAtEventResponse
+	(*ListWorkflowConflictResolutionsResponse)(nil),     // 117: temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsResponse
+	(*StartChainedActivityExecutionResponse)(nil),       // 118: temporal.server.api.adminservice.v1.StartChainedActivityExecutionResponse
+	(*DescribeChainedActivityExecutionResponse)(nil),    // 119: temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	86, // 86: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	87, // 87: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	88, // 88: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	89, // 89: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	90, // 90: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	91, // 91: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	46, // [46:92] is the sub-list for method output_type
-	0,  // [0:46] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.MoveHistoryShard:input_type -> temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.UnpinHistoryShard:input_type -> temporal.server.api.adminservice.v1.UnpinHistoryShardRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.DescribeTaskScheduler:input_type -> temporal.server.api.adminservice.v1.DescribeTaskSchedulerRequest
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.UpdateTaskSchedulerNamespaceWeight:input_type -> temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.GetTaskTrace:input_type -> temporal.server.api.adminservice.v1.GetTaskTraceRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.ListQueueMitigations:input_type -> temporal.server.api.adminservice.v1.ListQueueMitigationsRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.GetReplicationLag:input_type -> temporal.server.api.adminservice.v1.GetReplicationLagRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.UpdateNamespaceReplicationFilter:input_type -> temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.CloneWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.CloneWorkflowExecutionRequest
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.DryRunResetWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionRequest
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.DescribeMutableStateAtEvent:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateAtEventRequest
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.ListWorkflowConflictResolutions:input_type -> temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsRequest
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.StartChainedActivityExecution:input_type -> temporal.server.api.adminservice.v1.StartChainedActivityExecutionRequest
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.DescribeChainedActivityExecution:input_type -> temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionRequest
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.MoveHistoryShard:output_type -> temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.UnpinHistoryShard:output_type -> temporal.server.api.adminservice.v1.UnpinHistoryShardResponse
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.DescribeTaskScheduler:output_type -> temporal.server.api.adminservice.v1.DescribeTaskSchedulerResponse
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.UpdateTaskSchedulerNamespaceWeight:output_type -> temporal.server.api.adminservice.v1.UpdateTaskSchedulerNamespaceWeightResponse
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.GetTaskTrace:output_type -> temporal.server.api.adminservice.v1.GetTaskTraceResponse
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.ListQueueMitigations:output_type -> temporal.server.api.adminservice.v1.ListQueueMitigationsResponse
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.GetReplicationLag:output_type -> temporal.server.api.adminservice.v1.GetReplicationLagResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.UpdateNamespaceReplicationFilter:output_type -> temporal.server.api.adminservice.v1.UpdateNamespaceReplicationFilterResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	102, // 102: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	103, // 103: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	104, // 104: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	105, // 105: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	106, // 106: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	107, // 107: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	108, // 108: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	109, // 109: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	110, // 110: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	111, // 111: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	112, // 112: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	113, // 113: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	114, // 114: temporal.server.api.adminservice.v1.AdminService.CloneWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.CloneWorkflowExecutionResponse
+	115, // 115: temporal.server.api.adminservice.v1.AdminService.DryRunResetWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DryRunResetWorkflowExecutionResponse
+	116, // 116: temporal.server.api.adminservice.v1.AdminService.DescribeMutableStateAtEvent:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateAtEventResponse
+	117, // 117: temporal.server.api.adminservice.v1.AdminService.ListWorkflowConflictResolutions:output_type -> temporal.server.api.adminservice.v1.ListWorkflowConflictResolutionsResponse
+	118, // 118: temporal.server.api.adminservice.v1.AdminService.StartChainedActivityExecution:output_type -> temporal.server.api.adminservice.v1.StartChainedActivityExecutionResponse
+	119, // 119: temporal.server.api.adminservice.v1.AdminService.DescribeChainedActivityExecution:output_type -> temporal.server.api.adminservice.v1.DescribeChainedActivityExecutionResponse
+	60,  // [60:120] is the sub-list for method output_type
+	0,   // [0:60] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
@@ -26,13 +26,20 @@ const (
 	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
 	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_MoveHistoryShard_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MoveHistoryShard"
+	AdminService_UnpinHistoryShard_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/UnpinHistoryShard"
+	AdminService_DescribeTaskScheduler_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskScheduler"
+	AdminService_UpdateTaskSchedulerNamespaceWeight_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskSchedulerNamespaceWeight"
 	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_GetTaskTrace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetTaskTrace"
+	AdminService_ListQueueMitigations_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListQueueMitigations"
 	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
 	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
 	AdminService_GetWorkflowExecutionRawHistory_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
 	AdminService_GetReplicationMessages_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
 	AdminService_GetNamespaceReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
 	AdminService_GetDLQReplicationMessages_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
+	AdminService_GetReplicationLag_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationLag"
 	AdminService_ReapplyEvents_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
 	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
 	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
@@ -52,6 +59,7 @@ const (
 	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
 	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
 	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
+	AdminService_UpdateNamespaceReplicationFilter_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceReplicationFilter"
 	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
 	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
 	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
@@ -66,6 +74,12 @@ const (
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
 	AdminService_GetTaskQueueUserData_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueUserData"
 	AdminService_MigrateSchedule_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MigrateSchedule"
+	AdminService_CloneWorkflowExecution_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/CloneWorkflowExecution"
+	AdminService_DryRunResetWorkflowExecution_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/DryRunResetWorkflowExecution"
+	AdminService_DescribeMutableStateAtEvent_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableStateAtEvent"
+	AdminService_ListWorkflowConflictResolutions_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/ListWorkflowConflictResolutions"
+	AdminService_StartChainedActivityExecution_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/StartChainedActivityExecution"
+	AdminService_DescribeChainedActivityExecution_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/DescribeChainedActivityExecution"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -84,7 +98,25 @@ type AdminServiceClient interface {
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
+	// MoveHistoryShard pins a history shard to the given history host, which acquires it from its current owner. The
+	// shard stays on that host until it is unpinned with UnpinHistoryShard, or the host leaves the cluster.
+	MoveHistoryShard(ctx context.Context, in *MoveHistoryShardRequest, opts ...grpc.CallOption) (*MoveHistoryShardResponse, error)
+	// UnpinHistoryShard removes the pin of a history shard, moving it back to the history host membership assigns it to.
+	UnpinHistoryShard(ctx context.Context, in *UnpinHistoryShardRequest, opts ...grpc.CallOption) (*UnpinHistoryShardResponse, error)
+	// DescribeTaskScheduler returns the per namespace task channels of the host level task schedulers of history hosts,
+	// with their current weight and backlog.
+	DescribeTaskScheduler(ctx context.Context, in *DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*DescribeTaskSchedulerResponse, error)
+	// UpdateTaskSchedulerNamespaceWeight temporarily boosts or throttles the task processing of a namespace on all
+	// history hosts by multiplying the weights of its task channels. Overrides are kept in memory and are lost when a
+	// history host restarts.
+	UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*UpdateTaskSchedulerNamespaceWeightResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
+	// GetTaskTrace returns the recorded lifecycle of a history task sampled by history.queueTaskTracingSampleRate:
+	// when it was generated, loaded, scheduled, attempted, rescheduled and acked.
+	GetTaskTrace(ctx context.Context, in *GetTaskTraceRequest, opts ...grpc.CallOption) (*GetTaskTraceResponse, error)
+	// ListQueueMitigations returns the actions recently run by the history queues of a shard to mitigate stuck
+	// readers, too many pending tasks or too many slices, as configured by history.queueMitigationPolicy.
+	ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -100,6 +132,9 @@ type AdminServiceClient interface {
 	GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
 	GetDLQReplicationMessages(ctx context.Context, in *GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
+	// GetReplicationLag returns the replication lag, backlog and DLQ size of each remote cluster and namespace, and
+	// whether it's safe to fail over to the remote clusters.
+	GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(ctx context.Context, in *ReapplyEventsRequest, opts ...grpc.CallOption) (*ReapplyEventsResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
@@ -143,6 +178,9 @@ type AdminServiceClient interface {
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
 	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error)
+	// UpdateNamespaceReplicationFilter sets the filter restricting the workflows of a global namespace which are
+	// replicated from the current cluster to the other clusters. It only applies to workflows started afterwards.
+	UpdateNamespaceReplicationFilter(ctx context.Context, in *UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFilterResponse, error)
 	GetDLQTasks(ctx context.Context, in *GetDLQTasksRequest, opts ...grpc.CallOption) (*GetDLQTasksResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
 	//
@@ -161,6 +199,26 @@ type AdminServiceClient interface {
 	GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error)
+	// CloneWorkflowExecution copies the history of a workflow execution, optionally truncated at an event, into a new
+	// workflow ID in the same or a different namespace. The clone is rebuilt from the copied history and its pending
+	// tasks are regenerated, so it can be reset and run without affecting the original execution.
+	CloneWorkflowExecution(ctx context.Context, in *CloneWorkflowExecutionRequest, opts ...grpc.CallOption) (*CloneWorkflowExecutionResponse, error)
+	// DryRunResetWorkflowExecution computes a workflow reset in memory and returns which events would be reapplied or
+	// dropped and which pending work would be re-scheduled, without persisting anything.
+	DryRunResetWorkflowExecution(ctx context.Context, in *DryRunResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*DryRunResetWorkflowExecutionResponse, error)
+	// DescribeMutableStateAtEvent rebuilds the mutable state of a workflow execution in memory from its history up to an
+	// event, and returns it without persisting anything.
+	DescribeMutableStateAtEvent(ctx context.Context, in *DescribeMutableStateAtEventRequest, opts ...grpc.CallOption) (*DescribeMutableStateAtEventResponse, error)
+	// ListWorkflowConflictResolutions returns the most recent conflicts between history branches of a workflow
+	// execution resolved by this cluster, e.g. after a split brain, with the events of the losing branches which were
+	// reapplied or discarded.
+	ListWorkflowConflictResolutions(ctx context.Context, in *ListWorkflowConflictResolutionsRequest, opts ...grpc.CallOption) (*ListWorkflowConflictResolutionsResponse, error)
+	// StartChainedActivityExecution starts a standalone activity execution which runs follow-up activities, in order,
+	// after the activity completes successfully. Any unsuccessful outcome closes the execution.
+	StartChainedActivityExecution(ctx context.Context, in *StartChainedActivityExecutionRequest, opts ...grpc.CallOption) (*StartChainedActivityExecutionResponse, error)
+	// DescribeChainedActivityExecution describes a standalone activity execution like DescribeActivityExecution, with
+	// the progress of its chain if it was started with follow-up activities.
+	DescribeChainedActivityExecution(ctx context.Context, in *DescribeChainedActivityExecutionRequest, opts ...grpc.CallOption) (*DescribeChainedActivityExecutionResponse, error)
 }
 
 type adminServiceClient struct {
@@ -225,6 +283,42 @@ func (c *adminServiceClient) CloseShard(ctx context.Context, in *CloseShardReque
 	return out, nil
 }
 
+func (c *adminServiceClient) MoveHistoryShard(ctx context.Context, in *MoveHistoryShardRequest, opts ...grpc.CallOption) (*MoveHistoryShardResponse, error) {
+	out := new(MoveHistoryShardResponse)
+	err := c.cc.Invoke(ctx, AdminService_MoveHistoryShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpinHistoryShard(ctx context.Context, in *UnpinHistoryShardRequest, opts ...grpc.CallOption) (*UnpinHistoryShardResponse, error) {
+	out := new(UnpinHistoryShardResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnpinHistoryShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskScheduler(ctx context.Context, in *DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*DescribeTaskSchedulerResponse, error) {
+	out := new(DescribeTaskSchedulerResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskScheduler_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	out := new(UpdateTaskSchedulerNamespaceWeightResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskSchedulerNamespaceWeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error) {
 	out := new(ListHistoryTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListHistoryTasks_FullMethodName, in, out, opts...)
@@ -234,6 +328,24 @@ func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHisto
 	return out, nil
 }
 
+func (c *adminServiceClient) GetTaskTrace(ctx context.Context, in *GetTaskTraceRequest, opts ...grpc.CallOption) (*GetTaskTraceResponse, error) {
+	out := new(GetTaskTraceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetTaskTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error) {
+	out := new(ListQueueMitigationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListQueueMitigations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, AdminService_RemoveTask_FullMethodName, in, out, opts...)
@@ -288,6 +400,15 @@ func (c *adminServiceClient) GetDLQReplicationMessages(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminServiceClient) GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error) {
+	out := new(GetReplicationLagResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetReplicationLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ReapplyEvents(ctx context.Context, in *ReapplyEventsRequest, opts ...grpc.CallOption) (*ReapplyEventsResponse, error) {
 	out := new(ReapplyEventsResponse)
 	err := c.cc.Invoke(ctx, AdminService_ReapplyEvents_FullMethodName, in, out, opts...)
@@ -481,6 +602,15 @@ func (c *adminServiceClient) GetNamespace(ctx context.Context, in *GetNamespaceR
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateNamespaceReplicationFilter(ctx context.Context, in *UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFilterResponse, error) {
+	out := new(UpdateNamespaceReplicationFilterResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNamespaceReplicationFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetDLQTasks(ctx context.Context, in *GetDLQTasksRequest, opts ...grpc.CallOption) (*GetDLQTasksResponse, error) {
 	out := new(GetDLQTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetDLQTasks_FullMethodName, in, out, opts...)
@@ -607,6 +737,60 @@ func (c *adminServiceClient) MigrateSchedule(ctx context.Context, in *MigrateSch
 	return out, nil
 }
 
+func (c *adminServiceClient) CloneWorkflowExecution(ctx context.Context, in *CloneWorkflowExecutionRequest, opts ...grpc.CallOption) (*CloneWorkflowExecutionResponse, error) {
+	out := new(CloneWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_CloneWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DryRunResetWorkflowExecution(ctx context.Context, in *DryRunResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*DryRunResetWorkflowExecutionResponse, error) {
+	out := new(DryRunResetWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DryRunResetWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeMutableStateAtEvent(ctx context.Context, in *DescribeMutableStateAtEventRequest, opts ...grpc.CallOption) (*DescribeMutableStateAtEventResponse, error) {
+	out := new(DescribeMutableStateAtEventResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeMutableStateAtEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorkflowConflictResolutions(ctx context.Context, in *ListWorkflowConflictResolutionsRequest, opts ...grpc.CallOption) (*ListWorkflowConflictResolutionsResponse, error) {
+	out := new(ListWorkflowConflictResolutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWorkflowConflictResolutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartChainedActivityExecution(ctx context.Context, in *StartChainedActivityExecutionRequest, opts ...grpc.CallOption) (*StartChainedActivityExecutionResponse, error) {
+	out := new(StartChainedActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartChainedActivityExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeChainedActivityExecution(ctx context.Context, in *DescribeChainedActivityExecutionRequest, opts ...grpc.CallOption) (*DescribeChainedActivityExecutionResponse, error) {
+	out := new(DescribeChainedActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeChainedActivityExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -623,7 +807,25 @@ type AdminServiceServer interface {
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	// MoveHistoryShard pins a history shard to the given history host, which acquires it from its current owner. The
+	// shard stays on that host until it is unpinned with UnpinHistoryShard, or the host leaves the cluster.
+	MoveHistoryShard(context.Context, *MoveHistoryShardRequest) (*MoveHistoryShardResponse, error)
+	// UnpinHistoryShard removes the pin of a history shard, moving it back to the history host membership assigns it to.
+	UnpinHistoryShard(context.Context, *UnpinHistoryShardRequest) (*UnpinHistoryShardResponse, error)
+	// DescribeTaskScheduler returns the per namespace task channels of the host level task schedulers of history hosts,
+	// with their current weight and backlog.
+	DescribeTaskScheduler(context.Context, *DescribeTaskSchedulerRequest) (*DescribeTaskSchedulerResponse, error)
+	// UpdateTaskSchedulerNamespaceWeight temporarily boosts or throttles the task processing of a namespace on all
+	// history hosts by multiplying the weights of its task channels. Overrides are kept in memory and are lost when a
+	// history host restarts.
+	UpdateTaskSchedulerNamespaceWeight(context.Context, *UpdateTaskSchedulerNamespaceWeightRequest) (*UpdateTaskSchedulerNamespaceWeightResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
+	// GetTaskTrace returns the recorded lifecycle of a history task sampled by history.queueTaskTracingSampleRate:
+	// when it was generated, loaded, scheduled, attempted, rescheduled and acked.
+	GetTaskTrace(context.Context, *GetTaskTraceRequest) (*GetTaskTraceResponse, error)
+	// ListQueueMitigations returns the actions recently run by the history queues of a shard to mitigate stuck
+	// readers, too many pending tasks or too many slices, as configured by history.queueMitigationPolicy.
+	ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -639,6 +841,9 @@ type AdminServiceServer interface {
 	GetNamespaceReplicationMessages(context.Context, *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
+	// GetReplicationLag returns the replication lag, backlog and DLQ size of each remote cluster and namespace, and
+	// whether it's safe to fail over to the remote clusters.
+	GetReplicationLag(context.Context, *GetReplicationLagRequest) (*GetReplicationLagResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
@@ -682,6 +887,9 @@ type AdminServiceServer interface {
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
 	GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error)
+	// UpdateNamespaceReplicationFilter sets the filter restricting the workflows of a global namespace which are
+	// replicated from the current cluster to the other clusters. It only applies to workflows started afterwards.
+	UpdateNamespaceReplicationFilter(context.Context, *UpdateNamespaceReplicationFilterRequest) (*UpdateNamespaceReplicationFilterResponse, error)
 	GetDLQTasks(context.Context, *GetDLQTasksRequest) (*GetDLQTasksResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
 	//
@@ -700,6 +908,26 @@ type AdminServiceServer interface {
 	GetTaskQueueUserData(context.Context, *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error)
+	// CloneWorkflowExecution copies the history of a workflow execution, optionally truncated at an event, into a new
+	// workflow ID in the same or a different namespace. The clone is rebuilt from the copied history and its pending
+	// tasks are regenerated, so it can be reset and run without affecting the original execution.
+	CloneWorkflowExecution(context.Context, *CloneWorkflowExecutionRequest) (*CloneWorkflowExecutionResponse, error)
+	// DryRunResetWorkflowExecution computes a workflow reset in memory and returns which events would be reapplied or
+	// dropped and which pending work would be re-scheduled, without persisting anything.
+	DryRunResetWorkflowExecution(context.Context, *DryRunResetWorkflowExecutionRequest) (*DryRunResetWorkflowExecutionResponse, error)
+	// DescribeMutableStateAtEvent rebuilds the mutable state of a workflow execution in memory from its history up to an
+	// event, and returns it without persisting anything.
+	DescribeMutableStateAtEvent(context.Context, *DescribeMutableStateAtEventRequest) (*DescribeMutableStateAtEventResponse, error)
+	// ListWorkflowConflictResolutions returns the most recent conflicts between history branches of a workflow
+	// execution resolved by this cluster, e.g. after a split brain, with the events of the losing branches which were
+	// reapplied or discarded.
+	ListWorkflowConflictResolutions(context.Context, *ListWorkflowConflictResolutionsRequest) (*ListWorkflowConflictResolutionsResponse, error)
+	// StartChainedActivityExecution starts a standalone activity execution which runs follow-up activities, in order,
+	// after the activity completes successfully. Any unsuccessful outcome closes the execution.
+	StartChainedActivityExecution(context.Context, *StartChainedActivityExecutionRequest) (*StartChainedActivityExecutionResponse, error)
+	// DescribeChainedActivityExecution describes a standalone activity execution like DescribeActivityExecution, with
+	// the progress of its chain if it was started with follow-up activities.
+	DescribeChainedActivityExecution(context.Context, *DescribeChainedActivityExecutionRequest) (*DescribeChainedActivityExecutionResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -725,9 +953,27 @@ func (UnimplementedAdminServiceServer) GetShard(context.Context, *GetShardReques
 func (UnimplementedAdminServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
+func (UnimplementedAdminServiceServer) MoveHistoryShard(context.Context, *MoveHistoryShardRequest) (*MoveHistoryShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveHistoryShard not implemented")
+}
+func (UnimplementedAdminServiceServer) UnpinHistoryShard(context.Context, *UnpinHistoryShardRequest) (*UnpinHistoryShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinHistoryShard not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskScheduler(context.Context, *DescribeTaskSchedulerRequest) (*DescribeTaskSchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskScheduler not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateTaskSchedulerNamespaceWeight(context.Context, *UpdateTaskSchedulerNamespaceWeightRequest) (*UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskSchedulerNamespaceWeight not implemented")
+}
 func (UnimplementedAdminServiceServer) ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) GetTaskTrace(context.Context, *GetTaskTraceRequest) (*GetTaskTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskTrace not implemented")
+}
+func (UnimplementedAdminServiceServer) ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueMitigations not implemented")
+}
 func (UnimplementedAdminServiceServer) RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -746,6 +992,9 @@ func (UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(context.C
 func (UnimplementedAdminServiceServer) GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDLQReplicationMessages not implemented")
 }
+func (UnimplementedAdminServiceServer) GetReplicationLag(context.Context, *GetReplicationLagRequest) (*GetReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationLag not implemented")
+}
 func (UnimplementedAdminServiceServer) ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReapplyEvents not implemented")
 }
@@ -803,6 +1052,9 @@ func (UnimplementedAdminServiceServer) StreamWorkflowReplicationMessages(AdminSe
 func (UnimplementedAdminServiceServer) GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateNamespaceReplicationFilter(context.Context, *UpdateNamespaceReplicationFilterRequest) (*UpdateNamespaceReplicationFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceReplicationFilter not implemented")
+}
 func (UnimplementedAdminServiceServer) GetDLQTasks(context.Context, *GetDLQTasksRequest) (*GetDLQTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDLQTasks not implemented")
 }
@@ -845,6 +1097,24 @@ func (UnimplementedAdminServiceServer) GetTaskQueueUserData(context.Context, *Ge
 func (UnimplementedAdminServiceServer) MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSchedule not implemented")
 }
+func (UnimplementedAdminServiceServer) CloneWorkflowExecution(context.Context, *CloneWorkflowExecutionRequest) (*CloneWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) DryRunResetWorkflowExecution(context.Context, *DryRunResetWorkflowExecutionRequest) (*DryRunResetWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunResetWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeMutableStateAtEvent(context.Context, *DescribeMutableStateAtEventRequest) (*DescribeMutableStateAtEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableStateAtEvent not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWorkflowConflictResolutions(context.Context, *ListWorkflowConflictResolutionsRequest) (*ListWorkflowConflictResolutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowConflictResolutions not implemented")
+}
+func (UnimplementedAdminServiceServer) StartChainedActivityExecution(context.Context, *StartChainedActivityExecutionRequest) (*StartChainedActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartChainedActivityExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeChainedActivityExecution(context.Context, *DescribeChainedActivityExecutionRequest) (*DescribeChainedActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeChainedActivityExecution not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -966,6 +1236,78 @@ func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MoveHistoryShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveHistoryShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveHistoryShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MoveHistoryShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveHistoryShard(ctx, req.(*MoveHistoryShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpinHistoryShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinHistoryShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpinHistoryShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnpinHistoryShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpinHistoryShard(ctx, req.(*UnpinHistoryShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskSchedulerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskScheduler_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskScheduler(ctx, req.(*DescribeTaskSchedulerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateTaskSchedulerNamespaceWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskSchedulerNamespaceWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskSchedulerNamespaceWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskSchedulerNamespaceWeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskSchedulerNamespaceWeight(ctx, req.(*UpdateTaskSchedulerNamespaceWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHistoryTasksRequest)
 	if err := dec(in); err != nil {
@@ -984,6 +1326,42 @@ func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetTaskTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTaskTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTaskTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTaskTrace(ctx, req.(*GetTaskTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListQueueMitigations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueMitigationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListQueueMitigations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListQueueMitigations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListQueueMitigations(ctx, req.(*ListQueueMitigationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -1092,6 +1470,24 @@ func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetReplicationLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, req.(*GetReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReapplyEventsRequest)
 	if err := dec(in); err != nil {
@@ -1442,6 +1838,24 @@ func _AdminService_GetNamespace_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateNamespaceReplicationFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceReplicationFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNamespaceReplicationFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilter(ctx, req.(*UpdateNamespaceReplicationFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDLQTasksRequest)
 	if err := dec(in); err != nil {
@@ -1694,6 +2108,114 @@ func _AdminService_MigrateSchedule_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CloneWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloneWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CloneWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloneWorkflowExecution(ctx, req.(*CloneWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DryRunResetWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunResetWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DryRunResetWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DryRunResetWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DryRunResetWorkflowExecution(ctx, req.(*DryRunResetWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeMutableStateAtEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeMutableStateAtEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeMutableStateAtEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeMutableStateAtEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeMutableStateAtEvent(ctx, req.(*DescribeMutableStateAtEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorkflowConflictResolutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowConflictResolutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkflowConflictResolutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWorkflowConflictResolutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkflowConflictResolutions(ctx, req.(*ListWorkflowConflictResolutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartChainedActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartChainedActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartChainedActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartChainedActivityExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartChainedActivityExecution(ctx, req.(*StartChainedActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeChainedActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeChainedActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeChainedActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeChainedActivityExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeChainedActivityExecution(ctx, req.(*DescribeChainedActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1725,10 +2247,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseShard",
 			Handler:    _AdminService_CloseShard_Handler,
 		},
+		{
+			MethodName: "MoveHistoryShard",
+			Handler:    _AdminService_MoveHistoryShard_Handler,
+		},
+		{
+			MethodName: "UnpinHistoryShard",
+			Handler:    _AdminService_UnpinHistoryShard_Handler,
+		},
+		{
+			MethodName: "DescribeTaskScheduler",
+			Handler:    _AdminService_DescribeTaskScheduler_Handler,
+		},
+		{
+			MethodName: "UpdateTaskSchedulerNamespaceWeight",
+			Handler:    _AdminService_UpdateTaskSchedulerNamespaceWeight_Handler,
+		},
 		{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
 		},
+		{
+			MethodName: "GetTaskTrace",
+			Handler:    _AdminService_GetTaskTrace_Handler,
+		},
+		{
+			MethodName: "ListQueueMitigations",
+			Handler:    _AdminService_ListQueueMitigations_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _AdminService_RemoveTask_Handler,
@@ -1753,6 +2299,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDLQReplicationMessages",
 			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
 		},
+		{
+			MethodName: "GetReplicationLag",
+			Handler:    _AdminService_GetReplicationLag_Handler,
+		},
 		{
 			MethodName: "ReapplyEvents",
 			Handler:    _AdminService_ReapplyEvents_Handler,
@@ -1825,6 +2375,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNamespace",
 			Handler:    _AdminService_GetNamespace_Handler,
 		},
+		{
+			MethodName: "UpdateNamespaceReplicationFilter",
+			Handler:    _AdminService_UpdateNamespaceReplicationFilter_Handler,
+		},
 		{
 			MethodName: "GetDLQTasks",
 			Handler:    _AdminService_GetDLQTasks_Handler,
@@ -1881,6 +2435,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateSchedule",
 			Handler:    _AdminService_MigrateSchedule_Handler,
 		},
+		{
+			MethodName: "CloneWorkflowExecution",
+			Handler:    _AdminService_CloneWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DryRunResetWorkflowExecution",
+			Handler:    _AdminService_DryRunResetWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeMutableStateAtEvent",
+			Handler:    _AdminService_DescribeMutableStateAtEvent_Handler,
+		},
+		{
+			MethodName: "ListWorkflowConflictResolutions",
+			Handler:    _AdminService_ListWorkflowConflictResolutions_Handler,
+		},
+		{
+			MethodName: "StartChainedActivityExecution",
+			Handler:    _AdminService_StartChainedActivityExecution_Handler,
+		},
+		{
+			MethodName: "DescribeChainedActivityExecution",
+			Handler:    _AdminService_DescribeChainedActivityExecution_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
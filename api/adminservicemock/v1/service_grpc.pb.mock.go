@@ -123,6 +123,26 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CloneWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) CloneWorkflowExecution(ctx context.Context, in *adminservice.CloneWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.CloneWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CloneWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.CloneWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneWorkflowExecution indicates an expected call of CloneWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) CloneWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).CloneWorkflowExecution), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +203,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteWorkflowExecution), varargs...)
 }
 
+// DescribeChainedActivityExecution mocks base method.
+func (m *MockAdminServiceClient) DescribeChainedActivityExecution(ctx context.Context, in *adminservice.DescribeChainedActivityExecutionRequest, opts ...grpc.CallOption) (*adminservice.DescribeChainedActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeChainedActivityExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeChainedActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeChainedActivityExecution indicates an expected call of DescribeChainedActivityExecution.
+func (mr *MockAdminServiceClientMockRecorder) DescribeChainedActivityExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeChainedActivityExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeChainedActivityExecution), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +303,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeMutableStateAtEvent mocks base method.
+func (m *MockAdminServiceClient) DescribeMutableStateAtEvent(ctx context.Context, in *adminservice.DescribeMutableStateAtEventRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateAtEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMutableStateAtEvent", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateAtEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateAtEvent indicates an expected call of DescribeMutableStateAtEvent.
+func (mr *MockAdminServiceClientMockRecorder) DescribeMutableStateAtEvent(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateAtEvent", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableStateAtEvent), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +343,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeTaskScheduler mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskScheduler(ctx context.Context, in *adminservice.DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskSchedulerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskScheduler", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskSchedulerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskScheduler indicates an expected call of DescribeTaskScheduler.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskScheduler(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskScheduler", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskScheduler), varargs...)
+}
+
+// DryRunResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) DryRunResetWorkflowExecution(ctx context.Context, in *adminservice.DryRunResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DryRunResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DryRunResetWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.DryRunResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunResetWorkflowExecution indicates an expected call of DryRunResetWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) DryRunResetWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DryRunResetWorkflowExecution), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -423,6 +523,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceClient) GetReplicationLag(ctx context.Context, in *adminservice.GetReplicationLagRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationLag", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationLag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationLag), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,6 +643,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueUserData(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueUserData), varargs...)
 }
 
+// GetTaskTrace mocks base method.
+func (m *MockAdminServiceClient) GetTaskTrace(ctx context.Context, in *adminservice.GetTaskTraceRequest, opts ...grpc.CallOption) (*adminservice.GetTaskTraceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskTrace", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetTaskTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskTrace indicates an expected call of GetTaskTrace.
+func (mr *MockAdminServiceClientMockRecorder) GetTaskTrace(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskTrace", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskTrace), varargs...)
+}
+
 // GetWorkflowExecutionRawHistory mocks base method.
 func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistory(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +783,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockAdminServiceClient) ListQueueMitigations(ctx context.Context, in *adminservice.ListQueueMitigationsRequest, opts ...grpc.CallOption) (*adminservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQueueMitigations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockAdminServiceClientMockRecorder) ListQueueMitigations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueueMitigations), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +823,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListWorkflowConflictResolutions mocks base method.
+func (m *MockAdminServiceClient) ListWorkflowConflictResolutions(ctx context.Context, in *adminservice.ListWorkflowConflictResolutionsRequest, opts ...grpc.CallOption) (*adminservice.ListWorkflowConflictResolutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkflowConflictResolutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListWorkflowConflictResolutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowConflictResolutions indicates an expected call of ListWorkflowConflictResolutions.
+func (mr *MockAdminServiceClientMockRecorder) ListWorkflowConflictResolutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowConflictResolutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ListWorkflowConflictResolutions), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +903,26 @@ func (mr *MockAdminServiceClientMockRecorder) MigrateSchedule(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).MigrateSchedule), varargs...)
 }
 
+// MoveHistoryShard mocks base method.
+func (m *MockAdminServiceClient) MoveHistoryShard(ctx context.Context, in *adminservice.MoveHistoryShardRequest, opts ...grpc.CallOption) (*adminservice.MoveHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveHistoryShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveHistoryShard indicates an expected call of MoveHistoryShard.
+func (mr *MockAdminServiceClientMockRecorder) MoveHistoryShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveHistoryShard", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveHistoryShard), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -923,6 +1123,26 @@ func (mr *MockAdminServiceClientMockRecorder) StartAdminBatchOperation(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartAdminBatchOperation), varargs...)
 }
 
+// StartChainedActivityExecution mocks base method.
+func (m *MockAdminServiceClient) StartChainedActivityExecution(ctx context.Context, in *adminservice.StartChainedActivityExecutionRequest, opts ...grpc.CallOption) (*adminservice.StartChainedActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartChainedActivityExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartChainedActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartChainedActivityExecution indicates an expected call of StartChainedActivityExecution.
+func (mr *MockAdminServiceClientMockRecorder) StartChainedActivityExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartChainedActivityExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).StartChainedActivityExecution), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -963,6 +1183,66 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UnpinHistoryShard mocks base method.
+func (m *MockAdminServiceClient) UnpinHistoryShard(ctx context.Context, in *adminservice.UnpinHistoryShardRequest, opts ...grpc.CallOption) (*adminservice.UnpinHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpinHistoryShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpinHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinHistoryShard indicates an expected call of UnpinHistoryShard.
+func (mr *MockAdminServiceClientMockRecorder) UnpinHistoryShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinHistoryShard", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpinHistoryShard), varargs...)
+}
+
+// UpdateNamespaceReplicationFilter mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceReplicationFilter(ctx context.Context, in *adminservice.UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilter", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceReplicationFilter indicates an expected call of UpdateNamespaceReplicationFilter.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceReplicationFilter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilter", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceReplicationFilter), varargs...)
+}
+
+// UpdateTaskSchedulerNamespaceWeight mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *adminservice.UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskSchedulerNamespaceWeight", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskSchedulerNamespaceWeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSchedulerNamespaceWeight indicates an expected call of UpdateTaskSchedulerNamespaceWeight.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskSchedulerNamespaceWeight(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSchedulerNamespaceWeight", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskSchedulerNamespaceWeight), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1185,6 +1465,21 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CloneWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) CloneWorkflowExecution(arg0 context.Context, arg1 *adminservice.CloneWorkflowExecutionRequest) (*adminservice.CloneWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CloneWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneWorkflowExecution indicates an expected call of CloneWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) CloneWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).CloneWorkflowExecution), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1525,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
 }
 
+// DescribeChainedActivityExecution mocks base method.
+func (m *MockAdminServiceServer) DescribeChainedActivityExecution(arg0 context.Context, arg1 *adminservice.DescribeChainedActivityExecutionRequest) (*adminservice.DescribeChainedActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeChainedActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeChainedActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeChainedActivityExecution indicates an expected call of DescribeChainedActivityExecution.
+func (mr *MockAdminServiceServerMockRecorder) DescribeChainedActivityExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeChainedActivityExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeChainedActivityExecution), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -1290,6 +1600,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeMutableStateAtEvent mocks base method.
+func (m *MockAdminServiceServer) DescribeMutableStateAtEvent(arg0 context.Context, arg1 *adminservice.DescribeMutableStateAtEventRequest) (*adminservice.DescribeMutableStateAtEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableStateAtEvent", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateAtEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableStateAtEvent indicates an expected call of DescribeMutableStateAtEvent.
+func (mr *MockAdminServiceServerMockRecorder) DescribeMutableStateAtEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableStateAtEvent", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableStateAtEvent), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1305,6 +1630,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeTaskScheduler mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskScheduler(arg0 context.Context, arg1 *adminservice.DescribeTaskSchedulerRequest) (*adminservice.DescribeTaskSchedulerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskScheduler", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskSchedulerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskScheduler indicates an expected call of DescribeTaskScheduler.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskScheduler(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskScheduler", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskScheduler), arg0, arg1)
+}
+
+// DryRunResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) DryRunResetWorkflowExecution(arg0 context.Context, arg1 *adminservice.DryRunResetWorkflowExecutionRequest) (*adminservice.DryRunResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunResetWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DryRunResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunResetWorkflowExecution indicates an expected call of DryRunResetWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) DryRunResetWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DryRunResetWorkflowExecution), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1410,6 +1765,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceServer) GetReplicationLag(arg0 context.Context, arg1 *adminservice.GetReplicationLagRequest) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationLag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationLag), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1485,6 +1855,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueUserData(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueUserData), arg0, arg1)
 }
 
+// GetTaskTrace mocks base method.
+func (m *MockAdminServiceServer) GetTaskTrace(arg0 context.Context, arg1 *adminservice.GetTaskTraceRequest) (*adminservice.GetTaskTraceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskTrace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetTaskTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskTrace indicates an expected call of GetTaskTrace.
+func (mr *MockAdminServiceServerMockRecorder) GetTaskTrace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskTrace", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskTrace), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistory mocks base method.
 func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistory(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryRequest) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -1575,6 +1960,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockAdminServiceServer) ListQueueMitigations(arg0 context.Context, arg1 *adminservice.ListQueueMitigationsRequest) (*adminservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMitigations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockAdminServiceServerMockRecorder) ListQueueMitigations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueueMitigations), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1590,6 +1990,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListWorkflowConflictResolutions mocks base method.
+func (m *MockAdminServiceServer) ListWorkflowConflictResolutions(arg0 context.Context, arg1 *adminservice.ListWorkflowConflictResolutionsRequest) (*adminservice.ListWorkflowConflictResolutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowConflictResolutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListWorkflowConflictResolutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowConflictResolutions indicates an expected call of ListWorkflowConflictResolutions.
+func (mr *MockAdminServiceServerMockRecorder) ListWorkflowConflictResolutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowConflictResolutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ListWorkflowConflictResolutions), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1635,6 +2050,21 @@ func (mr *MockAdminServiceServerMockRecorder) MigrateSchedule(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).MigrateSchedule), arg0, arg1)
 }
 
+// MoveHistoryShard mocks base method.
+func (m *MockAdminServiceServer) MoveHistoryShard(arg0 context.Context, arg1 *adminservice.MoveHistoryShardRequest) (*adminservice.MoveHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveHistoryShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveHistoryShard indicates an expected call of MoveHistoryShard.
+func (mr *MockAdminServiceServerMockRecorder) MoveHistoryShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveHistoryShard", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveHistoryShard), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1785,6 +2215,21 @@ func (mr *MockAdminServiceServerMockRecorder) StartAdminBatchOperation(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartAdminBatchOperation), arg0, arg1)
 }
 
+// StartChainedActivityExecution mocks base method.
+func (m *MockAdminServiceServer) StartChainedActivityExecution(arg0 context.Context, arg1 *adminservice.StartChainedActivityExecutionRequest) (*adminservice.StartChainedActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartChainedActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartChainedActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartChainedActivityExecution indicates an expected call of StartChainedActivityExecution.
+func (mr *MockAdminServiceServerMockRecorder) StartChainedActivityExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartChainedActivityExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).StartChainedActivityExecution), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1814,6 +2259,51 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UnpinHistoryShard mocks base method.
+func (m *MockAdminServiceServer) UnpinHistoryShard(arg0 context.Context, arg1 *adminservice.UnpinHistoryShardRequest) (*adminservice.UnpinHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinHistoryShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpinHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinHistoryShard indicates an expected call of UnpinHistoryShard.
+func (mr *MockAdminServiceServerMockRecorder) UnpinHistoryShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinHistoryShard", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpinHistoryShard), arg0, arg1)
+}
+
+// UpdateNamespaceReplicationFilter mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceReplicationFilter(arg0 context.Context, arg1 *adminservice.UpdateNamespaceReplicationFilterRequest) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilter", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceReplicationFilter indicates an expected call of UpdateNamespaceReplicationFilter.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceReplicationFilter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilter", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceReplicationFilter), arg0, arg1)
+}
+
+// UpdateTaskSchedulerNamespaceWeight mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskSchedulerNamespaceWeight(arg0 context.Context, arg1 *adminservice.UpdateTaskSchedulerNamespaceWeightRequest) (*adminservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskSchedulerNamespaceWeight", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskSchedulerNamespaceWeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSchedulerNamespaceWeight indicates an expected call of UpdateTaskSchedulerNamespaceWeight.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskSchedulerNamespaceWeight(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSchedulerNamespaceWeight", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskSchedulerNamespaceWeight), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -127,7 +128,9 @@ type HistoryDLQTask struct {
 	state    protoimpl.MessageState  `protogen:"open.v1"`
 	Metadata *HistoryDLQTaskMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// This is named payload to prevent stuttering (e.g. task.Task).
-	Payload       *HistoryTask `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Payload *HistoryTask `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// failure is the error which caused the task to be moved to the DLQ. It is empty if the error was not recorded.
+	Failure       string `protobuf:"bytes,3,opt,name=failure,proto3" json:"failure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -176,6 +179,87 @@ func (x *HistoryDLQTask) GetPayload() *HistoryTask {
 	return nil
 }
 
+func (x *HistoryDLQTask) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
+// HistoryDLQTaskFilter selects tasks of a history DLQ. A task matches the filter if it matches all the non-empty fields
+// of the filter, so an empty filter matches every task.
+type HistoryDLQTaskFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace_ids matches tasks of any of these namespaces.
+	NamespaceIds []string `protobuf:"bytes,1,rep,name=namespace_ids,json=namespaceIds,proto3" json:"namespace_ids,omitempty"`
+	// workflow_id_prefix matches tasks of workflows whose ID starts with this prefix.
+	WorkflowIdPrefix string `protobuf:"bytes,2,opt,name=workflow_id_prefix,json=workflowIdPrefix,proto3" json:"workflow_id_prefix,omitempty"`
+	// task_types matches tasks of any of these types.
+	TaskTypes []v11.TaskType `protobuf:"varint,3,rep,packed,name=task_types,json=taskTypes,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_types,omitempty"`
+	// failure_contains matches tasks whose failure contains this substring. Tasks without a recorded failure don't match.
+	FailureContains string `protobuf:"bytes,4,opt,name=failure_contains,json=failureContains,proto3" json:"failure_contains,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HistoryDLQTaskFilter) Reset() {
+	*x = HistoryDLQTaskFilter{}
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryDLQTaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryDLQTaskFilter) ProtoMessage() {}
+
+func (x *HistoryDLQTaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryDLQTaskFilter.ProtoReflect.Descriptor instead.
+func (*HistoryDLQTaskFilter) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HistoryDLQTaskFilter) GetNamespaceIds() []string {
+	if x != nil {
+		return x.NamespaceIds
+	}
+	return nil
+}
+
+func (x *HistoryDLQTaskFilter) GetWorkflowIdPrefix() string {
+	if x != nil {
+		return x.WorkflowIdPrefix
+	}
+	return ""
+}
+
+func (x *HistoryDLQTaskFilter) GetTaskTypes() []v11.TaskType {
+	if x != nil {
+		return x.TaskTypes
+	}
+	return nil
+}
+
+func (x *HistoryDLQTaskFilter) GetFailureContains() string {
+	if x != nil {
+		return x.FailureContains
+	}
+	return ""
+}
+
 // HistoryDLQKey is a compound key that identifies a history DLQ.
 type HistoryDLQKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -195,7 +279,7 @@ type HistoryDLQKey struct {
 
 func (x *HistoryDLQKey) Reset() {
 	*x = HistoryDLQKey{}
-	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[3]
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +291,7 @@ func (x *HistoryDLQKey) String() string {
 func (*HistoryDLQKey) ProtoMessage() {}
 
 func (x *HistoryDLQKey) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[3]
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +304,7 @@ func (x *HistoryDLQKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryDLQKey.ProtoReflect.Descriptor instead.
 func (*HistoryDLQKey) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HistoryDLQKey) GetTaskCategory() int32 {
@@ -248,16 +332,23 @@ var File_temporal_server_api_common_v1_dlq_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_common_v1_dlq_proto_rawDesc = "" +
 	"\n" +
-	"'temporal/server/api/common/v1/dlq.proto\x12\x1dtemporal.server.api.common.v1\x1a$temporal/api/common/v1/message.proto\"^\n" +
+	"'temporal/server/api/common/v1/dlq.proto\x12\x1dtemporal.server.api.common.v1\x1a$temporal/api/common/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\"^\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
 	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"7\n" +
 	"\x16HistoryDLQTaskMetadata\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\x03R\tmessageId\"\xa9\x01\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\"\xc3\x01\n" +
 	"\x0eHistoryDLQTask\x12Q\n" +
 	"\bmetadata\x18\x01 \x01(\v25.temporal.server.api.common.v1.HistoryDLQTaskMetadataR\bmetadata\x12D\n" +
-	"\apayload\x18\x02 \x01(\v2*.temporal.server.api.common.v1.HistoryTaskR\apayload\"\x82\x01\n" +
+	"\apayload\x18\x02 \x01(\v2*.temporal.server.api.common.v1.HistoryTaskR\apayload\x12\x18\n" +
+	"\afailure\x18\x03 \x01(\tR\afailure\"\xdb\x01\n" +
+	"\x14HistoryDLQTaskFilter\x12#\n" +
+	"\rnamespace_ids\x18\x01 \x03(\tR\fnamespaceIds\x12,\n" +
+	"\x12workflow_id_prefix\x18\x02 \x01(\tR\x10workflowIdPrefix\x12E\n" +
+	"\n" +
+	"task_types\x18\x03 \x03(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\ttaskTypes\x12)\n" +
+	"\x10failure_contains\x18\x04 \x01(\tR\x0ffailureContains\"\x82\x01\n" +
 	"\rHistoryDLQKey\x12#\n" +
 	"\rtask_category\x18\x01 \x01(\x05R\ftaskCategory\x12%\n" +
 	"\x0esource_cluster\x18\x02 \x01(\tR\rsourceCluster\x12%\n" +
@@ -275,23 +366,26 @@ func file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_common_v1_dlq_proto_rawDescData
 }
 
-var file_temporal_server_api_common_v1_dlq_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_temporal_server_api_common_v1_dlq_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_temporal_server_api_common_v1_dlq_proto_goTypes = []any{
 	(*HistoryTask)(nil),            // 0: temporal.server.api.common.v1.HistoryTask
 	(*HistoryDLQTaskMetadata)(nil), // 1: temporal.server.api.common.v1.HistoryDLQTaskMetadata
 	(*HistoryDLQTask)(nil),         // 2: temporal.server.api.common.v1.HistoryDLQTask
-	(*HistoryDLQKey)(nil),          // 3: temporal.server.api.common.v1.HistoryDLQKey
-	(*v1.DataBlob)(nil),            // 4: temporal.api.common.v1.DataBlob
+	(*HistoryDLQTaskFilter)(nil),   // 3: temporal.server.api.common.v1.HistoryDLQTaskFilter
+	(*HistoryDLQKey)(nil),          // 4: temporal.server.api.common.v1.HistoryDLQKey
+	(*v1.DataBlob)(nil),            // 5: temporal.api.common.v1.DataBlob
+	(v11.TaskType)(0),              // 6: temporal.server.api.enums.v1.TaskType
 }
 var file_temporal_server_api_common_v1_dlq_proto_depIdxs = []int32{
-	4, // 0: temporal.server.api.common.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	5, // 0: temporal.server.api.common.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
 	1, // 1: temporal.server.api.common.v1.HistoryDLQTask.metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
 	0, // 2: temporal.server.api.common.v1.HistoryDLQTask.payload:type_name -> temporal.server.api.common.v1.HistoryTask
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 3: temporal.server.api.common.v1.HistoryDLQTaskFilter.task_types:type_name -> temporal.server.api.enums.v1.TaskType
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_common_v1_dlq_proto_init() }
@@ -305,7 +399,7 @@ func file_temporal_server_api_common_v1_dlq_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_common_v1_dlq_proto_rawDesc), len(file_temporal_server_api_common_v1_dlq_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -43,3 +43,22 @@ func DLQOperationStateFromString(s string) (DLQOperationState, error) {
 	}
 	return DLQOperationState(0), fmt.Errorf("%s is not a valid DLQOperationState", s)
 }
+
+var (
+	DLQTaskOutcome_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Merged":      1,
+		"Purged":      2,
+	}
+)
+
+// DLQTaskOutcomeFromString parses a DLQTaskOutcome value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to DLQTaskOutcome
+func DLQTaskOutcomeFromString(s string) (DLQTaskOutcome, error) {
+	if v, ok := DLQTaskOutcome_value[s]; ok {
+		return DLQTaskOutcome(v), nil
+	} else if v, ok := DLQTaskOutcome_shorthandValue[s]; ok {
+		return DLQTaskOutcome(v), nil
+	}
+	return DLQTaskOutcome(0), fmt.Errorf("%s is not a valid DLQTaskOutcome", s)
+}
@@ -146,6 +146,67 @@ func (DLQOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP(), []int{1}
 }
 
+type DLQTaskOutcome int32
+
+const (
+	DLQ_TASK_OUTCOME_UNSPECIFIED DLQTaskOutcome = 0
+	// The task was re-enqueued and removed from the DLQ.
+	DLQ_TASK_OUTCOME_MERGED DLQTaskOutcome = 1
+	// The task was removed from the DLQ without being re-enqueued.
+	DLQ_TASK_OUTCOME_PURGED DLQTaskOutcome = 2
+)
+
+// Enum value maps for DLQTaskOutcome.
+var (
+	DLQTaskOutcome_name = map[int32]string{
+		0: "DLQ_TASK_OUTCOME_UNSPECIFIED",
+		1: "DLQ_TASK_OUTCOME_MERGED",
+		2: "DLQ_TASK_OUTCOME_PURGED",
+	}
+	DLQTaskOutcome_value = map[string]int32{
+		"DLQ_TASK_OUTCOME_UNSPECIFIED": 0,
+		"DLQ_TASK_OUTCOME_MERGED":      1,
+		"DLQ_TASK_OUTCOME_PURGED":      2,
+	}
+)
+
+func (x DLQTaskOutcome) Enum() *DLQTaskOutcome {
+	p := new(DLQTaskOutcome)
+	*p = x
+	return p
+}
+
+func (x DLQTaskOutcome) String() string {
+	switch x {
+	case DLQ_TASK_OUTCOME_UNSPECIFIED:
+		return "DlqTaskOutcomeUnspecified"
+	case DLQ_TASK_OUTCOME_MERGED:
+		return "DlqTaskOutcomeMerged"
+	case DLQ_TASK_OUTCOME_PURGED:
+		return "DlqTaskOutcomePurged"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (DLQTaskOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_dlq_proto_enumTypes[2].Descriptor()
+}
+
+func (DLQTaskOutcome) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_dlq_proto_enumTypes[2]
+}
+
+func (x DLQTaskOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DLQTaskOutcome.Descriptor instead.
+func (DLQTaskOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP(), []int{2}
+}
+
 var File_temporal_server_api_enums_v1_dlq_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_dlq_proto_rawDesc = "" +
@@ -159,7 +220,11 @@ const file_temporal_server_api_enums_v1_dlq_proto_rawDesc = "" +
 	"\x1fDLQ_OPERATION_STATE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bDLQ_OPERATION_STATE_RUNNING\x10\x01\x12!\n" +
 	"\x1dDLQ_OPERATION_STATE_COMPLETED\x10\x02\x12\x1e\n" +
-	"\x1aDLQ_OPERATION_STATE_FAILED\x10\x03B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"\x1aDLQ_OPERATION_STATE_FAILED\x10\x03*l\n" +
+	"\x0eDLQTaskOutcome\x12 \n" +
+	"\x1cDLQ_TASK_OUTCOME_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DLQ_TASK_OUTCOME_MERGED\x10\x01\x12\x1b\n" +
+	"\x17DLQ_TASK_OUTCOME_PURGED\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_dlq_proto_rawDescOnce sync.Once
@@ -173,10 +238,11 @@ func file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_dlq_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_dlq_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_dlq_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_dlq_proto_goTypes = []any{
 	(DLQOperationType)(0),  // 0: temporal.server.api.enums.v1.DLQOperationType
 	(DLQOperationState)(0), // 1: temporal.server.api.enums.v1.DLQOperationState
+	(DLQTaskOutcome)(0),    // 2: temporal.server.api.enums.v1.DLQTaskOutcome
 }
 var file_temporal_server_api_enums_v1_dlq_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -196,7 +262,7 @@ func file_temporal_server_api_enums_v1_dlq_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_dlq_proto_rawDesc), len(file_temporal_server_api_enums_v1_dlq_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -72,3 +72,41 @@ func ReplicationFlowControlCommandFromString(s string) (ReplicationFlowControlCo
 	}
 	return ReplicationFlowControlCommand(0), fmt.Errorf("%s is not a valid ReplicationFlowControlCommand", s)
 }
+
+var (
+	ReplicationStreamCompression_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Gzip":        1,
+		"Zstd":        2,
+	}
+)
+
+// ReplicationStreamCompressionFromString parses a ReplicationStreamCompression value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ReplicationStreamCompression
+func ReplicationStreamCompressionFromString(s string) (ReplicationStreamCompression, error) {
+	if v, ok := ReplicationStreamCompression_value[s]; ok {
+		return ReplicationStreamCompression(v), nil
+	} else if v, ok := ReplicationStreamCompression_shorthandValue[s]; ok {
+		return ReplicationStreamCompression(v), nil
+	}
+	return ReplicationStreamCompression(0), fmt.Errorf("%s is not a valid ReplicationStreamCompression", s)
+}
+
+var (
+	ConflictResolutionType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Rebuild":     1,
+		"Backfill":    2,
+	}
+)
+
+// ConflictResolutionTypeFromString parses a ConflictResolutionType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ConflictResolutionType
+func ConflictResolutionTypeFromString(s string) (ConflictResolutionType, error) {
+	if v, ok := ConflictResolutionType_value[s]; ok {
+		return ConflictResolutionType(v), nil
+	} else if v, ok := ConflictResolutionType_shorthandValue[s]; ok {
+		return ConflictResolutionType(v), nil
+	}
+	return ConflictResolutionType(0), fmt.Errorf("%s is not a valid ConflictResolutionType", s)
+}
@@ -257,6 +257,126 @@ func (ReplicationFlowControlCommand) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP(), []int{2}
 }
 
+type ReplicationStreamCompression int32
+
+const (
+	REPLICATION_STREAM_COMPRESSION_UNSPECIFIED ReplicationStreamCompression = 0
+	REPLICATION_STREAM_COMPRESSION_GZIP        ReplicationStreamCompression = 1
+	REPLICATION_STREAM_COMPRESSION_ZSTD        ReplicationStreamCompression = 2
+)
+
+// Enum value maps for ReplicationStreamCompression.
+var (
+	ReplicationStreamCompression_name = map[int32]string{
+		0: "REPLICATION_STREAM_COMPRESSION_UNSPECIFIED",
+		1: "REPLICATION_STREAM_COMPRESSION_GZIP",
+		2: "REPLICATION_STREAM_COMPRESSION_ZSTD",
+	}
+	ReplicationStreamCompression_value = map[string]int32{
+		"REPLICATION_STREAM_COMPRESSION_UNSPECIFIED": 0,
+		"REPLICATION_STREAM_COMPRESSION_GZIP":        1,
+		"REPLICATION_STREAM_COMPRESSION_ZSTD":        2,
+	}
+)
+
+func (x ReplicationStreamCompression) Enum() *ReplicationStreamCompression {
+	p := new(ReplicationStreamCompression)
+	*p = x
+	return p
+}
+
+func (x ReplicationStreamCompression) String() string {
+	switch x {
+	case REPLICATION_STREAM_COMPRESSION_UNSPECIFIED:
+		return "Unspecified"
+	case REPLICATION_STREAM_COMPRESSION_GZIP:
+		return "Gzip"
+	case REPLICATION_STREAM_COMPRESSION_ZSTD:
+		return "Zstd"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ReplicationStreamCompression) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_replication_proto_enumTypes[3].Descriptor()
+}
+
+func (ReplicationStreamCompression) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_replication_proto_enumTypes[3]
+}
+
+func (x ReplicationStreamCompression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicationStreamCompression.Descriptor instead.
+func (ReplicationStreamCompression) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP(), []int{3}
+}
+
+type ConflictResolutionType int32
+
+const (
+	CONFLICT_RESOLUTION_TYPE_UNSPECIFIED ConflictResolutionType = 0
+	// The current branch lost: the mutable state was rebuilt from the incoming branch, which became the current branch.
+	CONFLICT_RESOLUTION_TYPE_REBUILD ConflictResolutionType = 1
+	// The incoming branch lost: its events were backfilled to a non-current branch.
+	CONFLICT_RESOLUTION_TYPE_BACKFILL ConflictResolutionType = 2
+)
+
+// Enum value maps for ConflictResolutionType.
+var (
+	ConflictResolutionType_name = map[int32]string{
+		0: "CONFLICT_RESOLUTION_TYPE_UNSPECIFIED",
+		1: "CONFLICT_RESOLUTION_TYPE_REBUILD",
+		2: "CONFLICT_RESOLUTION_TYPE_BACKFILL",
+	}
+	ConflictResolutionType_value = map[string]int32{
+		"CONFLICT_RESOLUTION_TYPE_UNSPECIFIED": 0,
+		"CONFLICT_RESOLUTION_TYPE_REBUILD":     1,
+		"CONFLICT_RESOLUTION_TYPE_BACKFILL":    2,
+	}
+)
+
+func (x ConflictResolutionType) Enum() *ConflictResolutionType {
+	p := new(ConflictResolutionType)
+	*p = x
+	return p
+}
+
+func (x ConflictResolutionType) String() string {
+	switch x {
+	case CONFLICT_RESOLUTION_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case CONFLICT_RESOLUTION_TYPE_REBUILD:
+		return "Rebuild"
+	case CONFLICT_RESOLUTION_TYPE_BACKFILL:
+		return "Backfill"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ConflictResolutionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_replication_proto_enumTypes[4].Descriptor()
+}
+
+func (ConflictResolutionType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_replication_proto_enumTypes[4]
+}
+
+func (x ConflictResolutionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictResolutionType.Descriptor instead.
+func (ConflictResolutionType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP(), []int{4}
+}
+
 var File_temporal_server_api_enums_v1_replication_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_replication_proto_rawDesc = "" +
@@ -285,7 +405,15 @@ const file_temporal_server_api_enums_v1_replication_proto_rawDesc = "" +
 	"\x1dReplicationFlowControlCommand\x120\n" +
 	",REPLICATION_FLOW_CONTROL_COMMAND_UNSPECIFIED\x10\x00\x12+\n" +
 	"'REPLICATION_FLOW_CONTROL_COMMAND_RESUME\x10\x01\x12*\n" +
-	"&REPLICATION_FLOW_CONTROL_COMMAND_PAUSE\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"&REPLICATION_FLOW_CONTROL_COMMAND_PAUSE\x10\x02*\xa0\x01\n" +
+	"\x1cReplicationStreamCompression\x12.\n" +
+	"*REPLICATION_STREAM_COMPRESSION_UNSPECIFIED\x10\x00\x12'\n" +
+	"#REPLICATION_STREAM_COMPRESSION_GZIP\x10\x01\x12'\n" +
+	"#REPLICATION_STREAM_COMPRESSION_ZSTD\x10\x02*\x8f\x01\n" +
+	"\x16ConflictResolutionType\x12(\n" +
+	"$CONFLICT_RESOLUTION_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" CONFLICT_RESOLUTION_TYPE_REBUILD\x10\x01\x12%\n" +
+	"!CONFLICT_RESOLUTION_TYPE_BACKFILL\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_replication_proto_rawDescOnce sync.Once
@@ -299,11 +427,13 @@ func file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_replication_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_replication_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_replication_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_replication_proto_goTypes = []any{
 	(ReplicationTaskType)(0),           // 0: temporal.server.api.enums.v1.ReplicationTaskType
 	(NamespaceOperation)(0),            // 1: temporal.server.api.enums.v1.NamespaceOperation
 	(ReplicationFlowControlCommand)(0), // 2: temporal.server.api.enums.v1.ReplicationFlowControlCommand
+	(ReplicationStreamCompression)(0),  // 3: temporal.server.api.enums.v1.ReplicationStreamCompression
+	(ConflictResolutionType)(0),        // 4: temporal.server.api.enums.v1.ConflictResolutionType
 }
 var file_temporal_server_api_enums_v1_replication_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -323,7 +453,7 @@ func file_temporal_server_api_enums_v1_replication_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_replication_proto_rawDesc), len(file_temporal_server_api_enums_v1_replication_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
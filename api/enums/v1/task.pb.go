@@ -132,6 +132,9 @@ const (
 	TASK_TYPE_WORKER_COMMANDS TaskType = 35
 	// A timer task that fires when an elapsed-duration time-skipping bound is reached.
 	TASK_TYPE_TIMESKIPPING_TIMER TaskType = 36
+	// A transfer task that publishes the history events of a closed workflow execution to the history event feed of its
+	// namespace.
+	TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED TaskType = 37
 )
 
 // Enum value maps for TaskType.
@@ -171,6 +174,7 @@ var (
 		34: "TASK_TYPE_REPLICATION_DELETE_EXECUTION",
 		35: "TASK_TYPE_WORKER_COMMANDS",
 		36: "TASK_TYPE_TIMESKIPPING_TIMER",
+		37: "TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED",
 	}
 	TaskType_value = map[string]int32{
 		"TASK_TYPE_UNSPECIFIED":                           0,
@@ -207,6 +211,7 @@ var (
 		"TASK_TYPE_REPLICATION_DELETE_EXECUTION":          34,
 		"TASK_TYPE_WORKER_COMMANDS":                       35,
 		"TASK_TYPE_TIMESKIPPING_TIMER":                    36,
+		"TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED":   37,
 	}
 )
 
@@ -296,6 +301,8 @@ func (x TaskType) String() string {
 		return "WorkerCommands"
 	case TASK_TYPE_TIMESKIPPING_TIMER:
 		return "TimeskippingTimer"
+	case TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED:
+		return "TransferPublishHistoryEventFeed"
 	default:
 		return strconv.Itoa(int(x))
 	}
@@ -385,7 +392,7 @@ const file_temporal_server_api_enums_v1_task_proto_rawDesc = "" +
 	"TaskSource\x12\x1b\n" +
 	"\x17TASK_SOURCE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TASK_SOURCE_HISTORY\x10\x01\x12\x1a\n" +
-	"\x16TASK_SOURCE_DB_BACKLOG\x10\x02*\xa3\n" +
+	"\x16TASK_SOURCE_DB_BACKLOG\x10\x02*\xd6\n" +
 	"\n" +
 	"\bTaskType\x12\x19\n" +
 	"\x15TASK_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
@@ -422,7 +429,8 @@ const file_temporal_server_api_enums_v1_task_proto_rawDesc = "" +
 	"\x0fTASK_TYPE_CHASM\x10!\x12*\n" +
 	"&TASK_TYPE_REPLICATION_DELETE_EXECUTION\x10\"\x12\x1d\n" +
 	"\x19TASK_TYPE_WORKER_COMMANDS\x10#\x12 \n" +
-	"\x1cTASK_TYPE_TIMESKIPPING_TIMER\x10$\"\x04\b\t\x10\t\"\x04\b\v\x10\v\"\x04\b\x17\x10\x17*\\\n" +
+	"\x1cTASK_TYPE_TIMESKIPPING_TIMER\x10$\x121\n" +
+	"-TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED\x10%\"\x04\b\t\x10\t\"\x04\b\v\x10\v\"\x04\b\x17\x10\x17*\\\n" +
 	"\fTaskPriority\x12\x1d\n" +
 	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12TASK_PRIORITY_HIGH\x10\x01\x12\x15\n" +
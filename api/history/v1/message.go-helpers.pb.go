@@ -300,3 +300,262 @@ func (this *StrippedHistoryEvents) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ResetDryRunResult to the protobuf v3 wire format
+func (val *ResetDryRunResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetDryRunResult from the protobuf v3 wire format
+func (val *ResetDryRunResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetDryRunResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetDryRunResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetDryRunResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetDryRunResult
+	switch t := that.(type) {
+	case *ResetDryRunResult:
+		that1 = t
+	case ResetDryRunResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskSchedulerState to the protobuf v3 wire format
+func (val *TaskSchedulerState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskSchedulerState from the protobuf v3 wire format
+func (val *TaskSchedulerState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskSchedulerState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskSchedulerState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskSchedulerState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskSchedulerState
+	switch t := that.(type) {
+	case *TaskSchedulerState:
+		that1 = t
+	case TaskSchedulerState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskSchedulerChannel to the protobuf v3 wire format
+func (val *TaskSchedulerChannel) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskSchedulerChannel from the protobuf v3 wire format
+func (val *TaskSchedulerChannel) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskSchedulerChannel) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskSchedulerChannel values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskSchedulerChannel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskSchedulerChannel
+	switch t := that.(type) {
+	case *TaskSchedulerChannel:
+		that1 = t
+	case TaskSchedulerChannel:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskSchedulerNamespaceWeightOverride to the protobuf v3 wire format
+func (val *TaskSchedulerNamespaceWeightOverride) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskSchedulerNamespaceWeightOverride from the protobuf v3 wire format
+func (val *TaskSchedulerNamespaceWeightOverride) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskSchedulerNamespaceWeightOverride) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskSchedulerNamespaceWeightOverride values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskSchedulerNamespaceWeightOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskSchedulerNamespaceWeightOverride
+	switch t := that.(type) {
+	case *TaskSchedulerNamespaceWeightOverride:
+		that1 = t
+	case TaskSchedulerNamespaceWeightOverride:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskTrace to the protobuf v3 wire format
+func (val *TaskTrace) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskTrace from the protobuf v3 wire format
+func (val *TaskTrace) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskTrace) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskTrace values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskTrace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskTrace
+	switch t := that.(type) {
+	case *TaskTrace:
+		that1 = t
+	case TaskTrace:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskTraceEvent to the protobuf v3 wire format
+func (val *TaskTraceEvent) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskTraceEvent from the protobuf v3 wire format
+func (val *TaskTraceEvent) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskTraceEvent) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskTraceEvent values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskTraceEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskTraceEvent
+	switch t := that.(type) {
+	case *TaskTraceEvent:
+		that1 = t
+	case TaskTraceEvent:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type QueueMitigation to the protobuf v3 wire format
+func (val *QueueMitigation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type QueueMitigation from the protobuf v3 wire format
+func (val *QueueMitigation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *QueueMitigation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two QueueMitigation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *QueueMitigation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *QueueMitigation
+	switch t := that.(type) {
+	case *QueueMitigation:
+		that1 = t
+	case QueueMitigation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/history/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -429,11 +430,920 @@ func (x *StrippedHistoryEvents) GetEvents() []*StrippedHistoryEvent {
 	return nil
 }
 
+// ResetDryRunResult describes the effect a workflow reset would have, computed without persisting anything.
+type ResetDryRunResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Run ID of the new run. It is not reserved, the actual reset will use a different run ID.
+	ResetRunId string `protobuf:"bytes,1,opt,name=reset_run_id,json=resetRunId,proto3" json:"reset_run_id,omitempty"`
+	// Run that would be terminated by the reset, if the current run is still running.
+	TerminatedRunId string `protobuf:"bytes,2,opt,name=terminated_run_id,json=terminatedRunId,proto3" json:"terminated_run_id,omitempty"`
+	// Events after the reset point that would be reapplied to the new run.
+	ReappliedEvents []*ResetDryRunResult_Event `protobuf:"bytes,3,rep,name=reapplied_events,json=reappliedEvents,proto3" json:"reapplied_events,omitempty"`
+	// Events after the reset point that would not be carried over to the new run.
+	DroppedEvents []*ResetDryRunResult_Event `protobuf:"bytes,4,rep,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	// Activities running at the reset point. They would be failed with a reset failure.
+	FailedActivities []*ResetDryRunResult_Activity `protobuf:"bytes,5,rep,name=failed_activities,json=failedActivities,proto3" json:"failed_activities,omitempty"`
+	// Activities that would be scheduled again by the new run.
+	RescheduledActivities []*ResetDryRunResult_Activity `protobuf:"bytes,6,rep,name=rescheduled_activities,json=rescheduledActivities,proto3" json:"rescheduled_activities,omitempty"`
+	// Timers that would be started again by the new run.
+	RescheduledTimers []*ResetDryRunResult_Timer `protobuf:"bytes,7,rep,name=rescheduled_timers,json=rescheduledTimers,proto3" json:"rescheduled_timers,omitempty"`
+	// Child workflows that would still be tracked by the new run.
+	PendingChildWorkflows []*ResetDryRunResult_ChildWorkflow `protobuf:"bytes,8,rep,name=pending_child_workflows,json=pendingChildWorkflows,proto3" json:"pending_child_workflows,omitempty"`
+	// Child workflows started after the reset point that are still open and would not be tracked by the new run.
+	OrphanedChildWorkflows []*ResetDryRunResult_ChildWorkflow `protobuf:"bytes,9,rep,name=orphaned_child_workflows,json=orphanedChildWorkflows,proto3" json:"orphaned_child_workflows,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResetDryRunResult) Reset() {
+	*x = ResetDryRunResult{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDryRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDryRunResult) ProtoMessage() {}
+
+func (x *ResetDryRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDryRunResult.ProtoReflect.Descriptor instead.
+func (*ResetDryRunResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResetDryRunResult) GetResetRunId() string {
+	if x != nil {
+		return x.ResetRunId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult) GetTerminatedRunId() string {
+	if x != nil {
+		return x.TerminatedRunId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult) GetReappliedEvents() []*ResetDryRunResult_Event {
+	if x != nil {
+		return x.ReappliedEvents
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetDroppedEvents() []*ResetDryRunResult_Event {
+	if x != nil {
+		return x.DroppedEvents
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetFailedActivities() []*ResetDryRunResult_Activity {
+	if x != nil {
+		return x.FailedActivities
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetRescheduledActivities() []*ResetDryRunResult_Activity {
+	if x != nil {
+		return x.RescheduledActivities
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetRescheduledTimers() []*ResetDryRunResult_Timer {
+	if x != nil {
+		return x.RescheduledTimers
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetPendingChildWorkflows() []*ResetDryRunResult_ChildWorkflow {
+	if x != nil {
+		return x.PendingChildWorkflows
+	}
+	return nil
+}
+
+func (x *ResetDryRunResult) GetOrphanedChildWorkflows() []*ResetDryRunResult_ChildWorkflow {
+	if x != nil {
+		return x.OrphanedChildWorkflows
+	}
+	return nil
+}
+
+// TaskSchedulerState is a snapshot of a host level task scheduler of a history host.
+type TaskSchedulerState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the task category the scheduler schedules tasks for.
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Channels      []*TaskSchedulerChannel `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSchedulerState) Reset() {
+	*x = TaskSchedulerState{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSchedulerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSchedulerState) ProtoMessage() {}
+
+func (x *TaskSchedulerState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSchedulerState.ProtoReflect.Descriptor instead.
+func (*TaskSchedulerState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TaskSchedulerState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskSchedulerState) GetChannels() []*TaskSchedulerChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// TaskSchedulerChannel is the task channel of a namespace and task priority in a host level task scheduler.
+type TaskSchedulerChannel struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Namespace   string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Priority    string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Current round robin weight of the channel.
+	Weight int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Number of tasks waiting in the channel.
+	Backlog        int32                  `protobuf:"varint,5,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	LastActiveTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_active_time,json=lastActiveTime,proto3" json:"last_active_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskSchedulerChannel) Reset() {
+	*x = TaskSchedulerChannel{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSchedulerChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSchedulerChannel) ProtoMessage() {}
+
+func (x *TaskSchedulerChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSchedulerChannel.ProtoReflect.Descriptor instead.
+func (*TaskSchedulerChannel) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskSchedulerChannel) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *TaskSchedulerChannel) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TaskSchedulerChannel) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TaskSchedulerChannel) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TaskSchedulerChannel) GetBacklog() int32 {
+	if x != nil {
+		return x.Backlog
+	}
+	return 0
+}
+
+func (x *TaskSchedulerChannel) GetLastActiveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveTime
+	}
+	return nil
+}
+
+// TaskSchedulerNamespaceWeightOverride temporarily multiplies the task channel weights of a namespace.
+type TaskSchedulerNamespaceWeightOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) Reset() {
+	*x = TaskSchedulerNamespaceWeightOverride{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSchedulerNamespaceWeightOverride) ProtoMessage() {}
+
+func (x *TaskSchedulerNamespaceWeightOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSchedulerNamespaceWeightOverride.ProtoReflect.Descriptor instead.
+func (*TaskSchedulerNamespaceWeightOverride) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *TaskSchedulerNamespaceWeightOverride) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// TaskTrace is the recorded lifecycle of a sampled history task, from its generation to its ack.
+type TaskTrace struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category    int32  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskId      int64  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType    string `protobuf:"bytes,4,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	NamespaceId string `protobuf:"bytes,5,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string `protobuf:"bytes,6,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId       string `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// ID of the OpenTelemetry trace the lifecycle spans of the task are reported under.
+	TraceId       string            `protobuf:"bytes,8,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	Events        []*TaskTraceEvent `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTrace) Reset() {
+	*x = TaskTrace{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTrace) ProtoMessage() {}
+
+func (x *TaskTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTrace.ProtoReflect.Descriptor instead.
+func (*TaskTrace) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TaskTrace) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *TaskTrace) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *TaskTrace) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskTrace) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *TaskTrace) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *TaskTrace) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *TaskTrace) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TaskTrace) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *TaskTrace) GetEvents() []*TaskTraceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// TaskTraceEvent is a stage in the lifecycle of a history task.
+type TaskTraceEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of generated, loaded, scheduled, attempted, rescheduled, acked, aborted or cancelled.
+	Stage     string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	Attempt   int32                  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// ID of the queue reader that loaded the task.
+	ReaderId int64 `protobuf:"varint,4,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	// Range of the queue slice the task was loaded in.
+	SliceRange string `protobuf:"bytes,5,opt,name=slice_range,json=sliceRange,proto3" json:"slice_range,omitempty"`
+	// Why the task was rescheduled, or the error of a failed attempt.
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTraceEvent) Reset() {
+	*x = TaskTraceEvent{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTraceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTraceEvent) ProtoMessage() {}
+
+func (x *TaskTraceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTraceEvent.ProtoReflect.Descriptor instead.
+func (*TaskTraceEvent) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TaskTraceEvent) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *TaskTraceEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *TaskTraceEvent) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *TaskTraceEvent) GetReaderId() int64 {
+	if x != nil {
+		return x.ReaderId
+	}
+	return 0
+}
+
+func (x *TaskTraceEvent) GetSliceRange() string {
+	if x != nil {
+		return x.SliceRange
+	}
+	return ""
+}
+
+func (x *TaskTraceEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// QueueMitigation is an action run by a history queue to mitigate one of its alerts.
+type QueueMitigation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ShardId int32                  `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category of the queue. See tasks.TaskCategoryRegistry for more.
+	Category int32 `protobuf:"varint,3,opt,name=category,proto3" json:"category,omitempty"`
+	// One of QueuePendingTaskCount, ReaderStuck or SliceCount.
+	AlertType    string `protobuf:"bytes,4,opt,name=alert_type,json=alertType,proto3" json:"alert_type,omitempty"`
+	AlertDetails string `protobuf:"bytes,5,opt,name=alert_details,json=alertDetails,proto3" json:"alert_details,omitempty"`
+	// Name of the mitigation action, e.g. reader-stuck or dlq-stuck-tasks.
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// Whether the action changed anything.
+	ActionTaken bool `protobuf:"varint,7,opt,name=action_taken,json=actionTaken,proto3" json:"action_taken,omitempty"`
+	// Why the action could not be run.
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueMitigation) Reset() {
+	*x = QueueMitigation{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueMitigation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueMitigation) ProtoMessage() {}
+
+func (x *QueueMitigation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueMitigation.ProtoReflect.Descriptor instead.
+func (*QueueMitigation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueueMitigation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *QueueMitigation) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *QueueMitigation) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *QueueMitigation) GetAlertType() string {
+	if x != nil {
+		return x.AlertType
+	}
+	return ""
+}
+
+func (x *QueueMitigation) GetAlertDetails() string {
+	if x != nil {
+		return x.AlertDetails
+	}
+	return ""
+}
+
+func (x *QueueMitigation) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *QueueMitigation) GetActionTaken() bool {
+	if x != nil {
+		return x.ActionTaken
+	}
+	return false
+}
+
+func (x *QueueMitigation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Event written after the reset point to the base run or to a run it continued as new into.
+type ResetDryRunResult_Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	EventId       int64                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     v11.EventType          `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=temporal.api.enums.v1.EventType" json:"event_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDryRunResult_Event) Reset() {
+	*x = ResetDryRunResult_Event{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDryRunResult_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDryRunResult_Event) ProtoMessage() {}
+
+func (x *ResetDryRunResult_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDryRunResult_Event.ProtoReflect.Descriptor instead.
+func (*ResetDryRunResult_Event) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ResetDryRunResult_Event) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_Event) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ResetDryRunResult_Event) GetEventType() v11.EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return v11.EventType(0)
+}
+
+type ResetDryRunResult_Activity struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledEventId int64                  `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	ActivityId       string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityType     string                 `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The activity completed after the reset point in the base run, so it will run again.
+	CompletedAfterResetPoint bool `protobuf:"varint,4,opt,name=completed_after_reset_point,json=completedAfterResetPoint,proto3" json:"completed_after_reset_point,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ResetDryRunResult_Activity) Reset() {
+	*x = ResetDryRunResult_Activity{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDryRunResult_Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDryRunResult_Activity) ProtoMessage() {}
+
+func (x *ResetDryRunResult_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDryRunResult_Activity.ProtoReflect.Descriptor instead.
+func (*ResetDryRunResult_Activity) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *ResetDryRunResult_Activity) GetScheduledEventId() int64 {
+	if x != nil {
+		return x.ScheduledEventId
+	}
+	return 0
+}
+
+func (x *ResetDryRunResult_Activity) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_Activity) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_Activity) GetCompletedAfterResetPoint() bool {
+	if x != nil {
+		return x.CompletedAfterResetPoint
+	}
+	return false
+}
+
+type ResetDryRunResult_Timer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TimerId        string                 `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartedEventId int64                  `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	ExpiryTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResetDryRunResult_Timer) Reset() {
+	*x = ResetDryRunResult_Timer{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDryRunResult_Timer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDryRunResult_Timer) ProtoMessage() {}
+
+func (x *ResetDryRunResult_Timer) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDryRunResult_Timer.ProtoReflect.Descriptor instead.
+func (*ResetDryRunResult_Timer) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *ResetDryRunResult_Timer) GetTimerId() string {
+	if x != nil {
+		return x.TimerId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_Timer) GetStartedEventId() int64 {
+	if x != nil {
+		return x.StartedEventId
+	}
+	return 0
+}
+
+func (x *ResetDryRunResult_Timer) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+type ResetDryRunResult_ChildWorkflow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Run that initiated the child workflow.
+	ParentRunId       string                `protobuf:"bytes,1,opt,name=parent_run_id,json=parentRunId,proto3" json:"parent_run_id,omitempty"`
+	InitiatedEventId  int64                 `protobuf:"varint,2,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	WorkflowId        string                `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId             string                `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WorkflowType      string                `protobuf:"bytes,5,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	ParentClosePolicy v11.ParentClosePolicy `protobuf:"varint,6,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) Reset() {
+	*x = ResetDryRunResult_ChildWorkflow{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDryRunResult_ChildWorkflow) ProtoMessage() {}
+
+func (x *ResetDryRunResult_ChildWorkflow) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDryRunResult_ChildWorkflow.ProtoReflect.Descriptor instead.
+func (*ResetDryRunResult_ChildWorkflow) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetParentRunId() string {
+	if x != nil {
+		return x.ParentRunId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetInitiatedEventId() int64 {
+	if x != nil {
+		return x.InitiatedEventId
+	}
+	return 0
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *ResetDryRunResult_ChildWorkflow) GetParentClosePolicy() v11.ParentClosePolicy {
+	if x != nil {
+		return x.ParentClosePolicy
+	}
+	return v11.ParentClosePolicy(0)
+}
+
 var File_temporal_server_api_history_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_history_v1_message_proto_rawDesc = "" +
 	"\n" +
-	",temporal/server/api/history/v1/message.proto\x12\x1etemporal.server.api.history.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%temporal/api/history/v1/message.proto\"u\n" +
+	",temporal/server/api/history/v1/message.proto\x12\x1etemporal.server.api.history.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&temporal/api/enums/v1/event_type.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/history/v1/message.proto\"u\n" +
 	"\x19TransientWorkflowTaskInfo\x12L\n" +
 	"\x0ehistory_suffix\x18\x03 \x03(\v2%.temporal.api.history.v1.HistoryEventR\rhistorySuffixJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"I\n" +
 	"\x12VersionHistoryItem\x12\x19\n" +
@@ -455,7 +1365,90 @@ const file_temporal_server_api_history_v1_message_proto_rawDesc = "" +
 	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\x03R\aversion\"e\n" +
 	"\x15StrippedHistoryEvents\x12L\n" +
-	"\x06events\x18\x01 \x03(\v24.temporal.server.api.history.v1.StrippedHistoryEventR\x06eventsB.Z,go.temporal.io/server/api/history/v1;historyb\x06proto3"
+	"\x06events\x18\x01 \x03(\v24.temporal.server.api.history.v1.StrippedHistoryEventR\x06events\"\xc0\f\n" +
+	"\x11ResetDryRunResult\x12 \n" +
+	"\freset_run_id\x18\x01 \x01(\tR\n" +
+	"resetRunId\x12*\n" +
+	"\x11terminated_run_id\x18\x02 \x01(\tR\x0fterminatedRunId\x12b\n" +
+	"\x10reapplied_events\x18\x03 \x03(\v27.temporal.server.api.history.v1.ResetDryRunResult.EventR\x0freappliedEvents\x12^\n" +
+	"\x0edropped_events\x18\x04 \x03(\v27.temporal.server.api.history.v1.ResetDryRunResult.EventR\rdroppedEvents\x12g\n" +
+	"\x11failed_activities\x18\x05 \x03(\v2:.temporal.server.api.history.v1.ResetDryRunResult.ActivityR\x10failedActivities\x12q\n" +
+	"\x16rescheduled_activities\x18\x06 \x03(\v2:.temporal.server.api.history.v1.ResetDryRunResult.ActivityR\x15rescheduledActivities\x12f\n" +
+	"\x12rescheduled_timers\x18\a \x03(\v27.temporal.server.api.history.v1.ResetDryRunResult.TimerR\x11rescheduledTimers\x12w\n" +
+	"\x17pending_child_workflows\x18\b \x03(\v2?.temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflowR\x15pendingChildWorkflows\x12y\n" +
+	"\x18orphaned_child_workflows\x18\t \x03(\v2?.temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflowR\x16orphanedChildWorkflows\x1az\n" +
+	"\x05Event\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x03R\aeventId\x12?\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x0e2 .temporal.api.enums.v1.EventTypeR\teventType\x1a\xbd\x01\n" +
+	"\bActivity\x12,\n" +
+	"\x12scheduled_event_id\x18\x01 \x01(\x03R\x10scheduledEventId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\tR\n" +
+	"activityId\x12#\n" +
+	"\ractivity_type\x18\x03 \x01(\tR\factivityType\x12=\n" +
+	"\x1bcompleted_after_reset_point\x18\x04 \x01(\bR\x18completedAfterResetPoint\x1a\x89\x01\n" +
+	"\x05Timer\x12\x19\n" +
+	"\btimer_id\x18\x01 \x01(\tR\atimerId\x12(\n" +
+	"\x10started_event_id\x18\x02 \x01(\x03R\x0estartedEventId\x12;\n" +
+	"\vexpiry_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryTime\x1a\x98\x02\n" +
+	"\rChildWorkflow\x12\"\n" +
+	"\rparent_run_id\x18\x01 \x01(\tR\vparentRunId\x12,\n" +
+	"\x12initiated_event_id\x18\x02 \x01(\x03R\x10initiatedEventId\x12\x1f\n" +
+	"\vworkflow_id\x18\x03 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12#\n" +
+	"\rworkflow_type\x18\x05 \x01(\tR\fworkflowType\x12X\n" +
+	"\x13parent_close_policy\x18\x06 \x01(\x0e2(.temporal.api.enums.v1.ParentClosePolicyR\x11parentClosePolicy\"z\n" +
+	"\x12TaskSchedulerState\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12P\n" +
+	"\bchannels\x18\x02 \x03(\v24.temporal.server.api.history.v1.TaskSchedulerChannelR\bchannels\"\xeb\x01\n" +
+	"\x14TaskSchedulerChannel\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12\x18\n" +
+	"\abacklog\x18\x05 \x01(\x05R\abacklog\x12D\n" +
+	"\x10last_active_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActiveTime\"\xc4\x01\n" +
+	"$TaskSchedulerNamespaceWeightOverride\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xb6\x02\n" +
+	"\tTaskTrace\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12\x1b\n" +
+	"\ttask_type\x18\x04 \x01(\tR\btaskType\x12!\n" +
+	"\fnamespace_id\x18\x05 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x06 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\a \x01(\tR\x05runId\x12\x19\n" +
+	"\btrace_id\x18\b \x01(\tR\atraceId\x12F\n" +
+	"\x06events\x18\t \x03(\v2..temporal.server.api.history.v1.TaskTraceEventR\x06events\"\xd1\x01\n" +
+	"\x0eTaskTraceEvent\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x129\n" +
+	"\n" +
+	"event_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\x05R\aattempt\x12\x1b\n" +
+	"\treader_id\x18\x04 \x01(\x03R\breaderId\x12\x1f\n" +
+	"\vslice_range\x18\x05 \x01(\tR\n" +
+	"sliceRange\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x8d\x02\n" +
+	"\x0fQueueMitigation\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\x05R\bcategory\x12\x1d\n" +
+	"\n" +
+	"alert_type\x18\x04 \x01(\tR\talertType\x12#\n" +
+	"\ralert_details\x18\x05 \x01(\tR\falertDetails\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12!\n" +
+	"\faction_taken\x18\a \x01(\bR\vactionTaken\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05errorB.Z,go.temporal.io/server/api/history/v1;historyb\x06proto3"
 
 var (
 	file_temporal_server_api_history_v1_message_proto_rawDescOnce sync.Once
@@ -469,32 +1462,61 @@ func file_temporal_server_api_history_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_history_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_history_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_api_history_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_temporal_server_api_history_v1_message_proto_goTypes = []any{
-	(*TransientWorkflowTaskInfo)(nil), // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo
-	(*VersionHistoryItem)(nil),        // 1: temporal.server.api.history.v1.VersionHistoryItem
-	(*VersionHistory)(nil),            // 2: temporal.server.api.history.v1.VersionHistory
-	(*VersionHistories)(nil),          // 3: temporal.server.api.history.v1.VersionHistories
-	(*TaskKey)(nil),                   // 4: temporal.server.api.history.v1.TaskKey
-	(*TaskRange)(nil),                 // 5: temporal.server.api.history.v1.TaskRange
-	(*StrippedHistoryEvent)(nil),      // 6: temporal.server.api.history.v1.StrippedHistoryEvent
-	(*StrippedHistoryEvents)(nil),     // 7: temporal.server.api.history.v1.StrippedHistoryEvents
-	(*v1.HistoryEvent)(nil),           // 8: temporal.api.history.v1.HistoryEvent
-	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*TransientWorkflowTaskInfo)(nil),            // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo
+	(*VersionHistoryItem)(nil),                   // 1: temporal.server.api.history.v1.VersionHistoryItem
+	(*VersionHistory)(nil),                       // 2: temporal.server.api.history.v1.VersionHistory
+	(*VersionHistories)(nil),                     // 3: temporal.server.api.history.v1.VersionHistories
+	(*TaskKey)(nil),                              // 4: temporal.server.api.history.v1.TaskKey
+	(*TaskRange)(nil),                            // 5: temporal.server.api.history.v1.TaskRange
+	(*StrippedHistoryEvent)(nil),                 // 6: temporal.server.api.history.v1.StrippedHistoryEvent
+	(*StrippedHistoryEvents)(nil),                // 7: temporal.server.api.history.v1.StrippedHistoryEvents
+	(*ResetDryRunResult)(nil),                    // 8: temporal.server.api.history.v1.ResetDryRunResult
+	(*TaskSchedulerState)(nil),                   // 9: temporal.server.api.history.v1.TaskSchedulerState
+	(*TaskSchedulerChannel)(nil),                 // 10: temporal.server.api.history.v1.TaskSchedulerChannel
+	(*TaskSchedulerNamespaceWeightOverride)(nil), // 11: temporal.server.api.history.v1.TaskSchedulerNamespaceWeightOverride
+	(*TaskTrace)(nil),                            // 12: temporal.server.api.history.v1.TaskTrace
+	(*TaskTraceEvent)(nil),                       // 13: temporal.server.api.history.v1.TaskTraceEvent
+	(*QueueMitigation)(nil),                      // 14: temporal.server.api.history.v1.QueueMitigation
+	(*ResetDryRunResult_Event)(nil),              // 15: temporal.server.api.history.v1.ResetDryRunResult.Event
+	(*ResetDryRunResult_Activity)(nil),           // 16: temporal.server.api.history.v1.ResetDryRunResult.Activity
+	(*ResetDryRunResult_Timer)(nil),              // 17: temporal.server.api.history.v1.ResetDryRunResult.Timer
+	(*ResetDryRunResult_ChildWorkflow)(nil),      // 18: temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflow
+	(*v1.HistoryEvent)(nil),                      // 19: temporal.api.history.v1.HistoryEvent
+	(*timestamppb.Timestamp)(nil),                // 20: google.protobuf.Timestamp
+	(v11.EventType)(0),                           // 21: temporal.api.enums.v1.EventType
+	(v11.ParentClosePolicy)(0),                   // 22: temporal.api.enums.v1.ParentClosePolicy
 }
 var file_temporal_server_api_history_v1_message_proto_depIdxs = []int32{
-	8, // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo.history_suffix:type_name -> temporal.api.history.v1.HistoryEvent
-	1, // 1: temporal.server.api.history.v1.VersionHistory.items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	2, // 2: temporal.server.api.history.v1.VersionHistories.histories:type_name -> temporal.server.api.history.v1.VersionHistory
-	9, // 3: temporal.server.api.history.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
-	4, // 4: temporal.server.api.history.v1.TaskRange.inclusive_min_task_key:type_name -> temporal.server.api.history.v1.TaskKey
-	4, // 5: temporal.server.api.history.v1.TaskRange.exclusive_max_task_key:type_name -> temporal.server.api.history.v1.TaskKey
-	6, // 6: temporal.server.api.history.v1.StrippedHistoryEvents.events:type_name -> temporal.server.api.history.v1.StrippedHistoryEvent
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	19, // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo.history_suffix:type_name -> temporal.api.history.v1.HistoryEvent
+	1,  // 1: temporal.server.api.history.v1.VersionHistory.items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	2,  // 2: temporal.server.api.history.v1.VersionHistories.histories:type_name -> temporal.server.api.history.v1.VersionHistory
+	20, // 3: temporal.server.api.history.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
+	4,  // 4: temporal.server.api.history.v1.TaskRange.inclusive_min_task_key:type_name -> temporal.server.api.history.v1.TaskKey
+	4,  // 5: temporal.server.api.history.v1.TaskRange.exclusive_max_task_key:type_name -> temporal.server.api.history.v1.TaskKey
+	6,  // 6: temporal.server.api.history.v1.StrippedHistoryEvents.events:type_name -> temporal.server.api.history.v1.StrippedHistoryEvent
+	15, // 7: temporal.server.api.history.v1.ResetDryRunResult.reapplied_events:type_name -> temporal.server.api.history.v1.ResetDryRunResult.Event
+	15, // 8: temporal.server.api.history.v1.ResetDryRunResult.dropped_events:type_name -> temporal.server.api.history.v1.ResetDryRunResult.Event
+	16, // 9: temporal.server.api.history.v1.ResetDryRunResult.failed_activities:type_name -> temporal.server.api.history.v1.ResetDryRunResult.Activity
+	16, // 10: temporal.server.api.history.v1.ResetDryRunResult.rescheduled_activities:type_name -> temporal.server.api.history.v1.ResetDryRunResult.Activity
+	17, // 11: temporal.server.api.history.v1.ResetDryRunResult.rescheduled_timers:type_name -> temporal.server.api.history.v1.ResetDryRunResult.Timer
+	18, // 12: temporal.server.api.history.v1.ResetDryRunResult.pending_child_workflows:type_name -> temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflow
+	18, // 13: temporal.server.api.history.v1.ResetDryRunResult.orphaned_child_workflows:type_name -> temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflow
+	10, // 14: temporal.server.api.history.v1.TaskSchedulerState.channels:type_name -> temporal.server.api.history.v1.TaskSchedulerChannel
+	20, // 15: temporal.server.api.history.v1.TaskSchedulerChannel.last_active_time:type_name -> google.protobuf.Timestamp
+	20, // 16: temporal.server.api.history.v1.TaskSchedulerNamespaceWeightOverride.expire_time:type_name -> google.protobuf.Timestamp
+	13, // 17: temporal.server.api.history.v1.TaskTrace.events:type_name -> temporal.server.api.history.v1.TaskTraceEvent
+	20, // 18: temporal.server.api.history.v1.TaskTraceEvent.event_time:type_name -> google.protobuf.Timestamp
+	20, // 19: temporal.server.api.history.v1.QueueMitigation.time:type_name -> google.protobuf.Timestamp
+	21, // 20: temporal.server.api.history.v1.ResetDryRunResult.Event.event_type:type_name -> temporal.api.enums.v1.EventType
+	20, // 21: temporal.server.api.history.v1.ResetDryRunResult.Timer.expiry_time:type_name -> google.protobuf.Timestamp
+	22, // 22: temporal.server.api.history.v1.ResetDryRunResult.ChildWorkflow.parent_close_policy:type_name -> temporal.api.enums.v1.ParentClosePolicy
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_history_v1_message_proto_init() }
@@ -508,7 +1530,7 @@ func file_temporal_server_api_history_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_history_v1_message_proto_rawDesc), len(file_temporal_server_api_history_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package historyeventfeedservice
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type ReadHistoryEventFeedRequest to the protobuf v3 wire format
+func (val *ReadHistoryEventFeedRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReadHistoryEventFeedRequest from the protobuf v3 wire format
+func (val *ReadHistoryEventFeedRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReadHistoryEventFeedRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReadHistoryEventFeedRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReadHistoryEventFeedRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReadHistoryEventFeedRequest
+	switch t := that.(type) {
+	case *ReadHistoryEventFeedRequest:
+		that1 = t
+	case ReadHistoryEventFeedRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReadHistoryEventFeedResponse to the protobuf v3 wire format
+func (val *ReadHistoryEventFeedResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReadHistoryEventFeedResponse from the protobuf v3 wire format
+func (val *ReadHistoryEventFeedResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReadHistoryEventFeedResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReadHistoryEventFeedResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReadHistoryEventFeedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReadHistoryEventFeedResponse
+	switch t := that.(type) {
+	case *ReadHistoryEventFeedResponse:
+		that1 = t
+	case ReadHistoryEventFeedResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryEventFeedEntry to the protobuf v3 wire format
+func (val *HistoryEventFeedEntry) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryEventFeedEntry from the protobuf v3 wire format
+func (val *HistoryEventFeedEntry) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryEventFeedEntry) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryEventFeedEntry values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryEventFeedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryEventFeedEntry
+	switch t := that.(type) {
+	case *HistoryEventFeedEntry:
+		that1 = t
+	case HistoryEventFeedEntry:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
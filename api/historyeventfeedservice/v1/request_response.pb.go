@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/historyeventfeedservice/v1/request_response.proto
+
+package historyeventfeedservice
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/enums/v1"
+	v11 "go.temporal.io/server/api/persistence/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReadHistoryEventFeedRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Read the feed after the record with this cursor. Empty reads from the oldest record retained.
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Only return records of workflows of these types. Empty returns all workflow types.
+	WorkflowTypes []string `protobuf:"bytes,3,rep,name=workflow_types,json=workflowTypes,proto3" json:"workflow_types,omitempty"`
+	// Only return events of these types. Empty returns all event types.
+	EventTypes []v1.EventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=temporal.api.enums.v1.EventType" json:"event_types,omitempty"`
+	// Number of records read from the feed.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Wait for new records until the request deadline when there is no record after the cursor.
+	WaitNewRecords bool `protobuf:"varint,6,opt,name=wait_new_records,json=waitNewRecords,proto3" json:"wait_new_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadHistoryEventFeedRequest) Reset() {
+	*x = ReadHistoryEventFeedRequest{}
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadHistoryEventFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadHistoryEventFeedRequest) ProtoMessage() {}
+
+func (x *ReadHistoryEventFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadHistoryEventFeedRequest.ProtoReflect.Descriptor instead.
+func (*ReadHistoryEventFeedRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadHistoryEventFeedRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReadHistoryEventFeedRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ReadHistoryEventFeedRequest) GetWorkflowTypes() []string {
+	if x != nil {
+		return x.WorkflowTypes
+	}
+	return nil
+}
+
+func (x *ReadHistoryEventFeedRequest) GetEventTypes() []v1.EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *ReadHistoryEventFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadHistoryEventFeedRequest) GetWaitNewRecords() bool {
+	if x != nil {
+		return x.WaitNewRecords
+	}
+	return false
+}
+
+type ReadHistoryEventFeedResponse struct {
+	state   protoimpl.MessageState   `protogen:"open.v1"`
+	Entries []*HistoryEventFeedEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Cursor to read the feed after the last record read by the request. Records which are filtered out move the cursor
+	// too, it is the request cursor if no record was read.
+	NextCursor []byte `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Number of records after the request cursor which were deleted by the feed retention before they were read. Records
+	// are retained for history.historyEventFeedRetention, a reader which falls further behind misses the records in
+	// between and has to recover them from the workflow histories, if they are still retained.
+	TrimmedRecords int64 `protobuf:"varint,3,opt,name=trimmed_records,json=trimmedRecords,proto3" json:"trimmed_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadHistoryEventFeedResponse) Reset() {
+	*x = ReadHistoryEventFeedResponse{}
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadHistoryEventFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadHistoryEventFeedResponse) ProtoMessage() {}
+
+func (x *ReadHistoryEventFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadHistoryEventFeedResponse.ProtoReflect.Descriptor instead.
+func (*ReadHistoryEventFeedResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadHistoryEventFeedResponse) GetEntries() []*HistoryEventFeedEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ReadHistoryEventFeedResponse) GetNextCursor() []byte {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+func (x *ReadHistoryEventFeedResponse) GetTrimmedRecords() int64 {
+	if x != nil {
+		return x.TrimmedRecords
+	}
+	return 0
+}
+
+type HistoryEventFeedEntry struct {
+	state  protoimpl.MessageState      `protogen:"open.v1"`
+	Record *v11.HistoryEventFeedRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Cursor to read the feed after this record.
+	Cursor        []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryEventFeedEntry) Reset() {
+	*x = HistoryEventFeedEntry{}
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryEventFeedEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEventFeedEntry) ProtoMessage() {}
+
+func (x *HistoryEventFeedEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEventFeedEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEventFeedEntry) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HistoryEventFeedEntry) GetRecord() *v11.HistoryEventFeedRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *HistoryEventFeedEntry) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+var File_temporal_server_api_historyeventfeedservice_v1_request_response_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDesc = "" +
+	"\n" +
+	"Etemporal/server/api/historyeventfeedservice/v1/request_response.proto\x12.temporal.server.api.historyeventfeedservice.v1\x1a&temporal/api/enums/v1/event_type.proto\x1a;temporal/server/api/persistence/v1/history_event_feed.proto\"\x84\x02\n" +
+	"\x1bReadHistoryEventFeedRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursor\x12%\n" +
+	"\x0eworkflow_types\x18\x03 \x03(\tR\rworkflowTypes\x12A\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2 .temporal.api.enums.v1.EventTypeR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12(\n" +
+	"\x10wait_new_records\x18\x06 \x01(\bR\x0ewaitNewRecords\"\xc9\x01\n" +
+	"\x1cReadHistoryEventFeedResponse\x12_\n" +
+	"\aentries\x18\x01 \x03(\v2E.temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\fR\n" +
+	"nextCursor\x12'\n" +
+	"\x0ftrimmed_records\x18\x03 \x01(\x03R\x0etrimmedRecords\"\x83\x01\n" +
+	"\x15HistoryEventFeedEntry\x12R\n" +
+	"\x06record\x18\x01 \x01(\v2:.temporal.server.api.persistence.v1.HistoryEventFeedRecordR\x06record\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursorBNZLgo.temporal.io/server/api/historyeventfeedservice/v1;historyeventfeedserviceb\x06proto3"
+
+var (
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescOnce sync.Once
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDesc)))
+	})
+	return file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDescData
+}
+
+var file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_goTypes = []any{
+	(*ReadHistoryEventFeedRequest)(nil),  // 0: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedRequest
+	(*ReadHistoryEventFeedResponse)(nil), // 1: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedResponse
+	(*HistoryEventFeedEntry)(nil),        // 2: temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedEntry
+	(v1.EventType)(0),                    // 3: temporal.api.enums.v1.EventType
+	(*v11.HistoryEventFeedRecord)(nil),   // 4: temporal.server.api.persistence.v1.HistoryEventFeedRecord
+}
+var file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_depIdxs = []int32{
+	3, // 0: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedRequest.event_types:type_name -> temporal.api.enums.v1.EventType
+	2, // 1: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedResponse.entries:type_name -> temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedEntry
+	4, // 2: temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedEntry.record:type_name -> temporal.server.api.persistence.v1.HistoryEventFeedRecord
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_init() }
+func file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_init() {
+	if File_temporal_server_api_historyeventfeedservice_v1_request_response_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_historyeventfeedservice_v1_request_response_proto = out.File
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_goTypes = nil
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_depIdxs = nil
+}
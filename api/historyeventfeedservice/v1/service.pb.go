@@ -0,0 +1,68 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/historyeventfeedservice/v1/service.proto
+
+package historyeventfeedservice
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_temporal_server_api_historyeventfeedservice_v1_service_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_historyeventfeedservice_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"<temporal/server/api/historyeventfeedservice/v1/service.proto\x12.temporal.server.api.historyeventfeedservice.v1\x1aEtemporal/server/api/historyeventfeedservice/v1/request_response.proto2\xcf\x01\n" +
+	"\x17HistoryEventFeedService\x12\xb3\x01\n" +
+	"\x14ReadHistoryEventFeed\x12K.temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedRequest\x1aL.temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedResponse\"\x00BNZLgo.temporal.io/server/api/historyeventfeedservice/v1;historyeventfeedserviceb\x06proto3"
+
+var file_temporal_server_api_historyeventfeedservice_v1_service_proto_goTypes = []any{
+	(*ReadHistoryEventFeedRequest)(nil),  // 0: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedRequest
+	(*ReadHistoryEventFeedResponse)(nil), // 1: temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedResponse
+}
+var file_temporal_server_api_historyeventfeedservice_v1_service_proto_depIdxs = []int32{
+	0, // 0: temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService.ReadHistoryEventFeed:input_type -> temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedRequest
+	1, // 1: temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService.ReadHistoryEventFeed:output_type -> temporal.server.api.historyeventfeedservice.v1.ReadHistoryEventFeedResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_historyeventfeedservice_v1_service_proto_init() }
+func file_temporal_server_api_historyeventfeedservice_v1_service_proto_init() {
+	if File_temporal_server_api_historyeventfeedservice_v1_service_proto != nil {
+		return
+	}
+	file_temporal_server_api_historyeventfeedservice_v1_request_response_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_historyeventfeedservice_v1_service_proto_rawDesc), len(file_temporal_server_api_historyeventfeedservice_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_temporal_server_api_historyeventfeedservice_v1_service_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_historyeventfeedservice_v1_service_proto_depIdxs,
+	}.Build()
+	File_temporal_server_api_historyeventfeedservice_v1_service_proto = out.File
+	file_temporal_server_api_historyeventfeedservice_v1_service_proto_goTypes = nil
+	file_temporal_server_api_historyeventfeedservice_v1_service_proto_depIdxs = nil
+}
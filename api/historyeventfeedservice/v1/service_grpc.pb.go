@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// plugins:
+// - protoc-gen-go-grpc
+// - protoc
+// source: temporal/server/api/historyeventfeedservice/v1/service.proto
+
+package historyeventfeedservice
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	HistoryEventFeedService_ReadHistoryEventFeed_FullMethodName = "/temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService/ReadHistoryEventFeed"
+)
+
+// HistoryEventFeedServiceClient is the client API for HistoryEventFeedService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HistoryEventFeedServiceClient interface {
+	// ReadHistoryEventFeed reads the history event feed of a namespace, which holds the history events of its closed
+	// workflow executions when the feed is enabled with the history.historyEventFeedEnabled dynamic config. Every record
+	// carries a cursor that can be used to resume the feed after it.
+	//
+	// Records are delivered at least once. A page of history events is published again when the task publishing it is
+	// retried after a failure, so the same events can be read in several records. Readers have to deduplicate records
+	// by workflow ID, run ID and event ID, and resume from the cursor of the last record they processed.
+	ReadHistoryEventFeed(ctx context.Context, in *ReadHistoryEventFeedRequest, opts ...grpc.CallOption) (*ReadHistoryEventFeedResponse, error)
+}
+
+type historyEventFeedServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHistoryEventFeedServiceClient(cc grpc.ClientConnInterface) HistoryEventFeedServiceClient {
+	return &historyEventFeedServiceClient{cc}
+}
+
+func (c *historyEventFeedServiceClient) ReadHistoryEventFeed(ctx context.Context, in *ReadHistoryEventFeedRequest, opts ...grpc.CallOption) (*ReadHistoryEventFeedResponse, error) {
+	out := new(ReadHistoryEventFeedResponse)
+	err := c.cc.Invoke(ctx, HistoryEventFeedService_ReadHistoryEventFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HistoryEventFeedServiceServer is the server API for HistoryEventFeedService service.
+// All implementations must embed UnimplementedHistoryEventFeedServiceServer
+// for forward compatibility
+type HistoryEventFeedServiceServer interface {
+	// ReadHistoryEventFeed reads the history event feed of a namespace, which holds the history events of its closed
+	// workflow executions when the feed is enabled with the history.historyEventFeedEnabled dynamic config. Every record
+	// carries a cursor that can be used to resume the feed after it.
+	//
+	// Records are delivered at least once. A page of history events is published again when the task publishing it is
+	// retried after a failure, so the same events can be read in several records. Readers have to deduplicate records
+	// by workflow ID, run ID and event ID, and resume from the cursor of the last record they processed.
+	ReadHistoryEventFeed(context.Context, *ReadHistoryEventFeedRequest) (*ReadHistoryEventFeedResponse, error)
+	mustEmbedUnimplementedHistoryEventFeedServiceServer()
+}
+
+// UnimplementedHistoryEventFeedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHistoryEventFeedServiceServer struct {
+}
+
+func (UnimplementedHistoryEventFeedServiceServer) ReadHistoryEventFeed(context.Context, *ReadHistoryEventFeedRequest) (*ReadHistoryEventFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadHistoryEventFeed not implemented")
+}
+func (UnimplementedHistoryEventFeedServiceServer) mustEmbedUnimplementedHistoryEventFeedServiceServer() {
+}
+
+// UnsafeHistoryEventFeedServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HistoryEventFeedServiceServer will
+// result in compilation errors.
+type UnsafeHistoryEventFeedServiceServer interface {
+	mustEmbedUnimplementedHistoryEventFeedServiceServer()
+}
+
+func RegisterHistoryEventFeedServiceServer(s grpc.ServiceRegistrar, srv HistoryEventFeedServiceServer) {
+	s.RegisterService(&HistoryEventFeedService_ServiceDesc, srv)
+}
+
+func _HistoryEventFeedService_ReadHistoryEventFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadHistoryEventFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryEventFeedServiceServer).ReadHistoryEventFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryEventFeedService_ReadHistoryEventFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryEventFeedServiceServer).ReadHistoryEventFeed(ctx, req.(*ReadHistoryEventFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HistoryEventFeedService_ServiceDesc is the grpc.ServiceDesc for HistoryEventFeedService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HistoryEventFeedService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService",
+	HandlerType: (*HistoryEventFeedServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadHistoryEventFeed",
+			Handler:    _HistoryEventFeedService_ReadHistoryEventFeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/historyeventfeedservice/v1/service.proto",
+}
@@ -0,0 +1,10 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api/historyeventfeedservice/v1/service.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -package historyeventfeedservicemock -source api/historyeventfeedservice/v1/service.pb.go -destination api/historyeventfeedservicemock/v1/service.pb.mock.go
+//
+
+// Package historyeventfeedservicemock is a generated GoMock package.
+package historyeventfeedservicemock
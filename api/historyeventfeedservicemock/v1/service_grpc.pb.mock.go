@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api/historyeventfeedservice/v1/service_grpc.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -package historyeventfeedservicemock -source api/historyeventfeedservice/v1/service_grpc.pb.go -destination api/historyeventfeedservicemock/v1/service_grpc.pb.mock.go
+//
+
+// Package historyeventfeedservicemock is a generated GoMock package.
+package historyeventfeedservicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	historyeventfeedservice "go.temporal.io/server/api/historyeventfeedservice/v1"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockHistoryEventFeedServiceClient is a mock of HistoryEventFeedServiceClient interface.
+type MockHistoryEventFeedServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryEventFeedServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryEventFeedServiceClientMockRecorder is the mock recorder for MockHistoryEventFeedServiceClient.
+type MockHistoryEventFeedServiceClientMockRecorder struct {
+	mock *MockHistoryEventFeedServiceClient
+}
+
+// NewMockHistoryEventFeedServiceClient creates a new mock instance.
+func NewMockHistoryEventFeedServiceClient(ctrl *gomock.Controller) *MockHistoryEventFeedServiceClient {
+	mock := &MockHistoryEventFeedServiceClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryEventFeedServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryEventFeedServiceClient) EXPECT() *MockHistoryEventFeedServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ReadHistoryEventFeed mocks base method.
+func (m *MockHistoryEventFeedServiceClient) ReadHistoryEventFeed(ctx context.Context, in *historyeventfeedservice.ReadHistoryEventFeedRequest, opts ...grpc.CallOption) (*historyeventfeedservice.ReadHistoryEventFeedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadHistoryEventFeed", varargs...)
+	ret0, _ := ret[0].(*historyeventfeedservice.ReadHistoryEventFeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryEventFeed indicates an expected call of ReadHistoryEventFeed.
+func (mr *MockHistoryEventFeedServiceClientMockRecorder) ReadHistoryEventFeed(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryEventFeed", reflect.TypeOf((*MockHistoryEventFeedServiceClient)(nil).ReadHistoryEventFeed), varargs...)
+}
+
+// MockHistoryEventFeedServiceServer is a mock of HistoryEventFeedServiceServer interface.
+type MockHistoryEventFeedServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryEventFeedServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryEventFeedServiceServerMockRecorder is the mock recorder for MockHistoryEventFeedServiceServer.
+type MockHistoryEventFeedServiceServerMockRecorder struct {
+	mock *MockHistoryEventFeedServiceServer
+}
+
+// NewMockHistoryEventFeedServiceServer creates a new mock instance.
+func NewMockHistoryEventFeedServiceServer(ctrl *gomock.Controller) *MockHistoryEventFeedServiceServer {
+	mock := &MockHistoryEventFeedServiceServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryEventFeedServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryEventFeedServiceServer) EXPECT() *MockHistoryEventFeedServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ReadHistoryEventFeed mocks base method.
+func (m *MockHistoryEventFeedServiceServer) ReadHistoryEventFeed(arg0 context.Context, arg1 *historyeventfeedservice.ReadHistoryEventFeedRequest) (*historyeventfeedservice.ReadHistoryEventFeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryEventFeed", arg0, arg1)
+	ret0, _ := ret[0].(*historyeventfeedservice.ReadHistoryEventFeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryEventFeed indicates an expected call of ReadHistoryEventFeed.
+func (mr *MockHistoryEventFeedServiceServerMockRecorder) ReadHistoryEventFeed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryEventFeed", reflect.TypeOf((*MockHistoryEventFeedServiceServer)(nil).ReadHistoryEventFeed), arg0, arg1)
+}
+
+// mustEmbedUnimplementedHistoryEventFeedServiceServer mocks base method.
+func (m *MockHistoryEventFeedServiceServer) mustEmbedUnimplementedHistoryEventFeedServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedHistoryEventFeedServiceServer")
+}
+
+// mustEmbedUnimplementedHistoryEventFeedServiceServer indicates an expected call of mustEmbedUnimplementedHistoryEventFeedServiceServer.
+func (mr *MockHistoryEventFeedServiceServerMockRecorder) mustEmbedUnimplementedHistoryEventFeedServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedHistoryEventFeedServiceServer", reflect.TypeOf((*MockHistoryEventFeedServiceServer)(nil).mustEmbedUnimplementedHistoryEventFeedServiceServer))
+}
+
+// MockUnsafeHistoryEventFeedServiceServer is a mock of UnsafeHistoryEventFeedServiceServer interface.
+type MockUnsafeHistoryEventFeedServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeHistoryEventFeedServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeHistoryEventFeedServiceServerMockRecorder is the mock recorder for MockUnsafeHistoryEventFeedServiceServer.
+type MockUnsafeHistoryEventFeedServiceServerMockRecorder struct {
+	mock *MockUnsafeHistoryEventFeedServiceServer
+}
+
+// NewMockUnsafeHistoryEventFeedServiceServer creates a new mock instance.
+func NewMockUnsafeHistoryEventFeedServiceServer(ctrl *gomock.Controller) *MockUnsafeHistoryEventFeedServiceServer {
+	mock := &MockUnsafeHistoryEventFeedServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeHistoryEventFeedServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeHistoryEventFeedServiceServer) EXPECT() *MockUnsafeHistoryEventFeedServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedHistoryEventFeedServiceServer mocks base method.
+func (m *MockUnsafeHistoryEventFeedServiceServer) mustEmbedUnimplementedHistoryEventFeedServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedHistoryEventFeedServiceServer")
+}
+
+// mustEmbedUnimplementedHistoryEventFeedServiceServer indicates an expected call of mustEmbedUnimplementedHistoryEventFeedServiceServer.
+func (mr *MockUnsafeHistoryEventFeedServiceServerMockRecorder) mustEmbedUnimplementedHistoryEventFeedServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedHistoryEventFeedServiceServer", reflect.TypeOf((*MockUnsafeHistoryEventFeedServiceServer)(nil).mustEmbedUnimplementedHistoryEventFeedServiceServer))
+}
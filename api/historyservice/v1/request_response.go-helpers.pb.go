@@ -2669,6 +2669,450 @@ func (this *CloseShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type PinShardRequest to the protobuf v3 wire format
+func (val *PinShardRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PinShardRequest from the protobuf v3 wire format
+func (val *PinShardRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PinShardRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PinShardRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PinShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PinShardRequest
+	switch t := that.(type) {
+	case *PinShardRequest:
+		that1 = t
+	case PinShardRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PinShardResponse to the protobuf v3 wire format
+func (val *PinShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PinShardResponse from the protobuf v3 wire format
+func (val *PinShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PinShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PinShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PinShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PinShardResponse
+	switch t := that.(type) {
+	case *PinShardResponse:
+		that1 = t
+	case PinShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpinShardRequest to the protobuf v3 wire format
+func (val *UnpinShardRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpinShardRequest from the protobuf v3 wire format
+func (val *UnpinShardRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpinShardRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpinShardRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpinShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpinShardRequest
+	switch t := that.(type) {
+	case *UnpinShardRequest:
+		that1 = t
+	case UnpinShardRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpinShardResponse to the protobuf v3 wire format
+func (val *UnpinShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpinShardResponse from the protobuf v3 wire format
+func (val *UnpinShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpinShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpinShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpinShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpinShardResponse
+	switch t := that.(type) {
+	case *UnpinShardResponse:
+		that1 = t
+	case UnpinShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskSchedulerRequest to the protobuf v3 wire format
+func (val *DescribeTaskSchedulerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskSchedulerRequest from the protobuf v3 wire format
+func (val *DescribeTaskSchedulerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskSchedulerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskSchedulerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskSchedulerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskSchedulerRequest
+	switch t := that.(type) {
+	case *DescribeTaskSchedulerRequest:
+		that1 = t
+	case DescribeTaskSchedulerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskSchedulerResponse to the protobuf v3 wire format
+func (val *DescribeTaskSchedulerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskSchedulerResponse from the protobuf v3 wire format
+func (val *DescribeTaskSchedulerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskSchedulerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskSchedulerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskSchedulerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskSchedulerResponse
+	switch t := that.(type) {
+	case *DescribeTaskSchedulerResponse:
+		that1 = t
+	case DescribeTaskSchedulerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskSchedulerNamespaceWeightRequest to the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskSchedulerNamespaceWeightRequest from the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskSchedulerNamespaceWeightRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskSchedulerNamespaceWeightRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskSchedulerNamespaceWeightRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskSchedulerNamespaceWeightRequest
+	switch t := that.(type) {
+	case *UpdateTaskSchedulerNamespaceWeightRequest:
+		that1 = t
+	case UpdateTaskSchedulerNamespaceWeightRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskSchedulerNamespaceWeightResponse to the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskSchedulerNamespaceWeightResponse from the protobuf v3 wire format
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskSchedulerNamespaceWeightResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskSchedulerNamespaceWeightResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskSchedulerNamespaceWeightResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskSchedulerNamespaceWeightResponse
+	switch t := that.(type) {
+	case *UpdateTaskSchedulerNamespaceWeightResponse:
+		that1 = t
+	case UpdateTaskSchedulerNamespaceWeightResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskTraceRequest to the protobuf v3 wire format
+func (val *GetTaskTraceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskTraceRequest from the protobuf v3 wire format
+func (val *GetTaskTraceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskTraceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskTraceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskTraceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskTraceRequest
+	switch t := that.(type) {
+	case *GetTaskTraceRequest:
+		that1 = t
+	case GetTaskTraceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskTraceResponse to the protobuf v3 wire format
+func (val *GetTaskTraceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskTraceResponse from the protobuf v3 wire format
+func (val *GetTaskTraceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskTraceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskTraceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskTraceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskTraceResponse
+	switch t := that.(type) {
+	case *GetTaskTraceResponse:
+		that1 = t
+	case GetTaskTraceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListQueueMitigationsRequest to the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListQueueMitigationsRequest from the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListQueueMitigationsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListQueueMitigationsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListQueueMitigationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListQueueMitigationsRequest
+	switch t := that.(type) {
+	case *ListQueueMitigationsRequest:
+		that1 = t
+	case ListQueueMitigationsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListQueueMitigationsResponse to the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListQueueMitigationsResponse from the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListQueueMitigationsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListQueueMitigationsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListQueueMitigationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListQueueMitigationsResponse
+	switch t := that.(type) {
+	case *ListQueueMitigationsResponse:
+		that1 = t
+	case ListQueueMitigationsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GetShardRequest to the protobuf v3 wire format
 func (val *GetShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3668,6 +4112,43 @@ func (this *ShardReplicationStatusPerCluster) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NamespaceReplicationBacklog to the protobuf v3 wire format
+func (val *NamespaceReplicationBacklog) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceReplicationBacklog from the protobuf v3 wire format
+func (val *NamespaceReplicationBacklog) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceReplicationBacklog) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceReplicationBacklog values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceReplicationBacklog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceReplicationBacklog
+	switch t := that.(type) {
+	case *NamespaceReplicationBacklog:
+		that1 = t
+	case NamespaceReplicationBacklog:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type RebuildMutableStateRequest to the protobuf v3 wire format
 func (val *RebuildMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -55,6 +55,12 @@ const (
 	HistoryService_DescribeMutableState_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState"
 	HistoryService_DescribeHistoryHost_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost"
 	HistoryService_CloseShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/CloseShard"
+	HistoryService_PinShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/PinShard"
+	HistoryService_UnpinShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/UnpinShard"
+	HistoryService_DescribeTaskScheduler_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/DescribeTaskScheduler"
+	HistoryService_UpdateTaskSchedulerNamespaceWeight_FullMethodName     = "/temporal.server.api.historyservice.v1.HistoryService/UpdateTaskSchedulerNamespaceWeight"
+	HistoryService_GetTaskTrace_FullMethodName                           = "/temporal.server.api.historyservice.v1.HistoryService/GetTaskTrace"
+	HistoryService_ListQueueMitigations_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/ListQueueMitigations"
 	HistoryService_GetShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/GetShard"
 	HistoryService_RemoveTask_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask"
 	HistoryService_GetReplicationMessages_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationMessages"
@@ -246,6 +252,24 @@ type HistoryServiceClient interface {
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	// CloseShard close the shard.
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
+	// PinShard pins a shard to the history host the request is sent to. The host then acquires the shard from its
+	// current owner, and keeps it until the shard is unpinned or the host leaves the membership ring.
+	PinShard(ctx context.Context, in *PinShardRequest, opts ...grpc.CallOption) (*PinShardResponse, error)
+	// UnpinShard removes the pin of a shard from the history host that owns it, so the shard moves back to the host
+	// membership assigns it to.
+	UnpinShard(ctx context.Context, in *UnpinShardRequest, opts ...grpc.CallOption) (*UnpinShardResponse, error)
+	// DescribeTaskScheduler returns the task channels of the host level task schedulers of the history host the request
+	// is sent to, and the namespace weight overrides applied to them.
+	DescribeTaskScheduler(ctx context.Context, in *DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*DescribeTaskSchedulerResponse, error)
+	// UpdateTaskSchedulerNamespaceWeight sets or removes a temporary multiplier of the task channel weights of a
+	// namespace on the history host the request is sent to.
+	UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*UpdateTaskSchedulerNamespaceWeightResponse, error)
+	// GetTaskTrace returns the recorded lifecycle of a sampled history task of a shard. Only tasks traced since the
+	// shard was loaded by its current owner are returned.
+	GetTaskTrace(ctx context.Context, in *GetTaskTraceRequest, opts ...grpc.CallOption) (*GetTaskTraceResponse, error)
+	// ListQueueMitigations returns the actions recently run by the queues of a shard to mitigate their alerts. Only
+	// mitigations applied by the current owner of the shard are returned.
+	ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
@@ -716,6 +740,60 @@ func (c *historyServiceClient) CloseShard(ctx context.Context, in *CloseShardReq
 	return out, nil
 }
 
+func (c *historyServiceClient) PinShard(ctx context.Context, in *PinShardRequest, opts ...grpc.CallOption) (*PinShardResponse, error) {
+	out := new(PinShardResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PinShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpinShard(ctx context.Context, in *UnpinShardRequest, opts ...grpc.CallOption) (*UnpinShardResponse, error) {
+	out := new(UnpinShardResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UnpinShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeTaskScheduler(ctx context.Context, in *DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*DescribeTaskSchedulerResponse, error) {
+	out := new(DescribeTaskSchedulerResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeTaskScheduler_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	out := new(UpdateTaskSchedulerNamespaceWeightResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpdateTaskSchedulerNamespaceWeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetTaskTrace(ctx context.Context, in *GetTaskTraceRequest, opts ...grpc.CallOption) (*GetTaskTraceResponse, error) {
+	out := new(GetTaskTraceResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetTaskTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error) {
+	out := new(ListQueueMitigationsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListQueueMitigations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error) {
 	out := new(GetShardResponse)
 	err := c.cc.Invoke(ctx, HistoryService_GetShard_FullMethodName, in, out, opts...)
@@ -1271,6 +1349,24 @@ type HistoryServiceServer interface {
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	// CloseShard close the shard.
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	// PinShard pins a shard to the history host the request is sent to. The host then acquires the shard from its
+	// current owner, and keeps it until the shard is unpinned or the host leaves the membership ring.
+	PinShard(context.Context, *PinShardRequest) (*PinShardResponse, error)
+	// UnpinShard removes the pin of a shard from the history host that owns it, so the shard moves back to the host
+	// membership assigns it to.
+	UnpinShard(context.Context, *UnpinShardRequest) (*UnpinShardResponse, error)
+	// DescribeTaskScheduler returns the task channels of the host level task schedulers of the history host the request
+	// is sent to, and the namespace weight overrides applied to them.
+	DescribeTaskScheduler(context.Context, *DescribeTaskSchedulerRequest) (*DescribeTaskSchedulerResponse, error)
+	// UpdateTaskSchedulerNamespaceWeight sets or removes a temporary multiplier of the task channel weights of a
+	// namespace on the history host the request is sent to.
+	UpdateTaskSchedulerNamespaceWeight(context.Context, *UpdateTaskSchedulerNamespaceWeightRequest) (*UpdateTaskSchedulerNamespaceWeightResponse, error)
+	// GetTaskTrace returns the recorded lifecycle of a sampled history task of a shard. Only tasks traced since the
+	// shard was loaded by its current owner are returned.
+	GetTaskTrace(context.Context, *GetTaskTraceRequest) (*GetTaskTraceResponse, error)
+	// ListQueueMitigations returns the actions recently run by the queues of a shard to mitigate their alerts. Only
+	// mitigations applied by the current owner of the shard are returned.
+	ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
@@ -1528,6 +1624,24 @@ func (UnimplementedHistoryServiceServer) DescribeHistoryHost(context.Context, *D
 func (UnimplementedHistoryServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
+func (UnimplementedHistoryServiceServer) PinShard(context.Context, *PinShardRequest) (*PinShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinShard not implemented")
+}
+func (UnimplementedHistoryServiceServer) UnpinShard(context.Context, *UnpinShardRequest) (*UnpinShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinShard not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeTaskScheduler(context.Context, *DescribeTaskSchedulerRequest) (*DescribeTaskSchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskScheduler not implemented")
+}
+func (UnimplementedHistoryServiceServer) UpdateTaskSchedulerNamespaceWeight(context.Context, *UpdateTaskSchedulerNamespaceWeightRequest) (*UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskSchedulerNamespaceWeight not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetTaskTrace(context.Context, *GetTaskTraceRequest) (*GetTaskTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskTrace not implemented")
+}
+func (UnimplementedHistoryServiceServer) ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueMitigations not implemented")
+}
 func (UnimplementedHistoryServiceServer) GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
@@ -2300,6 +2414,114 @@ func _HistoryService_CloseShard_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_PinShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PinShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PinShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PinShard(ctx, req.(*PinShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpinShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpinShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UnpinShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpinShard(ctx, req.(*UnpinShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeTaskScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskSchedulerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeTaskScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeTaskScheduler_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeTaskScheduler(ctx, req.(*DescribeTaskSchedulerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateTaskSchedulerNamespaceWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskSchedulerNamespaceWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateTaskSchedulerNamespaceWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpdateTaskSchedulerNamespaceWeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateTaskSchedulerNamespaceWeight(ctx, req.(*UpdateTaskSchedulerNamespaceWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetTaskTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetTaskTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetTaskTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetTaskTrace(ctx, req.(*GetTaskTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ListQueueMitigations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueMitigationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListQueueMitigations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListQueueMitigations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListQueueMitigations(ctx, req.(*ListQueueMitigationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShardRequest)
 	if err := dec(in); err != nil {
@@ -3229,6 +3451,30 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseShard",
 			Handler:    _HistoryService_CloseShard_Handler,
 		},
+		{
+			MethodName: "PinShard",
+			Handler:    _HistoryService_PinShard_Handler,
+		},
+		{
+			MethodName: "UnpinShard",
+			Handler:    _HistoryService_UnpinShard_Handler,
+		},
+		{
+			MethodName: "DescribeTaskScheduler",
+			Handler:    _HistoryService_DescribeTaskScheduler_Handler,
+		},
+		{
+			MethodName: "UpdateTaskSchedulerNamespaceWeight",
+			Handler:    _HistoryService_UpdateTaskSchedulerNamespaceWeight_Handler,
+		},
+		{
+			MethodName: "GetTaskTrace",
+			Handler:    _HistoryService_GetTaskTrace_Handler,
+		},
+		{
+			MethodName: "ListQueueMitigations",
+			Handler:    _HistoryService_ListQueueMitigations_Handler,
+		},
 		{
 			MethodName: "GetShard",
 			Handler:    _HistoryService_GetShard_Handler,
@@ -283,6 +283,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeTaskScheduler mocks base method.
+func (m *MockHistoryServiceClient) DescribeTaskScheduler(ctx context.Context, in *historyservice.DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*historyservice.DescribeTaskSchedulerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskScheduler", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeTaskSchedulerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskScheduler indicates an expected call of DescribeTaskScheduler.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeTaskScheduler(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskScheduler", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeTaskScheduler), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -503,6 +523,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetShard(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetShard), varargs...)
 }
 
+// GetTaskTrace mocks base method.
+func (m *MockHistoryServiceClient) GetTaskTrace(ctx context.Context, in *historyservice.GetTaskTraceRequest, opts ...grpc.CallOption) (*historyservice.GetTaskTraceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskTrace", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetTaskTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskTrace indicates an expected call of GetTaskTrace.
+func (mr *MockHistoryServiceClientMockRecorder) GetTaskTrace(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskTrace", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetTaskTrace), varargs...)
+}
+
 // GetWorkflowExecutionHistory mocks base method.
 func (m *MockHistoryServiceClient) GetWorkflowExecutionHistory(ctx context.Context, in *historyservice.GetWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (*historyservice.GetWorkflowExecutionHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +703,26 @@ func (mr *MockHistoryServiceClientMockRecorder) IsWorkflowTaskValid(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceClient)(nil).IsWorkflowTaskValid), varargs...)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockHistoryServiceClient) ListQueueMitigations(ctx context.Context, in *historyservice.ListQueueMitigationsRequest, opts ...grpc.CallOption) (*historyservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQueueMitigations", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockHistoryServiceClientMockRecorder) ListQueueMitigations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListQueueMitigations), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceClient) ListQueues(ctx context.Context, in *historyservice.ListQueuesRequest, opts ...grpc.CallOption) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -763,6 +823,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PauseWorkflowExecution(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseWorkflowExecution), varargs...)
 }
 
+// PinShard mocks base method.
+func (m *MockHistoryServiceClient) PinShard(ctx context.Context, in *historyservice.PinShardRequest, opts ...grpc.CallOption) (*historyservice.PinShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PinShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.PinShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinShard indicates an expected call of PinShard.
+func (mr *MockHistoryServiceClientMockRecorder) PinShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).PinShard), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1503,6 +1583,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
 }
 
+// UnpinShard mocks base method.
+func (m *MockHistoryServiceClient) UnpinShard(ctx context.Context, in *historyservice.UnpinShardRequest, opts ...grpc.CallOption) (*historyservice.UnpinShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpinShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpinShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinShard indicates an expected call of UnpinShard.
+func (mr *MockHistoryServiceClientMockRecorder) UnpinShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpinShard), varargs...)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceClient) UpdateActivityOptions(ctx context.Context, in *historyservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1523,6 +1623,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityOptions(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityOptions), varargs...)
 }
 
+// UpdateTaskSchedulerNamespaceWeight mocks base method.
+func (m *MockHistoryServiceClient) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, in *historyservice.UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskSchedulerNamespaceWeight", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateTaskSchedulerNamespaceWeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSchedulerNamespaceWeight indicates an expected call of UpdateTaskSchedulerNamespaceWeight.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateTaskSchedulerNamespaceWeight(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSchedulerNamespaceWeight", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateTaskSchedulerNamespaceWeight), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1945,6 +2065,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeTaskScheduler mocks base method.
+func (m *MockHistoryServiceServer) DescribeTaskScheduler(arg0 context.Context, arg1 *historyservice.DescribeTaskSchedulerRequest) (*historyservice.DescribeTaskSchedulerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskScheduler", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeTaskSchedulerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskScheduler indicates an expected call of DescribeTaskScheduler.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeTaskScheduler(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskScheduler", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeTaskScheduler), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -2110,6 +2245,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetShard(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetShard), arg0, arg1)
 }
 
+// GetTaskTrace mocks base method.
+func (m *MockHistoryServiceServer) GetTaskTrace(arg0 context.Context, arg1 *historyservice.GetTaskTraceRequest) (*historyservice.GetTaskTraceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskTrace", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetTaskTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskTrace indicates an expected call of GetTaskTrace.
+func (mr *MockHistoryServiceServerMockRecorder) GetTaskTrace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskTrace", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetTaskTrace), arg0, arg1)
+}
+
 // GetWorkflowExecutionHistory mocks base method.
 func (m *MockHistoryServiceServer) GetWorkflowExecutionHistory(arg0 context.Context, arg1 *historyservice.GetWorkflowExecutionHistoryRequest) (*historyservice.GetWorkflowExecutionHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -2230,6 +2380,21 @@ func (mr *MockHistoryServiceServerMockRecorder) IsWorkflowTaskValid(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceServer)(nil).IsWorkflowTaskValid), arg0, arg1)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockHistoryServiceServer) ListQueueMitigations(arg0 context.Context, arg1 *historyservice.ListQueueMitigationsRequest) (*historyservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMitigations", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockHistoryServiceServerMockRecorder) ListQueueMitigations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListQueueMitigations), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceServer) ListQueues(arg0 context.Context, arg1 *historyservice.ListQueuesRequest) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2305,6 +2470,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
 }
 
+// PinShard mocks base method.
+func (m *MockHistoryServiceServer) PinShard(arg0 context.Context, arg1 *historyservice.PinShardRequest) (*historyservice.PinShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PinShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinShard indicates an expected call of PinShard.
+func (mr *MockHistoryServiceServerMockRecorder) PinShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).PinShard), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2859,6 +3039,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
 }
 
+// UnpinShard mocks base method.
+func (m *MockHistoryServiceServer) UnpinShard(arg0 context.Context, arg1 *historyservice.UnpinShardRequest) (*historyservice.UnpinShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpinShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinShard indicates an expected call of UnpinShard.
+func (mr *MockHistoryServiceServerMockRecorder) UnpinShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpinShard), arg0, arg1)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -2874,6 +3069,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
 }
 
+// UpdateTaskSchedulerNamespaceWeight mocks base method.
+func (m *MockHistoryServiceServer) UpdateTaskSchedulerNamespaceWeight(arg0 context.Context, arg1 *historyservice.UpdateTaskSchedulerNamespaceWeightRequest) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskSchedulerNamespaceWeight", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateTaskSchedulerNamespaceWeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSchedulerNamespaceWeight indicates an expected call of UpdateTaskSchedulerNamespaceWeight.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateTaskSchedulerNamespaceWeight(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSchedulerNamespaceWeight", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateTaskSchedulerNamespaceWeight), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +79,80 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ConflictResolutionRecord to the protobuf v3 wire format
+func (val *ConflictResolutionRecord) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConflictResolutionRecord from the protobuf v3 wire format
+func (val *ConflictResolutionRecord) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConflictResolutionRecord) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConflictResolutionRecord values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConflictResolutionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConflictResolutionRecord
+	switch t := that.(type) {
+	case *ConflictResolutionRecord:
+		that1 = t
+	case ConflictResolutionRecord:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConflictResolutionEvent to the protobuf v3 wire format
+func (val *ConflictResolutionEvent) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConflictResolutionEvent from the protobuf v3 wire format
+func (val *ConflictResolutionEvent) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConflictResolutionEvent) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConflictResolutionEvent values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConflictResolutionEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConflictResolutionEvent
+	switch t := that.(type) {
+	case *ConflictResolutionEvent:
+		that1 = t
+	case ConflictResolutionEvent:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TimeSkippingInfo to the protobuf v3 wire format
 func (val *TimeSkippingInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
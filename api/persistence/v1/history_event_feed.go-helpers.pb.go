@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package persistence
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type HistoryEventFeedRecord to the protobuf v3 wire format
+func (val *HistoryEventFeedRecord) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryEventFeedRecord from the protobuf v3 wire format
+func (val *HistoryEventFeedRecord) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryEventFeedRecord) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryEventFeedRecord values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryEventFeedRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryEventFeedRecord
+	switch t := that.(type) {
+	case *HistoryEventFeedRecord:
+		that1 = t
+	case HistoryEventFeedRecord:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/history_event_feed.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/history/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A record of the history event feed of a namespace. Records are published when a workflow execution is closed, and
+// hold a page of its history events. A history that does not fit in one page is published as consecutive records.
+type HistoryEventFeedRecord struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId  string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId   string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId        string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WorkflowType string                 `protobuf:"bytes,4,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	CloseTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Events       []*v1.HistoryEvent     `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	// Time the record was published to the feed. The feed retention deletes records by publish time.
+	PublishTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryEventFeedRecord) Reset() {
+	*x = HistoryEventFeedRecord{}
+	mi := &file_temporal_server_api_persistence_v1_history_event_feed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryEventFeedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEventFeedRecord) ProtoMessage() {}
+
+func (x *HistoryEventFeedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_history_event_feed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEventFeedRecord.ProtoReflect.Descriptor instead.
+func (*HistoryEventFeedRecord) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HistoryEventFeedRecord) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *HistoryEventFeedRecord) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *HistoryEventFeedRecord) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *HistoryEventFeedRecord) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *HistoryEventFeedRecord) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *HistoryEventFeedRecord) GetEvents() []*v1.HistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *HistoryEventFeedRecord) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+var File_temporal_server_api_persistence_v1_history_event_feed_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDesc = "" +
+	"\n" +
+	";temporal/server/api/persistence/v1/history_event_feed.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%temporal/api/history/v1/message.proto\"\xd1\x02\n" +
+	"\x16HistoryEventFeedRecord\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12#\n" +
+	"\rworkflow_type\x18\x04 \x01(\tR\fworkflowType\x129\n" +
+	"\n" +
+	"close_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12=\n" +
+	"\x06events\x18\x06 \x03(\v2%.temporal.api.history.v1.HistoryEventR\x06events\x12=\n" +
+	"\fpublish_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTimeB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDesc), len(file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_history_event_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_temporal_server_api_persistence_v1_history_event_feed_proto_goTypes = []any{
+	(*HistoryEventFeedRecord)(nil), // 0: temporal.server.api.persistence.v1.HistoryEventFeedRecord
+	(*timestamppb.Timestamp)(nil),  // 1: google.protobuf.Timestamp
+	(*v1.HistoryEvent)(nil),        // 2: temporal.api.history.v1.HistoryEvent
+}
+var file_temporal_server_api_persistence_v1_history_event_feed_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.persistence.v1.HistoryEventFeedRecord.close_time:type_name -> google.protobuf.Timestamp
+	2, // 1: temporal.server.api.persistence.v1.HistoryEventFeedRecord.events:type_name -> temporal.api.history.v1.HistoryEvent
+	1, // 2: temporal.server.api.persistence.v1.HistoryEventFeedRecord.publish_time:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_history_event_feed_proto_init() }
+func file_temporal_server_api_persistence_v1_history_event_feed_proto_init() {
+	if File_temporal_server_api_persistence_v1_history_event_feed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDesc), len(file_temporal_server_api_persistence_v1_history_event_feed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_history_event_feed_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_history_event_feed_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_history_event_feed_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_history_event_feed_proto = out.File
+	file_temporal_server_api_persistence_v1_history_event_feed_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_history_event_feed_proto_depIdxs = nil
+}
@@ -215,8 +215,13 @@ type NamespaceConfig struct {
 	VisibilityArchivalUri        string                       `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
 	CustomSearchAttributeAliases map[string]string            `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	WorkflowRules                map[string]*v12.WorkflowRule `protobuf:"bytes,9,rep,name=workflow_rules,json=workflowRules,proto3" json:"workflow_rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+	// shard_pool is the history shard pool the workflows of the namespace are placed on, recorded with the shard range
+	// of the pool when the namespace is assigned to it. Pools are defined by the history.shardPools dynamic config,
+	// but routing only uses the recorded range so that editing the dynamic config can't move existing executions.
+	// Unset means all shards. Shard pools are local to a cluster, so this is not replicated.
+	ShardPool     *ShardPoolAssignment `protobuf:"bytes,10,opt,name=shard_pool,json=shardPool,proto3" json:"shard_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NamespaceConfig) Reset() {
@@ -312,19 +317,92 @@ func (x *NamespaceConfig) GetWorkflowRules() map[string]*v12.WorkflowRule {
 	return nil
 }
 
+func (x *NamespaceConfig) GetShardPool() *ShardPoolAssignment {
+	if x != nil {
+		return x.ShardPool
+	}
+	return nil
+}
+
+// ShardPoolAssignment is the history shard pool a namespace is assigned to.
+type ShardPoolAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// first_shard_id and last_shard_id are the first and last shard of the pool, inclusive.
+	FirstShardId  int32 `protobuf:"varint,2,opt,name=first_shard_id,json=firstShardId,proto3" json:"first_shard_id,omitempty"`
+	LastShardId   int32 `protobuf:"varint,3,opt,name=last_shard_id,json=lastShardId,proto3" json:"last_shard_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardPoolAssignment) Reset() {
+	*x = ShardPoolAssignment{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardPoolAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardPoolAssignment) ProtoMessage() {}
+
+func (x *ShardPoolAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardPoolAssignment.ProtoReflect.Descriptor instead.
+func (*ShardPoolAssignment) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShardPoolAssignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShardPoolAssignment) GetFirstShardId() int32 {
+	if x != nil {
+		return x.FirstShardId
+	}
+	return 0
+}
+
+func (x *ShardPoolAssignment) GetLastShardId() int32 {
+	if x != nil {
+		return x.LastShardId
+	}
+	return 0
+}
+
 type NamespaceReplicationConfig struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ActiveClusterName string                 `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string               `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	State             v1.ReplicationState    `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.api.enums.v1.ReplicationState" json:"state,omitempty"`
 	FailoverHistory   []*FailoverStatus      `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	// replication_filter restricts the workflows of a global namespace which are replicated to the other clusters. It's
+	// evaluated by the cluster which starts a workflow. Replication filters are local to a cluster, so this is not
+	// replicated.
+	ReplicationFilter *ReplicationFilter `protobuf:"bytes,9,opt,name=replication_filter,json=replicationFilter,proto3" json:"replication_filter,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *NamespaceReplicationConfig) Reset() {
 	*x = NamespaceReplicationConfig{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +414,7 @@ func (x *NamespaceReplicationConfig) String() string {
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 
 func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +427,7 @@ func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceReplicationConfig.ProtoReflect.Descriptor instead.
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NamespaceReplicationConfig) GetActiveClusterName() string {
@@ -380,6 +458,121 @@ func (x *NamespaceReplicationConfig) GetFailoverHistory() []*FailoverStatus {
 	return nil
 }
 
+func (x *NamespaceReplicationConfig) GetReplicationFilter() *ReplicationFilter {
+	if x != nil {
+		return x.ReplicationFilter
+	}
+	return nil
+}
+
+// ReplicationFilter selects the workflows of a global namespace which are replicated to the other clusters of the
+// namespace. A workflow is replicated if it matches any of the workflow types or any of the search attribute values it
+// started with. An empty filter replicates every workflow.
+type ReplicationFilter struct {
+	state            protoimpl.MessageState              `protogen:"open.v1"`
+	WorkflowTypes    []string                            `protobuf:"bytes,1,rep,name=workflow_types,json=workflowTypes,proto3" json:"workflow_types,omitempty"`
+	SearchAttributes []*SearchAttributeReplicationFilter `protobuf:"bytes,2,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReplicationFilter) Reset() {
+	*x = ReplicationFilter{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationFilter) ProtoMessage() {}
+
+func (x *ReplicationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationFilter.ProtoReflect.Descriptor instead.
+func (*ReplicationFilter) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplicationFilter) GetWorkflowTypes() []string {
+	if x != nil {
+		return x.WorkflowTypes
+	}
+	return nil
+}
+
+func (x *ReplicationFilter) GetSearchAttributes() []*SearchAttributeReplicationFilter {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+type SearchAttributeReplicationFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of a keyword or keyword list search attribute.
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAttributeReplicationFilter) Reset() {
+	*x = SearchAttributeReplicationFilter{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeReplicationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeReplicationFilter) ProtoMessage() {}
+
+func (x *SearchAttributeReplicationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeReplicationFilter.ProtoReflect.Descriptor instead.
+func (*SearchAttributeReplicationFilter) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchAttributeReplicationFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchAttributeReplicationFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // Represents a historical replication status of a Namespace
 type FailoverStatus struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -391,7 +584,7 @@ type FailoverStatus struct {
 
 func (x *FailoverStatus) Reset() {
 	*x = FailoverStatus{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +596,7 @@ func (x *FailoverStatus) String() string {
 func (*FailoverStatus) ProtoMessage() {}
 
 func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +609,7 @@ func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailoverStatus.ProtoReflect.Descriptor instead.
 func (*FailoverStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FailoverStatus) GetFailoverTime() *timestamppb.Timestamp {
@@ -455,7 +648,7 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x04data\x18\x06 \x03(\v2;.temporal.server.api.persistence.v1.NamespaceInfo.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\a\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\b\n" +
 	"\x0fNamespaceConfig\x127\n" +
 	"\tretention\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12'\n" +
 	"\x0farchival_bucket\x18\x02 \x01(\tR\x0earchivalBucket\x12I\n" +
@@ -465,18 +658,32 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x19visibility_archival_state\x18\x06 \x01(\x0e2$.temporal.api.enums.v1.ArchivalStateR\x17visibilityArchivalState\x126\n" +
 	"\x17visibility_archival_uri\x18\a \x01(\tR\x15visibilityArchivalUri\x12\x9c\x01\n" +
 	"\x1fcustom_search_attribute_aliases\x18\b \x03(\v2U.temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntryR\x1ccustomSearchAttributeAliases\x12m\n" +
-	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x1aO\n" +
+	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x12V\n" +
+	"\n" +
+	"shard_pool\x18\n" +
+	" \x01(\v27.temporal.server.api.persistence.v1.ShardPoolAssignmentR\tshardPool\x1aO\n" +
 	"!CustomSearchAttributeAliasesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ae\n" +
 	"\x12WorkflowRulesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\"\x86\x02\n" +
+	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\"s\n" +
+	"\x13ShardPoolAssignment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
+	"\x0efirst_shard_id\x18\x02 \x01(\x05R\ffirstShardId\x12\"\n" +
+	"\rlast_shard_id\x18\x03 \x01(\x05R\vlastShardId\"\xec\x02\n" +
 	"\x1aNamespaceReplicationConfig\x12.\n" +
 	"\x13active_cluster_name\x18\x01 \x01(\tR\x11activeClusterName\x12\x1a\n" +
 	"\bclusters\x18\x02 \x03(\tR\bclusters\x12=\n" +
 	"\x05state\x18\x03 \x01(\x0e2'.temporal.api.enums.v1.ReplicationStateR\x05state\x12]\n" +
-	"\x10failover_history\x18\b \x03(\v22.temporal.server.api.persistence.v1.FailoverStatusR\x0ffailoverHistory\"|\n" +
+	"\x10failover_history\x18\b \x03(\v22.temporal.server.api.persistence.v1.FailoverStatusR\x0ffailoverHistory\x12d\n" +
+	"\x12replication_filter\x18\t \x01(\v25.temporal.server.api.persistence.v1.ReplicationFilterR\x11replicationFilter\"\xad\x01\n" +
+	"\x11ReplicationFilter\x12%\n" +
+	"\x0eworkflow_types\x18\x01 \x03(\tR\rworkflowTypes\x12q\n" +
+	"\x11search_attributes\x18\x02 \x03(\v2D.temporal.server.api.persistence.v1.SearchAttributeReplicationFilterR\x10searchAttributes\"N\n" +
+	" SearchAttributeReplicationFilter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"|\n" +
 	"\x0eFailoverStatus\x12?\n" +
 	"\rfailover_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ffailoverTime\x12)\n" +
 	"\x10failover_version\x18\x02 \x01(\x03R\x0ffailoverVersionB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
@@ -493,46 +700,52 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP() []by
 	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_temporal_server_api_persistence_v1_namespaces_proto_goTypes = []any{
-	(*NamespaceDetail)(nil),            // 0: temporal.server.api.persistence.v1.NamespaceDetail
-	(*NamespaceInfo)(nil),              // 1: temporal.server.api.persistence.v1.NamespaceInfo
-	(*NamespaceConfig)(nil),            // 2: temporal.server.api.persistence.v1.NamespaceConfig
-	(*NamespaceReplicationConfig)(nil), // 3: temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	(*FailoverStatus)(nil),             // 4: temporal.server.api.persistence.v1.FailoverStatus
-	nil,                                // 5: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	nil,                                // 6: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	nil,                                // 7: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(v1.NamespaceState)(0),             // 9: temporal.api.enums.v1.NamespaceState
-	(*durationpb.Duration)(nil),        // 10: google.protobuf.Duration
-	(*v11.BadBinaries)(nil),            // 11: temporal.api.namespace.v1.BadBinaries
-	(v1.ArchivalState)(0),              // 12: temporal.api.enums.v1.ArchivalState
-	(v1.ReplicationState)(0),           // 13: temporal.api.enums.v1.ReplicationState
-	(*v12.WorkflowRule)(nil),           // 14: temporal.api.rules.v1.WorkflowRule
+	(*NamespaceDetail)(nil),                  // 0: temporal.server.api.persistence.v1.NamespaceDetail
+	(*NamespaceInfo)(nil),                    // 1: temporal.server.api.persistence.v1.NamespaceInfo
+	(*NamespaceConfig)(nil),                  // 2: temporal.server.api.persistence.v1.NamespaceConfig
+	(*ShardPoolAssignment)(nil),              // 3: temporal.server.api.persistence.v1.ShardPoolAssignment
+	(*NamespaceReplicationConfig)(nil),       // 4: temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	(*ReplicationFilter)(nil),                // 5: temporal.server.api.persistence.v1.ReplicationFilter
+	(*SearchAttributeReplicationFilter)(nil), // 6: temporal.server.api.persistence.v1.SearchAttributeReplicationFilter
+	(*FailoverStatus)(nil),                   // 7: temporal.server.api.persistence.v1.FailoverStatus
+	nil,                                      // 8: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	nil,                                      // 9: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	nil,                                      // 10: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	(*timestamppb.Timestamp)(nil),            // 11: google.protobuf.Timestamp
+	(v1.NamespaceState)(0),                   // 12: temporal.api.enums.v1.NamespaceState
+	(*durationpb.Duration)(nil),              // 13: google.protobuf.Duration
+	(*v11.BadBinaries)(nil),                  // 14: temporal.api.namespace.v1.BadBinaries
+	(v1.ArchivalState)(0),                    // 15: temporal.api.enums.v1.ArchivalState
+	(v1.ReplicationState)(0),                 // 16: temporal.api.enums.v1.ReplicationState
+	(*v12.WorkflowRule)(nil),                 // 17: temporal.api.rules.v1.WorkflowRule
 }
 var file_temporal_server_api_persistence_v1_namespaces_proto_depIdxs = []int32{
 	1,  // 0: temporal.server.api.persistence.v1.NamespaceDetail.info:type_name -> temporal.server.api.persistence.v1.NamespaceInfo
 	2,  // 1: temporal.server.api.persistence.v1.NamespaceDetail.config:type_name -> temporal.server.api.persistence.v1.NamespaceConfig
-	3,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	8,  // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
-	5,  // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	10, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
-	11, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
-	12, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	12, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	6,  // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	7,  // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	13, // 12: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
-	4,  // 13: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
-	8,  // 14: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
-	14, // 15: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	4,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	11, // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
+	12, // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
+	8,  // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	13, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
+	14, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
+	15, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	15, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	9,  // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	10, // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	3,  // 12: temporal.server.api.persistence.v1.NamespaceConfig.shard_pool:type_name -> temporal.server.api.persistence.v1.ShardPoolAssignment
+	16, // 13: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
+	7,  // 14: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
+	5,  // 15: temporal.server.api.persistence.v1.NamespaceReplicationConfig.replication_filter:type_name -> temporal.server.api.persistence.v1.ReplicationFilter
+	6,  // 16: temporal.server.api.persistence.v1.ReplicationFilter.search_attributes:type_name -> temporal.server.api.persistence.v1.SearchAttributeReplicationFilter
+	11, // 17: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
+	17, // 18: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_namespaces_proto_init() }
@@ -546,7 +759,7 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc), len(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -376,7 +376,10 @@ type HistoryTask struct {
 	// blob that contains the history task proto. There is a GoLang-specific generic deserializer for this blob, but
 	// there is no common proto for all task proto types, so deserializing in other languages will require a custom
 	// switch on the task category, which should be available from the metadata for the queue that this task came from.
-	Blob          *v1.DataBlob `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Blob *v1.DataBlob `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	// failure is the error which caused the task to be moved to a DLQ. It is only set for tasks in a DLQ, and may be
+	// empty if the error was not recorded.
+	Failure       string `protobuf:"bytes,3,opt,name=failure,proto3" json:"failure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -425,6 +428,13 @@ func (x *HistoryTask) GetBlob() *v1.DataBlob {
 	return nil
 }
 
+func (x *HistoryTask) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
 type QueuePartition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// min_message_id is less than or equal to the id of every message in the queue. The min_message_id is mainly used to
@@ -438,9 +448,13 @@ type QueuePartition struct {
 	// However, such errors surface to clients with an "Unavailable" code, so clients retry, and the id should be updated
 	// soon. Additionally, we only use min_message_id to skip over tombstones, so it will only affect read performance,
 	// not correctness.
-	MinMessageId  int64 `protobuf:"varint,1,opt,name=min_message_id,json=minMessageId,proto3" json:"min_message_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MinMessageId int64 `protobuf:"varint,1,opt,name=min_message_id,json=minMessageId,proto3" json:"min_message_id,omitempty"`
+	// min_next_message_id is a lower bound for the id of the next message written to the partition. The next id is
+	// normally derived from the last message, which range deletes never remove. Deleting individual messages can remove
+	// the last message though, so this is advanced past every individually deleted id to make sure ids are never reused.
+	MinNextMessageId int64 `protobuf:"varint,2,opt,name=min_next_message_id,json=minNextMessageId,proto3" json:"min_next_message_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *QueuePartition) Reset() {
@@ -480,6 +494,13 @@ func (x *QueuePartition) GetMinMessageId() int64 {
 	return 0
 }
 
+func (x *QueuePartition) GetMinNextMessageId() int64 {
+	if x != nil {
+		return x.MinNextMessageId
+	}
+	return 0
+}
+
 type Queue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A map from partition index (0-based) to the partition metadata.
@@ -551,12 +572,14 @@ const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\x1eReadQueueMessagesNextPageToken\x12/\n" +
 	"\x14last_read_message_id\x18\x01 \x01(\x03R\x11lastReadMessageId\"N\n" +
 	"\x17ListQueuesNextPageToken\x123\n" +
-	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"^\n" +
+	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"x\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
-	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"6\n" +
+	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\x12\x18\n" +
+	"\afailure\x18\x03 \x01(\tR\afailure\"e\n" +
 	"\x0eQueuePartition\x12$\n" +
-	"\x0emin_message_id\x18\x01 \x01(\x03R\fminMessageId\"\xd5\x01\n" +
+	"\x0emin_message_id\x18\x01 \x01(\x03R\fminMessageId\x12-\n" +
+	"\x13min_next_message_id\x18\x02 \x01(\x03R\x10minNextMessageId\"\xd5\x01\n" +
 	"\x05Queue\x12Y\n" +
 	"\n" +
 	"partitions\x18\x01 \x03(\v29.temporal.server.api.persistence.v1.Queue.PartitionsEntryR\n" +
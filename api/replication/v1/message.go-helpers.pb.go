@@ -264,6 +264,80 @@ func (this *WorkflowReplicationMessages) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CompressedReplicationTasks to the protobuf v3 wire format
+func (val *CompressedReplicationTasks) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CompressedReplicationTasks from the protobuf v3 wire format
+func (val *CompressedReplicationTasks) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CompressedReplicationTasks) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CompressedReplicationTasks values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CompressedReplicationTasks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CompressedReplicationTasks
+	switch t := that.(type) {
+	case *CompressedReplicationTasks:
+		that1 = t
+	case CompressedReplicationTasks:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicationTasks to the protobuf v3 wire format
+func (val *ReplicationTasks) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationTasks from the protobuf v3 wire format
+func (val *ReplicationTasks) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationTasks) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationTasks values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationTasks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationTasks
+	switch t := that.(type) {
+	case *ReplicationTasks:
+		that1 = t
+	case ReplicationTasks:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ReplicationTaskInfo to the protobuf v3 wire format
 func (val *ReplicationTaskInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -623,6 +623,9 @@ type WorkflowReplicationMessages struct {
 	ExclusiveHighWatermark     int64                  `protobuf:"varint,2,opt,name=exclusive_high_watermark,json=exclusiveHighWatermark,proto3" json:"exclusive_high_watermark,omitempty"`
 	ExclusiveHighWatermarkTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exclusive_high_watermark_time,json=exclusiveHighWatermarkTime,proto3" json:"exclusive_high_watermark_time,omitempty"`
 	Priority                   v1.TaskPriority        `protobuf:"varint,4,opt,name=priority,proto3,enum=temporal.server.api.enums.v1.TaskPriority" json:"priority,omitempty"`
+	// Set instead of replication_tasks when the sender batched and compressed the tasks. Only sent to receivers
+	// which advertised support for the compression when opening the stream.
+	CompressedReplicationTasks *CompressedReplicationTasks `protobuf:"bytes,5,opt,name=compressed_replication_tasks,json=compressedReplicationTasks,proto3" json:"compressed_replication_tasks,omitempty"`
 	unknownFields              protoimpl.UnknownFields
 	sizeCache                  protoimpl.SizeCache
 }
@@ -685,6 +688,126 @@ func (x *WorkflowReplicationMessages) GetPriority() v1.TaskPriority {
 	return v1.TaskPriority(0)
 }
 
+func (x *WorkflowReplicationMessages) GetCompressedReplicationTasks() *CompressedReplicationTasks {
+	if x != nil {
+		return x.CompressedReplicationTasks
+	}
+	return nil
+}
+
+type CompressedReplicationTasks struct {
+	state       protoimpl.MessageState          `protogen:"open.v1"`
+	Compression v1.ReplicationStreamCompression `protobuf:"varint,1,opt,name=compression,proto3,enum=temporal.server.api.enums.v1.ReplicationStreamCompression" json:"compression,omitempty"`
+	// Compressed serialized ReplicationTasks message.
+	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	TaskCount        int32  `protobuf:"varint,3,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	UncompressedSize int64  `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CompressedReplicationTasks) Reset() {
+	*x = CompressedReplicationTasks{}
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompressedReplicationTasks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressedReplicationTasks) ProtoMessage() {}
+
+func (x *CompressedReplicationTasks) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressedReplicationTasks.ProtoReflect.Descriptor instead.
+func (*CompressedReplicationTasks) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompressedReplicationTasks) GetCompression() v1.ReplicationStreamCompression {
+	if x != nil {
+		return x.Compression
+	}
+	return v1.ReplicationStreamCompression(0)
+}
+
+func (x *CompressedReplicationTasks) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CompressedReplicationTasks) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *CompressedReplicationTasks) GetUncompressedSize() int64 {
+	if x != nil {
+		return x.UncompressedSize
+	}
+	return 0
+}
+
+type ReplicationTasks struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationTasks []*ReplicationTask     `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReplicationTasks) Reset() {
+	*x = ReplicationTasks{}
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicationTasks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationTasks) ProtoMessage() {}
+
+func (x *ReplicationTasks) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationTasks.ProtoReflect.Descriptor instead.
+func (*ReplicationTasks) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplicationTasks) GetReplicationTasks() []*ReplicationTask {
+	if x != nil {
+		return x.ReplicationTasks
+	}
+	return nil
+}
+
 // TODO: Deprecate this definition, it only used by the deprecated replication DLQ v1 logic
 type ReplicationTaskInfo struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -704,7 +827,7 @@ type ReplicationTaskInfo struct {
 
 func (x *ReplicationTaskInfo) Reset() {
 	*x = ReplicationTaskInfo{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -716,7 +839,7 @@ func (x *ReplicationTaskInfo) String() string {
 func (*ReplicationTaskInfo) ProtoMessage() {}
 
 func (x *ReplicationTaskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,7 +852,7 @@ func (x *ReplicationTaskInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplicationTaskInfo.ProtoReflect.Descriptor instead.
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReplicationTaskInfo) GetNamespaceId() string {
@@ -818,7 +941,7 @@ type NamespaceTaskAttributes struct {
 
 func (x *NamespaceTaskAttributes) Reset() {
 	*x = NamespaceTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +953,7 @@ func (x *NamespaceTaskAttributes) String() string {
 func (*NamespaceTaskAttributes) ProtoMessage() {}
 
 func (x *NamespaceTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +966,7 @@ func (x *NamespaceTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceTaskAttributes.ProtoReflect.Descriptor instead.
 func (*NamespaceTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NamespaceTaskAttributes) GetNamespaceOperation() v1.NamespaceOperation {
@@ -913,7 +1036,7 @@ type SyncShardStatusTaskAttributes struct {
 
 func (x *SyncShardStatusTaskAttributes) Reset() {
 	*x = SyncShardStatusTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -925,7 +1048,7 @@ func (x *SyncShardStatusTaskAttributes) String() string {
 func (*SyncShardStatusTaskAttributes) ProtoMessage() {}
 
 func (x *SyncShardStatusTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,7 +1061,7 @@ func (x *SyncShardStatusTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncShardStatusTaskAttributes.ProtoReflect.Descriptor instead.
 func (*SyncShardStatusTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{9}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SyncShardStatusTaskAttributes) GetSourceCluster() string {
@@ -1004,7 +1127,7 @@ type SyncActivityTaskAttributes struct {
 
 func (x *SyncActivityTaskAttributes) Reset() {
 	*x = SyncActivityTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1016,7 +1139,7 @@ func (x *SyncActivityTaskAttributes) String() string {
 func (*SyncActivityTaskAttributes) ProtoMessage() {}
 
 func (x *SyncActivityTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1152,7 @@ func (x *SyncActivityTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncActivityTaskAttributes.ProtoReflect.Descriptor instead.
 func (*SyncActivityTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{10}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SyncActivityTaskAttributes) GetNamespaceId() string {
@@ -1233,7 +1356,7 @@ type HistoryTaskAttributes struct {
 
 func (x *HistoryTaskAttributes) Reset() {
 	*x = HistoryTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1368,7 @@ func (x *HistoryTaskAttributes) String() string {
 func (*HistoryTaskAttributes) ProtoMessage() {}
 
 func (x *HistoryTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1381,7 @@ func (x *HistoryTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryTaskAttributes.ProtoReflect.Descriptor instead.
 func (*HistoryTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{11}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *HistoryTaskAttributes) GetNamespaceId() string {
@@ -1335,7 +1458,7 @@ type SyncWorkflowStateTaskAttributes struct {
 
 func (x *SyncWorkflowStateTaskAttributes) Reset() {
 	*x = SyncWorkflowStateTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +1470,7 @@ func (x *SyncWorkflowStateTaskAttributes) String() string {
 func (*SyncWorkflowStateTaskAttributes) ProtoMessage() {}
 
 func (x *SyncWorkflowStateTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1483,7 @@ func (x *SyncWorkflowStateTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncWorkflowStateTaskAttributes.ProtoReflect.Descriptor instead.
 func (*SyncWorkflowStateTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{12}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SyncWorkflowStateTaskAttributes) GetWorkflowState() *v12.WorkflowMutableState {
@@ -1395,7 +1518,7 @@ type TaskQueueUserDataAttributes struct {
 
 func (x *TaskQueueUserDataAttributes) Reset() {
 	*x = TaskQueueUserDataAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1407,7 +1530,7 @@ func (x *TaskQueueUserDataAttributes) String() string {
 func (*TaskQueueUserDataAttributes) ProtoMessage() {}
 
 func (x *TaskQueueUserDataAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,7 +1543,7 @@ func (x *TaskQueueUserDataAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskQueueUserDataAttributes.ProtoReflect.Descriptor instead.
 func (*TaskQueueUserDataAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TaskQueueUserDataAttributes) GetNamespaceId() string {
@@ -1457,7 +1580,7 @@ type SyncHSMAttributes struct {
 
 func (x *SyncHSMAttributes) Reset() {
 	*x = SyncHSMAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1469,7 +1592,7 @@ func (x *SyncHSMAttributes) String() string {
 func (*SyncHSMAttributes) ProtoMessage() {}
 
 func (x *SyncHSMAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,7 +1605,7 @@ func (x *SyncHSMAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncHSMAttributes.ProtoReflect.Descriptor instead.
 func (*SyncHSMAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SyncHSMAttributes) GetNamespaceId() string {
@@ -1534,7 +1657,7 @@ type BackfillHistoryTaskAttributes struct {
 
 func (x *BackfillHistoryTaskAttributes) Reset() {
 	*x = BackfillHistoryTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1546,7 +1669,7 @@ func (x *BackfillHistoryTaskAttributes) String() string {
 func (*BackfillHistoryTaskAttributes) ProtoMessage() {}
 
 func (x *BackfillHistoryTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,7 +1682,7 @@ func (x *BackfillHistoryTaskAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackfillHistoryTaskAttributes.ProtoReflect.Descriptor instead.
 func (*BackfillHistoryTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BackfillHistoryTaskAttributes) GetNamespaceId() string {
@@ -1614,7 +1737,7 @@ type NewRunInfo struct {
 
 func (x *NewRunInfo) Reset() {
 	*x = NewRunInfo{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[16]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1626,7 +1749,7 @@ func (x *NewRunInfo) String() string {
 func (*NewRunInfo) ProtoMessage() {}
 
 func (x *NewRunInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[16]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,7 +1762,7 @@ func (x *NewRunInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewRunInfo.ProtoReflect.Descriptor instead.
 func (*NewRunInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{16}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NewRunInfo) GetRunId() string {
@@ -1666,7 +1789,7 @@ type SyncWorkflowStateMutationAttributes struct {
 
 func (x *SyncWorkflowStateMutationAttributes) Reset() {
 	*x = SyncWorkflowStateMutationAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[17]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1678,7 +1801,7 @@ func (x *SyncWorkflowStateMutationAttributes) String() string {
 func (*SyncWorkflowStateMutationAttributes) ProtoMessage() {}
 
 func (x *SyncWorkflowStateMutationAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[17]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,7 +1814,7 @@ func (x *SyncWorkflowStateMutationAttributes) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SyncWorkflowStateMutationAttributes.ProtoReflect.Descriptor instead.
 func (*SyncWorkflowStateMutationAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{17}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SyncWorkflowStateMutationAttributes) GetExclusiveStartVersionedTransition() *v12.VersionedTransition {
@@ -1717,7 +1840,7 @@ type SyncWorkflowStateSnapshotAttributes struct {
 
 func (x *SyncWorkflowStateSnapshotAttributes) Reset() {
 	*x = SyncWorkflowStateSnapshotAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[18]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +1852,7 @@ func (x *SyncWorkflowStateSnapshotAttributes) String() string {
 func (*SyncWorkflowStateSnapshotAttributes) ProtoMessage() {}
 
 func (x *SyncWorkflowStateSnapshotAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[18]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,7 +1865,7 @@ func (x *SyncWorkflowStateSnapshotAttributes) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SyncWorkflowStateSnapshotAttributes.ProtoReflect.Descriptor instead.
 func (*SyncWorkflowStateSnapshotAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{18}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SyncWorkflowStateSnapshotAttributes) GetState() *v12.WorkflowMutableState {
@@ -1768,7 +1891,7 @@ type VerifyVersionedTransitionTaskAttributes struct {
 
 func (x *VerifyVersionedTransitionTaskAttributes) Reset() {
 	*x = VerifyVersionedTransitionTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[19]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1780,7 +1903,7 @@ func (x *VerifyVersionedTransitionTaskAttributes) String() string {
 func (*VerifyVersionedTransitionTaskAttributes) ProtoMessage() {}
 
 func (x *VerifyVersionedTransitionTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[19]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +1916,7 @@ func (x *VerifyVersionedTransitionTaskAttributes) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use VerifyVersionedTransitionTaskAttributes.ProtoReflect.Descriptor instead.
 func (*VerifyVersionedTransitionTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{19}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *VerifyVersionedTransitionTaskAttributes) GetNamespaceId() string {
@@ -1859,7 +1982,7 @@ type SyncVersionedTransitionTaskAttributes struct {
 
 func (x *SyncVersionedTransitionTaskAttributes) Reset() {
 	*x = SyncVersionedTransitionTaskAttributes{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[20]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1871,7 +1994,7 @@ func (x *SyncVersionedTransitionTaskAttributes) String() string {
 func (*SyncVersionedTransitionTaskAttributes) ProtoMessage() {}
 
 func (x *SyncVersionedTransitionTaskAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[20]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2007,7 @@ func (x *SyncVersionedTransitionTaskAttributes) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use SyncVersionedTransitionTaskAttributes.ProtoReflect.Descriptor instead.
 func (*SyncVersionedTransitionTaskAttributes) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{20}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SyncVersionedTransitionTaskAttributes) GetVersionedTransitionArtifact() *VersionedTransitionArtifact {
@@ -1940,7 +2063,7 @@ type VersionedTransitionArtifact struct {
 
 func (x *VersionedTransitionArtifact) Reset() {
 	*x = VersionedTransitionArtifact{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[21]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1952,7 +2075,7 @@ func (x *VersionedTransitionArtifact) String() string {
 func (*VersionedTransitionArtifact) ProtoMessage() {}
 
 func (x *VersionedTransitionArtifact) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[21]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,7 +2088,7 @@ func (x *VersionedTransitionArtifact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionedTransitionArtifact.ProtoReflect.Descriptor instead.
 func (*VersionedTransitionArtifact) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{21}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *VersionedTransitionArtifact) GetStateAttributes() isVersionedTransitionArtifact_StateAttributes {
@@ -2064,7 +2187,7 @@ type MigrationExecutionInfo struct {
 
 func (x *MigrationExecutionInfo) Reset() {
 	*x = MigrationExecutionInfo{}
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[22]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2076,7 +2199,7 @@ func (x *MigrationExecutionInfo) String() string {
 func (*MigrationExecutionInfo) ProtoMessage() {}
 
 func (x *MigrationExecutionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[22]
+	mi := &file_temporal_server_api_replication_v1_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,7 +2212,7 @@ func (x *MigrationExecutionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MigrationExecutionInfo.ProtoReflect.Descriptor instead.
 func (*MigrationExecutionInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{22}
+	return file_temporal_server_api_replication_v1_message_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MigrationExecutionInfo) GetBusinessId() string {
@@ -2160,12 +2283,21 @@ const file_temporal_server_api_replication_v1_message_proto_rawDesc = "" +
 	"\x11replication_tasks\x18\x01 \x03(\v23.temporal.server.api.replication.v1.ReplicationTaskR\x10replicationTasks\x129\n" +
 	"\x19last_retrieved_message_id\x18\x02 \x01(\x03R\x16lastRetrievedMessageId\x12\x19\n" +
 	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12_\n" +
-	"\x11sync_shard_status\x18\x04 \x01(\v23.temporal.server.api.replication.v1.SyncShardStatusR\x0fsyncShardStatus\"\xe0\x02\n" +
+	"\x11sync_shard_status\x18\x04 \x01(\v23.temporal.server.api.replication.v1.SyncShardStatusR\x0fsyncShardStatus\"\xe3\x03\n" +
 	"\x1bWorkflowReplicationMessages\x12`\n" +
 	"\x11replication_tasks\x18\x01 \x03(\v23.temporal.server.api.replication.v1.ReplicationTaskR\x10replicationTasks\x128\n" +
 	"\x18exclusive_high_watermark\x18\x02 \x01(\x03R\x16exclusiveHighWatermark\x12]\n" +
 	"\x1dexclusive_high_watermark_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x1aexclusiveHighWatermarkTime\x12F\n" +
-	"\bpriority\x18\x04 \x01(\x0e2*.temporal.server.api.enums.v1.TaskPriorityR\bpriority\"\xa8\x03\n" +
+	"\bpriority\x18\x04 \x01(\x0e2*.temporal.server.api.enums.v1.TaskPriorityR\bpriority\x12\x80\x01\n" +
+	"\x1ccompressed_replication_tasks\x18\x05 \x01(\v2>.temporal.server.api.replication.v1.CompressedReplicationTasksR\x1acompressedReplicationTasks\"\xda\x01\n" +
+	"\x1aCompressedReplicationTasks\x12\\\n" +
+	"\vcompression\x18\x01 \x01(\x0e2:.temporal.server.api.enums.v1.ReplicationStreamCompressionR\vcompression\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x03 \x01(\x05R\ttaskCount\x12+\n" +
+	"\x11uncompressed_size\x18\x04 \x01(\x03R\x10uncompressedSize\"t\n" +
+	"\x10ReplicationTasks\x12`\n" +
+	"\x11replication_tasks\x18\x01 \x03(\v23.temporal.server.api.replication.v1.ReplicationTaskR\x10replicationTasks\"\xa8\x03\n" +
 	"\x13ReplicationTaskInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -2314,7 +2446,7 @@ func file_temporal_server_api_replication_v1_message_proto_rawDescGZIP() []byte
 	return file_temporal_server_api_replication_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_replication_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_temporal_server_api_replication_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_temporal_server_api_replication_v1_message_proto_goTypes = []any{
 	(*ReplicationTask)(nil),                         // 0: temporal.server.api.replication.v1.ReplicationTask
 	(*ReplicationToken)(nil),                        // 1: temporal.server.api.replication.v1.ReplicationToken
@@ -2323,121 +2455,127 @@ var file_temporal_server_api_replication_v1_message_proto_goTypes = []any{
 	(*ReplicationState)(nil),                        // 4: temporal.server.api.replication.v1.ReplicationState
 	(*ReplicationMessages)(nil),                     // 5: temporal.server.api.replication.v1.ReplicationMessages
 	(*WorkflowReplicationMessages)(nil),             // 6: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*ReplicationTaskInfo)(nil),                     // 7: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*NamespaceTaskAttributes)(nil),                 // 8: temporal.server.api.replication.v1.NamespaceTaskAttributes
-	(*SyncShardStatusTaskAttributes)(nil),           // 9: temporal.server.api.replication.v1.SyncShardStatusTaskAttributes
-	(*SyncActivityTaskAttributes)(nil),              // 10: temporal.server.api.replication.v1.SyncActivityTaskAttributes
-	(*HistoryTaskAttributes)(nil),                   // 11: temporal.server.api.replication.v1.HistoryTaskAttributes
-	(*SyncWorkflowStateTaskAttributes)(nil),         // 12: temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes
-	(*TaskQueueUserDataAttributes)(nil),             // 13: temporal.server.api.replication.v1.TaskQueueUserDataAttributes
-	(*SyncHSMAttributes)(nil),                       // 14: temporal.server.api.replication.v1.SyncHSMAttributes
-	(*BackfillHistoryTaskAttributes)(nil),           // 15: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes
-	(*NewRunInfo)(nil),                              // 16: temporal.server.api.replication.v1.NewRunInfo
-	(*SyncWorkflowStateMutationAttributes)(nil),     // 17: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes
-	(*SyncWorkflowStateSnapshotAttributes)(nil),     // 18: temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes
-	(*VerifyVersionedTransitionTaskAttributes)(nil), // 19: temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes
-	(*SyncVersionedTransitionTaskAttributes)(nil),   // 20: temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes
-	(*VersionedTransitionArtifact)(nil),             // 21: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*MigrationExecutionInfo)(nil),                  // 22: temporal.server.api.replication.v1.MigrationExecutionInfo
-	(v1.ReplicationTaskType)(0),                     // 23: temporal.server.api.enums.v1.ReplicationTaskType
-	(*v11.DataBlob)(nil),                            // 24: temporal.api.common.v1.DataBlob
-	(*timestamppb.Timestamp)(nil),                   // 25: google.protobuf.Timestamp
-	(v1.TaskPriority)(0),                            // 26: temporal.server.api.enums.v1.TaskPriority
-	(*v12.VersionedTransition)(nil),                 // 27: temporal.server.api.persistence.v1.VersionedTransition
-	(*v12.ReplicationTaskInfo)(nil),                 // 28: temporal.server.api.persistence.v1.ReplicationTaskInfo
-	(v1.ReplicationFlowControlCommand)(0),           // 29: temporal.server.api.enums.v1.ReplicationFlowControlCommand
-	(v1.TaskType)(0),                                // 30: temporal.server.api.enums.v1.TaskType
-	(v1.NamespaceOperation)(0),                      // 31: temporal.server.api.enums.v1.NamespaceOperation
-	(*v13.NamespaceInfo)(nil),                       // 32: temporal.api.namespace.v1.NamespaceInfo
-	(*v13.NamespaceConfig)(nil),                     // 33: temporal.api.namespace.v1.NamespaceConfig
-	(*v14.NamespaceReplicationConfig)(nil),          // 34: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v14.FailoverStatus)(nil),                      // 35: temporal.api.replication.v1.FailoverStatus
-	(*v11.Payloads)(nil),                            // 36: temporal.api.common.v1.Payloads
-	(*v15.Failure)(nil),                             // 37: temporal.api.failure.v1.Failure
-	(*v16.VersionHistory)(nil),                      // 38: temporal.server.api.history.v1.VersionHistory
-	(*v17.BaseExecutionInfo)(nil),                   // 39: temporal.server.api.workflow.v1.BaseExecutionInfo
-	(*durationpb.Duration)(nil),                     // 40: google.protobuf.Duration
-	(*v16.VersionHistoryItem)(nil),                  // 41: temporal.server.api.history.v1.VersionHistoryItem
-	(*v12.WorkflowMutableState)(nil),                // 42: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v12.TaskQueueUserData)(nil),                   // 43: temporal.server.api.persistence.v1.TaskQueueUserData
-	(*v12.StateMachineNode)(nil),                    // 44: temporal.server.api.persistence.v1.StateMachineNode
-	(*v12.WorkflowMutableStateMutation)(nil),        // 45: temporal.server.api.persistence.v1.WorkflowMutableStateMutation
+	(*CompressedReplicationTasks)(nil),              // 7: temporal.server.api.replication.v1.CompressedReplicationTasks
+	(*ReplicationTasks)(nil),                        // 8: temporal.server.api.replication.v1.ReplicationTasks
+	(*ReplicationTaskInfo)(nil),                     // 9: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*NamespaceTaskAttributes)(nil),                 // 10: temporal.server.api.replication.v1.NamespaceTaskAttributes
+	(*SyncShardStatusTaskAttributes)(nil),           // 11: temporal.server.api.replication.v1.SyncShardStatusTaskAttributes
+	(*SyncActivityTaskAttributes)(nil),              // 12: temporal.server.api.replication.v1.SyncActivityTaskAttributes
+	(*HistoryTaskAttributes)(nil),                   // 13: temporal.server.api.replication.v1.HistoryTaskAttributes
+	(*SyncWorkflowStateTaskAttributes)(nil),         // 14: temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes
+	(*TaskQueueUserDataAttributes)(nil),             // 15: temporal.server.api.replication.v1.TaskQueueUserDataAttributes
+	(*SyncHSMAttributes)(nil),                       // 16: temporal.server.api.replication.v1.SyncHSMAttributes
+	(*BackfillHistoryTaskAttributes)(nil),           // 17: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes
+	(*NewRunInfo)(nil),                              // 18: temporal.server.api.replication.v1.NewRunInfo
+	(*SyncWorkflowStateMutationAttributes)(nil),     // 19: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes
+	(*SyncWorkflowStateSnapshotAttributes)(nil),     // 20: temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes
+	(*VerifyVersionedTransitionTaskAttributes)(nil), // 21: temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes
+	(*SyncVersionedTransitionTaskAttributes)(nil),   // 22: temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes
+	(*VersionedTransitionArtifact)(nil),             // 23: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*MigrationExecutionInfo)(nil),                  // 24: temporal.server.api.replication.v1.MigrationExecutionInfo
+	(v1.ReplicationTaskType)(0),                     // 25: temporal.server.api.enums.v1.ReplicationTaskType
+	(*v11.DataBlob)(nil),                            // 26: temporal.api.common.v1.DataBlob
+	(*timestamppb.Timestamp)(nil),                   // 27: google.protobuf.Timestamp
+	(v1.TaskPriority)(0),                            // 28: temporal.server.api.enums.v1.TaskPriority
+	(*v12.VersionedTransition)(nil),                 // 29: temporal.server.api.persistence.v1.VersionedTransition
+	(*v12.ReplicationTaskInfo)(nil),                 // 30: temporal.server.api.persistence.v1.ReplicationTaskInfo
+	(v1.ReplicationFlowControlCommand)(0),           // 31: temporal.server.api.enums.v1.ReplicationFlowControlCommand
+	(v1.ReplicationStreamCompression)(0),            // 32: temporal.server.api.enums.v1.ReplicationStreamCompression
+	(v1.TaskType)(0),                                // 33: temporal.server.api.enums.v1.TaskType
+	(v1.NamespaceOperation)(0),                      // 34: temporal.server.api.enums.v1.NamespaceOperation
+	(*v13.NamespaceInfo)(nil),                       // 35: temporal.api.namespace.v1.NamespaceInfo
+	(*v13.NamespaceConfig)(nil),                     // 36: temporal.api.namespace.v1.NamespaceConfig
+	(*v14.NamespaceReplicationConfig)(nil),          // 37: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v14.FailoverStatus)(nil),                      // 38: temporal.api.replication.v1.FailoverStatus
+	(*v11.Payloads)(nil),                            // 39: temporal.api.common.v1.Payloads
+	(*v15.Failure)(nil),                             // 40: temporal.api.failure.v1.Failure
+	(*v16.VersionHistory)(nil),                      // 41: temporal.server.api.history.v1.VersionHistory
+	(*v17.BaseExecutionInfo)(nil),                   // 42: temporal.server.api.workflow.v1.BaseExecutionInfo
+	(*durationpb.Duration)(nil),                     // 43: google.protobuf.Duration
+	(*v16.VersionHistoryItem)(nil),                  // 44: temporal.server.api.history.v1.VersionHistoryItem
+	(*v12.WorkflowMutableState)(nil),                // 45: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v12.TaskQueueUserData)(nil),                   // 46: temporal.server.api.persistence.v1.TaskQueueUserData
+	(*v12.StateMachineNode)(nil),                    // 47: temporal.server.api.persistence.v1.StateMachineNode
+	(*v12.WorkflowMutableStateMutation)(nil),        // 48: temporal.server.api.persistence.v1.WorkflowMutableStateMutation
 }
 var file_temporal_server_api_replication_v1_message_proto_depIdxs = []int32{
-	23, // 0: temporal.server.api.replication.v1.ReplicationTask.task_type:type_name -> temporal.server.api.enums.v1.ReplicationTaskType
-	8,  // 1: temporal.server.api.replication.v1.ReplicationTask.namespace_task_attributes:type_name -> temporal.server.api.replication.v1.NamespaceTaskAttributes
-	9,  // 2: temporal.server.api.replication.v1.ReplicationTask.sync_shard_status_task_attributes:type_name -> temporal.server.api.replication.v1.SyncShardStatusTaskAttributes
-	10, // 3: temporal.server.api.replication.v1.ReplicationTask.sync_activity_task_attributes:type_name -> temporal.server.api.replication.v1.SyncActivityTaskAttributes
-	11, // 4: temporal.server.api.replication.v1.ReplicationTask.history_task_attributes:type_name -> temporal.server.api.replication.v1.HistoryTaskAttributes
-	12, // 5: temporal.server.api.replication.v1.ReplicationTask.sync_workflow_state_task_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes
-	13, // 6: temporal.server.api.replication.v1.ReplicationTask.task_queue_user_data_attributes:type_name -> temporal.server.api.replication.v1.TaskQueueUserDataAttributes
-	14, // 7: temporal.server.api.replication.v1.ReplicationTask.sync_hsm_attributes:type_name -> temporal.server.api.replication.v1.SyncHSMAttributes
-	15, // 8: temporal.server.api.replication.v1.ReplicationTask.backfill_history_task_attributes:type_name -> temporal.server.api.replication.v1.BackfillHistoryTaskAttributes
-	19, // 9: temporal.server.api.replication.v1.ReplicationTask.verify_versioned_transition_task_attributes:type_name -> temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes
-	20, // 10: temporal.server.api.replication.v1.ReplicationTask.sync_versioned_transition_task_attributes:type_name -> temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes
-	24, // 11: temporal.server.api.replication.v1.ReplicationTask.data:type_name -> temporal.api.common.v1.DataBlob
-	25, // 12: temporal.server.api.replication.v1.ReplicationTask.visibility_time:type_name -> google.protobuf.Timestamp
-	26, // 13: temporal.server.api.replication.v1.ReplicationTask.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
-	27, // 14: temporal.server.api.replication.v1.ReplicationTask.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	28, // 15: temporal.server.api.replication.v1.ReplicationTask.raw_task_info:type_name -> temporal.server.api.persistence.v1.ReplicationTaskInfo
-	25, // 16: temporal.server.api.replication.v1.ReplicationToken.last_processed_visibility_time:type_name -> google.protobuf.Timestamp
-	25, // 17: temporal.server.api.replication.v1.SyncShardStatus.status_time:type_name -> google.protobuf.Timestamp
-	25, // 18: temporal.server.api.replication.v1.SyncReplicationState.inclusive_low_watermark_time:type_name -> google.protobuf.Timestamp
+	25, // 0: temporal.server.api.replication.v1.ReplicationTask.task_type:type_name -> temporal.server.api.enums.v1.ReplicationTaskType
+	10, // 1: temporal.server.api.replication.v1.ReplicationTask.namespace_task_attributes:type_name -> temporal.server.api.replication.v1.NamespaceTaskAttributes
+	11, // 2: temporal.server.api.replication.v1.ReplicationTask.sync_shard_status_task_attributes:type_name -> temporal.server.api.replication.v1.SyncShardStatusTaskAttributes
+	12, // 3: temporal.server.api.replication.v1.ReplicationTask.sync_activity_task_attributes:type_name -> temporal.server.api.replication.v1.SyncActivityTaskAttributes
+	13, // 4: temporal.server.api.replication.v1.ReplicationTask.history_task_attributes:type_name -> temporal.server.api.replication.v1.HistoryTaskAttributes
+	14, // 5: temporal.server.api.replication.v1.ReplicationTask.sync_workflow_state_task_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes
+	15, // 6: temporal.server.api.replication.v1.ReplicationTask.task_queue_user_data_attributes:type_name -> temporal.server.api.replication.v1.TaskQueueUserDataAttributes
+	16, // 7: temporal.server.api.replication.v1.ReplicationTask.sync_hsm_attributes:type_name -> temporal.server.api.replication.v1.SyncHSMAttributes
+	17, // 8: temporal.server.api.replication.v1.ReplicationTask.backfill_history_task_attributes:type_name -> temporal.server.api.replication.v1.BackfillHistoryTaskAttributes
+	21, // 9: temporal.server.api.replication.v1.ReplicationTask.verify_versioned_transition_task_attributes:type_name -> temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes
+	22, // 10: temporal.server.api.replication.v1.ReplicationTask.sync_versioned_transition_task_attributes:type_name -> temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes
+	26, // 11: temporal.server.api.replication.v1.ReplicationTask.data:type_name -> temporal.api.common.v1.DataBlob
+	27, // 12: temporal.server.api.replication.v1.ReplicationTask.visibility_time:type_name -> google.protobuf.Timestamp
+	28, // 13: temporal.server.api.replication.v1.ReplicationTask.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
+	29, // 14: temporal.server.api.replication.v1.ReplicationTask.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	30, // 15: temporal.server.api.replication.v1.ReplicationTask.raw_task_info:type_name -> temporal.server.api.persistence.v1.ReplicationTaskInfo
+	27, // 16: temporal.server.api.replication.v1.ReplicationToken.last_processed_visibility_time:type_name -> google.protobuf.Timestamp
+	27, // 17: temporal.server.api.replication.v1.SyncShardStatus.status_time:type_name -> google.protobuf.Timestamp
+	27, // 18: temporal.server.api.replication.v1.SyncReplicationState.inclusive_low_watermark_time:type_name -> google.protobuf.Timestamp
 	4,  // 19: temporal.server.api.replication.v1.SyncReplicationState.high_priority_state:type_name -> temporal.server.api.replication.v1.ReplicationState
 	4,  // 20: temporal.server.api.replication.v1.SyncReplicationState.low_priority_state:type_name -> temporal.server.api.replication.v1.ReplicationState
-	25, // 21: temporal.server.api.replication.v1.ReplicationState.inclusive_low_watermark_time:type_name -> google.protobuf.Timestamp
-	29, // 22: temporal.server.api.replication.v1.ReplicationState.flow_control_command:type_name -> temporal.server.api.enums.v1.ReplicationFlowControlCommand
+	27, // 21: temporal.server.api.replication.v1.ReplicationState.inclusive_low_watermark_time:type_name -> google.protobuf.Timestamp
+	31, // 22: temporal.server.api.replication.v1.ReplicationState.flow_control_command:type_name -> temporal.server.api.enums.v1.ReplicationFlowControlCommand
 	0,  // 23: temporal.server.api.replication.v1.ReplicationMessages.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
 	2,  // 24: temporal.server.api.replication.v1.ReplicationMessages.sync_shard_status:type_name -> temporal.server.api.replication.v1.SyncShardStatus
 	0,  // 25: temporal.server.api.replication.v1.WorkflowReplicationMessages.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	25, // 26: temporal.server.api.replication.v1.WorkflowReplicationMessages.exclusive_high_watermark_time:type_name -> google.protobuf.Timestamp
-	26, // 27: temporal.server.api.replication.v1.WorkflowReplicationMessages.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
-	30, // 28: temporal.server.api.replication.v1.ReplicationTaskInfo.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	26, // 29: temporal.server.api.replication.v1.ReplicationTaskInfo.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
-	31, // 30: temporal.server.api.replication.v1.NamespaceTaskAttributes.namespace_operation:type_name -> temporal.server.api.enums.v1.NamespaceOperation
-	32, // 31: temporal.server.api.replication.v1.NamespaceTaskAttributes.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	33, // 32: temporal.server.api.replication.v1.NamespaceTaskAttributes.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	34, // 33: temporal.server.api.replication.v1.NamespaceTaskAttributes.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	35, // 34: temporal.server.api.replication.v1.NamespaceTaskAttributes.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	25, // 35: temporal.server.api.replication.v1.SyncShardStatusTaskAttributes.status_time:type_name -> google.protobuf.Timestamp
-	25, // 36: temporal.server.api.replication.v1.SyncActivityTaskAttributes.scheduled_time:type_name -> google.protobuf.Timestamp
-	25, // 37: temporal.server.api.replication.v1.SyncActivityTaskAttributes.started_time:type_name -> google.protobuf.Timestamp
-	25, // 38: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_heartbeat_time:type_name -> google.protobuf.Timestamp
-	36, // 39: temporal.server.api.replication.v1.SyncActivityTaskAttributes.details:type_name -> temporal.api.common.v1.Payloads
-	37, // 40: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_failure:type_name -> temporal.api.failure.v1.Failure
-	38, // 41: temporal.server.api.replication.v1.SyncActivityTaskAttributes.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	39, // 42: temporal.server.api.replication.v1.SyncActivityTaskAttributes.base_execution_info:type_name -> temporal.server.api.workflow.v1.BaseExecutionInfo
-	25, // 43: temporal.server.api.replication.v1.SyncActivityTaskAttributes.first_scheduled_time:type_name -> google.protobuf.Timestamp
-	25, // 44: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_attempt_complete_time:type_name -> google.protobuf.Timestamp
-	40, // 45: temporal.server.api.replication.v1.SyncActivityTaskAttributes.retry_initial_interval:type_name -> google.protobuf.Duration
-	40, // 46: temporal.server.api.replication.v1.SyncActivityTaskAttributes.retry_maximum_interval:type_name -> google.protobuf.Duration
-	41, // 47: temporal.server.api.replication.v1.HistoryTaskAttributes.version_history_items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	24, // 48: temporal.server.api.replication.v1.HistoryTaskAttributes.events:type_name -> temporal.api.common.v1.DataBlob
-	24, // 49: temporal.server.api.replication.v1.HistoryTaskAttributes.new_run_events:type_name -> temporal.api.common.v1.DataBlob
-	39, // 50: temporal.server.api.replication.v1.HistoryTaskAttributes.base_execution_info:type_name -> temporal.server.api.workflow.v1.BaseExecutionInfo
-	24, // 51: temporal.server.api.replication.v1.HistoryTaskAttributes.events_batches:type_name -> temporal.api.common.v1.DataBlob
-	42, // 52: temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes.workflow_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	43, // 53: temporal.server.api.replication.v1.TaskQueueUserDataAttributes.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
-	38, // 54: temporal.server.api.replication.v1.SyncHSMAttributes.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	44, // 55: temporal.server.api.replication.v1.SyncHSMAttributes.state_machine_node:type_name -> temporal.server.api.persistence.v1.StateMachineNode
-	41, // 56: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.event_version_history:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	24, // 57: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.event_batches:type_name -> temporal.api.common.v1.DataBlob
-	16, // 58: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.new_run_info:type_name -> temporal.server.api.replication.v1.NewRunInfo
-	24, // 59: temporal.server.api.replication.v1.NewRunInfo.event_batch:type_name -> temporal.api.common.v1.DataBlob
-	27, // 60: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes.exclusive_start_versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	45, // 61: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes.state_mutation:type_name -> temporal.server.api.persistence.v1.WorkflowMutableStateMutation
-	42, // 62: temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes.state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	41, // 63: temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes.event_version_history:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	21, // 64: temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	17, // 65: temporal.server.api.replication.v1.VersionedTransitionArtifact.sync_workflow_state_mutation_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes
-	18, // 66: temporal.server.api.replication.v1.VersionedTransitionArtifact.sync_workflow_state_snapshot_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes
-	24, // 67: temporal.server.api.replication.v1.VersionedTransitionArtifact.event_batches:type_name -> temporal.api.common.v1.DataBlob
-	16, // 68: temporal.server.api.replication.v1.VersionedTransitionArtifact.new_run_info:type_name -> temporal.server.api.replication.v1.NewRunInfo
-	69, // [69:69] is the sub-list for method output_type
-	69, // [69:69] is the sub-list for method input_type
-	69, // [69:69] is the sub-list for extension type_name
-	69, // [69:69] is the sub-list for extension extendee
-	0,  // [0:69] is the sub-list for field type_name
+	27, // 26: temporal.server.api.replication.v1.WorkflowReplicationMessages.exclusive_high_watermark_time:type_name -> google.protobuf.Timestamp
+	28, // 27: temporal.server.api.replication.v1.WorkflowReplicationMessages.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
+	7,  // 28: temporal.server.api.replication.v1.WorkflowReplicationMessages.compressed_replication_tasks:type_name -> temporal.server.api.replication.v1.CompressedReplicationTasks
+	32, // 29: temporal.server.api.replication.v1.CompressedReplicationTasks.compression:type_name -> temporal.server.api.enums.v1.ReplicationStreamCompression
+	0,  // 30: temporal.server.api.replication.v1.ReplicationTasks.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	33, // 31: temporal.server.api.replication.v1.ReplicationTaskInfo.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	28, // 32: temporal.server.api.replication.v1.ReplicationTaskInfo.priority:type_name -> temporal.server.api.enums.v1.TaskPriority
+	34, // 33: temporal.server.api.replication.v1.NamespaceTaskAttributes.namespace_operation:type_name -> temporal.server.api.enums.v1.NamespaceOperation
+	35, // 34: temporal.server.api.replication.v1.NamespaceTaskAttributes.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	36, // 35: temporal.server.api.replication.v1.NamespaceTaskAttributes.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	37, // 36: temporal.server.api.replication.v1.NamespaceTaskAttributes.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	38, // 37: temporal.server.api.replication.v1.NamespaceTaskAttributes.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	27, // 38: temporal.server.api.replication.v1.SyncShardStatusTaskAttributes.status_time:type_name -> google.protobuf.Timestamp
+	27, // 39: temporal.server.api.replication.v1.SyncActivityTaskAttributes.scheduled_time:type_name -> google.protobuf.Timestamp
+	27, // 40: temporal.server.api.replication.v1.SyncActivityTaskAttributes.started_time:type_name -> google.protobuf.Timestamp
+	27, // 41: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_heartbeat_time:type_name -> google.protobuf.Timestamp
+	39, // 42: temporal.server.api.replication.v1.SyncActivityTaskAttributes.details:type_name -> temporal.api.common.v1.Payloads
+	40, // 43: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_failure:type_name -> temporal.api.failure.v1.Failure
+	41, // 44: temporal.server.api.replication.v1.SyncActivityTaskAttributes.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	42, // 45: temporal.server.api.replication.v1.SyncActivityTaskAttributes.base_execution_info:type_name -> temporal.server.api.workflow.v1.BaseExecutionInfo
+	27, // 46: temporal.server.api.replication.v1.SyncActivityTaskAttributes.first_scheduled_time:type_name -> google.protobuf.Timestamp
+	27, // 47: temporal.server.api.replication.v1.SyncActivityTaskAttributes.last_attempt_complete_time:type_name -> google.protobuf.Timestamp
+	43, // 48: temporal.server.api.replication.v1.SyncActivityTaskAttributes.retry_initial_interval:type_name -> google.protobuf.Duration
+	43, // 49: temporal.server.api.replication.v1.SyncActivityTaskAttributes.retry_maximum_interval:type_name -> google.protobuf.Duration
+	44, // 50: temporal.server.api.replication.v1.HistoryTaskAttributes.version_history_items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	26, // 51: temporal.server.api.replication.v1.HistoryTaskAttributes.events:type_name -> temporal.api.common.v1.DataBlob
+	26, // 52: temporal.server.api.replication.v1.HistoryTaskAttributes.new_run_events:type_name -> temporal.api.common.v1.DataBlob
+	42, // 53: temporal.server.api.replication.v1.HistoryTaskAttributes.base_execution_info:type_name -> temporal.server.api.workflow.v1.BaseExecutionInfo
+	26, // 54: temporal.server.api.replication.v1.HistoryTaskAttributes.events_batches:type_name -> temporal.api.common.v1.DataBlob
+	45, // 55: temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes.workflow_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	46, // 56: temporal.server.api.replication.v1.TaskQueueUserDataAttributes.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
+	41, // 57: temporal.server.api.replication.v1.SyncHSMAttributes.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	47, // 58: temporal.server.api.replication.v1.SyncHSMAttributes.state_machine_node:type_name -> temporal.server.api.persistence.v1.StateMachineNode
+	44, // 59: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.event_version_history:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	26, // 60: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.event_batches:type_name -> temporal.api.common.v1.DataBlob
+	18, // 61: temporal.server.api.replication.v1.BackfillHistoryTaskAttributes.new_run_info:type_name -> temporal.server.api.replication.v1.NewRunInfo
+	26, // 62: temporal.server.api.replication.v1.NewRunInfo.event_batch:type_name -> temporal.api.common.v1.DataBlob
+	29, // 63: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes.exclusive_start_versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	48, // 64: temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes.state_mutation:type_name -> temporal.server.api.persistence.v1.WorkflowMutableStateMutation
+	45, // 65: temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes.state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	44, // 66: temporal.server.api.replication.v1.VerifyVersionedTransitionTaskAttributes.event_version_history:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	23, // 67: temporal.server.api.replication.v1.SyncVersionedTransitionTaskAttributes.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	19, // 68: temporal.server.api.replication.v1.VersionedTransitionArtifact.sync_workflow_state_mutation_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateMutationAttributes
+	20, // 69: temporal.server.api.replication.v1.VersionedTransitionArtifact.sync_workflow_state_snapshot_attributes:type_name -> temporal.server.api.replication.v1.SyncWorkflowStateSnapshotAttributes
+	26, // 70: temporal.server.api.replication.v1.VersionedTransitionArtifact.event_batches:type_name -> temporal.api.common.v1.DataBlob
+	18, // 71: temporal.server.api.replication.v1.VersionedTransitionArtifact.new_run_info:type_name -> temporal.server.api.replication.v1.NewRunInfo
+	72, // [72:72] is the sub-list for method output_type
+	72, // [72:72] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_replication_v1_message_proto_init() }
@@ -2457,7 +2595,7 @@ func file_temporal_server_api_replication_v1_message_proto_init() {
 		(*ReplicationTask_VerifyVersionedTransitionTaskAttributes)(nil),
 		(*ReplicationTask_SyncVersionedTransitionTaskAttributes)(nil),
 	}
-	file_temporal_server_api_replication_v1_message_proto_msgTypes[21].OneofWrappers = []any{
+	file_temporal_server_api_replication_v1_message_proto_msgTypes[23].OneofWrappers = []any{
 		(*VersionedTransitionArtifact_SyncWorkflowStateMutationAttributes)(nil),
 		(*VersionedTransitionArtifact_SyncWorkflowStateSnapshotAttributes)(nil),
 	}
@@ -2467,7 +2605,7 @@ func file_temporal_server_api_replication_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_replication_v1_message_proto_rawDesc), len(file_temporal_server_api_replication_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,7 +31,10 @@ type RoutingOptions struct {
 	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	// Requests will be routed by resolving the namespace ID and business ID to a given shard.
 	// If multiple fields are specified, the first non-empty value is used.
-	BusinessId    []string `protobuf:"bytes,3,rep,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	BusinessId []string `protobuf:"bytes,3,rep,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	// Requests will be routed to the shard ID in the given field. Used by batch APIs whose items have
+	// already been grouped by shard by the caller. Cannot be combined with other directives.
+	ShardId       string `protobuf:"bytes,4,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -87,6 +90,13 @@ func (x *RoutingOptions) GetBusinessId() []string {
 	return nil
 }
 
+func (x *RoutingOptions) GetShardId() string {
+	if x != nil {
+		return x.ShardId
+	}
+	return ""
+}
+
 var file_temporal_server_api_routing_v1_extension_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -108,12 +118,13 @@ var File_temporal_server_api_routing_v1_extension_proto protoreflect.FileDescrip
 
 const file_temporal_server_api_routing_v1_extension_proto_rawDesc = "" +
 	"\n" +
-	".temporal/server/api/routing/v1/extension.proto\x12\x1etemporal.server.api.routing.v1\x1a google/protobuf/descriptor.proto\"l\n" +
+	".temporal/server/api/routing/v1/extension.proto\x12\x1etemporal.server.api.routing.v1\x1a google/protobuf/descriptor.proto\"\x87\x01\n" +
 	"\x0eRoutingOptions\x12\x16\n" +
 	"\x06random\x18\x01 \x01(\bR\x06random\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vbusiness_id\x18\x03 \x03(\tR\n" +
-	"businessId:m\n" +
+	"businessId\x12\x19\n" +
+	"\bshard_id\x18\x04 \x01(\tR\ashardId:m\n" +
 	"\arouting\x12\x1e.google.protobuf.MethodOptions\x18\xba\x88\x03 \x01(\v2..temporal.server.api.routing.v1.RoutingOptionsR\arouting\x88\x01\x01B.Z,go.temporal.io/server/api/routing/v1;routingb\x06proto3"
 
 var (
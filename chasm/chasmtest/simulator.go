@@ -0,0 +1,558 @@
+package chasmtest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	defaultSimulationSeedCount       = 20
+	defaultSimulationMaxSteps        = 1000
+	defaultSimulationMaxTaskAttempts = 10
+	defaultSimulationRetryBackoff    = time.Second
+)
+
+// simulatedCategories are the task categories the simulator delivers. Visibility tasks are
+// processed by the visibility queue rather than by their registered handler, so they are skipped.
+var simulatedCategories = []tasks.Category{
+	tasks.CategoryTransfer,
+	tasks.CategoryTimer,
+	tasks.CategoryOutbound,
+}
+
+type (
+	SimulatorOption func(*simulatorConfig)
+
+	simulatorConfig struct {
+		seeds                 []int64
+		startTime             time.Time
+		maxSteps              int
+		maxTaskAttempts       int
+		retryBackoff          time.Duration
+		sideEffectFailureRate float64
+		actions               []SimulationAction
+		invariants            []Invariant
+	}
+
+	// Invariant is a named property that must hold for the root component of every execution
+	// after each transition the simulator applies.
+	Invariant struct {
+		Name  string
+		Check func(chasm.Context, chasm.Component) error
+	}
+
+	// SimulationAction is an externally triggered transition, such as an API request, that the
+	// simulator interleaves with task execution at a random point of each run.
+	SimulationAction struct {
+		Name string
+		// Times is how many times the action runs per simulation. Defaults to 1.
+		Times int
+		Run   func(*Simulation) error
+	}
+
+	// Simulator deterministically explores the task interleavings of CHASM components registered
+	// in a set of libraries. Each run is driven by a seed that decides the order in which ready
+	// tasks and actions execute, when virtual time advances, and which side effect task attempts
+	// fail, so any failure can be reproduced exactly by re-running its seed.
+	Simulator struct {
+		t         *testing.T
+		libraries []chasm.Library
+		config    simulatorConfig
+	}
+
+	// Simulation is the state of a single seeded run. It is passed to the setup function and to
+	// actions so they can start executions and drive components through the [Engine].
+	Simulation struct {
+		seed       int64
+		rand       *rand.Rand
+		engine     *Engine
+		timeSource *clock.EventTimeSource
+		config     *simulatorConfig
+
+		// cursors records, per execution and category, how many of the backend's physical tasks
+		// have already been collected into pending.
+		cursors map[*execution]map[tasks.Category]int
+		pending []*simulatedTask
+		// actionRuns is the remaining number of runs for each configured action.
+		actionRuns []int
+		step       int
+		trace      []string
+	}
+
+	// SimulationFailure describes a failed simulation run.
+	SimulationFailure struct {
+		Seed int64
+		// Step is the transition after which the failure was detected. Step 0 is the setup.
+		Step  int
+		Err   error
+		Trace []string
+	}
+
+	simulatedTask struct {
+		execution *execution
+		task      tasks.Task
+		attempt   int
+		readyAt   time.Time
+	}
+)
+
+// WithSimulationSeeds sets the exact seeds to run, e.g. to reproduce a reported failure.
+func WithSimulationSeeds(seeds ...int64) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.seeds = seeds
+	}
+}
+
+// WithSimulationSeedCount runs seeds 1 through n. The default is 20.
+func WithSimulationSeedCount(n int) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.seeds = make([]int64, n)
+		for i := range c.seeds {
+			c.seeds[i] = int64(i + 1)
+		}
+	}
+}
+
+// WithSimulationStartTime sets the virtual time every run starts at.
+func WithSimulationStartTime(startTime time.Time) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.startTime = startTime
+	}
+}
+
+// WithSimulationMaxSteps bounds the number of transitions per run. A run that still has work
+// after this many steps fails, which usually means tasks keep rescheduling each other.
+func WithSimulationMaxSteps(maxSteps int) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.maxSteps = maxSteps
+	}
+}
+
+// WithSideEffectFailureRate sets the probability in [0, 1] that a side effect task attempt is
+// failed by the simulator. Half of the injected failures fail the attempt before the handler
+// runs; the other half run the handler and then redeliver the task, as happens when a task is
+// executed but its completion is not acked.
+func WithSideEffectFailureRate(rate float64) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.sideEffectFailureRate = rate
+	}
+}
+
+// WithMaxTaskAttempts sets how many attempts a side effect task whose handler keeps returning
+// errors gets before the run fails. Injected failures count as attempts.
+func WithMaxTaskAttempts(attempts int) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.maxTaskAttempts = attempts
+	}
+}
+
+// WithTaskRetryBackoff sets how far in virtual time a failed side effect task is rescheduled.
+func WithTaskRetryBackoff(backoff time.Duration) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.retryBackoff = backoff
+	}
+}
+
+// WithSimulationActions adds actions to interleave with task execution.
+func WithSimulationActions(actions ...SimulationAction) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.actions = append(c.actions, actions...)
+	}
+}
+
+// WithInvariants adds invariants to check after every transition.
+func WithInvariants(invariants ...Invariant) SimulatorOption {
+	return func(c *simulatorConfig) {
+		c.invariants = append(c.invariants, invariants...)
+	}
+}
+
+// NewSimulator creates a simulator for components registered by the given libraries. The core
+// library is always registered.
+func NewSimulator(
+	t *testing.T,
+	libraries []chasm.Library,
+	opts ...SimulatorOption,
+) *Simulator {
+	t.Helper()
+
+	s := &Simulator{
+		t:         t,
+		libraries: libraries,
+		config: simulatorConfig{
+			startTime:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			maxSteps:        defaultSimulationMaxSteps,
+			maxTaskAttempts: defaultSimulationMaxTaskAttempts,
+			retryBackoff:    defaultSimulationRetryBackoff,
+		},
+	}
+	WithSimulationSeedCount(defaultSimulationSeedCount)(&s.config)
+	for _, opt := range opts {
+		opt(&s.config)
+	}
+	return s
+}
+
+// Run runs setup followed by a simulation for every configured seed, and fails the test with
+// the minimal reproducing seed if any run fails.
+func (s *Simulator) Run(setup func(*Simulation) error) {
+	s.t.Helper()
+
+	if failure := s.Check(setup); failure != nil {
+		s.t.Fatal(failure.String())
+	}
+}
+
+// Check is like [Simulator.Run] but returns the minimal failure instead of failing the test.
+// The minimal failure is the one detected after the fewest transitions, with ties broken by the
+// smallest seed. It returns nil if all runs succeed.
+func (s *Simulator) Check(setup func(*Simulation) error) *SimulationFailure {
+	s.t.Helper()
+
+	var minimal *SimulationFailure
+	for _, seed := range s.config.seeds {
+		failure := s.RunSeed(seed, setup)
+		if failure == nil {
+			continue
+		}
+		if minimal == nil || failure.Step < minimal.Step ||
+			(failure.Step == minimal.Step && failure.Seed < minimal.Seed) {
+			minimal = failure
+		}
+	}
+	return minimal
+}
+
+// RunSeed runs setup followed by a single simulation driven by seed.
+func (s *Simulator) RunSeed(seed int64, setup func(*Simulation) error) *SimulationFailure {
+	s.t.Helper()
+
+	registry := chasm.NewRegistry(log.NewNoopLogger())
+	if err := registry.Register(&chasm.CoreLibrary{}); err != nil {
+		s.t.Fatalf("failed to register core library: %v", err)
+	}
+	for _, lib := range s.libraries {
+		if err := registry.Register(lib); err != nil {
+			s.t.Fatalf("failed to register library %s: %v", lib.Name(), err)
+		}
+	}
+
+	ts := clock.NewEventTimeSource()
+	ts.Update(s.config.startTime)
+
+	sim := &Simulation{
+		seed:       seed,
+		rand:       rand.New(rand.NewSource(seed)),
+		engine:     NewEngine(s.t, registry, WithTimeSource(ts)),
+		timeSource: ts,
+		config:     &s.config,
+		cursors:    make(map[*execution]map[tasks.Category]int),
+		actionRuns: make([]int, len(s.config.actions)),
+	}
+	for i, action := range s.config.actions {
+		sim.actionRuns[i] = max(action.Times, 1)
+	}
+
+	if err := sim.run(setup); err != nil {
+		return &SimulationFailure{
+			Seed:  seed,
+			Step:  sim.step,
+			Err:   err,
+			Trace: sim.trace,
+		}
+	}
+	return nil
+}
+
+// String formats the failure with the trace of transitions that led to it.
+func (f *SimulationFailure) String() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "CHASM simulation failed with seed %d at step %d: %v\n", f.Seed, f.Step, f.Err)
+	_, _ = fmt.Fprintf(&b, "reproduce with chasmtest.WithSimulationSeeds(%d)\ntrace:\n", f.Seed)
+	for _, line := range f.Trace {
+		b.WriteString("  ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Seed returns the seed driving this run.
+func (s *Simulation) Seed() int64 {
+	return s.seed
+}
+
+// Rand returns the run's seeded source of randomness. Setup and actions must use it instead of
+// global randomness for runs to be reproducible.
+func (s *Simulation) Rand() *rand.Rand {
+	return s.rand
+}
+
+// Engine returns the engine backing this run.
+func (s *Simulation) Engine() *Engine {
+	return s.engine
+}
+
+// Context returns a context carrying the run's engine, for use with [chasm.StartExecution],
+// [chasm.UpdateComponent] and friends.
+func (s *Simulation) Context() context.Context {
+	return chasm.NewEngineContext(context.Background(), s.engine)
+}
+
+// Now returns the current virtual time.
+func (s *Simulation) Now() time.Time {
+	return s.timeSource.Now()
+}
+
+func (s *Simulation) run(setup func(*Simulation) error) error {
+	s.tracef("setup")
+	if err := setup(s); err != nil {
+		return fmt.Errorf("setup failed: %w", err)
+	}
+	if err := s.checkInvariants(); err != nil {
+		return err
+	}
+
+	for {
+		s.collectTasks()
+
+		var ready []*simulatedTask
+		for _, task := range s.pending {
+			if !task.readyAt.After(s.Now()) {
+				ready = append(ready, task)
+			}
+		}
+		var actions []int
+		for i, runs := range s.actionRuns {
+			if runs > 0 {
+				actions = append(actions, i)
+			}
+		}
+		canAdvance := len(ready) < len(s.pending)
+
+		if len(ready) == 0 && len(actions) == 0 && !canAdvance {
+			// Quiesced: no more work to do.
+			return nil
+		}
+
+		s.step++
+		if s.step > s.config.maxSteps {
+			return fmt.Errorf("simulation did not quiesce within %d steps, %d tasks pending", s.config.maxSteps, len(s.pending))
+		}
+
+		// Advancing time is only a choice while actions remain, otherwise it's done when
+		// nothing is ready.
+		choices := len(ready) + len(actions)
+		if canAdvance && (len(actions) > 0 || len(ready) == 0) {
+			choices++
+		}
+		choice := s.rand.Intn(choices)
+
+		var err error
+		switch {
+		case choice < len(ready):
+			err = s.executeTask(ready[choice])
+		case choice < len(ready)+len(actions):
+			err = s.runAction(actions[choice-len(ready)])
+		default:
+			s.advanceTime()
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.checkInvariants(); err != nil {
+			return err
+		}
+	}
+}
+
+// collectTasks moves physical tasks generated since the last step into pending.
+func (s *Simulation) collectTasks() {
+	for _, exec := range s.executions() {
+		cursors, ok := s.cursors[exec]
+		if !ok {
+			cursors = make(map[tasks.Category]int)
+			s.cursors[exec] = cursors
+		}
+		for _, category := range simulatedCategories {
+			generated := exec.backend.TasksByCategory[category]
+			for _, task := range generated[cursors[category]:] {
+				switch task.(type) {
+				case *tasks.ChasmTask, *tasks.ChasmTaskPure:
+					s.pending = append(s.pending, &simulatedTask{
+						execution: exec,
+						task:      task,
+						attempt:   1,
+						readyAt:   task.GetVisibilityTime(),
+					})
+				}
+			}
+			cursors[category] = len(generated)
+		}
+	}
+}
+
+// executions returns the engine's executions in creation order.
+func (s *Simulation) executions() []*execution {
+	executions := make([]*execution, 0, len(s.engine.allExecutions))
+	for _, exec := range s.engine.allExecutions {
+		executions = append(executions, exec)
+	}
+	slices.SortFunc(executions, func(a, b *execution) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	return executions
+}
+
+func (s *Simulation) advanceTime() {
+	next := s.pending[0].readyAt
+	for _, task := range s.pending[1:] {
+		if task.readyAt.Before(next) {
+			next = task.readyAt
+		}
+	}
+	if next.After(s.Now()) {
+		s.timeSource.Update(next)
+	}
+	s.tracef("advanced time")
+}
+
+func (s *Simulation) runAction(idx int) error {
+	action := s.config.actions[idx]
+	s.actionRuns[idx]--
+	s.tracef("ran action %q", action.Name)
+	if err := action.Run(s); err != nil {
+		return fmt.Errorf("action %q failed: %w", action.Name, err)
+	}
+	return nil
+}
+
+func (s *Simulation) executeTask(task *simulatedTask) error {
+	s.removePending(task)
+
+	if s.engine.allExecutions[newRunKey(task.execution.key)] != task.execution {
+		s.tracef("dropped task on deleted execution %s", task.execution.key.BusinessID)
+		return nil
+	}
+
+	switch t := task.task.(type) {
+	case *tasks.ChasmTaskPure:
+		executed, err := s.engine.firePureTasks(task.execution, s.Now())
+		if err != nil {
+			return fmt.Errorf("pure tasks failed on %s: %w", task.execution.key.BusinessID, err)
+		}
+		s.tracef("fired %d pure task(s) on %s", executed, task.execution.key.BusinessID)
+		return nil
+	case *tasks.ChasmTask:
+		return s.executeSideEffectTask(task, t)
+	default:
+		return fmt.Errorf("unexpected task type %T", t)
+	}
+}
+
+func (s *Simulation) executeSideEffectTask(task *simulatedTask, chasmTask *tasks.ChasmTask) error {
+	exec := task.execution
+	taskType, _ := s.engine.registry.TaskFqnByID(chasmTask.Info.GetTypeId())
+	desc := fmt.Sprintf("%s (attempt %d) on %s", taskType, task.attempt, exec.key.BusinessID)
+
+	redeliver := false
+	if s.rand.Float64() < s.config.sideEffectFailureRate {
+		if s.rand.Intn(2) == 0 {
+			s.tracef("injected failure before executing %s", desc)
+			return s.retry(task, errors.New("injected failure"))
+		}
+		redeliver = true
+	}
+
+	chasmTask.Attempt = task.attempt
+	ctx := s.Context()
+	isTaskInTree, isValid, err := exec.node.ValidateSideEffectTask(ctx, chasmTask)
+	if err != nil {
+		s.tracef("failed to validate %s: %v", desc, err)
+		return s.retry(task, err)
+	}
+	if !isTaskInTree || !isValid {
+		s.tracef("dropped invalid %s", desc)
+		return nil
+	}
+
+	err = exec.node.ExecuteSideEffectTask(
+		ctx,
+		exec.key,
+		chasmTask,
+		func(chasm.NodeBackend, chasm.Context, chasm.Component) error { return nil },
+	)
+	if errors.As(err, new(*serviceerror.NotFound)) {
+		// Matches the production queue, which drops tasks whose component or execution is gone
+		// or that no longer pass validation.
+		s.tracef("dropped %s: %v", desc, err)
+		return nil
+	}
+	if err != nil {
+		s.tracef("failed to execute %s: %v", desc, err)
+		return s.retry(task, err)
+	}
+
+	if redeliver {
+		s.tracef("executed %s, injected redelivery", desc)
+		return s.retry(task, nil)
+	}
+	s.tracef("executed %s", desc)
+	return nil
+}
+
+// retry puts a failed side effect task back into pending for its next attempt, or fails the run
+// if the task is out of attempts.
+func (s *Simulation) retry(task *simulatedTask, cause error) error {
+	if cause != nil && task.attempt >= s.config.maxTaskAttempts {
+		return fmt.Errorf("side effect task on %s failed after %d attempts: %w", task.execution.key.BusinessID, task.attempt, cause)
+	}
+	task.attempt++
+	task.readyAt = s.Now().Add(s.config.retryBackoff)
+	s.pending = append(s.pending, task)
+	return nil
+}
+
+func (s *Simulation) removePending(task *simulatedTask) {
+	s.pending = slices.DeleteFunc(s.pending, func(t *simulatedTask) bool {
+		return t == task
+	})
+}
+
+func (s *Simulation) checkInvariants() error {
+	if len(s.config.invariants) == 0 {
+		return nil
+	}
+	for _, exec := range s.executions() {
+		chasmCtx := chasm.NewContext(context.Background(), exec.node)
+		root, err := exec.node.Component(chasmCtx, chasm.ComponentRef{})
+		if err != nil {
+			return fmt.Errorf("failed to load root component of %s: %w", exec.key.BusinessID, err)
+		}
+		for _, invariant := range s.config.invariants {
+			if err := invariant.Check(chasmCtx, root); err != nil {
+				return fmt.Errorf("invariant %q violated on %s: %w", invariant.Name, exec.key.BusinessID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Simulation) tracef(format string, args ...any) {
+	elapsed := s.Now().Sub(s.config.startTime)
+	s.trace = append(s.trace, fmt.Sprintf("%d [+%v] %s", s.step, elapsed, fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,297 @@
+package chasmtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/chasmtest"
+	"go.temporal.io/server/chasm/lib/tests"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// countMatchesPayloads: the store's TotalCount must always match the number of payloads it holds.
+var countMatchesPayloads = chasmtest.Invariant{
+	Name: "count matches payloads",
+	Check: func(_ chasm.Context, c chasm.Component) error {
+		store := c.(*tests.PayloadStore)
+		if int(store.State.TotalCount) != len(store.Payloads) {
+			return fmt.Errorf("TotalCount is %d but store holds %d payloads", store.State.TotalCount, len(store.Payloads))
+		}
+		return nil
+	},
+}
+
+func TestSimulatorExpiresPayloads(t *testing.T) {
+	var stores []chasm.ComponentRef
+	sim := chasmtest.NewSimulator(t, []chasm.Library{tests.Library},
+		chasmtest.WithInvariants(countMatchesPayloads),
+		chasmtest.WithSimulationActions(addRandomPayloadAction(&stores)),
+	)
+
+	sim.Run(func(s *chasmtest.Simulation) error {
+		stores = stores[:0]
+		for i := range 2 {
+			ref, err := startSimulatedStore(s, fmt.Sprintf("store-%d", i))
+			if err != nil {
+				return err
+			}
+			stores = append(stores, ref)
+		}
+		return nil
+	})
+}
+
+func TestSimulatorReportsMinimalSeed(t *testing.T) {
+	var stores []chasm.ComponentRef
+	setup := func(s *chasmtest.Simulation) error {
+		ref, err := startSimulatedStore(s, "store")
+		stores = []chasm.ComponentRef{ref}
+		return err
+	}
+	newSimulator := func(opts ...chasmtest.SimulatorOption) *chasmtest.Simulator {
+		return chasmtest.NewSimulator(t, []chasm.Library{tests.Library},
+			append([]chasmtest.SimulatorOption{
+				chasmtest.WithInvariants(chasmtest.Invariant{
+					Name: "at most one payload",
+					Check: func(_ chasm.Context, c chasm.Component) error {
+						if n := len(c.(*tests.PayloadStore).Payloads); n > 1 {
+							return fmt.Errorf("store holds %d payloads", n)
+						}
+						return nil
+					},
+				}),
+				chasmtest.WithSimulationActions(addRandomPayloadAction(&stores)),
+			}, opts...)...,
+		)
+	}
+
+	failure := newSimulator().Check(setup)
+	require.NotNil(t, failure)
+	require.ErrorContains(t, failure.Err, "at most one payload")
+	require.Contains(t, failure.String(), fmt.Sprintf("chasmtest.WithSimulationSeeds(%d)", failure.Seed))
+
+	// Re-running the reported seed reproduces the exact same failure.
+	reproduced := newSimulator(chasmtest.WithSimulationSeeds(failure.Seed)).Check(setup)
+	require.NotNil(t, reproduced)
+	require.Equal(t, failure.Step, reproduced.Step)
+	require.Equal(t, failure.Trace, reproduced.Trace)
+}
+
+func TestSimulatorDetectsRunawayTasks(t *testing.T) {
+	sim := chasmtest.NewSimulator(t, []chasm.Library{tests.Library},
+		chasmtest.WithSimulationSeeds(1),
+		chasmtest.WithSimulationMaxSteps(1),
+	)
+	failure := sim.Check(func(s *chasmtest.Simulation) error {
+		ref, err := startSimulatedStore(s, "store")
+		if err != nil {
+			return err
+		}
+		for i := range 2 {
+			if err := addSimulatedPayload(s, ref, fmt.Sprintf("key-%d", i), time.Duration(i+1)*time.Hour); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NotNil(t, failure)
+	require.ErrorContains(t, failure.Err, "did not quiesce")
+}
+
+func TestSimulatorRetriesFailedSideEffectTasks(t *testing.T) {
+	lib := newFlakyLibrary()
+	sim := chasmtest.NewSimulator(t, []chasm.Library{lib},
+		chasmtest.WithSimulationSeeds(1),
+		chasmtest.WithMaxTaskAttempts(3),
+		chasmtest.WithTaskRetryBackoff(time.Minute),
+	)
+
+	// The handler fails twice, the third and last attempt succeeds.
+	sim.Run(lib.setup(2))
+	require.Equal(t, []time.Duration{0, time.Minute, 2 * time.Minute}, lib.handler.executions)
+}
+
+func TestSimulatorFailsSideEffectTaskOutOfAttempts(t *testing.T) {
+	lib := newFlakyLibrary()
+	sim := chasmtest.NewSimulator(t, []chasm.Library{lib},
+		chasmtest.WithSimulationSeeds(1),
+		chasmtest.WithMaxTaskAttempts(3),
+		chasmtest.WithTaskRetryBackoff(time.Minute),
+	)
+
+	failure := sim.Check(lib.setup(3))
+	require.NotNil(t, failure)
+	require.ErrorContains(t, failure.Err, "failed after 3 attempts")
+	require.ErrorIs(t, failure.Err, errFlakyTask)
+	require.Equal(t, []time.Duration{0, time.Minute, 2 * time.Minute}, lib.handler.executions)
+}
+
+func TestSimulatorInjectsSideEffectFailures(t *testing.T) {
+	t.Run("never", func(t *testing.T) {
+		lib := newFlakyLibrary()
+		sim := chasmtest.NewSimulator(t, []chasm.Library{lib})
+		for seed := range int64(20) {
+			require.Nil(t, sim.RunSeed(seed, lib.setup(0)))
+			require.Equal(t, []time.Duration{0}, lib.handler.executions)
+		}
+	})
+
+	t.Run("sometimes", func(t *testing.T) {
+		lib := newFlakyLibrary()
+		sim := chasmtest.NewSimulator(t, []chasm.Library{lib},
+			chasmtest.WithSideEffectFailureRate(0.5),
+			chasmtest.WithTaskRetryBackoff(time.Minute),
+		)
+		var failedBefore, redelivered bool
+		for seed := range int64(20) {
+			require.Nil(t, sim.RunSeed(seed, lib.setup(0)))
+			executions := lib.handler.executions
+			require.NotEmpty(t, executions)
+			// An injected failure before the first execution delays it by whole backoffs.
+			require.Zero(t, executions[0]%time.Minute)
+			failedBefore = failedBefore || executions[0] > 0
+			redelivered = redelivered || len(executions) > 1
+		}
+		require.True(t, failedBefore, "no attempt failed before executing")
+		require.True(t, redelivered, "no task was redelivered after executing")
+	})
+
+	t.Run("always", func(t *testing.T) {
+		lib := newFlakyLibrary()
+		sim := chasmtest.NewSimulator(t, []chasm.Library{lib},
+			chasmtest.WithSideEffectFailureRate(1),
+			chasmtest.WithMaxTaskAttempts(3),
+		)
+		// Every attempt is failed or redelivered, so the task never completes.
+		failure := sim.Check(lib.setup(0))
+		require.NotNil(t, failure)
+		require.ErrorContains(t, failure.Err, "injected failure")
+	})
+}
+
+func startSimulatedStore(s *chasmtest.Simulation, businessID string) (chasm.ComponentRef, error) {
+	key := chasm.ExecutionKey{NamespaceID: "test-ns", BusinessID: businessID}
+	result, err := chasm.StartExecution(s.Context(), key,
+		func(mc chasm.MutableContext, _ any) (*tests.PayloadStore, error) {
+			return tests.NewPayloadStore(mc)
+		}, nil)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	return chasm.NewComponentRef[*tests.PayloadStore](result.ExecutionKey), nil
+}
+
+func addSimulatedPayload(s *chasmtest.Simulation, ref chasm.ComponentRef, key string, ttl time.Duration) error {
+	_, _, err := chasm.UpdateComponent(s.Context(), ref,
+		func(store *tests.PayloadStore, mc chasm.MutableContext, _ any) (any, error) {
+			return nil, addPayload(store, mc, key, ttl)
+		}, nil)
+	return err
+}
+
+// addRandomPayloadAction adds a payload with a random TTL to a random store, three times per run.
+func addRandomPayloadAction(stores *[]chasm.ComponentRef) chasmtest.SimulationAction {
+	return chasmtest.SimulationAction{
+		Name:  "add payload",
+		Times: 3,
+		Run: func(s *chasmtest.Simulation) error {
+			ref := (*stores)[s.Rand().Intn(len(*stores))]
+			key := fmt.Sprintf("key-%d", s.Rand().Int())
+			return addSimulatedPayload(s, ref, key, time.Duration(1+s.Rand().Intn(10))*time.Minute)
+		},
+	}
+}
+
+var errFlakyTask = errors.New("flaky task failed")
+
+type (
+	// flakyLibrary registers a component that schedules a single side effect task on start, whose
+	// handler fails a configurable number of times.
+	flakyLibrary struct {
+		chasm.UnimplementedLibrary
+
+		handler *flakyTaskHandler
+	}
+
+	flakyComponent struct {
+		chasm.UnimplementedComponent
+
+		State *emptypb.Empty
+	}
+
+	flakyTask struct{}
+
+	flakyTaskHandler struct {
+		chasm.SideEffectTaskHandlerBase[*flakyTask]
+
+		sim      *chasmtest.Simulation
+		start    time.Time
+		failures int
+		// executions records when, relative to the start of the run, the handler was executed.
+		executions []time.Duration
+	}
+)
+
+func newFlakyLibrary() *flakyLibrary {
+	return &flakyLibrary{handler: &flakyTaskHandler{}}
+}
+
+func (l *flakyLibrary) Name() string {
+	return "flaky"
+}
+
+func (l *flakyLibrary) Components() []*chasm.RegistrableComponent {
+	return []*chasm.RegistrableComponent{
+		chasm.NewRegistrableComponent[*flakyComponent]("component"),
+	}
+}
+
+func (l *flakyLibrary) Tasks() []*chasm.RegistrableTask {
+	return []*chasm.RegistrableTask{
+		chasm.NewRegistrableSideEffectTask("task", l.handler),
+	}
+}
+
+// setup resets the handler to fail the given number of executions and starts a component.
+func (l *flakyLibrary) setup(failures int) func(*chasmtest.Simulation) error {
+	return func(s *chasmtest.Simulation) error {
+		*l.handler = flakyTaskHandler{sim: s, start: s.Now(), failures: failures}
+		key := chasm.ExecutionKey{NamespaceID: "test-ns", BusinessID: "flaky"}
+		_, err := chasm.StartExecution(s.Context(), key,
+			func(mc chasm.MutableContext, _ any) (*flakyComponent, error) {
+				c := &flakyComponent{State: &emptypb.Empty{}}
+				mc.AddTask(c, chasm.TaskAttributes{}, &flakyTask{})
+				return c, nil
+			}, nil)
+		return err
+	}
+}
+
+func (c *flakyComponent) LifecycleState(chasm.Context) chasm.LifecycleState {
+	return chasm.LifecycleStateRunning
+}
+
+func (c *flakyComponent) Terminate(chasm.MutableContext, chasm.TerminateComponentRequest) (chasm.TerminateComponentResponse, error) {
+	return chasm.TerminateComponentResponse{}, nil
+}
+
+func (c *flakyComponent) ContextMetadata(chasm.Context) map[string]string {
+	return nil
+}
+
+func (h *flakyTaskHandler) Execute(context.Context, chasm.ComponentRef, chasm.TaskAttributes, *flakyTask) error {
+	h.executions = append(h.executions, h.sim.Now().Sub(h.start))
+	if len(h.executions) <= h.failures {
+		return errFlakyTask
+	}
+	return nil
+}
+
+func (h *flakyTaskHandler) Validate(chasm.Context, *flakyComponent, chasm.TaskInvocation, *flakyTask) (bool, error) {
+	return true, nil
+}
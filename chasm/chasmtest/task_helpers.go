@@ -61,7 +61,10 @@ func (e *Engine) FirePureTasks(ref chasm.ComponentRef, referenceTime time.Time)
 	if err != nil {
 		return 0, err
 	}
+	return e.firePureTasks(exec, referenceTime)
+}
 
+func (e *Engine) firePureTasks(exec *execution, referenceTime time.Time) (executed int, err error) {
 	engineCtx := chasm.NewEngineContext(context.Background(), e)
 	if err := exec.node.EachPureTask(
 		referenceTime,
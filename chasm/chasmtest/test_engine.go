@@ -42,10 +42,13 @@ type (
 		// allExecutions maps (namespaceID, businessID, runID) to any run, for lookups by specific RunID.
 		allExecutions map[runKey]*execution
 		notifier      *executionNotifier
+		// executionSeq is incremented for every execution created, giving executions a stable order.
+		executionSeq int
 	}
 
 	execution struct {
 		key             chasm.ExecutionKey
+		seq             int
 		node            *chasm.Node
 		backend         *chasm.MockNodeBackend
 		root            chasm.RootComponent
@@ -577,8 +580,10 @@ func (e *Engine) newExecution(key chasm.ExecutionKey) *execution {
 			return changed, nil
 		},
 	}
+	e.executionSeq++
 	return &execution{
 		key:     key,
+		seq:     e.executionSeq,
 		backend: backend,
 		node: chasm.NewEmptyTree(
 			e.registry,
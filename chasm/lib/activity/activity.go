@@ -39,6 +39,7 @@ import (
 	sdkpb "go.temporal.io/api/sdk/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	activityspb "go.temporal.io/server/api/activity/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
@@ -110,6 +111,10 @@ type Activity struct {
 	// Callbacks holds completion callbacks to be invoked when this standalone activity reaches a terminal state. Nil
 	// for workflow-embedded activities as the workflow handles its own callbacks.
 	Callbacks chasm.Map[string, *callback.Callback]
+
+	// Chain holds the follow-up steps of a standalone activity and the progress through them. Empty if the activity
+	// was started without follow-up steps.
+	Chain chasm.Field[*activityspb.ActivityChainState]
 }
 
 // WithToken wraps a request with its deserialized task token.
@@ -593,7 +598,7 @@ func (a *Activity) HandleCompleted(
 	baseHandler := a.baseMetricsHandler(ctx, metrics.HistoryRespondActivityTaskCompletedScope)
 	enrichedHandler := a.enrichedMetricsHandler(ctx, metrics.HistoryRespondActivityTaskCompletedScope)
 
-	if err := TransitionCompleted.Apply(a, ctx, completeEvent{
+	if err := a.successfulCompletionTransition(ctx).Apply(a, ctx, completeEvent{
 		req:             event.Request,
 		baseHandler:     baseHandler,
 		enrichedHandler: enrichedHandler,
@@ -1893,8 +1898,14 @@ func (a *Activity) buildDescribeActivityExecutionResponse(
 		response.Outcome = a.outcome(ctx)
 	}
 
+	var chain *activityspb.ActivityChainState
+	if c, ok := a.Chain.TryGet(ctx); ok {
+		chain = common.CloneProto(c)
+	}
+
 	return &activitypb.DescribeActivityExecutionResponse{
 		FrontendResponse: response,
+		Chain:            chain,
 	}, nil
 }
 
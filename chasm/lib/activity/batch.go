@@ -0,0 +1,161 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchResult is the outcome of a single item of a batch request. Err is set if the item failed,
+// otherwise Response holds the item's response.
+type BatchResult[T any] struct {
+	Response T
+	Err      error
+}
+
+// batchItemResult is implemented by the per-item results of the ActivityService batch RPCs.
+type batchItemResult[T any] interface {
+	GetFrontendResponse() T
+	GetFailure() *activitypb.BatchItemFailure
+}
+
+// newBatchItemFailure converts an item's error to its wire representation.
+func newBatchItemFailure(err error) *activitypb.BatchItemFailure {
+	st := serviceerror.ToStatus(err).Proto()
+	return &activitypb.BatchItemFailure{
+		Code:    st.GetCode(),
+		Message: st.GetMessage(),
+		Details: st.GetDetails(),
+	}
+}
+
+// batchItemError converts an item failure back to the service error it was created from.
+func batchItemError(failure *activitypb.BatchItemFailure) error {
+	st := status.New(codes.Code(failure.GetCode()), failure.GetMessage()).Proto()
+	st.Details = failure.GetDetails()
+	return serviceerrors.FromStatus(status.FromProto(st))
+}
+
+func fromBatchItemResults[T any, R batchItemResult[T]](items []R) []BatchResult[T] {
+	results := make([]BatchResult[T], len(items))
+	for i, item := range items {
+		if failure := item.GetFailure(); failure != nil {
+			results[i].Err = batchItemError(failure)
+			continue
+		}
+		results[i].Response = item.GetFrontendResponse()
+	}
+	return results
+}
+
+// isShardOwnershipLost reports whether err indicates that the shard a batch was routed to is not
+// owned by this host. Such errors fail the whole batch instead of individual items, so that the
+// client retries the batch against the shard's current owner.
+func isShardOwnershipLost(err error) bool {
+	var solErr *serviceerrors.ShardOwnershipLost
+	var persistenceSOLErr *persistence.ShardOwnershipLostError
+	return errors.As(err, &solErr) || errors.As(err, &persistenceSOLErr)
+}
+
+// dispatchBatch groups the items that have not already failed by the history shard owning their
+// activity ID, and sends one request per shard concurrently. A failed shard request only fails the
+// items that were routed to that shard.
+func dispatchBatch[Req any, Resp any](
+	ctx context.Context,
+	namespaceID namespace.ID,
+	shardPools *shardpool.Resolver,
+	reqs []Req,
+	activityID func(Req) string,
+	results []BatchResult[Resp],
+	send func(ctx context.Context, shardID int32, reqs []Req) ([]BatchResult[Resp], error),
+) {
+	indicesByShard := make(map[int32][]int)
+	for i, req := range reqs {
+		if results[i].Err != nil {
+			continue
+		}
+		shardID, err := shardPools.ShardID(namespaceID.String(), activityID(req))
+		if err != nil {
+			results[i] = BatchResult[Resp]{Err: err}
+			continue
+		}
+		indicesByShard[shardID] = append(indicesByShard[shardID], i)
+	}
+
+	// Each shard request writes to a disjoint set of indices, so results needs no locking.
+	var wg sync.WaitGroup
+	for shardID, indices := range indicesByShard {
+		wg.Go(func() {
+			shardReqs := make([]Req, len(indices))
+			for j, i := range indices {
+				shardReqs[j] = reqs[i]
+			}
+			shardResults, err := send(ctx, shardID, shardReqs)
+			if err == nil && len(shardResults) != len(indices) {
+				err = serviceerror.NewInternalf("shard %d returned %d results for %d requests", shardID, len(shardResults), len(indices))
+			}
+			for j, i := range indices {
+				if err != nil {
+					results[i] = BatchResult[Resp]{Err: err}
+					continue
+				}
+				results[i] = shardResults[j]
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// batchNamespace returns the namespace of a batch, which the frontend checks is the namespace of every item.
+func batchNamespace[Req interface{ GetNamespace() string }](reqs []Req) string {
+	if len(reqs) == 0 {
+		return ""
+	}
+	return reqs[0].GetNamespace()
+}
+
+// runBatchItems calls process for each of the n items of a batch, with at most maxConcurrency items processed at a
+// time. If key is set, items with the same key are processed one after another in request order, so that an item
+// observes the effects of the earlier items for the same execution.
+func runBatchItems(n int, maxConcurrency int, key func(i int) string, process func(i int)) {
+	var groups [][]int
+	if key == nil {
+		groups = make([][]int, n)
+		for i := range n {
+			groups[i] = []int{i}
+		}
+	} else {
+		groupByKey := make(map[string]int)
+		for i := range n {
+			g, ok := groupByKey[key(i)]
+			if !ok {
+				g = len(groups)
+				groupByKey[key(i)] = g
+				groups = append(groups, nil)
+			}
+			groups[g] = append(groups[g], i)
+		}
+	}
+
+	sem := make(chan struct{}, max(maxConcurrency, 1))
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			for _, i := range group {
+				process(i)
+			}
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,176 @@
+package activity
+
+import (
+	"slices"
+
+	apiactivitypb "go.temporal.io/api/activity/v1" //nolint:importas
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	activityspb "go.temporal.io/server/api/activity/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// hasNextChainStep reports whether a successful completion of the current activity should advance
+// its chain rather than close the execution.
+func (a *Activity) hasNextChainStep(ctx chasm.Context) bool {
+	chain, ok := a.Chain.TryGet(ctx)
+	return ok && len(chain.GetCompleted()) < len(chain.GetSteps())
+}
+
+// successfulCompletionTransition returns the transition to apply when the current activity
+// completes successfully. A pending cancellation request closes the execution even if there are
+// steps left in the chain.
+func (a *Activity) successfulCompletionTransition(ctx chasm.Context) chasm.Transition[activitypb.ActivityExecutionStatus, *Activity, completeEvent] {
+	if a.hasNextChainStep(ctx) {
+		if TransitionChainAdvanced.Possible(a) {
+			return TransitionChainAdvanced
+		}
+		if TransitionChainAdvancedPaused.Possible(a) {
+			return TransitionChainAdvancedPaused
+		}
+	}
+	return TransitionCompleted
+}
+
+// advanceChain records the successful completion of the current activity and replaces it with the
+// next step of the chain. The caller is responsible for scheduling the step.
+func (a *Activity) advanceChain(ctx chasm.MutableContext, event completeEvent) error {
+	chain := a.Chain.Get(ctx)
+	req := event.req.GetCompleteRequest()
+	now := ctx.Now(a)
+
+	attemptWasStarted := a.hasAttemptInProgress()
+	attempt := a.LastAttempt.Get(ctx)
+	if !attemptWasStarted {
+		attempt.StartedTime = timestamppb.New(now)
+	}
+	a.emitOnCompletedMetrics(ctx, event.baseHandler, event.enrichedHandler, req.GetResult(), attemptWasStarted)
+
+	chain.Completed = append(chain.Completed, &activityspb.ActivityChainStepRecord{
+		ActivityType: a.GetActivityType(),
+		TaskQueue:    a.GetTaskQueue().GetName(),
+		Attempts:     attempt.GetCount(),
+		ScheduleTime: a.GetScheduleTime(),
+		CloseTime:    timestamppb.New(now),
+	})
+	step := chain.GetSteps()[len(chain.GetCompleted())-1]
+	options := step.GetOptions()
+
+	a.ActivityType = step.GetActivityType()
+	a.TaskQueue = options.GetTaskQueue()
+	a.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeout()
+	a.ScheduleToStartTimeout = options.GetScheduleToStartTimeout()
+	a.StartToCloseTimeout = options.GetStartToCloseTimeout()
+	a.HeartbeatTimeout = options.GetHeartbeatTimeout()
+	a.RetryPolicy = options.GetRetryPolicy()
+	a.StartDelay = options.GetStartDelay()
+	if options.GetPriority() != nil {
+		a.Priority = options.GetPriority()
+	}
+	a.OriginalOptions = common.CloneProto(options)
+	a.ScheduleTime = timestamppb.New(now)
+	a.FirstAttemptStartedTime = nil
+	// A pending reset applied to the activity that just completed.
+	a.ResetShouldClearHeartbeat = false
+	a.ResetShouldPause = false
+	a.ResetRestoreOptions = false
+
+	a.RequestData.Get(ctx).Input = chainStepInput(step, req.GetResult())
+	a.clearHeartbeatDetails(ctx)
+
+	// Start the step from a clean attempt, but keep the stamp so that it keeps increasing and tasks
+	// issued for the completed activity are invalidated.
+	stamp := attempt.GetStamp()
+	proto.Reset(attempt)
+	attempt.Stamp = stamp
+
+	return nil
+}
+
+// chainStepInput derives the input of a chain step from the result of the previous activity.
+func chainStepInput(step *activityspb.ActivityChainStep, previousResult *commonpb.Payloads) *commonpb.Payloads {
+	switch step.GetInputMapping() {
+	case activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC:
+		return step.GetInput()
+	case activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT:
+		return &commonpb.Payloads{
+			Payloads: slices.Concat(step.GetInput().GetPayloads(), previousResult.GetPayloads()),
+		}
+	default:
+		return previousResult
+	}
+}
+
+// validateAndNormalizeChainSteps validates the follow-up steps of a chained start request, filling
+// in defaults the same way as for the first activity of the chain.
+// IMPORTANT: this function mutates the steps; clone them first if the request may be retried.
+func validateAndNormalizeChainSteps(
+	activityID string,
+	namespaceName string,
+	steps []*activityspb.ActivityChainStep,
+	config *Config,
+	logger log.Logger,
+) error {
+	if maxLength := config.MaxChainLength(namespaceName); len(steps) > maxLength {
+		return serviceerror.NewInvalidArgumentf("too many follow-up activities. Count=%d Limit=%d", len(steps), maxLength)
+	}
+
+	for i, step := range steps {
+		if step.Options == nil {
+			step.Options = &apiactivitypb.ActivityOptions{}
+		}
+		options := step.Options
+		if options.RetryPolicy == nil {
+			options.RetryPolicy = &commonpb.RetryPolicy{}
+		}
+
+		if err := validateStartDelay(options.GetStartDelay()); err != nil {
+			return err
+		}
+		if options.GetStartDelay().AsDuration() > 0 && !config.StartDelayEnabled(namespaceName) {
+			return serviceerror.NewInvalidArgument("start_delay is not enabled for this namespace")
+		}
+
+		if err := ValidateAndNormalizeStandaloneActivity(
+			activityID,
+			step.GetActivityType().GetName(),
+			config.DefaultActivityRetryPolicy,
+			config.MaxIDLengthLimit(),
+			namespace.Name(namespaceName),
+			options,
+			options.GetPriority(),
+		); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid follow-up activity %d: %v", i+1, err)
+		}
+
+		switch step.GetInputMapping() {
+		case activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED:
+			step.InputMapping = activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT
+			step.Input = nil
+		case activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT:
+			step.Input = nil
+		case activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC,
+			activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT:
+		default:
+			return serviceerror.NewInvalidArgumentf("invalid follow-up activity %d: unknown input mapping %v", i+1, step.GetInputMapping())
+		}
+
+		if err := validateBlobSize(
+			activityID,
+			"StartActivityExecution",
+			config.BlobSizeLimitError,
+			config.BlobSizeLimitWarn,
+			step.GetInput().Size(),
+			logger,
+			namespaceName); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid follow-up activity %d: input exceeds length limit", i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apiactivitypb "go.temporal.io/api/activity/v1" //nolint:importas
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	activityspb "go.temporal.io/server/api/activity/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newChainedActivity(
+	ctx chasm.MutableContext,
+	status activitypb.ActivityExecutionStatus,
+	steps ...*activityspb.ActivityChainStep,
+) *Activity {
+	return &Activity{
+		ActivityState: &activitypb.ActivityState{
+			ActivityType:           &commonpb.ActivityType{Name: "first-activity-type"},
+			RetryPolicy:            defaultRetryPolicy,
+			ScheduleTime:           timestamppb.New(defaultTime.Add(-time.Minute)),
+			ScheduleToCloseTimeout: durationpb.New(defaultScheduleToCloseTimeout),
+			StartToCloseTimeout:    durationpb.New(defaultStartToCloseTimeout),
+			Status:                 status,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "first-task-queue"},
+		},
+		LastAttempt: chasm.NewDataField(ctx, &activitypb.ActivityAttemptState{
+			Count:       3,
+			Stamp:       7,
+			StartedTime: timestamppb.New(defaultTime.Add(-time.Second)),
+		}),
+		Outcome:     chasm.NewDataField(ctx, &activitypb.ActivityOutcome{}),
+		RequestData: chasm.NewDataField(ctx, &activitypb.ActivityRequestData{Input: payloads.EncodeString("first-input")}),
+		Chain:       chasm.NewDataField(ctx, &activityspb.ActivityChainState{Steps: steps}),
+	}
+}
+
+func newChainStep(activityType string, mapping activityspb.ActivityChainInputMapping, input *commonpb.Payloads) *activityspb.ActivityChainStep {
+	return &activityspb.ActivityChainStep{
+		ActivityType: &commonpb.ActivityType{Name: activityType},
+		Options: &apiactivitypb.ActivityOptions{
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: activityType + "-task-queue"},
+			ScheduleToCloseTimeout: durationpb.New(defaultScheduleToCloseTimeout),
+			StartToCloseTimeout:    durationpb.New(defaultStartToCloseTimeout),
+			RetryPolicy:            defaultRetryPolicy,
+		},
+		InputMapping: mapping,
+		Input:        input,
+	}
+}
+
+func newChainCompleteEvent(result *commonpb.Payloads) completeEvent {
+	return completeEvent{
+		req: &historyservice.RespondActivityTaskCompletedRequest{
+			CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{Result: result},
+		},
+		baseHandler:     metrics.NoopMetricsHandler,
+		enrichedHandler: metrics.NoopMetricsHandler,
+	}
+}
+
+func TestChainAdvance(t *testing.T) {
+	result := payloads.EncodeString("result")
+	static := payloads.EncodeString("static")
+
+	testCases := []struct {
+		name          string
+		mapping       activityspb.ActivityChainInputMapping
+		expectedInput *commonpb.Payloads
+	}{
+		{
+			name:          "previous result",
+			mapping:       activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT,
+			expectedInput: result,
+		},
+		{
+			name:          "static",
+			mapping:       activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC,
+			expectedInput: static,
+		},
+		{
+			name:    "static then previous result",
+			mapping: activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC_THEN_PREVIOUS_RESULT,
+			expectedInput: &commonpb.Payloads{
+				Payloads: append(static.GetPayloads(), result.GetPayloads()...),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &chasm.MockMutableContext{}
+			ctx.HandleNow = func(chasm.Component) time.Time { return defaultTime }
+			activity := newChainedActivity(ctx, activitypb.ACTIVITY_EXECUTION_STATUS_STARTED,
+				newChainStep("second", tc.mapping, static))
+
+			transition := activity.successfulCompletionTransition(ctx)
+			require.NoError(t, transition.Apply(activity, ctx, newChainCompleteEvent(result)))
+
+			require.Equal(t, activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED, activity.Status)
+			require.Equal(t, "second", activity.GetActivityType().GetName())
+			require.Equal(t, "second-task-queue", activity.GetTaskQueue().GetName())
+			require.Equal(t, defaultTime, activity.GetScheduleTime().AsTime())
+			protorequire.ProtoEqual(t, tc.expectedInput, activity.RequestData.Get(ctx).GetInput())
+
+			attempt := activity.LastAttempt.Get(ctx)
+			require.EqualValues(t, 1, attempt.GetCount())
+			require.EqualValues(t, 8, attempt.GetStamp())
+			require.Nil(t, attempt.GetStartedTime())
+
+			chain := activity.Chain.Get(ctx)
+			require.Len(t, chain.GetCompleted(), 1)
+			record := chain.GetCompleted()[0]
+			require.Equal(t, "first-activity-type", record.GetActivityType().GetName())
+			require.Equal(t, "first-task-queue", record.GetTaskQueue())
+			require.EqualValues(t, 3, record.GetAttempts())
+			require.Equal(t, defaultTime, record.GetCloseTime().AsTime())
+
+			require.Len(t, ctx.Tasks, 2)
+			require.IsType(t, &activitypb.ScheduleToCloseTimeoutTask{}, ctx.Tasks[0].Payload)
+			require.IsType(t, &activitypb.ActivityDispatchTask{}, ctx.Tasks[1].Payload)
+			require.EqualValues(t, 8, ctx.Tasks[1].Payload.(*activitypb.ActivityDispatchTask).GetStamp())
+
+			// The last step closes the execution.
+			require.Equal(t, activitypb.ACTIVITY_EXECUTION_STATUS_COMPLETED, activity.successfulCompletionTransition(ctx).Destination)
+		})
+	}
+}
+
+func TestChainAdvancePaused(t *testing.T) {
+	ctx := &chasm.MockMutableContext{}
+	ctx.HandleNow = func(chasm.Component) time.Time { return defaultTime }
+	activity := newChainedActivity(ctx, activitypb.ACTIVITY_EXECUTION_STATUS_PAUSE_REQUESTED,
+		newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT, nil))
+
+	transition := activity.successfulCompletionTransition(ctx)
+	require.NoError(t, transition.Apply(activity, ctx, newChainCompleteEvent(payloads.EncodeString("result"))))
+
+	require.Equal(t, activitypb.ACTIVITY_EXECUTION_STATUS_PAUSED, activity.Status)
+	require.Equal(t, "second", activity.GetActivityType().GetName())
+	require.EqualValues(t, 1, activity.LastAttempt.Get(ctx).GetCount())
+	require.EqualValues(t, 8, activity.LastAttempt.Get(ctx).GetStamp())
+
+	// Only the ScheduleToClose timer is issued; the step is dispatched when the activity is unpaused.
+	require.Len(t, ctx.Tasks, 1)
+	require.IsType(t, &activitypb.ScheduleToCloseTimeoutTask{}, ctx.Tasks[0].Payload)
+}
+
+func TestChainCancelRequestedCompletes(t *testing.T) {
+	ctx := &chasm.MockMutableContext{}
+	activity := newChainedActivity(ctx, activitypb.ACTIVITY_EXECUTION_STATUS_CANCEL_REQUESTED,
+		newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT, nil))
+
+	require.Equal(t, activitypb.ACTIVITY_EXECUTION_STATUS_COMPLETED, activity.successfulCompletionTransition(ctx).Destination)
+}
+
+func TestValidateAndNormalizeChainSteps(t *testing.T) {
+	config := &Config{
+		BlobSizeLimitError:         defaultBlobSizeLimitError,
+		BlobSizeLimitWarn:          defaultBlobSizeLimitWarn,
+		DefaultActivityRetryPolicy: dynamicconfig.GetTypedPropertyFnFilteredByNamespace(getDefaultRetrySettings("")),
+		MaxChainLength:             dynamicconfig.GetIntPropertyFnFilteredByNamespace(2),
+		MaxIDLengthLimit:           func() int { return defaultMaxIDLengthLimit },
+		StartDelayEnabled:          dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+	}
+	validate := func(steps ...*activityspb.ActivityChainStep) error {
+		return validateAndNormalizeChainSteps(defaultActivityID, defaultNamespaceID, steps, config, log.NewNoopLogger())
+	}
+
+	t.Run("normalizes input mapping", func(t *testing.T) {
+		unspecified := newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, payloads.EncodeString("x"))
+		static := newChainStep("third", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC, payloads.EncodeString("x"))
+		require.NoError(t, validate(unspecified, static))
+		require.Equal(t, activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_PREVIOUS_RESULT, unspecified.GetInputMapping())
+		require.Nil(t, unspecified.GetInput())
+		require.NotNil(t, static.GetInput())
+	})
+
+	t.Run("too many steps", func(t *testing.T) {
+		var invalidArgErr *serviceerror.InvalidArgument
+		err := validate(
+			newChainStep("a", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, nil),
+			newChainStep("b", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, nil),
+			newChainStep("c", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, nil),
+		)
+		require.ErrorAs(t, err, &invalidArgErr)
+	})
+
+	t.Run("missing task queue", func(t *testing.T) {
+		step := newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, nil)
+		step.Options.TaskQueue = nil
+		var invalidArgErr *serviceerror.InvalidArgument
+		require.ErrorAs(t, validate(step), &invalidArgErr)
+	})
+
+	t.Run("start delay disabled", func(t *testing.T) {
+		step := newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_UNSPECIFIED, nil)
+		step.Options.StartDelay = durationpb.New(time.Minute)
+		require.ErrorContains(t, validate(step), "start_delay is not enabled")
+	})
+
+	t.Run("static input too large", func(t *testing.T) {
+		step := newChainStep("second", activityspb.ACTIVITY_CHAIN_INPUT_MAPPING_STATIC,
+			payloads.EncodeString(string(make([]byte, 1000))))
+		require.ErrorContains(t, validate(step), "input exceeds length limit")
+	})
+}
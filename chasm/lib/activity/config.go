@@ -40,6 +40,25 @@ var (
 		`Allows attaching completion callbacks to standalone activity executions.`,
 	)
 
+	BatchMaxSize = dynamicconfig.NewNamespaceIntSetting(
+		"activity.batchMaxSize",
+		1000,
+		`Maximum number of items in a single batch start, describe, or poll request for standalone activities.`,
+	)
+
+	BatchMaxConcurrency = dynamicconfig.NewNamespaceIntSetting(
+		"activity.batchMaxConcurrency",
+		100,
+		`Maximum number of items of a batch start, describe, or poll request for standalone activities that a history
+ host processes at a time. Poll items that only start once the long-poll deadline is reached have an empty response.`,
+	)
+
+	MaxChainLength = dynamicconfig.NewNamespaceIntSetting(
+		"activity.maxChainLength",
+		10,
+		`Maximum number of follow-up activities that can be chained to a standalone activity.`,
+	)
+
 	EnableStandaloneActivityOperatorCommands = dynamicconfig.NewNamespaceBoolSetting(
 		"history.enableStandaloneActivityOperatorCommands",
 		false,
@@ -48,6 +67,8 @@ var (
 )
 
 type Config struct {
+	BatchMaxConcurrency                       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BatchMaxSize                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BreakdownMetricsByTaskQueue               dynamicconfig.TypedPropertyFnWithTaskQueueFilter[bool]
@@ -56,6 +77,7 @@ type Config struct {
 	EnableStandaloneActivityOperatorCommands  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	LongPollBuffer                            dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	LongPollTimeout                           dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	MaxChainLength                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxIDLengthLimit                          dynamicconfig.IntPropertyFn
 	MaxCallbacksPerExecution                  dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateActivityFailureSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -68,6 +90,8 @@ type Config struct {
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
+		BatchMaxConcurrency:                       BatchMaxConcurrency.Get(dc),
+		BatchMaxSize:                              BatchMaxSize.Get(dc),
 		BlobSizeLimitError:                        dynamicconfig.BlobSizeLimitError.Get(dc),
 		BlobSizeLimitWarn:                         dynamicconfig.BlobSizeLimitWarn.Get(dc),
 		BreakdownMetricsByTaskQueue:               dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
@@ -77,6 +101,7 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 		EnableStandaloneActivityOperatorCommands:  EnableStandaloneActivityOperatorCommands.Get(dc),
 		LongPollBuffer:                            LongPollBuffer.Get(dc),
 		LongPollTimeout:                           LongPollTimeout.Get(dc),
+		MaxChainLength:                            MaxChainLength.Get(dc),
 		MaxIDLengthLimit:                          dynamicconfig.MaxIDLengthLimit.Get(dc),
 		StartDelayEnabled:                         StartDelayEnabled.Get(dc),
 		MaxCallbacksPerExecution:                  callback.MaxPerExecution.Get(dc),
@@ -9,6 +9,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	activityspb "go.temporal.io/server/api/activity/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
 	"go.temporal.io/server/chasm/lib/callback"
@@ -17,6 +18,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -25,7 +27,12 @@ import (
 type FrontendHandler interface {
 	StartActivityExecution(ctx context.Context, req *workflowservice.StartActivityExecutionRequest) (*workflowservice.StartActivityExecutionResponse, error)
 	DescribeActivityExecution(ctx context.Context, req *workflowservice.DescribeActivityExecutionRequest) (*workflowservice.DescribeActivityExecutionResponse, error)
+	StartChainedActivityExecution(ctx context.Context, req *workflowservice.StartActivityExecutionRequest, followUps []*activityspb.ActivityChainStep) (*workflowservice.StartActivityExecutionResponse, error)
+	DescribeChainedActivityExecution(ctx context.Context, req *workflowservice.DescribeActivityExecutionRequest) (*activitypb.DescribeActivityExecutionResponse, error)
 	PollActivityExecution(ctx context.Context, req *workflowservice.PollActivityExecutionRequest) (*workflowservice.PollActivityExecutionResponse, error)
+	BatchStartActivityExecutions(ctx context.Context, namespaceName string, reqs []*workflowservice.StartActivityExecutionRequest) ([]BatchResult[*workflowservice.StartActivityExecutionResponse], error)
+	BatchDescribeActivityExecutions(ctx context.Context, namespaceName string, reqs []*workflowservice.DescribeActivityExecutionRequest) ([]BatchResult[*workflowservice.DescribeActivityExecutionResponse], error)
+	BatchPollActivityExecutions(ctx context.Context, namespaceName string, reqs []*workflowservice.PollActivityExecutionRequest) ([]BatchResult[*workflowservice.PollActivityExecutionResponse], error)
 	CountActivityExecutions(context.Context, *workflowservice.CountActivityExecutionsRequest) (*workflowservice.CountActivityExecutionsResponse, error)
 	DeleteActivityExecution(context.Context, *workflowservice.DeleteActivityExecutionRequest) (*workflowservice.DeleteActivityExecutionResponse, error)
 	ListActivityExecutions(context.Context, *workflowservice.ListActivityExecutionsRequest) (*workflowservice.ListActivityExecutionsResponse, error)
@@ -53,6 +60,7 @@ type frontendHandler struct {
 	namespaceRegistry namespace.Registry
 	saMapperProvider  searchattribute.MapperProvider
 	saValidator       *searchattribute.Validator
+	shardPools        *shardpool.Resolver
 }
 
 // NewFrontendHandler creates a new FrontendHandler instance for processing activity frontend requests.
@@ -66,6 +74,7 @@ func NewFrontendHandler(
 	namespaceRegistry namespace.Registry,
 	saMapperProvider searchattribute.MapperProvider,
 	saValidator *searchattribute.Validator,
+	shardPools *shardpool.Resolver,
 ) FrontendHandler {
 	return &frontendHandler{
 		callbackValidator: callbackValidator,
@@ -77,6 +86,7 @@ func NewFrontendHandler(
 		namespaceRegistry: namespaceRegistry,
 		saMapperProvider:  saMapperProvider,
 		saValidator:       saValidator,
+		shardPools:        shardPools,
 	}
 }
 
@@ -95,6 +105,29 @@ func (h *frontendHandler) IsStandaloneActivityEnabled(namespaceName string) bool
 // before mutation to preserve the original for retries.
 // 3. Sends the request to the history activity service.
 func (h *frontendHandler) StartActivityExecution(ctx context.Context, req *workflowservice.StartActivityExecutionRequest) (*workflowservice.StartActivityExecutionResponse, error) {
+	return h.startActivityExecution(ctx, req, nil)
+}
+
+// StartChainedActivityExecution is like StartActivityExecution, but additionally declares follow-up
+// activities that are started automatically, in the same execution, each time the previous
+// activity completes successfully. The execution closes when the last activity closes, or as soon
+// as any activity of the chain closes unsuccessfully.
+func (h *frontendHandler) StartChainedActivityExecution(
+	ctx context.Context,
+	req *workflowservice.StartActivityExecutionRequest,
+	followUps []*activityspb.ActivityChainStep,
+) (*workflowservice.StartActivityExecutionResponse, error) {
+	if len(followUps) == 0 {
+		return nil, serviceerror.NewInvalidArgument("at least one follow-up activity is required")
+	}
+	return h.startActivityExecution(ctx, req, followUps)
+}
+
+func (h *frontendHandler) startActivityExecution(
+	ctx context.Context,
+	req *workflowservice.StartActivityExecutionRequest,
+	followUps []*activityspb.ActivityChainStep,
+) (*workflowservice.StartActivityExecutionResponse, error) {
 	if !h.config.Enabled(req.GetNamespace()) {
 		return nil, ErrStandaloneActivityDisabled
 	}
@@ -109,9 +142,19 @@ func (h *frontendHandler) StartActivityExecution(ctx context.Context, req *workf
 		return nil, err
 	}
 
+	// Like the request, the steps are cloned before normalization to preserve them for retries.
+	steps := make([]*activityspb.ActivityChainStep, len(followUps))
+	for i, step := range followUps {
+		steps[i] = common.CloneProto(step)
+	}
+	if err := validateAndNormalizeChainSteps(modifiedReq.GetActivityId(), modifiedReq.GetNamespace(), steps, h.config, h.logger); err != nil {
+		return nil, err
+	}
+
 	resp, err := h.client.StartActivityExecution(ctx, &activitypb.StartActivityExecutionRequest{
 		NamespaceId:     namespaceID.String(),
 		FrontendRequest: modifiedReq,
+		FollowUps:       steps,
 	})
 
 	return resp.GetFrontendResponse(), err
@@ -147,6 +190,35 @@ func (h *frontendHandler) DescribeActivityExecution(
 	return resp.GetFrontendResponse(), err
 }
 
+// DescribeChainedActivityExecution is like DescribeActivityExecution, but also returns the progress
+// of the activity's chain if it was started with follow-up activities.
+func (h *frontendHandler) DescribeChainedActivityExecution(
+	ctx context.Context,
+	req *workflowservice.DescribeActivityExecutionRequest,
+) (*activitypb.DescribeActivityExecutionResponse, error) {
+	if !h.config.Enabled(req.GetNamespace()) {
+		return nil, ErrStandaloneActivityDisabled
+	}
+
+	err := validateAndNormalizeDescribeActivityExecutionRequest(
+		req,
+		h.config.MaxIDLengthLimit(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	return h.client.DescribeActivityExecution(ctx, &activitypb.DescribeActivityExecutionRequest{
+		NamespaceId:     namespaceID.String(),
+		FrontendRequest: req,
+	})
+}
+
 // PollActivityExecution long-polls for activity outcome.
 func (h *frontendHandler) PollActivityExecution(
 	ctx context.Context,
@@ -174,6 +246,150 @@ func (h *frontendHandler) PollActivityExecution(
 	return resp.GetFrontendResponse(), err
 }
 
+// BatchStartActivityExecutions starts a batch of standalone activity executions in one namespace.
+// Items are validated individually and sent to history in one request per owning shard. The
+// returned slice holds one result per request, in request order; ID reuse and conflict policy
+// outcomes are reported per item. An error is returned only if the batch as a whole is rejected.
+func (h *frontendHandler) BatchStartActivityExecutions(
+	ctx context.Context,
+	namespaceName string,
+	reqs []*workflowservice.StartActivityExecutionRequest,
+) ([]BatchResult[*workflowservice.StartActivityExecutionResponse], error) {
+	namespaceID, err := h.validateBatch(namespaceName, len(reqs))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult[*workflowservice.StartActivityExecutionResponse], len(reqs))
+	modifiedReqs := make([]*workflowservice.StartActivityExecutionRequest, len(reqs))
+	for i, req := range reqs {
+		if results[i].Err = validateBatchItemNamespace(req.GetNamespace(), namespaceName); results[i].Err != nil {
+			continue
+		}
+		modifiedReqs[i], results[i].Err = h.validateAndPopulateStartRequest(ctx, req, namespaceID)
+	}
+
+	dispatchBatch(ctx, namespaceID, h.shardPools, modifiedReqs, (*workflowservice.StartActivityExecutionRequest).GetActivityId, results, func(
+		ctx context.Context,
+		shardID int32,
+		reqs []*workflowservice.StartActivityExecutionRequest,
+	) ([]BatchResult[*workflowservice.StartActivityExecutionResponse], error) {
+		resp, err := h.client.BatchStartActivityExecutions(ctx, &activitypb.BatchStartActivityExecutionsRequest{
+			NamespaceId:      namespaceID.String(),
+			ShardId:          shardID,
+			FrontendRequests: reqs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fromBatchItemResults[*workflowservice.StartActivityExecutionResponse](resp.GetResults()), nil
+	})
+	return results, nil
+}
+
+// BatchDescribeActivityExecutions queries the current state of a batch of activity executions in
+// one namespace. Long-poll tokens are not supported. Results are returned per item, in request
+// order.
+func (h *frontendHandler) BatchDescribeActivityExecutions(
+	ctx context.Context,
+	namespaceName string,
+	reqs []*workflowservice.DescribeActivityExecutionRequest,
+) ([]BatchResult[*workflowservice.DescribeActivityExecutionResponse], error) {
+	namespaceID, err := h.validateBatch(namespaceName, len(reqs))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult[*workflowservice.DescribeActivityExecutionResponse], len(reqs))
+	for i, req := range reqs {
+		if results[i].Err = validateBatchItemNamespace(req.GetNamespace(), namespaceName); results[i].Err != nil {
+			continue
+		}
+		if len(req.GetLongPollToken()) > 0 {
+			results[i].Err = serviceerror.NewInvalidArgument("long_poll_token is not supported in batch describe requests")
+			continue
+		}
+		results[i].Err = validateAndNormalizeDescribeActivityExecutionRequest(req, h.config.MaxIDLengthLimit())
+	}
+
+	dispatchBatch(ctx, namespaceID, h.shardPools, reqs, (*workflowservice.DescribeActivityExecutionRequest).GetActivityId, results, func(
+		ctx context.Context,
+		shardID int32,
+		reqs []*workflowservice.DescribeActivityExecutionRequest,
+	) ([]BatchResult[*workflowservice.DescribeActivityExecutionResponse], error) {
+		resp, err := h.client.BatchDescribeActivityExecutions(ctx, &activitypb.BatchDescribeActivityExecutionsRequest{
+			NamespaceId:      namespaceID.String(),
+			ShardId:          shardID,
+			FrontendRequests: reqs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fromBatchItemResults[*workflowservice.DescribeActivityExecutionResponse](resp.GetResults()), nil
+	})
+	return results, nil
+}
+
+// BatchPollActivityExecutions long-polls for the outcomes of a batch of activity executions in one
+// namespace. It returns once every activity in the batch has an outcome or the long-poll deadline
+// is reached; items without an outcome have an empty response and should be polled again.
+func (h *frontendHandler) BatchPollActivityExecutions(
+	ctx context.Context,
+	namespaceName string,
+	reqs []*workflowservice.PollActivityExecutionRequest,
+) ([]BatchResult[*workflowservice.PollActivityExecutionResponse], error) {
+	namespaceID, err := h.validateBatch(namespaceName, len(reqs))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult[*workflowservice.PollActivityExecutionResponse], len(reqs))
+	for i, req := range reqs {
+		if results[i].Err = validateBatchItemNamespace(req.GetNamespace(), namespaceName); results[i].Err != nil {
+			continue
+		}
+		results[i].Err = validateAndNormalizePollActivityExecutionRequest(req, h.config.MaxIDLengthLimit())
+	}
+
+	dispatchBatch(ctx, namespaceID, h.shardPools, reqs, (*workflowservice.PollActivityExecutionRequest).GetActivityId, results, func(
+		ctx context.Context,
+		shardID int32,
+		reqs []*workflowservice.PollActivityExecutionRequest,
+	) ([]BatchResult[*workflowservice.PollActivityExecutionResponse], error) {
+		resp, err := h.client.BatchPollActivityExecutions(ctx, &activitypb.BatchPollActivityExecutionsRequest{
+			NamespaceId:      namespaceID.String(),
+			ShardId:          shardID,
+			FrontendRequests: reqs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fromBatchItemResults[*workflowservice.PollActivityExecutionResponse](resp.GetResults()), nil
+	})
+	return results, nil
+}
+
+// validateBatch checks the constraints that apply to a batch as a whole and resolves its namespace.
+func (h *frontendHandler) validateBatch(namespaceName string, size int) (namespace.ID, error) {
+	if !h.config.Enabled(namespaceName) {
+		return namespace.EmptyID, ErrStandaloneActivityDisabled
+	}
+	if size == 0 {
+		return namespace.EmptyID, serviceerror.NewInvalidArgument("batch must contain at least one request")
+	}
+	if maxSize := h.config.BatchMaxSize(namespaceName); size > maxSize {
+		return namespace.EmptyID, serviceerror.NewInvalidArgumentf("batch size %d exceeds limit %d", size, maxSize)
+	}
+	return h.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+}
+
+func validateBatchItemNamespace(itemNamespace, batchNamespace string) error {
+	if itemNamespace != batchNamespace {
+		return serviceerror.NewInvalidArgumentf("request namespace %q does not match batch namespace %q", itemNamespace, batchNamespace)
+	}
+	return nil
+}
+
 // ListActivityExecutions lists activity executions matching the query in the request.
 func (h *frontendHandler) ListActivityExecutions(
 	ctx context.Context,
@@ -2,16 +2,23 @@ package activity
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -215,3 +222,135 @@ func TestRequestIdStableAcrossRetries(t *testing.T) {
 		})
 	})
 }
+
+// batchClient is a fake ActivityServiceClient that serves batch describe requests and records the
+// requests it received.
+type batchClient struct {
+	activitypb.ActivityServiceClient
+	mu       sync.Mutex
+	requests []*activitypb.BatchDescribeActivityExecutionsRequest
+	failing  map[int32]error
+}
+
+func (c *batchClient) BatchDescribeActivityExecutions(
+	_ context.Context,
+	req *activitypb.BatchDescribeActivityExecutionsRequest,
+	_ ...grpc.CallOption,
+) (*activitypb.BatchDescribeActivityExecutionsResponse, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, req)
+	c.mu.Unlock()
+	if err := c.failing[req.GetShardId()]; err != nil {
+		return nil, err
+	}
+
+	results := make([]*activitypb.BatchDescribeActivityExecutionsResponse_Result, len(req.GetFrontendRequests()))
+	for i, frontendReq := range req.GetFrontendRequests() {
+		if frontendReq.GetActivityId() == "missing" {
+			results[i] = &activitypb.BatchDescribeActivityExecutionsResponse_Result{
+				Outcome: &activitypb.BatchDescribeActivityExecutionsResponse_Result_Failure{
+					Failure: newBatchItemFailure(serviceerror.NewNotFound("activity not found")),
+				},
+			}
+			continue
+		}
+		results[i] = &activitypb.BatchDescribeActivityExecutionsResponse_Result{
+			Outcome: &activitypb.BatchDescribeActivityExecutionsResponse_Result_FrontendResponse{
+				FrontendResponse: &workflowservice.DescribeActivityExecutionResponse{RunId: frontendReq.GetActivityId()},
+			},
+		}
+	}
+	return &activitypb.BatchDescribeActivityExecutionsResponse{Results: results}, nil
+}
+
+func TestBatchDescribeActivityExecutions(t *testing.T) {
+	const (
+		nsName    = "test-namespace"
+		nsID      = namespace.ID("test-namespace-id")
+		numShards = 4
+	)
+
+	newHandler := func(t *testing.T, client *batchClient) *frontendHandler {
+		ctrl := gomock.NewController(t)
+		registry := namespace.NewMockRegistry(ctrl)
+		registry.EXPECT().GetNamespaceID(namespace.Name(nsName)).Return(nsID, nil).AnyTimes()
+		return &frontendHandler{
+			client: client,
+			config: &Config{
+				BatchMaxSize:     dynamicconfig.GetIntPropertyFnFilteredByNamespace(12),
+				Enabled:          dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+				MaxIDLengthLimit: func() int { return defaultMaxIDLengthLimit },
+			},
+			logger:            log.NewNoopLogger(),
+			namespaceRegistry: registry,
+			shardPools:        shardpooltest.NewResolver(numShards),
+		}
+	}
+	newReqs := func(activityIDs ...string) []*workflowservice.DescribeActivityExecutionRequest {
+		reqs := make([]*workflowservice.DescribeActivityExecutionRequest, len(activityIDs))
+		for i, id := range activityIDs {
+			reqs[i] = &workflowservice.DescribeActivityExecutionRequest{Namespace: nsName, ActivityId: id}
+		}
+		return reqs
+	}
+	activityIDs := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	t.Run("routes by shard", func(t *testing.T) {
+		client := &batchClient{}
+		results, err := newHandler(t, client).BatchDescribeActivityExecutions(context.Background(), nsName, newReqs(activityIDs...))
+		require.NoError(t, err)
+		require.Len(t, results, len(activityIDs))
+		for i, result := range results {
+			require.NoError(t, result.Err)
+			require.Equal(t, activityIDs[i], result.Response.GetRunId())
+		}
+
+		shards := make(map[int32]bool)
+		for _, req := range client.requests {
+			require.False(t, shards[req.GetShardId()], "shard %d received more than one request", req.GetShardId())
+			shards[req.GetShardId()] = true
+			for _, frontendReq := range req.GetFrontendRequests() {
+				require.Equal(t, req.GetShardId(), common.WorkflowIDToHistoryShard(nsID.String(), frontendReq.GetActivityId(), numShards))
+			}
+		}
+	})
+
+	t.Run("reports partial failures", func(t *testing.T) {
+		failedShard := common.WorkflowIDToHistoryShard(nsID.String(), "a", numShards)
+		client := &batchClient{failing: map[int32]error{failedShard: serviceerror.NewUnavailable("shard unavailable")}}
+		reqs := newReqs(append(activityIDs, "missing", "")...)
+		reqs = append(reqs, &workflowservice.DescribeActivityExecutionRequest{Namespace: "other-namespace", ActivityId: "x"})
+
+		results, err := newHandler(t, client).BatchDescribeActivityExecutions(context.Background(), nsName, reqs)
+		require.NoError(t, err)
+		require.Len(t, results, len(reqs))
+		for i, id := range activityIDs {
+			if common.WorkflowIDToHistoryShard(nsID.String(), id, numShards) == failedShard {
+				var unavailableErr *serviceerror.Unavailable
+				require.ErrorAs(t, results[i].Err, &unavailableErr)
+				continue
+			}
+			require.NoError(t, results[i].Err)
+		}
+		var notFoundErr *serviceerror.NotFound
+		require.ErrorAs(t, results[len(activityIDs)].Err, &notFoundErr)
+		var invalidArgErr *serviceerror.InvalidArgument
+		require.ErrorAs(t, results[len(activityIDs)+1].Err, &invalidArgErr)
+		require.ErrorAs(t, results[len(activityIDs)+2].Err, &invalidArgErr)
+	})
+
+	t.Run("rejects oversized batch", func(t *testing.T) {
+		client := &batchClient{}
+		_, err := newHandler(t, client).BatchDescribeActivityExecutions(context.Background(), nsName, newReqs(append(activityIDs, "i", "j", "k", "l", "m")...))
+		var invalidArgErr *serviceerror.InvalidArgument
+		require.ErrorAs(t, err, &invalidArgErr)
+		require.Empty(t, client.requests)
+	})
+}
+
+func TestBatchItemFailureRoundTrip(t *testing.T) {
+	err := batchItemError(newBatchItemFailure(serviceerror.NewActivityExecutionAlreadyStarted("already started", "request-id", "run-id")))
+	var alreadyStartedErr *serviceerror.ActivityExecutionAlreadyStarted
+	require.ErrorAs(t, err, &alreadyStartedErr)
+	require.Equal(t, "run-id", alreadyStartedErr.RunId)
+}
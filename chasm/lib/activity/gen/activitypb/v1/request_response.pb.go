@@ -12,8 +12,10 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/workflowservice/v1"
+	v11 "go.temporal.io/server/api/activity/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 )
 
 const (
@@ -27,8 +29,10 @@ type StartActivityExecutionRequest struct {
 	state           protoimpl.MessageState            `protogen:"open.v1"`
 	NamespaceId     string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	FrontendRequest *v1.StartActivityExecutionRequest `protobuf:"bytes,2,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Activities to run, in order, after the requested activity completes successfully.
+	FollowUps     []*v11.ActivityChainStep `protobuf:"bytes,3,rep,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartActivityExecutionRequest) Reset() {
@@ -75,6 +79,13 @@ func (x *StartActivityExecutionRequest) GetFrontendRequest() *v1.StartActivityEx
 	return nil
 }
 
+func (x *StartActivityExecutionRequest) GetFollowUps() []*v11.ActivityChainStep {
+	if x != nil {
+		return x.FollowUps
+	}
+	return nil
+}
+
 type StartActivityExecutionResponse struct {
 	state            protoimpl.MessageState             `protogen:"open.v1"`
 	FrontendResponse *v1.StartActivityExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3" json:"frontend_response,omitempty"`
@@ -174,8 +185,10 @@ func (x *DescribeActivityExecutionRequest) GetFrontendRequest() *v1.DescribeActi
 type DescribeActivityExecutionResponse struct {
 	state            protoimpl.MessageState                `protogen:"open.v1"`
 	FrontendResponse *v1.DescribeActivityExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3" json:"frontend_response,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Set if the activity was started with follow-up steps.
+	Chain         *v11.ActivityChainState `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DescribeActivityExecutionResponse) Reset() {
@@ -215,6 +228,13 @@ func (x *DescribeActivityExecutionResponse) GetFrontendResponse() *v1.DescribeAc
 	return nil
 }
 
+func (x *DescribeActivityExecutionResponse) GetChain() *v11.ActivityChainState {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
 type PollActivityExecutionRequest struct {
 	state           protoimpl.MessageState           `protogen:"open.v1"`
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -311,6 +331,386 @@ func (x *PollActivityExecutionResponse) GetFrontendResponse() *v1.PollActivityEx
 	return nil
 }
 
+// Status of a batch item that failed. Mirrors google.rpc.Status so that it round-trips service errors.
+type BatchItemFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Details       []*anypb.Any           `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemFailure) Reset() {
+	*x = BatchItemFailure{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemFailure) ProtoMessage() {}
+
+func (x *BatchItemFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemFailure.ProtoReflect.Descriptor instead.
+func (*BatchItemFailure) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchItemFailure) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchItemFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchItemFailure) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type BatchStartActivityExecutionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// All activity IDs in the batch must map to this shard.
+	ShardId          int32                               `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	FrontendRequests []*v1.StartActivityExecutionRequest `protobuf:"bytes,3,rep,name=frontend_requests,json=frontendRequests,proto3" json:"frontend_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsRequest) Reset() {
+	*x = BatchStartActivityExecutionsRequest{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchStartActivityExecutionsRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *BatchStartActivityExecutionsRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *BatchStartActivityExecutionsRequest) GetFrontendRequests() []*v1.StartActivityExecutionRequest {
+	if x != nil {
+		return x.FrontendRequests
+	}
+	return nil
+}
+
+type BatchStartActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchStartActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsResponse) Reset() {
+	*x = BatchStartActivityExecutionsResponse{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchStartActivityExecutionsResponse) GetResults() []*BatchStartActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDescribeActivityExecutionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// All activity IDs in the batch must map to this shard.
+	ShardId int32 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Long-poll tokens are not supported in batch requests.
+	FrontendRequests []*v1.DescribeActivityExecutionRequest `protobuf:"bytes,3,rep,name=frontend_requests,json=frontendRequests,proto3" json:"frontend_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) Reset() {
+	*x = BatchDescribeActivityExecutionsRequest{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *BatchDescribeActivityExecutionsRequest) GetFrontendRequests() []*v1.DescribeActivityExecutionRequest {
+	if x != nil {
+		return x.FrontendRequests
+	}
+	return nil
+}
+
+type BatchDescribeActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchDescribeActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) Reset() {
+	*x = BatchDescribeActivityExecutionsResponse{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchDescribeActivityExecutionsResponse) GetResults() []*BatchDescribeActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchPollActivityExecutionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// All activity IDs in the batch must map to this shard.
+	ShardId          int32                              `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	FrontendRequests []*v1.PollActivityExecutionRequest `protobuf:"bytes,3,rep,name=frontend_requests,json=frontendRequests,proto3" json:"frontend_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsRequest) Reset() {
+	*x = BatchPollActivityExecutionsRequest{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsRequest) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchPollActivityExecutionsRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *BatchPollActivityExecutionsRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *BatchPollActivityExecutionsRequest) GetFrontendRequests() []*v1.PollActivityExecutionRequest {
+	if x != nil {
+		return x.FrontendRequests
+	}
+	return nil
+}
+
+type BatchPollActivityExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request, in request order.
+	Results       []*BatchPollActivityExecutionsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsResponse) Reset() {
+	*x = BatchPollActivityExecutionsResponse{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsResponse) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchPollActivityExecutionsResponse) GetResults() []*BatchPollActivityExecutionsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type TerminateActivityExecutionRequest struct {
 	state           protoimpl.MessageState                `protogen:"open.v1"`
 	NamespaceId     string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -321,7 +721,7 @@ type TerminateActivityExecutionRequest struct {
 
 func (x *TerminateActivityExecutionRequest) Reset() {
 	*x = TerminateActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[6]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -333,7 +733,7 @@ func (x *TerminateActivityExecutionRequest) String() string {
 func (*TerminateActivityExecutionRequest) ProtoMessage() {}
 
 func (x *TerminateActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[6]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +746,7 @@ func (x *TerminateActivityExecutionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TerminateActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*TerminateActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{6}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TerminateActivityExecutionRequest) GetNamespaceId() string {
@@ -371,7 +771,7 @@ type TerminateActivityExecutionResponse struct {
 
 func (x *TerminateActivityExecutionResponse) Reset() {
 	*x = TerminateActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[7]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +783,7 @@ func (x *TerminateActivityExecutionResponse) String() string {
 func (*TerminateActivityExecutionResponse) ProtoMessage() {}
 
 func (x *TerminateActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[7]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +796,7 @@ func (x *TerminateActivityExecutionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TerminateActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*TerminateActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{14}
 }
 
 type RequestCancelActivityExecutionRequest struct {
@@ -409,7 +809,7 @@ type RequestCancelActivityExecutionRequest struct {
 
 func (x *RequestCancelActivityExecutionRequest) Reset() {
 	*x = RequestCancelActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[8]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +821,7 @@ func (x *RequestCancelActivityExecutionRequest) String() string {
 func (*RequestCancelActivityExecutionRequest) ProtoMessage() {}
 
 func (x *RequestCancelActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[8]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +834,7 @@ func (x *RequestCancelActivityExecutionRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use RequestCancelActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*RequestCancelActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RequestCancelActivityExecutionRequest) GetNamespaceId() string {
@@ -459,7 +859,7 @@ type RequestCancelActivityExecutionResponse struct {
 
 func (x *RequestCancelActivityExecutionResponse) Reset() {
 	*x = RequestCancelActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[9]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -471,7 +871,7 @@ func (x *RequestCancelActivityExecutionResponse) String() string {
 func (*RequestCancelActivityExecutionResponse) ProtoMessage() {}
 
 func (x *RequestCancelActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[9]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +884,7 @@ func (x *RequestCancelActivityExecutionResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use RequestCancelActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*RequestCancelActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{9}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{16}
 }
 
 type DeleteActivityExecutionRequest struct {
@@ -497,7 +897,7 @@ type DeleteActivityExecutionRequest struct {
 
 func (x *DeleteActivityExecutionRequest) Reset() {
 	*x = DeleteActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[10]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +909,7 @@ func (x *DeleteActivityExecutionRequest) String() string {
 func (*DeleteActivityExecutionRequest) ProtoMessage() {}
 
 func (x *DeleteActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[10]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +922,7 @@ func (x *DeleteActivityExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{10}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteActivityExecutionRequest) GetNamespaceId() string {
@@ -547,7 +947,7 @@ type DeleteActivityExecutionResponse struct {
 
 func (x *DeleteActivityExecutionResponse) Reset() {
 	*x = DeleteActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[11]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +959,7 @@ func (x *DeleteActivityExecutionResponse) String() string {
 func (*DeleteActivityExecutionResponse) ProtoMessage() {}
 
 func (x *DeleteActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[11]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +972,7 @@ func (x *DeleteActivityExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{11}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{18}
 }
 
 type PauseActivityExecutionRequest struct {
@@ -585,7 +985,7 @@ type PauseActivityExecutionRequest struct {
 
 func (x *PauseActivityExecutionRequest) Reset() {
 	*x = PauseActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[12]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +997,7 @@ func (x *PauseActivityExecutionRequest) String() string {
 func (*PauseActivityExecutionRequest) ProtoMessage() {}
 
 func (x *PauseActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[12]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +1010,7 @@ func (x *PauseActivityExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*PauseActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{12}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PauseActivityExecutionRequest) GetNamespaceId() string {
@@ -635,7 +1035,7 @@ type PauseActivityExecutionResponse struct {
 
 func (x *PauseActivityExecutionResponse) Reset() {
 	*x = PauseActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[13]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +1047,7 @@ func (x *PauseActivityExecutionResponse) String() string {
 func (*PauseActivityExecutionResponse) ProtoMessage() {}
 
 func (x *PauseActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[13]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +1060,7 @@ func (x *PauseActivityExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*PauseActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{13}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{20}
 }
 
 type UnpauseActivityExecutionRequest struct {
@@ -673,7 +1073,7 @@ type UnpauseActivityExecutionRequest struct {
 
 func (x *UnpauseActivityExecutionRequest) Reset() {
 	*x = UnpauseActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[14]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +1085,7 @@ func (x *UnpauseActivityExecutionRequest) String() string {
 func (*UnpauseActivityExecutionRequest) ProtoMessage() {}
 
 func (x *UnpauseActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[14]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1098,7 @@ func (x *UnpauseActivityExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpauseActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*UnpauseActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UnpauseActivityExecutionRequest) GetNamespaceId() string {
@@ -723,7 +1123,7 @@ type UnpauseActivityExecutionResponse struct {
 
 func (x *UnpauseActivityExecutionResponse) Reset() {
 	*x = UnpauseActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[15]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +1135,7 @@ func (x *UnpauseActivityExecutionResponse) String() string {
 func (*UnpauseActivityExecutionResponse) ProtoMessage() {}
 
 func (x *UnpauseActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[15]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +1148,7 @@ func (x *UnpauseActivityExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpauseActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*UnpauseActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{22}
 }
 
 type ResetActivityExecutionRequest struct {
@@ -761,7 +1161,7 @@ type ResetActivityExecutionRequest struct {
 
 func (x *ResetActivityExecutionRequest) Reset() {
 	*x = ResetActivityExecutionRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[16]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1173,7 @@ func (x *ResetActivityExecutionRequest) String() string {
 func (*ResetActivityExecutionRequest) ProtoMessage() {}
 
 func (x *ResetActivityExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[16]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1186,7 @@ func (x *ResetActivityExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetActivityExecutionRequest.ProtoReflect.Descriptor instead.
 func (*ResetActivityExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{16}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ResetActivityExecutionRequest) GetNamespaceId() string {
@@ -811,7 +1211,7 @@ type ResetActivityExecutionResponse struct {
 
 func (x *ResetActivityExecutionResponse) Reset() {
 	*x = ResetActivityExecutionResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[17]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -823,7 +1223,7 @@ func (x *ResetActivityExecutionResponse) String() string {
 func (*ResetActivityExecutionResponse) ProtoMessage() {}
 
 func (x *ResetActivityExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[17]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +1236,7 @@ func (x *ResetActivityExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetActivityExecutionResponse.ProtoReflect.Descriptor instead.
 func (*ResetActivityExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{17}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{24}
 }
 
 type UpdateActivityExecutionOptionsRequest struct {
@@ -849,7 +1249,7 @@ type UpdateActivityExecutionOptionsRequest struct {
 
 func (x *UpdateActivityExecutionOptionsRequest) Reset() {
 	*x = UpdateActivityExecutionOptionsRequest{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[18]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -861,7 +1261,7 @@ func (x *UpdateActivityExecutionOptionsRequest) String() string {
 func (*UpdateActivityExecutionOptionsRequest) ProtoMessage() {}
 
 func (x *UpdateActivityExecutionOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[18]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1274,7 @@ func (x *UpdateActivityExecutionOptionsRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateActivityExecutionOptionsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateActivityExecutionOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{18}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateActivityExecutionOptionsRequest) GetNamespaceId() string {
@@ -900,7 +1300,7 @@ type UpdateActivityExecutionOptionsResponse struct {
 
 func (x *UpdateActivityExecutionOptionsResponse) Reset() {
 	*x = UpdateActivityExecutionOptionsResponse{}
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[19]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1312,7 @@ func (x *UpdateActivityExecutionOptionsResponse) String() string {
 func (*UpdateActivityExecutionOptionsResponse) ProtoMessage() {}
 
 func (x *UpdateActivityExecutionOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[19]
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1325,7 @@ func (x *UpdateActivityExecutionOptionsResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use UpdateActivityExecutionOptionsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateActivityExecutionOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{19}
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateActivityExecutionOptionsResponse) GetFrontendResponse() *v1.UpdateActivityExecutionOptionsResponse {
@@ -935,26 +1335,316 @@ func (x *UpdateActivityExecutionOptionsResponse) GetFrontendResponse() *v1.Updat
 	return nil
 }
 
+type BatchStartActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchStartActivityExecutionsResponse_Result_FrontendResponse
+	//	*BatchStartActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchStartActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) Reset() {
+	*x = BatchStartActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStartActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchStartActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStartActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchStartActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetOutcome() isBatchStartActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetFrontendResponse() *v1.StartActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchStartActivityExecutionsResponse_Result_FrontendResponse); ok {
+			return x.FrontendResponse
+		}
+	}
+	return nil
+}
+
+func (x *BatchStartActivityExecutionsResponse_Result) GetFailure() *BatchItemFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchStartActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchStartActivityExecutionsResponse_Result_Outcome interface {
+	isBatchStartActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchStartActivityExecutionsResponse_Result_FrontendResponse struct {
+	FrontendResponse *v1.StartActivityExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3,oneof"`
+}
+
+type BatchStartActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchItemFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchStartActivityExecutionsResponse_Result_FrontendResponse) isBatchStartActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchStartActivityExecutionsResponse_Result_Failure) isBatchStartActivityExecutionsResponse_Result_Outcome() {
+}
+
+type BatchDescribeActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchDescribeActivityExecutionsResponse_Result_FrontendResponse
+	//	*BatchDescribeActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchDescribeActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) Reset() {
+	*x = BatchDescribeActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDescribeActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchDescribeActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetOutcome() isBatchDescribeActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetFrontendResponse() *v1.DescribeActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchDescribeActivityExecutionsResponse_Result_FrontendResponse); ok {
+			return x.FrontendResponse
+		}
+	}
+	return nil
+}
+
+func (x *BatchDescribeActivityExecutionsResponse_Result) GetFailure() *BatchItemFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchDescribeActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchDescribeActivityExecutionsResponse_Result_Outcome interface {
+	isBatchDescribeActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchDescribeActivityExecutionsResponse_Result_FrontendResponse struct {
+	FrontendResponse *v1.DescribeActivityExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3,oneof"`
+}
+
+type BatchDescribeActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchItemFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result_FrontendResponse) isBatchDescribeActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchDescribeActivityExecutionsResponse_Result_Failure) isBatchDescribeActivityExecutionsResponse_Result_Outcome() {
+}
+
+type BatchPollActivityExecutionsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*BatchPollActivityExecutionsResponse_Result_FrontendResponse
+	//	*BatchPollActivityExecutionsResponse_Result_Failure
+	Outcome       isBatchPollActivityExecutionsResponse_Result_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) Reset() {
+	*x = BatchPollActivityExecutionsResponse_Result{}
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPollActivityExecutionsResponse_Result) ProtoMessage() {}
+
+func (x *BatchPollActivityExecutionsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPollActivityExecutionsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchPollActivityExecutionsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetOutcome() isBatchPollActivityExecutionsResponse_Result_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetFrontendResponse() *v1.PollActivityExecutionResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchPollActivityExecutionsResponse_Result_FrontendResponse); ok {
+			return x.FrontendResponse
+		}
+	}
+	return nil
+}
+
+func (x *BatchPollActivityExecutionsResponse_Result) GetFailure() *BatchItemFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*BatchPollActivityExecutionsResponse_Result_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isBatchPollActivityExecutionsResponse_Result_Outcome interface {
+	isBatchPollActivityExecutionsResponse_Result_Outcome()
+}
+
+type BatchPollActivityExecutionsResponse_Result_FrontendResponse struct {
+	// Empty if the activity had not completed by the long-poll deadline.
+	FrontendResponse *v1.PollActivityExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3,oneof"`
+}
+
+type BatchPollActivityExecutionsResponse_Result_Failure struct {
+	Failure *BatchItemFailure `protobuf:"bytes,2,opt,name=failure,proto3,oneof"`
+}
+
+func (*BatchPollActivityExecutionsResponse_Result_FrontendResponse) isBatchPollActivityExecutionsResponse_Result_Outcome() {
+}
+
+func (*BatchPollActivityExecutionsResponse_Result_Failure) isBatchPollActivityExecutionsResponse_Result_Outcome() {
+}
+
 var File_temporal_server_chasm_lib_activity_proto_v1_request_response_proto protoreflect.FileDescriptor
 
 const file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	"Btemporal/server/chasm/lib/activity/proto/v1/request_response.proto\x12+temporal.server.chasm.lib.activity.proto.v1\x1a6temporal/api/workflowservice/v1/request_response.proto\"\xad\x01\n" +
+	"Btemporal/server/chasm/lib/activity/proto/v1/request_response.proto\x12+temporal.server.chasm.lib.activity.proto.v1\x1a\x19google/protobuf/any.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a-temporal/server/api/activity/v1/message.proto\"\x80\x02\n" +
 	"\x1dStartActivityExecutionRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12i\n" +
-	"\x10frontend_request\x18\x02 \x01(\v2>.temporal.api.workflowservice.v1.StartActivityExecutionRequestR\x0ffrontendRequest\"\x8e\x01\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2>.temporal.api.workflowservice.v1.StartActivityExecutionRequestR\x0ffrontendRequest\x12Q\n" +
+	"\n" +
+	"follow_ups\x18\x03 \x03(\v22.temporal.server.api.activity.v1.ActivityChainStepR\tfollowUps\"\x8e\x01\n" +
 	"\x1eStartActivityExecutionResponse\x12l\n" +
 	"\x11frontend_response\x18\x01 \x01(\v2?.temporal.api.workflowservice.v1.StartActivityExecutionResponseR\x10frontendResponse\"\xb3\x01\n" +
 	" DescribeActivityExecutionRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12l\n" +
-	"\x10frontend_request\x18\x02 \x01(\v2A.temporal.api.workflowservice.v1.DescribeActivityExecutionRequestR\x0ffrontendRequest\"\x94\x01\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2A.temporal.api.workflowservice.v1.DescribeActivityExecutionRequestR\x0ffrontendRequest\"\xdf\x01\n" +
 	"!DescribeActivityExecutionResponse\x12o\n" +
-	"\x11frontend_response\x18\x01 \x01(\v2B.temporal.api.workflowservice.v1.DescribeActivityExecutionResponseR\x10frontendResponse\"\xab\x01\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2B.temporal.api.workflowservice.v1.DescribeActivityExecutionResponseR\x10frontendResponse\x12I\n" +
+	"\x05chain\x18\x02 \x01(\v23.temporal.server.api.activity.v1.ActivityChainStateR\x05chain\"\xab\x01\n" +
 	"\x1cPollActivityExecutionRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12h\n" +
 	"\x10frontend_request\x18\x02 \x01(\v2=.temporal.api.workflowservice.v1.PollActivityExecutionRequestR\x0ffrontendRequest\"\x8c\x01\n" +
 	"\x1dPollActivityExecutionResponse\x12k\n" +
-	"\x11frontend_response\x18\x01 \x01(\v2>.temporal.api.workflowservice.v1.PollActivityExecutionResponseR\x10frontendResponse\"\xb5\x01\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2>.temporal.api.workflowservice.v1.PollActivityExecutionResponseR\x10frontendResponse\"p\n" +
+	"\x10BatchItemFailure\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\adetails\x18\x03 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\xd0\x01\n" +
+	"#BatchStartActivityExecutionsRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12k\n" +
+	"\x11frontend_requests\x18\x03 \x03(\v2>.temporal.api.workflowservice.v1.StartActivityExecutionRequestR\x10frontendRequests\"\xfb\x02\n" +
+	"$BatchStartActivityExecutionsResponse\x12r\n" +
+	"\aresults\x18\x01 \x03(\v2X.temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.ResultR\aresults\x1a\xde\x01\n" +
+	"\x06Result\x12n\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2?.temporal.api.workflowservice.v1.StartActivityExecutionResponseH\x00R\x10frontendResponse\x12Y\n" +
+	"\afailure\x18\x02 \x01(\v2=.temporal.server.chasm.lib.activity.proto.v1.BatchItemFailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"\xd6\x01\n" +
+	"&BatchDescribeActivityExecutionsRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12n\n" +
+	"\x11frontend_requests\x18\x03 \x03(\v2A.temporal.api.workflowservice.v1.DescribeActivityExecutionRequestR\x10frontendRequests\"\x84\x03\n" +
+	"'BatchDescribeActivityExecutionsResponse\x12u\n" +
+	"\aresults\x18\x01 \x03(\v2[.temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.ResultR\aresults\x1a\xe1\x01\n" +
+	"\x06Result\x12q\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2B.temporal.api.workflowservice.v1.DescribeActivityExecutionResponseH\x00R\x10frontendResponse\x12Y\n" +
+	"\afailure\x18\x02 \x01(\v2=.temporal.server.chasm.lib.activity.proto.v1.BatchItemFailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"\xce\x01\n" +
+	"\"BatchPollActivityExecutionsRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12j\n" +
+	"\x11frontend_requests\x18\x03 \x03(\v2=.temporal.api.workflowservice.v1.PollActivityExecutionRequestR\x10frontendRequests\"\xf8\x02\n" +
+	"#BatchPollActivityExecutionsResponse\x12q\n" +
+	"\aresults\x18\x01 \x03(\v2W.temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.ResultR\aresults\x1a\xdd\x01\n" +
+	"\x06Result\x12m\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2>.temporal.api.workflowservice.v1.PollActivityExecutionResponseH\x00R\x10frontendResponse\x12Y\n" +
+	"\afailure\x18\x02 \x01(\v2=.temporal.server.chasm.lib.activity.proto.v1.BatchItemFailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"\xb5\x01\n" +
 	"!TerminateActivityExecutionRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12m\n" +
 	"\x10frontend_request\x18\x02 \x01(\v2B.temporal.api.workflowservice.v1.TerminateActivityExecutionRequestR\x0ffrontendRequest\"$\n" +
@@ -997,63 +1687,91 @@ func file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_raw
 	return file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_goTypes = []any{
-	(*StartActivityExecutionRequest)(nil),             // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest
-	(*StartActivityExecutionResponse)(nil),            // 1: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
-	(*DescribeActivityExecutionRequest)(nil),          // 2: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest
-	(*DescribeActivityExecutionResponse)(nil),         // 3: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
-	(*PollActivityExecutionRequest)(nil),              // 4: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest
-	(*PollActivityExecutionResponse)(nil),             // 5: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
-	(*TerminateActivityExecutionRequest)(nil),         // 6: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
-	(*TerminateActivityExecutionResponse)(nil),        // 7: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
-	(*RequestCancelActivityExecutionRequest)(nil),     // 8: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
-	(*RequestCancelActivityExecutionResponse)(nil),    // 9: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
-	(*DeleteActivityExecutionRequest)(nil),            // 10: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
-	(*DeleteActivityExecutionResponse)(nil),           // 11: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
-	(*PauseActivityExecutionRequest)(nil),             // 12: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
-	(*PauseActivityExecutionResponse)(nil),            // 13: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
-	(*UnpauseActivityExecutionRequest)(nil),           // 14: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
-	(*UnpauseActivityExecutionResponse)(nil),          // 15: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
-	(*ResetActivityExecutionRequest)(nil),             // 16: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
-	(*ResetActivityExecutionResponse)(nil),            // 17: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
-	(*UpdateActivityExecutionOptionsRequest)(nil),     // 18: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
-	(*UpdateActivityExecutionOptionsResponse)(nil),    // 19: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
-	(*v1.StartActivityExecutionRequest)(nil),          // 20: temporal.api.workflowservice.v1.StartActivityExecutionRequest
-	(*v1.StartActivityExecutionResponse)(nil),         // 21: temporal.api.workflowservice.v1.StartActivityExecutionResponse
-	(*v1.DescribeActivityExecutionRequest)(nil),       // 22: temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
-	(*v1.DescribeActivityExecutionResponse)(nil),      // 23: temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
-	(*v1.PollActivityExecutionRequest)(nil),           // 24: temporal.api.workflowservice.v1.PollActivityExecutionRequest
-	(*v1.PollActivityExecutionResponse)(nil),          // 25: temporal.api.workflowservice.v1.PollActivityExecutionResponse
-	(*v1.TerminateActivityExecutionRequest)(nil),      // 26: temporal.api.workflowservice.v1.TerminateActivityExecutionRequest
-	(*v1.RequestCancelActivityExecutionRequest)(nil),  // 27: temporal.api.workflowservice.v1.RequestCancelActivityExecutionRequest
-	(*v1.DeleteActivityExecutionRequest)(nil),         // 28: temporal.api.workflowservice.v1.DeleteActivityExecutionRequest
-	(*v1.PauseActivityExecutionRequest)(nil),          // 29: temporal.api.workflowservice.v1.PauseActivityExecutionRequest
-	(*v1.UnpauseActivityExecutionRequest)(nil),        // 30: temporal.api.workflowservice.v1.UnpauseActivityExecutionRequest
-	(*v1.ResetActivityExecutionRequest)(nil),          // 31: temporal.api.workflowservice.v1.ResetActivityExecutionRequest
-	(*v1.UpdateActivityExecutionOptionsRequest)(nil),  // 32: temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsRequest
-	(*v1.UpdateActivityExecutionOptionsResponse)(nil), // 33: temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsResponse
+	(*StartActivityExecutionRequest)(nil),                  // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest
+	(*StartActivityExecutionResponse)(nil),                 // 1: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
+	(*DescribeActivityExecutionRequest)(nil),               // 2: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest
+	(*DescribeActivityExecutionResponse)(nil),              // 3: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
+	(*PollActivityExecutionRequest)(nil),                   // 4: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest
+	(*PollActivityExecutionResponse)(nil),                  // 5: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
+	(*BatchItemFailure)(nil),                               // 6: temporal.server.chasm.lib.activity.proto.v1.BatchItemFailure
+	(*BatchStartActivityExecutionsRequest)(nil),            // 7: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsRequest
+	(*BatchStartActivityExecutionsResponse)(nil),           // 8: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse
+	(*BatchDescribeActivityExecutionsRequest)(nil),         // 9: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsRequest
+	(*BatchDescribeActivityExecutionsResponse)(nil),        // 10: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse
+	(*BatchPollActivityExecutionsRequest)(nil),             // 11: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsRequest
+	(*BatchPollActivityExecutionsResponse)(nil),            // 12: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse
+	(*TerminateActivityExecutionRequest)(nil),              // 13: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
+	(*TerminateActivityExecutionResponse)(nil),             // 14: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
+	(*RequestCancelActivityExecutionRequest)(nil),          // 15: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
+	(*RequestCancelActivityExecutionResponse)(nil),         // 16: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
+	(*DeleteActivityExecutionRequest)(nil),                 // 17: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
+	(*DeleteActivityExecutionResponse)(nil),                // 18: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
+	(*PauseActivityExecutionRequest)(nil),                  // 19: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
+	(*PauseActivityExecutionResponse)(nil),                 // 20: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
+	(*UnpauseActivityExecutionRequest)(nil),                // 21: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
+	(*UnpauseActivityExecutionResponse)(nil),               // 22: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
+	(*ResetActivityExecutionRequest)(nil),                  // 23: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
+	(*ResetActivityExecutionResponse)(nil),                 // 24: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
+	(*UpdateActivityExecutionOptionsRequest)(nil),          // 25: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
+	(*UpdateActivityExecutionOptionsResponse)(nil),         // 26: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
+	(*BatchStartActivityExecutionsResponse_Result)(nil),    // 27: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.Result
+	(*BatchDescribeActivityExecutionsResponse_Result)(nil), // 28: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.Result
+	(*BatchPollActivityExecutionsResponse_Result)(nil),     // 29: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.Result
+	(*v1.StartActivityExecutionRequest)(nil),               // 30: temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	(*v11.ActivityChainStep)(nil),                          // 31: temporal.server.api.activity.v1.ActivityChainStep
+	(*v1.StartActivityExecutionResponse)(nil),              // 32: temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	(*v1.DescribeActivityExecutionRequest)(nil),            // 33: temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	(*v1.DescribeActivityExecutionResponse)(nil),           // 34: temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	(*v11.ActivityChainState)(nil),                         // 35: temporal.server.api.activity.v1.ActivityChainState
+	(*v1.PollActivityExecutionRequest)(nil),                // 36: temporal.api.workflowservice.v1.PollActivityExecutionRequest
+	(*v1.PollActivityExecutionResponse)(nil),               // 37: temporal.api.workflowservice.v1.PollActivityExecutionResponse
+	(*anypb.Any)(nil),                                      // 38: google.protobuf.Any
+	(*v1.TerminateActivityExecutionRequest)(nil),           // 39: temporal.api.workflowservice.v1.TerminateActivityExecutionRequest
+	(*v1.RequestCancelActivityExecutionRequest)(nil),       // 40: temporal.api.workflowservice.v1.RequestCancelActivityExecutionRequest
+	(*v1.DeleteActivityExecutionRequest)(nil),              // 41: temporal.api.workflowservice.v1.DeleteActivityExecutionRequest
+	(*v1.PauseActivityExecutionRequest)(nil),               // 42: temporal.api.workflowservice.v1.PauseActivityExecutionRequest
+	(*v1.UnpauseActivityExecutionRequest)(nil),             // 43: temporal.api.workflowservice.v1.UnpauseActivityExecutionRequest
+	(*v1.ResetActivityExecutionRequest)(nil),               // 44: temporal.api.workflowservice.v1.ResetActivityExecutionRequest
+	(*v1.UpdateActivityExecutionOptionsRequest)(nil),       // 45: temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsRequest
+	(*v1.UpdateActivityExecutionOptionsResponse)(nil),      // 46: temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsResponse
 }
 var file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_depIdxs = []int32{
-	20, // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionRequest
-	21, // 1: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionResponse
-	22, // 2: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
-	23, // 3: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
-	24, // 4: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionRequest
-	25, // 5: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionResponse
-	26, // 6: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.TerminateActivityExecutionRequest
-	27, // 7: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.RequestCancelActivityExecutionRequest
-	28, // 8: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DeleteActivityExecutionRequest
-	29, // 9: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PauseActivityExecutionRequest
-	30, // 10: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.UnpauseActivityExecutionRequest
-	31, // 11: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.ResetActivityExecutionRequest
-	32, // 12: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsRequest
-	33, // 13: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsResponse
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	30, // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	31, // 1: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest.follow_ups:type_name -> temporal.server.api.activity.v1.ActivityChainStep
+	32, // 2: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	33, // 3: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	34, // 4: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	35, // 5: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse.chain:type_name -> temporal.server.api.activity.v1.ActivityChainState
+	36, // 6: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionRequest
+	37, // 7: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionResponse
+	38, // 8: temporal.server.chasm.lib.activity.proto.v1.BatchItemFailure.details:type_name -> google.protobuf.Any
+	30, // 9: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsRequest.frontend_requests:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionRequest
+	27, // 10: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.results:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.Result
+	33, // 11: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsRequest.frontend_requests:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionRequest
+	28, // 12: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.results:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.Result
+	36, // 13: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsRequest.frontend_requests:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionRequest
+	29, // 14: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.results:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.Result
+	39, // 15: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.TerminateActivityExecutionRequest
+	40, // 16: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.RequestCancelActivityExecutionRequest
+	41, // 17: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DeleteActivityExecutionRequest
+	42, // 18: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PauseActivityExecutionRequest
+	43, // 19: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.UnpauseActivityExecutionRequest
+	44, // 20: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.ResetActivityExecutionRequest
+	45, // 21: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsRequest
+	46, // 22: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.UpdateActivityExecutionOptionsResponse
+	32, // 23: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.Result.frontend_response:type_name -> temporal.api.workflowservice.v1.StartActivityExecutionResponse
+	6,  // 24: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse.Result.failure:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchItemFailure
+	34, // 25: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.Result.frontend_response:type_name -> temporal.api.workflowservice.v1.DescribeActivityExecutionResponse
+	6,  // 26: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse.Result.failure:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchItemFailure
+	37, // 27: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.Result.frontend_response:type_name -> temporal.api.workflowservice.v1.PollActivityExecutionResponse
+	6,  // 28: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse.Result.failure:type_name -> temporal.server.chasm.lib.activity.proto.v1.BatchItemFailure
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_init() }
@@ -1061,13 +1779,25 @@ func file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_ini
 	if File_temporal_server_chasm_lib_activity_proto_v1_request_response_proto != nil {
 		return
 	}
+	file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[27].OneofWrappers = []any{
+		(*BatchStartActivityExecutionsResponse_Result_FrontendResponse)(nil),
+		(*BatchStartActivityExecutionsResponse_Result_Failure)(nil),
+	}
+	file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[28].OneofWrappers = []any{
+		(*BatchDescribeActivityExecutionsResponse_Result_FrontendResponse)(nil),
+		(*BatchDescribeActivityExecutionsResponse_Result_Failure)(nil),
+	}
+	file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_msgTypes[29].OneofWrappers = []any{
+		(*BatchPollActivityExecutionsResponse_Result_FrontendResponse)(nil),
+		(*BatchPollActivityExecutionsResponse_Result_Failure)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDesc), len(file_temporal_server_chasm_lib_activity_proto_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
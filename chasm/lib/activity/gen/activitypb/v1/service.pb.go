@@ -27,11 +27,17 @@ var File_temporal_server_chasm_lib_activity_proto_v1_service_proto protoreflect.
 
 const file_temporal_server_chasm_lib_activity_proto_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"9temporal/server/chasm/lib/activity/proto/v1/service.proto\x12+temporal.server.chasm.lib.activity.proto.v1\x1aBtemporal/server/chasm/lib/activity/proto/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto\x1a.temporal/server/api/routing/v1/extension.proto2\x80\x13\n" +
+	"9temporal/server/chasm/lib/activity/proto/v1/service.proto\x12+temporal.server.chasm.lib.activity.proto.v1\x1aBtemporal/server/chasm/lib/activity/proto/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto\x1a.temporal/server/api/routing/v1/extension.proto2\x9a\x18\n" +
 	"\x0fActivityService\x12\xdb\x01\n" +
 	"\x16StartActivityExecution\x12J.temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest\x1aK.temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xe4\x01\n" +
 	"\x19DescribeActivityExecution\x12M.temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest\x1aN.temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xd8\x01\n" +
-	"\x15PollActivityExecution\x12I.temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest\x1aJ.temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xe7\x01\n" +
+	"\x15PollActivityExecution\x12I.temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest\x1aJ.temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xd9\x01\n" +
+	"\x1cBatchStartActivityExecutions\x12P.temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsRequest\x1aQ.temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse\"\x14\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\n" +
+	"\"\bshard_id\x12\xe2\x01\n" +
+	"\x1fBatchDescribeActivityExecutions\x12S.temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsRequest\x1aT.temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse\"\x14\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\n" +
+	"\"\bshard_id\x12\xd6\x01\n" +
+	"\x1bBatchPollActivityExecutions\x12O.temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsRequest\x1aP.temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse\"\x14\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\n" +
+	"\"\bshard_id\x12\xe7\x01\n" +
 	"\x1aTerminateActivityExecution\x12N.temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest\x1aO.temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xf3\x01\n" +
 	"\x1eRequestCancelActivityExecution\x12R.temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest\x1aS.temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xde\x01\n" +
 	"\x17DeleteActivityExecution\x12K.temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest\x1aL.temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse\"(\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1e\x1a\x1cfrontend_request.activity_id\x12\xf9\x01\n" +
@@ -41,50 +47,62 @@ const file_temporal_server_chasm_lib_activity_proto_v1_service_proto_rawDesc = "
 	"\x1eUpdateActivityExecutionOptions\x12R.temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest\x1aS.temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse\"F\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18<\x1a\x1cfrontend_request.workflow_id\x1a\x1cfrontend_request.activity_idBDZBgo.temporal.io/server/chasm/lib/activity/gen/activitypb;activitypbb\x06proto3"
 
 var file_temporal_server_chasm_lib_activity_proto_v1_service_proto_goTypes = []any{
-	(*StartActivityExecutionRequest)(nil),          // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest
-	(*DescribeActivityExecutionRequest)(nil),       // 1: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest
-	(*PollActivityExecutionRequest)(nil),           // 2: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest
-	(*TerminateActivityExecutionRequest)(nil),      // 3: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
-	(*RequestCancelActivityExecutionRequest)(nil),  // 4: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
-	(*DeleteActivityExecutionRequest)(nil),         // 5: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
-	(*PauseActivityExecutionRequest)(nil),          // 6: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
-	(*UnpauseActivityExecutionRequest)(nil),        // 7: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
-	(*ResetActivityExecutionRequest)(nil),          // 8: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
-	(*UpdateActivityExecutionOptionsRequest)(nil),  // 9: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
-	(*StartActivityExecutionResponse)(nil),         // 10: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
-	(*DescribeActivityExecutionResponse)(nil),      // 11: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
-	(*PollActivityExecutionResponse)(nil),          // 12: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
-	(*TerminateActivityExecutionResponse)(nil),     // 13: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
-	(*RequestCancelActivityExecutionResponse)(nil), // 14: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
-	(*DeleteActivityExecutionResponse)(nil),        // 15: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
-	(*PauseActivityExecutionResponse)(nil),         // 16: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
-	(*UnpauseActivityExecutionResponse)(nil),       // 17: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
-	(*ResetActivityExecutionResponse)(nil),         // 18: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
-	(*UpdateActivityExecutionOptionsResponse)(nil), // 19: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
+	(*StartActivityExecutionRequest)(nil),           // 0: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest
+	(*DescribeActivityExecutionRequest)(nil),        // 1: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest
+	(*PollActivityExecutionRequest)(nil),            // 2: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest
+	(*BatchStartActivityExecutionsRequest)(nil),     // 3: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsRequest
+	(*BatchDescribeActivityExecutionsRequest)(nil),  // 4: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsRequest
+	(*BatchPollActivityExecutionsRequest)(nil),      // 5: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsRequest
+	(*TerminateActivityExecutionRequest)(nil),       // 6: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
+	(*RequestCancelActivityExecutionRequest)(nil),   // 7: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
+	(*DeleteActivityExecutionRequest)(nil),          // 8: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
+	(*PauseActivityExecutionRequest)(nil),           // 9: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
+	(*UnpauseActivityExecutionRequest)(nil),         // 10: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
+	(*ResetActivityExecutionRequest)(nil),           // 11: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
+	(*UpdateActivityExecutionOptionsRequest)(nil),   // 12: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
+	(*StartActivityExecutionResponse)(nil),          // 13: temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
+	(*DescribeActivityExecutionResponse)(nil),       // 14: temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
+	(*PollActivityExecutionResponse)(nil),           // 15: temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
+	(*BatchStartActivityExecutionsResponse)(nil),    // 16: temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse
+	(*BatchDescribeActivityExecutionsResponse)(nil), // 17: temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse
+	(*BatchPollActivityExecutionsResponse)(nil),     // 18: temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse
+	(*TerminateActivityExecutionResponse)(nil),      // 19: temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
+	(*RequestCancelActivityExecutionResponse)(nil),  // 20: temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
+	(*DeleteActivityExecutionResponse)(nil),         // 21: temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
+	(*PauseActivityExecutionResponse)(nil),          // 22: temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
+	(*UnpauseActivityExecutionResponse)(nil),        // 23: temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
+	(*ResetActivityExecutionResponse)(nil),          // 24: temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
+	(*UpdateActivityExecutionOptionsResponse)(nil),  // 25: temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
 }
 var file_temporal_server_chasm_lib_activity_proto_v1_service_proto_depIdxs = []int32{
 	0,  // 0: temporal.server.chasm.lib.activity.proto.v1.ActivityService.StartActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionRequest
 	1,  // 1: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DescribeActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionRequest
 	2,  // 2: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PollActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionRequest
-	3,  // 3: temporal.server.chasm.lib.activity.proto.v1.ActivityService.TerminateActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
-	4,  // 4: temporal.server.chasm.lib.activity.proto.v1.ActivityService.RequestCancelActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
-	5,  // 5: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DeleteActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
-	6,  // 6: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PauseActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
-	7,  // 7: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UnpauseActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
-	8,  // 8: temporal.server.chasm.lib.activity.proto.v1.ActivityService.ResetActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
-	9,  // 9: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UpdateActivityExecutionOptions:input_type -> temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
-	10, // 10: temporal.server.chasm.lib.activity.proto.v1.ActivityService.StartActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
-	11, // 11: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DescribeActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
-	12, // 12: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PollActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
-	13, // 13: temporal.server.chasm.lib.activity.proto.v1.ActivityService.TerminateActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
-	14, // 14: temporal.server.chasm.lib.activity.proto.v1.ActivityService.RequestCancelActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
-	15, // 15: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DeleteActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
-	16, // 16: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PauseActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
-	17, // 17: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UnpauseActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
-	18, // 18: temporal.server.chasm.lib.activity.proto.v1.ActivityService.ResetActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
-	19, // 19: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UpdateActivityExecutionOptions:output_type -> temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	3,  // 3: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchStartActivityExecutions:input_type -> temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsRequest
+	4,  // 4: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchDescribeActivityExecutions:input_type -> temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsRequest
+	5,  // 5: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchPollActivityExecutions:input_type -> temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsRequest
+	6,  // 6: temporal.server.chasm.lib.activity.proto.v1.ActivityService.TerminateActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionRequest
+	7,  // 7: temporal.server.chasm.lib.activity.proto.v1.ActivityService.RequestCancelActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionRequest
+	8,  // 8: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DeleteActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionRequest
+	9,  // 9: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PauseActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionRequest
+	10, // 10: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UnpauseActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionRequest
+	11, // 11: temporal.server.chasm.lib.activity.proto.v1.ActivityService.ResetActivityExecution:input_type -> temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionRequest
+	12, // 12: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UpdateActivityExecutionOptions:input_type -> temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsRequest
+	13, // 13: temporal.server.chasm.lib.activity.proto.v1.ActivityService.StartActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.StartActivityExecutionResponse
+	14, // 14: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DescribeActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.DescribeActivityExecutionResponse
+	15, // 15: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PollActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.PollActivityExecutionResponse
+	16, // 16: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchStartActivityExecutions:output_type -> temporal.server.chasm.lib.activity.proto.v1.BatchStartActivityExecutionsResponse
+	17, // 17: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchDescribeActivityExecutions:output_type -> temporal.server.chasm.lib.activity.proto.v1.BatchDescribeActivityExecutionsResponse
+	18, // 18: temporal.server.chasm.lib.activity.proto.v1.ActivityService.BatchPollActivityExecutions:output_type -> temporal.server.chasm.lib.activity.proto.v1.BatchPollActivityExecutionsResponse
+	19, // 19: temporal.server.chasm.lib.activity.proto.v1.ActivityService.TerminateActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.TerminateActivityExecutionResponse
+	20, // 20: temporal.server.chasm.lib.activity.proto.v1.ActivityService.RequestCancelActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.RequestCancelActivityExecutionResponse
+	21, // 21: temporal.server.chasm.lib.activity.proto.v1.ActivityService.DeleteActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.DeleteActivityExecutionResponse
+	22, // 22: temporal.server.chasm.lib.activity.proto.v1.ActivityService.PauseActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.PauseActivityExecutionResponse
+	23, // 23: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UnpauseActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.UnpauseActivityExecutionResponse
+	24, // 24: temporal.server.chasm.lib.activity.proto.v1.ActivityService.ResetActivityExecution:output_type -> temporal.server.chasm.lib.activity.proto.v1.ResetActivityExecutionResponse
+	25, // 25: temporal.server.chasm.lib.activity.proto.v1.ActivityService.UpdateActivityExecutionOptions:output_type -> temporal.server.chasm.lib.activity.proto.v1.UpdateActivityExecutionOptionsResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardpool"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 )
@@ -25,6 +26,7 @@ type ActivityServiceLayeredClient struct {
 	numShards      int32
 	redirector     history.Redirector[ActivityServiceClient]
 	retryPolicy    backoff.RetryPolicy
+	shardPools     *shardpool.Resolver
 }
 
 // NewActivityServiceLayeredClient initializes a new ActivityServiceLayeredClient.
@@ -36,6 +38,7 @@ func NewActivityServiceLayeredClient(
 	config *config.Persistence,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	shardPools *shardpool.Resolver,
 ) (ActivityServiceClient, error) {
 	resolver, err := monitor.GetResolver(primitives.HistoryService)
 	if err != nil {
@@ -58,6 +61,7 @@ func NewActivityServiceLayeredClient(
 		redirector:     redirector,
 		numShards:      config.NumHistoryShards,
 		retryPolicy:    common.CreateHistoryClientRetryPolicy(dynamicconfig.RetryUnboundedOnSystemResourceExhausted.Get(dc)),
+		shardPools:     shardPools,
 	}
 	lc.Append(fx.StopHook(client.Stop))
 	return client, nil
@@ -87,7 +91,10 @@ func (c *ActivityServiceLayeredClient) callStartActivityExecutionNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -130,7 +137,10 @@ func (c *ActivityServiceLayeredClient) callDescribeActivityExecutionNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -173,7 +183,10 @@ func (c *ActivityServiceLayeredClient) callPollActivityExecutionNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -194,6 +207,135 @@ func (c *ActivityServiceLayeredClient) PollActivityExecution(
 	}
 	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
 }
+func (c *ActivityServiceLayeredClient) callBatchStartActivityExecutionsNoRetry(
+	ctx context.Context,
+	request *BatchStartActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchStartActivityExecutionsResponse, error) {
+	var response *BatchStartActivityExecutionsResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("ActivityService.BatchStartActivityExecutions"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID := request.GetShardId()
+	op := func(ctx context.Context, client ActivityServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.BatchStartActivityExecutions(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *ActivityServiceLayeredClient) BatchStartActivityExecutions(
+	ctx context.Context,
+	request *BatchStartActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchStartActivityExecutionsResponse, error) {
+	call := func(ctx context.Context) (*BatchStartActivityExecutionsResponse, error) {
+		return c.callBatchStartActivityExecutionsNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *ActivityServiceLayeredClient) callBatchDescribeActivityExecutionsNoRetry(
+	ctx context.Context,
+	request *BatchDescribeActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchDescribeActivityExecutionsResponse, error) {
+	var response *BatchDescribeActivityExecutionsResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("ActivityService.BatchDescribeActivityExecutions"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID := request.GetShardId()
+	op := func(ctx context.Context, client ActivityServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.BatchDescribeActivityExecutions(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *ActivityServiceLayeredClient) BatchDescribeActivityExecutions(
+	ctx context.Context,
+	request *BatchDescribeActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchDescribeActivityExecutionsResponse, error) {
+	call := func(ctx context.Context) (*BatchDescribeActivityExecutionsResponse, error) {
+		return c.callBatchDescribeActivityExecutionsNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *ActivityServiceLayeredClient) callBatchPollActivityExecutionsNoRetry(
+	ctx context.Context,
+	request *BatchPollActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchPollActivityExecutionsResponse, error) {
+	var response *BatchPollActivityExecutionsResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("ActivityService.BatchPollActivityExecutions"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID := request.GetShardId()
+	op := func(ctx context.Context, client ActivityServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.BatchPollActivityExecutions(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *ActivityServiceLayeredClient) BatchPollActivityExecutions(
+	ctx context.Context,
+	request *BatchPollActivityExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*BatchPollActivityExecutionsResponse, error) {
+	call := func(ctx context.Context) (*BatchPollActivityExecutionsResponse, error) {
+		return c.callBatchPollActivityExecutionsNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
 func (c *ActivityServiceLayeredClient) callTerminateActivityExecutionNoRetry(
 	ctx context.Context,
 	request *TerminateActivityExecutionRequest,
@@ -216,7 +358,10 @@ func (c *ActivityServiceLayeredClient) callTerminateActivityExecutionNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -259,7 +404,10 @@ func (c *ActivityServiceLayeredClient) callRequestCancelActivityExecutionNoRetry
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -302,7 +450,10 @@ func (c *ActivityServiceLayeredClient) callDeleteActivityExecutionNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -349,7 +500,10 @@ func (c *ActivityServiceLayeredClient) callPauseActivityExecutionNoRetry(
 	if businessID == "" {
 		businessID = request.GetFrontendRequest().GetActivityId()
 	}
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), businessID, c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), businessID)
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -396,7 +550,10 @@ func (c *ActivityServiceLayeredClient) callUnpauseActivityExecutionNoRetry(
 	if businessID == "" {
 		businessID = request.GetFrontendRequest().GetActivityId()
 	}
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), businessID, c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), businessID)
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -443,7 +600,10 @@ func (c *ActivityServiceLayeredClient) callResetActivityExecutionNoRetry(
 	if businessID == "" {
 		businessID = request.GetFrontendRequest().GetActivityId()
 	}
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), businessID, c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), businessID)
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -490,7 +650,10 @@ func (c *ActivityServiceLayeredClient) callUpdateActivityExecutionOptionsNoRetry
 	if businessID == "" {
 		businessID = request.GetFrontendRequest().GetActivityId()
 	}
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), businessID, c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), businessID)
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client ActivityServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -20,16 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ActivityService_StartActivityExecution_FullMethodName         = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/StartActivityExecution"
-	ActivityService_DescribeActivityExecution_FullMethodName      = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/DescribeActivityExecution"
-	ActivityService_PollActivityExecution_FullMethodName          = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/PollActivityExecution"
-	ActivityService_TerminateActivityExecution_FullMethodName     = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/TerminateActivityExecution"
-	ActivityService_RequestCancelActivityExecution_FullMethodName = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/RequestCancelActivityExecution"
-	ActivityService_DeleteActivityExecution_FullMethodName        = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/DeleteActivityExecution"
-	ActivityService_PauseActivityExecution_FullMethodName         = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/PauseActivityExecution"
-	ActivityService_UnpauseActivityExecution_FullMethodName       = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/UnpauseActivityExecution"
-	ActivityService_ResetActivityExecution_FullMethodName         = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/ResetActivityExecution"
-	ActivityService_UpdateActivityExecutionOptions_FullMethodName = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/UpdateActivityExecutionOptions"
+	ActivityService_StartActivityExecution_FullMethodName          = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/StartActivityExecution"
+	ActivityService_DescribeActivityExecution_FullMethodName       = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/DescribeActivityExecution"
+	ActivityService_PollActivityExecution_FullMethodName           = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/PollActivityExecution"
+	ActivityService_BatchStartActivityExecutions_FullMethodName    = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/BatchStartActivityExecutions"
+	ActivityService_BatchDescribeActivityExecutions_FullMethodName = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/BatchDescribeActivityExecutions"
+	ActivityService_BatchPollActivityExecutions_FullMethodName     = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/BatchPollActivityExecutions"
+	ActivityService_TerminateActivityExecution_FullMethodName      = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/TerminateActivityExecution"
+	ActivityService_RequestCancelActivityExecution_FullMethodName  = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/RequestCancelActivityExecution"
+	ActivityService_DeleteActivityExecution_FullMethodName         = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/DeleteActivityExecution"
+	ActivityService_PauseActivityExecution_FullMethodName          = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/PauseActivityExecution"
+	ActivityService_UnpauseActivityExecution_FullMethodName        = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/UnpauseActivityExecution"
+	ActivityService_ResetActivityExecution_FullMethodName          = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/ResetActivityExecution"
+	ActivityService_UpdateActivityExecutionOptions_FullMethodName  = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/UpdateActivityExecutionOptions"
 )
 
 // ActivityServiceClient is the client API for ActivityService service.
@@ -39,6 +42,13 @@ type ActivityServiceClient interface {
 	StartActivityExecution(ctx context.Context, in *StartActivityExecutionRequest, opts ...grpc.CallOption) (*StartActivityExecutionResponse, error)
 	DescribeActivityExecution(ctx context.Context, in *DescribeActivityExecutionRequest, opts ...grpc.CallOption) (*DescribeActivityExecutionResponse, error)
 	PollActivityExecution(ctx context.Context, in *PollActivityExecutionRequest, opts ...grpc.CallOption) (*PollActivityExecutionResponse, error)
+	// Starts a batch of activity executions that all belong to the given shard. Each item is subject to its
+	// own ID reuse and conflict policies; failures are reported per item.
+	BatchStartActivityExecutions(ctx context.Context, in *BatchStartActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchStartActivityExecutionsResponse, error)
+	// Describes a batch of activity executions that all belong to the given shard.
+	BatchDescribeActivityExecutions(ctx context.Context, in *BatchDescribeActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchDescribeActivityExecutionsResponse, error)
+	// Long-polls for the outcomes of a batch of activity executions that all belong to the given shard.
+	BatchPollActivityExecutions(ctx context.Context, in *BatchPollActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchPollActivityExecutionsResponse, error)
 	TerminateActivityExecution(ctx context.Context, in *TerminateActivityExecutionRequest, opts ...grpc.CallOption) (*TerminateActivityExecutionResponse, error)
 	RequestCancelActivityExecution(ctx context.Context, in *RequestCancelActivityExecutionRequest, opts ...grpc.CallOption) (*RequestCancelActivityExecutionResponse, error)
 	DeleteActivityExecution(ctx context.Context, in *DeleteActivityExecutionRequest, opts ...grpc.CallOption) (*DeleteActivityExecutionResponse, error)
@@ -83,6 +93,33 @@ func (c *activityServiceClient) PollActivityExecution(ctx context.Context, in *P
 	return out, nil
 }
 
+func (c *activityServiceClient) BatchStartActivityExecutions(ctx context.Context, in *BatchStartActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchStartActivityExecutionsResponse, error) {
+	out := new(BatchStartActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_BatchStartActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) BatchDescribeActivityExecutions(ctx context.Context, in *BatchDescribeActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchDescribeActivityExecutionsResponse, error) {
+	out := new(BatchDescribeActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_BatchDescribeActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) BatchPollActivityExecutions(ctx context.Context, in *BatchPollActivityExecutionsRequest, opts ...grpc.CallOption) (*BatchPollActivityExecutionsResponse, error) {
+	out := new(BatchPollActivityExecutionsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_BatchPollActivityExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *activityServiceClient) TerminateActivityExecution(ctx context.Context, in *TerminateActivityExecutionRequest, opts ...grpc.CallOption) (*TerminateActivityExecutionResponse, error) {
 	out := new(TerminateActivityExecutionResponse)
 	err := c.cc.Invoke(ctx, ActivityService_TerminateActivityExecution_FullMethodName, in, out, opts...)
@@ -153,6 +190,13 @@ type ActivityServiceServer interface {
 	StartActivityExecution(context.Context, *StartActivityExecutionRequest) (*StartActivityExecutionResponse, error)
 	DescribeActivityExecution(context.Context, *DescribeActivityExecutionRequest) (*DescribeActivityExecutionResponse, error)
 	PollActivityExecution(context.Context, *PollActivityExecutionRequest) (*PollActivityExecutionResponse, error)
+	// Starts a batch of activity executions that all belong to the given shard. Each item is subject to its
+	// own ID reuse and conflict policies; failures are reported per item.
+	BatchStartActivityExecutions(context.Context, *BatchStartActivityExecutionsRequest) (*BatchStartActivityExecutionsResponse, error)
+	// Describes a batch of activity executions that all belong to the given shard.
+	BatchDescribeActivityExecutions(context.Context, *BatchDescribeActivityExecutionsRequest) (*BatchDescribeActivityExecutionsResponse, error)
+	// Long-polls for the outcomes of a batch of activity executions that all belong to the given shard.
+	BatchPollActivityExecutions(context.Context, *BatchPollActivityExecutionsRequest) (*BatchPollActivityExecutionsResponse, error)
 	TerminateActivityExecution(context.Context, *TerminateActivityExecutionRequest) (*TerminateActivityExecutionResponse, error)
 	RequestCancelActivityExecution(context.Context, *RequestCancelActivityExecutionRequest) (*RequestCancelActivityExecutionResponse, error)
 	DeleteActivityExecution(context.Context, *DeleteActivityExecutionRequest) (*DeleteActivityExecutionResponse, error)
@@ -176,6 +220,15 @@ func (UnimplementedActivityServiceServer) DescribeActivityExecution(context.Cont
 func (UnimplementedActivityServiceServer) PollActivityExecution(context.Context, *PollActivityExecutionRequest) (*PollActivityExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PollActivityExecution not implemented")
 }
+func (UnimplementedActivityServiceServer) BatchStartActivityExecutions(context.Context, *BatchStartActivityExecutionsRequest) (*BatchStartActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStartActivityExecutions not implemented")
+}
+func (UnimplementedActivityServiceServer) BatchDescribeActivityExecutions(context.Context, *BatchDescribeActivityExecutionsRequest) (*BatchDescribeActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDescribeActivityExecutions not implemented")
+}
+func (UnimplementedActivityServiceServer) BatchPollActivityExecutions(context.Context, *BatchPollActivityExecutionsRequest) (*BatchPollActivityExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPollActivityExecutions not implemented")
+}
 func (UnimplementedActivityServiceServer) TerminateActivityExecution(context.Context, *TerminateActivityExecutionRequest) (*TerminateActivityExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateActivityExecution not implemented")
 }
@@ -264,6 +317,60 @@ func _ActivityService_PollActivityExecution_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_BatchStartActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStartActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).BatchStartActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_BatchStartActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).BatchStartActivityExecutions(ctx, req.(*BatchStartActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_BatchDescribeActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDescribeActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).BatchDescribeActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_BatchDescribeActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).BatchDescribeActivityExecutions(ctx, req.(*BatchDescribeActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_BatchPollActivityExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPollActivityExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).BatchPollActivityExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_BatchPollActivityExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).BatchPollActivityExecutions(ctx, req.(*BatchPollActivityExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ActivityService_TerminateActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateActivityExecutionRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +516,18 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PollActivityExecution",
 			Handler:    _ActivityService_PollActivityExecution_Handler,
 		},
+		{
+			MethodName: "BatchStartActivityExecutions",
+			Handler:    _ActivityService_BatchStartActivityExecutions_Handler,
+		},
+		{
+			MethodName: "BatchDescribeActivityExecutions",
+			Handler:    _ActivityService_BatchDescribeActivityExecutions_Handler,
+		},
+		{
+			MethodName: "BatchPollActivityExecutions",
+			Handler:    _ActivityService_BatchPollActivityExecutions_Handler,
+		},
 		{
 			MethodName: "TerminateActivityExecution",
 			Handler:    _ActivityService_TerminateActivityExecution_Handler,
@@ -8,6 +8,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	activityspb "go.temporal.io/server/api/activity/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
@@ -15,6 +16,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardpool"
 )
 
 var (
@@ -38,6 +40,7 @@ type handler struct {
 	logger            log.Logger
 	metricsHandler    metrics.Handler
 	namespaceRegistry namespace.Registry
+	shardPools        *shardpool.Resolver
 }
 
 func newHandler(
@@ -47,6 +50,7 @@ func newHandler(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	namespaceRegistry namespace.Registry,
+	shardPools *shardpool.Resolver,
 ) *handler {
 	return &handler{
 		config:            config,
@@ -55,6 +59,7 @@ func newHandler(
 		logger:            logger,
 		metricsHandler:    metricsHandler,
 		namespaceRegistry: namespaceRegistry,
+		shardPools:        shardPools,
 	}
 }
 
@@ -88,6 +93,10 @@ func (h *handler) StartActivityExecution(ctx context.Context, req *activitypb.St
 				return nil, err
 			}
 
+			if steps := req.GetFollowUps(); len(steps) > 0 {
+				newActivity.Chain = chasm.NewDataField(mutableContext, &activityspb.ActivityChainState{Steps: steps})
+			}
+
 			if cbs := request.GetCompletionCallbacks(); len(cbs) > 0 {
 				if err := newActivity.addCompletionCallbacks(mutableContext, request.GetRequestId(), cbs, maxCallbacks); err != nil {
 					return nil, err
@@ -294,6 +303,148 @@ func (h *handler) PollActivityExecution(
 	return response, err
 }
 
+// BatchStartActivityExecutions schedules a batch of activity executions owned by a single shard.
+// Items are processed concurrently, up to chasm.activity.batchMaxConcurrency at a time, except for
+// items with the same activity ID which are processed in request order, so an item's ID reuse and
+// conflict policies are applied against any earlier item in the batch with the same activity ID.
+// An item's failure is reported in its result and does not affect the other items.
+func (h *handler) BatchStartActivityExecutions(
+	ctx context.Context,
+	req *activitypb.BatchStartActivityExecutionsRequest,
+) (*activitypb.BatchStartActivityExecutionsResponse, error) {
+	frontendReqs := req.GetFrontendRequests()
+	responses := make([]*activitypb.StartActivityExecutionResponse, len(frontendReqs))
+	errs := make([]error, len(frontendReqs))
+
+	runBatchItems(len(frontendReqs), h.config.BatchMaxConcurrency(batchNamespace(frontendReqs)), func(i int) string {
+		return frontendReqs[i].GetActivityId()
+	}, func(i int) {
+		if errs[i] = h.validateBatchItemShard(req.GetNamespaceId(), req.GetShardId(), frontendReqs[i].GetActivityId()); errs[i] != nil {
+			return
+		}
+		responses[i], errs[i] = h.StartActivityExecution(ctx, &activitypb.StartActivityExecutionRequest{
+			NamespaceId:     req.GetNamespaceId(),
+			FrontendRequest: frontendReqs[i],
+		})
+	})
+
+	results := make([]*activitypb.BatchStartActivityExecutionsResponse_Result, len(frontendReqs))
+	for i, err := range errs {
+		if isShardOwnershipLost(err) {
+			// Items already started are deduplicated by request ID when the client retries.
+			return nil, err
+		}
+		if err != nil {
+			results[i] = &activitypb.BatchStartActivityExecutionsResponse_Result{
+				Outcome: &activitypb.BatchStartActivityExecutionsResponse_Result_Failure{Failure: newBatchItemFailure(err)},
+			}
+			continue
+		}
+		results[i] = &activitypb.BatchStartActivityExecutionsResponse_Result{
+			Outcome: &activitypb.BatchStartActivityExecutionsResponse_Result_FrontendResponse{FrontendResponse: responses[i].GetFrontendResponse()},
+		}
+	}
+	return &activitypb.BatchStartActivityExecutionsResponse{Results: results}, nil
+}
+
+// BatchDescribeActivityExecutions queries the current state of a batch of activity executions owned
+// by a single shard. Items are processed concurrently, up to chasm.activity.batchMaxConcurrency at a
+// time.
+func (h *handler) BatchDescribeActivityExecutions(
+	ctx context.Context,
+	req *activitypb.BatchDescribeActivityExecutionsRequest,
+) (*activitypb.BatchDescribeActivityExecutionsResponse, error) {
+	frontendReqs := req.GetFrontendRequests()
+	responses := make([]*activitypb.DescribeActivityExecutionResponse, len(frontendReqs))
+	errs := make([]error, len(frontendReqs))
+
+	runBatchItems(len(frontendReqs), h.config.BatchMaxConcurrency(batchNamespace(frontendReqs)), nil, func(i int) {
+		frontendReq := frontendReqs[i]
+		if errs[i] = h.validateBatchItemShard(req.GetNamespaceId(), req.GetShardId(), frontendReq.GetActivityId()); errs[i] != nil {
+			return
+		}
+		if len(frontendReq.GetLongPollToken()) > 0 {
+			errs[i] = serviceerror.NewInvalidArgument("long_poll_token is not supported in batch describe requests")
+			return
+		}
+		responses[i], errs[i] = h.DescribeActivityExecution(ctx, &activitypb.DescribeActivityExecutionRequest{
+			NamespaceId:     req.GetNamespaceId(),
+			FrontendRequest: frontendReq,
+		})
+	})
+
+	results := make([]*activitypb.BatchDescribeActivityExecutionsResponse_Result, len(frontendReqs))
+	for i, err := range errs {
+		if isShardOwnershipLost(err) {
+			return nil, err
+		}
+		if err != nil {
+			results[i] = &activitypb.BatchDescribeActivityExecutionsResponse_Result{
+				Outcome: &activitypb.BatchDescribeActivityExecutionsResponse_Result_Failure{Failure: newBatchItemFailure(err)},
+			}
+			continue
+		}
+		results[i] = &activitypb.BatchDescribeActivityExecutionsResponse_Result{
+			Outcome: &activitypb.BatchDescribeActivityExecutionsResponse_Result_FrontendResponse{FrontendResponse: responses[i].GetFrontendResponse()},
+		}
+	}
+	return &activitypb.BatchDescribeActivityExecutionsResponse{Results: results}, nil
+}
+
+// BatchPollActivityExecutions long-polls for the outcomes of a batch of activity executions owned by
+// a single shard. Items are polled concurrently, up to chasm.activity.batchMaxConcurrency at a time,
+// and the response is sent once every item has an outcome or the long-poll deadline is reached.
+// Items that have not completed by then, including items that were not polled before the deadline,
+// have an empty response, as for PollActivityExecution.
+func (h *handler) BatchPollActivityExecutions(
+	ctx context.Context,
+	req *activitypb.BatchPollActivityExecutionsRequest,
+) (*activitypb.BatchPollActivityExecutionsResponse, error) {
+	frontendReqs := req.GetFrontendRequests()
+	responses := make([]*activitypb.PollActivityExecutionResponse, len(frontendReqs))
+	errs := make([]error, len(frontendReqs))
+
+	runBatchItems(len(frontendReqs), h.config.BatchMaxConcurrency(batchNamespace(frontendReqs)), nil, func(i int) {
+		if errs[i] = h.validateBatchItemShard(req.GetNamespaceId(), req.GetShardId(), frontendReqs[i].GetActivityId()); errs[i] != nil {
+			return
+		}
+		responses[i], errs[i] = h.PollActivityExecution(ctx, &activitypb.PollActivityExecutionRequest{
+			NamespaceId:     req.GetNamespaceId(),
+			FrontendRequest: frontendReqs[i],
+		})
+	})
+
+	results := make([]*activitypb.BatchPollActivityExecutionsResponse_Result, len(frontendReqs))
+	for i, err := range errs {
+		if isShardOwnershipLost(err) {
+			return nil, err
+		}
+		if err != nil {
+			results[i] = &activitypb.BatchPollActivityExecutionsResponse_Result{
+				Outcome: &activitypb.BatchPollActivityExecutionsResponse_Result_Failure{Failure: newBatchItemFailure(err)},
+			}
+			continue
+		}
+		results[i] = &activitypb.BatchPollActivityExecutionsResponse_Result{
+			Outcome: &activitypb.BatchPollActivityExecutionsResponse_Result_FrontendResponse{FrontendResponse: responses[i].GetFrontendResponse()},
+		}
+	}
+	return &activitypb.BatchPollActivityExecutionsResponse{Results: results}, nil
+}
+
+// validateBatchItemShard checks that an item of a batch is owned by the shard the batch was routed to, as the batch
+// is only processed by the owner of that shard.
+func (h *handler) validateBatchItemShard(namespaceID string, shardID int32, activityID string) error {
+	itemShardID, err := h.shardPools.ShardID(namespaceID, activityID)
+	if err != nil {
+		return err
+	}
+	if itemShardID != shardID {
+		return serviceerror.NewInvalidArgumentf("activity ID %q is owned by shard %d, not by the batch shard %d", activityID, itemShardID, shardID)
+	}
+	return nil
+}
+
 // DeleteActivityExecution terminates the activity if running, then schedules it for deletion.
 func (h *handler) DeleteActivityExecution(
 	ctx context.Context,
@@ -0,0 +1,123 @@
+package activity
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBatchRejectsItemsOfOtherShards(t *testing.T) {
+	const (
+		nsID      = "ns-id"
+		numShards = 4
+	)
+	h := &handler{
+		config:     &Config{BatchMaxConcurrency: dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)},
+		shardPools: shardpooltest.NewResolver(numShards),
+	}
+	shardID := common.WorkflowIDToHistoryShard(nsID, "a", numShards)
+	otherShardActivityID := "b"
+	for common.WorkflowIDToHistoryShard(nsID, otherShardActivityID, numShards) == shardID {
+		otherShardActivityID += "b"
+	}
+
+	t.Run("start", func(t *testing.T) {
+		resp, err := h.BatchStartActivityExecutions(context.Background(), &activitypb.BatchStartActivityExecutionsRequest{
+			NamespaceId: nsID,
+			ShardId:     shardID,
+			FrontendRequests: []*workflowservice.StartActivityExecutionRequest{
+				{ActivityId: otherShardActivityID},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 1)
+		require.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[0].GetFailure().GetCode())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		resp, err := h.BatchDescribeActivityExecutions(context.Background(), &activitypb.BatchDescribeActivityExecutionsRequest{
+			NamespaceId: nsID,
+			ShardId:     shardID,
+			FrontendRequests: []*workflowservice.DescribeActivityExecutionRequest{
+				{ActivityId: otherShardActivityID},
+				// Owned by the shard, but rejected for its long-poll token without reaching the engine.
+				{ActivityId: "a", LongPollToken: []byte("token")},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		require.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[0].GetFailure().GetCode())
+		require.Contains(t, resp.GetResults()[0].GetFailure().GetMessage(), "is owned by shard")
+		require.NotContains(t, resp.GetResults()[1].GetFailure().GetMessage(), "is owned by shard")
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		resp, err := h.BatchPollActivityExecutions(context.Background(), &activitypb.BatchPollActivityExecutionsRequest{
+			NamespaceId: nsID,
+			ShardId:     shardID,
+			FrontendRequests: []*workflowservice.PollActivityExecutionRequest{
+				{ActivityId: otherShardActivityID},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 1)
+		require.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[0].GetFailure().GetCode())
+	})
+}
+
+func TestRunBatchItems(t *testing.T) {
+	t.Run("bounded concurrency", func(t *testing.T) {
+		const maxConcurrency = 3
+		var running, maxRunning atomic.Int32
+		var processed [20]bool
+		runBatchItems(len(processed), maxConcurrency, nil, func(i int) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			processed[i] = true
+			running.Add(-1)
+		})
+		for i, ok := range processed {
+			require.True(t, ok, "item %d was not processed", i)
+		}
+		require.LessOrEqual(t, maxRunning.Load(), int32(maxConcurrency))
+	})
+
+	t.Run("same key in request order", func(t *testing.T) {
+		keys := []string{"a", "b", "a", "c", "a", "b"}
+		var mu sync.Mutex
+		var order []int
+		runBatchItems(len(keys), len(keys), func(i int) string { return keys[i] }, func(i int) {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, i)
+		})
+		require.Len(t, order, len(keys))
+		var orderOfA, orderOfB []int
+		for _, i := range order {
+			switch keys[i] {
+			case "a":
+				orderOfA = append(orderOfA, i)
+			case "b":
+				orderOfB = append(orderOfB, i)
+			}
+		}
+		require.Equal(t, []int{0, 2, 4}, orderOfA)
+		require.Equal(t, []int{1, 5}, orderOfB)
+	})
+}
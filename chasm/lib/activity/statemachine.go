@@ -42,49 +42,54 @@ var TransitionScheduled = chasm.NewTransition(
 	},
 	activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
 	func(a *Activity, ctx chasm.MutableContext, _ any) error {
-		attempt := a.LastAttempt.Get(ctx)
+		a.scheduleFirstAttempt(ctx)
+		return nil
+	},
+)
 
-		attempt.Count++
-		attempt.Stamp++
+// scheduleFirstAttempt schedules the first attempt of the activity described by the current state,
+// along with its timeout tasks.
+func (a *Activity) scheduleFirstAttempt(ctx chasm.MutableContext) {
+	attempt := a.LastAttempt.Get(ctx)
 
-		// Start delay defers the dispatch and extends ScheduleToClose and ScheduleToStart timeouts. StartToClose and
-		// Heartbeat timeouts are unaffected as they only start when a worker picks up the task.
-		dispatchTime := a.firstDispatchTime()
-		attempt.DispatchTime = timestamppb.New(dispatchTime)
+	attempt.Count++
+	attempt.Stamp++
 
-		if timeout := a.GetScheduleToStartTimeout().AsDuration(); timeout > 0 {
-			ctx.AddTask(
-				a,
-				chasm.TaskAttributes{
-					ScheduledTime: dispatchTime.Add(timeout),
-				},
-				&activitypb.ScheduleToStartTimeoutTask{
-					Stamp: attempt.GetStamp(),
-				})
-		}
+	// Start delay defers the dispatch and extends ScheduleToClose and ScheduleToStart timeouts. StartToClose and
+	// Heartbeat timeouts are unaffected as they only start when a worker picks up the task.
+	dispatchTime := a.firstDispatchTime()
+	attempt.DispatchTime = timestamppb.New(dispatchTime)
 
-		if deadline := a.scheduleToCloseDeadline(); !deadline.IsZero() {
-			a.ScheduleToCloseStamp++
-			ctx.AddTask(
-				a,
-				chasm.TaskAttributes{
-					ScheduledTime: deadline,
-				},
-				&activitypb.ScheduleToCloseTimeoutTask{Stamp: a.GetScheduleToCloseStamp()})
-		}
+	if timeout := a.GetScheduleToStartTimeout().AsDuration(); timeout > 0 {
+		ctx.AddTask(
+			a,
+			chasm.TaskAttributes{
+				ScheduledTime: dispatchTime.Add(timeout),
+			},
+			&activitypb.ScheduleToStartTimeoutTask{
+				Stamp: attempt.GetStamp(),
+			})
+	}
 
-		dispatchAttrs := chasm.TaskAttributes{}
-		if dispatchTime.After(a.ScheduleTime.AsTime()) {
-			dispatchAttrs.ScheduledTime = dispatchTime
-		}
+	if deadline := a.scheduleToCloseDeadline(); !deadline.IsZero() {
+		a.ScheduleToCloseStamp++
 		ctx.AddTask(
 			a,
-			dispatchAttrs,
-			a.newActivityDispatchTask(ctx))
+			chasm.TaskAttributes{
+				ScheduledTime: deadline,
+			},
+			&activitypb.ScheduleToCloseTimeoutTask{Stamp: a.GetScheduleToCloseStamp()})
+	}
 
-		return nil
-	},
-)
+	dispatchAttrs := chasm.TaskAttributes{}
+	if dispatchTime.After(a.ScheduleTime.AsTime()) {
+		dispatchAttrs.ScheduledTime = dispatchTime
+	}
+	ctx.AddTask(
+		a,
+		dispatchAttrs,
+		a.newActivityDispatchTask(ctx))
+}
 
 type rescheduleEvent struct {
 	retryInterval       time.Duration
@@ -229,6 +234,47 @@ var TransitionCompleted = chasm.NewTransition(
 	},
 )
 
+// TransitionChainAdvanced replaces an activity that completed successfully with the next step of
+// its chain, and schedules the first attempt of that step. SCHEDULED is included because
+// RespondActivityTaskCompletedById can complete an activity when no attempt is in progress. A
+// pending reset applied to the completed activity, so it is discarded.
+var TransitionChainAdvanced = chasm.NewTransition(
+	[]activitypb.ActivityExecutionStatus{
+		activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
+		activitypb.ACTIVITY_EXECUTION_STATUS_STARTED,
+		activitypb.ACTIVITY_EXECUTION_STATUS_RESET_REQUESTED,
+	},
+	activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
+	func(a *Activity, ctx chasm.MutableContext, event completeEvent) error {
+		if err := a.advanceChain(ctx, event); err != nil {
+			return err
+		}
+		a.scheduleFirstAttempt(ctx)
+		return nil
+	},
+)
+
+// TransitionChainAdvancedPaused is like TransitionChainAdvanced, for an activity that is paused or
+// has a pending pause request. The pause carries over to the next step, which is not dispatched
+// until the activity is unpaused.
+var TransitionChainAdvancedPaused = chasm.NewTransition(
+	[]activitypb.ActivityExecutionStatus{
+		activitypb.ACTIVITY_EXECUTION_STATUS_PAUSED,
+		activitypb.ACTIVITY_EXECUTION_STATUS_PAUSE_REQUESTED,
+	},
+	activitypb.ACTIVITY_EXECUTION_STATUS_PAUSED,
+	func(a *Activity, ctx chasm.MutableContext, event completeEvent) error {
+		if err := a.advanceChain(ctx, event); err != nil {
+			return err
+		}
+		attempt := a.LastAttempt.Get(ctx)
+		attempt.Count++
+		attempt.Stamp++
+		a.reissueScheduleToClose(ctx)
+		return nil
+	},
+)
+
 type failedEvent struct {
 	req             *historyservice.RespondActivityTaskFailedRequest
 	retryState      enumspb.RetryState
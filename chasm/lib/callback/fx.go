@@ -1,13 +1,17 @@
 package callback
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/callbackauth"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
@@ -29,6 +33,7 @@ func httpCallerProviderProvider(
 	namespaceRegistry namespace.Registry,
 	rpcFactory common.RPCFactory,
 	httpClientCache *cluster.FrontendHTTPClientCache,
+	dc *dynamicconfig.Collection,
 	logger log.Logger,
 	httpClientTransportInstrumenter telemetry.HTTPClientTransportInstrumenter,
 ) (HTTPCallerProvider, error) {
@@ -40,12 +45,30 @@ func httpCallerProviderProvider(
 	if err != nil {
 		return nil, err
 	}
-	externalClient := &http.Client{
-		Transport: httpClientTransportInstrumenter.Instrument(externalTransport),
-	}
+	tlsTransports := callbackauth.NewTLSTransportCache(func(tlsConfig *tls.Config) (http.RoundTripper, error) {
+		transport, err := common.NewHTTPTransport(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		return httpClientTransportInstrumenter.Instrument(transport), nil
+	})
+	secrets := callbackauth.NewSecretCache()
+	timeSource := clock.NewRealTimeSource()
 	callbackTokenGenerator := commonnexus.NewCallbackTokenGenerator()
 
-	m := collection.NewOnceMap(func(queuescommon.NamespaceIDAndDestination) HTTPCaller {
+	m := collection.NewOnceMap(func(key queuescommon.NamespaceIDAndDestination) HTTPCaller {
+		externalClient := &http.Client{
+			Transport: callbackauth.NewRoundTripper(
+				namespace.ID(key.NamespaceID),
+				key.Destination,
+				namespaceRegistry,
+				dc,
+				httpClientTransportInstrumenter.Instrument(externalTransport),
+				tlsTransports,
+				secrets,
+				timeSource,
+			),
+		}
 		return func(r *http.Request) (*http.Response, error) {
 			return routeRequest(r,
 				clusterMetadata,
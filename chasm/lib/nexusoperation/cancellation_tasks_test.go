@@ -27,6 +27,7 @@ import (
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexusrpc"
 	"go.temporal.io/server/common/nexus/nexustest"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/common/testing/protorequire"
 	queueserrors "go.temporal.io/server/service/history/queues/errors"
 	"go.uber.org/mock/gomock"
@@ -718,7 +719,7 @@ func TestCancellationInvocationTaskHandler_SystemEndpoint(t *testing.T) {
 			// Set up system endpoint dependencies.
 			historyClient := tc.setupHistoryClient(env.ctrl)
 			env.handler.historyClient = historyClient
-			env.handler.config.NumHistoryShards = 4
+			env.handler.config.ShardPools = shardpooltest.NewResolver(4)
 
 			reg := chasm.NewRegistry(log.NewNoopLogger())
 			if tc.registerProcessor {
@@ -8,10 +8,10 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
-	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/shardpool"
 )
 
 var LongPollTimeout = dynamicconfig.NewNamespaceDurationSetting(
@@ -35,6 +35,13 @@ var Enabled = dynamicconfig.NewNamespaceBoolSetting(
 	`Toggles standalone Nexus operation functionality on the server.`,
 )
 
+var EnableFanOut = dynamicconfig.NewNamespaceBoolSetting(
+	"nexusoperation.enableFanOut",
+	false,
+	`Toggles Nexus operation fan-out executions, which start many Nexus operations against one endpoint and complete
+once all, any, or a quorum of them finish. Requires standalone Nexus operations to be enabled.`,
+)
+
 var EnableChasmWorkflowOperations = dynamicconfig.NewNamespaceBoolSetting(
 	"nexusoperation.enableChasmWorkflowOperations",
 	false,
@@ -93,6 +100,20 @@ var MaxConcurrentOperationsPerWorkflow = dynamicconfig.NewNamespaceIntSetting(
 ScheduleNexusOperation commands will be rejected.`,
 )
 
+var MaxFanOutOperations = dynamicconfig.NewNamespaceIntSetting(
+	"nexusoperation.limit.fanOut.operations.max",
+	1000,
+	`Limits the maximum number of operations, one per input, of a Nexus operation fan-out. Start requests with more
+inputs will be rejected.`,
+)
+
+var MaxFanOutConcurrency = dynamicconfig.NewNamespaceIntSetting(
+	"nexusoperation.limit.fanOut.concurrency.max",
+	100,
+	`Limits the maximum number of operations of a Nexus operation fan-out that may be in flight at the same time. Also
+used as the concurrency of fan-outs that don't specify one.`,
+)
+
 var MaxServiceNameLength = dynamicconfig.NewNamespaceIntSetting(
 	"nexusoperation.limit.service.name.length",
 	1000,
@@ -242,15 +263,18 @@ Added for safety. Defaults to true. Likely to be removed in future server versio
 
 type Config struct {
 	Enabled                                    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	EnableFanOut                               dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableChasm                                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableChasmNexusWorkflowOperations         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ChasmNexusWorkflowOperationsRolloutPercent dynamicconfig.IntPropertyFnWithNamespaceFilter
-	NumHistoryShards                           int32
+	ShardPools                                 *shardpool.Resolver
 	LongPollBuffer                             dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	LongPollTimeout                            dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	RequestTimeout                             dynamicconfig.DurationPropertyFnWithDestinationFilter
 	MinRequestTimeout                          dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	MaxConcurrentOperationsPerWorkflow         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxFanOutOperations                        dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxFanOutConcurrency                       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxServiceNameLength                       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxOperationNameLength                     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxOperationTokenLength                    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -271,18 +295,21 @@ type Config struct {
 	RetryPolicy                                func() backoff.RetryPolicy
 }
 
-func configProvider(dc *dynamicconfig.Collection, cfg *config.Persistence) *Config {
+func configProvider(dc *dynamicconfig.Collection, shardPools *shardpool.Resolver) *Config {
 	return &Config{
 		Enabled:                            Enabled.Get(dc),
+		EnableFanOut:                       EnableFanOut.Get(dc),
 		EnableChasm:                        dynamicconfig.EnableChasm.Get(dc),
 		EnableChasmNexusWorkflowOperations: EnableChasmWorkflowOperations.Get(dc),
 		ChasmNexusWorkflowOperationsRolloutPercent: ChasmWorkflowOperationsRolloutPercent.Get(dc),
-		NumHistoryShards:                   cfg.NumHistoryShards,
+		ShardPools:                         shardPools,
 		LongPollBuffer:                     LongPollBuffer.Get(dc),
 		LongPollTimeout:                    LongPollTimeout.Get(dc),
 		RequestTimeout:                     RequestTimeout.Get(dc),
 		MinRequestTimeout:                  MinRequestTimeout.Get(dc),
 		MaxConcurrentOperationsPerWorkflow: MaxConcurrentOperationsPerWorkflow.Get(dc),
+		MaxFanOutOperations:                MaxFanOutOperations.Get(dc),
+		MaxFanOutConcurrency:               MaxFanOutConcurrency.Get(dc),
 		MaxServiceNameLength:               MaxServiceNameLength.Get(dc),
 		MaxOperationNameLength:             MaxOperationNameLength.Get(dc),
 		MaxOperationTokenLength:            MaxOperationTokenLength.Get(dc),
@@ -0,0 +1,577 @@
+package nexusoperation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexus-rpc/sdk-go/nexus"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/callback"
+	callbackspb "go.temporal.io/server/chasm/lib/callback/gen/callbackpb/v1"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/nexus/nexusrpc"
+	sdkconverter "go.temporal.io/server/common/sdk"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// fanOutWorkflowTypeName is the workflow type for tagging operations started by a fan-out.
+	// Used as the WorkflowTypeTag in metrics emitted from those operations.
+	// Do not change. It is exposed in metrics.
+	fanOutWorkflowTypeName = "__temporal_nexus_fan_out__"
+
+	// fanOutFailureType is the application failure type of a fan-out whose completion policy can no longer be
+	// satisfied.
+	fanOutFailureType = "NexusFanOutFailed"
+)
+
+var _ chasm.RootComponent = (*FanOut)(nil)
+var _ chasm.StateMachine[nexusoperationpb.FanOutStatus] = (*FanOut)(nil)
+var _ chasm.VisibilitySearchAttributesProvider = (*FanOut)(nil)
+var _ OperationStore = (*FanOut)(nil)
+var _ callback.CompletionSource = (*FanOut)(nil)
+
+// ErrFanOutAlreadyClosed is returned when trying to cancel or terminate a fan-out that has already closed.
+var ErrFanOutAlreadyClosed = serviceerror.NewFailedPrecondition("fan-out already closed")
+
+// FanOut is a CHASM component that starts one Nexus operation per input against the same endpoint, service and
+// operation, with a bound on how many operations are in flight at the same time. It closes once its completion
+// policy is satisfied or can no longer be satisfied, aggregating the outcomes of the completed operations.
+//
+// The operations are child components that report their outcomes back to the fan-out through the [OperationStore]
+// interface, the same way operations scheduled by a workflow do.
+type FanOut struct {
+	chasm.UnimplementedComponent
+
+	// Persisted internal state
+	*nexusoperationpb.FanOutState
+
+	RequestData chasm.Field[*nexusoperationpb.FanOutRequestData]
+	// Operations that are in flight, keyed by input index. Operations are removed once they complete.
+	Operations chasm.Map[int32, *Operation]
+	// Outcomes of the operations that completed while the fan-out was running, keyed by input index.
+	Results chasm.Map[int32, *nexusoperationpb.FanOutOperationResult]
+	// Callbacks invoked when the fan-out closes. Set when the fan-out is started as a Nexus operation.
+	Callbacks  chasm.Map[string, *callback.Callback]
+	Visibility chasm.Field[*chasm.Visibility]
+}
+
+func newFanOut(
+	ctx chasm.MutableContext,
+	req *nexusoperationpb.StartNexusFanOutRequest,
+) (*FanOut, error) {
+	frontendReq := req.GetFrontendRequest()
+	operationCount := int32(len(frontendReq.GetInputs()))
+
+	f := &FanOut{
+		FanOutState: &nexusoperationpb.FanOutState{
+			EndpointId:             req.GetEndpointId(),
+			Endpoint:               frontendReq.GetEndpoint(),
+			Service:                frontendReq.GetService(),
+			Operation:              frontendReq.GetOperation(),
+			CompletionPolicy:       frontendReq.GetCompletionPolicy(),
+			RequiredSuccesses:      requiredSuccesses(frontendReq.GetCompletionPolicy(), frontendReq.GetQuorum(), operationCount),
+			MaxConcurrency:         frontendReq.GetMaxConcurrency(),
+			OperationCount:         operationCount,
+			ScheduleToCloseTimeout: frontendReq.GetScheduleToCloseTimeout(),
+			ScheduleToStartTimeout: frontendReq.GetScheduleToStartTimeout(),
+			StartToCloseTimeout:    frontendReq.GetStartToCloseTimeout(),
+		},
+	}
+	f.RequestData = chasm.NewDataField(ctx, &nexusoperationpb.FanOutRequestData{
+		Inputs:      frontendReq.GetInputs(),
+		NexusHeader: frontendReq.GetNexusHeader(),
+		Identity:    frontendReq.GetIdentity(),
+	})
+	f.Visibility = chasm.NewComponentField(ctx, chasm.NewVisibility(ctx))
+
+	if err := f.addCompletionCallbacks(ctx, frontendReq.GetRequestId(), req.GetCompletionCallbacks()); err != nil {
+		return nil, err
+	}
+
+	if err := transitionFanOutStarted.Apply(f, ctx, eventFanOutStarted{}); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// requiredSuccesses returns the number of operations that must succeed for a fan-out to succeed.
+func requiredSuccesses(policy nexusoperationpb.FanOutCompletionPolicy, quorum int32, operationCount int32) int32 {
+	switch policy {
+	case nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY:
+		return 1
+	case nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM:
+		return quorum
+	default:
+		return operationCount
+	}
+}
+
+// addCompletionCallbacks registers the callbacks to invoke when the fan-out closes.
+func (f *FanOut) addCompletionCallbacks(
+	ctx chasm.MutableContext,
+	requestID string,
+	completionCallbacks []*commonpb.Callback,
+) error {
+	if len(completionCallbacks) == 0 {
+		return nil
+	}
+
+	f.Callbacks = make(chasm.Map[string, *callback.Callback], len(completionCallbacks))
+	registrationTime := timestamppb.New(ctx.Now(f))
+
+	for idx, cb := range completionCallbacks {
+		chasmCB := &callbackspb.Callback{
+			Links: cb.GetLinks(),
+		}
+		switch variant := cb.Variant.(type) {
+		case *commonpb.Callback_Nexus_:
+			chasmCB.Variant = &callbackspb.Callback_Nexus_{
+				Nexus: &callbackspb.Callback_Nexus{
+					Url:    variant.Nexus.GetUrl(),
+					Header: variant.Nexus.GetHeader(),
+				},
+			}
+		default:
+			return serviceerror.NewInvalidArgumentf("unsupported callback variant: %T", variant)
+		}
+
+		// requestID (unique per API call) + idx (position within the request) ensures unique,idempotent callback IDs.
+		id := fmt.Sprintf("%s-%d", requestID, idx)
+		callbackObj := callback.NewCallback(requestID, registrationTime, &callbackspb.CallbackState{}, chasmCB)
+		f.Callbacks[id] = chasm.NewComponentField(ctx, callbackObj)
+	}
+	return nil
+}
+
+// LifecycleState maps the fan-out's status to a CHASM lifecycle state.
+func (f *FanOut) LifecycleState(_ chasm.Context) chasm.LifecycleState {
+	switch f.Status {
+	case nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED:
+		return chasm.LifecycleStateCompleted
+	case nexusoperationpb.FAN_OUT_STATUS_FAILED,
+		nexusoperationpb.FAN_OUT_STATUS_CANCELED,
+		nexusoperationpb.FAN_OUT_STATUS_TERMINATED:
+		return chasm.LifecycleStateFailed
+	default:
+		return chasm.LifecycleStateRunning
+	}
+}
+
+func (f *FanOut) ContextMetadata(_ chasm.Context) map[string]string {
+	return nil
+}
+
+// StateMachineState returns the current fan-out status.
+func (f *FanOut) StateMachineState() nexusoperationpb.FanOutStatus {
+	return f.Status
+}
+
+// SetStateMachineState sets the fan-out status.
+func (f *FanOut) SetStateMachineState(status nexusoperationpb.FanOutStatus) {
+	f.Status = status
+}
+
+func (f *FanOut) SearchAttributes(_ chasm.Context) []chasm.SearchAttributeKeyValue {
+	return []chasm.SearchAttributeKeyValue{
+		EndpointSearchAttribute.Value(f.Endpoint),
+		ServiceSearchAttribute.Value(f.Service),
+		OperationSearchAttribute.Value(f.Operation),
+	}
+}
+
+// RequestCancel cancels the fan-out and the operations it has in flight.
+func (f *FanOut) RequestCancel(
+	ctx chasm.MutableContext,
+	req *nexusoperationpb.RequestCancelNexusFanOutExecutionRequest,
+) error {
+	// A cancel retry can arrive after the fan-out closed, so dedupe before rejecting terminal states.
+	if f.Status == nexusoperationpb.FAN_OUT_STATUS_CANCELED && f.CloseRequestId == req.GetRequestId() {
+		return nil
+	}
+	if !transitionFanOutCanceled.Possible(f) {
+		return ErrFanOutAlreadyClosed
+	}
+	return transitionFanOutCanceled.Apply(f, ctx, eventFanOutCanceled{
+		RequestID: req.GetRequestId(),
+		Identity:  req.GetIdentity(),
+		Reason:    req.GetReason(),
+	})
+}
+
+func (f *FanOut) Terminate(
+	ctx chasm.MutableContext,
+	req chasm.TerminateComponentRequest,
+) (chasm.TerminateComponentResponse, error) {
+	if f.Status == nexusoperationpb.FAN_OUT_STATUS_TERMINATED {
+		if f.CloseRequestId != req.RequestID {
+			return chasm.TerminateComponentResponse{},
+				serviceerror.NewFailedPreconditionf("already terminated with request ID %s", f.CloseRequestId)
+		}
+		return chasm.TerminateComponentResponse{}, nil
+	}
+	if !transitionFanOutTerminated.Possible(f) {
+		return chasm.TerminateComponentResponse{}, ErrFanOutAlreadyClosed
+	}
+	return chasm.TerminateComponentResponse{}, transitionFanOutTerminated.Apply(f, ctx, eventFanOutTerminated{
+		TerminateComponentRequest: req,
+	})
+}
+
+// scheduleOperations schedules operations in input order until either all operations are scheduled or
+// MaxConcurrency operations are in flight.
+func (f *FanOut) scheduleOperations(ctx chasm.MutableContext) error {
+	for f.ScheduledCount < f.OperationCount && f.runningCount() < f.MaxConcurrency {
+		if err := f.scheduleOperation(ctx, f.ScheduledCount); err != nil {
+			return err
+		}
+		f.ScheduledCount++
+	}
+	return nil
+}
+
+func (f *FanOut) scheduleOperation(ctx chasm.MutableContext, index int32) error {
+	parentData, err := anypb.New(&nexusoperationpb.FanOutOperationParentData{Index: index})
+	if err != nil {
+		return serviceerror.NewInternalf("failed to encode operation parent data: %v", err)
+	}
+
+	op := NewOperation(&nexusoperationpb.OperationState{
+		EndpointId:             f.EndpointId,
+		Endpoint:               f.Endpoint,
+		Service:                f.Service,
+		Operation:              f.Operation,
+		ScheduleToCloseTimeout: f.ScheduleToCloseTimeout,
+		ScheduleToStartTimeout: f.ScheduleToStartTimeout,
+		StartToCloseTimeout:    f.StartToCloseTimeout,
+		ScheduledTime:          timestamppb.New(ctx.Now(f)),
+		RequestId:              uuid.NewString(),
+		ParentData:             parentData,
+	})
+
+	if f.Operations == nil {
+		f.Operations = make(chasm.Map[int32, *Operation])
+	}
+	// Detached so that operations still in flight when the fan-out closes can be canceled.
+	f.Operations[index] = chasm.NewComponentField(ctx, op, chasm.ComponentFieldDetached())
+	return TransitionScheduled.Apply(op, ctx, EventScheduled{})
+}
+
+// runningCount returns the number of operations that are scheduled but have not completed yet.
+func (f *FanOut) runningCount() int32 {
+	return f.ScheduledCount - f.SucceededCount - f.FailedCount
+}
+
+// operationIndex returns the input index of an operation scheduled by the fan-out.
+func operationIndex(op *Operation) (int32, error) {
+	var parentData nexusoperationpb.FanOutOperationParentData
+	if err := op.GetParentData().UnmarshalTo(&parentData); err != nil {
+		return 0, serviceerror.NewInternalf("failed to decode operation parent data: %v", err)
+	}
+	return parentData.GetIndex(), nil
+}
+
+func (f *FanOut) OnNexusOperationStarted(
+	ctx chasm.MutableContext,
+	operation *Operation,
+	operationToken string,
+	startTime *time.Time,
+	_ []*commonpb.Link,
+) error {
+	return TransitionStarted.Apply(operation, ctx, EventStarted{
+		OperationToken: operationToken,
+		StartTime:      startTime,
+	})
+}
+
+func (f *FanOut) OnNexusOperationCompleted(
+	ctx chasm.MutableContext,
+	operation *Operation,
+	result *commonpb.Payload,
+	_ []*commonpb.Link,
+) error {
+	if err := TransitionSucceeded.Apply(operation, ctx, EventSucceeded{Result: result}); err != nil {
+		return err
+	}
+	return f.onOperationClosed(ctx, operation)
+}
+
+func (f *FanOut) OnNexusOperationFailed(ctx chasm.MutableContext, operation *Operation, cause *failurepb.Failure) error {
+	if err := TransitionFailed.Apply(operation, ctx, EventFailed{Failure: cause}); err != nil {
+		return err
+	}
+	return f.onOperationClosed(ctx, operation)
+}
+
+func (f *FanOut) OnNexusOperationCanceled(ctx chasm.MutableContext, operation *Operation, cause *failurepb.Failure) error {
+	if err := TransitionCanceled.Apply(operation, ctx, EventCanceled{Failure: cause}); err != nil {
+		return err
+	}
+	return f.onOperationClosed(ctx, operation)
+}
+
+func (f *FanOut) OnNexusOperationTimedOut(
+	ctx chasm.MutableContext,
+	operation *Operation,
+	cause *failurepb.Failure,
+	fromAttempt bool,
+) error {
+	if err := TransitionTimedOut.Apply(operation, ctx, EventTimedOut{
+		Failure:     cause,
+		FromAttempt: fromAttempt,
+	}); err != nil {
+		return err
+	}
+	return f.onOperationClosed(ctx, operation)
+}
+
+func (f *FanOut) OnNexusOperationCancellationCompleted(ctx chasm.MutableContext, operation *Operation) error {
+	return TransitionCancellationSucceeded.Apply(operation.Cancellation.Get(ctx), ctx, EventCancellationSucceeded{})
+}
+
+func (f *FanOut) OnNexusOperationCancellationFailed(
+	ctx chasm.MutableContext,
+	operation *Operation,
+	cause *failurepb.Failure,
+) error {
+	return TransitionCancellationFailed.Apply(operation.Cancellation.Get(ctx), ctx, EventCancellationFailed{
+		Failure: cause,
+	})
+}
+
+// NexusOperationInvocationData returns the input at the operation's index. All operations share the fan-out's
+// headers.
+func (f *FanOut) NexusOperationInvocationData(ctx chasm.Context, operation *Operation) (InvocationData, error) {
+	index, err := operationIndex(operation)
+	if err != nil {
+		return InvocationData{}, err
+	}
+	requestData := f.RequestData.Get(ctx)
+	if index < 0 || int(index) >= len(requestData.GetInputs()) {
+		return InvocationData{}, serviceerror.NewInternalf("operation index %d out of range", index)
+	}
+	return InvocationData{
+		Input:  requestData.GetInputs()[index],
+		Header: requestData.GetNexusHeader(),
+	}, nil
+}
+
+func (f *FanOut) WorkflowTypeName() string {
+	return fanOutWorkflowTypeName
+}
+
+// onOperationClosed records the outcome of an operation that reached a terminal state, closes the fan-out if its
+// completion policy is decided, and otherwise schedules more operations.
+func (f *FanOut) onOperationClosed(ctx chasm.MutableContext, op *Operation) error {
+	index, err := operationIndex(op)
+	if err != nil {
+		return err
+	}
+	delete(f.Operations, index)
+
+	// Operations canceled after the fan-out closed no longer affect its outcome.
+	if f.Status != nexusoperationpb.FAN_OUT_STATUS_RUNNING {
+		return nil
+	}
+
+	result := &nexusoperationpb.FanOutOperationResult{
+		Index:     index,
+		Status:    op.Status,
+		CloseTime: op.ClosedTime,
+	}
+	successful, failure := op.outcome(ctx)
+	if successful != nil {
+		result.Outcome = &nexusoperationpb.FanOutOperationResult_Result{Result: successful}
+	} else if failure != nil {
+		result.Outcome = &nexusoperationpb.FanOutOperationResult_Failure{Failure: failure}
+	}
+	if f.Results == nil {
+		f.Results = make(chasm.Map[int32, *nexusoperationpb.FanOutOperationResult])
+	}
+	f.Results[index] = chasm.NewDataField(ctx, result)
+
+	if op.Status == nexusoperationpb.OPERATION_STATUS_SUCCEEDED {
+		f.SucceededCount++
+	} else {
+		f.FailedCount++
+	}
+
+	switch {
+	case f.SucceededCount >= f.RequiredSuccesses:
+		return transitionFanOutSucceeded.Apply(f, ctx, eventFanOutSucceeded{})
+	case f.FailedCount > f.OperationCount-f.RequiredSuccesses:
+		return transitionFanOutFailed.Apply(f, ctx, eventFanOutFailed{Cause: failure})
+	default:
+		return f.scheduleOperations(ctx)
+	}
+}
+
+// cancelOperations requests cancellation of the operations that are still in flight.
+func (f *FanOut) cancelOperations(ctx chasm.MutableContext, reason string) error {
+	for _, index := range slices.Sorted(maps.Keys(f.Operations)) {
+		op := f.Operations[index].Get(ctx)
+		if err := op.RequestCancel(ctx, &nexusoperationpb.CancellationState{
+			RequestId:     uuid.NewString(),
+			RequestedTime: timestamppb.New(ctx.Now(f)),
+			Reason:        reason,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// terminateOperations terminates the operations that are still in flight and removes them from the fan-out.
+func (f *FanOut) terminateOperations(ctx chasm.MutableContext, req chasm.TerminateComponentRequest) error {
+	for _, index := range slices.Sorted(maps.Keys(f.Operations)) {
+		op := f.Operations[index].Get(ctx)
+		if err := TransitionTerminated.Apply(op, ctx, EventTerminated{TerminateComponentRequest: req}); err != nil {
+			return err
+		}
+		delete(f.Operations, index)
+	}
+	return nil
+}
+
+// GetNexusCompletion returns the fan-out's completion data in the format required by the Nexus callback invocation.
+// Implements callback.CompletionSource.
+func (f *FanOut) GetNexusCompletion(ctx chasm.Context, _ string) (nexusrpc.CompleteOperationOptions, error) {
+	if !f.LifecycleState(ctx).IsClosed() {
+		return nexusrpc.CompleteOperationOptions{}, serviceerror.NewInternal("fan-out has not completed yet")
+	}
+
+	opts := nexusrpc.CompleteOperationOptions{
+		StartTime: f.GetStartTime().AsTime(),
+		CloseTime: f.GetCloseTime().AsTime(),
+	}
+
+	if f.Status == nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED {
+		p, err := sdkconverter.PreferProtoDataConverter.ToPayload(f.buildResults(ctx))
+		if err != nil {
+			return nexusrpc.CompleteOperationOptions{}, serviceerror.NewInternalf("failed to encode fan-out results: %v", err)
+		}
+		opts.Result = p
+		return opts, nil
+	}
+
+	state := nexus.OperationStateFailed
+	message := "operation failed"
+	if f.Status == nexusoperationpb.FAN_OUT_STATUS_CANCELED {
+		state = nexus.OperationStateCanceled
+		message = "operation canceled"
+	}
+
+	nf, err := commonnexus.TemporalFailureToNexusFailure(f.Failure)
+	if err != nil {
+		return nexusrpc.CompleteOperationOptions{}, serviceerror.NewInternalf("failed to convert failure: %v", err)
+	}
+
+	opErr := &nexus.OperationError{
+		State:   state,
+		Message: message,
+		Cause:   &nexus.FailureError{Failure: nf},
+	}
+	if err := nexusrpc.MarkAsWrapperError(nexusrpc.DefaultFailureConverter(), opErr); err != nil {
+		return nexusrpc.CompleteOperationOptions{}, err
+	}
+	opts.Error = opErr
+	return opts, nil
+}
+
+// buildResults returns the outcomes of the completed operations, ordered by index.
+func (f *FanOut) buildResults(ctx chasm.Context) *nexusoperationpb.FanOutResults {
+	results := make([]*nexusoperationpb.FanOutOperationResult, 0, len(f.Results))
+	for _, index := range slices.Sorted(maps.Keys(f.Results)) {
+		results = append(results, f.Results[index].Get(ctx))
+	}
+	return &nexusoperationpb.FanOutResults{Results: results}
+}
+
+func (f *FanOut) buildDescribeResponse(
+	ctx chasm.Context,
+	req *nexusoperationpb.DescribeNexusFanOutRequest,
+) (*nexusoperationpb.DescribeNexusFanOutResponse, error) {
+	token, err := ctx.Ref(f)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &nexusoperationpb.DescribeNexusFanOutExecutionResponse{
+		RunId:         ctx.ExecutionKey().RunID,
+		Info:          f.buildExecutionInfo(ctx),
+		LongPollToken: token,
+	}
+	if req.GetFrontendRequest().GetIncludeResults() {
+		resp.Results = f.buildResults(ctx).GetResults()
+	}
+	return &nexusoperationpb.DescribeNexusFanOutResponse{FrontendResponse: resp}, nil
+}
+
+func (f *FanOut) buildPollResponse(ctx chasm.Context) *nexusoperationpb.PollNexusFanOutResponse {
+	resp := &nexusoperationpb.PollNexusFanOutExecutionResponse{
+		RunId:  ctx.ExecutionKey().RunID,
+		Status: f.Status,
+	}
+	if f.LifecycleState(ctx).IsClosed() {
+		resp.Results = f.buildResults(ctx).GetResults()
+		resp.Failure = f.Failure
+	}
+	return &nexusoperationpb.PollNexusFanOutResponse{FrontendResponse: resp}
+}
+
+func (f *FanOut) buildExecutionInfo(ctx chasm.Context) *nexusoperationpb.NexusFanOutExecutionInfo {
+	key := ctx.ExecutionKey()
+	return &nexusoperationpb.NexusFanOutExecutionInfo{
+		FanOutId:             key.BusinessID,
+		RunId:                key.RunID,
+		Endpoint:             f.Endpoint,
+		Service:              f.Service,
+		Operation:            f.Operation,
+		Status:               f.Status,
+		CompletionPolicy:     f.CompletionPolicy,
+		RequiredSuccesses:    f.RequiredSuccesses,
+		MaxConcurrency:       f.MaxConcurrency,
+		OperationCount:       f.OperationCount,
+		ScheduledCount:       f.ScheduledCount,
+		RunningCount:         int32(len(f.Operations)),
+		SucceededCount:       f.SucceededCount,
+		FailedCount:          f.FailedCount,
+		StartTime:            f.StartTime,
+		CloseTime:            f.CloseTime,
+		Failure:              f.Failure,
+		Identity:             f.RequestData.Get(ctx).GetIdentity(),
+		StateTransitionCount: ctx.ExecutionInfo().StateTransitionCount,
+	}
+}
+
+// encodeFanOutOperationToken encodes the token returned when a fan-out is started as a Nexus operation.
+func encodeFanOutOperationToken(token *nexusoperationpb.FanOutOperationToken) (string, error) {
+	data, err := proto.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// decodeFanOutOperationToken decodes a token returned by encodeFanOutOperationToken.
+func decodeFanOutOperationToken(token string) (*nexusoperationpb.FanOutOperationToken, error) {
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	decoded := &nexusoperationpb.FanOutOperationToken{}
+	if err := proto.Unmarshal(data, decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
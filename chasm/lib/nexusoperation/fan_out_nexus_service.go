@@ -0,0 +1,175 @@
+package nexusoperation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/nexus-rpc/sdk-go/nexus"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+)
+
+const (
+	// FanOutNexusServiceName is the name of the system Nexus service that starts fan-outs as Nexus operations.
+	FanOutNexusServiceName = "temporal.server.nexusoperation.v1.NexusFanOutService"
+	// StartFanOutNexusOperationName is the name of the asynchronous operation that starts a fan-out and completes
+	// with its aggregated results.
+	StartFanOutNexusOperationName = "StartNexusFanOutExecution"
+)
+
+// startFanOutOperation is the Nexus operation that starts a fan-out. It takes a
+// StartNexusFanOutExecutionRequest and completes asynchronously with the fan-out's FanOutResults.
+type startFanOutOperation struct {
+	nexus.UnimplementedOperation[*nexusoperationpb.StartNexusFanOutExecutionRequest, *nexusoperationpb.FanOutResults]
+
+	handler           *handler
+	namespaceRegistry namespace.Registry
+	endpointRegistry  commonnexus.EndpointRegistry
+}
+
+func (o *startFanOutOperation) Name() string {
+	return StartFanOutNexusOperationName
+}
+
+// Start starts the fan-out with a completion callback to the caller. The request was already validated and
+// normalized by startFanOutOperationProcessor.
+func (o *startFanOutOperation) Start(
+	ctx context.Context,
+	req *nexusoperationpb.StartNexusFanOutExecutionRequest,
+	options nexus.StartOperationOptions,
+) (nexus.HandlerStartOperationResult[*nexusoperationpb.FanOutResults], error) {
+	namespaceID, err := o.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	endpointEntry, err := o.endpointRegistry.GetByName(ctx, namespaceID, req.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+
+	var completionCallbacks []*commonpb.Callback
+	if options.CallbackURL != "" {
+		completionCallbacks = append(completionCallbacks, &commonpb.Callback{
+			Variant: &commonpb.Callback_Nexus_{
+				Nexus: &commonpb.Callback_Nexus{
+					Url:    options.CallbackURL,
+					Header: options.CallbackHeader,
+				},
+			},
+		})
+	}
+
+	resp, err := o.handler.StartNexusFanOut(ctx, &nexusoperationpb.StartNexusFanOutRequest{
+		NamespaceId:         namespaceID.String(),
+		EndpointId:          endpointEntry.GetId(),
+		FrontendRequest:     req,
+		CompletionCallbacks: completionCallbacks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := encodeFanOutOperationToken(&nexusoperationpb.FanOutOperationToken{
+		NamespaceId: namespaceID.String(),
+		FanOutId:    req.GetFanOutId(),
+		RunId:       resp.GetFrontendResponse().GetRunId(),
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternalf("failed to encode operation token: %v", err)
+	}
+	return &nexus.HandlerStartOperationResultAsync{OperationToken: token}, nil
+}
+
+// Cancel cancels the fan-out identified by the token. Canceling a fan-out that already closed is a no-op.
+func (o *startFanOutOperation) Cancel(ctx context.Context, token string, _ nexus.CancelOperationOptions) error {
+	decoded, err := decodeFanOutOperationToken(token)
+	if err != nil {
+		return nexus.NewHandlerErrorf(nexus.HandlerErrorTypeBadRequest, "invalid operation token: %v", err)
+	}
+
+	_, err = o.handler.RequestCancelNexusFanOut(ctx, &nexusoperationpb.RequestCancelNexusFanOutRequest{
+		NamespaceId: decoded.GetNamespaceId(),
+		FrontendRequest: &nexusoperationpb.RequestCancelNexusFanOutExecutionRequest{
+			FanOutId:  decoded.GetFanOutId(),
+			RunId:     decoded.GetRunId(),
+			RequestId: uuid.NewString(),
+			Reason:    "canceled by Nexus caller",
+		},
+	})
+	if errors.Is(err, ErrFanOutAlreadyClosed) {
+		return nil
+	}
+	return err
+}
+
+func newFanOutNexusService(
+	handler *handler,
+	namespaceRegistry namespace.Registry,
+	endpointRegistry commonnexus.EndpointRegistry,
+) *nexus.Service {
+	svc := nexus.NewService(FanOutNexusServiceName)
+	svc.MustRegister(&startFanOutOperation{
+		handler:           handler,
+		namespaceRegistry: namespaceRegistry,
+		endpointRegistry:  endpointRegistry,
+	})
+	return svc
+}
+
+// startFanOutOperationProcessor validates and normalizes the input of StartFanOutNexusOperationName and routes it to
+// the shard that owns the fan-out.
+type startFanOutOperationProcessor struct {
+	config    *Config
+	validator *validator
+}
+
+func (p startFanOutOperationProcessor) ProcessInput(
+	ctx chasm.NexusOperationProcessorContext,
+	request *nexusoperationpb.StartNexusFanOutExecutionRequest,
+) (*chasm.NexusOperationProcessorResult, error) {
+	nsName := ctx.Namespace.Name().String()
+	if !p.config.EnableChasm(nsName) || !p.config.Enabled(nsName) || !p.config.EnableFanOut(nsName) {
+		return nil, ErrNexusFanOutDisabled
+	}
+	if request == nil {
+		return nil, serviceerror.NewInvalidArgument("Request is empty")
+	}
+	if request.GetNamespace() == "" {
+		request.Namespace = nsName
+	} else if request.GetNamespace() != nsName {
+		return nil, serviceerror.NewInvalidArgumentf("Namespace in request %q does not match namespace in context %q", request.GetNamespace(), nsName)
+	}
+
+	if request.GetRequestId() != "" {
+		return nil, serviceerror.NewInvalidArgument("RequestID should not be set on the request")
+	}
+	request.RequestId = ctx.RequestID
+
+	if err := p.validator.validateAndNormalizeStartFanOutRequest(request); err != nil {
+		return nil, err
+	}
+
+	return &chasm.NexusOperationProcessorResult{
+		RoutingKey: chasm.NexusOperationRoutingKeyExecution{
+			NamespaceID: ctx.Namespace.ID().String(),
+			BusinessID:  request.GetFanOutId(),
+		},
+	}, nil
+}
+
+func newFanOutNexusServiceProcessor(config *Config, validator *validator) *chasm.NexusServiceProcessor {
+	sp := chasm.NewNexusServiceProcessor(FanOutNexusServiceName)
+	sp.MustRegisterOperation(
+		StartFanOutNexusOperationName,
+		chasm.NewRegisterableNexusOperationProcessor(startFanOutOperationProcessor{
+			config:    config,
+			validator: validator,
+		}),
+	)
+	return sp
+}
@@ -0,0 +1,128 @@
+package nexusoperation
+
+import (
+	"fmt"
+
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/callback"
+	"go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventFanOutStarted is triggered when the fan-out is created.
+type eventFanOutStarted struct {
+}
+
+var transitionFanOutStarted = chasm.NewTransition(
+	[]nexusoperationpb.FanOutStatus{nexusoperationpb.FAN_OUT_STATUS_UNSPECIFIED},
+	nexusoperationpb.FAN_OUT_STATUS_RUNNING,
+	func(f *FanOut, ctx chasm.MutableContext, event eventFanOutStarted) error {
+		f.StartTime = timestamppb.New(ctx.Now(f))
+		return f.scheduleOperations(ctx)
+	},
+)
+
+// eventFanOutSucceeded is triggered when enough operations succeeded to satisfy the completion policy.
+type eventFanOutSucceeded struct {
+}
+
+var transitionFanOutSucceeded = chasm.NewTransition(
+	[]nexusoperationpb.FanOutStatus{nexusoperationpb.FAN_OUT_STATUS_RUNNING},
+	nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED,
+	func(f *FanOut, ctx chasm.MutableContext, event eventFanOutSucceeded) error {
+		if err := f.cancelOperations(ctx, "fan-out succeeded"); err != nil {
+			return err
+		}
+		return f.onClosed(ctx)
+	},
+)
+
+// eventFanOutFailed is triggered when too many operations completed unsuccessfully for the completion policy to be
+// satisfied.
+type eventFanOutFailed struct {
+	// Failure of the operation whose completion decided the fan-out's outcome.
+	Cause *failurepb.Failure
+}
+
+var transitionFanOutFailed = chasm.NewTransition(
+	[]nexusoperationpb.FanOutStatus{nexusoperationpb.FAN_OUT_STATUS_RUNNING},
+	nexusoperationpb.FAN_OUT_STATUS_FAILED,
+	func(f *FanOut, ctx chasm.MutableContext, event eventFanOutFailed) error {
+		f.Failure = &failurepb.Failure{
+			Message: fmt.Sprintf(
+				"%d of %d operations completed unsuccessfully, at least %d must succeed",
+				f.FailedCount, f.OperationCount, f.RequiredSuccesses,
+			),
+			Cause: event.Cause,
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+					Type:         fanOutFailureType,
+					NonRetryable: true,
+				},
+			},
+		}
+		if err := f.cancelOperations(ctx, "fan-out failed"); err != nil {
+			return err
+		}
+		return f.onClosed(ctx)
+	},
+)
+
+// eventFanOutCanceled is triggered when cancellation of the fan-out is requested.
+type eventFanOutCanceled struct {
+	RequestID string
+	Identity  string
+	Reason    string
+}
+
+var transitionFanOutCanceled = chasm.NewTransition(
+	[]nexusoperationpb.FanOutStatus{nexusoperationpb.FAN_OUT_STATUS_RUNNING},
+	nexusoperationpb.FAN_OUT_STATUS_CANCELED,
+	func(f *FanOut, ctx chasm.MutableContext, event eventFanOutCanceled) error {
+		f.CloseRequestId = event.RequestID
+		f.Failure = &failurepb.Failure{
+			Message: event.Reason,
+			FailureInfo: &failurepb.Failure_CanceledFailureInfo{
+				CanceledFailureInfo: &failurepb.CanceledFailureInfo{
+					Identity: event.Identity,
+				},
+			},
+		}
+		if err := f.cancelOperations(ctx, event.Reason); err != nil {
+			return err
+		}
+		return f.onClosed(ctx)
+	},
+)
+
+// eventFanOutTerminated is triggered when the fan-out is terminated by user request.
+type eventFanOutTerminated struct {
+	chasm.TerminateComponentRequest
+}
+
+var transitionFanOutTerminated = chasm.NewTransition(
+	[]nexusoperationpb.FanOutStatus{nexusoperationpb.FAN_OUT_STATUS_RUNNING},
+	nexusoperationpb.FAN_OUT_STATUS_TERMINATED,
+	func(f *FanOut, ctx chasm.MutableContext, event eventFanOutTerminated) error {
+		f.CloseRequestId = event.RequestID
+		f.Failure = &failurepb.Failure{
+			Message: event.Reason,
+			FailureInfo: &failurepb.Failure_TerminatedFailureInfo{
+				TerminatedFailureInfo: &failurepb.TerminatedFailureInfo{
+					Identity: event.Identity,
+				},
+			},
+		}
+		if err := f.terminateOperations(ctx, event.TerminateComponentRequest); err != nil {
+			return err
+		}
+		return f.onClosed(ctx)
+	},
+)
+
+// onClosed records the close time and schedules the completion callbacks.
+func (f *FanOut) onClosed(ctx chasm.MutableContext) error {
+	f.CloseTime = timestamppb.New(ctx.Now(f))
+	return callback.ScheduleStandbyCallbacks(ctx, f.Callbacks)
+}
@@ -0,0 +1,323 @@
+package nexusoperation
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	sdkconverter "go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func newFanOutTestContext() *chasm.MockMutableContext {
+	return &chasm.MockMutableContext{
+		MockContext: chasm.MockContext{
+			HandleNow: func(chasm.Component) time.Time { return defaultTime },
+			HandleExecutionKey: func() chasm.ExecutionKey {
+				return chasm.ExecutionKey{NamespaceID: "ns-id", BusinessID: "fan-out-id", RunID: "run-id"}
+			},
+			HandleNamespaceEntry: func() *namespace.Namespace {
+				return namespace.NewNamespaceForTest(&persistencespb.NamespaceInfo{Name: "ns-name"}, nil, false, nil, 0)
+			},
+			GoCtx: context.WithValue(context.Background(), OperationContextKey, &OperationContext{
+				MetricTagConfig: dynamicconfig.GetTypedPropertyFn(NexusMetricTagConfig{}),
+			}),
+		},
+	}
+}
+
+func newTestFanOut(
+	t *testing.T,
+	ctx *chasm.MockMutableContext,
+	inputCount int,
+	policy nexusoperationpb.FanOutCompletionPolicy,
+	quorum int32,
+	maxConcurrency int32,
+) *FanOut {
+	t.Helper()
+	inputs := make([]*commonpb.Payload, inputCount)
+	for i := range inputs {
+		inputs[i] = payload.EncodeString("input")
+	}
+	f, err := newFanOut(ctx, &nexusoperationpb.StartNexusFanOutRequest{
+		NamespaceId: "ns-id",
+		EndpointId:  "endpoint-id",
+		FrontendRequest: &nexusoperationpb.StartNexusFanOutExecutionRequest{
+			Namespace:        "ns-name",
+			FanOutId:         "fan-out-id",
+			RequestId:        "request-id",
+			Endpoint:         "test-endpoint",
+			Service:          "test-service",
+			Operation:        "test-operation",
+			Inputs:           inputs,
+			NexusHeader:      map[string]string{"key": "value"},
+			CompletionPolicy: policy,
+			Quorum:           quorum,
+			MaxConcurrency:   maxConcurrency,
+		},
+		CompletionCallbacks: []*commonpb.Callback{
+			{
+				Variant: &commonpb.Callback_Nexus_{
+					Nexus: &commonpb.Callback_Nexus{Url: "http://caller.invalid/callback"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return f
+}
+
+func inFlightIndexes(f *FanOut) []int32 {
+	return slices.Sorted(maps.Keys(f.Operations))
+}
+
+func completeFanOutOperation(t *testing.T, ctx *chasm.MockMutableContext, f *FanOut, index int32) {
+	t.Helper()
+	op := f.Operations[index].Get(ctx)
+	require.NoError(t, f.OnNexusOperationCompleted(ctx, op, payload.EncodeString("result"), nil))
+}
+
+func failFanOutOperation(t *testing.T, ctx *chasm.MockMutableContext, f *FanOut, index int32) {
+	t.Helper()
+	op := f.Operations[index].Get(ctx)
+	require.NoError(t, f.OnNexusOperationFailed(ctx, op, &failurepb.Failure{Message: "operation failed"}))
+}
+
+func TestFanOut_SchedulesWithBoundedConcurrency(t *testing.T) {
+	ctx := newFanOutTestContext()
+	f := newTestFanOut(t, ctx, 4, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 2)
+
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+	require.Equal(t, int32(4), f.RequiredSuccesses)
+	require.Equal(t, int32(2), f.ScheduledCount)
+	require.Equal(t, []int32{0, 1}, inFlightIndexes(f))
+	for _, index := range inFlightIndexes(f) {
+		op := f.Operations[index].Get(ctx)
+		require.Equal(t, nexusoperationpb.OPERATION_STATUS_SCHEDULED, op.Status)
+		require.Equal(t, "test-endpoint", op.Endpoint)
+	}
+
+	// Each completion frees a slot for the next input.
+	completeFanOutOperation(t, ctx, f, 1)
+	require.Equal(t, []int32{0, 2}, inFlightIndexes(f))
+	completeFanOutOperation(t, ctx, f, 0)
+	require.Equal(t, []int32{2, 3}, inFlightIndexes(f))
+	completeFanOutOperation(t, ctx, f, 2)
+	require.Equal(t, []int32{3}, inFlightIndexes(f))
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+
+	completeFanOutOperation(t, ctx, f, 3)
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED, f.Status)
+	require.Equal(t, int32(4), f.SucceededCount)
+	require.Empty(t, f.Operations)
+	require.Equal(t, defaultTime, f.CloseTime.AsTime())
+
+	results := f.buildResults(ctx).GetResults()
+	require.Len(t, results, 4)
+	for i, result := range results {
+		require.Equal(t, int32(i), result.GetIndex())
+		require.Equal(t, nexusoperationpb.OPERATION_STATUS_SUCCEEDED, result.GetStatus())
+		protorequire.ProtoEqual(t, payload.EncodeString("result"), result.GetResult())
+	}
+}
+
+func TestFanOut_CompletionPolicies(t *testing.T) {
+	t.Run("AllFailsOnFirstFailure", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 3, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 3)
+
+		failFanOutOperation(t, ctx, f, 1)
+
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_FAILED, f.Status)
+		require.Equal(t, fanOutFailureType, f.Failure.GetApplicationFailureInfo().GetType())
+		require.Equal(t, "operation failed", f.Failure.GetCause().GetMessage())
+		// Operations still in flight are canceled.
+		require.Equal(t, []int32{0, 2}, inFlightIndexes(f))
+		for _, index := range inFlightIndexes(f) {
+			_, ok := f.Operations[index].Get(ctx).Cancellation.TryGet(ctx)
+			require.True(t, ok)
+		}
+	})
+
+	t.Run("AnySucceedsOnFirstSuccess", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 3, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY, 0, 3)
+
+		failFanOutOperation(t, ctx, f, 0)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+		completeFanOutOperation(t, ctx, f, 2)
+
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED, f.Status)
+		require.Equal(t, int32(1), f.SucceededCount)
+		require.Equal(t, int32(1), f.FailedCount)
+	})
+
+	t.Run("AnyFailsWhenAllFail", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 2, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY, 0, 2)
+
+		failFanOutOperation(t, ctx, f, 0)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+		failFanOutOperation(t, ctx, f, 1)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_FAILED, f.Status)
+	})
+
+	t.Run("QuorumSucceeds", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 3, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM, 2, 3)
+
+		failFanOutOperation(t, ctx, f, 0)
+		completeFanOutOperation(t, ctx, f, 1)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+		completeFanOutOperation(t, ctx, f, 2)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED, f.Status)
+	})
+
+	t.Run("QuorumFailsWhenUnreachable", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 3, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM, 2, 3)
+
+		failFanOutOperation(t, ctx, f, 0)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_RUNNING, f.Status)
+		failFanOutOperation(t, ctx, f, 2)
+		require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_FAILED, f.Status)
+	})
+}
+
+func TestFanOut_OperationClosedAfterFanOutClosed(t *testing.T) {
+	ctx := newFanOutTestContext()
+	f := newTestFanOut(t, ctx, 2, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY, 0, 2)
+
+	completeFanOutOperation(t, ctx, f, 0)
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED, f.Status)
+
+	// The remaining operation acknowledges the cancellation. It is removed without affecting the outcome.
+	op := f.Operations[1].Get(ctx)
+	require.NoError(t, f.OnNexusOperationCanceled(ctx, op, &failurepb.Failure{
+		Message:     "canceled",
+		FailureInfo: &failurepb.Failure_CanceledFailureInfo{CanceledFailureInfo: &failurepb.CanceledFailureInfo{}},
+	}))
+	require.Empty(t, f.Operations)
+	require.Equal(t, int32(0), f.FailedCount)
+	require.Len(t, f.buildResults(ctx).GetResults(), 1)
+}
+
+func TestFanOut_RequestCancel(t *testing.T) {
+	ctx := newFanOutTestContext()
+	f := newTestFanOut(t, ctx, 2, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 2)
+
+	req := &nexusoperationpb.RequestCancelNexusFanOutExecutionRequest{
+		RequestId: "cancel-request-id",
+		Identity:  "identity",
+		Reason:    "no longer needed",
+	}
+	require.NoError(t, f.RequestCancel(ctx, req))
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_CANCELED, f.Status)
+	require.Equal(t, "identity", f.Failure.GetCanceledFailureInfo().GetIdentity())
+	for _, index := range inFlightIndexes(f) {
+		cancellation, ok := f.Operations[index].Get(ctx).Cancellation.TryGet(ctx)
+		require.True(t, ok)
+		require.Equal(t, "no longer needed", cancellation.GetReason())
+	}
+
+	// Retries of the same request are deduplicated.
+	require.NoError(t, f.RequestCancel(ctx, req))
+	require.ErrorIs(t, f.RequestCancel(ctx, &nexusoperationpb.RequestCancelNexusFanOutExecutionRequest{
+		RequestId: "other-request-id",
+	}), ErrFanOutAlreadyClosed)
+}
+
+func TestFanOut_Terminate(t *testing.T) {
+	ctx := newFanOutTestContext()
+	f := newTestFanOut(t, ctx, 3, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 2)
+	ops := []*Operation{f.Operations[0].Get(ctx), f.Operations[1].Get(ctx)}
+
+	req := chasm.TerminateComponentRequest{RequestID: "terminate-request-id", Reason: "terminated"}
+	_, err := f.Terminate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, nexusoperationpb.FAN_OUT_STATUS_TERMINATED, f.Status)
+	require.Empty(t, f.Operations)
+	for _, op := range ops {
+		require.Equal(t, nexusoperationpb.OPERATION_STATUS_TERMINATED, op.Status)
+	}
+
+	_, err = f.Terminate(ctx, req)
+	require.NoError(t, err)
+	_, err = f.Terminate(ctx, chasm.TerminateComponentRequest{RequestID: "other-request-id"})
+	require.Error(t, err)
+}
+
+func TestFanOut_NexusOperationInvocationData(t *testing.T) {
+	ctx := newFanOutTestContext()
+	f := newTestFanOut(t, ctx, 2, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 2)
+	f.RequestData.Get(ctx).Inputs[1] = payload.EncodeString("second")
+
+	data, err := f.NexusOperationInvocationData(ctx, f.Operations[1].Get(ctx))
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, payload.EncodeString("second"), data.Input)
+	require.Equal(t, map[string]string{"key": "value"}, data.Header)
+	require.Equal(t, fanOutWorkflowTypeName, f.WorkflowTypeName())
+}
+
+func TestFanOut_GetNexusCompletion(t *testing.T) {
+	t.Run("Succeeded", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 1, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 1)
+		completeFanOutOperation(t, ctx, f, 0)
+
+		completion, err := f.GetNexusCompletion(ctx, "request-id")
+		require.NoError(t, err)
+		var results nexusoperationpb.FanOutResults
+		require.NoError(t, sdkconverter.PreferProtoDataConverter.FromPayload(completion.Result.(*commonpb.Payload), &results))
+		require.Len(t, results.GetResults(), 1)
+		require.Nil(t, completion.Error)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 1, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 1)
+		require.NoError(t, f.RequestCancel(ctx, &nexusoperationpb.RequestCancelNexusFanOutExecutionRequest{
+			RequestId: "cancel-request-id",
+		}))
+
+		completion, err := f.GetNexusCompletion(ctx, "request-id")
+		require.NoError(t, err)
+		require.NotNil(t, completion.Error)
+		require.Equal(t, nexus.OperationStateCanceled, completion.Error.State)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		ctx := newFanOutTestContext()
+		f := newTestFanOut(t, ctx, 1, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, 0, 1)
+		_, err := f.GetNexusCompletion(ctx, "request-id")
+		require.Error(t, err)
+	})
+}
+
+func TestFanOutOperationToken_RoundTrip(t *testing.T) {
+	token := &nexusoperationpb.FanOutOperationToken{
+		NamespaceId: "ns-id",
+		FanOutId:    "fan-out-id",
+		RunId:       "run-id",
+	}
+	encoded, err := encodeFanOutOperationToken(token)
+	require.NoError(t, err)
+	decoded, err := decodeFanOutOperationToken(encoded)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, token, decoded)
+
+	_, err = decodeFanOutOperationToken("not a token")
+	require.Error(t, err)
+}
@@ -29,11 +29,18 @@ type FrontendHandler interface {
 	RequestCancelNexusOperationExecution(context.Context, *workflowservice.RequestCancelNexusOperationExecutionRequest) (*workflowservice.RequestCancelNexusOperationExecutionResponse, error)
 	TerminateNexusOperationExecution(context.Context, *workflowservice.TerminateNexusOperationExecutionRequest) (*workflowservice.TerminateNexusOperationExecutionResponse, error)
 	DeleteNexusOperationExecution(context.Context, *workflowservice.DeleteNexusOperationExecutionRequest) (*workflowservice.DeleteNexusOperationExecutionResponse, error)
+
+	// The fan-out APIs are served by the frontend as the NexusFanOutService.
+	nexusoperationpb.NexusFanOutServiceServer
 }
 
 var ErrStandaloneNexusOperationDisabled = serviceerror.NewUnimplemented("Standalone Nexus operation is disabled")
 
+var ErrNexusFanOutDisabled = serviceerror.NewUnimplemented("Nexus operation fan-out is disabled")
+
 type frontendHandler struct {
+	nexusoperationpb.UnimplementedNexusFanOutServiceServer
+
 	client            nexusoperationpb.NexusOperationServiceClient
 	config            *Config
 	namespaceRegistry namespace.Registry
@@ -315,7 +322,144 @@ func (h *frontendHandler) DeleteNexusOperationExecution(
 	return &workflowservice.DeleteNexusOperationExecutionResponse{}, nil
 }
 
+func (h *frontendHandler) StartNexusFanOutExecution(
+	ctx context.Context,
+	req *nexusoperationpb.StartNexusFanOutExecutionRequest,
+) (*nexusoperationpb.StartNexusFanOutExecutionResponse, error) {
+	if !h.isNexusFanOutEnabled(req.GetNamespace()) {
+		return nil, ErrNexusFanOutDisabled
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.validateAndNormalizeStartFanOutRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Verify the endpoint exists before creating the fan-out.
+	endpointEntry, err := h.endpointRegistry.GetByName(ctx, namespaceID, req.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.StartNexusFanOut(ctx, &nexusoperationpb.StartNexusFanOutRequest{
+		NamespaceId:     namespaceID.String(),
+		EndpointId:      endpointEntry.GetId(),
+		FrontendRequest: req,
+	})
+	return resp.GetFrontendResponse(), err
+}
+
+func (h *frontendHandler) DescribeNexusFanOutExecution(
+	ctx context.Context,
+	req *nexusoperationpb.DescribeNexusFanOutExecutionRequest,
+) (*nexusoperationpb.DescribeNexusFanOutExecutionResponse, error) {
+	if !h.isNexusFanOutEnabled(req.GetNamespace()) {
+		return nil, ErrNexusFanOutDisabled
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.validateAndNormalizeDescribeFanOutRequest(req, namespaceID.String()); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.DescribeNexusFanOut(ctx, &nexusoperationpb.DescribeNexusFanOutRequest{
+		NamespaceId:     namespaceID.String(),
+		FrontendRequest: req,
+	})
+	return resp.GetFrontendResponse(), err
+}
+
+// PollNexusFanOutExecution long-polls for a fan-out to close.
+func (h *frontendHandler) PollNexusFanOutExecution(
+	ctx context.Context,
+	req *nexusoperationpb.PollNexusFanOutExecutionRequest,
+) (*nexusoperationpb.PollNexusFanOutExecutionResponse, error) {
+	if !h.isNexusFanOutEnabled(req.GetNamespace()) {
+		return nil, ErrNexusFanOutDisabled
+	}
+
+	if err := h.validator.validateAndNormalizePollFanOutRequest(req); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.PollNexusFanOut(ctx, &nexusoperationpb.PollNexusFanOutRequest{
+		NamespaceId:     namespaceID.String(),
+		FrontendRequest: req,
+	})
+	return resp.GetFrontendResponse(), err
+}
+
+func (h *frontendHandler) RequestCancelNexusFanOutExecution(
+	ctx context.Context,
+	req *nexusoperationpb.RequestCancelNexusFanOutExecutionRequest,
+) (*nexusoperationpb.RequestCancelNexusFanOutExecutionResponse, error) {
+	if !h.isNexusFanOutEnabled(req.GetNamespace()) {
+		return nil, ErrNexusFanOutDisabled
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.validateAndNormalizeCancelFanOutRequest(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := h.client.RequestCancelNexusFanOut(ctx, &nexusoperationpb.RequestCancelNexusFanOutRequest{
+		NamespaceId:     namespaceID.String(),
+		FrontendRequest: req,
+	}); err != nil {
+		return nil, err
+	}
+	return &nexusoperationpb.RequestCancelNexusFanOutExecutionResponse{}, nil
+}
+
+func (h *frontendHandler) TerminateNexusFanOutExecution(
+	ctx context.Context,
+	req *nexusoperationpb.TerminateNexusFanOutExecutionRequest,
+) (*nexusoperationpb.TerminateNexusFanOutExecutionResponse, error) {
+	if !h.isNexusFanOutEnabled(req.GetNamespace()) {
+		return nil, ErrNexusFanOutDisabled
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(req.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validator.validateAndNormalizeTerminateFanOutRequest(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := h.client.TerminateNexusFanOut(ctx, &nexusoperationpb.TerminateNexusFanOutRequest{
+		NamespaceId:     namespaceID.String(),
+		FrontendRequest: req,
+	}); err != nil {
+		return nil, err
+	}
+	return &nexusoperationpb.TerminateNexusFanOutExecutionResponse{}, nil
+}
+
 // isStandaloneNexusOperationEnabled checks if standalone Nexus operations are enabled for the given namespace.
 func (h *frontendHandler) isStandaloneNexusOperationEnabled(namespaceName string) bool {
 	return h.config.EnableChasm(namespaceName) && h.config.Enabled(namespaceName)
 }
+
+// isNexusFanOutEnabled checks if Nexus operation fan-outs are enabled for the given namespace.
+func (h *frontendHandler) isNexusFanOutEnabled(namespaceName string) bool {
+	return h.isStandaloneNexusOperationEnabled(namespaceName) && h.config.EnableFanOut(namespaceName)
+}
@@ -0,0 +1,262 @@
+package nexusoperation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/testing/temporalapi"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+const (
+	testFanOutNamespace   = "test-namespace"
+	testFanOutNamespaceID = namespace.ID("test-namespace-id")
+	testFanOutEndpointID  = "test-endpoint-id"
+)
+
+// fanOutHistoryClient records the fan-out requests the frontend handler forwards to history.
+type fanOutHistoryClient struct {
+	nexusoperationpb.NexusOperationServiceClient
+
+	requests []any
+}
+
+func (c *fanOutHistoryClient) StartNexusFanOut(_ context.Context, req *nexusoperationpb.StartNexusFanOutRequest, _ ...grpc.CallOption) (*nexusoperationpb.StartNexusFanOutResponse, error) {
+	c.requests = append(c.requests, req)
+	return &nexusoperationpb.StartNexusFanOutResponse{
+		FrontendResponse: &nexusoperationpb.StartNexusFanOutExecutionResponse{RunId: "run-id", Started: true},
+	}, nil
+}
+
+func (c *fanOutHistoryClient) DescribeNexusFanOut(_ context.Context, req *nexusoperationpb.DescribeNexusFanOutRequest, _ ...grpc.CallOption) (*nexusoperationpb.DescribeNexusFanOutResponse, error) {
+	c.requests = append(c.requests, req)
+	return &nexusoperationpb.DescribeNexusFanOutResponse{
+		FrontendResponse: &nexusoperationpb.DescribeNexusFanOutExecutionResponse{RunId: "run-id"},
+	}, nil
+}
+
+func (c *fanOutHistoryClient) PollNexusFanOut(_ context.Context, req *nexusoperationpb.PollNexusFanOutRequest, _ ...grpc.CallOption) (*nexusoperationpb.PollNexusFanOutResponse, error) {
+	c.requests = append(c.requests, req)
+	return &nexusoperationpb.PollNexusFanOutResponse{
+		FrontendResponse: &nexusoperationpb.PollNexusFanOutExecutionResponse{
+			RunId:  "run-id",
+			Status: nexusoperationpb.FAN_OUT_STATUS_SUCCEEDED,
+		},
+	}, nil
+}
+
+func (c *fanOutHistoryClient) RequestCancelNexusFanOut(_ context.Context, req *nexusoperationpb.RequestCancelNexusFanOutRequest, _ ...grpc.CallOption) (*nexusoperationpb.RequestCancelNexusFanOutResponse, error) {
+	c.requests = append(c.requests, req)
+	return &nexusoperationpb.RequestCancelNexusFanOutResponse{}, nil
+}
+
+func (c *fanOutHistoryClient) TerminateNexusFanOut(_ context.Context, req *nexusoperationpb.TerminateNexusFanOutRequest, _ ...grpc.CallOption) (*nexusoperationpb.TerminateNexusFanOutResponse, error) {
+	c.requests = append(c.requests, req)
+	return &nexusoperationpb.TerminateNexusFanOutResponse{}, nil
+}
+
+type staticEndpointRegistry struct {
+	commonnexus.EndpointRegistry
+}
+
+func (staticEndpointRegistry) GetByName(_ context.Context, _ namespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
+	if endpointName != "endpoint" {
+		return nil, serviceerror.NewNotFoundf("endpoint not registered: %q", endpointName)
+	}
+	return &persistencespb.NexusEndpointEntry{Id: testFanOutEndpointID}, nil
+}
+
+func newTestFanOutFrontendHandler(t *testing.T, fanOutEnabled bool) (*frontendHandler, *fanOutHistoryClient) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name(testFanOutNamespace)).Return(testFanOutNamespaceID, nil).AnyTimes()
+
+	config := &Config{
+		Enabled:                            dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		EnableChasm:                        dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		EnableFanOut:                       dynamicconfig.GetBoolPropertyFnFilteredByNamespace(fanOutEnabled),
+		MaxIDLengthLimit:                   func() int { return 50 },
+		MaxReasonLength:                    func(string) int { return 20 },
+		MaxServiceNameLength:               func(string) int { return 10 },
+		MaxOperationNameLength:             func(string) int { return 10 },
+		PayloadSizeLimit:                   func(string) int { return 100 },
+		MaxOperationHeaderSize:             func(string) int { return 10 },
+		DisallowedOperationHeaders:         func() []string { return nil },
+		MaxOperationScheduleToCloseTimeout: func(string) time.Duration { return time.Hour },
+		MaxFanOutOperations:                func(string) int { return 3 },
+		MaxFanOutConcurrency:               func(string) int { return 2 },
+	}
+	client := &fanOutHistoryClient{}
+	return &frontendHandler{
+		client:            client,
+		config:            config,
+		namespaceRegistry: namespaceRegistry,
+		endpointRegistry:  staticEndpointRegistry{},
+		validator:         newTestValidator(config),
+	}, client
+}
+
+func TestNexusFanOutFrontendHandler(t *testing.T) {
+	const runID = "11111111-2222-3333-4444-555555555555"
+
+	for _, tc := range []struct {
+		name string
+		call func(*frontendHandler) (any, error)
+		// check verifies the request forwarded to history.
+		check func(*testing.T, any)
+	}{
+		{
+			name: "start",
+			call: func(h *frontendHandler) (any, error) {
+				return h.StartNexusFanOutExecution(context.Background(), &nexusoperationpb.StartNexusFanOutExecutionRequest{
+					Namespace: testFanOutNamespace,
+					FanOutId:  "fan-out-id",
+					Endpoint:  "endpoint",
+					Service:   "service",
+					Operation: "operation",
+					Inputs:    []*commonpb.Payload{{Data: []byte("a")}, {Data: []byte("b")}},
+				})
+			},
+			check: func(t *testing.T, req any) {
+				startReq := req.(*nexusoperationpb.StartNexusFanOutRequest)
+				require.Equal(t, testFanOutNamespaceID.String(), startReq.GetNamespaceId())
+				require.Equal(t, testFanOutEndpointID, startReq.GetEndpointId())
+				require.NotEmpty(t, startReq.GetFrontendRequest().GetRequestId())
+				require.Equal(t, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, startReq.GetFrontendRequest().GetCompletionPolicy())
+			},
+		},
+		{
+			name: "describe",
+			call: func(h *frontendHandler) (any, error) {
+				return h.DescribeNexusFanOutExecution(context.Background(), &nexusoperationpb.DescribeNexusFanOutExecutionRequest{
+					Namespace: testFanOutNamespace,
+					FanOutId:  "fan-out-id",
+					RunId:     runID,
+				})
+			},
+			check: func(t *testing.T, req any) {
+				describeReq := req.(*nexusoperationpb.DescribeNexusFanOutRequest)
+				require.Equal(t, testFanOutNamespaceID.String(), describeReq.GetNamespaceId())
+				require.Equal(t, "fan-out-id", describeReq.GetFrontendRequest().GetFanOutId())
+			},
+		},
+		{
+			name: "poll",
+			call: func(h *frontendHandler) (any, error) {
+				return h.PollNexusFanOutExecution(context.Background(), &nexusoperationpb.PollNexusFanOutExecutionRequest{
+					Namespace: testFanOutNamespace,
+					FanOutId:  "fan-out-id",
+				})
+			},
+			check: func(t *testing.T, req any) {
+				pollReq := req.(*nexusoperationpb.PollNexusFanOutRequest)
+				require.Equal(t, testFanOutNamespaceID.String(), pollReq.GetNamespaceId())
+				require.Equal(t, "fan-out-id", pollReq.GetFrontendRequest().GetFanOutId())
+			},
+		},
+		{
+			name: "request cancel",
+			call: func(h *frontendHandler) (any, error) {
+				return h.RequestCancelNexusFanOutExecution(context.Background(), &nexusoperationpb.RequestCancelNexusFanOutExecutionRequest{
+					Namespace: testFanOutNamespace,
+					FanOutId:  "fan-out-id",
+					Reason:    "no longer needed",
+				})
+			},
+			check: func(t *testing.T, req any) {
+				cancelReq := req.(*nexusoperationpb.RequestCancelNexusFanOutRequest)
+				require.Equal(t, testFanOutNamespaceID.String(), cancelReq.GetNamespaceId())
+				require.NotEmpty(t, cancelReq.GetFrontendRequest().GetRequestId())
+			},
+		},
+		{
+			name: "terminate",
+			call: func(h *frontendHandler) (any, error) {
+				return h.TerminateNexusFanOutExecution(context.Background(), &nexusoperationpb.TerminateNexusFanOutExecutionRequest{
+					Namespace: testFanOutNamespace,
+					FanOutId:  "fan-out-id",
+					RunId:     runID,
+				})
+			},
+			check: func(t *testing.T, req any) {
+				terminateReq := req.(*nexusoperationpb.TerminateNexusFanOutRequest)
+				require.Equal(t, testFanOutNamespaceID.String(), terminateReq.GetNamespaceId())
+				require.Equal(t, runID, terminateReq.GetFrontendRequest().GetRunId())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("disabled", func(t *testing.T) {
+				h, client := newTestFanOutFrontendHandler(t, false)
+				_, err := tc.call(h)
+				require.ErrorIs(t, err, ErrNexusFanOutDisabled)
+				require.Empty(t, client.requests)
+			})
+
+			t.Run("forwarded to history", func(t *testing.T) {
+				h, client := newTestFanOutFrontendHandler(t, true)
+				resp, err := tc.call(h)
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.Len(t, client.requests, 1)
+				tc.check(t, client.requests[0])
+			})
+		})
+	}
+}
+
+func TestNexusFanOutFrontendHandler_InvalidRequestNotForwarded(t *testing.T) {
+	h, client := newTestFanOutFrontendHandler(t, true)
+
+	_, err := h.StartNexusFanOutExecution(context.Background(), &nexusoperationpb.StartNexusFanOutExecutionRequest{
+		Namespace: testFanOutNamespace,
+		FanOutId:  "fan-out-id",
+		Endpoint:  "unknown",
+		Service:   "service",
+		Operation: "operation",
+		Inputs:    []*commonpb.Payload{{Data: []byte("a")}},
+	})
+	var notFoundErr *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFoundErr)
+
+	_, err = h.PollNexusFanOutExecution(context.Background(), &nexusoperationpb.PollNexusFanOutExecutionRequest{
+		Namespace: testFanOutNamespace,
+		FanOutId:  "fan-out-id",
+		RunId:     "not-a-uuid",
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+
+	_, err = h.TerminateNexusFanOutExecution(context.Background(), &nexusoperationpb.TerminateNexusFanOutExecutionRequest{
+		Namespace: testFanOutNamespace,
+		FanOutId:  "fan-out-id",
+		Reason:    "this reason is longer than the limit",
+	})
+	require.ErrorAs(t, err, &invalidArgErr)
+	require.ErrorContains(t, err, "reason exceeds length limit")
+
+	require.Empty(t, client.requests)
+}
+
+func TestNexusFanOutServiceMetadata(t *testing.T) {
+	var service nexusoperationpb.NexusFanOutServiceServer
+	temporalapi.WalkExportedMethods(&service, func(m reflect.Method) {
+		md := api.GetMethodMetadata(api.NexusFanOutServicePrefix + m.Name)
+		require.Equal(t, api.ScopeNamespace, md.Scope, "missing metadata for API: %v", m.Name)
+		require.NotEqual(t, api.AccessUnknown, md.Access, "missing metadata for API: %v", m.Name)
+	})
+	require.Equal(t, api.PollingAlways, api.GetMethodMetadata(api.NexusFanOutServicePrefix+"PollNexusFanOutExecution").Polling)
+	require.Equal(t, api.PollingCapable, api.GetMethodMetadata(api.NexusFanOutServicePrefix+"DescribeNexusFanOutExecution").Polling)
+}
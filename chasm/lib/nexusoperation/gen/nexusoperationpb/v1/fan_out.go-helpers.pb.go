@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package nexusoperationpb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type FanOutState to the protobuf v3 wire format
+func (val *FanOutState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutState from the protobuf v3 wire format
+func (val *FanOutState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutState
+	switch t := that.(type) {
+	case *FanOutState:
+		that1 = t
+	case FanOutState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FanOutRequestData to the protobuf v3 wire format
+func (val *FanOutRequestData) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutRequestData from the protobuf v3 wire format
+func (val *FanOutRequestData) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutRequestData) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutRequestData values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutRequestData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutRequestData
+	switch t := that.(type) {
+	case *FanOutRequestData:
+		that1 = t
+	case FanOutRequestData:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FanOutOperationResult to the protobuf v3 wire format
+func (val *FanOutOperationResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutOperationResult from the protobuf v3 wire format
+func (val *FanOutOperationResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutOperationResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutOperationResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutOperationResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutOperationResult
+	switch t := that.(type) {
+	case *FanOutOperationResult:
+		that1 = t
+	case FanOutOperationResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FanOutResults to the protobuf v3 wire format
+func (val *FanOutResults) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutResults from the protobuf v3 wire format
+func (val *FanOutResults) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutResults) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutResults values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutResults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutResults
+	switch t := that.(type) {
+	case *FanOutResults:
+		that1 = t
+	case FanOutResults:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FanOutOperationParentData to the protobuf v3 wire format
+func (val *FanOutOperationParentData) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutOperationParentData from the protobuf v3 wire format
+func (val *FanOutOperationParentData) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutOperationParentData) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutOperationParentData values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutOperationParentData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutOperationParentData
+	switch t := that.(type) {
+	case *FanOutOperationParentData:
+		that1 = t
+	case FanOutOperationParentData:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FanOutOperationToken to the protobuf v3 wire format
+func (val *FanOutOperationToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FanOutOperationToken from the protobuf v3 wire format
+func (val *FanOutOperationToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FanOutOperationToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FanOutOperationToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FanOutOperationToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FanOutOperationToken
+	switch t := that.(type) {
+	case *FanOutOperationToken:
+		that1 = t
+	case FanOutOperationToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusFanOutExecutionInfo to the protobuf v3 wire format
+func (val *NexusFanOutExecutionInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusFanOutExecutionInfo from the protobuf v3 wire format
+func (val *NexusFanOutExecutionInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusFanOutExecutionInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusFanOutExecutionInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusFanOutExecutionInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusFanOutExecutionInfo
+	switch t := that.(type) {
+	case *NexusFanOutExecutionInfo:
+		that1 = t
+	case NexusFanOutExecutionInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+var (
+	FanOutStatus_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Running":     1,
+		"Succeeded":   2,
+		"Failed":      3,
+		"Canceled":    4,
+		"Terminated":  5,
+	}
+)
+
+// FanOutStatusFromString parses a FanOutStatus value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to FanOutStatus
+func FanOutStatusFromString(s string) (FanOutStatus, error) {
+	if v, ok := FanOutStatus_value[s]; ok {
+		return FanOutStatus(v), nil
+	} else if v, ok := FanOutStatus_shorthandValue[s]; ok {
+		return FanOutStatus(v), nil
+	}
+	return FanOutStatus(0), fmt.Errorf("%s is not a valid FanOutStatus", s)
+}
+
+var (
+	FanOutCompletionPolicy_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"All":         1,
+		"Any":         2,
+		"Quorum":      3,
+	}
+)
+
+// FanOutCompletionPolicyFromString parses a FanOutCompletionPolicy value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to FanOutCompletionPolicy
+func FanOutCompletionPolicyFromString(s string) (FanOutCompletionPolicy, error) {
+	if v, ok := FanOutCompletionPolicy_value[s]; ok {
+		return FanOutCompletionPolicy(v), nil
+	} else if v, ok := FanOutCompletionPolicy_shorthandValue[s]; ok {
+		return FanOutCompletionPolicy(v), nil
+	}
+	return FanOutCompletionPolicy(0), fmt.Errorf("%s is not a valid FanOutCompletionPolicy", s)
+}
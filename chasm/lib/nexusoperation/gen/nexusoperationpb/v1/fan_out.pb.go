@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/chasm/lib/nexusoperation/proto/v1/fan_out.proto
+
+package nexusoperationpb
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	v11 "go.temporal.io/api/common/v1"
+	v1 "go.temporal.io/api/failure/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FanOutStatus int32
+
+const (
+	FAN_OUT_STATUS_UNSPECIFIED FanOutStatus = 0
+	// Operations are being scheduled or are in flight.
+	FAN_OUT_STATUS_RUNNING FanOutStatus = 1
+	// The completion policy was satisfied.
+	FAN_OUT_STATUS_SUCCEEDED FanOutStatus = 2
+	// Too many operations completed unsuccessfully for the completion policy to be satisfied.
+	FAN_OUT_STATUS_FAILED     FanOutStatus = 3
+	FAN_OUT_STATUS_CANCELED   FanOutStatus = 4
+	FAN_OUT_STATUS_TERMINATED FanOutStatus = 5
+)
+
+// Enum value maps for FanOutStatus.
+var (
+	FanOutStatus_name = map[int32]string{
+		0: "FAN_OUT_STATUS_UNSPECIFIED",
+		1: "FAN_OUT_STATUS_RUNNING",
+		2: "FAN_OUT_STATUS_SUCCEEDED",
+		3: "FAN_OUT_STATUS_FAILED",
+		4: "FAN_OUT_STATUS_CANCELED",
+		5: "FAN_OUT_STATUS_TERMINATED",
+	}
+	FanOutStatus_value = map[string]int32{
+		"FAN_OUT_STATUS_UNSPECIFIED": 0,
+		"FAN_OUT_STATUS_RUNNING":     1,
+		"FAN_OUT_STATUS_SUCCEEDED":   2,
+		"FAN_OUT_STATUS_FAILED":      3,
+		"FAN_OUT_STATUS_CANCELED":    4,
+		"FAN_OUT_STATUS_TERMINATED":  5,
+	}
+)
+
+func (x FanOutStatus) Enum() *FanOutStatus {
+	p := new(FanOutStatus)
+	*p = x
+	return p
+}
+
+func (x FanOutStatus) String() string {
+	switch x {
+	case FAN_OUT_STATUS_UNSPECIFIED:
+		return "Unspecified"
+	case FAN_OUT_STATUS_RUNNING:
+		return "Running"
+	case FAN_OUT_STATUS_SUCCEEDED:
+		return "Succeeded"
+	case FAN_OUT_STATUS_FAILED:
+		return "Failed"
+	case FAN_OUT_STATUS_CANCELED:
+		return "Canceled"
+	case FAN_OUT_STATUS_TERMINATED:
+		return "Terminated"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (FanOutStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes[0].Descriptor()
+}
+
+func (FanOutStatus) Type() protoreflect.EnumType {
+	return &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes[0]
+}
+
+func (x FanOutStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FanOutStatus.Descriptor instead.
+func (FanOutStatus) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{0}
+}
+
+type FanOutCompletionPolicy int32
+
+const (
+	FAN_OUT_COMPLETION_POLICY_UNSPECIFIED FanOutCompletionPolicy = 0
+	// Succeed once all operations succeed. Fail as soon as one operation completes unsuccessfully.
+	FAN_OUT_COMPLETION_POLICY_ALL FanOutCompletionPolicy = 1
+	// Succeed as soon as one operation succeeds. Fail once all operations completed unsuccessfully.
+	FAN_OUT_COMPLETION_POLICY_ANY FanOutCompletionPolicy = 2
+	// Succeed as soon as the requested quorum of operations succeeds. Fail once the quorum can no longer be reached.
+	FAN_OUT_COMPLETION_POLICY_QUORUM FanOutCompletionPolicy = 3
+)
+
+// Enum value maps for FanOutCompletionPolicy.
+var (
+	FanOutCompletionPolicy_name = map[int32]string{
+		0: "FAN_OUT_COMPLETION_POLICY_UNSPECIFIED",
+		1: "FAN_OUT_COMPLETION_POLICY_ALL",
+		2: "FAN_OUT_COMPLETION_POLICY_ANY",
+		3: "FAN_OUT_COMPLETION_POLICY_QUORUM",
+	}
+	FanOutCompletionPolicy_value = map[string]int32{
+		"FAN_OUT_COMPLETION_POLICY_UNSPECIFIED": 0,
+		"FAN_OUT_COMPLETION_POLICY_ALL":         1,
+		"FAN_OUT_COMPLETION_POLICY_ANY":         2,
+		"FAN_OUT_COMPLETION_POLICY_QUORUM":      3,
+	}
+)
+
+func (x FanOutCompletionPolicy) Enum() *FanOutCompletionPolicy {
+	p := new(FanOutCompletionPolicy)
+	*p = x
+	return p
+}
+
+func (x FanOutCompletionPolicy) String() string {
+	switch x {
+	case FAN_OUT_COMPLETION_POLICY_UNSPECIFIED:
+		return "Unspecified"
+	case FAN_OUT_COMPLETION_POLICY_ALL:
+		return "All"
+	case FAN_OUT_COMPLETION_POLICY_ANY:
+		return "Any"
+	case FAN_OUT_COMPLETION_POLICY_QUORUM:
+		return "Quorum"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (FanOutCompletionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes[1].Descriptor()
+}
+
+func (FanOutCompletionPolicy) Type() protoreflect.EnumType {
+	return &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes[1]
+}
+
+func (x FanOutCompletionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FanOutCompletionPolicy.Descriptor instead.
+func (FanOutCompletionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{1}
+}
+
+type FanOutState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current status of the fan-out.
+	Status FanOutStatus `protobuf:"varint,1,opt,name=status,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus" json:"status,omitempty"`
+	// Endpoint ID - used internally to avoid failing requests when endpoint is renamed.
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// Endpoint name - resolved from the endpoint registry for the fan-out's namespace.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Service name.
+	Service string `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	// Operation name. The same operation is started once per input.
+	Operation        string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	CompletionPolicy FanOutCompletionPolicy `protobuf:"varint,6,opt,name=completion_policy,json=completionPolicy,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy" json:"completion_policy,omitempty"`
+	// Number of operations that must succeed for the fan-out to succeed. Derived from the completion policy.
+	RequiredSuccesses int32 `protobuf:"varint,7,opt,name=required_successes,json=requiredSuccesses,proto3" json:"required_successes,omitempty"`
+	// Maximum number of operations that may be in flight at the same time.
+	MaxConcurrency int32 `protobuf:"varint,8,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// Total number of operations, one per input.
+	OperationCount int32 `protobuf:"varint,9,opt,name=operation_count,json=operationCount,proto3" json:"operation_count,omitempty"`
+	// Number of operations scheduled so far. Operations are scheduled in input order, so this is also the index of the
+	// next operation to schedule.
+	ScheduledCount int32 `protobuf:"varint,10,opt,name=scheduled_count,json=scheduledCount,proto3" json:"scheduled_count,omitempty"`
+	// Number of operations that succeeded.
+	SucceededCount int32 `protobuf:"varint,11,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	// Number of operations that completed unsuccessfully (failed, canceled, timed out or terminated).
+	FailedCount int32 `protobuf:"varint,12,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// Timeouts applied to every operation.
+	ScheduleToCloseTimeout *durationpb.Duration `protobuf:"bytes,13,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	ScheduleToStartTimeout *durationpb.Duration `protobuf:"bytes,14,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	StartToCloseTimeout    *durationpb.Duration `protobuf:"bytes,15,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	// The time when the fan-out was started.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time when the fan-out reached a terminal state.
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	// Set when the fan-out closes unsuccessfully.
+	Failure *v1.Failure `protobuf:"bytes,18,opt,name=failure,proto3" json:"failure,omitempty"`
+	// Request ID of the cancel or terminate request that closed the fan-out, used for deduplication.
+	CloseRequestId string `protobuf:"bytes,19,opt,name=close_request_id,json=closeRequestId,proto3" json:"close_request_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FanOutState) Reset() {
+	*x = FanOutState{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutState) ProtoMessage() {}
+
+func (x *FanOutState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutState.ProtoReflect.Descriptor instead.
+func (*FanOutState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FanOutState) GetStatus() FanOutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FAN_OUT_STATUS_UNSPECIFIED
+}
+
+func (x *FanOutState) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *FanOutState) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *FanOutState) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *FanOutState) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *FanOutState) GetCompletionPolicy() FanOutCompletionPolicy {
+	if x != nil {
+		return x.CompletionPolicy
+	}
+	return FAN_OUT_COMPLETION_POLICY_UNSPECIFIED
+}
+
+func (x *FanOutState) GetRequiredSuccesses() int32 {
+	if x != nil {
+		return x.RequiredSuccesses
+	}
+	return 0
+}
+
+func (x *FanOutState) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *FanOutState) GetOperationCount() int32 {
+	if x != nil {
+		return x.OperationCount
+	}
+	return 0
+}
+
+func (x *FanOutState) GetScheduledCount() int32 {
+	if x != nil {
+		return x.ScheduledCount
+	}
+	return 0
+}
+
+func (x *FanOutState) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *FanOutState) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *FanOutState) GetScheduleToCloseTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (x *FanOutState) GetScheduleToStartTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (x *FanOutState) GetStartToCloseTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (x *FanOutState) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *FanOutState) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *FanOutState) GetFailure() *v1.Failure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+func (x *FanOutState) GetCloseRequestId() string {
+	if x != nil {
+		return x.CloseRequestId
+	}
+	return ""
+}
+
+type FanOutRequestData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operation inputs. The operation at index i is started with inputs[i].
+	Inputs []*v11.Payload `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Nexus headers sent with every operation.
+	NexusHeader   map[string]string `protobuf:"bytes,2,rep,name=nexus_header,json=nexusHeader,proto3" json:"nexus_header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Identity      string            `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutRequestData) Reset() {
+	*x = FanOutRequestData{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutRequestData) ProtoMessage() {}
+
+func (x *FanOutRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutRequestData.ProtoReflect.Descriptor instead.
+func (*FanOutRequestData) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FanOutRequestData) GetInputs() []*v11.Payload {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *FanOutRequestData) GetNexusHeader() map[string]string {
+	if x != nil {
+		return x.NexusHeader
+	}
+	return nil
+}
+
+func (x *FanOutRequestData) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// Outcome of a single operation of a fan-out.
+type FanOutOperationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index of the operation's input.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Terminal status of the operation.
+	Status    OperationStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.OperationStatus" json:"status,omitempty"`
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*FanOutOperationResult_Result
+	//	*FanOutOperationResult_Failure
+	Outcome       isFanOutOperationResult_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutOperationResult) Reset() {
+	*x = FanOutOperationResult{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutOperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutOperationResult) ProtoMessage() {}
+
+func (x *FanOutOperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutOperationResult.ProtoReflect.Descriptor instead.
+func (*FanOutOperationResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FanOutOperationResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FanOutOperationResult) GetStatus() OperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OPERATION_STATUS_UNSPECIFIED
+}
+
+func (x *FanOutOperationResult) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *FanOutOperationResult) GetOutcome() isFanOutOperationResult_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *FanOutOperationResult) GetResult() *v11.Payload {
+	if x != nil {
+		if x, ok := x.Outcome.(*FanOutOperationResult_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *FanOutOperationResult) GetFailure() *v1.Failure {
+	if x != nil {
+		if x, ok := x.Outcome.(*FanOutOperationResult_Failure); ok {
+			return x.Failure
+		}
+	}
+	return nil
+}
+
+type isFanOutOperationResult_Outcome interface {
+	isFanOutOperationResult_Outcome()
+}
+
+type FanOutOperationResult_Result struct {
+	Result *v11.Payload `protobuf:"bytes,4,opt,name=result,proto3,oneof"`
+}
+
+type FanOutOperationResult_Failure struct {
+	Failure *v1.Failure `protobuf:"bytes,5,opt,name=failure,proto3,oneof"`
+}
+
+func (*FanOutOperationResult_Result) isFanOutOperationResult_Outcome() {}
+
+func (*FanOutOperationResult_Failure) isFanOutOperationResult_Outcome() {}
+
+// Aggregated results of a fan-out. This is the result of the fan-out when it is started as a Nexus operation.
+type FanOutResults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results of the operations that completed before the fan-out closed, ordered by index.
+	Results       []*FanOutOperationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutResults) Reset() {
+	*x = FanOutResults{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutResults) ProtoMessage() {}
+
+func (x *FanOutResults) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutResults.ProtoReflect.Descriptor instead.
+func (*FanOutResults) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FanOutResults) GetResults() []*FanOutOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Injected by the fan-out into the parent_data of the operations it schedules.
+type FanOutOperationParentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutOperationParentData) Reset() {
+	*x = FanOutOperationParentData{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutOperationParentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutOperationParentData) ProtoMessage() {}
+
+func (x *FanOutOperationParentData) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutOperationParentData.ProtoReflect.Descriptor instead.
+func (*FanOutOperationParentData) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FanOutOperationParentData) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// Token of a fan-out that was started as a Nexus operation.
+type FanOutOperationToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	FanOutId      string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutOperationToken) Reset() {
+	*x = FanOutOperationToken{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutOperationToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutOperationToken) ProtoMessage() {}
+
+func (x *FanOutOperationToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutOperationToken.ProtoReflect.Descriptor instead.
+func (*FanOutOperationToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FanOutOperationToken) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *FanOutOperationToken) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *FanOutOperationToken) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type NexusFanOutExecutionInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FanOutId          string                 `protobuf:"bytes,1,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId             string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Endpoint          string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Service           string                 `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Operation         string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	Status            FanOutStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus" json:"status,omitempty"`
+	CompletionPolicy  FanOutCompletionPolicy `protobuf:"varint,7,opt,name=completion_policy,json=completionPolicy,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy" json:"completion_policy,omitempty"`
+	RequiredSuccesses int32                  `protobuf:"varint,8,opt,name=required_successes,json=requiredSuccesses,proto3" json:"required_successes,omitempty"`
+	MaxConcurrency    int32                  `protobuf:"varint,9,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	OperationCount    int32                  `protobuf:"varint,10,opt,name=operation_count,json=operationCount,proto3" json:"operation_count,omitempty"`
+	ScheduledCount    int32                  `protobuf:"varint,11,opt,name=scheduled_count,json=scheduledCount,proto3" json:"scheduled_count,omitempty"`
+	// Number of operations that are scheduled but have not completed yet.
+	RunningCount         int32                  `protobuf:"varint,12,opt,name=running_count,json=runningCount,proto3" json:"running_count,omitempty"`
+	SucceededCount       int32                  `protobuf:"varint,13,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount          int32                  `protobuf:"varint,14,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	StartTime            *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime            *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Failure              *v1.Failure            `protobuf:"bytes,17,opt,name=failure,proto3" json:"failure,omitempty"`
+	Identity             string                 `protobuf:"bytes,18,opt,name=identity,proto3" json:"identity,omitempty"`
+	StateTransitionCount int64                  `protobuf:"varint,19,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NexusFanOutExecutionInfo) Reset() {
+	*x = NexusFanOutExecutionInfo{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusFanOutExecutionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusFanOutExecutionInfo) ProtoMessage() {}
+
+func (x *NexusFanOutExecutionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusFanOutExecutionInfo.ProtoReflect.Descriptor instead.
+func (*NexusFanOutExecutionInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NexusFanOutExecutionInfo) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetStatus() FanOutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FAN_OUT_STATUS_UNSPECIFIED
+}
+
+func (x *NexusFanOutExecutionInfo) GetCompletionPolicy() FanOutCompletionPolicy {
+	if x != nil {
+		return x.CompletionPolicy
+	}
+	return FAN_OUT_COMPLETION_POLICY_UNSPECIFIED
+}
+
+func (x *NexusFanOutExecutionInfo) GetRequiredSuccesses() int32 {
+	if x != nil {
+		return x.RequiredSuccesses
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetOperationCount() int32 {
+	if x != nil {
+		return x.OperationCount
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetScheduledCount() int32 {
+	if x != nil {
+		return x.ScheduledCount
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetRunningCount() int32 {
+	if x != nil {
+		return x.RunningCount
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *NexusFanOutExecutionInfo) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *NexusFanOutExecutionInfo) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *NexusFanOutExecutionInfo) GetFailure() *v1.Failure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+func (x *NexusFanOutExecutionInfo) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *NexusFanOutExecutionInfo) GetStateTransitionCount() int64 {
+	if x != nil {
+		return x.StateTransitionCount
+	}
+	return 0
+}
+
+var File_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto protoreflect.FileDescriptor
+
+const file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDesc = "" +
+	"\n" +
+	"?temporal/server/chasm/lib/nexusoperation/proto/v1/fan_out.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1aAtemporal/server/chasm/lib/nexusoperation/proto/v1/operation.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/failure/v1/message.proto\"\xa1\b\n" +
+	"\vFanOutState\x12W\n" +
+	"\x06status\x18\x01 \x01(\x0e2?.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatusR\x06status\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12v\n" +
+	"\x11completion_policy\x18\x06 \x01(\x0e2I.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicyR\x10completionPolicy\x12-\n" +
+	"\x12required_successes\x18\a \x01(\x05R\x11requiredSuccesses\x12'\n" +
+	"\x0fmax_concurrency\x18\b \x01(\x05R\x0emaxConcurrency\x12'\n" +
+	"\x0foperation_count\x18\t \x01(\x05R\x0eoperationCount\x12'\n" +
+	"\x0fscheduled_count\x18\n" +
+	" \x01(\x05R\x0escheduledCount\x12'\n" +
+	"\x0fsucceeded_count\x18\v \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\f \x01(\x05R\vfailedCount\x12T\n" +
+	"\x19schedule_to_close_timeout\x18\r \x01(\v2\x19.google.protobuf.DurationR\x16scheduleToCloseTimeout\x12T\n" +
+	"\x19schedule_to_start_timeout\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x16scheduleToStartTimeout\x12N\n" +
+	"\x16start_to_close_timeout\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x13startToCloseTimeout\x129\n" +
+	"\n" +
+	"start_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12:\n" +
+	"\afailure\x18\x12 \x01(\v2 .temporal.api.failure.v1.FailureR\afailure\x12(\n" +
+	"\x10close_request_id\x18\x13 \x01(\tR\x0ecloseRequestId\"\xa2\x02\n" +
+	"\x11FanOutRequestData\x127\n" +
+	"\x06inputs\x18\x01 \x03(\v2\x1f.temporal.api.common.v1.PayloadR\x06inputs\x12x\n" +
+	"\fnexus_header\x18\x02 \x03(\v2U.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData.NexusHeaderEntryR\vnexusHeader\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x1a>\n" +
+	"\x10NexusHeaderEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc8\x02\n" +
+	"\x15FanOutOperationResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12Z\n" +
+	"\x06status\x18\x02 \x01(\x0e2B.temporal.server.chasm.lib.nexusoperation.proto.v1.OperationStatusR\x06status\x129\n" +
+	"\n" +
+	"close_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x129\n" +
+	"\x06result\x18\x04 \x01(\v2\x1f.temporal.api.common.v1.PayloadH\x00R\x06result\x12<\n" +
+	"\afailure\x18\x05 \x01(\v2 .temporal.api.failure.v1.FailureH\x00R\afailureB\t\n" +
+	"\aoutcome\"s\n" +
+	"\rFanOutResults\x12b\n" +
+	"\aresults\x18\x01 \x03(\v2H.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResultR\aresults\"1\n" +
+	"\x19FanOutOperationParentData\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\"n\n" +
+	"\x14FanOutOperationToken\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\"\x93\a\n" +
+	"\x18NexusFanOutExecutionInfo\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x01 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12W\n" +
+	"\x06status\x18\x06 \x01(\x0e2?.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatusR\x06status\x12v\n" +
+	"\x11completion_policy\x18\a \x01(\x0e2I.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicyR\x10completionPolicy\x12-\n" +
+	"\x12required_successes\x18\b \x01(\x05R\x11requiredSuccesses\x12'\n" +
+	"\x0fmax_concurrency\x18\t \x01(\x05R\x0emaxConcurrency\x12'\n" +
+	"\x0foperation_count\x18\n" +
+	" \x01(\x05R\x0eoperationCount\x12'\n" +
+	"\x0fscheduled_count\x18\v \x01(\x05R\x0escheduledCount\x12#\n" +
+	"\rrunning_count\x18\f \x01(\x05R\frunningCount\x12'\n" +
+	"\x0fsucceeded_count\x18\r \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x0e \x01(\x05R\vfailedCount\x129\n" +
+	"\n" +
+	"start_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12:\n" +
+	"\afailure\x18\x11 \x01(\v2 .temporal.api.failure.v1.FailureR\afailure\x12\x1a\n" +
+	"\bidentity\x18\x12 \x01(\tR\bidentity\x124\n" +
+	"\x16state_transition_count\x18\x13 \x01(\x03R\x14stateTransitionCount*\xbf\x01\n" +
+	"\fFanOutStatus\x12\x1e\n" +
+	"\x1aFAN_OUT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16FAN_OUT_STATUS_RUNNING\x10\x01\x12\x1c\n" +
+	"\x18FAN_OUT_STATUS_SUCCEEDED\x10\x02\x12\x19\n" +
+	"\x15FAN_OUT_STATUS_FAILED\x10\x03\x12\x1b\n" +
+	"\x17FAN_OUT_STATUS_CANCELED\x10\x04\x12\x1d\n" +
+	"\x19FAN_OUT_STATUS_TERMINATED\x10\x05*\xaf\x01\n" +
+	"\x16FanOutCompletionPolicy\x12)\n" +
+	"%FAN_OUT_COMPLETION_POLICY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dFAN_OUT_COMPLETION_POLICY_ALL\x10\x01\x12!\n" +
+	"\x1dFAN_OUT_COMPLETION_POLICY_ANY\x10\x02\x12$\n" +
+	" FAN_OUT_COMPLETION_POLICY_QUORUM\x10\x03BVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
+
+var (
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescOnce sync.Once
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescData []byte
+)
+
+func file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescGZIP() []byte {
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescOnce.Do(func() {
+		file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDesc), len(file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDesc)))
+	})
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDescData
+}
+
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_goTypes = []any{
+	(FanOutStatus)(0),                 // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus
+	(FanOutCompletionPolicy)(0),       // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy
+	(*FanOutState)(nil),               // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState
+	(*FanOutRequestData)(nil),         // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData
+	(*FanOutOperationResult)(nil),     // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult
+	(*FanOutResults)(nil),             // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutResults
+	(*FanOutOperationParentData)(nil), // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationParentData
+	(*FanOutOperationToken)(nil),      // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationToken
+	(*NexusFanOutExecutionInfo)(nil),  // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo
+	nil,                               // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData.NexusHeaderEntry
+	(*durationpb.Duration)(nil),       // 10: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+	(*v1.Failure)(nil),                // 12: temporal.api.failure.v1.Failure
+	(*v11.Payload)(nil),               // 13: temporal.api.common.v1.Payload
+	(OperationStatus)(0),              // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.OperationStatus
+}
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_depIdxs = []int32{
+	0,  // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.status:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus
+	1,  // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.completion_policy:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy
+	10, // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.schedule_to_close_timeout:type_name -> google.protobuf.Duration
+	10, // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.schedule_to_start_timeout:type_name -> google.protobuf.Duration
+	10, // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.start_to_close_timeout:type_name -> google.protobuf.Duration
+	11, // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.start_time:type_name -> google.protobuf.Timestamp
+	11, // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.close_time:type_name -> google.protobuf.Timestamp
+	12, // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutState.failure:type_name -> temporal.api.failure.v1.Failure
+	13, // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData.inputs:type_name -> temporal.api.common.v1.Payload
+	9,  // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData.nexus_header:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutRequestData.NexusHeaderEntry
+	14, // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult.status:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.OperationStatus
+	11, // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult.close_time:type_name -> google.protobuf.Timestamp
+	13, // 12: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult.result:type_name -> temporal.api.common.v1.Payload
+	12, // 13: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult.failure:type_name -> temporal.api.failure.v1.Failure
+	4,  // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutResults.results:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult
+	0,  // 15: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo.status:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus
+	1,  // 16: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo.completion_policy:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy
+	11, // 17: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo.start_time:type_name -> google.protobuf.Timestamp
+	11, // 18: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo.close_time:type_name -> google.protobuf.Timestamp
+	12, // 19: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo.failure:type_name -> temporal.api.failure.v1.Failure
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_init() }
+func file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_init() {
+	if File_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto != nil {
+		return
+	}
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_operation_proto_init()
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes[2].OneofWrappers = []any{
+		(*FanOutOperationResult_Result)(nil),
+		(*FanOutOperationResult_Failure)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDesc), len(file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_goTypes,
+		DependencyIndexes: file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_depIdxs,
+		EnumInfos:         file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_enumTypes,
+		MessageInfos:      file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_msgTypes,
+	}.Build()
+	File_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto = out.File
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_goTypes = nil
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_depIdxs = nil
+}
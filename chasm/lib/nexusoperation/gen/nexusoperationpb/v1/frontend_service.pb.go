@@ -0,0 +1,88 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/chasm/lib/nexusoperation/proto/v1/frontend_service.proto
+
+package nexusoperationpb
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto protoreflect.FileDescriptor
+
+const file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_rawDesc = "" +
+	"\n" +
+	"Htemporal/server/chasm/lib/nexusoperation/proto/v1/frontend_service.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1aHtemporal/server/chasm/lib/nexusoperation/proto/v1/request_response.proto2\xb5\b\n" +
+	"\x12NexusFanOutService\x12\xc8\x01\n" +
+	"\x19StartNexusFanOutExecution\x12S.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest\x1aT.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponse\"\x00\x12\xd1\x01\n" +
+	"\x1cDescribeNexusFanOutExecution\x12V.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequest\x1aW.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse\"\x00\x12\xc5\x01\n" +
+	"\x18PollNexusFanOutExecution\x12R.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequest\x1aS.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse\"\x00\x12\xe0\x01\n" +
+	"!RequestCancelNexusFanOutExecution\x12[.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequest\x1a\\.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionResponse\"\x00\x12\xd4\x01\n" +
+	"\x1dTerminateNexusFanOutExecution\x12W.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequest\x1aX.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionResponse\"\x00BVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
+
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_goTypes = []any{
+	(*StartNexusFanOutExecutionRequest)(nil),          // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest
+	(*DescribeNexusFanOutExecutionRequest)(nil),       // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequest
+	(*PollNexusFanOutExecutionRequest)(nil),           // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequest
+	(*RequestCancelNexusFanOutExecutionRequest)(nil),  // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequest
+	(*TerminateNexusFanOutExecutionRequest)(nil),      // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequest
+	(*StartNexusFanOutExecutionResponse)(nil),         // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponse
+	(*DescribeNexusFanOutExecutionResponse)(nil),      // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse
+	(*PollNexusFanOutExecutionResponse)(nil),          // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse
+	(*RequestCancelNexusFanOutExecutionResponse)(nil), // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionResponse
+	(*TerminateNexusFanOutExecutionResponse)(nil),     // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionResponse
+}
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_depIdxs = []int32{
+	0, // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.StartNexusFanOutExecution:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest
+	1, // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.DescribeNexusFanOutExecution:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequest
+	2, // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.PollNexusFanOutExecution:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequest
+	3, // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.RequestCancelNexusFanOutExecution:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequest
+	4, // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.TerminateNexusFanOutExecution:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequest
+	5, // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.StartNexusFanOutExecution:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponse
+	6, // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.DescribeNexusFanOutExecution:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse
+	7, // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.PollNexusFanOutExecution:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse
+	8, // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.RequestCancelNexusFanOutExecution:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionResponse
+	9, // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService.TerminateNexusFanOutExecution:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_init() }
+func file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_init() {
+	if File_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto != nil {
+		return
+	}
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_rawDesc), len(file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_goTypes,
+		DependencyIndexes: file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_depIdxs,
+	}.Build()
+	File_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto = out.File
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_goTypes = nil
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_frontend_service_proto_depIdxs = nil
+}
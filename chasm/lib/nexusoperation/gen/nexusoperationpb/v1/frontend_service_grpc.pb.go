@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// plugins:
+// - protoc-gen-go-grpc
+// - protoc
+// source: temporal/server/chasm/lib/nexusoperation/proto/v1/frontend_service.proto
+
+package nexusoperationpb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NexusFanOutService_StartNexusFanOutExecution_FullMethodName         = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/StartNexusFanOutExecution"
+	NexusFanOutService_DescribeNexusFanOutExecution_FullMethodName      = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/DescribeNexusFanOutExecution"
+	NexusFanOutService_PollNexusFanOutExecution_FullMethodName          = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/PollNexusFanOutExecution"
+	NexusFanOutService_RequestCancelNexusFanOutExecution_FullMethodName = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/RequestCancelNexusFanOutExecution"
+	NexusFanOutService_TerminateNexusFanOutExecution_FullMethodName     = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/TerminateNexusFanOutExecution"
+)
+
+// NexusFanOutServiceClient is the client API for NexusFanOutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NexusFanOutServiceClient interface {
+	// StartNexusFanOutExecution starts one Nexus operation per input against an endpoint and completes once the
+	// completion policy of the fan-out is met.
+	StartNexusFanOutExecution(ctx context.Context, in *StartNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*StartNexusFanOutExecutionResponse, error)
+	// DescribeNexusFanOutExecution describes a fan-out. If a long poll token is set, it long-polls until the fan-out
+	// state changes from the state the token was issued for.
+	DescribeNexusFanOutExecution(ctx context.Context, in *DescribeNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*DescribeNexusFanOutExecutionResponse, error)
+	// PollNexusFanOutExecution long-polls for a fan-out to close.
+	PollNexusFanOutExecution(ctx context.Context, in *PollNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*PollNexusFanOutExecutionResponse, error)
+	// RequestCancelNexusFanOutExecution requests the cancelation of a fan-out and of its running operations.
+	RequestCancelNexusFanOutExecution(ctx context.Context, in *RequestCancelNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*RequestCancelNexusFanOutExecutionResponse, error)
+	// TerminateNexusFanOutExecution terminates a fan-out.
+	TerminateNexusFanOutExecution(ctx context.Context, in *TerminateNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*TerminateNexusFanOutExecutionResponse, error)
+}
+
+type nexusFanOutServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNexusFanOutServiceClient(cc grpc.ClientConnInterface) NexusFanOutServiceClient {
+	return &nexusFanOutServiceClient{cc}
+}
+
+func (c *nexusFanOutServiceClient) StartNexusFanOutExecution(ctx context.Context, in *StartNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*StartNexusFanOutExecutionResponse, error) {
+	out := new(StartNexusFanOutExecutionResponse)
+	err := c.cc.Invoke(ctx, NexusFanOutService_StartNexusFanOutExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusFanOutServiceClient) DescribeNexusFanOutExecution(ctx context.Context, in *DescribeNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*DescribeNexusFanOutExecutionResponse, error) {
+	out := new(DescribeNexusFanOutExecutionResponse)
+	err := c.cc.Invoke(ctx, NexusFanOutService_DescribeNexusFanOutExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusFanOutServiceClient) PollNexusFanOutExecution(ctx context.Context, in *PollNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*PollNexusFanOutExecutionResponse, error) {
+	out := new(PollNexusFanOutExecutionResponse)
+	err := c.cc.Invoke(ctx, NexusFanOutService_PollNexusFanOutExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusFanOutServiceClient) RequestCancelNexusFanOutExecution(ctx context.Context, in *RequestCancelNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*RequestCancelNexusFanOutExecutionResponse, error) {
+	out := new(RequestCancelNexusFanOutExecutionResponse)
+	err := c.cc.Invoke(ctx, NexusFanOutService_RequestCancelNexusFanOutExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusFanOutServiceClient) TerminateNexusFanOutExecution(ctx context.Context, in *TerminateNexusFanOutExecutionRequest, opts ...grpc.CallOption) (*TerminateNexusFanOutExecutionResponse, error) {
+	out := new(TerminateNexusFanOutExecutionResponse)
+	err := c.cc.Invoke(ctx, NexusFanOutService_TerminateNexusFanOutExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NexusFanOutServiceServer is the server API for NexusFanOutService service.
+// All implementations must embed UnimplementedNexusFanOutServiceServer
+// for forward compatibility
+type NexusFanOutServiceServer interface {
+	// StartNexusFanOutExecution starts one Nexus operation per input against an endpoint and completes once the
+	// completion policy of the fan-out is met.
+	StartNexusFanOutExecution(context.Context, *StartNexusFanOutExecutionRequest) (*StartNexusFanOutExecutionResponse, error)
+	// DescribeNexusFanOutExecution describes a fan-out. If a long poll token is set, it long-polls until the fan-out
+	// state changes from the state the token was issued for.
+	DescribeNexusFanOutExecution(context.Context, *DescribeNexusFanOutExecutionRequest) (*DescribeNexusFanOutExecutionResponse, error)
+	// PollNexusFanOutExecution long-polls for a fan-out to close.
+	PollNexusFanOutExecution(context.Context, *PollNexusFanOutExecutionRequest) (*PollNexusFanOutExecutionResponse, error)
+	// RequestCancelNexusFanOutExecution requests the cancelation of a fan-out and of its running operations.
+	RequestCancelNexusFanOutExecution(context.Context, *RequestCancelNexusFanOutExecutionRequest) (*RequestCancelNexusFanOutExecutionResponse, error)
+	// TerminateNexusFanOutExecution terminates a fan-out.
+	TerminateNexusFanOutExecution(context.Context, *TerminateNexusFanOutExecutionRequest) (*TerminateNexusFanOutExecutionResponse, error)
+	mustEmbedUnimplementedNexusFanOutServiceServer()
+}
+
+// UnimplementedNexusFanOutServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNexusFanOutServiceServer struct {
+}
+
+func (UnimplementedNexusFanOutServiceServer) StartNexusFanOutExecution(context.Context, *StartNexusFanOutExecutionRequest) (*StartNexusFanOutExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartNexusFanOutExecution not implemented")
+}
+func (UnimplementedNexusFanOutServiceServer) DescribeNexusFanOutExecution(context.Context, *DescribeNexusFanOutExecutionRequest) (*DescribeNexusFanOutExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNexusFanOutExecution not implemented")
+}
+func (UnimplementedNexusFanOutServiceServer) PollNexusFanOutExecution(context.Context, *PollNexusFanOutExecutionRequest) (*PollNexusFanOutExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollNexusFanOutExecution not implemented")
+}
+func (UnimplementedNexusFanOutServiceServer) RequestCancelNexusFanOutExecution(context.Context, *RequestCancelNexusFanOutExecutionRequest) (*RequestCancelNexusFanOutExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCancelNexusFanOutExecution not implemented")
+}
+func (UnimplementedNexusFanOutServiceServer) TerminateNexusFanOutExecution(context.Context, *TerminateNexusFanOutExecutionRequest) (*TerminateNexusFanOutExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateNexusFanOutExecution not implemented")
+}
+func (UnimplementedNexusFanOutServiceServer) mustEmbedUnimplementedNexusFanOutServiceServer() {}
+
+// UnsafeNexusFanOutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NexusFanOutServiceServer will
+// result in compilation errors.
+type UnsafeNexusFanOutServiceServer interface {
+	mustEmbedUnimplementedNexusFanOutServiceServer()
+}
+
+func RegisterNexusFanOutServiceServer(s grpc.ServiceRegistrar, srv NexusFanOutServiceServer) {
+	s.RegisterService(&NexusFanOutService_ServiceDesc, srv)
+}
+
+func _NexusFanOutService_StartNexusFanOutExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartNexusFanOutExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusFanOutServiceServer).StartNexusFanOutExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusFanOutService_StartNexusFanOutExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusFanOutServiceServer).StartNexusFanOutExecution(ctx, req.(*StartNexusFanOutExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusFanOutService_DescribeNexusFanOutExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNexusFanOutExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusFanOutServiceServer).DescribeNexusFanOutExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusFanOutService_DescribeNexusFanOutExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusFanOutServiceServer).DescribeNexusFanOutExecution(ctx, req.(*DescribeNexusFanOutExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusFanOutService_PollNexusFanOutExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollNexusFanOutExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusFanOutServiceServer).PollNexusFanOutExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusFanOutService_PollNexusFanOutExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusFanOutServiceServer).PollNexusFanOutExecution(ctx, req.(*PollNexusFanOutExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusFanOutService_RequestCancelNexusFanOutExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCancelNexusFanOutExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusFanOutServiceServer).RequestCancelNexusFanOutExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusFanOutService_RequestCancelNexusFanOutExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusFanOutServiceServer).RequestCancelNexusFanOutExecution(ctx, req.(*RequestCancelNexusFanOutExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusFanOutService_TerminateNexusFanOutExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateNexusFanOutExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusFanOutServiceServer).TerminateNexusFanOutExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusFanOutService_TerminateNexusFanOutExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusFanOutServiceServer).TerminateNexusFanOutExecution(ctx, req.(*TerminateNexusFanOutExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NexusFanOutService_ServiceDesc is the grpc.ServiceDesc for NexusFanOutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NexusFanOutService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService",
+	HandlerType: (*NexusFanOutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartNexusFanOutExecution",
+			Handler:    _NexusFanOutService_StartNexusFanOutExecution_Handler,
+		},
+		{
+			MethodName: "DescribeNexusFanOutExecution",
+			Handler:    _NexusFanOutService_DescribeNexusFanOutExecution_Handler,
+		},
+		{
+			MethodName: "PollNexusFanOutExecution",
+			Handler:    _NexusFanOutService_PollNexusFanOutExecution_Handler,
+		},
+		{
+			MethodName: "RequestCancelNexusFanOutExecution",
+			Handler:    _NexusFanOutService_RequestCancelNexusFanOutExecution_Handler,
+		},
+		{
+			MethodName: "TerminateNexusFanOutExecution",
+			Handler:    _NexusFanOutService_TerminateNexusFanOutExecution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/chasm/lib/nexusoperation/proto/v1/frontend_service.proto",
+}
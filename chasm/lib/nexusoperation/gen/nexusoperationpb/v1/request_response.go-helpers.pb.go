@@ -448,3 +448,743 @@ func (this *PollNexusOperationResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type StartNexusFanOutExecutionRequest to the protobuf v3 wire format
+func (val *StartNexusFanOutExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusFanOutExecutionRequest from the protobuf v3 wire format
+func (val *StartNexusFanOutExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusFanOutExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusFanOutExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusFanOutExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusFanOutExecutionRequest
+	switch t := that.(type) {
+	case *StartNexusFanOutExecutionRequest:
+		that1 = t
+	case StartNexusFanOutExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartNexusFanOutExecutionResponse to the protobuf v3 wire format
+func (val *StartNexusFanOutExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusFanOutExecutionResponse from the protobuf v3 wire format
+func (val *StartNexusFanOutExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusFanOutExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusFanOutExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusFanOutExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusFanOutExecutionResponse
+	switch t := that.(type) {
+	case *StartNexusFanOutExecutionResponse:
+		that1 = t
+	case StartNexusFanOutExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusFanOutExecutionRequest to the protobuf v3 wire format
+func (val *DescribeNexusFanOutExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusFanOutExecutionRequest from the protobuf v3 wire format
+func (val *DescribeNexusFanOutExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusFanOutExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusFanOutExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusFanOutExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusFanOutExecutionRequest
+	switch t := that.(type) {
+	case *DescribeNexusFanOutExecutionRequest:
+		that1 = t
+	case DescribeNexusFanOutExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusFanOutExecutionResponse to the protobuf v3 wire format
+func (val *DescribeNexusFanOutExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusFanOutExecutionResponse from the protobuf v3 wire format
+func (val *DescribeNexusFanOutExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusFanOutExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusFanOutExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusFanOutExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusFanOutExecutionResponse
+	switch t := that.(type) {
+	case *DescribeNexusFanOutExecutionResponse:
+		that1 = t
+	case DescribeNexusFanOutExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollNexusFanOutExecutionRequest to the protobuf v3 wire format
+func (val *PollNexusFanOutExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollNexusFanOutExecutionRequest from the protobuf v3 wire format
+func (val *PollNexusFanOutExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollNexusFanOutExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollNexusFanOutExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollNexusFanOutExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollNexusFanOutExecutionRequest
+	switch t := that.(type) {
+	case *PollNexusFanOutExecutionRequest:
+		that1 = t
+	case PollNexusFanOutExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollNexusFanOutExecutionResponse to the protobuf v3 wire format
+func (val *PollNexusFanOutExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollNexusFanOutExecutionResponse from the protobuf v3 wire format
+func (val *PollNexusFanOutExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollNexusFanOutExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollNexusFanOutExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollNexusFanOutExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollNexusFanOutExecutionResponse
+	switch t := that.(type) {
+	case *PollNexusFanOutExecutionResponse:
+		that1 = t
+	case PollNexusFanOutExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RequestCancelNexusFanOutExecutionRequest to the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RequestCancelNexusFanOutExecutionRequest from the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RequestCancelNexusFanOutExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RequestCancelNexusFanOutExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RequestCancelNexusFanOutExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RequestCancelNexusFanOutExecutionRequest
+	switch t := that.(type) {
+	case *RequestCancelNexusFanOutExecutionRequest:
+		that1 = t
+	case RequestCancelNexusFanOutExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RequestCancelNexusFanOutExecutionResponse to the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RequestCancelNexusFanOutExecutionResponse from the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RequestCancelNexusFanOutExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RequestCancelNexusFanOutExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RequestCancelNexusFanOutExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RequestCancelNexusFanOutExecutionResponse
+	switch t := that.(type) {
+	case *RequestCancelNexusFanOutExecutionResponse:
+		that1 = t
+	case RequestCancelNexusFanOutExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TerminateNexusFanOutExecutionRequest to the protobuf v3 wire format
+func (val *TerminateNexusFanOutExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TerminateNexusFanOutExecutionRequest from the protobuf v3 wire format
+func (val *TerminateNexusFanOutExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TerminateNexusFanOutExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TerminateNexusFanOutExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TerminateNexusFanOutExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TerminateNexusFanOutExecutionRequest
+	switch t := that.(type) {
+	case *TerminateNexusFanOutExecutionRequest:
+		that1 = t
+	case TerminateNexusFanOutExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TerminateNexusFanOutExecutionResponse to the protobuf v3 wire format
+func (val *TerminateNexusFanOutExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TerminateNexusFanOutExecutionResponse from the protobuf v3 wire format
+func (val *TerminateNexusFanOutExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TerminateNexusFanOutExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TerminateNexusFanOutExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TerminateNexusFanOutExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TerminateNexusFanOutExecutionResponse
+	switch t := that.(type) {
+	case *TerminateNexusFanOutExecutionResponse:
+		that1 = t
+	case TerminateNexusFanOutExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartNexusFanOutRequest to the protobuf v3 wire format
+func (val *StartNexusFanOutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusFanOutRequest from the protobuf v3 wire format
+func (val *StartNexusFanOutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusFanOutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusFanOutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusFanOutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusFanOutRequest
+	switch t := that.(type) {
+	case *StartNexusFanOutRequest:
+		that1 = t
+	case StartNexusFanOutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartNexusFanOutResponse to the protobuf v3 wire format
+func (val *StartNexusFanOutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusFanOutResponse from the protobuf v3 wire format
+func (val *StartNexusFanOutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusFanOutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusFanOutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusFanOutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusFanOutResponse
+	switch t := that.(type) {
+	case *StartNexusFanOutResponse:
+		that1 = t
+	case StartNexusFanOutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusFanOutRequest to the protobuf v3 wire format
+func (val *DescribeNexusFanOutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusFanOutRequest from the protobuf v3 wire format
+func (val *DescribeNexusFanOutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusFanOutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusFanOutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusFanOutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusFanOutRequest
+	switch t := that.(type) {
+	case *DescribeNexusFanOutRequest:
+		that1 = t
+	case DescribeNexusFanOutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusFanOutResponse to the protobuf v3 wire format
+func (val *DescribeNexusFanOutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusFanOutResponse from the protobuf v3 wire format
+func (val *DescribeNexusFanOutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusFanOutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusFanOutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusFanOutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusFanOutResponse
+	switch t := that.(type) {
+	case *DescribeNexusFanOutResponse:
+		that1 = t
+	case DescribeNexusFanOutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollNexusFanOutRequest to the protobuf v3 wire format
+func (val *PollNexusFanOutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollNexusFanOutRequest from the protobuf v3 wire format
+func (val *PollNexusFanOutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollNexusFanOutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollNexusFanOutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollNexusFanOutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollNexusFanOutRequest
+	switch t := that.(type) {
+	case *PollNexusFanOutRequest:
+		that1 = t
+	case PollNexusFanOutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollNexusFanOutResponse to the protobuf v3 wire format
+func (val *PollNexusFanOutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollNexusFanOutResponse from the protobuf v3 wire format
+func (val *PollNexusFanOutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollNexusFanOutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollNexusFanOutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollNexusFanOutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollNexusFanOutResponse
+	switch t := that.(type) {
+	case *PollNexusFanOutResponse:
+		that1 = t
+	case PollNexusFanOutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RequestCancelNexusFanOutRequest to the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RequestCancelNexusFanOutRequest from the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RequestCancelNexusFanOutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RequestCancelNexusFanOutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RequestCancelNexusFanOutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RequestCancelNexusFanOutRequest
+	switch t := that.(type) {
+	case *RequestCancelNexusFanOutRequest:
+		that1 = t
+	case RequestCancelNexusFanOutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RequestCancelNexusFanOutResponse to the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RequestCancelNexusFanOutResponse from the protobuf v3 wire format
+func (val *RequestCancelNexusFanOutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RequestCancelNexusFanOutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RequestCancelNexusFanOutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RequestCancelNexusFanOutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RequestCancelNexusFanOutResponse
+	switch t := that.(type) {
+	case *RequestCancelNexusFanOutResponse:
+		that1 = t
+	case RequestCancelNexusFanOutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TerminateNexusFanOutRequest to the protobuf v3 wire format
+func (val *TerminateNexusFanOutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TerminateNexusFanOutRequest from the protobuf v3 wire format
+func (val *TerminateNexusFanOutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TerminateNexusFanOutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TerminateNexusFanOutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TerminateNexusFanOutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TerminateNexusFanOutRequest
+	switch t := that.(type) {
+	case *TerminateNexusFanOutRequest:
+		that1 = t
+	case TerminateNexusFanOutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TerminateNexusFanOutResponse to the protobuf v3 wire format
+func (val *TerminateNexusFanOutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TerminateNexusFanOutResponse from the protobuf v3 wire format
+func (val *TerminateNexusFanOutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TerminateNexusFanOutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TerminateNexusFanOutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TerminateNexusFanOutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TerminateNexusFanOutResponse
+	switch t := that.(type) {
+	case *TerminateNexusFanOutResponse:
+		that1 = t
+	case TerminateNexusFanOutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
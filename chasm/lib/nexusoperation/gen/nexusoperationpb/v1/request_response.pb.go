@@ -11,9 +11,12 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v11 "go.temporal.io/api/common/v1"
+	v12 "go.temporal.io/api/failure/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -583,11 +586,1227 @@ func (x *PollNexusOperationResponse) GetFrontendResponse() *v1.PollNexusOperatio
 	return nil
 }
 
+// Starts a fan-out: one Nexus operation per input, all against the same endpoint, service and operation.
+type StartNexusFanOutExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FanOutId  string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	// Used to deduplicate start requests. Generated if empty.
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity  string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	Endpoint  string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Service   string `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
+	Operation string `protobuf:"bytes,7,opt,name=operation,proto3" json:"operation,omitempty"`
+	// One operation is started per input.
+	Inputs []*v11.Payload `protobuf:"bytes,8,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Nexus headers sent with every operation.
+	NexusHeader map[string]string `protobuf:"bytes,9,rep,name=nexus_header,json=nexusHeader,proto3" json:"nexus_header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Defaults to ALL.
+	CompletionPolicy FanOutCompletionPolicy `protobuf:"varint,10,opt,name=completion_policy,json=completionPolicy,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy" json:"completion_policy,omitempty"`
+	// Number of operations that must succeed. Required for the QUORUM policy, must not be set otherwise.
+	Quorum int32 `protobuf:"varint,11,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Maximum number of operations in flight. Defaults to, and is capped by, the namespace limit.
+	MaxConcurrency int32 `protobuf:"varint,12,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// Timeouts applied to every operation.
+	ScheduleToCloseTimeout *durationpb.Duration `protobuf:"bytes,13,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	ScheduleToStartTimeout *durationpb.Duration `protobuf:"bytes,14,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	StartToCloseTimeout    *durationpb.Duration `protobuf:"bytes,15,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StartNexusFanOutExecutionRequest) Reset() {
+	*x = StartNexusFanOutExecutionRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNexusFanOutExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNexusFanOutExecutionRequest) ProtoMessage() {}
+
+func (x *StartNexusFanOutExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNexusFanOutExecutionRequest.ProtoReflect.Descriptor instead.
+func (*StartNexusFanOutExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetInputs() []*v11.Payload {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetNexusHeader() map[string]string {
+	if x != nil {
+		return x.NexusHeader
+	}
+	return nil
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetCompletionPolicy() FanOutCompletionPolicy {
+	if x != nil {
+		return x.CompletionPolicy
+	}
+	return FAN_OUT_COMPLETION_POLICY_UNSPECIFIED
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetScheduleToCloseTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetScheduleToStartTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (x *StartNexusFanOutExecutionRequest) GetStartToCloseTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StartToCloseTimeout
+	}
+	return nil
+}
+
+type StartNexusFanOutExecutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// False if an execution with the same request ID already existed.
+	Started       bool `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNexusFanOutExecutionResponse) Reset() {
+	*x = StartNexusFanOutExecutionResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNexusFanOutExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNexusFanOutExecutionResponse) ProtoMessage() {}
+
+func (x *StartNexusFanOutExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNexusFanOutExecutionResponse.ProtoReflect.Descriptor instead.
+func (*StartNexusFanOutExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartNexusFanOutExecutionResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutExecutionResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+type DescribeNexusFanOutExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FanOutId  string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId     string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// If set, the request long-polls until the fan-out state changes from the state the token was issued for.
+	LongPollToken []byte `protobuf:"bytes,4,opt,name=long_poll_token,json=longPollToken,proto3" json:"long_poll_token,omitempty"`
+	// Include the results of the operations that completed so far.
+	IncludeResults bool `protobuf:"varint,5,opt,name=include_results,json=includeResults,proto3" json:"include_results,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) Reset() {
+	*x = DescribeNexusFanOutExecutionRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusFanOutExecutionRequest) ProtoMessage() {}
+
+func (x *DescribeNexusFanOutExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusFanOutExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeNexusFanOutExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) GetLongPollToken() []byte {
+	if x != nil {
+		return x.LongPollToken
+	}
+	return nil
+}
+
+func (x *DescribeNexusFanOutExecutionRequest) GetIncludeResults() bool {
+	if x != nil {
+		return x.IncludeResults
+	}
+	return false
+}
+
+type DescribeNexusFanOutExecutionResponse struct {
+	state protoimpl.MessageState    `protogen:"open.v1"`
+	RunId string                    `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Info  *NexusFanOutExecutionInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// Only set if include_results was set in the request. Ordered by index.
+	Results       []*FanOutOperationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	LongPollToken []byte                   `protobuf:"bytes,4,opt,name=long_poll_token,json=longPollToken,proto3" json:"long_poll_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) Reset() {
+	*x = DescribeNexusFanOutExecutionResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusFanOutExecutionResponse) ProtoMessage() {}
+
+func (x *DescribeNexusFanOutExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusFanOutExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeNexusFanOutExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) GetInfo() *NexusFanOutExecutionInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) GetResults() []*FanOutOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DescribeNexusFanOutExecutionResponse) GetLongPollToken() []byte {
+	if x != nil {
+		return x.LongPollToken
+	}
+	return nil
+}
+
+type PollNexusFanOutExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FanOutId      string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollNexusFanOutExecutionRequest) Reset() {
+	*x = PollNexusFanOutExecutionRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollNexusFanOutExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollNexusFanOutExecutionRequest) ProtoMessage() {}
+
+func (x *PollNexusFanOutExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollNexusFanOutExecutionRequest.ProtoReflect.Descriptor instead.
+func (*PollNexusFanOutExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PollNexusFanOutExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PollNexusFanOutExecutionRequest) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *PollNexusFanOutExecutionRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// Empty if the fan-out did not close before the long-poll deadline.
+type PollNexusFanOutExecutionResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RunId  string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status FanOutStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus" json:"status,omitempty"`
+	// Results of the operations that completed before the fan-out closed, ordered by index.
+	Results []*FanOutOperationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	// Set if the fan-out closed unsuccessfully.
+	Failure       *v12.Failure `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollNexusFanOutExecutionResponse) Reset() {
+	*x = PollNexusFanOutExecutionResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollNexusFanOutExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollNexusFanOutExecutionResponse) ProtoMessage() {}
+
+func (x *PollNexusFanOutExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollNexusFanOutExecutionResponse.ProtoReflect.Descriptor instead.
+func (*PollNexusFanOutExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PollNexusFanOutExecutionResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *PollNexusFanOutExecutionResponse) GetStatus() FanOutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FAN_OUT_STATUS_UNSPECIFIED
+}
+
+func (x *PollNexusFanOutExecutionResponse) GetResults() []*FanOutOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PollNexusFanOutExecutionResponse) GetFailure() *v12.Failure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+type RequestCancelNexusFanOutExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FanOutId      string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity      string                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) Reset() {
+	*x = RequestCancelNexusFanOutExecutionRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCancelNexusFanOutExecutionRequest) ProtoMessage() {}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCancelNexusFanOutExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RequestCancelNexusFanOutExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutExecutionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestCancelNexusFanOutExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCancelNexusFanOutExecutionResponse) Reset() {
+	*x = RequestCancelNexusFanOutExecutionResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCancelNexusFanOutExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCancelNexusFanOutExecutionResponse) ProtoMessage() {}
+
+func (x *RequestCancelNexusFanOutExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCancelNexusFanOutExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RequestCancelNexusFanOutExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{19}
+}
+
+type TerminateNexusFanOutExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FanOutId      string                 `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity      string                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) Reset() {
+	*x = TerminateNexusFanOutExecutionRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateNexusFanOutExecutionRequest) ProtoMessage() {}
+
+func (x *TerminateNexusFanOutExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateNexusFanOutExecutionRequest.ProtoReflect.Descriptor instead.
+func (*TerminateNexusFanOutExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutExecutionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TerminateNexusFanOutExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateNexusFanOutExecutionResponse) Reset() {
+	*x = TerminateNexusFanOutExecutionResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateNexusFanOutExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateNexusFanOutExecutionResponse) ProtoMessage() {}
+
+func (x *TerminateNexusFanOutExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateNexusFanOutExecutionResponse.ProtoReflect.Descriptor instead.
+func (*TerminateNexusFanOutExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{21}
+}
+
+type StartNexusFanOutRequest struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	NamespaceId     string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	EndpointId      string                            `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	FrontendRequest *StartNexusFanOutExecutionRequest `protobuf:"bytes,3,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
+	// Callbacks invoked when the fan-out closes. Set when the fan-out is started as a Nexus operation.
+	CompletionCallbacks []*v11.Callback `protobuf:"bytes,4,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartNexusFanOutRequest) Reset() {
+	*x = StartNexusFanOutRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNexusFanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNexusFanOutRequest) ProtoMessage() {}
+
+func (x *StartNexusFanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNexusFanOutRequest.ProtoReflect.Descriptor instead.
+func (*StartNexusFanOutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StartNexusFanOutRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *StartNexusFanOutRequest) GetFrontendRequest() *StartNexusFanOutExecutionRequest {
+	if x != nil {
+		return x.FrontendRequest
+	}
+	return nil
+}
+
+func (x *StartNexusFanOutRequest) GetCompletionCallbacks() []*v11.Callback {
+	if x != nil {
+		return x.CompletionCallbacks
+	}
+	return nil
+}
+
+type StartNexusFanOutResponse struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	FrontendResponse *StartNexusFanOutExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3" json:"frontend_response,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartNexusFanOutResponse) Reset() {
+	*x = StartNexusFanOutResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNexusFanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNexusFanOutResponse) ProtoMessage() {}
+
+func (x *StartNexusFanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNexusFanOutResponse.ProtoReflect.Descriptor instead.
+func (*StartNexusFanOutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartNexusFanOutResponse) GetFrontendResponse() *StartNexusFanOutExecutionResponse {
+	if x != nil {
+		return x.FrontendResponse
+	}
+	return nil
+}
+
+type DescribeNexusFanOutRequest struct {
+	state           protoimpl.MessageState               `protogen:"open.v1"`
+	NamespaceId     string                               `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	FrontendRequest *DescribeNexusFanOutExecutionRequest `protobuf:"bytes,2,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeNexusFanOutRequest) Reset() {
+	*x = DescribeNexusFanOutRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusFanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusFanOutRequest) ProtoMessage() {}
+
+func (x *DescribeNexusFanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusFanOutRequest.ProtoReflect.Descriptor instead.
+func (*DescribeNexusFanOutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DescribeNexusFanOutRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DescribeNexusFanOutRequest) GetFrontendRequest() *DescribeNexusFanOutExecutionRequest {
+	if x != nil {
+		return x.FrontendRequest
+	}
+	return nil
+}
+
+type DescribeNexusFanOutResponse struct {
+	state            protoimpl.MessageState                `protogen:"open.v1"`
+	FrontendResponse *DescribeNexusFanOutExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3" json:"frontend_response,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeNexusFanOutResponse) Reset() {
+	*x = DescribeNexusFanOutResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusFanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusFanOutResponse) ProtoMessage() {}
+
+func (x *DescribeNexusFanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusFanOutResponse.ProtoReflect.Descriptor instead.
+func (*DescribeNexusFanOutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DescribeNexusFanOutResponse) GetFrontendResponse() *DescribeNexusFanOutExecutionResponse {
+	if x != nil {
+		return x.FrontendResponse
+	}
+	return nil
+}
+
+type PollNexusFanOutRequest struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	FrontendRequest *PollNexusFanOutExecutionRequest `protobuf:"bytes,2,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PollNexusFanOutRequest) Reset() {
+	*x = PollNexusFanOutRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollNexusFanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollNexusFanOutRequest) ProtoMessage() {}
+
+func (x *PollNexusFanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollNexusFanOutRequest.ProtoReflect.Descriptor instead.
+func (*PollNexusFanOutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PollNexusFanOutRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *PollNexusFanOutRequest) GetFrontendRequest() *PollNexusFanOutExecutionRequest {
+	if x != nil {
+		return x.FrontendRequest
+	}
+	return nil
+}
+
+type PollNexusFanOutResponse struct {
+	state            protoimpl.MessageState            `protogen:"open.v1"`
+	FrontendResponse *PollNexusFanOutExecutionResponse `protobuf:"bytes,1,opt,name=frontend_response,json=frontendResponse,proto3" json:"frontend_response,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PollNexusFanOutResponse) Reset() {
+	*x = PollNexusFanOutResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollNexusFanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollNexusFanOutResponse) ProtoMessage() {}
+
+func (x *PollNexusFanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollNexusFanOutResponse.ProtoReflect.Descriptor instead.
+func (*PollNexusFanOutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PollNexusFanOutResponse) GetFrontendResponse() *PollNexusFanOutExecutionResponse {
+	if x != nil {
+		return x.FrontendResponse
+	}
+	return nil
+}
+
+type RequestCancelNexusFanOutRequest struct {
+	state           protoimpl.MessageState                    `protogen:"open.v1"`
+	NamespaceId     string                                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	FrontendRequest *RequestCancelNexusFanOutExecutionRequest `protobuf:"bytes,2,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestCancelNexusFanOutRequest) Reset() {
+	*x = RequestCancelNexusFanOutRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCancelNexusFanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCancelNexusFanOutRequest) ProtoMessage() {}
+
+func (x *RequestCancelNexusFanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCancelNexusFanOutRequest.ProtoReflect.Descriptor instead.
+func (*RequestCancelNexusFanOutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RequestCancelNexusFanOutRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RequestCancelNexusFanOutRequest) GetFrontendRequest() *RequestCancelNexusFanOutExecutionRequest {
+	if x != nil {
+		return x.FrontendRequest
+	}
+	return nil
+}
+
+type RequestCancelNexusFanOutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCancelNexusFanOutResponse) Reset() {
+	*x = RequestCancelNexusFanOutResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCancelNexusFanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCancelNexusFanOutResponse) ProtoMessage() {}
+
+func (x *RequestCancelNexusFanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCancelNexusFanOutResponse.ProtoReflect.Descriptor instead.
+func (*RequestCancelNexusFanOutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{29}
+}
+
+type TerminateNexusFanOutRequest struct {
+	state           protoimpl.MessageState                `protogen:"open.v1"`
+	NamespaceId     string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	FrontendRequest *TerminateNexusFanOutExecutionRequest `protobuf:"bytes,2,opt,name=frontend_request,json=frontendRequest,proto3" json:"frontend_request,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TerminateNexusFanOutRequest) Reset() {
+	*x = TerminateNexusFanOutRequest{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateNexusFanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateNexusFanOutRequest) ProtoMessage() {}
+
+func (x *TerminateNexusFanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateNexusFanOutRequest.ProtoReflect.Descriptor instead.
+func (*TerminateNexusFanOutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TerminateNexusFanOutRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *TerminateNexusFanOutRequest) GetFrontendRequest() *TerminateNexusFanOutExecutionRequest {
+	if x != nil {
+		return x.FrontendRequest
+	}
+	return nil
+}
+
+type TerminateNexusFanOutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateNexusFanOutResponse) Reset() {
+	*x = TerminateNexusFanOutResponse{}
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateNexusFanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateNexusFanOutResponse) ProtoMessage() {}
+
+func (x *TerminateNexusFanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateNexusFanOutResponse.ProtoReflect.Descriptor instead.
+func (*TerminateNexusFanOutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescGZIP(), []int{31}
+}
+
 var File_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto protoreflect.FileDescriptor
 
 const file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	"Htemporal/server/chasm/lib/nexusoperation/proto/v1/request_response.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1a6temporal/api/workflowservice/v1/request_response.proto\"\xd1\x01\n" +
+	"Htemporal/server/chasm/lib/nexusoperation/proto/v1/request_response.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1a?temporal/server/chasm/lib/nexusoperation/proto/v1/fan_out.proto\x1a\x1egoogle/protobuf/duration.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/failure/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\"\xd1\x01\n" +
 	"\x1aStartNexusOperationRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vendpoint_id\x18\x02 \x01(\tR\n" +
@@ -616,7 +1835,100 @@ const file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_pr
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12n\n" +
 	"\x10frontend_request\x18\x02 \x01(\v2C.temporal.api.workflowservice.v1.PollNexusOperationExecutionRequestR\x0ffrontendRequest\"\x8f\x01\n" +
 	"\x1aPollNexusOperationResponse\x12q\n" +
-	"\x11frontend_response\x18\x01 \x01(\v2D.temporal.api.workflowservice.v1.PollNexusOperationExecutionResponseR\x10frontendResponseBVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
+	"\x11frontend_response\x18\x01 \x01(\v2D.temporal.api.workflowservice.v1.PollNexusOperationExecutionResponseR\x10frontendResponse\"\xa5\a\n" +
+	" StartNexusFanOutExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x18\n" +
+	"\aservice\x18\x06 \x01(\tR\aservice\x12\x1c\n" +
+	"\toperation\x18\a \x01(\tR\toperation\x127\n" +
+	"\x06inputs\x18\b \x03(\v2\x1f.temporal.api.common.v1.PayloadR\x06inputs\x12\x87\x01\n" +
+	"\fnexus_header\x18\t \x03(\v2d.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.NexusHeaderEntryR\vnexusHeader\x12v\n" +
+	"\x11completion_policy\x18\n" +
+	" \x01(\x0e2I.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicyR\x10completionPolicy\x12\x16\n" +
+	"\x06quorum\x18\v \x01(\x05R\x06quorum\x12'\n" +
+	"\x0fmax_concurrency\x18\f \x01(\x05R\x0emaxConcurrency\x12T\n" +
+	"\x19schedule_to_close_timeout\x18\r \x01(\v2\x19.google.protobuf.DurationR\x16scheduleToCloseTimeout\x12T\n" +
+	"\x19schedule_to_start_timeout\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x16scheduleToStartTimeout\x12N\n" +
+	"\x16start_to_close_timeout\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x13startToCloseTimeout\x1a>\n" +
+	"\x10NexusHeaderEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"!StartNexusFanOutExecutionResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x18\n" +
+	"\astarted\x18\x02 \x01(\bR\astarted\"\xc9\x01\n" +
+	"#DescribeNexusFanOutExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12&\n" +
+	"\x0flong_poll_token\x18\x04 \x01(\fR\rlongPollToken\x12'\n" +
+	"\x0finclude_results\x18\x05 \x01(\bR\x0eincludeResults\"\xaa\x02\n" +
+	"$DescribeNexusFanOutExecutionResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12_\n" +
+	"\x04info\x18\x02 \x01(\v2K.temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfoR\x04info\x12b\n" +
+	"\aresults\x18\x03 \x03(\v2H.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResultR\aresults\x12&\n" +
+	"\x0flong_poll_token\x18\x04 \x01(\fR\rlongPollToken\"t\n" +
+	"\x1fPollNexusFanOutExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\"\xb2\x02\n" +
+	" PollNexusFanOutExecutionResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12W\n" +
+	"\x06status\x18\x02 \x01(\x0e2?.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatusR\x06status\x12b\n" +
+	"\aresults\x18\x03 \x03(\v2H.temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResultR\aresults\x12:\n" +
+	"\afailure\x18\x04 \x01(\v2 .temporal.api.failure.v1.FailureR\afailure\"\xd0\x01\n" +
+	"(RequestCancelNexusFanOutExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"+\n" +
+	")RequestCancelNexusFanOutExecutionResponse\"\xcc\x01\n" +
+	"$TerminateNexusFanOutExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"'\n" +
+	"%TerminateNexusFanOutExecutionResponse\"\xb2\x02\n" +
+	"\x17StartNexusFanOutRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12~\n" +
+	"\x10frontend_request\x18\x03 \x01(\v2S.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequestR\x0ffrontendRequest\x12S\n" +
+	"\x14completion_callbacks\x18\x04 \x03(\v2 .temporal.api.common.v1.CallbackR\x13completionCallbacks\"\x9e\x01\n" +
+	"\x18StartNexusFanOutResponse\x12\x81\x01\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2T.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponseR\x10frontendResponse\"\xc3\x01\n" +
+	"\x1aDescribeNexusFanOutRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x81\x01\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2V.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequestR\x0ffrontendRequest\"\xa4\x01\n" +
+	"\x1bDescribeNexusFanOutResponse\x12\x84\x01\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2W.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponseR\x10frontendResponse\"\xba\x01\n" +
+	"\x16PollNexusFanOutRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12}\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2R.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequestR\x0ffrontendRequest\"\x9c\x01\n" +
+	"\x17PollNexusFanOutResponse\x12\x80\x01\n" +
+	"\x11frontend_response\x18\x01 \x01(\v2S.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponseR\x10frontendResponse\"\xcd\x01\n" +
+	"\x1fRequestCancelNexusFanOutRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x86\x01\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2[.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequestR\x0ffrontendRequest\"\"\n" +
+	" RequestCancelNexusFanOutResponse\"\xc5\x01\n" +
+	"\x1bTerminateNexusFanOutRequest\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x82\x01\n" +
+	"\x10frontend_request\x18\x02 \x01(\v2W.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequestR\x0ffrontendRequest\"\x1e\n" +
+	"\x1cTerminateNexusFanOutResponseBVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
 
 var (
 	file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescOnce sync.Once
@@ -630,7 +1942,7 @@ func file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_pro
 	return file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_goTypes = []any{
 	(*StartNexusOperationRequest)(nil),                     // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest
 	(*StartNexusOperationResponse)(nil),                    // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse
@@ -644,31 +1956,80 @@ var file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_prot
 	(*DeleteNexusOperationResponse)(nil),                   // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse
 	(*PollNexusOperationRequest)(nil),                      // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest
 	(*PollNexusOperationResponse)(nil),                     // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse
-	(*v1.StartNexusOperationExecutionRequest)(nil),         // 12: temporal.api.workflowservice.v1.StartNexusOperationExecutionRequest
-	(*v1.StartNexusOperationExecutionResponse)(nil),        // 13: temporal.api.workflowservice.v1.StartNexusOperationExecutionResponse
-	(*v1.DescribeNexusOperationExecutionRequest)(nil),      // 14: temporal.api.workflowservice.v1.DescribeNexusOperationExecutionRequest
-	(*v1.DescribeNexusOperationExecutionResponse)(nil),     // 15: temporal.api.workflowservice.v1.DescribeNexusOperationExecutionResponse
-	(*v1.RequestCancelNexusOperationExecutionRequest)(nil), // 16: temporal.api.workflowservice.v1.RequestCancelNexusOperationExecutionRequest
-	(*v1.TerminateNexusOperationExecutionRequest)(nil),     // 17: temporal.api.workflowservice.v1.TerminateNexusOperationExecutionRequest
-	(*v1.DeleteNexusOperationExecutionRequest)(nil),        // 18: temporal.api.workflowservice.v1.DeleteNexusOperationExecutionRequest
-	(*v1.PollNexusOperationExecutionRequest)(nil),          // 19: temporal.api.workflowservice.v1.PollNexusOperationExecutionRequest
-	(*v1.PollNexusOperationExecutionResponse)(nil),         // 20: temporal.api.workflowservice.v1.PollNexusOperationExecutionResponse
+	(*StartNexusFanOutExecutionRequest)(nil),               // 12: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest
+	(*StartNexusFanOutExecutionResponse)(nil),              // 13: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponse
+	(*DescribeNexusFanOutExecutionRequest)(nil),            // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequest
+	(*DescribeNexusFanOutExecutionResponse)(nil),           // 15: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse
+	(*PollNexusFanOutExecutionRequest)(nil),                // 16: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequest
+	(*PollNexusFanOutExecutionResponse)(nil),               // 17: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse
+	(*RequestCancelNexusFanOutExecutionRequest)(nil),       // 18: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequest
+	(*RequestCancelNexusFanOutExecutionResponse)(nil),      // 19: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionResponse
+	(*TerminateNexusFanOutExecutionRequest)(nil),           // 20: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequest
+	(*TerminateNexusFanOutExecutionResponse)(nil),          // 21: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionResponse
+	(*StartNexusFanOutRequest)(nil),                        // 22: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest
+	(*StartNexusFanOutResponse)(nil),                       // 23: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutResponse
+	(*DescribeNexusFanOutRequest)(nil),                     // 24: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutRequest
+	(*DescribeNexusFanOutResponse)(nil),                    // 25: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutResponse
+	(*PollNexusFanOutRequest)(nil),                         // 26: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutRequest
+	(*PollNexusFanOutResponse)(nil),                        // 27: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutResponse
+	(*RequestCancelNexusFanOutRequest)(nil),                // 28: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutRequest
+	(*RequestCancelNexusFanOutResponse)(nil),               // 29: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutResponse
+	(*TerminateNexusFanOutRequest)(nil),                    // 30: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutRequest
+	(*TerminateNexusFanOutResponse)(nil),                   // 31: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutResponse
+	nil,                                                    // 32: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.NexusHeaderEntry
+	(*v1.StartNexusOperationExecutionRequest)(nil),         // 33: temporal.api.workflowservice.v1.StartNexusOperationExecutionRequest
+	(*v1.StartNexusOperationExecutionResponse)(nil),        // 34: temporal.api.workflowservice.v1.StartNexusOperationExecutionResponse
+	(*v1.DescribeNexusOperationExecutionRequest)(nil),      // 35: temporal.api.workflowservice.v1.DescribeNexusOperationExecutionRequest
+	(*v1.DescribeNexusOperationExecutionResponse)(nil),     // 36: temporal.api.workflowservice.v1.DescribeNexusOperationExecutionResponse
+	(*v1.RequestCancelNexusOperationExecutionRequest)(nil), // 37: temporal.api.workflowservice.v1.RequestCancelNexusOperationExecutionRequest
+	(*v1.TerminateNexusOperationExecutionRequest)(nil),     // 38: temporal.api.workflowservice.v1.TerminateNexusOperationExecutionRequest
+	(*v1.DeleteNexusOperationExecutionRequest)(nil),        // 39: temporal.api.workflowservice.v1.DeleteNexusOperationExecutionRequest
+	(*v1.PollNexusOperationExecutionRequest)(nil),          // 40: temporal.api.workflowservice.v1.PollNexusOperationExecutionRequest
+	(*v1.PollNexusOperationExecutionResponse)(nil),         // 41: temporal.api.workflowservice.v1.PollNexusOperationExecutionResponse
+	(*v11.Payload)(nil),                                    // 42: temporal.api.common.v1.Payload
+	(FanOutCompletionPolicy)(0),                            // 43: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy
+	(*durationpb.Duration)(nil),                            // 44: google.protobuf.Duration
+	(*NexusFanOutExecutionInfo)(nil),                       // 45: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo
+	(*FanOutOperationResult)(nil),                          // 46: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult
+	(FanOutStatus)(0),                                      // 47: temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus
+	(*v12.Failure)(nil),                                    // 48: temporal.api.failure.v1.Failure
+	(*v11.Callback)(nil),                                   // 49: temporal.api.common.v1.Callback
 }
 var file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_depIdxs = []int32{
-	12, // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.StartNexusOperationExecutionRequest
-	13, // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.StartNexusOperationExecutionResponse
-	14, // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DescribeNexusOperationExecutionRequest
-	15, // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.DescribeNexusOperationExecutionResponse
-	16, // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.RequestCancelNexusOperationExecutionRequest
-	17, // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.TerminateNexusOperationExecutionRequest
-	18, // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DeleteNexusOperationExecutionRequest
-	19, // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PollNexusOperationExecutionRequest
-	20, // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.PollNexusOperationExecutionResponse
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	33, // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.StartNexusOperationExecutionRequest
+	34, // 1: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.StartNexusOperationExecutionResponse
+	35, // 2: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DescribeNexusOperationExecutionRequest
+	36, // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.DescribeNexusOperationExecutionResponse
+	37, // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.RequestCancelNexusOperationExecutionRequest
+	38, // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.TerminateNexusOperationExecutionRequest
+	39, // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.DeleteNexusOperationExecutionRequest
+	40, // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest.frontend_request:type_name -> temporal.api.workflowservice.v1.PollNexusOperationExecutionRequest
+	41, // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse.frontend_response:type_name -> temporal.api.workflowservice.v1.PollNexusOperationExecutionResponse
+	42, // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.inputs:type_name -> temporal.api.common.v1.Payload
+	32, // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.nexus_header:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.NexusHeaderEntry
+	43, // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.completion_policy:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutCompletionPolicy
+	44, // 12: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.schedule_to_close_timeout:type_name -> google.protobuf.Duration
+	44, // 13: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.schedule_to_start_timeout:type_name -> google.protobuf.Duration
+	44, // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest.start_to_close_timeout:type_name -> google.protobuf.Duration
+	45, // 15: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse.info:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutExecutionInfo
+	46, // 16: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse.results:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult
+	47, // 17: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse.status:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutStatus
+	46, // 18: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse.results:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.FanOutOperationResult
+	48, // 19: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse.failure:type_name -> temporal.api.failure.v1.Failure
+	12, // 20: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest.frontend_request:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionRequest
+	49, // 21: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest.completion_callbacks:type_name -> temporal.api.common.v1.Callback
+	13, // 22: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutResponse.frontend_response:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutExecutionResponse
+	14, // 23: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutRequest.frontend_request:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionRequest
+	15, // 24: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutResponse.frontend_response:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutExecutionResponse
+	16, // 25: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutRequest.frontend_request:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionRequest
+	17, // 26: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutResponse.frontend_response:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutExecutionResponse
+	18, // 27: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutRequest.frontend_request:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutExecutionRequest
+	20, // 28: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutRequest.frontend_request:type_name -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutExecutionRequest
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_init() }
@@ -676,13 +2037,14 @@ func file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_pro
 	if File_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto != nil {
 		return
 	}
+	file_temporal_server_chasm_lib_nexusoperation_proto_v1_fan_out_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDesc), len(file_temporal_server_chasm_lib_nexusoperation_proto_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
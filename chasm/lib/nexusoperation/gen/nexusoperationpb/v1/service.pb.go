@@ -27,14 +27,19 @@ var File_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto protore
 
 const file_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"?temporal/server/chasm/lib/nexusoperation/proto/v1/service.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1aHtemporal/server/chasm/lib/nexusoperation/proto/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto\x1a.temporal/server/api/routing/v1/extension.proto2\x90\v\n" +
+	"?temporal/server/chasm/lib/nexusoperation/proto/v1/service.proto\x121temporal.server.chasm.lib.nexusoperation.proto.v1\x1aHtemporal/server/chasm/lib/nexusoperation/proto/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto\x1a.temporal/server/api/routing/v1/extension.proto2\xed\x13\n" +
 	"\x15NexusOperationService\x12\xdf\x01\n" +
 	"\x13StartNexusOperation\x12M.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest\x1aN.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse\")\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xe8\x01\n" +
 	"\x16DescribeNexusOperation\x12P.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationRequest\x1aQ.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse\")\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xf7\x01\n" +
 	"\x1bRequestCancelNexusOperation\x12U.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationRequest\x1aV.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationResponse\")\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xeb\x01\n" +
 	"\x17TerminateNexusOperation\x12Q.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationRequest\x1aR.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationResponse\")\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xe2\x01\n" +
 	"\x14DeleteNexusOperation\x12N.temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationRequest\x1aO.temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse\")\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xdc\x01\n" +
-	"\x12PollNexusOperation\x12L.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest\x1aM.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse\")\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_idBVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
+	"\x12PollNexusOperation\x12L.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest\x1aM.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse\")\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1f\x1a\x1dfrontend_request.operation_id\x12\xd4\x01\n" +
+	"\x10StartNexusFanOut\x12J.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest\x1aK.temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutResponse\"'\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1d\x1a\x1bfrontend_request.fan_out_id\x12\xdd\x01\n" +
+	"\x13DescribeNexusFanOut\x12M.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutRequest\x1aN.temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutResponse\"'\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1d\x1a\x1bfrontend_request.fan_out_id\x12\xd1\x01\n" +
+	"\x0fPollNexusFanOut\x12I.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutRequest\x1aJ.temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutResponse\"'\x8a\xb5\x18\x02\b\x02\xd2\xc3\x18\x1d\x1a\x1bfrontend_request.fan_out_id\x12\xec\x01\n" +
+	"\x18RequestCancelNexusFanOut\x12R.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutRequest\x1aS.temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutResponse\"'\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1d\x1a\x1bfrontend_request.fan_out_id\x12\xe0\x01\n" +
+	"\x14TerminateNexusFanOut\x12N.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutRequest\x1aO.temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutResponse\"'\x8a\xb5\x18\x02\b\x01\xd2\xc3\x18\x1d\x1a\x1bfrontend_request.fan_out_idBVZTgo.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb;nexusoperationpbb\x06proto3"
 
 var file_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto_goTypes = []any{
 	(*StartNexusOperationRequest)(nil),          // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest
@@ -43,12 +48,22 @@ var file_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto_goTypes
 	(*TerminateNexusOperationRequest)(nil),      // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationRequest
 	(*DeleteNexusOperationRequest)(nil),         // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationRequest
 	(*PollNexusOperationRequest)(nil),           // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest
-	(*StartNexusOperationResponse)(nil),         // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse
-	(*DescribeNexusOperationResponse)(nil),      // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse
-	(*RequestCancelNexusOperationResponse)(nil), // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationResponse
-	(*TerminateNexusOperationResponse)(nil),     // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationResponse
-	(*DeleteNexusOperationResponse)(nil),        // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse
-	(*PollNexusOperationResponse)(nil),          // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse
+	(*StartNexusFanOutRequest)(nil),             // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest
+	(*DescribeNexusFanOutRequest)(nil),          // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutRequest
+	(*PollNexusFanOutRequest)(nil),              // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutRequest
+	(*RequestCancelNexusFanOutRequest)(nil),     // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutRequest
+	(*TerminateNexusFanOutRequest)(nil),         // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutRequest
+	(*StartNexusOperationResponse)(nil),         // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse
+	(*DescribeNexusOperationResponse)(nil),      // 12: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse
+	(*RequestCancelNexusOperationResponse)(nil), // 13: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationResponse
+	(*TerminateNexusOperationResponse)(nil),     // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationResponse
+	(*DeleteNexusOperationResponse)(nil),        // 15: temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse
+	(*PollNexusOperationResponse)(nil),          // 16: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse
+	(*StartNexusFanOutResponse)(nil),            // 17: temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutResponse
+	(*DescribeNexusFanOutResponse)(nil),         // 18: temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutResponse
+	(*PollNexusFanOutResponse)(nil),             // 19: temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutResponse
+	(*RequestCancelNexusFanOutResponse)(nil),    // 20: temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutResponse
+	(*TerminateNexusFanOutResponse)(nil),        // 21: temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutResponse
 }
 var file_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto_depIdxs = []int32{
 	0,  // 0: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.StartNexusOperation:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationRequest
@@ -57,14 +72,24 @@ var file_temporal_server_chasm_lib_nexusoperation_proto_v1_service_proto_depIdxs
 	3,  // 3: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.TerminateNexusOperation:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationRequest
 	4,  // 4: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DeleteNexusOperation:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationRequest
 	5,  // 5: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.PollNexusOperation:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationRequest
-	6,  // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.StartNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse
-	7,  // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DescribeNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse
-	8,  // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.RequestCancelNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationResponse
-	9,  // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.TerminateNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationResponse
-	10, // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DeleteNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse
-	11, // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.PollNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	6,  // 6: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.StartNexusFanOut:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutRequest
+	7,  // 7: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DescribeNexusFanOut:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutRequest
+	8,  // 8: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.PollNexusFanOut:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutRequest
+	9,  // 9: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.RequestCancelNexusFanOut:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutRequest
+	10, // 10: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.TerminateNexusFanOut:input_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutRequest
+	11, // 11: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.StartNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusOperationResponse
+	12, // 12: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DescribeNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusOperationResponse
+	13, // 13: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.RequestCancelNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusOperationResponse
+	14, // 14: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.TerminateNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusOperationResponse
+	15, // 15: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DeleteNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DeleteNexusOperationResponse
+	16, // 16: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.PollNexusOperation:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusOperationResponse
+	17, // 17: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.StartNexusFanOut:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.StartNexusFanOutResponse
+	18, // 18: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.DescribeNexusFanOut:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.DescribeNexusFanOutResponse
+	19, // 19: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.PollNexusFanOut:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.PollNexusFanOutResponse
+	20, // 20: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.RequestCancelNexusFanOut:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.RequestCancelNexusFanOutResponse
+	21, // 21: temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService.TerminateNexusFanOut:output_type -> temporal.server.chasm.lib.nexusoperation.proto.v1.TerminateNexusFanOutResponse
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardpool"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 )
@@ -25,6 +26,7 @@ type NexusOperationServiceLayeredClient struct {
 	numShards      int32
 	redirector     history.Redirector[NexusOperationServiceClient]
 	retryPolicy    backoff.RetryPolicy
+	shardPools     *shardpool.Resolver
 }
 
 // NewNexusOperationServiceLayeredClient initializes a new NexusOperationServiceLayeredClient.
@@ -36,6 +38,7 @@ func NewNexusOperationServiceLayeredClient(
 	config *config.Persistence,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	shardPools *shardpool.Resolver,
 ) (NexusOperationServiceClient, error) {
 	resolver, err := monitor.GetResolver(primitives.HistoryService)
 	if err != nil {
@@ -58,6 +61,7 @@ func NewNexusOperationServiceLayeredClient(
 		redirector:     redirector,
 		numShards:      config.NumHistoryShards,
 		retryPolicy:    common.CreateHistoryClientRetryPolicy(dynamicconfig.RetryUnboundedOnSystemResourceExhausted.Get(dc)),
+		shardPools:     shardPools,
 	}
 	lc.Append(fx.StopHook(client.Stop))
 	return client, nil
@@ -87,7 +91,10 @@ func (c *NexusOperationServiceLayeredClient) callStartNexusOperationNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -130,7 +137,10 @@ func (c *NexusOperationServiceLayeredClient) callDescribeNexusOperationNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -173,7 +183,10 @@ func (c *NexusOperationServiceLayeredClient) callRequestCancelNexusOperationNoRe
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -216,7 +229,10 @@ func (c *NexusOperationServiceLayeredClient) callTerminateNexusOperationNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -259,7 +275,10 @@ func (c *NexusOperationServiceLayeredClient) callDeleteNexusOperationNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -302,7 +321,10 @@ func (c *NexusOperationServiceLayeredClient) callPollNexusOperationNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetOperationId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client NexusOperationServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -323,3 +345,233 @@ func (c *NexusOperationServiceLayeredClient) PollNexusOperation(
 	}
 	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
 }
+func (c *NexusOperationServiceLayeredClient) callStartNexusFanOutNoRetry(
+	ctx context.Context,
+	request *StartNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*StartNexusFanOutResponse, error) {
+	var response *StartNexusFanOutResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("NexusOperationService.StartNexusFanOut"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetFanOutId())
+	if err != nil {
+		return nil, err
+	}
+	op := func(ctx context.Context, client NexusOperationServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.StartNexusFanOut(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *NexusOperationServiceLayeredClient) StartNexusFanOut(
+	ctx context.Context,
+	request *StartNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*StartNexusFanOutResponse, error) {
+	call := func(ctx context.Context) (*StartNexusFanOutResponse, error) {
+		return c.callStartNexusFanOutNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *NexusOperationServiceLayeredClient) callDescribeNexusFanOutNoRetry(
+	ctx context.Context,
+	request *DescribeNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*DescribeNexusFanOutResponse, error) {
+	var response *DescribeNexusFanOutResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("NexusOperationService.DescribeNexusFanOut"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetFanOutId())
+	if err != nil {
+		return nil, err
+	}
+	op := func(ctx context.Context, client NexusOperationServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.DescribeNexusFanOut(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *NexusOperationServiceLayeredClient) DescribeNexusFanOut(
+	ctx context.Context,
+	request *DescribeNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*DescribeNexusFanOutResponse, error) {
+	call := func(ctx context.Context) (*DescribeNexusFanOutResponse, error) {
+		return c.callDescribeNexusFanOutNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *NexusOperationServiceLayeredClient) callPollNexusFanOutNoRetry(
+	ctx context.Context,
+	request *PollNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*PollNexusFanOutResponse, error) {
+	var response *PollNexusFanOutResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("NexusOperationService.PollNexusFanOut"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetFanOutId())
+	if err != nil {
+		return nil, err
+	}
+	op := func(ctx context.Context, client NexusOperationServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.PollNexusFanOut(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *NexusOperationServiceLayeredClient) PollNexusFanOut(
+	ctx context.Context,
+	request *PollNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*PollNexusFanOutResponse, error) {
+	call := func(ctx context.Context) (*PollNexusFanOutResponse, error) {
+		return c.callPollNexusFanOutNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *NexusOperationServiceLayeredClient) callRequestCancelNexusFanOutNoRetry(
+	ctx context.Context,
+	request *RequestCancelNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*RequestCancelNexusFanOutResponse, error) {
+	var response *RequestCancelNexusFanOutResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("NexusOperationService.RequestCancelNexusFanOut"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetFanOutId())
+	if err != nil {
+		return nil, err
+	}
+	op := func(ctx context.Context, client NexusOperationServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.RequestCancelNexusFanOut(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *NexusOperationServiceLayeredClient) RequestCancelNexusFanOut(
+	ctx context.Context,
+	request *RequestCancelNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*RequestCancelNexusFanOutResponse, error) {
+	call := func(ctx context.Context) (*RequestCancelNexusFanOutResponse, error) {
+		return c.callRequestCancelNexusFanOutNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
+func (c *NexusOperationServiceLayeredClient) callTerminateNexusFanOutNoRetry(
+	ctx context.Context,
+	request *TerminateNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*TerminateNexusFanOutResponse, error) {
+	var response *TerminateNexusFanOutResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("NexusOperationService.TerminateNexusFanOut"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetFanOutId())
+	if err != nil {
+		return nil, err
+	}
+	op := func(ctx context.Context, client NexusOperationServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.TerminateNexusFanOut(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *NexusOperationServiceLayeredClient) TerminateNexusFanOut(
+	ctx context.Context,
+	request *TerminateNexusFanOutRequest,
+	opts ...grpc.CallOption,
+) (*TerminateNexusFanOutResponse, error) {
+	call := func(ctx context.Context) (*TerminateNexusFanOutResponse, error) {
+		return c.callTerminateNexusFanOutNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
@@ -26,6 +26,11 @@ const (
 	NexusOperationService_TerminateNexusOperation_FullMethodName     = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/TerminateNexusOperation"
 	NexusOperationService_DeleteNexusOperation_FullMethodName        = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/DeleteNexusOperation"
 	NexusOperationService_PollNexusOperation_FullMethodName          = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/PollNexusOperation"
+	NexusOperationService_StartNexusFanOut_FullMethodName            = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/StartNexusFanOut"
+	NexusOperationService_DescribeNexusFanOut_FullMethodName         = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/DescribeNexusFanOut"
+	NexusOperationService_PollNexusFanOut_FullMethodName             = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/PollNexusFanOut"
+	NexusOperationService_RequestCancelNexusFanOut_FullMethodName    = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/RequestCancelNexusFanOut"
+	NexusOperationService_TerminateNexusFanOut_FullMethodName        = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusOperationService/TerminateNexusFanOut"
 )
 
 // NexusOperationServiceClient is the client API for NexusOperationService service.
@@ -38,6 +43,11 @@ type NexusOperationServiceClient interface {
 	TerminateNexusOperation(ctx context.Context, in *TerminateNexusOperationRequest, opts ...grpc.CallOption) (*TerminateNexusOperationResponse, error)
 	DeleteNexusOperation(ctx context.Context, in *DeleteNexusOperationRequest, opts ...grpc.CallOption) (*DeleteNexusOperationResponse, error)
 	PollNexusOperation(ctx context.Context, in *PollNexusOperationRequest, opts ...grpc.CallOption) (*PollNexusOperationResponse, error)
+	StartNexusFanOut(ctx context.Context, in *StartNexusFanOutRequest, opts ...grpc.CallOption) (*StartNexusFanOutResponse, error)
+	DescribeNexusFanOut(ctx context.Context, in *DescribeNexusFanOutRequest, opts ...grpc.CallOption) (*DescribeNexusFanOutResponse, error)
+	PollNexusFanOut(ctx context.Context, in *PollNexusFanOutRequest, opts ...grpc.CallOption) (*PollNexusFanOutResponse, error)
+	RequestCancelNexusFanOut(ctx context.Context, in *RequestCancelNexusFanOutRequest, opts ...grpc.CallOption) (*RequestCancelNexusFanOutResponse, error)
+	TerminateNexusFanOut(ctx context.Context, in *TerminateNexusFanOutRequest, opts ...grpc.CallOption) (*TerminateNexusFanOutResponse, error)
 }
 
 type nexusOperationServiceClient struct {
@@ -102,6 +112,51 @@ func (c *nexusOperationServiceClient) PollNexusOperation(ctx context.Context, in
 	return out, nil
 }
 
+func (c *nexusOperationServiceClient) StartNexusFanOut(ctx context.Context, in *StartNexusFanOutRequest, opts ...grpc.CallOption) (*StartNexusFanOutResponse, error) {
+	out := new(StartNexusFanOutResponse)
+	err := c.cc.Invoke(ctx, NexusOperationService_StartNexusFanOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusOperationServiceClient) DescribeNexusFanOut(ctx context.Context, in *DescribeNexusFanOutRequest, opts ...grpc.CallOption) (*DescribeNexusFanOutResponse, error) {
+	out := new(DescribeNexusFanOutResponse)
+	err := c.cc.Invoke(ctx, NexusOperationService_DescribeNexusFanOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusOperationServiceClient) PollNexusFanOut(ctx context.Context, in *PollNexusFanOutRequest, opts ...grpc.CallOption) (*PollNexusFanOutResponse, error) {
+	out := new(PollNexusFanOutResponse)
+	err := c.cc.Invoke(ctx, NexusOperationService_PollNexusFanOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusOperationServiceClient) RequestCancelNexusFanOut(ctx context.Context, in *RequestCancelNexusFanOutRequest, opts ...grpc.CallOption) (*RequestCancelNexusFanOutResponse, error) {
+	out := new(RequestCancelNexusFanOutResponse)
+	err := c.cc.Invoke(ctx, NexusOperationService_RequestCancelNexusFanOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusOperationServiceClient) TerminateNexusFanOut(ctx context.Context, in *TerminateNexusFanOutRequest, opts ...grpc.CallOption) (*TerminateNexusFanOutResponse, error) {
+	out := new(TerminateNexusFanOutResponse)
+	err := c.cc.Invoke(ctx, NexusOperationService_TerminateNexusFanOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NexusOperationServiceServer is the server API for NexusOperationService service.
 // All implementations must embed UnimplementedNexusOperationServiceServer
 // for forward compatibility
@@ -112,6 +167,11 @@ type NexusOperationServiceServer interface {
 	TerminateNexusOperation(context.Context, *TerminateNexusOperationRequest) (*TerminateNexusOperationResponse, error)
 	DeleteNexusOperation(context.Context, *DeleteNexusOperationRequest) (*DeleteNexusOperationResponse, error)
 	PollNexusOperation(context.Context, *PollNexusOperationRequest) (*PollNexusOperationResponse, error)
+	StartNexusFanOut(context.Context, *StartNexusFanOutRequest) (*StartNexusFanOutResponse, error)
+	DescribeNexusFanOut(context.Context, *DescribeNexusFanOutRequest) (*DescribeNexusFanOutResponse, error)
+	PollNexusFanOut(context.Context, *PollNexusFanOutRequest) (*PollNexusFanOutResponse, error)
+	RequestCancelNexusFanOut(context.Context, *RequestCancelNexusFanOutRequest) (*RequestCancelNexusFanOutResponse, error)
+	TerminateNexusFanOut(context.Context, *TerminateNexusFanOutRequest) (*TerminateNexusFanOutResponse, error)
 	mustEmbedUnimplementedNexusOperationServiceServer()
 }
 
@@ -137,6 +197,21 @@ func (UnimplementedNexusOperationServiceServer) DeleteNexusOperation(context.Con
 func (UnimplementedNexusOperationServiceServer) PollNexusOperation(context.Context, *PollNexusOperationRequest) (*PollNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PollNexusOperation not implemented")
 }
+func (UnimplementedNexusOperationServiceServer) StartNexusFanOut(context.Context, *StartNexusFanOutRequest) (*StartNexusFanOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartNexusFanOut not implemented")
+}
+func (UnimplementedNexusOperationServiceServer) DescribeNexusFanOut(context.Context, *DescribeNexusFanOutRequest) (*DescribeNexusFanOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNexusFanOut not implemented")
+}
+func (UnimplementedNexusOperationServiceServer) PollNexusFanOut(context.Context, *PollNexusFanOutRequest) (*PollNexusFanOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollNexusFanOut not implemented")
+}
+func (UnimplementedNexusOperationServiceServer) RequestCancelNexusFanOut(context.Context, *RequestCancelNexusFanOutRequest) (*RequestCancelNexusFanOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCancelNexusFanOut not implemented")
+}
+func (UnimplementedNexusOperationServiceServer) TerminateNexusFanOut(context.Context, *TerminateNexusFanOutRequest) (*TerminateNexusFanOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateNexusFanOut not implemented")
+}
 func (UnimplementedNexusOperationServiceServer) mustEmbedUnimplementedNexusOperationServiceServer() {}
 
 // UnsafeNexusOperationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +333,96 @@ func _NexusOperationService_PollNexusOperation_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NexusOperationService_StartNexusFanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartNexusFanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusOperationServiceServer).StartNexusFanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusOperationService_StartNexusFanOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusOperationServiceServer).StartNexusFanOut(ctx, req.(*StartNexusFanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusOperationService_DescribeNexusFanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNexusFanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusOperationServiceServer).DescribeNexusFanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusOperationService_DescribeNexusFanOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusOperationServiceServer).DescribeNexusFanOut(ctx, req.(*DescribeNexusFanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusOperationService_PollNexusFanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollNexusFanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusOperationServiceServer).PollNexusFanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusOperationService_PollNexusFanOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusOperationServiceServer).PollNexusFanOut(ctx, req.(*PollNexusFanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusOperationService_RequestCancelNexusFanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCancelNexusFanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusOperationServiceServer).RequestCancelNexusFanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusOperationService_RequestCancelNexusFanOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusOperationServiceServer).RequestCancelNexusFanOut(ctx, req.(*RequestCancelNexusFanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusOperationService_TerminateNexusFanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateNexusFanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusOperationServiceServer).TerminateNexusFanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusOperationService_TerminateNexusFanOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusOperationServiceServer).TerminateNexusFanOut(ctx, req.(*TerminateNexusFanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NexusOperationService_ServiceDesc is the grpc.ServiceDesc for NexusOperationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +454,26 @@ var NexusOperationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PollNexusOperation",
 			Handler:    _NexusOperationService_PollNexusOperation_Handler,
 		},
+		{
+			MethodName: "StartNexusFanOut",
+			Handler:    _NexusOperationService_StartNexusFanOut_Handler,
+		},
+		{
+			MethodName: "DescribeNexusFanOut",
+			Handler:    _NexusOperationService_DescribeNexusFanOut_Handler,
+		},
+		{
+			MethodName: "PollNexusFanOut",
+			Handler:    _NexusOperationService_PollNexusFanOut_Handler,
+		},
+		{
+			MethodName: "RequestCancelNexusFanOut",
+			Handler:    _NexusOperationService_RequestCancelNexusFanOut_Handler,
+		},
+		{
+			MethodName: "TerminateNexusFanOut",
+			Handler:    _NexusOperationService_TerminateNexusFanOut_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/chasm/lib/nexusoperation/proto/v1/service.proto",
@@ -276,6 +276,196 @@ func (h *handler) DeleteNexusOperation(
 
 	return &nexusoperationpb.DeleteNexusOperationResponse{}, nil
 }
+
+// StartNexusFanOut creates a new Nexus operation fan-out execution via CHASM.
+func (h *handler) StartNexusFanOut(
+	ctx context.Context,
+	req *nexusoperationpb.StartNexusFanOutRequest,
+) (response *nexusoperationpb.StartNexusFanOutResponse, err error) {
+	defer log.CapturePanic(h.logger, &err)
+
+	frontendReq := req.GetFrontendRequest()
+
+	result, err := chasm.StartExecution[*FanOut](
+		ctx,
+		chasm.ExecutionKey{
+			NamespaceID: req.GetNamespaceId(),
+			BusinessID:  frontendReq.GetFanOutId(),
+		},
+		newFanOut,
+		req,
+		chasm.WithRequestID(frontendReq.GetRequestId()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nexusoperationpb.StartNexusFanOutResponse{
+		FrontendResponse: &nexusoperationpb.StartNexusFanOutExecutionResponse{
+			RunId:   result.ExecutionKey.RunID,
+			Started: result.Created,
+		},
+	}, nil
+}
+
+// DescribeNexusFanOut queries current fan-out state, optionally as a long-poll that waits for any
+// state change. Like DescribeNexusOperation, it returns an empty non-error response on context
+// deadline expiry.
+func (h *handler) DescribeNexusFanOut(
+	ctx context.Context,
+	req *nexusoperationpb.DescribeNexusFanOutRequest,
+) (response *nexusoperationpb.DescribeNexusFanOutResponse, err error) {
+	defer log.CapturePanic(h.logger, &err)
+
+	ref := chasm.NewComponentRef[*FanOut](chasm.ExecutionKey{
+		NamespaceID: req.GetNamespaceId(),
+		BusinessID:  req.GetFrontendRequest().GetFanOutId(),
+		RunID:       req.GetFrontendRequest().GetRunId(),
+	})
+
+	token := req.GetFrontendRequest().GetLongPollToken()
+	if len(token) == 0 {
+		// No long poll.
+		return chasm.ReadComponent(ctx, ref, (*FanOut).buildDescribeResponse, req)
+	}
+
+	ns := req.GetFrontendRequest().GetNamespace()
+	ctx, cancel := contextutil.WithDeadlineBuffer(
+		ctx,
+		h.config.LongPollTimeout(ns),
+		h.config.LongPollBuffer(ns),
+	)
+	defer cancel()
+
+	response, _, err = chasm.PollComponent(ctx, ref, func(
+		f *FanOut,
+		ctx chasm.Context,
+		req *nexusoperationpb.DescribeNexusFanOutRequest,
+	) (*nexusoperationpb.DescribeNexusFanOutResponse, bool, error) {
+		changed, err := chasm.ExecutionStateChanged(f, ctx, token)
+		if err != nil {
+			if errors.Is(err, chasm.ErrMalformedComponentRef) {
+				return nil, false, serviceerror.NewInvalidArgument("invalid long poll token")
+			}
+			if errors.Is(err, chasm.ErrInvalidComponentRef) {
+				return nil, false, serviceerror.NewInvalidArgument("long poll token does not match execution")
+			}
+			return nil, false, err
+		}
+		if changed {
+			response, err := f.buildDescribeResponse(ctx, req)
+			return response, true, err
+		}
+		return nil, false, nil
+	}, req)
+
+	if err != nil && ctx.Err() != nil {
+		// Send empty non-error response on deadline expiry: caller should continue long-polling.
+		return &nexusoperationpb.DescribeNexusFanOutResponse{
+			FrontendResponse: &nexusoperationpb.DescribeNexusFanOutExecutionResponse{},
+		}, nil
+	}
+	return response, err
+}
+
+// PollNexusFanOut long-polls for a fan-out to close. Like PollNexusOperation, it returns an empty
+// non-error response on context deadline expiry.
+func (h *handler) PollNexusFanOut(
+	ctx context.Context,
+	req *nexusoperationpb.PollNexusFanOutRequest,
+) (response *nexusoperationpb.PollNexusFanOutResponse, err error) {
+	defer log.CapturePanic(h.logger, &err)
+
+	ref := chasm.NewComponentRef[*FanOut](chasm.ExecutionKey{
+		NamespaceID: req.GetNamespaceId(),
+		BusinessID:  req.GetFrontendRequest().GetFanOutId(),
+		RunID:       req.GetFrontendRequest().GetRunId(),
+	})
+
+	ns := req.GetFrontendRequest().GetNamespace()
+	ctx, cancel := contextutil.WithDeadlineBuffer(
+		ctx,
+		h.config.LongPollTimeout(ns),
+		h.config.LongPollBuffer(ns),
+	)
+	defer cancel()
+
+	response, _, err = chasm.PollComponent(ctx, ref, func(
+		f *FanOut,
+		ctx chasm.Context,
+		_ *nexusoperationpb.PollNexusFanOutRequest,
+	) (*nexusoperationpb.PollNexusFanOutResponse, bool, error) {
+		if f.LifecycleState(ctx).IsClosed() {
+			return f.buildPollResponse(ctx), true, nil
+		}
+		return nil, false, nil
+	}, req)
+
+	if err != nil && ctx.Err() != nil {
+		// Send an empty non-error response as an invitation to resubmit the long-poll.
+		return &nexusoperationpb.PollNexusFanOutResponse{
+			FrontendResponse: &nexusoperationpb.PollNexusFanOutExecutionResponse{},
+		}, nil
+	}
+	return response, err
+}
+
+// RequestCancelNexusFanOut cancels a fan-out and the operations it has in flight.
+func (h *handler) RequestCancelNexusFanOut(
+	ctx context.Context,
+	req *nexusoperationpb.RequestCancelNexusFanOutRequest,
+) (response *nexusoperationpb.RequestCancelNexusFanOutResponse, err error) {
+	defer log.CapturePanic(h.logger, &err)
+
+	ref := chasm.NewComponentRef[*FanOut](chasm.ExecutionKey{
+		NamespaceID: req.GetNamespaceId(),
+		BusinessID:  req.GetFrontendRequest().GetFanOutId(),
+		RunID:       req.GetFrontendRequest().GetRunId(),
+	})
+
+	resp, _, err := chasm.UpdateComponent(
+		ctx,
+		ref,
+		func(f *FanOut, ctx chasm.MutableContext, req *nexusoperationpb.RequestCancelNexusFanOutRequest) (*nexusoperationpb.RequestCancelNexusFanOutResponse, error) {
+			if err := f.RequestCancel(ctx, req.GetFrontendRequest()); err != nil {
+				return nil, err
+			}
+			return &nexusoperationpb.RequestCancelNexusFanOutResponse{}, nil
+		}, req)
+
+	return resp, err
+}
+
+// TerminateNexusFanOut terminates a fan-out and the operations it has in flight.
+func (h *handler) TerminateNexusFanOut(
+	ctx context.Context,
+	req *nexusoperationpb.TerminateNexusFanOutRequest,
+) (response *nexusoperationpb.TerminateNexusFanOutResponse, err error) {
+	defer log.CapturePanic(h.logger, &err)
+
+	ref := chasm.NewComponentRef[*FanOut](chasm.ExecutionKey{
+		NamespaceID: req.GetNamespaceId(),
+		BusinessID:  req.GetFrontendRequest().GetFanOutId(),
+		RunID:       req.GetFrontendRequest().GetRunId(),
+	})
+
+	resp, _, err := chasm.UpdateComponent(
+		ctx,
+		ref,
+		func(f *FanOut, ctx chasm.MutableContext, req *nexusoperationpb.TerminateNexusFanOutRequest) (*nexusoperationpb.TerminateNexusFanOutResponse, error) {
+			if _, err := f.Terminate(ctx, chasm.TerminateComponentRequest{
+				RequestID: req.GetFrontendRequest().GetRequestId(),
+				Identity:  req.GetFrontendRequest().GetIdentity(),
+				Reason:    req.GetFrontendRequest().GetReason(),
+			}); err != nil {
+				return nil, err
+			}
+			return &nexusoperationpb.TerminateNexusFanOutResponse{}, nil
+		}, req)
+
+	return resp, err
+}
+
 func idReusePolicyFromProto(p enumspb.NexusOperationIdReusePolicy) chasm.BusinessIDReusePolicy {
 	switch p {
 	case enumspb.NEXUS_OPERATION_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
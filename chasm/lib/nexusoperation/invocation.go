@@ -213,9 +213,13 @@ func (i *invocationSystem) Start(
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", errOpProcessorFailed, err)
 	}
+	shardID, err := res.RoutingKey.ShardID(i.config.ShardPools)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := i.historyClient.StartNexusOperation(ctx, &historyservice.StartNexusOperationRequest{
 		NamespaceId: i.ns.ID().String(),
-		ShardId:     res.RoutingKey.ShardID(i.config.NumHistoryShards),
+		ShardId:     shardID,
 		Request: &nexuspb.StartOperationRequest{
 			Service:        args.service,
 			Operation:      args.operation,
@@ -282,9 +286,13 @@ func (i *invocationSystem) Cancel(
 		return fmt.Errorf("%w: %w", errOpProcessorFailed, err)
 	}
 
+	shardID, err := res.RoutingKey.ShardID(i.config.ShardPools)
+	if err != nil {
+		return err
+	}
 	_, err = i.historyClient.CancelNexusOperation(ctx, &historyservice.CancelNexusOperationRequest{
 		NamespaceId: i.ns.ID().String(),
-		ShardId:     res.RoutingKey.ShardID(i.config.NumHistoryShards),
+		ShardId:     shardID,
 		Request: &nexuspb.CancelOperationRequest{
 			Service:        args.service,
 			Operation:      args.operation,
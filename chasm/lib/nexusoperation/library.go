@@ -1,20 +1,28 @@
 package nexusoperation
 
 import (
+	"github.com/nexus-rpc/sdk-go/nexus"
 	"go.temporal.io/server/chasm"
 	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"google.golang.org/grpc"
 )
 
 const (
-	libraryName   = "nexusoperation"
-	componentName = "operation"
+	libraryName         = "nexusoperation"
+	componentName       = "operation"
+	fanOutComponentName = "fanOut"
 )
 
 var (
 	Archetype   = chasm.FullyQualifiedName(libraryName, componentName)
 	ArchetypeID = chasm.GenerateTypeID(Archetype)
+
+	FanOutArchetype   = chasm.FullyQualifiedName(libraryName, fanOutComponentName)
+	FanOutArchetypeID = chasm.GenerateTypeID(FanOutArchetype)
 )
 
 type operationContextKeyType struct{}
@@ -33,11 +41,15 @@ type OperationContext struct {
 type componentOnlyLibrary struct {
 	chasm.UnimplementedLibrary
 	metricTagConfig dynamicconfig.TypedPropertyFn[NexusMetricTagConfig]
+	config          *Config
+	logger          log.Logger
 }
 
-func newComponentOnlyLibrary(dc *dynamicconfig.Collection) *componentOnlyLibrary {
+func newComponentOnlyLibrary(dc *dynamicconfig.Collection, config *Config, logger log.Logger) *componentOnlyLibrary {
 	return &componentOnlyLibrary{
 		metricTagConfig: MetricTagConfiguration.Get(dc),
+		config:          config,
+		logger:          logger,
 	}
 }
 
@@ -64,13 +76,33 @@ func (l *componentOnlyLibrary) Components() []*chasm.RegistrableComponent {
 			}),
 		),
 		chasm.NewRegistrableComponent[*Cancellation]("cancellation"),
+		chasm.NewRegistrableComponent[*FanOut](
+			fanOutComponentName,
+			chasm.WithSearchAttributes(
+				EndpointSearchAttribute,
+				ServiceSearchAttribute,
+				OperationSearchAttribute,
+			),
+			chasm.WithBusinessIDAlias("FanOutId"),
+		),
+	}
+}
+
+// NexusServiceProcessors is registered on the component-only library so that the frontend can validate and route
+// fan-outs started through the system Nexus endpoint.
+func (l *componentOnlyLibrary) NexusServiceProcessors() []*chasm.NexusServiceProcessor {
+	return []*chasm.NexusServiceProcessor{
+		// Fan-out requests carry no search attributes, so the validator needs no search attribute dependencies.
+		newFanOutNexusServiceProcessor(l.config, newValidator(l.config, l.logger, nil, nil)),
 	}
 }
 
 type Library struct {
 	componentOnlyLibrary
 
-	handler *handler
+	handler           *handler
+	namespaceRegistry namespace.Registry
+	endpointRegistry  commonnexus.EndpointRegistry
 
 	operationBackoffTaskHandler                *operationBackoffTaskHandler
 	operationInvocationTaskHandler             *operationInvocationTaskHandler
@@ -92,10 +124,16 @@ func newLibrary(
 	cancellationInvocationTaskHandler *cancellationInvocationTaskHandler,
 	cancellationBackoffTaskHandler *cancellationBackoffTaskHandler,
 	dc *dynamicconfig.Collection,
+	config *Config,
+	logger log.Logger,
+	namespaceRegistry namespace.Registry,
+	endpointRegistry commonnexus.EndpointRegistry,
 ) *Library {
 	return &Library{
-		componentOnlyLibrary:                       *newComponentOnlyLibrary(dc),
+		componentOnlyLibrary:                       *newComponentOnlyLibrary(dc, config, logger),
 		handler:                                    handler,
+		namespaceRegistry:                          namespaceRegistry,
+		endpointRegistry:                           endpointRegistry,
 		operationBackoffTaskHandler:                operationBackoffTaskHandler,
 		operationInvocationTaskHandler:             operationInvocationTaskHandler,
 		operationScheduleToCloseTimeoutTaskHandler: operationScheduleToCloseTimeoutTaskHandler,
@@ -126,6 +164,12 @@ func (l *Library) Tasks() []*chasm.RegistrableTask {
 	}
 }
 
+func (l *Library) NexusServices() []*nexus.Service {
+	return []*nexus.Service{
+		newFanOutNexusService(l.handler, l.namespaceRegistry, l.endpointRegistry),
+	}
+}
+
 func (l *Library) RegisterServices(server *grpc.Server) {
 	server.RegisterService(&nexusoperationpb.NexusOperationService_ServiceDesc, l.handler)
 }
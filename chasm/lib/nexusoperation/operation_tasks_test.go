@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/nexus/nexusrpc"
 	"go.temporal.io/server/common/nexus/nexustest"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/common/testing/protorequire"
 	queueserrors "go.temporal.io/server/service/history/queues/errors"
 	"go.uber.org/mock/gomock"
@@ -1186,7 +1187,7 @@ func TestInvocationTaskHandler_SystemEndpoint(t *testing.T) {
 			// Set up system endpoint dependencies.
 			historyClient := tc.setupHistoryClient(env.ctrl)
 			env.handler.historyClient = historyClient
-			env.handler.config.NumHistoryShards = 4
+			env.handler.config.ShardPools = shardpooltest.NewResolver(4)
 			env.handler.config.MaxOperationTokenLength = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000)
 			env.handler.chasmRegistry = tc.setupChasmRegistry()
 
@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/chasm"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -28,6 +29,23 @@ type cancelOrTerminateRequest interface {
 	GetReason() string
 }
 
+// closeFanOutRequest is the subset of the fan-out cancel and terminate requests that are validated the same way.
+type closeFanOutRequest interface {
+	GetNamespace() string
+	GetFanOutId() string
+	GetRunId() string
+	GetIdentity() string
+	GetReason() string
+}
+
+// targetRequest is the subset of the start requests that identify the Nexus operation to start.
+type targetRequest interface {
+	GetNamespace() string
+	GetEndpoint() string
+	GetService() string
+	GetOperation() string
+}
+
 // validator validates and normalizes the frontend requests for standalone Nexus operations.
 //
 // Requests are mutated in place: defaults are applied, values are capped to their configured
@@ -68,7 +86,12 @@ func (v *validator) validateAndNormalizeStartRequest(req *workflowservice.StartN
 	if err := v.validateTarget(req); err != nil {
 		return err
 	}
-	if err := v.validateAndCapTimeouts(req); err != nil {
+	if err := v.validateAndCapTimeouts(
+		ns,
+		&req.ScheduleToCloseTimeout,
+		&req.ScheduleToStartTimeout,
+		&req.StartToCloseTimeout,
+	); err != nil {
 		return err
 	}
 	if err := v.validateInput(req); err != nil {
@@ -156,6 +179,143 @@ func (v *validator) validateAndNormalizeDeleteRequest(req *workflowservice.Delet
 	return v.validateRunID(req.GetRunId())
 }
 
+func (v *validator) validateAndNormalizeStartFanOutRequest(req *nexusoperationpb.StartNexusFanOutExecutionRequest) error {
+	ns := req.GetNamespace()
+
+	if err := v.normalizeRequestID(&req.RequestId); err != nil {
+		return err
+	}
+	if err := v.validateFanOutID(req.GetFanOutId()); err != nil {
+		return err
+	}
+	if err := v.validateIDLength("identity", req.GetIdentity()); err != nil {
+		return err
+	}
+	if err := v.validateTarget(req); err != nil {
+		return err
+	}
+	if err := v.validateAndCapTimeouts(
+		ns,
+		&req.ScheduleToCloseTimeout,
+		&req.ScheduleToStartTimeout,
+		&req.StartToCloseTimeout,
+	); err != nil {
+		return err
+	}
+
+	inputCount := len(req.GetInputs())
+	if inputCount == 0 {
+		return serviceerror.NewInvalidArgument("inputs must contain at least one input")
+	}
+	if limit := v.config.MaxFanOutOperations(ns); inputCount > limit {
+		return serviceerror.NewInvalidArgumentf("inputs exceeds count limit. Count=%d Limit=%d", inputCount, limit)
+	}
+	// All inputs are stored with the fan-out, so the limit applies to their combined size.
+	inputSize := 0
+	for _, input := range req.GetInputs() {
+		inputSize += input.Size()
+	}
+	if limit := v.config.PayloadSizeLimit(ns); inputSize > limit {
+		return serviceerror.NewInvalidArgumentf("inputs exceed size limit. Length=%d Limit=%d", inputSize, limit)
+	}
+
+	loweredHeaders, err := v.validateAndLowercaseHeaders(ns, req.GetNexusHeader())
+	if err != nil {
+		return err
+	}
+	req.NexusHeader = loweredHeaders
+
+	switch req.GetCompletionPolicy() {
+	case nexusoperationpb.FAN_OUT_COMPLETION_POLICY_UNSPECIFIED:
+		req.CompletionPolicy = nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL
+		fallthrough
+	case nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL,
+		nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY:
+		if req.GetQuorum() != 0 {
+			return serviceerror.NewInvalidArgumentf("quorum must not be set with completion policy %s", req.GetCompletionPolicy())
+		}
+	case nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM:
+		if req.GetQuorum() < 1 || int(req.GetQuorum()) > inputCount {
+			return serviceerror.NewInvalidArgumentf("quorum must be between 1 and the number of inputs (%d)", inputCount)
+		}
+	default:
+		return serviceerror.NewInvalidArgumentf("unsupported completion_policy: %s", req.GetCompletionPolicy())
+	}
+
+	if req.GetMaxConcurrency() < 0 {
+		return serviceerror.NewInvalidArgument("max_concurrency must not be negative")
+	}
+	if limit := int32(v.config.MaxFanOutConcurrency(ns)); req.GetMaxConcurrency() == 0 || req.GetMaxConcurrency() > limit {
+		req.MaxConcurrency = limit
+	}
+	return nil
+}
+
+func (v *validator) validateAndNormalizeDescribeFanOutRequest(
+	req *nexusoperationpb.DescribeNexusFanOutExecutionRequest,
+	namespaceID string,
+) error {
+	if err := v.validateFanOutID(req.GetFanOutId()); err != nil {
+		return err
+	}
+	if len(req.GetLongPollToken()) > 0 && req.GetRunId() == "" {
+		return serviceerror.NewInvalidArgument("run_id is required when long_poll_token is provided")
+	}
+	if err := v.validateRunID(req.GetRunId()); err != nil {
+		return err
+	}
+	if len(req.GetLongPollToken()) > 0 {
+		ref, err := chasm.DeserializeComponentRef(req.GetLongPollToken())
+		if err != nil {
+			return serviceerror.NewInvalidArgument("invalid long poll token")
+		}
+		if ref.NamespaceID != namespaceID {
+			return serviceerror.NewInvalidArgument("long poll token does not match execution")
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateAndNormalizePollFanOutRequest(req *nexusoperationpb.PollNexusFanOutExecutionRequest) error {
+	if err := v.validateFanOutID(req.GetFanOutId()); err != nil {
+		return err
+	}
+	return v.validateRunID(req.GetRunId())
+}
+
+func (v *validator) validateAndNormalizeCancelFanOutRequest(req *nexusoperationpb.RequestCancelNexusFanOutExecutionRequest) error {
+	if err := v.normalizeRequestID(&req.RequestId); err != nil {
+		return err
+	}
+	return v.validateCloseFanOutRequest(req)
+}
+
+func (v *validator) validateAndNormalizeTerminateFanOutRequest(req *nexusoperationpb.TerminateNexusFanOutExecutionRequest) error {
+	if err := v.normalizeRequestID(&req.RequestId); err != nil {
+		return err
+	}
+	return v.validateCloseFanOutRequest(req)
+}
+
+// validateCloseFanOutRequest validates the fields the fan-out cancel and terminate requests have in
+// common.
+func (v *validator) validateCloseFanOutRequest(req closeFanOutRequest) error {
+	if err := v.validateFanOutID(req.GetFanOutId()); err != nil {
+		return err
+	}
+	if err := v.validateRunID(req.GetRunId()); err != nil {
+		return err
+	}
+	if err := v.validateIDLength("identity", req.GetIdentity()); err != nil {
+		return err
+	}
+	if limit := v.config.MaxReasonLength(req.GetNamespace()); len(req.GetReason()) > limit {
+		return serviceerror.NewInvalidArgumentf("reason exceeds length limit. Length=%d Limit=%d",
+			len(req.GetReason()), limit)
+	}
+	return nil
+}
+
 // validateCancelOrTerminateRequest validates the fields the cancel and terminate requests have in
 // common.
 func (v *validator) validateCancelOrTerminateRequest(req cancelOrTerminateRequest) error {
@@ -176,7 +336,7 @@ func (v *validator) validateCancelOrTerminateRequest(req cancelOrTerminateReques
 }
 
 // validateTarget checks the endpoint, service, and operation the request targets.
-func (v *validator) validateTarget(req *workflowservice.StartNexusOperationExecutionRequest) error {
+func (v *validator) validateTarget(req targetRequest) error {
 	ns := req.GetNamespace()
 	if req.GetEndpoint() == "" {
 		return serviceerror.NewInvalidArgument("endpoint is required")
@@ -200,34 +360,37 @@ func (v *validator) validateTarget(req *workflowservice.StartNexusOperationExecu
 
 // validateAndCapTimeouts validates the request timeouts, capping schedule_to_close_timeout to the
 // namespace limit and the remaining timeouts to schedule_to_close_timeout.
-func (v *validator) validateAndCapTimeouts(req *workflowservice.StartNexusOperationExecutionRequest) error {
-	if err := timestamp.ValidateAndCapProtoDuration(req.GetScheduleToCloseTimeout()); err != nil {
+func (v *validator) validateAndCapTimeouts(
+	ns string,
+	scheduleToCloseTimeout, scheduleToStartTimeout, startToCloseTimeout **durationpb.Duration,
+) error {
+	if err := timestamp.ValidateAndCapProtoDuration(*scheduleToCloseTimeout); err != nil {
 		return serviceerror.NewInvalidArgumentf("schedule_to_close_timeout is invalid: %v", err)
 	}
-	if err := timestamp.ValidateAndCapProtoDuration(req.GetScheduleToStartTimeout()); err != nil {
+	if err := timestamp.ValidateAndCapProtoDuration(*scheduleToStartTimeout); err != nil {
 		return serviceerror.NewInvalidArgumentf("schedule_to_start_timeout is invalid: %v", err)
 	}
-	if err := timestamp.ValidateAndCapProtoDuration(req.GetStartToCloseTimeout()); err != nil {
+	if err := timestamp.ValidateAndCapProtoDuration(*startToCloseTimeout); err != nil {
 		return serviceerror.NewInvalidArgumentf("start_to_close_timeout is invalid: %v", err)
 	}
 
-	scheduleToCloseTimeout := req.GetScheduleToCloseTimeout().AsDuration()
-	maxTimeout := v.config.MaxOperationScheduleToCloseTimeout(req.GetNamespace())
+	scheduleToClose := (*scheduleToCloseTimeout).AsDuration()
+	maxTimeout := v.config.MaxOperationScheduleToCloseTimeout(ns)
 	if maxTimeout > 0 {
-		if scheduleToCloseTimeout == 0 || scheduleToCloseTimeout > maxTimeout {
+		if scheduleToClose == 0 || scheduleToClose > maxTimeout {
 			// Apply the effective namespace limit to schedule_to_close_timeout before capping the other timeouts.
-			req.ScheduleToCloseTimeout = durationpb.New(maxTimeout)
-			scheduleToCloseTimeout = maxTimeout
+			*scheduleToCloseTimeout = durationpb.New(maxTimeout)
+			scheduleToClose = maxTimeout
 		}
 	}
 
 	// Bound schedule_to_start_timeout and start_to_close_timeout to schedule_to_close_timeout.
-	if scheduleToCloseTimeout > 0 {
-		if req.GetScheduleToStartTimeout().AsDuration() > scheduleToCloseTimeout {
-			req.ScheduleToStartTimeout = req.GetScheduleToCloseTimeout()
+	if scheduleToClose > 0 {
+		if (*scheduleToStartTimeout).AsDuration() > scheduleToClose {
+			*scheduleToStartTimeout = *scheduleToCloseTimeout
 		}
-		if req.GetStartToCloseTimeout().AsDuration() > scheduleToCloseTimeout {
-			req.StartToCloseTimeout = req.GetScheduleToCloseTimeout()
+		if (*startToCloseTimeout).AsDuration() > scheduleToClose {
+			*startToCloseTimeout = *scheduleToCloseTimeout
 		}
 	}
 	return nil
@@ -329,6 +492,13 @@ func (v *validator) validateOperationID(operationID string) error {
 	return v.validateIDLength("operation_id", operationID)
 }
 
+func (v *validator) validateFanOutID(fanOutID string) error {
+	if fanOutID == "" {
+		return serviceerror.NewInvalidArgument("fan_out_id is required")
+	}
+	return v.validateIDLength("fan_out_id", fanOutID)
+}
+
 // validateRunID checks that the run ID, if set, is a valid UUID.
 func (v *validator) validateRunID(runID string) error {
 	if runID == "" {
@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -788,3 +789,137 @@ func TestValidatePollNexusOperationExecutionRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStartNexusFanOutExecutionRequest(t *testing.T) {
+	config := &Config{
+		MaxIDLengthLimit:                   func() int { return 50 },
+		MaxServiceNameLength:               func(string) int { return 10 },
+		MaxOperationNameLength:             func(string) int { return 10 },
+		PayloadSizeLimit:                   func(string) int { return 100 },
+		MaxOperationHeaderSize:             func(string) int { return 10 },
+		DisallowedOperationHeaders:         func() []string { return []string{"disallowed-header"} },
+		MaxOperationScheduleToCloseTimeout: func(string) time.Duration { return time.Hour },
+		MaxFanOutOperations:                func(string) int { return 3 },
+		MaxFanOutConcurrency:               func(string) int { return 2 },
+	}
+
+	for _, tc := range []struct {
+		name   string
+		mutate func(*nexusoperationpb.StartNexusFanOutExecutionRequest)
+		errMsg string
+		check  func(*testing.T, *nexusoperationpb.StartNexusFanOutExecutionRequest)
+	}{
+		{
+			name: "valid request - defaults applied",
+			check: func(t *testing.T, r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				require.Equal(t, nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ALL, r.CompletionPolicy)
+				require.Equal(t, int32(2), r.MaxConcurrency)
+			},
+		},
+		{
+			name: "fan_out_id - required",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.FanOutId = ""
+			},
+			errMsg: "fan_out_id is required",
+		},
+		{
+			name: "inputs - required",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.Inputs = nil
+			},
+			errMsg: "inputs must contain at least one input",
+		},
+		{
+			name: "inputs - exceeds count limit",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.Inputs = append(r.Inputs, r.Inputs...)
+			},
+			errMsg: "inputs exceeds count limit",
+		},
+		{
+			name: "inputs - exceed size limit",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.Inputs = []*commonpb.Payload{{Data: []byte(strings.Repeat("x", 101))}}
+			},
+			errMsg: "inputs exceed size limit",
+		},
+		{
+			name: "quorum - not allowed with policy ANY",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.CompletionPolicy = nexusoperationpb.FAN_OUT_COMPLETION_POLICY_ANY
+				r.Quorum = 1
+			},
+			errMsg: "quorum must not be set",
+		},
+		{
+			name: "quorum - exceeds number of inputs",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.CompletionPolicy = nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM
+				r.Quorum = 3
+			},
+			errMsg: "quorum must be between 1 and the number of inputs",
+		},
+		{
+			name: "quorum - valid",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.CompletionPolicy = nexusoperationpb.FAN_OUT_COMPLETION_POLICY_QUORUM
+				r.Quorum = 2
+			},
+		},
+		{
+			name: "max_concurrency - negative",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.MaxConcurrency = -1
+			},
+			errMsg: "max_concurrency must not be negative",
+		},
+		{
+			name: "max_concurrency - within limit is kept",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.MaxConcurrency = 1
+			},
+			check: func(t *testing.T, r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				require.Equal(t, int32(1), r.MaxConcurrency)
+			},
+		},
+		{
+			name: "nexus_header - lowercased",
+			mutate: func(r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				r.NexusHeader = map[string]string{"Key": "v"}
+			},
+			check: func(t *testing.T, r *nexusoperationpb.StartNexusFanOutExecutionRequest) {
+				require.Equal(t, map[string]string{"key": "v"}, r.NexusHeader)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &nexusoperationpb.StartNexusFanOutExecutionRequest{
+				Namespace: "default",
+				FanOutId:  "fan-out-id",
+				RequestId: "request-id",
+				Endpoint:  "endpoint",
+				Service:   "service",
+				Operation: "operation",
+				Inputs: []*commonpb.Payload{
+					{Data: []byte("a")},
+					{Data: []byte("b")},
+				},
+			}
+			if tc.mutate != nil {
+				tc.mutate(req)
+			}
+			err := newTestValidator(config).validateAndNormalizeStartFanOutRequest(req)
+			if tc.errMsg != "" {
+				var invalidArgErr *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgErr)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.check != nil {
+				tc.check(t, req)
+			}
+		})
+	}
+}
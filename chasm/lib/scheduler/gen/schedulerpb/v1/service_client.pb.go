@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardpool"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 )
@@ -25,6 +26,7 @@ type SchedulerServiceLayeredClient struct {
 	numShards      int32
 	redirector     history.Redirector[SchedulerServiceClient]
 	retryPolicy    backoff.RetryPolicy
+	shardPools     *shardpool.Resolver
 }
 
 // NewSchedulerServiceLayeredClient initializes a new SchedulerServiceLayeredClient.
@@ -36,6 +38,7 @@ func NewSchedulerServiceLayeredClient(
 	config *config.Persistence,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	shardPools *shardpool.Resolver,
 ) (SchedulerServiceClient, error) {
 	resolver, err := monitor.GetResolver(primitives.HistoryService)
 	if err != nil {
@@ -58,6 +61,7 @@ func NewSchedulerServiceLayeredClient(
 		redirector:     redirector,
 		numShards:      config.NumHistoryShards,
 		retryPolicy:    common.CreateHistoryClientRetryPolicy(dynamicconfig.RetryUnboundedOnSystemResourceExhausted.Get(dc)),
+		shardPools:     shardPools,
 	}
 	lc.Append(fx.StopHook(client.Stop))
 	return client, nil
@@ -87,7 +91,10 @@ func (c *SchedulerServiceLayeredClient) callCreateScheduleNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -130,7 +137,10 @@ func (c *SchedulerServiceLayeredClient) callUpdateScheduleNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -173,7 +183,10 @@ func (c *SchedulerServiceLayeredClient) callPatchScheduleNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -216,7 +229,10 @@ func (c *SchedulerServiceLayeredClient) callDeleteScheduleNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -259,7 +275,10 @@ func (c *SchedulerServiceLayeredClient) callDescribeScheduleNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -302,7 +321,10 @@ func (c *SchedulerServiceLayeredClient) callListScheduleMatchingTimesNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetFrontendRequest().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -345,7 +367,10 @@ func (c *SchedulerServiceLayeredClient) callCreateFromMigrationStateNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetState().GetSchedulerState().GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetState().GetSchedulerState().GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -388,7 +413,10 @@ func (c *SchedulerServiceLayeredClient) callCreateSentinelNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -431,7 +459,10 @@ func (c *SchedulerServiceLayeredClient) callMigrateToWorkflowNoRetry(
 		}
 		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
-	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetScheduleId(), c.numShards)
+	shardID, err := c.shardPools.ShardID(request.GetNamespaceId(), request.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
 	op := func(ctx context.Context, client SchedulerServiceClient) error {
 		var err error
 		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
@@ -16,6 +16,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardpool"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 )
@@ -26,6 +27,7 @@ type TestServiceLayeredClient struct {
 	numShards      int32
 	redirector     history.Redirector[TestServiceClient]
 	retryPolicy    backoff.RetryPolicy
+	shardPools     *shardpool.Resolver
 }
 
 // NewTestServiceLayeredClient initializes a new TestServiceLayeredClient.
@@ -37,6 +39,7 @@ func NewTestServiceLayeredClient(
 	config *config.Persistence,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	shardPools *shardpool.Resolver,
 ) (TestServiceClient, error) {
 	resolver, err := monitor.GetResolver(primitives.HistoryService)
 	if err != nil {
@@ -59,6 +62,7 @@ func NewTestServiceLayeredClient(
 		redirector:     redirector,
 		numShards:      config.NumHistoryShards,
 		retryPolicy:    common.CreateHistoryClientRetryPolicy(dynamicconfig.RetryUnboundedOnSystemResourceExhausted.Get(dc)),
+		shardPools:     shardPools,
 	}
 	lc.Append(fx.StopHook(client.Stop))
 	return client, nil
@@ -6,9 +6,9 @@ import (
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	commonpb "go.temporal.io/api/common/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/namespace"
 	sdkconverter "go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardpool"
 )
 
 // NexusOperationProcessorContext contains context for processing a Nexus operation's input, including the target
@@ -28,8 +28,8 @@ type NexusOperationProcessorContext struct {
 // NexusOperationRoutingKey determines which history shard should process a Nexus operation.
 // Different implementations provide different routing strategies (e.g., by execution, random).
 type NexusOperationRoutingKey interface {
-	// ShardID returns the target shard ID for this routing key given the total number of shards.
-	ShardID(numShards int32) int32
+	// ShardID returns the target shard ID for this routing key given the cluster's shards and shard pools.
+	ShardID(shardPools *shardpool.Resolver) (int32, error)
 }
 
 // NexusOperationRoutingKeyExecution routes operations to a specific shard based on an execution key.
@@ -42,8 +42,8 @@ type NexusOperationRoutingKeyExecution struct {
 }
 
 // ShardID returns the shard that owns the execution identified by the namespace and business IDs.
-func (r NexusOperationRoutingKeyExecution) ShardID(numShards int32) int32 {
-	return common.WorkflowIDToHistoryShard(r.NamespaceID, r.BusinessID, numShards)
+func (r NexusOperationRoutingKeyExecution) ShardID(shardPools *shardpool.Resolver) (int32, error) {
+	return shardPools.ShardID(r.NamespaceID, r.BusinessID)
 }
 
 // NexusOperationRoutingKeyRandom routes operations to a random shard.
@@ -52,8 +52,8 @@ type NexusOperationRoutingKeyRandom struct {
 }
 
 // ShardID returns a randomly selected shard ID in the range [1, numShards].
-func (NexusOperationRoutingKeyRandom) ShardID(numShards int32) int32 {
-	return rand.Int32N(numShards) + 1
+func (NexusOperationRoutingKeyRandom) ShardID(shardPools *shardpool.Resolver) (int32, error) {
+	return rand.Int32N(shardPools.NumberOfShards()) + 1, nil
 }
 
 // NexusOperationProcessorResult contains the result of processing a Nexus operation input,
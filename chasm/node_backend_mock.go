@@ -28,6 +28,7 @@ type MockNodeBackend struct {
 	HandleNextTransitionCount         func() int64
 	HandleGetApproximatePersistedSize func() int
 	HandleChasmSkipPersistenceEnabled func() bool
+	HandleChasmSchemaUpgradeEnabled   func() bool
 	HandleCurrentVersionedTransition  func() *persistencespb.VersionedTransition
 	HandleGetWorkflowKey              func() definition.WorkflowKey
 	HandleUpdateWorkflowStateStatus   func(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) (bool, error)
@@ -79,6 +80,13 @@ func (m *MockNodeBackend) ChasmSkipPersistenceEnabled() bool {
 	return false
 }
 
+func (m *MockNodeBackend) ChasmSchemaUpgradeEnabled() bool {
+	if m.HandleChasmSchemaUpgradeEnabled != nil {
+		return m.HandleChasmSchemaUpgradeEnabled()
+	}
+	return false
+}
+
 func (m *MockNodeBackend) GetCurrentVersion() int64 {
 	if m.HandleGetCurrentVersion != nil {
 		return m.HandleGetCurrentVersion()
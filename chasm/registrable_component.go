@@ -27,6 +27,10 @@ type (
 		searchAttributesMapper *VisibilitySearchAttributesMapper
 
 		contextValues map[any]any
+
+		schemaVersion    uint32
+		minSchemaVersion uint32
+		schemaUpgraders  []SchemaUpgrader
 	}
 
 	RegistrableComponentOption func(*RegistrableComponent)
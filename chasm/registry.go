@@ -287,6 +287,9 @@ func (r *Registry) validate(rc *RegistrableComponent) error {
 	if err := r.validateName(rc.componentType); err != nil {
 		return err
 	}
+	if err := r.validateSchemaVersion(rc); err != nil {
+		return err
+	}
 	return r.validateVisibilityBusinessIDAlias(rc)
 }
 
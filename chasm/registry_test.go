@@ -390,6 +390,95 @@ func (s *RegistryTestSuite) TestRegistry_RegisterComponents_Error() {
 
 }
 
+func (s *RegistryTestSuite) TestRegistry_RegisterComponents_SchemaVersion() {
+	ctrl := gomock.NewController(s.T())
+	lib := chasm.NewMockLibrary(ctrl)
+	lib.EXPECT().Name().Return("TestLibrary").AnyTimes()
+
+	noopUpgrade := func(chasm.MutableContext, *chasm.MockComponent) error { return nil }
+
+	s.Run("missing schema upgrader", func() {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSchemaVersion(2, chasm.NewSchemaUpgrader(1, noopUpgrade)),
+			),
+		})
+		r := chasm.NewRegistry(s.logger)
+		err := r.Register(lib)
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "has no schema upgrader from version 0 to 1")
+	})
+
+	s.Run("schema upgrader for another type", func() {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSchemaVersion(1, chasm.NewSchemaUpgrader(0, func(chasm.MutableContext, *testComponentWithVisibility) error {
+					return nil
+				})),
+			),
+		})
+		r := chasm.NewRegistry(s.logger)
+		err := r.Register(lib)
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "is defined for type")
+	})
+
+	s.Run("schema upgrader out of range", func() {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSchemaVersion(1,
+					chasm.NewSchemaUpgrader(0, noopUpgrade),
+					chasm.NewSchemaUpgrader(1, noopUpgrade),
+				),
+			),
+		})
+		r := chasm.NewRegistry(s.logger)
+		err := r.Register(lib)
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "outside of the supported range")
+	})
+
+	s.Run("minimum schema version greater than schema version", func() {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithMinSchemaVersion(1),
+			),
+		})
+		r := chasm.NewRegistry(s.logger)
+		err := r.Register(lib)
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "minimum schema version 1 is greater than its schema version 0")
+	})
+
+	s.Run("minimum schema version allows partial upgrader chain", func() {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSchemaVersion(2, chasm.NewSchemaUpgrader(1, noopUpgrade)),
+				chasm.WithMinSchemaVersion(1),
+			),
+		})
+		lib.EXPECT().Tasks().Return(nil)
+		lib.EXPECT().NexusServices().Return(nil)
+		lib.EXPECT().NexusServiceProcessors().Return(nil)
+		r := chasm.NewRegistry(s.logger)
+		err := r.Register(lib)
+		s.Require().NoError(err)
+
+		rc, ok := r.Component("TestLibrary.Component1")
+		s.Require().True(ok)
+		s.Require().Equal(uint32(2), rc.SchemaVersion())
+		id, ok := r.ComponentIDByFqn("TestLibrary.Component1")
+		s.Require().True(ok)
+		s.Require().True(r.ComponentNeedsSchemaUpgrade(id, 1))
+		s.Require().False(r.ComponentNeedsSchemaUpgrade(id, 2))
+	})
+}
+
 func (s *RegistryTestSuite) TestRegistry_RegisterTasks_Error() {
 	ctrl := gomock.NewController(s.T())
 	lib := chasm.NewMockLibrary(ctrl)
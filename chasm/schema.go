@@ -0,0 +1,188 @@
+package chasm
+
+import (
+	"fmt"
+	"reflect"
+
+	commonpb "go.temporal.io/api/common/v1"
+	sdkpb "go.temporal.io/api/sdk/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	// SchemaUpgrader upgrades the state of a component from one schema version to the next.
+	// Use NewSchemaUpgrader to create one, and WithSchemaVersion to register it.
+	SchemaUpgrader struct {
+		fromVersion uint32
+		goType      reflect.Type
+		upgrade     func(MutableContext, Component) error
+	}
+)
+
+// inMemoryUpgradeCtx is the MutableContext that upgraders run with when a component with an older
+// schema version is accessed with a read-only context. The upgrade is only applied to the value
+// returned to the reader and is never persisted, so changes to the tree other than the component's
+// own fields are dropped.
+type inMemoryUpgradeCtx struct {
+	*immutableCtx
+}
+
+func newInMemoryUpgradeCtx(chasmContext Context, node *Node) *inMemoryUpgradeCtx {
+	return &inMemoryUpgradeCtx{
+		immutableCtx: newContext(chasmContext.goContext(), node),
+	}
+}
+
+func (c *inMemoryUpgradeCtx) AddTask(Component, TaskAttributes, any) {}
+
+func (c *inMemoryUpgradeCtx) SetRequestLinks(Component, string, []*commonpb.Link) error {
+	return nil
+}
+
+func (c *inMemoryUpgradeCtx) SetUserMetadata(Component, *sdkpb.UserMetadata) error {
+	return nil
+}
+
+func (c *inMemoryUpgradeCtx) withValue(key any, value any) Context {
+	return &inMemoryUpgradeCtx{
+		immutableCtx: ContextWithValue(c.immutableCtx, key, value),
+	}
+}
+
+// NewSchemaUpgrader creates an upgrader that migrates the state of a component of type C from
+// schema version fromVersion to fromVersion+1.
+//
+// The upgrade function is called with a fully deserialized component, as stored at fromVersion,
+// and may use the MutableContext like any other transition, e.g. to populate new fields or move
+// state into new sub-components. It must be deterministic and idempotent: it may be applied more
+// than once if the transaction that persists the upgrade fails, and every time the component is
+// loaded before the upgrade is persisted, including to state that was written by this server
+// version while the upgrade is not enabled yet (see dynamicconfig.EnableCHASMSchemaUpgrade). Tasks,
+// links and metadata added by an upgrade that is applied for a read are dropped.
+func NewSchemaUpgrader[C Component](
+	fromVersion uint32,
+	upgrade func(MutableContext, C) error,
+) SchemaUpgrader {
+	return SchemaUpgrader{
+		fromVersion: fromVersion,
+		goType:      reflect.TypeFor[C](),
+		upgrade: func(ctx MutableContext, c Component) error {
+			return upgrade(ctx, c.(C))
+		},
+	}
+}
+
+// WithSchemaVersion declares the current schema version of the component's state and the
+// upgraders that migrate state persisted with older schema versions to it.
+//
+// Components without a declared schema version are at version 0. When the version is bumped, an
+// upgrader must be registered for every version between the minimum schema version (see
+// WithMinSchemaVersion) and the new version, otherwise the registry refuses to register the
+// component. Once the upgrade is enabled with dynamicconfig.EnableCHASMSchemaUpgrade, after every
+// host runs a server version with the upgraders, persisted state is upgraded lazily, the first
+// time an execution is loaded on an active cluster, and eagerly by the executions scanner. Until
+// then, e.g. on standby clusters, components are upgraded in memory every time they are loaded.
+func WithSchemaVersion(
+	version uint32,
+	upgraders ...SchemaUpgrader,
+) RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.schemaVersion = version
+		rc.schemaUpgraders = upgraders
+	}
+}
+
+// WithMinSchemaVersion declares the oldest schema version that may still be persisted for the
+// component. Upgraders from older versions can be removed once all executions were migrated past
+// them. Defaults to 0.
+func WithMinSchemaVersion(
+	version uint32,
+) RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.minSchemaVersion = version
+	}
+}
+
+// SchemaVersion returns the current schema version of the component's state.
+func (rc *RegistrableComponent) SchemaVersion() uint32 {
+	return rc.schemaVersion
+}
+
+func (rc *RegistrableComponent) needsSchemaUpgrade(storedVersion uint32) bool {
+	return storedVersion < rc.schemaVersion
+}
+
+// upgradeSchema runs the upgraders of the component, in order, starting from storedVersion.
+func (rc *RegistrableComponent) upgradeSchema(
+	ctx MutableContext,
+	component Component,
+	storedVersion uint32,
+) error {
+	if storedVersion < rc.minSchemaVersion {
+		return serviceerror.NewInternalf(
+			"component %s has schema version %d, which is older than the minimum supported version %d",
+			rc.fqType(), storedVersion, rc.minSchemaVersion)
+	}
+	for version := storedVersion; version < rc.schemaVersion; version++ {
+		upgrader, ok := rc.schemaUpgraderFrom(version)
+		if !ok {
+			// Registry validation guarantees that the upgrader chain is complete.
+			return serviceerror.NewInternalf("component %s has no upgrader from schema version %d", rc.fqType(), version)
+		}
+		if err := upgrader.upgrade(ctx, component); err != nil {
+			return fmt.Errorf("failed to upgrade component %s from schema version %d: %w", rc.fqType(), version, err)
+		}
+	}
+	return nil
+}
+
+func (rc *RegistrableComponent) schemaUpgraderFrom(version uint32) (SchemaUpgrader, bool) {
+	for _, upgrader := range rc.schemaUpgraders {
+		if upgrader.fromVersion == version {
+			return upgrader, true
+		}
+	}
+	return SchemaUpgrader{}, false
+}
+
+// validateSchemaVersion validates that state persisted with any supported schema version of the
+// component can be upgraded to its current version.
+func (r *Registry) validateSchemaVersion(rc *RegistrableComponent) error {
+	if rc.minSchemaVersion > rc.schemaVersion {
+		return fmt.Errorf("component %s minimum schema version %d is greater than its schema version %d",
+			rc.componentType, rc.minSchemaVersion, rc.schemaVersion)
+	}
+
+	seen := make(map[uint32]struct{}, len(rc.schemaUpgraders))
+	for _, upgrader := range rc.schemaUpgraders {
+		if upgrader.goType != rc.goType {
+			return fmt.Errorf("component %s schema upgrader from version %d is defined for type %s",
+				rc.componentType, upgrader.fromVersion, upgrader.goType.String())
+		}
+		if upgrader.fromVersion < rc.minSchemaVersion || upgrader.fromVersion >= rc.schemaVersion {
+			return fmt.Errorf("component %s schema upgrader from version %d is outside of the supported range [%d, %d)",
+				rc.componentType, upgrader.fromVersion, rc.minSchemaVersion, rc.schemaVersion)
+		}
+		if _, ok := seen[upgrader.fromVersion]; ok {
+			return fmt.Errorf("component %s has multiple schema upgraders from version %d", rc.componentType, upgrader.fromVersion)
+		}
+		seen[upgrader.fromVersion] = struct{}{}
+	}
+
+	for version := rc.minSchemaVersion; version < rc.schemaVersion; version++ {
+		if _, ok := seen[version]; !ok {
+			return fmt.Errorf("component %s has no schema upgrader from version %d to %d; register one or raise the minimum schema version",
+				rc.componentType, version, version+1)
+		}
+	}
+	return nil
+}
+
+// ComponentNeedsSchemaUpgrade reports whether the persisted state of a component with the given
+// type ID and schema version must be upgraded before it can be used.
+// This method should only be used by CHASM framework internal code,
+// NOT CHASM library developers.
+func (r *Registry) ComponentNeedsSchemaUpgrade(typeID uint32, storedVersion uint32) bool {
+	rc, ok := r.rcByID[typeID]
+	return ok && rc.needsSchemaUpgrade(storedVersion)
+}
@@ -0,0 +1,136 @@
+package chasm
+
+import (
+	"context"
+	"reflect"
+)
+
+// schemaTestLibrary registers TestSubComponent1 with a newer schema version. Its upgrader from
+// version 0 moves part of the component's data into a new sub-component.
+type schemaTestLibrary struct {
+	*TestLibrary
+}
+
+const upgradedRequestID = "upgraded-request-id"
+
+func (l *schemaTestLibrary) Components() []*RegistrableComponent {
+	components := l.TestLibrary.Components()
+	for _, rc := range components {
+		if rc.goType != reflect.TypeFor[*TestSubComponent1]() {
+			continue
+		}
+		WithSchemaVersion(1, NewSchemaUpgrader(0, func(ctx MutableContext, c *TestSubComponent1) error {
+			if c.SubComponent1Data.GetCreateRequestId() == upgradedRequestID {
+				// Already upgraded, but not persisted with the new schema version.
+				return nil
+			}
+			c.SubComponent11_2 = NewComponentField(ctx, &TestSubComponent11{
+				SubComponent11Data: &protoMessageType{CreateRequestId: c.SubComponent1Data.GetCreateRequestId()},
+			})
+			c.SubComponent1Data.CreateRequestId = upgradedRequestID
+			return nil
+		}))(rc)
+	}
+	return components
+}
+
+func (s *nodeSuite) TestUpgradeSchema() {
+	registry := NewRegistry(s.logger)
+	s.NoError(registry.Register(&schemaTestLibrary{TestLibrary: s.testLibrary}))
+	s.NoError(registry.Register(&CoreLibrary{}))
+
+	s.nodeBackend.HandleChasmSkipPersistenceEnabled = func() bool { return true }
+	s.nodeBackend.HandleChasmSchemaUpgradeEnabled = func() bool { return true }
+	root, err := NewTreeFromDB(testComponentSerializedNodes(), registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger, s.metricsHandler)
+	s.NoError(err)
+	s.True(root.NeedsSchemaUpgrade())
+
+	// A read-only access sees the state upgraded in memory, without persisting the upgrade.
+	readCtx := NewContext(context.Background(), root)
+	component, err := root.ComponentByPath(readCtx, []string{"SubComponent1"})
+	s.NoError(err)
+	sc1 := component.(*TestSubComponent1)
+	s.Equal(upgradedRequestID, sc1.SubComponent1Data.GetCreateRequestId())
+	s.Equal("sub-component1-data", sc1.SubComponent11_2.Get(readCtx).SubComponent11Data.GetCreateRequestId())
+	s.False(root.IsDirty())
+	s.True(root.NeedsSchemaUpgrade())
+
+	// Reading again applies the upgrade to freshly deserialized state.
+	component, err = root.ComponentByPath(readCtx, []string{"SubComponent1"})
+	s.NoError(err)
+	s.Equal(upgradedRequestID, component.(*TestSubComponent1).SubComponent1Data.GetCreateRequestId())
+	s.False(root.IsDirty())
+
+	s.NoError(root.UpgradeSchema())
+	mutation, err := root.CloseTransaction()
+	s.NoError(err)
+	s.Len(mutation.UpdatedNodes, 2)
+	s.Equal(uint32(1), mutation.UpdatedNodes["SubComponent1"].GetMetadata().GetComponentAttributes().GetSchemaVersion())
+	s.Contains(mutation.UpdatedNodes, "SubComponent1/SubComponent11_2")
+	s.False(root.NeedsSchemaUpgrade())
+
+	// The upgraded state can be read from a tree loaded from the persisted nodes.
+	serializedNodes := testComponentSerializedNodes()
+	for path, node := range mutation.UpdatedNodes {
+		serializedNodes[path] = node
+	}
+	root, err = NewTreeFromDB(serializedNodes, registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger, s.metricsHandler)
+	s.NoError(err)
+	s.False(root.NeedsSchemaUpgrade())
+
+	readCtx = NewContext(context.Background(), root)
+	component, err = root.ComponentByPath(readCtx, []string{"SubComponent1"})
+	s.NoError(err)
+	sc1 = component.(*TestSubComponent1)
+	s.Equal(upgradedRequestID, sc1.SubComponent1Data.GetCreateRequestId())
+	s.Equal("sub-component1-data", sc1.SubComponent11_2.Get(readCtx).SubComponent11Data.GetCreateRequestId())
+}
+
+func (s *nodeSuite) TestUpgradeSchema_NewerSchemaVersion() {
+	serializedNodes := testComponentSerializedNodes()
+	serializedNodes["SubComponent1"].GetMetadata().GetComponentAttributes().SchemaVersion = 1
+
+	root, err := s.newTestTree(serializedNodes)
+	s.NoError(err)
+	s.False(root.NeedsSchemaUpgrade())
+
+	_, err = root.ComponentByPath(NewMutableContext(context.Background(), root), []string{"SubComponent1"})
+	s.ErrorContains(err, "newer than the supported version")
+}
+
+func (s *nodeSuite) TestUpgradeSchema_NotEnabled() {
+	registry := NewRegistry(s.logger)
+	s.NoError(registry.Register(&schemaTestLibrary{TestLibrary: s.testLibrary}))
+	s.NoError(registry.Register(&CoreLibrary{}))
+
+	root, err := NewTreeFromDB(testComponentSerializedNodes(), registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger, s.metricsHandler)
+	s.NoError(err)
+
+	// A mutation uses the upgraded state, but keeps the persisted schema version, so that older
+	// server versions can still load the component.
+	mutableCtx := NewMutableContext(context.Background(), root)
+	component, err := root.ComponentByPath(mutableCtx, []string{"SubComponent1"})
+	s.NoError(err)
+	s.Equal(upgradedRequestID, component.(*TestSubComponent1).SubComponent1Data.GetCreateRequestId())
+	mutation, err := root.CloseTransaction()
+	s.NoError(err)
+	s.Equal(uint32(0), mutation.UpdatedNodes["SubComponent1"].GetMetadata().GetComponentAttributes().GetSchemaVersion())
+	s.Equal(uint32(0), mutation.UpdatedNodes["SubComponent1/SubComponent11_2"].GetMetadata().GetComponentAttributes().GetSchemaVersion())
+	s.True(root.NeedsSchemaUpgrade())
+
+	// The upgrade is applied again when the component is loaded.
+	serializedNodes := testComponentSerializedNodes()
+	for path, node := range mutation.UpdatedNodes {
+		serializedNodes[path] = node
+	}
+	root, err = NewTreeFromDB(serializedNodes, registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger, s.metricsHandler)
+	s.NoError(err)
+	s.True(root.NeedsSchemaUpgrade())
+
+	readCtx := NewContext(context.Background(), root)
+	component, err = root.ComponentByPath(readCtx, []string{"SubComponent1"})
+	s.NoError(err)
+	sc1 := component.(*TestSubComponent1)
+	s.Equal(upgradedRequestID, sc1.SubComponent1Data.GetCreateRequestId())
+	s.Equal("sub-component1-data", sc1.SubComponent11_2.Get(readCtx).SubComponent11Data.GetCreateRequestId())
+}
@@ -209,6 +209,7 @@ type (
 		GetExecutionInfo() *persistencespb.WorkflowExecutionInfo
 		GetApproximatePersistedSize() int
 		ChasmSkipPersistenceEnabled() bool
+		ChasmSchemaUpgradeEnabled() bool
 		GetNamespaceEntry() *namespace.Namespace
 		GetCurrentVersion() int64
 		NextTransitionCount() int64
@@ -615,9 +616,32 @@ func (n *Node) prepareComponentValue(
 				fmt.Errorf("%d", componentAttr.GetTypeId()))
 		}
 
+		storedSchemaVersion := componentAttr.GetSchemaVersion()
+		if storedSchemaVersion > registrableComponent.schemaVersion {
+			// The state was written by a newer server version, e.g. during a rolling deployment.
+			return serviceerror.NewUnavailablef(
+				"component %s has schema version %d, which is newer than the supported version %d",
+				registrableComponent.fqType(), storedSchemaVersion, registrableComponent.schemaVersion)
+		}
 		if err := n.deserialize(registrableComponent.goType); err != nil {
 			return fmt.Errorf("failed to deserialize component: %w", err)
 		}
+
+		if registrableComponent.needsSchemaUpgrade(storedSchemaVersion) {
+			mutableContext, isMutableContext := chasmContext.(MutableContext)
+			if !isMutableContext {
+				// Read-only accesses, e.g. on a standby cluster, get the state upgraded in memory only.
+				// The node still needs to be deserialized, so the upgrade is applied again on its next
+				// access and persisted by the first transaction on the active cluster.
+				mutableContext = newInMemoryUpgradeCtx(chasmContext, n)
+				defer n.setValueState(valueStateNeedDeserialize)
+			}
+			// The upgraded schema version is persisted when the node is serialized.
+			//nolint:revive // value is guaranteed to be a Component after deserialization.
+			if err := registrableComponent.upgradeSchema(mutableContext, n.value.(Component), storedSchemaVersion); err != nil {
+				return err
+			}
+		}
 	}
 
 	// For now, we assume if a node is accessed with a MutableContext,
@@ -847,7 +871,7 @@ func (n *Node) serialize() error {
 }
 
 // serializeComponentNode serializes the component node.
-// If this method is updated to modify serialized fields beyond Data and
+// If this method is updated to modify serialized fields beyond Data, SchemaVersion and
 // LastUpdateVersionedTransition, the skip-if-clean revert logic in
 // closeTransactionSerializeNodes must be updated accordingly.
 func (n *Node) serializeComponentNode() error {
@@ -870,14 +894,14 @@ func (n *Node) serializeComponentNode() error {
 
 		n.serializedNode.Data = blob
 
+		rc, ok := n.registry.componentFor(n.value)
+		if !ok {
+			return softassert.UnexpectedInternalErr(
+				n.logger,
+				"component type is not registered",
+				fmt.Errorf("%s", reflect.TypeOf(n.value).String()))
+		}
 		if n.serializedNode.GetMetadata().GetLastUpdateVersionedTransition() == nil {
-			rc, ok := n.registry.componentFor(n.value)
-			if !ok {
-				return softassert.UnexpectedInternalErr(
-					n.logger,
-					"component type is not registered",
-					fmt.Errorf("%s", reflect.TypeOf(n.value).String()))
-			}
 			// TypeId mismatch on a brand new node indicates node reassignment.
 			existingTypeID := n.serializedNode.GetMetadata().GetComponentAttributes().GetTypeId()
 			if existingTypeID != 0 && existingTypeID != rc.componentID {
@@ -889,6 +913,18 @@ func (n *Node) serializeComponentNode() error {
 			}
 			n.serializedNode.GetMetadata().GetComponentAttributes().TypeId = rc.componentID
 		}
+		// The component was upgraded to the current schema version, if needed, when it was
+		// prepared for mutation. Until the upgrade is enabled, older server versions may still
+		// load the component, so its persisted schema version is kept, and new components are
+		// persisted with the oldest schema version they may have. The upgraders are applied again
+		// when the component is loaded.
+		componentAttr := n.serializedNode.GetMetadata().GetComponentAttributes()
+		switch {
+		case n.backend.ChasmSchemaUpgradeEnabled():
+			componentAttr.SchemaVersion = rc.schemaVersion
+		case n.serializedNode.GetMetadata().GetLastUpdateVersionedTransition() == nil:
+			componentAttr.SchemaVersion = rc.minSchemaVersion
+		}
 
 		n.updateLastUpdateVersionedTransition()
 		n.setValueState(valueStateSynced)
@@ -1959,16 +1995,20 @@ func (n *Node) closeTransactionSerializeNodes() error {
 			prevVersionedTransition != nil &&
 			!node.hasNewTransactionSideEffects()
 		var prevData *commonpb.DataBlob
+		var prevSchemaVersion uint32
 		if skipIfClean {
 			prevData = node.serializedNode.Data
+			prevSchemaVersion = node.serializedNode.GetMetadata().GetComponentAttributes().GetSchemaVersion()
 		}
 
 		if err := node.serialize(); err != nil {
 			return err
 		}
 
-		// Data bytes unchanged: revert the versioned transition bump and skip persistence.
-		if skipIfClean && bytes.Equal(prevData.GetData(), node.serializedNode.Data.GetData()) {
+		// Data bytes and schema version unchanged: revert the versioned transition bump and skip persistence.
+		if skipIfClean &&
+			bytes.Equal(prevData.GetData(), node.serializedNode.Data.GetData()) &&
+			prevSchemaVersion == node.serializedNode.GetMetadata().GetComponentAttributes().GetSchemaVersion() {
 			node.serializedNode.GetMetadata().LastUpdateVersionedTransition = prevVersionedTransition
 			continue
 		}
@@ -2880,6 +2920,48 @@ func (n *Node) applyUpdates(
 	return nil
 }
 
+// NeedsSchemaUpgrade returns true if the persisted state of any component in the tree has an
+// older schema version than the one registered for the component.
+func (n *Node) NeedsSchemaUpgrade() bool {
+	for _, node := range n.andAllChildren() {
+		componentAttr := node.serializedNode.GetMetadata().GetComponentAttributes()
+		if componentAttr == nil {
+			continue
+		}
+		if n.registry.ComponentNeedsSchemaUpgrade(componentAttr.GetTypeId(), componentAttr.GetSchemaVersion()) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpgradeSchema upgrades all components in the tree whose persisted state has an older schema
+// version to the version registered for the component, parents before their children. Like any
+// other change, the upgrade is persisted when CloseTransaction() is called.
+func (n *Node) UpgradeSchema() error {
+	var staleNodes []*Node
+	for _, node := range n.andAllChildren() {
+		componentAttr := node.serializedNode.GetMetadata().GetComponentAttributes()
+		if componentAttr != nil &&
+			node.valueState == valueStateNeedDeserialize &&
+			n.registry.ComponentNeedsSchemaUpgrade(componentAttr.GetTypeId(), componentAttr.GetSchemaVersion()) {
+			staleNodes = append(staleNodes, node)
+		}
+	}
+	slices.SortFunc(staleNodes, func(a, b *Node) int {
+		return cmp.Compare(len(a.path()), len(b.path()))
+	})
+
+	mutableContext := NewMutableContext(context.TODO(), n)
+	for _, node := range staleNodes {
+		// A parent's upgrader may have already accessed, and thus upgraded, the node.
+		if err := node.prepareComponentValue(mutableContext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n *Node) RefreshTasks() error {
 	for _, node := range n.andAllChildren() {
 		// Only reset task status here, the actual task generation will be done when
@@ -1667,7 +1667,7 @@ func TestPartitionedSnapshot_ClusterLocalFieldGuard(t *testing.T) {
 		},
 		{
 			&persistencespb.ChasmComponentAttributes{},
-			[]string{"type_id", "side_effect_tasks", "pure_tasks", "detached", "requests", "user_metadata"},
+			[]string{"type_id", "side_effect_tasks", "pure_tasks", "detached", "requests", "user_metadata", "schema_version"},
 		},
 		{
 			// The only message carrying a cluster-local field today (physical_task_status).
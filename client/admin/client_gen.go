@@ -49,6 +49,16 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CloneWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.CloneWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CloneWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CloneWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -79,6 +89,16 @@ func (c *clientImpl) DeleteWorkflowExecution(
 	return c.client.DeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.DescribeChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeChainedActivityExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeChainedActivityExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -119,6 +139,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeMutableStateAtEvent(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateAtEventRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateAtEventResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeMutableStateAtEvent(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +159,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskScheduler(
+	ctx context.Context,
+	request *adminservice.DescribeTaskSchedulerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskSchedulerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskScheduler(ctx, request, opts...)
+}
+
+func (c *clientImpl) DryRunResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DryRunResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DryRunResetWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DryRunResetWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -199,6 +249,16 @@ func (c *clientImpl) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationLag(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -249,6 +309,16 @@ func (c *clientImpl) GetTaskQueueUserData(
 	return c.client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetTaskTrace(
+	ctx context.Context,
+	request *adminservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskTraceResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetTaskTrace(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -309,6 +379,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListQueueMitigationsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -319,6 +399,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkflowConflictResolutions(
+	ctx context.Context,
+	request *adminservice.ListWorkflowConflictResolutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkflowConflictResolutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkflowConflictResolutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -349,6 +439,16 @@ func (c *clientImpl) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveHistoryShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveHistoryShard(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -449,6 +549,16 @@ func (c *clientImpl) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *clientImpl) StartChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.StartChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartChainedActivityExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartChainedActivityExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -458,3 +568,33 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UnpinHistoryShard(
+	ctx context.Context,
+	request *adminservice.UnpinHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpinHistoryShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpinHistoryShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskSchedulerNamespaceWeight(
+	ctx context.Context,
+	request *adminservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+}
@@ -65,6 +65,20 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CloneWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.CloneWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CloneWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCloneWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CloneWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -107,6 +121,20 @@ func (c *metricClient) DeleteWorkflowExecution(
 	return c.client.DeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.DescribeChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeChainedActivityExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeChainedActivityExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeChainedActivityExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -163,6 +191,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeMutableStateAtEvent(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateAtEventRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeMutableStateAtEventResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeMutableStateAtEvent")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeMutableStateAtEvent(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -177,6 +219,34 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeTaskScheduler(
+	ctx context.Context,
+	request *adminservice.DescribeTaskSchedulerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskSchedulerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeTaskScheduler")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskScheduler(ctx, request, opts...)
+}
+
+func (c *metricClient) DryRunResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DryRunResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DryRunResetWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDryRunResetWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DryRunResetWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -275,6 +345,20 @@ func (c *metricClient) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetReplicationLagResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetReplicationLag")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetReplicationLag(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -345,6 +429,20 @@ func (c *metricClient) GetTaskQueueUserData(
 	return c.client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *metricClient) GetTaskTrace(
+	ctx context.Context,
+	request *adminservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetTaskTraceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetTaskTrace")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetTaskTrace(ctx, request, opts...)
+}
+
 func (c *metricClient) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -429,6 +527,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListQueueMitigationsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListQueueMitigations")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -443,6 +555,20 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListWorkflowConflictResolutions(
+	ctx context.Context,
+	request *adminservice.ListWorkflowConflictResolutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListWorkflowConflictResolutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListWorkflowConflictResolutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListWorkflowConflictResolutions(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -485,6 +611,20 @@ func (c *metricClient) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveHistoryShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMoveHistoryShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveHistoryShard(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -625,6 +765,20 @@ func (c *metricClient) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *metricClient) StartChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.StartChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartChainedActivityExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartChainedActivityExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartChainedActivityExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -638,3 +792,45 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UnpinHistoryShard(
+	ctx context.Context,
+	request *adminservice.UnpinHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpinHistoryShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnpinHistoryShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpinHistoryShard(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNamespaceReplicationFilterResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNamespaceReplicationFilter")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateTaskSchedulerNamespaceWeight(
+	ctx context.Context,
+	request *adminservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskSchedulerNamespaceWeightResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskSchedulerNamespaceWeight")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+}
@@ -71,6 +71,21 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CloneWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.CloneWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CloneWorkflowExecutionResponse, error) {
+	var resp *adminservice.CloneWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CloneWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -116,6 +131,21 @@ func (c *retryableClient) DeleteWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.DescribeChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeChainedActivityExecutionResponse, error) {
+	var resp *adminservice.DescribeChainedActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeChainedActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -176,6 +206,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeMutableStateAtEvent(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateAtEventRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateAtEventResponse, error) {
+	var resp *adminservice.DescribeMutableStateAtEventResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeMutableStateAtEvent(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +236,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskScheduler(
+	ctx context.Context,
+	request *adminservice.DescribeTaskSchedulerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskSchedulerResponse, error) {
+	var resp *adminservice.DescribeTaskSchedulerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskScheduler(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DryRunResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DryRunResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DryRunResetWorkflowExecutionResponse, error) {
+	var resp *adminservice.DryRunResetWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DryRunResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -296,6 +371,21 @@ func (c *retryableClient) GetNamespaceReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	var resp *adminservice.GetReplicationLagResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -371,6 +461,21 @@ func (c *retryableClient) GetTaskQueueUserData(
 	return resp, err
 }
 
+func (c *retryableClient) GetTaskTrace(
+	ctx context.Context,
+	request *adminservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskTraceResponse, error) {
+	var resp *adminservice.GetTaskTraceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskTrace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -461,6 +566,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListQueueMitigationsResponse, error) {
+	var resp *adminservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -476,6 +596,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListWorkflowConflictResolutions(
+	ctx context.Context,
+	request *adminservice.ListWorkflowConflictResolutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkflowConflictResolutionsResponse, error) {
+	var resp *adminservice.ListWorkflowConflictResolutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkflowConflictResolutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -521,6 +656,21 @@ func (c *retryableClient) MigrateSchedule(
 	return resp, err
 }
 
+func (c *retryableClient) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveHistoryShardResponse, error) {
+	var resp *adminservice.MoveHistoryShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveHistoryShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -671,6 +821,21 @@ func (c *retryableClient) StartAdminBatchOperation(
 	return resp, err
 }
 
+func (c *retryableClient) StartChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.StartChainedActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartChainedActivityExecutionResponse, error) {
+	var resp *adminservice.StartChainedActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartChainedActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -685,3 +850,48 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UnpinHistoryShard(
+	ctx context.Context,
+	request *adminservice.UnpinHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpinHistoryShardResponse, error) {
+	var resp *adminservice.UnpinHistoryShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpinHistoryShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	var resp *adminservice.UpdateNamespaceReplicationFilterResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskSchedulerNamespaceWeight(
+	ctx context.Context,
+	request *adminservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	var resp *adminservice.UpdateTaskSchedulerNamespaceWeightResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
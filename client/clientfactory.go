@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/testing/testhooks"
 	"google.golang.org/grpc"
 )
@@ -47,7 +48,7 @@ type (
 			metricsHandler metrics.Handler,
 			dc *dynamicconfig.Collection,
 			testHooks testhooks.TestHooks,
-			numberOfHistoryShards int32,
+			historyShardPools *shardpool.Resolver,
 			logger log.Logger,
 			throttledLogger log.Logger,
 		) Factory
@@ -57,14 +58,14 @@ type (
 	NamespaceIDToNameFunc func(id namespace.ID) (namespace.Name, error)
 
 	rpcClientFactory struct {
-		rpcFactory            common.RPCFactory
-		monitor               membership.Monitor
-		metricsHandler        metrics.Handler
-		dynConfig             *dynamicconfig.Collection
-		testHooks             testhooks.TestHooks
-		numberOfHistoryShards int32
-		logger                log.Logger
-		throttledLogger       log.Logger
+		rpcFactory        common.RPCFactory
+		monitor           membership.Monitor
+		metricsHandler    metrics.Handler
+		dynConfig         *dynamicconfig.Collection
+		testHooks         testhooks.TestHooks
+		historyShardPools *shardpool.Resolver
+		logger            log.Logger
+		throttledLogger   log.Logger
 	}
 
 	factoryProviderImpl struct {
@@ -87,19 +88,19 @@ func (p *factoryProviderImpl) NewFactory(
 	metricsHandler metrics.Handler,
 	dc *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	numberOfHistoryShards int32,
+	historyShardPools *shardpool.Resolver,
 	logger log.Logger,
 	throttledLogger log.Logger,
 ) Factory {
 	return &rpcClientFactory{
-		rpcFactory:            rpcFactory,
-		monitor:               monitor,
-		metricsHandler:        metricsHandler,
-		dynConfig:             dc,
-		testHooks:             testHooks,
-		numberOfHistoryShards: numberOfHistoryShards,
-		logger:                logger,
-		throttledLogger:       throttledLogger,
+		rpcFactory:        rpcFactory,
+		monitor:           monitor,
+		metricsHandler:    metricsHandler,
+		dynConfig:         dc,
+		testHooks:         testHooks,
+		historyShardPools: historyShardPools,
+		logger:            logger,
+		throttledLogger:   throttledLogger,
 	}
 }
 
@@ -112,7 +113,7 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 		cf.dynConfig,
 		resolver,
 		cf.logger,
-		cf.numberOfHistoryShards,
+		cf.historyShardPools,
 		cf.rpcFactory,
 		timeout,
 	)
@@ -13,13 +13,13 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/tasktoken"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -42,6 +42,7 @@ type clientImpl struct {
 	logger          log.Logger
 	numberOfShards  int32
 	redirector      Redirector[historyservice.HistoryServiceClient]
+	shardPools      *shardpool.Resolver
 	timeout         time.Duration
 	tokenSerializer *tasktoken.Serializer
 }
@@ -51,7 +52,7 @@ func NewClient(
 	dc *dynamicconfig.Collection,
 	historyServiceResolver membership.ServiceResolver,
 	logger log.Logger,
-	numberOfShards int32,
+	shardPools *shardpool.Resolver,
 	rpcFactory RPCFactory,
 	timeout time.Duration,
 ) historyservice.HistoryServiceClient {
@@ -74,8 +75,9 @@ func NewClient(
 	return &clientImpl{
 		connections:     connections,
 		logger:          logger,
-		numberOfShards:  numberOfShards,
+		numberOfShards:  shardPools.NumberOfShards(),
 		redirector:      redirector,
+		shardPools:      shardPools,
 		timeout:         timeout,
 		tokenSerializer: tasktoken.NewSerializer(),
 	}
@@ -85,6 +87,24 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) PinShard(ctx context.Context, request *historyservice.PinShardRequest, opts ...grpc.CallOption) (*historyservice.PinShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.PinShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskScheduler(ctx context.Context, request *historyservice.DescribeTaskSchedulerRequest, opts ...grpc.CallOption) (*historyservice.DescribeTaskSchedulerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DescribeTaskScheduler(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, request *historyservice.UpdateTaskSchedulerNamespaceWeightRequest, opts ...grpc.CallOption) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
@@ -94,7 +114,11 @@ func (c *clientImpl) DescribeHistoryHost(
 	if request.GetShardId() != 0 {
 		shardID = request.GetShardId()
 	} else if request.GetWorkflowExecution() != nil {
-		shardID = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+		var err error
+		shardID, err = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		clientConn := c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress()))
 		return clientConn.grpcClient.DescribeHistoryHost(ctx, request, opts...)
@@ -230,14 +254,21 @@ func (c *clientImpl) RecordActivityTaskStarted(
 	// For Chasm components we need to route the shard based on business ID. Note that shardIDFromWorkflowID simply
 	// calculates the hash from the ID so it works for both workflowID and businessID.
 	if len(request.GetComponentRef()) == 0 {
-		shardID = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+		var err error
+		shardID, err = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		componentRef, err := c.tokenSerializer.DeserializeChasmComponentRef(request.GetComponentRef())
 		if err != nil {
 			return nil, err
 		}
 
-		shardID = c.shardIDFromWorkflowID(componentRef.GetNamespaceId(), componentRef.GetBusinessId())
+		shardID, err = c.shardIDFromWorkflowID(componentRef.GetNamespaceId(), componentRef.GetBusinessId())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var response *historyservice.RecordActivityTaskStartedResponse
@@ -254,6 +285,60 @@ func (c *clientImpl) RecordActivityTaskStarted(
 	return response, nil
 }
 
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	shardID := request.GetShardId()
+	if shardID == 0 {
+		var err error
+		shardID, err = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	var response *historyservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ForceDeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForceDeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForceDeleteWorkflowExecutionResponse, error) {
+	shardID := request.GetShardId()
+	if shardID == 0 {
+		var err error
+		shardID, err = c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	var response *historyservice.ForceDeleteWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ForceDeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) StreamWorkflowReplicationMessages(
 	ctx context.Context,
 	opts ...grpc.CallOption,
@@ -295,8 +380,8 @@ func (c *clientImpl) createContextWithStateSyncTimeout(parent context.Context) (
 	return context.WithTimeout(parent, DefaultStateSyncTimeout)
 }
 
-func (c *clientImpl) shardIDFromWorkflowID(namespaceID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.numberOfShards)
+func (c *clientImpl) shardIDFromWorkflowID(namespaceID, workflowID string) (int32, error) {
+	return c.shardPools.ShardID(namespaceID, workflowID)
 }
 
 // Stop stops the membership watcher and closes pooled connections.
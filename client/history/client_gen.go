@@ -75,7 +75,10 @@ func (c *clientImpl) CompleteNexusOperation(
 	request *historyservice.CompleteNexusOperationRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.CompleteNexusOperationResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetCompletion().GetNamespaceId(), request.GetCompletion().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetCompletion().GetNamespaceId(), request.GetCompletion().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.CompleteNexusOperationResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -99,7 +102,10 @@ func (c *clientImpl) CompleteNexusOperationChasm(
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument("error deserializing component ref")
 	}
-	shardID := c.shardIDFromWorkflowID(ref.GetNamespaceId(), ref.GetBusinessId())
+	shardID, err := c.shardIDFromWorkflowID(ref.GetNamespaceId(), ref.GetBusinessId())
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.CompleteNexusOperationChasmResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -140,7 +146,10 @@ func (c *clientImpl) DeleteExecution(
 	request *historyservice.DeleteExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.DeleteExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.DeleteExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -160,7 +169,10 @@ func (c *clientImpl) DeleteWorkflowExecution(
 	request *historyservice.DeleteWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.DeleteWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.DeleteWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -180,7 +192,10 @@ func (c *clientImpl) DeleteWorkflowVisibilityRecord(
 	request *historyservice.DeleteWorkflowVisibilityRecordRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.DeleteWorkflowVisibilityRecordResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.DeleteWorkflowVisibilityRecordResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -200,7 +215,10 @@ func (c *clientImpl) DescribeMutableState(
 	request *historyservice.DescribeMutableStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.DescribeMutableStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.DescribeMutableStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -220,7 +238,10 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	request *historyservice.DescribeWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.DescribeWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.DescribeWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -240,33 +261,16 @@ func (c *clientImpl) ExecuteMultiOperation(
 	request *historyservice.ExecuteMultiOperationRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ExecuteMultiOperationResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
-	var response *historyservice.ExecuteMultiOperationResponse
-	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
-		var err error
-		ctx, cancel := c.createContext(ctx)
-		defer cancel()
-		response, err = client.ExecuteMultiOperation(ctx, request, opts...)
-		return err
-	}
-	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
+	if err != nil {
 		return nil, err
 	}
-	return response, nil
-}
-
-func (c *clientImpl) ForceDeleteWorkflowExecution(
-	ctx context.Context,
-	request *historyservice.ForceDeleteWorkflowExecutionRequest,
-	opts ...grpc.CallOption,
-) (*historyservice.ForceDeleteWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
-	var response *historyservice.ForceDeleteWorkflowExecutionResponse
+	var response *historyservice.ExecuteMultiOperationResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
 		ctx, cancel := c.createContext(ctx)
 		defer cancel()
-		response, err = client.ForceDeleteWorkflowExecution(ctx, request, opts...)
+		response, err = client.ExecuteMultiOperation(ctx, request, opts...)
 		return err
 	}
 	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
@@ -280,7 +284,10 @@ func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GenerateLastHistoryReplicationTasksResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -324,7 +331,10 @@ func (c *clientImpl) GetDLQReplicationMessages(
 	if len(request.GetTaskInfos()) == 0 {
 		return nil, serviceerror.NewInvalidArgument("missing TaskInfos")
 	}
-	shardID := c.shardIDFromWorkflowID(request.GetTaskInfos()[0].NamespaceId, request.GetTaskInfos()[0].WorkflowId)
+	shardID, err := c.shardIDFromWorkflowID(request.GetTaskInfos()[0].NamespaceId, request.GetTaskInfos()[0].WorkflowId)
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GetDLQReplicationMessagesResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -364,7 +374,10 @@ func (c *clientImpl) GetMutableState(
 	request *historyservice.GetMutableStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.GetMutableStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GetMutableStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -399,12 +412,35 @@ func (c *clientImpl) GetShard(
 	return response, nil
 }
 
+func (c *clientImpl) GetTaskTrace(
+	ctx context.Context,
+	request *historyservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetTaskTraceResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.GetTaskTraceResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetTaskTrace(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *historyservice.GetWorkflowExecutionHistoryRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.GetWorkflowExecutionHistoryResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GetWorkflowExecutionHistoryResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -424,7 +460,10 @@ func (c *clientImpl) GetWorkflowExecutionHistoryReverse(
 	request *historyservice.GetWorkflowExecutionHistoryReverseRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.GetWorkflowExecutionHistoryReverseResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GetWorkflowExecutionHistoryReverseResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -444,7 +483,10 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	request *historyservice.GetWorkflowExecutionRawHistoryRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.GetWorkflowExecutionRawHistoryResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.GetWorkflowExecutionRawHistoryResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -464,33 +506,16 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	request *historyservice.GetWorkflowExecutionRawHistoryV2Request,
 	opts ...grpc.CallOption,
 ) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
-	var response *historyservice.GetWorkflowExecutionRawHistoryV2Response
-	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
-		var err error
-		ctx, cancel := c.createContext(ctx)
-		defer cancel()
-		response, err = client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
-		return err
-	}
-	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
 		return nil, err
 	}
-	return response, nil
-}
-
-func (c *clientImpl) ImportWorkflowExecution(
-	ctx context.Context,
-	request *historyservice.ImportWorkflowExecutionRequest,
-	opts ...grpc.CallOption,
-) (*historyservice.ImportWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
-	var response *historyservice.ImportWorkflowExecutionResponse
+	var response *historyservice.GetWorkflowExecutionRawHistoryV2Response
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
 		ctx, cancel := c.createContext(ctx)
 		defer cancel()
-		response, err = client.ImportWorkflowExecution(ctx, request, opts...)
+		response, err = client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 		return err
 	}
 	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
@@ -504,7 +529,10 @@ func (c *clientImpl) InvokeStateMachineMethod(
 	request *historyservice.InvokeStateMachineMethodRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.InvokeStateMachineMethodResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.InvokeStateMachineMethodResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -524,7 +552,10 @@ func (c *clientImpl) IsActivityTaskValid(
 	request *historyservice.IsActivityTaskValidRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.IsActivityTaskValidResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.IsActivityTaskValidResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -544,7 +575,10 @@ func (c *clientImpl) IsWorkflowTaskValid(
 	request *historyservice.IsWorkflowTaskValidRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.IsWorkflowTaskValidResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.IsWorkflowTaskValidResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -559,6 +593,26 @@ func (c *clientImpl) IsWorkflowTaskValid(
 	return response, nil
 }
 
+func (c *clientImpl) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListQueueMitigationsResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -624,7 +678,10 @@ func (c *clientImpl) PauseActivity(
 	request *historyservice.PauseActivityRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.PauseActivityResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.PauseActivityResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -644,7 +701,10 @@ func (c *clientImpl) PauseWorkflowExecution(
 	request *historyservice.PauseWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.PauseWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetPauseRequest().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetPauseRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.PauseWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -664,7 +724,10 @@ func (c *clientImpl) PollMutableState(
 	request *historyservice.PollMutableStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.PollMutableStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.PollMutableStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -684,7 +747,10 @@ func (c *clientImpl) PollWorkflowExecutionTimeSkipping(
 	request *historyservice.PollWorkflowExecutionTimeSkippingRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.PollWorkflowExecutionTimeSkippingResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.PollWorkflowExecutionTimeSkippingResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -704,7 +770,10 @@ func (c *clientImpl) PollWorkflowExecutionUpdate(
 	request *historyservice.PollWorkflowExecutionUpdateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.PollWorkflowExecutionUpdateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetUpdateRef().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetUpdateRef().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.PollWorkflowExecutionUpdateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -744,7 +813,10 @@ func (c *clientImpl) QueryWorkflow(
 	request *historyservice.QueryWorkflowRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.QueryWorkflowResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.QueryWorkflowResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -764,7 +836,10 @@ func (c *clientImpl) ReapplyEvents(
 	request *historyservice.ReapplyEventsRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ReapplyEventsResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ReapplyEventsResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -784,7 +859,10 @@ func (c *clientImpl) RebuildMutableState(
 	request *historyservice.RebuildMutableStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RebuildMutableStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RebuildMutableStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -821,7 +899,10 @@ func (c *clientImpl) RecordActivityTaskHeartbeat(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RecordActivityTaskHeartbeatResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -842,7 +923,10 @@ func (c *clientImpl) RecordChildExecutionCompleted(
 	request *historyservice.RecordChildExecutionCompletedRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RecordChildExecutionCompletedResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetParentExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetParentExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RecordChildExecutionCompletedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -862,7 +946,10 @@ func (c *clientImpl) RecordWorkflowTaskStarted(
 	request *historyservice.RecordWorkflowTaskStartedRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RecordWorkflowTaskStartedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -882,7 +969,10 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	request *historyservice.RefreshWorkflowTasksRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RefreshWorkflowTasksResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RefreshWorkflowTasksResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -902,7 +992,10 @@ func (c *clientImpl) RemoveSignalMutableState(
 	request *historyservice.RemoveSignalMutableStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RemoveSignalMutableStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RemoveSignalMutableStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -942,7 +1035,10 @@ func (c *clientImpl) ReplicateEventsV2(
 	request *historyservice.ReplicateEventsV2Request,
 	opts ...grpc.CallOption,
 ) (*historyservice.ReplicateEventsV2Response, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ReplicateEventsV2Response
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -962,7 +1058,10 @@ func (c *clientImpl) ReplicateWorkflowState(
 	request *historyservice.ReplicateWorkflowStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ReplicateWorkflowStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowState().GetExecutionInfo().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowState().GetExecutionInfo().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ReplicateWorkflowStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -982,7 +1081,10 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	request *historyservice.RequestCancelWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.RequestCancelWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetCancelRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetCancelRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.RequestCancelWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1002,7 +1104,10 @@ func (c *clientImpl) ResetActivity(
 	request *historyservice.ResetActivityRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ResetActivityResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ResetActivityResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1022,7 +1127,10 @@ func (c *clientImpl) ResetStickyTaskQueue(
 	request *historyservice.ResetStickyTaskQueueRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ResetStickyTaskQueueResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ResetStickyTaskQueueResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1042,7 +1150,10 @@ func (c *clientImpl) ResetWorkflowExecution(
 	request *historyservice.ResetWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ResetWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetResetRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetResetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ResetWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1079,7 +1190,10 @@ func (c *clientImpl) RespondActivityTaskCanceled(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RespondActivityTaskCanceledResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -1117,7 +1231,10 @@ func (c *clientImpl) RespondActivityTaskCompleted(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RespondActivityTaskCompletedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -1155,7 +1272,10 @@ func (c *clientImpl) RespondActivityTaskFailed(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RespondActivityTaskFailedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -1193,7 +1313,10 @@ func (c *clientImpl) RespondWorkflowTaskCompleted(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RespondWorkflowTaskCompletedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -1231,7 +1354,10 @@ func (c *clientImpl) RespondWorkflowTaskFailed(
 		namespaceID = request.GetNamespaceId()
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	
 	var response *historyservice.RespondWorkflowTaskFailedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
@@ -1252,7 +1378,10 @@ func (c *clientImpl) ScheduleWorkflowTask(
 	request *historyservice.ScheduleWorkflowTaskRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.ScheduleWorkflowTaskResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.ScheduleWorkflowTaskResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1272,7 +1401,10 @@ func (c *clientImpl) SignalWithStartWorkflowExecution(
 	request *historyservice.SignalWithStartWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.SignalWithStartWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetSignalWithStartRequest().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetSignalWithStartRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.SignalWithStartWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1292,7 +1424,10 @@ func (c *clientImpl) SignalWorkflowExecution(
 	request *historyservice.SignalWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.SignalWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.SignalWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1332,7 +1467,10 @@ func (c *clientImpl) StartWorkflowExecution(
 	request *historyservice.StartWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.StartWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetStartRequest().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetStartRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.StartWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1352,7 +1490,10 @@ func (c *clientImpl) SyncActivity(
 	request *historyservice.SyncActivityRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.SyncActivityResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.SyncActivityResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1392,7 +1533,10 @@ func (c *clientImpl) SyncWorkflowState(
 	request *historyservice.SyncWorkflowStateRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.SyncWorkflowStateResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.SyncWorkflowStateResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1412,7 +1556,10 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	request *historyservice.TerminateWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.TerminateWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.TerminateWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1432,7 +1579,10 @@ func (c *clientImpl) UnpauseActivity(
 	request *historyservice.UnpauseActivityRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.UnpauseActivityResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetFrontendRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.UnpauseActivityResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1452,7 +1602,10 @@ func (c *clientImpl) UnpauseWorkflowExecution(
 	request *historyservice.UnpauseWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUnpauseRequest().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUnpauseRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.UnpauseWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1467,12 +1620,35 @@ func (c *clientImpl) UnpauseWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UnpinShard(
+	ctx context.Context,
+	request *historyservice.UnpinShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpinShardResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.UnpinShardResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UnpinShard(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.UpdateActivityOptionsResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUpdateRequest().GetExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUpdateRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.UpdateActivityOptionsResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1492,7 +1668,10 @@ func (c *clientImpl) UpdateWorkflowExecution(
 	request *historyservice.UpdateWorkflowExecutionRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.UpdateWorkflowExecutionResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.UpdateWorkflowExecutionResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1512,7 +1691,10 @@ func (c *clientImpl) UpdateWorkflowExecutionOptions(
 	request *historyservice.UpdateWorkflowExecutionOptionsRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.UpdateWorkflowExecutionOptionsResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUpdateRequest().GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetUpdateRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.UpdateWorkflowExecutionOptionsResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1532,7 +1714,10 @@ func (c *clientImpl) VerifyChildExecutionCompletionRecorded(
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetParentExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetParentExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.VerifyChildExecutionCompletionRecordedResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -1552,7 +1737,10 @@ func (c *clientImpl) VerifyFirstWorkflowTaskScheduled(
 	request *historyservice.VerifyFirstWorkflowTaskScheduledRequest,
 	opts ...grpc.CallOption,
 ) (*historyservice.VerifyFirstWorkflowTaskScheduledResponse, error) {
-	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	shardID, err := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	var response *historyservice.VerifyFirstWorkflowTaskScheduledResponse
 	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
 		var err error
@@ -12,6 +12,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/testing/nettest"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -40,7 +42,7 @@ func TestErrLookup(t *testing.T) {
 		dynamicconfig.NewNoopCollection(),
 		serviceResolver,
 		log.NewTestLogger(),
-		1,
+		shardpool.NewResolver(dynamicconfig.NewNoopCollection(), namespace.NewMockRegistry(ctrl), 1),
 		nil,
 		time.Duration(0),
 	)
@@ -133,7 +135,7 @@ func TestShardAgnosticConnectionStrategy(t *testing.T) {
 				dynamicconfig.NewNoopCollection(),
 				serviceResolver,
 				log.NewTestLogger(),
-				2,
+				shardpool.NewResolver(dynamicconfig.NewNoopCollection(), namespace.NewMockRegistry(ctrl), 2),
 				rpcFactory,
 				time.Second,
 			)
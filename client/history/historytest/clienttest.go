@@ -20,8 +20,10 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/persistencetest"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/testing/nettest"
 	historyserver "go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/history/tasks"
@@ -165,7 +167,7 @@ func createClient(ctrl *gomock.Controller, listener *nettest.PipeListener) histo
 		dynamicconfig.NewNoopCollection(),
 		serviceResolver,
 		log.NewTestLogger(),
-		1,
+		shardpool.NewResolver(dynamicconfig.NewNoopCollection(), namespace.NewMockRegistry(ctrl), 1),
 		rpcFactory,
 		time.Second,
 	)
@@ -13,6 +13,10 @@ const (
 	MetadataKeyClientShardID   = "temporal-client-shard-id"
 	MetadataKeyServerClusterID = "temporal-server-cluster-id"
 	MetadataKeyServerShardID   = "temporal-server-shard-id"
+
+	// MetadataKeyClientReplicationCompression lists the compressions, comma separated, the client of a
+	// replication stream can decompress. Clients of older versions don't set it.
+	MetadataKeyClientReplicationCompression = "temporal-client-replication-compression"
 )
 
 type (
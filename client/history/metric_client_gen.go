@@ -163,6 +163,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeTaskScheduler(
+	ctx context.Context,
+	request *historyservice.DescribeTaskSchedulerRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeTaskSchedulerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeTaskScheduler")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskScheduler(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -317,6 +331,20 @@ func (c *metricClient) GetShard(
 	return c.client.GetShard(ctx, request, opts...)
 }
 
+func (c *metricClient) GetTaskTrace(
+	ctx context.Context,
+	request *historyservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetTaskTraceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientGetTaskTrace")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetTaskTrace(ctx, request, opts...)
+}
+
 func (c *metricClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *historyservice.GetWorkflowExecutionHistoryRequest,
@@ -429,6 +457,20 @@ func (c *metricClient) IsWorkflowTaskValid(
 	return c.client.IsWorkflowTaskValid(ctx, request, opts...)
 }
 
+func (c *metricClient) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ListQueueMitigationsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientListQueueMitigations")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -499,6 +541,20 @@ func (c *metricClient) PauseWorkflowExecution(
 	return c.client.PauseWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) PinShard(
+	ctx context.Context,
+	request *historyservice.PinShardRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PinShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPinShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PinShard(ctx, request, opts...)
+}
+
 func (c *metricClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -989,6 +1045,20 @@ func (c *metricClient) UnpauseWorkflowExecution(
 	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) UnpinShard(
+	ctx context.Context,
+	request *historyservice.UnpinShardRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UnpinShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUnpinShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpinShard(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
@@ -1003,6 +1073,20 @@ func (c *metricClient) UpdateActivityOptions(
 	return c.client.UpdateActivityOptions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskSchedulerNamespaceWeight(
+	ctx context.Context,
+	request *historyservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateTaskSchedulerNamespaceWeightResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpdateTaskSchedulerNamespaceWeight")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -191,6 +191,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskScheduler(
+	ctx context.Context,
+	request *historyservice.DescribeTaskSchedulerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeTaskSchedulerResponse, error) {
+	var resp *historyservice.DescribeTaskSchedulerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskScheduler(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -356,6 +371,21 @@ func (c *retryableClient) GetShard(
 	return resp, err
 }
 
+func (c *retryableClient) GetTaskTrace(
+	ctx context.Context,
+	request *historyservice.GetTaskTraceRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetTaskTraceResponse, error) {
+	var resp *historyservice.GetTaskTraceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskTrace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *historyservice.GetWorkflowExecutionHistoryRequest,
@@ -476,6 +506,21 @@ func (c *retryableClient) IsWorkflowTaskValid(
 	return resp, err
 }
 
+func (c *retryableClient) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListQueueMitigationsResponse, error) {
+	var resp *historyservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -551,6 +596,21 @@ func (c *retryableClient) PauseWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) PinShard(
+	ctx context.Context,
+	request *historyservice.PinShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PinShardResponse, error) {
+	var resp *historyservice.PinShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PinShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -1091,6 +1151,21 @@ func (c *retryableClient) UnpauseWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UnpinShard(
+	ctx context.Context,
+	request *historyservice.UnpinShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpinShardResponse, error) {
+	var resp *historyservice.UnpinShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpinShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
@@ -1106,6 +1181,21 @@ func (c *retryableClient) UpdateActivityOptions(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskSchedulerNamespaceWeight(
+	ctx context.Context,
+	request *historyservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	var resp *historyservice.UpdateTaskSchedulerNamespaceWeightResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskSchedulerNamespaceWeight(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
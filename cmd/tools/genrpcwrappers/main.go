@@ -270,7 +270,10 @@ func makeGetHistoryClient(reqType reflect.Type, routingOptions *historyservice.R
 		}
 		verifyFieldExists(t, namespaceIdField)
 		verifyFieldExists(t, routingOptions.WorkflowId)
-		return fmt.Sprintf("shardID := c.shardIDFromWorkflowID(%s, %s)", toGetter(namespaceIdField), toGetter(routingOptions.WorkflowId))
+		return fmt.Sprintf(`shardID, err := c.shardIDFromWorkflowID(%s, %s)
+	if err != nil {
+		return nil, err
+	}`, toGetter(namespaceIdField), toGetter(routingOptions.WorkflowId))
 	}
 	if routingOptions.TaskToken != "" {
 		namespaceIdField := routingOptions.NamespaceId
@@ -297,7 +300,10 @@ func makeGetHistoryClient(reqType reflect.Type, routingOptions *historyservice.R
 		namespaceID = %s
 		businessID = taskToken.GetWorkflowId()
 	}
-	shardID := c.shardIDFromWorkflowID(namespaceID, businessID)
+	shardID, err := c.shardIDFromWorkflowID(namespaceID, businessID)
+	if err != nil {
+		return nil, err
+	}
 	`, toGetter(routingOptions.TaskToken), toGetter(namespaceIdField))
 	}
 	if routingOptions.ChasmComponentRef != "" {
@@ -306,7 +312,10 @@ func makeGetHistoryClient(reqType reflect.Type, routingOptions *historyservice.R
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument("error deserializing component ref")
 	}
-	shardID := c.shardIDFromWorkflowID(ref.GetNamespaceId(), ref.GetBusinessId())
+	shardID, err := c.shardIDFromWorkflowID(ref.GetNamespaceId(), ref.GetBusinessId())
+	if err != nil {
+		return nil, err
+	}
 	`, toGetter(routingOptions.ChasmComponentRef))
 	}
 	if routingOptions.TaskInfos != "" {
@@ -317,7 +326,10 @@ func makeGetHistoryClient(reqType reflect.Type, routingOptions *historyservice.R
 	if len(%s) == 0 {
 		return nil, serviceerror.NewInvalidArgument("missing TaskInfos")
 	}
-	shardID := c.shardIDFromWorkflowID(%s[0].NamespaceId, %s[0].WorkflowId)`, p, p, p)
+	shardID, err := c.shardIDFromWorkflowID(%s[0].NamespaceId, %s[0].WorkflowId)
+	if err != nil {
+		return nil, err
+	}`, p, p, p)
 	}
 
 	log.Fatalf("No routing directive specified on %s", t)
@@ -70,7 +70,11 @@ func (p *Plugin) Run(plugin *protogen.Plugin) error {
 		if !strings.Contains(string(file.GoImportPath), "go.temporal.io/server/chasm/lib") {
 			continue
 		}
-		if len(file.Services) == 0 {
+		// Services without routing directives are served by the frontend and don't need a history client.
+		services := slices.DeleteFunc(slices.Clone(file.Services), func(svc *protogen.Service) bool {
+			return !isRouted(svc)
+		})
+		if len(services) == 0 {
 			continue
 		}
 		// create the file
@@ -95,12 +99,13 @@ func (p *Plugin) Run(plugin *protogen.Plugin) error {
 		w.println(`"go.temporal.io/server/common/log"`)
 		w.println(`"go.temporal.io/server/common/membership"`)
 		w.println(`"go.temporal.io/server/common/metrics"`)
+		w.println(`"go.temporal.io/server/common/shardpool"`)
 		w.println(`"go.uber.org/fx"`)
 		w.println(`"google.golang.org/grpc"`)
 		w.unindent()
 		w.println(")")
 
-		for _, svc := range file.Services {
+		for _, svc := range services {
 			if err := p.genClient(w, svc); err != nil {
 				return err
 			}
@@ -125,9 +130,19 @@ func genAssignShard(m *protogen.Method) (string, error) {
 	if opts.Random && (opts.NamespaceId != "" || len(opts.BusinessId) != 0) {
 		return "", fmt.Errorf("random directive cannot be combined with namespace_id or business_id on %s", m.Desc.FullName())
 	}
+	if opts.ShardId != "" && (opts.Random || opts.NamespaceId != "" || len(opts.BusinessId) != 0) {
+		return "", fmt.Errorf("shard_id directive cannot be combined with other directives on %s", m.Desc.FullName())
+	}
 	if opts.Random {
 		return "shardID := int32(rand.Intn(int(c.numShards)) + 1)", nil
 	}
+	if opts.ShardId != "" {
+		shardIDFieldGetter, err := goFieldPath(m, opts.ShardId)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve shard_id field path %q: %w", opts.ShardId, err)
+		}
+		return fmt.Sprintf("shardID := request%s", shardIDFieldGetter), nil
+	}
 	if len(opts.BusinessId) == 0 {
 		return "", fmt.Errorf("business_id directive empty on %s", m.Desc.FullName())
 	}
@@ -148,7 +163,7 @@ func genAssignShard(m *protogen.Method) (string, error) {
 	}
 
 	if len(opts.BusinessId) == 1 {
-		return fmt.Sprintf("shardID := common.WorkflowIDToHistoryShard(request%s, request%s, c.numShards)", namespaceIDFieldGetter, primaryFieldGetter), nil
+		return fmt.Sprintf("shardID, err := c.shardPools.ShardID(request%s, request%s)\nif err != nil {\nreturn nil, err\n}", namespaceIDFieldGetter, primaryFieldGetter), nil
 	}
 
 	// Multiple business_id fields: use the first non-empty value as the routing key.
@@ -161,7 +176,7 @@ func genAssignShard(m *protogen.Method) (string, error) {
 		}
 		fmt.Fprintf(&sb, "if businessID == \"\" { businessID = request%s }\n", fallbackGetter)
 	}
-	fmt.Fprintf(&sb, "shardID := common.WorkflowIDToHistoryShard(request%s, businessID, c.numShards)", namespaceIDFieldGetter)
+	fmt.Fprintf(&sb, "shardID, err := c.shardPools.ShardID(request%s, businessID)\nif err != nil {\nreturn nil, err\n}", namespaceIDFieldGetter)
 	return sb.String(), nil
 }
 
@@ -192,6 +207,14 @@ func routingOptions(m *protogen.Method) (*routingspb.RoutingOptions, error) {
 	return opts, nil
 }
 
+// isRouted returns whether any method of the service has a routing directive.
+func isRouted(svc *protogen.Service) bool {
+	return slices.ContainsFunc(svc.Methods, func(m *protogen.Method) bool {
+		opts, err := routingOptions(m)
+		return err == nil && opts != nil
+	})
+}
+
 func (p *Plugin) genClient(w *writer, svc *protogen.Service) error {
 	structName := fmt.Sprintf("%sLayeredClient", svc.GoName)
 	w.println("// %s is a client for %s.", structName, svc.GoName)
@@ -201,6 +224,7 @@ func (p *Plugin) genClient(w *writer, svc *protogen.Service) error {
 	w.println("numShards      int32")
 	w.println("redirector     history.Redirector[%sClient]", svc.GoName)
 	w.println("retryPolicy    backoff.RetryPolicy")
+	w.println("shardPools     *shardpool.Resolver")
 	w.unindent()
 	w.println("}")
 
@@ -215,6 +239,7 @@ func (p *Plugin) genClient(w *writer, svc *protogen.Service) error {
 	w.println("config         *config.Persistence,")
 	w.println("logger         log.Logger,")
 	w.println("metricsHandler metrics.Handler,")
+	w.println("shardPools     *shardpool.Resolver,")
 	w.unindent()
 	w.println(") (%sClient, error) {", svc.GoName)
 	w.indent() // start ctor body
@@ -248,6 +273,7 @@ func (p *Plugin) genClient(w *writer, svc *protogen.Service) error {
 	w.println("redirector:     redirector,")
 	w.println("numShards:      config.NumHistoryShards,")
 	w.println("retryPolicy:    common.CreateHistoryClientRetryPolicy(dynamicconfig.RetryUnboundedOnSystemResourceExhausted.Get(dc)),")
+	w.println("shardPools:     shardPools,")
 	w.unindent() // close struct literal
 	w.println("}")
 	w.println("lc.Append(fx.StopHook(client.Stop))")
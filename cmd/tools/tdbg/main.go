@@ -3,6 +3,9 @@ package main
 import (
 	"os"
 
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin for offline mode
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin for offline mode
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"     // needed to load sqlite plugin for offline mode
 	"go.temporal.io/server/tools/tdbg"
 )
 
@@ -57,11 +57,14 @@ const (
 )
 
 const (
-	WorkflowServicePrefix = "/temporal.api.workflowservice.v1.WorkflowService/"
-	OperatorServicePrefix = "/temporal.api.operatorservice.v1.OperatorService/"
-	HistoryServicePrefix  = "/temporal.server.api.historyservice.v1.HistoryService/"
-	AdminServicePrefix    = "/temporal.server.api.adminservice.v1.AdminService/"
-	MatchingServicePrefix = "/temporal.server.api.matchingservice.v1.MatchingService/"
+	WorkflowServicePrefix         = "/temporal.api.workflowservice.v1.WorkflowService/"
+	OperatorServicePrefix         = "/temporal.api.operatorservice.v1.OperatorService/"
+	HistoryServicePrefix          = "/temporal.server.api.historyservice.v1.HistoryService/"
+	AdminServicePrefix            = "/temporal.server.api.adminservice.v1.AdminService/"
+	MatchingServicePrefix         = "/temporal.server.api.matchingservice.v1.MatchingService/"
+	HistoryEventFeedServicePrefix = "/temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService/"
+	NexusFanOutServicePrefix      = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/"
+	ActivityBatchServicePrefix    = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/"
 	// Technically not a gRPC service, but still using this format for metadata.
 	NexusServicePrefix = "/temporal.api.nexusservice.v1.NexusService/"
 )
@@ -206,6 +209,21 @@ var (
 		"GetNexusEndpoint":         {Scope: ScopeCluster, Access: AccessAdmin, Polling: PollingNone},
 		"ListNexusEndpoints":       {Scope: ScopeCluster, Access: AccessAdmin, Polling: PollingNone},
 	}
+	historyEventFeedServiceMetadata = map[string]MethodMetadata{
+		"ReadHistoryEventFeed": {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingCapable},
+	}
+	nexusFanOutServiceMetadata = map[string]MethodMetadata{
+		"StartNexusFanOutExecution":         {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
+		"DescribeNexusFanOutExecution":      {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingCapable},
+		"PollNexusFanOutExecution":          {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingAlways},
+		"RequestCancelNexusFanOutExecution": {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
+		"TerminateNexusFanOutExecution":     {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
+	}
+	activityBatchServiceMetadata = map[string]MethodMetadata{
+		"BatchStartActivityExecutions":    {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
+		"BatchDescribeActivityExecutions": {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingNone},
+		"BatchPollActivityExecutions":     {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingAlways},
+	}
 	nexusServiceMetadata = map[string]MethodMetadata{
 		"DispatchNexusTask":               {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
 		"DispatchByNamespaceAndTaskQueue": {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
@@ -216,13 +234,20 @@ var (
 )
 
 // GetMethodMetadata gets metadata for a given API method in one of the services exported by
-// frontend (WorkflowService, OperatorService, AdminService).
+// frontend (WorkflowService, OperatorService, AdminService, HistoryEventFeedService, NexusFanOutService,
+// ActivityBatchService).
 func GetMethodMetadata(fullApiName string) MethodMetadata {
 	switch {
 	case strings.HasPrefix(fullApiName, WorkflowServicePrefix):
 		return workflowServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, OperatorServicePrefix):
 		return operatorServiceMetadata[MethodName(fullApiName)]
+	case strings.HasPrefix(fullApiName, HistoryEventFeedServicePrefix):
+		return historyEventFeedServiceMetadata[MethodName(fullApiName)]
+	case strings.HasPrefix(fullApiName, NexusFanOutServicePrefix):
+		return nexusFanOutServiceMetadata[MethodName(fullApiName)]
+	case strings.HasPrefix(fullApiName, ActivityBatchServicePrefix):
+		return activityBatchServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, NexusServicePrefix):
 		return nexusServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, AdminServicePrefix):
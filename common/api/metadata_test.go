@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
+	"go.temporal.io/server/api/historyeventfeedservice/v1"
 	expmaps "golang.org/x/exp/maps"
 )
 
@@ -24,6 +26,16 @@ func TestOperatorServiceMetadata(t *testing.T) {
 	checkService(t, tp, operatorServiceMetadata)
 }
 
+func TestHistoryEventFeedServiceMetadata(t *testing.T) {
+	tp := reflect.TypeFor[historyeventfeedservice.HistoryEventFeedServiceServer]()
+	checkService(t, tp, historyEventFeedServiceMetadata)
+}
+
+func TestActivityBatchServiceMetadata(t *testing.T) {
+	tp := reflect.TypeFor[activitybatchservice.ActivityBatchServiceServer]()
+	checkService(t, tp, activityBatchServiceMetadata)
+}
+
 func checkService(t *testing.T, tp reflect.Type, m map[string]MethodMetadata) {
 	methods := getMethodNames(tp)
 	require.ElementsMatch(t, methods, expmaps.Keys(m),
@@ -0,0 +1,213 @@
+package callbackauth
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/tests/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConvertConfig(t *testing.T) {
+	config, err := convertConfig(map[string]any{
+		"SigningKeys": []any{
+			map[string]any{"ID": "new", "SecretFile": "new-secret"},
+			map[string]any{"ID": "empty"},
+			map[string]any{"ID": "old", "SecretFile": "old-secret"},
+		},
+		"Headers": map[string]any{"X-Tenant": "acme"},
+		"ClientTLS": []any{
+			map[string]any{"Pattern": "*.internal:443", "CertFile": "cert.pem", "KeyFile": "key.pem", "CAFile": "ca.pem"},
+			map[string]any{"Pattern": "missing-key:443", "CertFile": "cert.pem"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []SigningKeyFile{{ID: "new", SecretFile: "new-secret"}, {ID: "old", SecretFile: "old-secret"}}, config.SigningKeys)
+	require.Equal(t, map[string]string{"X-Tenant": "acme"}, config.Headers)
+	require.Len(t, config.ClientTLS, 1)
+	require.Equal(t, &ClientTLS{CertFile: "cert.pem", KeyFile: "key.pem", CAFile: "ca.pem"}, config.ClientTLSFor("api.internal:443"))
+	require.Nil(t, config.ClientTLSFor("api.internal:8443"))
+	require.Nil(t, config.ClientTLSFor("missing-key:443"))
+}
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"completion":"ok"}`)
+	header := http.Header{}
+	SignRequest(header, SigningKey{ID: "new", Secret: "new-secret"}, now, body)
+
+	require.Equal(t, "1700000000", header.Get(TimestampHeader))
+	require.Equal(t, "new", header.Get(KeyIDHeader))
+	require.True(t, strings.HasPrefix(header.Get(SignatureHeader), "v1="))
+
+	keys := []SigningKey{{ID: "old", Secret: "old-secret"}, {ID: "new", Secret: "new-secret"}}
+	require.NoError(t, Verify(header, body, keys, now.Add(time.Minute), 5*time.Minute))
+	require.ErrorIs(t, Verify(header, []byte("tampered"), keys, now, 5*time.Minute), ErrInvalidSignature)
+	require.ErrorIs(t, Verify(header, body, keys[:1], now, 5*time.Minute), ErrInvalidSignature)
+	require.ErrorIs(t, Verify(header, body, keys, now.Add(time.Hour), 5*time.Minute), ErrExpiredSignature)
+	require.ErrorIs(t, Verify(http.Header{}, body, keys, now, 5*time.Minute), ErrMissingSignature)
+}
+
+func TestRoundTripper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, "active",
+	), nil).AnyTimes()
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret\n"), 0600))
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		OutboundAuth.Key(): []dynamicconfig.ConstrainedValue{
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "ns"},
+				Value: map[string]any{
+					"SigningKeys": []any{map[string]any{"ID": "k1", "SecretFile": secretFile}},
+					"Headers":     map[string]any{"X-Tenant": "acme", "Content-Type": "text/plain"},
+				},
+			},
+		},
+	}, log.NewNoopLogger())
+	now := time.Unix(1700000000, 0)
+	timeSource := clock.NewEventTimeSource().Update(now)
+
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	tlsTransports := NewTLSTransportCache(func(*tls.Config) (http.RoundTripper, error) {
+		t.Fatal("unexpected client certificate")
+		return nil, nil
+	})
+	secrets := NewSecretCache()
+	client := &http.Client{
+		Transport: NewRoundTripper("ns-id", server.URL, namespaceRegistry, dc, http.DefaultTransport, tlsTransports, secrets, timeSource),
+	}
+
+	body := `{"completion":"ok"}`
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.Equal(t, body, string(receivedBody))
+	require.Equal(t, "acme", received.Header.Get("X-Tenant"))
+	// Static headers don't override the headers of the callback.
+	require.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	require.NoError(t, Verify(received.Header, receivedBody, []SigningKey{{ID: "k1", Secret: "secret"}}, now, time.Minute))
+	// The caller's request is left untouched.
+	require.Empty(t, req.Header.Get(SignatureHeader))
+
+	// Requests are signed with the new secret once its file is rotated.
+	rotateFile(t, secretFile, []byte("rotated-secret"))
+	resp, err = client.Post(server.URL, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, Verify(received.Header, receivedBody, []SigningKey{{ID: "k1", Secret: "rotated-secret"}}, now, time.Minute))
+
+	// Namespaces without config are sent as is.
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("other-id")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "other-id", Name: "other"}, nil, "active",
+	), nil)
+	client.Transport = NewRoundTripper("other-id", server.URL, namespaceRegistry, dc, http.DefaultTransport, tlsTransports, secrets, timeSource)
+	resp, err = client.Post(server.URL, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Empty(t, received.Header.Get(SignatureHeader))
+	require.Empty(t, received.Header.Get("X-Tenant"))
+}
+
+func TestTLSTransportCache_RetriesFailures(t *testing.T) {
+	cache := NewTLSTransportCache(func(*tls.Config) (http.RoundTripper, error) {
+		return http.DefaultTransport, nil
+	})
+	clientTLS := ClientTLS{CertFile: "does-not-exist.pem", KeyFile: "does-not-exist.key"}
+	_, err := cache.Get(clientTLS)
+	require.ErrorContains(t, err, "unable to load callback client certificate")
+	_, ok := cache.transports.entries.Load(clientTLS)
+	require.False(t, ok)
+}
+
+func TestTLSTransportCache_RebuildsRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	chain, _, err := testutils.GenerateTestChainWithSN(dir, "callback", 1)
+	require.NoError(t, err)
+
+	var certificates [][]byte
+	cache := NewTLSTransportCache(func(tlsConfig *tls.Config) (http.RoundTripper, error) {
+		certificates = append(certificates, tlsConfig.Certificates[0].Certificate[0])
+		return &http.Transport{TLSClientConfig: tlsConfig}, nil
+	})
+	clientTLS := ClientTLS{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile, CAFile: chain.CaPubFile}
+	first, err := cache.Get(clientTLS)
+	require.NoError(t, err)
+	cached, err := cache.Get(clientTLS)
+	require.NoError(t, err)
+	require.Same(t, first, cached)
+	require.Len(t, certificates, 1)
+
+	// Rotate the certificate in place.
+	rotated := t.TempDir()
+	rotatedChain, _, err := testutils.GenerateTestChainWithSN(rotated, "callback", 2)
+	require.NoError(t, err)
+	for src, dst := range map[string]string{
+		rotatedChain.CertPubFile: chain.CertPubFile,
+		rotatedChain.CertKeyFile: chain.CertKeyFile,
+		rotatedChain.CaPubFile:   chain.CaPubFile,
+	} {
+		data, err := os.ReadFile(src)
+		require.NoError(t, err)
+		rotateFile(t, dst, data)
+	}
+
+	second, err := cache.Get(clientTLS)
+	require.NoError(t, err)
+	require.NotSame(t, first, second)
+	require.Len(t, certificates, 2)
+	require.NotEqual(t, certificates[0], certificates[1])
+}
+
+func TestSecretCache(t *testing.T) {
+	cache := NewSecretCache()
+	secretFile := filepath.Join(t.TempDir(), "secret")
+
+	_, err := cache.Get(secretFile)
+	require.ErrorContains(t, err, "unable to load callback signing secret")
+
+	require.NoError(t, os.WriteFile(secretFile, []byte(" \n"), 0600))
+	_, err = cache.Get(secretFile)
+	require.ErrorContains(t, err, "is empty")
+
+	rotateFile(t, secretFile, []byte("secret\n"))
+	secret, err := cache.Get(secretFile)
+	require.NoError(t, err)
+	require.Equal(t, "secret", secret)
+}
+
+// rotateFile rewrites a file and moves its modification time forward, so that the change is noticed even on file
+// systems with a coarse timestamp granularity.
+func rotateFile(t *testing.T, path string, data []byte) {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	modTime := info.ModTime().Add(time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
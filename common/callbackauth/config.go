@@ -0,0 +1,142 @@
+package callbackauth
+
+import (
+	"regexp"
+	"strings"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+var OutboundAuth = dynamicconfig.NewNamespaceTypedSettingWithConverter(
+	"callback.outboundAuth",
+	convertConfig,
+	Config{},
+	`The per-namespace authentication of the callbacks delivered to external destinations. The default is to send
+callbacks without any authentication. The value is a map with possible values:
+     - "SigningKeys":list (optional) keys used to sign callback requests with HMAC-SHA256 over the request timestamp
+        and body. Each entry is a map with "ID":string and "SecretFile":string the file holding the secret, which is
+        read again when it changes. Secrets are never set in the dynamic config itself. The first key signs requests
+        and its ID is sent in the Temporal-Callback-Key-Id header; keep the previous key after it so receivers can be
+        rotated before it is removed.
+     - "Headers":map (optional) static headers added to every callback request. They do not override the headers of
+        the callback itself.
+     - "ClientTLS":list (optional) client certificates to present to destinations. Each entry is a map with
+        "Pattern":string (required) the host:port pattern of the destinations, with '*' wildcards as in
+        callback.allowedAddresses, "CertFile":string and "KeyFile":string (required) the PEM encoded client
+        certificate and key, "CAFile":string (optional) the PEM encoded CAs used to verify the destination instead
+        of the system roots, and "ServerName":string (optional) the name used to verify the destination
+        certificate. The first matching entry is used.`,
+)
+
+type (
+	// Config is the authentication of the callbacks of a namespace.
+	Config struct {
+		SigningKeys []SigningKeyFile
+		Headers     map[string]string
+		ClientTLS   []ClientTLSRule
+	}
+
+	// SigningKey is an HMAC-SHA256 key used to sign callback requests.
+	SigningKey struct {
+		ID     string
+		Secret string
+	}
+
+	// SigningKeyFile references a SigningKey whose secret is held in a file.
+	SigningKeyFile struct {
+		ID         string
+		SecretFile string
+	}
+
+	// ClientTLSRule selects the client certificate presented to the destinations whose host matches Regexp.
+	ClientTLSRule struct {
+		Regexp *regexp.Regexp
+		TLS    ClientTLS
+	}
+
+	// ClientTLS holds the files of a client certificate. It is comparable so that it can key the transports built
+	// from it.
+	ClientTLS struct {
+		CertFile   string
+		KeyFile    string
+		CAFile     string
+		ServerName string
+	}
+)
+
+// ClientTLSFor returns the client certificate to present to a destination host, or nil if there is none.
+func (c Config) ClientTLSFor(host string) *ClientTLS {
+	for _, rule := range c.ClientTLS {
+		if rule.Regexp.MatchString(host) {
+			return &rule.TLS
+		}
+	}
+	return nil
+}
+
+func convertConfig(val any) (Config, error) {
+	type clientTLSEntry struct {
+		Pattern    string
+		CertFile   string
+		KeyFile    string
+		CAFile     string
+		ServerName string
+	}
+	type entry struct {
+		SigningKeys []SigningKeyFile
+		Headers     map[string]string
+		ClientTLS   []clientTLSEntry
+	}
+	intermediate, err := dynamicconfig.ConvertStructure[entry](entry{})(val)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config := Config{
+		Headers: intermediate.Headers,
+	}
+	for _, key := range intermediate.SigningKeys {
+		if key.SecretFile == "" {
+			// Skip keys that cannot sign anything.
+			continue
+		}
+		config.SigningKeys = append(config.SigningKeys, key)
+	}
+	for _, e := range intermediate.ClientTLS {
+		if e.Pattern == "" || e.CertFile == "" || e.KeyFile == "" {
+			// Skip incomplete entries.
+			continue
+		}
+		re, err := regexp.Compile(addressPatternToRegexp(e.Pattern))
+		if err != nil {
+			// Skip entries with malformed Pattern
+			continue
+		}
+		config.ClientTLS = append(config.ClientTLS, ClientTLSRule{
+			Regexp: re,
+			TLS: ClientTLS{
+				CertFile:   e.CertFile,
+				KeyFile:    e.KeyFile,
+				CAFile:     e.CAFile,
+				ServerName: e.ServerName,
+			},
+		})
+	}
+	return config, nil
+}
+
+func addressPatternToRegexp(pattern string) string {
+	var result strings.Builder
+	result.WriteString("^")
+	first := true
+	for literal := range strings.SplitSeq(pattern, "*") {
+		if !first {
+			// Replace * with .*
+			result.WriteString(".*")
+		}
+		result.WriteString(regexp.QuoteMeta(literal))
+		first = false
+	}
+	result.WriteString("$")
+	return result.String()
+}
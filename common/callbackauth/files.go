@@ -0,0 +1,107 @@
+package callbackauth
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type (
+	// SecretCache holds the signing secrets read from files, so that they are not read for every callback. A
+	// secret is read again when its file changes, so that it can be rotated in place.
+	SecretCache struct {
+		secrets fileCache[string, string]
+	}
+
+	// fileCache holds values loaded from files and loads them again when any of the files changes.
+	fileCache[K comparable, V any] struct {
+		files   func(K) []string
+		load    func(K) (V, error)
+		entries sync.Map // K -> *fileCacheEntry[V]
+	}
+
+	fileCacheEntry[V any] struct {
+		stamps []fileStamp
+		once   sync.Once
+		value  V
+		err    error
+	}
+
+	// fileStamp changes whenever a file is written.
+	fileStamp struct {
+		modTime int64
+		size    int64
+	}
+)
+
+func NewSecretCache() *SecretCache {
+	return &SecretCache{
+		secrets: fileCache[string, string]{
+			files: func(secretFile string) []string { return []string{secretFile} },
+			load:  loadSecret,
+		},
+	}
+}
+
+// Get returns the secret held in a file.
+func (c *SecretCache) Get(secretFile string) (string, error) {
+	return c.secrets.get(secretFile)
+}
+
+func loadSecret(secretFile string) (string, error) {
+	data, err := os.ReadFile(secretFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to load callback signing secret: %w", err)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("callback signing secret file %q is empty", secretFile)
+	}
+	return secret, nil
+}
+
+func (c *fileCache[K, V]) get(key K) (V, error) {
+	stamps, err := stampFiles(c.files(key))
+	if err != nil {
+		// Let load report the missing file.
+		return c.load(key)
+	}
+
+	entry := &fileCacheEntry[V]{stamps: stamps}
+	if v, loaded := c.entries.LoadOrStore(key, entry); loaded {
+		if current := v.(*fileCacheEntry[V]); slices.Equal(current.stamps, stamps) {
+			entry = current
+		} else {
+			// The files changed since the value was loaded, load it again.
+			c.entries.CompareAndSwap(key, current, entry)
+		}
+	}
+	entry.once.Do(func() {
+		entry.value, entry.err = c.load(key)
+	})
+	if entry.err != nil {
+		// Don't cache failures, the files may be fixed without changing the dynamic config.
+		c.entries.CompareAndDelete(key, entry)
+	}
+	return entry.value, entry.err
+}
+
+func stampFiles(paths []string) ([]fileStamp, error) {
+	stamps := make([]fileStamp, 0, len(paths))
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		stamps = append(stamps, fileStamp{
+			modTime: info.ModTime().UnixNano(),
+			size:    info.Size(),
+		})
+	}
+	return stamps, nil
+}
@@ -0,0 +1,168 @@
+package callbackauth
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// TransportFactory builds the transport used to reach destinations that are presented a client certificate.
+	TransportFactory func(*tls.Config) (http.RoundTripper, error)
+
+	// TLSTransportCache holds the transports built for each client certificate, so that connections are reused
+	// across callbacks. A transport is built again when the dynamic config of its certificate changes, and when
+	// any of the certificate files changes so that certificates can be rotated in place.
+	TLSTransportCache struct {
+		transports fileCache[ClientTLS, http.RoundTripper]
+	}
+
+	// RoundTripper authenticates the callbacks of a namespace to a destination according to the
+	// callback.outboundAuth dynamic config of the namespace.
+	RoundTripper struct {
+		namespaceID       namespace.ID
+		host              string
+		namespaceRegistry namespace.Registry
+		config            dynamicconfig.TypedPropertyFnWithNamespaceFilter[Config]
+		base              http.RoundTripper
+		tlsTransports     *TLSTransportCache
+		secrets           *SecretCache
+		timeSource        clock.TimeSource
+	}
+)
+
+var _ http.RoundTripper = (*RoundTripper)(nil)
+
+func NewTLSTransportCache(newTransport TransportFactory) *TLSTransportCache {
+	return &TLSTransportCache{
+		transports: fileCache[ClientTLS, http.RoundTripper]{
+			files: func(clientTLS ClientTLS) []string {
+				return []string{clientTLS.CertFile, clientTLS.KeyFile, clientTLS.CAFile}
+			},
+			load: func(clientTLS ClientTLS) (http.RoundTripper, error) {
+				tlsConfig, err := newTLSConfig(clientTLS)
+				if err != nil {
+					return nil, err
+				}
+				return newTransport(tlsConfig)
+			},
+		},
+	}
+}
+
+// Get returns the transport presenting the given client certificate.
+func (c *TLSTransportCache) Get(clientTLS ClientTLS) (http.RoundTripper, error) {
+	return c.transports.get(clientTLS)
+}
+
+func newTLSConfig(clientTLS ClientTLS) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(clientTLS.CertFile, clientTLS.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load callback client certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ServerName:   clientTLS.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientTLS.CAFile != "" {
+		caPEM, err := os.ReadFile(clientTLS.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load callback client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("unable to parse callback client CA file %q", clientTLS.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// NewRoundTripper returns a RoundTripper delivering the callbacks of a namespace to a destination, which is the
+// scheme and host of the callback URL. Requests are sent with base unless the destination is presented a client
+// certificate, and are signed with the secrets read through secrets.
+func NewRoundTripper(
+	namespaceID namespace.ID,
+	destination string,
+	namespaceRegistry namespace.Registry,
+	dc *dynamicconfig.Collection,
+	base http.RoundTripper,
+	tlsTransports *TLSTransportCache,
+	secrets *SecretCache,
+	timeSource clock.TimeSource,
+) *RoundTripper {
+	var host string
+	if u, err := url.Parse(destination); err == nil {
+		host = u.Host
+	}
+	return &RoundTripper{
+		namespaceID:       namespaceID,
+		host:              host,
+		namespaceRegistry: namespaceRegistry,
+		config:            OutboundAuth.Get(dc),
+		base:              base,
+		tlsTransports:     tlsTransports,
+		secrets:           secrets,
+		timeSource:        timeSource,
+	}
+}
+
+func (t *RoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	ns, err := t.namespaceRegistry.GetNamespaceByID(t.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	config := t.config(ns.Name().String())
+
+	transport := t.base
+	if clientTLS := config.ClientTLSFor(t.host); clientTLS != nil {
+		if transport, err = t.tlsTransports.Get(*clientTLS); err != nil {
+			return nil, err
+		}
+	}
+	if len(config.Headers) == 0 && len(config.SigningKeys) == 0 {
+		return transport.RoundTrip(r)
+	}
+	var signingKey SigningKey
+	if len(config.SigningKeys) > 0 {
+		signingKey.ID = config.SigningKeys[0].ID
+		if signingKey.Secret, err = t.secrets.Get(config.SigningKeys[0].SecretFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// A RoundTripper must not modify the request it was given.
+	r = r.Clone(r.Context())
+	for k, v := range config.Headers {
+		if r.Header.Get(k) == "" {
+			r.Header.Set(k, v)
+		}
+	}
+	if len(config.SigningKeys) > 0 {
+		var body []byte
+		if r.Body != nil && r.Body != http.NoBody {
+			body, err = io.ReadAll(r.Body)
+			_ = r.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+			r.ContentLength = int64(len(body))
+		}
+		SignRequest(r.Header, signingKey, t.timeSource.Now(), body)
+	}
+	return transport.RoundTrip(r)
+}
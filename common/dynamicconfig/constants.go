@@ -726,6 +726,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		primitives.GetHistoryMaxPageSize,
 		`FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page`,
 	)
+	FrontendHistoryEventFeedPollInterval = NewGlobalDurationSetting(
+		"frontend.historyEventFeedPollInterval",
+		time.Second,
+		`FrontendHistoryEventFeedPollInterval is how often ReadHistoryEventFeed polls the history event feed for new
+records when the request waits for new records.`,
+	)
 	FrontendRPS = NewGlobalIntSetting(
 		"frontend.rps",
 		2400,
@@ -1217,6 +1223,32 @@ Default is 4. Read once before delete of specified namespace is started.`,
 after all namespace resources (i.e. workflow executions) are deleted.
 Default is 0, means, namespace will be deleted immediately.`,
 	)
+	FrontendShadowTrafficTargetCluster = NewNamespaceStringSetting(
+		"frontend.shadowTrafficTargetCluster",
+		"",
+		`FrontendShadowTrafficTargetCluster is the cluster to which the read-only calls (describe and query) served by
+this cluster are mirrored, so that a namespace migration target can be verified before handover. Queries are
+mirrored as a describe of the queried execution, since only the workers polling this cluster can answer them.
+Latency and result mismatches of the mirrored calls are reported as metrics and wide events. Empty disables
+shadow traffic.`,
+	)
+	FrontendShadowTrafficSampleRate = NewNamespaceFloatSetting(
+		"frontend.shadowTrafficSampleRate",
+		1.0,
+		`FrontendShadowTrafficSampleRate is the fraction (0.0 to 1.0) of read-only calls mirrored to the shadow traffic
+target cluster.`,
+	)
+	FrontendShadowTrafficMaxOutstandingRequests = NewGlobalIntSetting(
+		"frontend.shadowTrafficMaxOutstandingRequests",
+		100,
+		`FrontendShadowTrafficMaxOutstandingRequests is the maximum number of in-flight mirrored calls per frontend host.
+Calls exceeding the limit are dropped rather than mirrored.`,
+	)
+	FrontendShadowTrafficTimeout = NewGlobalDurationSetting(
+		"frontend.shadowTrafficTimeout",
+		10*time.Second,
+		`FrontendShadowTrafficTimeout is the timeout of a call mirrored to the shadow traffic target cluster.`,
+	)
 	ProtectedNamespaces = NewGlobalTypedSetting(
 		"worker.protectedNamespaces",
 		([]string)(nil),
@@ -1963,6 +1995,50 @@ Do NOT use non-zero value with persistence layers that are missing AssertShardOw
 		2*time.Second,
 		`ShardFinalizerTimeout configures if and for how long the shard will attempt
 to cleanup any of its associated data, such as workflow contexts. If set to zero, the finalizer is disabled.`,
+	)
+	ShardBalancerEnabled = NewGlobalBoolSetting(
+		"history.shardBalancerEnabled",
+		false,
+		`ShardBalancerEnabled enables the history shard balancer. The history host membership assigns the balancer to
+periodically gathers the load of the shards owned by every history host, and pins a hot shard of the most loaded
+host to the least loaded host when the former is overloaded.`,
+	)
+	ShardBalancerInterval = NewGlobalDurationSetting(
+		"history.shardBalancerInterval",
+		5*time.Minute,
+		`ShardBalancerInterval is the interval at which the history shard balancer samples shard load and moves at
+most one shard.`,
+	)
+	ShardBalancerLoadThreshold = NewGlobalFloatSetting(
+		"history.shardBalancerLoadThreshold",
+		0.25,
+		`ShardBalancerLoadThreshold is how far above the average load of all history hosts, as a fraction of the
+average, the load of a host must be before the shard balancer moves one of its shards.`,
+	)
+	HistoryShardPools = NewGlobalTypedSetting(
+		"history.shardPools",
+		map[string]ShardPool(nil),
+		`HistoryShardPools defines the history shard pools, by name. A pool is a range of history shards that
+namespaces can be assigned to with the shard pool migration workflow, which records the pool and its shard range
+in the namespace config, so that their workflows are only placed on the shards of the pool. The workflows of
+namespaces that are not assigned to a pool are placed on all shards. Workflows are placed using the recorded range
+only, so changing this setting doesn't move the workflows of assigned namespaces, and requests for a namespace
+whose recorded range is not valid fail. Pools must not overlap, and a pool can't be resized while namespaces are
+assigned to it: assignments to such pools are rejected. Replication streams shards one to one between clusters
+with the same number of shards, so such clusters must define the same pools.`,
+	)
+	HistoryEventFeedEnabled = NewNamespaceIDBoolSetting(
+		"history.historyEventFeedEnabled",
+		false,
+		`HistoryEventFeedEnabled publishes the history events of the workflow executions of a namespace to its history
+event feed when they close. The feed is read with the ReadHistoryEventFeed API of the frontend service.`,
+	)
+	HistoryEventFeedRetention = NewNamespaceIDDurationSetting(
+		"history.historyEventFeedRetention",
+		7*24*time.Hour,
+		`HistoryEventFeedRetention is how long records are kept in the history event feed of a namespace after they are
+published, whether they were read or not. Readers which fall further behind are told how many records they missed.
+Zero keeps all records.`,
 	)
 	HistoryClientOwnershipCachingEnabled = NewGlobalBoolSetting(
 		"history.clientOwnershipCachingEnabled",
@@ -2047,6 +2123,21 @@ currently only work for scheduled queues and the task range is 1s.`,
 		50,
 		`QueueCriticalSlicesCount is the max number of slices in one queue
 before force compacting slices`,
+	)
+	QueueMitigationPolicy = NewGlobalTypedSetting(
+		"history.queueMitigationPolicy",
+		QueueMitigationPolicySettings(nil),
+		`QueueMitigationPolicy configures the actions run, in order, when a history queue raises an alert. It maps an
+alert type (QueuePendingTaskCount, ReaderStuck or SliceCount) to a list of actions, e.g.
+{"ReaderStuck": [{"Action": "dlq-stuck-tasks", "MinAttempts": 100}, {"Action": "reader-stuck"}, {"Action": "page"}]}.
+Built-in actions are queue-pending-task, reader-stuck, slice-count, dlq-stuck-tasks, split-slice-by-namespace,
+throttle-namespace and page. Alert types missing from the policy run their default action.`,
+	)
+	QueueMitigationLogSize = NewGlobalIntSetting(
+		"history.queueMitigationLogSize",
+		1000,
+		`QueueMitigationLogSize is the number of applied queue mitigations kept in memory by a history host
+and returned by the ListQueueMitigations admin API`,
 	)
 	QueuePendingTaskMaxCount = NewGlobalIntSetting(
 		"history.queuePendingTasksMaxCount",
@@ -2155,6 +2246,46 @@ When this limit is reached, new workflows will fall back to the base FIFO schedu
 		`TaskSchedulerExecutionQueueSchedulerQueueConcurrency is the max number of worker goroutines per workflow queue.
 Higher values allow limited parallelism per workflow. Values <= 0 are capped to 1.`,
 	)
+	TaskSchedulerNamespaceWeight = NewNamespaceFloatSetting(
+		"history.taskSchedulerNamespaceWeight",
+		1,
+		`TaskSchedulerNamespaceWeight is the multiplier applied to the round robin weights of a namespace's task channels
+in the host level task schedulers. Values above 1 give the namespace a larger share of the task processing capacity
+of a host when tasks are backlogged, values below 1 a smaller share.`,
+	)
+	TaskSchedulerBacklogWeightMultiplier = NewGlobalFloatSetting(
+		"history.taskSchedulerBacklogWeightMultiplier",
+		1,
+		`TaskSchedulerBacklogWeightMultiplier is the multiplier applied to the round robin weight of a task channel whose
+buffer is full. Channels with a partial backlog are scaled proportionally. Values less or equal to 1 disable backlog
+based weights.`,
+	)
+	TaskSchedulerMaxChannelWeight = NewGlobalIntSetting(
+		"history.taskSchedulerMaxChannelWeight",
+		100,
+		`TaskSchedulerMaxChannelWeight caps the round robin weight of a task channel after namespace, backlog and override
+multipliers are applied. Configured round robin weights above the cap are not lowered.`,
+	)
+	TaskSchedulerWeightRefreshInterval = NewGlobalDurationSetting(
+		"history.taskSchedulerWeightRefreshInterval",
+		10*time.Second,
+		`TaskSchedulerWeightRefreshInterval is the interval at which host level task schedulers re-evaluate the weights of
+their task channels and emit per namespace channel metrics`,
+	)
+	QueueTaskTracingSampleRate = NewNamespaceFloatSetting(
+		"history.queueTaskTracingSampleRate",
+		0,
+		`QueueTaskTracingSampleRate is the fraction of the history tasks of a namespace whose lifecycle is traced, from
+their generation to their ack. Traced tasks are reported as OpenTelemetry spans when tracing is enabled, and can be
+inspected with tdbg shard trace-task. Sampling is deterministic per task, so a task is traced by every history host
+that processes it.`,
+	)
+	QueueTaskTracingBufferSize = NewGlobalIntSetting(
+		"history.queueTaskTracingBufferSize",
+		10000,
+		`QueueTaskTracingBufferSize is the maximum number of traced history tasks whose lifecycle is kept in memory by a
+history host`,
+	)
 
 	TimerTaskBatchSize = NewGlobalIntSetting(
 		"history.timerTaskBatchSize",
@@ -2766,6 +2897,19 @@ the number of children greater than or equal to this threshold`,
 		false,
 		`SkipReapplicationByNamespaceID is whether skipping a event re-application for a namespace`,
 	)
+	ConflictResolutionRecordMaxCount = NewNamespaceIDIntSetting(
+		"history.conflictResolutionRecordMaxCount",
+		10,
+		`ConflictResolutionRecordMaxCount is the max number of conflict resolution records kept in the mutable state of a
+workflow run, older records are dropped first. 0 disables recording conflict resolutions in the mutable state, they are
+still emitted as wide events.`,
+	)
+	ConflictResolutionRecordMaxReappliedEvents = NewNamespaceIDIntSetting(
+		"history.conflictResolutionRecordMaxReappliedEvents",
+		100,
+		`ConflictResolutionRecordMaxReappliedEvents is the max number of reapplied events kept in a conflict resolution
+record, further events are only counted.`,
+	)
 	StandbyTaskReReplicationContextTimeout = NewNamespaceIDDurationSetting(
 		"history.standbyTaskReReplicationContextTimeout",
 		30*time.Second,
@@ -3108,6 +3252,31 @@ time (mirrors gRPC MaxConnectionAge's +/-10% jitter). Values outside [0, 1] are
 		false,
 		`ReplicationStreamSenderSkipStuckTask, when true, makes the replication stream sender log, emit a metric, and skip (advance the watermark past) a task that could not be built ("converted") after exhausting retries, instead of failing and wedging the whole stream. Only unbuildable tasks (corrupt/unusable source info) are skipped; transient send/rate-limit failures and infra/teardown errors (shard-ownership-lost, stream error, context canceled) are not, so they still tear the stream down. Deterministic non-retryable send failures such as an oversized gRPC message are not handled here (left to the transport-layer message-size fix).`,
 	)
+	ReplicationStreamSenderBatchMaxTasks = NewGlobalIntSetting(
+		"history.ReplicationStreamSenderBatchMaxTasks",
+		1,
+		`ReplicationStreamSenderBatchMaxTasks is the max number of replication tasks sent in one replication stream message. 1 disables batching.`,
+	)
+	ReplicationStreamSenderBatchMaxBytes = NewGlobalIntSetting(
+		"history.ReplicationStreamSenderBatchMaxBytes",
+		1024*1024,
+		`ReplicationStreamSenderBatchMaxBytes is the max serialized size of the replication tasks batched in one replication stream message. A single task larger than this limit is still sent in its own message.`,
+	)
+	ReplicationStreamSenderBatchMaxDelay = NewGlobalDurationSetting(
+		"history.ReplicationStreamSenderBatchMaxDelay",
+		100*time.Millisecond,
+		`ReplicationStreamSenderBatchMaxDelay is the max time a replication task is held by the stream sender waiting for its batch to fill up.`,
+	)
+	ReplicationStreamSenderCompression = NewGlobalStringSetting(
+		"history.ReplicationStreamSenderCompression",
+		"",
+		`ReplicationStreamSenderCompression is the compression ("gzip" or "zstd") applied to batches of replication tasks sent over replication streams. Compression is only used if the receiving cluster advertised support for it, otherwise tasks are sent uncompressed. Empty disables compression.`,
+	)
+	ReplicationStreamReceiverMaxUncompressedSize = NewGlobalIntSetting(
+		"history.ReplicationStreamReceiverMaxUncompressedSize",
+		128*1024*1024,
+		`ReplicationStreamReceiverMaxUncompressedSize is the max uncompressed size, in bytes, of a compressed batch of replication tasks accepted by the replication stream receiver. Batches declaring a larger size are rejected before being decompressed.`,
+	)
 	ReplicationExecutableTaskErrorRetryWait = NewGlobalDurationSetting(
 		"history.ReplicationExecutableTaskErrorRetryWait",
 		1*time.Second,
@@ -3220,6 +3389,16 @@ This optimization should only be enabled after every cluster that may receive CH
 hydrated ancestor components when applying child-node mutations.`,
 	)
 
+	EnableCHASMSchemaUpgrade = NewGlobalBoolSetting(
+		"history.enableCHASMSchemaUpgrade",
+		false,
+		`EnableCHASMSchemaUpgrade controls whether CHASM components persisted with an older schema version are upgraded
+to, and persisted with, the schema version of this server version. Servers running an older version fail requests
+for executions with a newer schema version, so this should only be enabled after every history host of every cluster
+that may receive CHASM replication runs this server version. Until then, components are upgraded in memory only and
+keep their persisted schema version, and new components are persisted with their minimum schema version.`,
+	)
+
 	ChasmMaxInMemoryPureTasks = NewGlobalIntSetting(
 		"history.chasmMaxInMemoryPureTasks",
 		32,
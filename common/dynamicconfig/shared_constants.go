@@ -158,6 +158,16 @@ var DefaultHistoryCacheBackgroundEvictSettings = CacheBackgroundEvictSettings{
 	MaxEntryPerCall: 1024,
 }
 
+// ShardPool is a range of history shards that namespaces can be assigned to.
+type ShardPool struct {
+	// FirstShardID and LastShardID are the first and last shard of the pool, inclusive.
+	FirstShardID int32
+	LastShardID  int32
+	// RPS limits the requests per second each history host accepts for all the namespaces assigned to the pool
+	// together. 0 means the pool is only limited by the host and namespace limits.
+	RPS int
+}
+
 type PartitionScaleAllowedDrift struct {
 	// Delta and Ratio controls how far off client counts can be before we reject an RPC.
 	// If the client count is within the delta, it's allowed. Also, if the ratio of
@@ -256,3 +266,18 @@ type LatencyHealthChecksPerPercentile struct {
 	// PercentileSettings stores settings for the health check of each percentile.
 	PercentileSettings []LatencyHealthCheckSettings
 }
+
+// QueueMitigationPolicySettings maps a history queue alert type (QueuePendingTaskCount, ReaderStuck or SliceCount) to the
+// mitigation actions run, in order, when the alert is raised.
+type QueueMitigationPolicySettings map[string][]QueueMitigationActionSettings
+
+type QueueMitigationActionSettings struct {
+	// Action is the name of a registered mitigation action, e.g. dlq-stuck-tasks.
+	Action string
+	// MinAttempts is the number of attempts after which a stuck task is moved to the DLQ (dlq-stuck-tasks only).
+	MinAttempts int
+	// Multiplier is applied to the weights of the throttled namespace (throttle-namespace only).
+	Multiplier float64
+	// Duration is how long the namespace is throttled (throttle-namespace only).
+	Duration time.Duration
+}
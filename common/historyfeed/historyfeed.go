@@ -0,0 +1,137 @@
+// Package historyfeed publishes the history events of closed workflow executions to the history event feed of their
+// namespace.
+//
+// Records are published by the history service from a dedicated transfer task generated when a workflow execution
+// closes, one page of history events at a time. The task checkpoints its progress in the mutable state after every
+// page, so a retried task resumes after the last page it checkpointed. A page may still be published again if the
+// task fails between publishing it and checkpointing it: records are delivered at least once, and consumers have to be
+// idempotent.
+package historyfeed
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Sink receives the records of the history event feeds of all namespaces.
+	Sink interface {
+		Publish(ctx context.Context, record *persistencespb.HistoryEventFeedRecord) error
+	}
+
+	// PersistenceSink appends records to the durable feed of their namespace, which is read with the
+	// ReadHistoryEventFeed frontend API. Records are retained for a duration after they are published.
+	PersistenceSink struct {
+		manager    persistence.HistoryEventFeedManager
+		timeSource clock.TimeSource
+		retention  dynamicconfig.DurationPropertyFnWithNamespaceIDFilter
+	}
+
+	// FileSink appends records as JSON lines to a file. It is meant for testing.
+	FileSink struct {
+		mu      sync.Mutex
+		path    string
+		encoder codec.JSONPBEncoder
+	}
+
+	// Filter selects the records and events of a feed. Empty fields select everything.
+	Filter struct {
+		WorkflowTypes []string
+		EventTypes    []enumspb.EventType
+	}
+)
+
+var (
+	_ Sink = (*PersistenceSink)(nil)
+	_ Sink = (*FileSink)(nil)
+)
+
+func NewPersistenceSink(
+	manager persistence.HistoryEventFeedManager,
+	timeSource clock.TimeSource,
+	dc *dynamicconfig.Collection,
+) *PersistenceSink {
+	return &PersistenceSink{
+		manager:    manager,
+		timeSource: timeSource,
+		retention:  dynamicconfig.HistoryEventFeedRetention.Get(dc),
+	}
+}
+
+func (s *PersistenceSink) Publish(ctx context.Context, record *persistencespb.HistoryEventFeedRecord) error {
+	record.PublishTime = timestamppb.New(s.timeSource.Now())
+	_, err := s.manager.AppendHistoryEventFeedRecord(ctx, &persistence.AppendHistoryEventFeedRecordRequest{
+		NamespaceID: record.GetNamespaceId(),
+		Record:      record,
+		Retention:   s.retention(namespace.ID(record.GetNamespaceId())),
+	})
+	return err
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{
+		path:    path,
+		encoder: codec.NewJSONPBEncoder(),
+	}
+}
+
+func (s *FileSink) Publish(_ context.Context, record *persistencespb.HistoryEventFeedRecord) error {
+	data, err := s.encoder.Encode(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open history event feed file: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write history event feed file: %w", err)
+	}
+	return f.Close()
+}
+
+// Apply returns the part of the record selected by the filter, or nil if nothing is selected.
+func (f Filter) Apply(record *persistencespb.HistoryEventFeedRecord) *persistencespb.HistoryEventFeedRecord {
+	if len(f.WorkflowTypes) > 0 && !slices.Contains(f.WorkflowTypes, record.GetWorkflowType()) {
+		return nil
+	}
+	if len(f.EventTypes) == 0 {
+		return record
+	}
+	var events []*historypb.HistoryEvent
+	for _, event := range record.GetEvents() {
+		if slices.Contains(f.EventTypes, event.GetEventType()) {
+			events = append(events, event)
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return &persistencespb.HistoryEventFeedRecord{
+		NamespaceId:  record.GetNamespaceId(),
+		WorkflowId:   record.GetWorkflowId(),
+		RunId:        record.GetRunId(),
+		WorkflowType: record.GetWorkflowType(),
+		CloseTime:    record.GetCloseTime(),
+		Events:       events,
+		PublishTime:  record.GetPublishTime(),
+	}
+}
@@ -0,0 +1,106 @@
+package historyfeed
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+)
+
+func testRecord() *persistencespb.HistoryEventFeedRecord {
+	return &persistencespb.HistoryEventFeedRecord{
+		NamespaceId:  "namespace-id",
+		WorkflowId:   "workflow-id",
+		RunId:        "run-id",
+		WorkflowType: "workflow-type",
+		Events: []*historypb.HistoryEvent{
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+		},
+	}
+}
+
+func TestFilter(t *testing.T) {
+	record := testRecord()
+
+	protorequire.ProtoEqual(t, record, Filter{}.Apply(record))
+	protorequire.ProtoEqual(t, record, Filter{WorkflowTypes: []string{"other", "workflow-type"}}.Apply(record))
+	require.Nil(t, Filter{WorkflowTypes: []string{"other"}}.Apply(record))
+	require.Nil(t, Filter{EventTypes: []enumspb.EventType{enumspb.EVENT_TYPE_TIMER_FIRED}}.Apply(record))
+
+	filtered := Filter{
+		WorkflowTypes: []string{"workflow-type"},
+		EventTypes: []enumspb.EventType{
+			enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		},
+	}.Apply(record)
+	require.Equal(t, "workflow-id", filtered.GetWorkflowId())
+	require.Len(t, filtered.GetEvents(), 2)
+	require.Equal(t, int64(1), filtered.GetEvents()[0].GetEventId())
+	require.Equal(t, int64(3), filtered.GetEvents()[1].GetEventId())
+	// The record itself is not modified.
+	require.Len(t, record.GetEvents(), 3)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feed.jsonl")
+	sink := NewFileSink(path)
+
+	first := testRecord()
+	second := testRecord()
+	second.RunId = "other-run-id"
+	require.NoError(t, sink.Publish(context.Background(), first))
+	require.NoError(t, sink.Publish(context.Background(), second))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	decoder := codec.NewJSONPBEncoder()
+	var records []*persistencespb.HistoryEventFeedRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := &persistencespb.HistoryEventFeedRecord{}
+		require.NoError(t, decoder.Decode(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 2)
+	protorequire.ProtoEqual(t, first, records[0])
+	protorequire.ProtoEqual(t, second, records[1])
+}
+
+func TestPersistenceSink(t *testing.T) {
+	manager := persistence.NewMockHistoryEventFeedManager(gomock.NewController(t))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.HistoryEventFeedRetention.Key(): time.Hour,
+	}, log.NewNoopLogger())
+	sink := NewPersistenceSink(manager, clock.NewEventTimeSource().Update(now), dc)
+
+	record := testRecord()
+	manager.EXPECT().AppendHistoryEventFeedRecord(gomock.Any(), &persistence.AppendHistoryEventFeedRecordRequest{
+		NamespaceID: "namespace-id",
+		Record:      record,
+		Retention:   time.Hour,
+	}).Return(&persistence.AppendHistoryEventFeedRecordResponse{}, nil)
+	require.NoError(t, sink.Publish(context.Background(), record))
+	// The feed retention deletes records by publish time.
+	require.Equal(t, now, record.GetPublishTime().AsTime())
+}
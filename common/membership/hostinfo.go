@@ -10,6 +10,13 @@ type HostInfo interface {
 	GetAddress() string
 }
 
+// LabeledHostInfo is a HostInfo that also exposes the labels its host has set with Monitor.SetLabel.
+type LabeledHostInfo interface {
+	HostInfo
+	// Label returns the value of the given label, if set.
+	Label(key string) (string, bool)
+}
+
 // NewHostInfoFromAddress creates a new HostInfo instance from a socket address.
 func NewHostInfoFromAddress(address string) HostInfo {
 	return hostAddress(address)
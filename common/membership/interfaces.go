@@ -58,6 +58,9 @@ type (
 		WaitUntilInitialized(context.Context) error
 		// SetDraining sets the draining state (synchronized through ringpop)
 		SetDraining(draining bool) error
+		// SetLabel sets a label on this host (synchronized through ringpop). Setting an empty value removes the
+		// label. Labels of other hosts can be read from the HostInfo of their members, see LabeledHostInfo.
+		SetLabel(key string, value string) error
 		// ApproximateMaxPropagationTime returns an approximate upper bound on propagation time
 		// for updates to membership information. This is _not_ a guarantee! This value is only
 		// provided to help with startup/shutdown timing as a best-effort.
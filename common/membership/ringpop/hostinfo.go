@@ -19,7 +19,7 @@ type hostInfo struct {
 }
 
 var _ rpmembership.Member = (*hostInfo)(nil)
-var _ membership.HostInfo = (*hostInfo)(nil)
+var _ membership.LabeledHostInfo = (*hostInfo)(nil)
 
 // newHostInfo creates a new *hostInfo instance
 func newHostInfo(addr string, labels map[string]string) *hostInfo {
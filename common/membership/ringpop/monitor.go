@@ -445,6 +445,19 @@ func (rpo *monitor) SetDraining(draining bool) error {
 	return labels.Set(drainingKey, strconv.FormatBool(draining))
 }
 
+func (rpo *monitor) SetLabel(key string, value string) error {
+	labels, err := rpo.rp.Labels()
+	if err != nil {
+		// This only happens if ringpop is not bootstrapped yet.
+		return err
+	}
+	if value == "" {
+		_, err = labels.Remove(key)
+		return err
+	}
+	return labels.Set(key, value)
+}
+
 func (rpo *monitor) ApproximateMaxPropagationTime() time.Duration {
 	return rpo.propagationTime
 }
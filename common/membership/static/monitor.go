@@ -2,12 +2,16 @@ package static
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/primitives"
 )
 
+// errLabelsNotSupported is returned by SetLabel, since static hosts have no way to share labels.
+var errLabelsNotSupported = errors.New("host labels are not supported with static membership")
+
 type (
 	staticMonitor struct {
 		hosts     map[primitives.ServiceName]Hosts
@@ -73,6 +77,10 @@ func (s *staticMonitor) SetDraining(draining bool) error {
 	return nil
 }
 
+func (s *staticMonitor) SetLabel(key string, value string) error {
+	return errLabelsNotSupported
+}
+
 func (s *staticMonitor) ApproximateMaxPropagationTime() time.Duration {
 	return 0
 }
@@ -582,6 +582,7 @@ const (
 	TaskTypeTransferActiveTaskSignalExecution             = "TransferActiveTaskSignalExecution"
 	TaskTypeTransferActiveTaskStartChildExecution         = "TransferActiveTaskStartChildExecution"
 	TaskTypeTransferActiveTaskResetWorkflow               = "TransferActiveTaskResetWorkflow"
+	TaskTypeTransferActiveTaskPublishHistoryEventFeed     = "TransferActiveTaskPublishHistoryEventFeed"
 	TaskTypeTransferActiveTaskDeleteExecution             = "TransferActiveTaskDeleteExecution"
 	TaskTypeTransferStandbyTaskActivity                   = "TransferStandbyTaskActivity"
 	TaskTypeTransferStandbyTaskWorkflowTask               = "TransferStandbyTaskWorkflowTask"
@@ -590,6 +591,7 @@ const (
 	TaskTypeTransferStandbyTaskSignalExecution            = "TransferStandbyTaskSignalExecution"
 	TaskTypeTransferStandbyTaskStartChildExecution        = "TransferStandbyTaskStartChildExecution"
 	TaskTypeTransferStandbyTaskResetWorkflow              = "TransferStandbyTaskResetWorkflow"
+	TaskTypeTransferStandbyTaskPublishHistoryEventFeed    = "TransferStandbyTaskPublishHistoryEventFeed"
 	TaskTypeTransferStandbyTaskDeleteExecution            = "TransferStandbyTaskDeleteExecution"
 	TaskTypeVisibilityTaskStartExecution                  = "VisibilityTaskStartExecution"
 	TaskTypeVisibilityTaskUpsertExecution                 = "VisibilityTaskUpsertExecution"
@@ -719,6 +721,11 @@ var (
 	ClientRedirectionRequests        = NewCounterDef("client_redirection_requests")
 	ClientRedirectionFailures        = NewCounterDef("client_redirection_errors")
 	ClientRedirectionLatency         = NewTimerDef("client_redirection_latency")
+	ShadowTrafficRequests            = NewCounterDef("shadow_traffic_requests")
+	ShadowTrafficFailures            = NewCounterDef("shadow_traffic_errors")
+	ShadowTrafficMismatches          = NewCounterDef("shadow_traffic_mismatches")
+	ShadowTrafficDropped             = NewCounterDef("shadow_traffic_dropped")
+	ShadowTrafficLatency             = NewTimerDef("shadow_traffic_latency")
 	StateTransitionCount             = NewDimensionlessHistogramDef("state_transition_count")
 	HistorySize                      = NewBytesHistogramDef("history_size")
 	HistoryCount                     = NewDimensionlessHistogramDef("history_count")
@@ -952,7 +959,15 @@ var (
 		"pending_tasks",
 		WithDescription("A histogram across history shards for the number of in-memory pending history tasks."),
 	)
-	TaskSchedulerThrottled       = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerThrottled     = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerChannelWeight = NewGaugeDef(
+		"task_scheduler_channel_weight",
+		WithDescription("The round robin weight of a namespace's task channel in a host level task scheduler."),
+	)
+	TaskSchedulerChannelBacklog = NewGaugeDef(
+		"task_scheduler_channel_backlog",
+		WithDescription("The number of tasks waiting in a namespace's task channel of a host level task scheduler."),
+	)
 	QueueScheduleLatency         = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
 	QueueReaderCountHistogram    = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram     = NewDimensionlessHistogramDef("queue_slice_count")
@@ -1128,6 +1143,10 @@ var (
 	ReplicationTaskSendBacklog         = NewDimensionlessHistogramDef("replication_task_send_backlog")
 	ReplicationTasksRecv               = NewCounterDef("replication_tasks_recv")
 	ReplicationTasksRecvBacklog        = NewDimensionlessHistogramDef("replication_tasks_recv_backlog")
+	ReplicationStreamBatchSize         = NewDimensionlessHistogramDef("replication_stream_batch_size")
+	ReplicationStreamUncompressedBytes = NewBytesHistogramDef("replication_stream_uncompressed_bytes")
+	ReplicationStreamCompressedBytes   = NewBytesHistogramDef("replication_stream_compressed_bytes")
+	ReplicationStreamCompressionRatio  = NewDimensionlessHistogramDef("replication_stream_compression_ratio")
 	ReplicationTasksSkipped            = NewCounterDef("replication_tasks_skipped")
 	ReplicationTasksApplied            = NewCounterDef("replication_tasks_applied")
 	ReplicationTasksFailed             = NewCounterDef("replication_tasks_failed")
@@ -1657,15 +1676,16 @@ var (
 	WorkflowTasksCompleted    = NewCounterDef("workflow_tasks_completed")
 
 	// Force replication
-	EncounterZombieWorkflowCount        = NewCounterDef("encounter_zombie_workflow_count")
-	EncounterNotFoundWorkflowCount      = NewCounterDef("encounter_not_found_workflow_count")
-	EncounterPassRetentionWorkflowCount = NewCounterDef("encounter_pass_retention_workflow_count")
-	GenerateReplicationTasksLatency     = NewTimerDef("generate_replication_tasks_latency")
-	VerifyReplicationTaskSuccess        = NewCounterDef("verify_replication_task_success")
-	VerifyReplicationTaskNotFound       = NewCounterDef("verify_replication_task_not_found")
-	VerifyReplicationTaskFailed         = NewCounterDef("verify_replication_task_failed")
-	VerifyReplicationTasksLatency       = NewTimerDef("verify_replication_tasks_latency")
-	VerifyDescribeMutableStateLatency   = NewTimerDef("verify_describe_mutable_state_latency")
+	EncounterZombieWorkflowCount                  = NewCounterDef("encounter_zombie_workflow_count")
+	EncounterNotFoundWorkflowCount                = NewCounterDef("encounter_not_found_workflow_count")
+	EncounterPassRetentionWorkflowCount           = NewCounterDef("encounter_pass_retention_workflow_count")
+	EncounterExcludedFromReplicationWorkflowCount = NewCounterDef("encounter_excluded_from_replication_workflow_count")
+	GenerateReplicationTasksLatency               = NewTimerDef("generate_replication_tasks_latency")
+	VerifyReplicationTaskSuccess                  = NewCounterDef("verify_replication_task_success")
+	VerifyReplicationTaskNotFound                 = NewCounterDef("verify_replication_task_not_found")
+	VerifyReplicationTaskFailed                   = NewCounterDef("verify_replication_task_failed")
+	VerifyReplicationTasksLatency                 = NewTimerDef("verify_replication_tasks_latency")
+	VerifyDescribeMutableStateLatency             = NewTimerDef("verify_describe_mutable_state_latency")
 
 	// Replication
 	NamespaceReplicationTaskAckLevelGauge = NewGaugeDef("namespace_replication_task_ack_level")
@@ -245,6 +245,16 @@ func (ns *Namespace) ReplicationConfig() *persistencespb.NamespaceReplicationCon
 	return ns.replicationResolver.ReplicationConfig()
 }
 
+// ReplicationFilter returns the filter restricting the workflows replicated from this cluster to the other clusters
+// of the namespace, or nil if every workflow is replicated.
+func (ns *Namespace) ReplicationFilter() *persistencespb.ReplicationFilter {
+	filter := ns.ReplicationConfig().GetReplicationFilter()
+	if len(filter.GetWorkflowTypes()) == 0 && len(filter.GetSearchAttributes()) == 0 {
+		return nil
+	}
+	return filter
+}
+
 // NotificationVersion return the global notification version of when namespace changed
 func (ns *Namespace) NotificationVersion() int64 {
 	return ns.notificationVersion
@@ -291,6 +301,11 @@ func (ns *Namespace) Retention() time.Duration {
 	return ns.config.Retention.AsDuration()
 }
 
+// ShardPool returns the history shard pool the workflows of this namespace are placed on. Nil means all shards.
+func (ns *Namespace) ShardPool() *persistencespb.ShardPoolAssignment {
+	return ns.config.GetShardPool()
+}
+
 // CustomSearchAttributesMapper is a part of temporary solution. Do not use this method.
 func (ns *Namespace) CustomSearchAttributesMapper() CustomSearchAttributesMapper {
 	return ns.customSearchAttributesMapper
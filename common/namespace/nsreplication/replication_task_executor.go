@@ -361,6 +361,8 @@ func (h *taskExecutorImpl) handleNamespaceUpdateReplicationTask(
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			// Shard pools are local to the cluster.
+			ShardPool: resp.Namespace.Config.GetShardPool(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
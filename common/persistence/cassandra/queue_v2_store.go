@@ -35,9 +35,13 @@ const (
 	TemplateCreateQueueQuery         = `INSERT INTO queues (queue_type, queue_name, metadata_payload, metadata_encoding, version) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS`
 	TemplateGetQueueQuery            = `SELECT metadata_payload, metadata_encoding, version FROM queues WHERE queue_type = ? AND queue_name = ?`
 	TemplateRangeDeleteMessagesQuery = `DELETE FROM queue_messages WHERE queue_type = ? AND queue_name = ? AND queue_partition = ? AND message_id >= ? AND message_id <= ?`
+	TemplateDeleteMessageQuery       = `DELETE FROM queue_messages WHERE queue_type = ? AND queue_name = ? AND queue_partition = ? AND message_id = ?`
+	TemplateTombstoneMessageQuery    = `UPDATE queue_messages SET message_payload = ?, message_encoding = ? WHERE queue_type = ? AND queue_name = ? AND queue_partition = ? AND message_id = ? IF EXISTS`
 	TemplateUpdateQueueMetadataQuery = `UPDATE queues SET metadata_payload = ?, metadata_encoding = ?, version = ? WHERE queue_type = ? AND queue_name = ? IF version = ?`
 	// We will have to ALLOW FILTERING for this query since partition key consists of both queue_type and queue_name.
 	templateGetQueueNamesQuery = `SELECT queue_name, metadata_payload, metadata_encoding, version FROM queues WHERE queue_type = ? ALLOW FILTERING`
+	// tombstoneMessageEncoding is the encoding of a deleted message which is kept in the queue. See DeleteMessages.
+	tombstoneMessageEncoding = "Tombstone"
 )
 
 var (
@@ -114,7 +118,7 @@ func (s *queueV2Store) EnqueueMessage(
 ) (*persistence.InternalEnqueueMessageResponse, error) {
 	// TODO: add concurrency control around this method to avoid things like QueueMessageIDConflict.
 	// TODO: cache the queue in memory to avoid querying the database every time.
-	_, err := s.getQueue(ctx, request.QueueType, request.QueueName)
+	q, err := s.getQueue(ctx, request.QueueType, request.QueueName)
 	if err != nil {
 		return nil, err
 	}
@@ -122,12 +126,7 @@ func (s *queueV2Store) EnqueueMessage(
 	if err != nil {
 		return nil, err
 	}
-	var nextMessageID int64
-	if !ok {
-		nextMessageID = persistence.FirstQueueMessageID
-	} else {
-		nextMessageID = lastMessageID + 1
-	}
+	nextMessageID := persistence.GetNextMessageIDForQueueV2(lastMessageID, ok, q.Metadata.GetPartitions()[0])
 	err = s.tryInsert(ctx, request.QueueType, request.QueueName, request.Blob, nextMessageID)
 	if err != nil {
 		return nil, err
@@ -166,6 +165,7 @@ func (s *queueV2Store) ReadMessages(
 		messages []persistence.QueueV2Message
 		// messageID is the ID of the last message returned by the query.
 		messageID int64
+		scanned   bool
 	)
 
 	for {
@@ -176,6 +176,11 @@ func (s *queueV2Store) ReadMessages(
 		if !iter.Scan(&messageID, &messagePayload, &messageEncoding) {
 			break
 		}
+		scanned = true
+		if messageEncoding == tombstoneMessageEncoding {
+			// See DeleteMessages.
+			continue
+		}
 		encoding, err := enumspb.EncodingTypeFromString(messageEncoding)
 		if err != nil {
 			return nil, serialization.NewUnknownEncodingTypeError(messageEncoding)
@@ -197,7 +202,11 @@ func (s *queueV2Store) ReadMessages(
 		return nil, gocql.ConvertError("QueueV2ReadMessages", err)
 	}
 
-	nextPageToken := persistence.GetNextPageTokenForReadMessages(messages)
+	var nextPageToken []byte
+	if scanned {
+		// Continue after the last row read, which may be a deleted message.
+		nextPageToken = persistence.GetNextPageTokenForLastReadMessageID(messageID)
+	}
 	return &persistence.InternalReadMessagesResponse{
 		Messages:      messages,
 		NextPageToken: nextPageToken,
@@ -302,6 +311,82 @@ func (s *queueV2Store) RangeDeleteMessages(
 	}, nil
 }
 
+func (s *queueV2Store) DeleteMessages(
+	ctx context.Context,
+	request *persistence.InternalDeleteMessagesRequest,
+) (*persistence.InternalDeleteMessagesResponse, error) {
+	if len(request.MessageIDs) == 0 {
+		return &persistence.InternalDeleteMessagesResponse{}, nil
+	}
+	queueType := request.QueueType
+	queueName := request.QueueName
+	q, err := s.getQueue(ctx, queueType, queueName)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := persistence.GetPartitionForQueueV2(queueType, queueName, q.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	maxMessageID, ok, err := s.getMaxMessageID(ctx, queueType, queueName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Nothing in the queue to delete.
+		return &persistence.InternalDeleteMessagesResponse{}, nil
+	}
+	messageIDs := persistence.GetMessageIDsToDelete(request.MessageIDs, persistence.InclusiveMessageRange{
+		MinMessageID: partition.MinMessageId,
+		MaxMessageID: maxMessageID,
+	})
+	if len(messageIDs) == 0 {
+		return &persistence.InternalDeleteMessagesResponse{}, nil
+	}
+	// The ID of the next message is derived from the last message in the queue, and EnqueueMessage doesn't coordinate
+	// with this method through the queue metadata. So, like RangeDeleteMessages, never actually delete the last
+	// message: replace it with a tombstone, which readers skip, so that its ID can't be reused by a concurrent enqueue.
+	// The tombstone is written only if the message still exists, and it is deleted with the range of messages before
+	// the next message.
+	messagesDeleted := int64(len(messageIDs))
+	if lastID := messageIDs[len(messageIDs)-1]; lastID == maxMessageID {
+		messageIDs = messageIDs[:len(messageIDs)-1]
+		applied, err := s.session.Query(
+			TemplateTombstoneMessageQuery,
+			nil,
+			tombstoneMessageEncoding,
+			queueType,
+			queueName,
+			0, // partition
+			lastID,
+		).WithContext(ctx).MapScanCAS(make(map[string]any))
+		if err != nil {
+			return nil, gocql.ConvertError("QueueV2DeleteMessages", err)
+		}
+		if !applied {
+			messagesDeleted--
+		}
+	}
+	if len(messageIDs) > 0 {
+		batch := s.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+		for _, messageID := range messageIDs {
+			batch.Query(
+				TemplateDeleteMessageQuery,
+				queueType,
+				queueName,
+				0, // partition
+				messageID,
+			)
+		}
+		if err := s.session.ExecuteBatch(batch); err != nil {
+			return nil, gocql.ConvertError("QueueV2DeleteMessages", err)
+		}
+	}
+	return &persistence.InternalDeleteMessagesResponse{
+		MessagesDeleted: messagesDeleted,
+	}, nil
+}
+
 func (s *queueV2Store) updateQueue(
 	ctx context.Context,
 	q *Queue,
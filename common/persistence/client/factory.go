@@ -45,6 +45,8 @@ type (
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
+		// NewHistoryEventFeedManager returns a new manager for namespace history event feeds
+		NewHistoryEventFeedManager() (persistence.HistoryEventFeedManager, error)
 	}
 
 	factoryImpl struct {
@@ -242,6 +244,14 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, f.serializer), nil
 }
 
+func (f *factoryImpl) NewHistoryEventFeedManager() (persistence.HistoryEventFeedManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewHistoryEventFeedManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
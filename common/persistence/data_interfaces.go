@@ -1277,6 +1277,8 @@ type (
 		// CreateQueue must return an ErrQueueAlreadyExists if the queue already exists.
 		CreateQueue(ctx context.Context, request *CreateQueueRequest) (*CreateQueueResponse, error)
 		DeleteTasks(ctx context.Context, request *DeleteTasksRequest) (*DeleteTasksResponse, error)
+		// DeleteTasksByID deletes individual tasks by their message ID, leaving the rest of the queue untouched.
+		DeleteTasksByID(ctx context.Context, request *DeleteTasksByIDRequest) (*DeleteTasksResponse, error)
 		ListQueues(ctx context.Context, request *ListQueuesRequest) (*ListQueuesResponse, error)
 	}
 
@@ -1304,6 +1306,8 @@ type (
 		// SourceShardID of the task in its original cluster. Note that tasks may move between clusters, so this shard
 		// id may not be the same as the shard id of the task in the current cluster.
 		SourceShardID int
+		// Failure is the error which caused the task to be moved to a DLQ. It is only used for DLQs.
+		Failure string
 	}
 
 	EnqueueTaskResponse struct {
@@ -1350,6 +1354,11 @@ type (
 		InclusiveMaxMessageMetadata MessageMetadata
 	}
 
+	DeleteTasksByIDRequest struct {
+		QueueKey   QueueKey
+		MessageIDs []int64
+	}
+
 	DeleteTasksResponse struct {
 		MessagesDeleted int64
 	}
@@ -1364,6 +1373,60 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	// HistoryEventFeedManager manages the history event feeds of namespaces. The feed of a namespace is a queue of the
+	// history events of its closed workflow executions, which can be read from any position with a cursor. Records are
+	// delivered at least once: the same history events may be appended in several records, and readers have to
+	// deduplicate them.
+	HistoryEventFeedManager interface {
+		Closeable
+		AppendHistoryEventFeedRecord(
+			ctx context.Context,
+			request *AppendHistoryEventFeedRecordRequest,
+		) (*AppendHistoryEventFeedRecordResponse, error)
+		// ReadHistoryEventFeedRecords returns records in the order they were appended. Reading the feed of a namespace
+		// that has no records returns an empty page. Records deleted by the feed retention after the cursor are
+		// skipped and counted in the response.
+		ReadHistoryEventFeedRecords(
+			ctx context.Context,
+			request *ReadHistoryEventFeedRecordsRequest,
+		) (*ReadHistoryEventFeedRecordsResponse, error)
+	}
+
+	AppendHistoryEventFeedRecordRequest struct {
+		NamespaceID string
+		Record      *persistencespb.HistoryEventFeedRecord
+		// Retention is how long records are retained in the feed after their publish time, older records are deleted
+		// whether they were read or not. Zero retains all records.
+		Retention time.Duration
+	}
+
+	AppendHistoryEventFeedRecordResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadHistoryEventFeedRecordsRequest struct {
+		NamespaceID string
+		PageSize    int
+		// NextPageToken is either the next page token of a previous read or the cursor of a record, in which case
+		// the feed is read from the record after it.
+		NextPageToken []byte
+	}
+
+	ReadHistoryEventFeedRecordsResponse struct {
+		Records       []HistoryEventFeedMessage
+		NextPageToken []byte
+		// TrimmedRecords is the number of records between the request token and the first record returned which were
+		// deleted by the feed retention.
+		TrimmedRecords int64
+	}
+
+	HistoryEventFeedMessage struct {
+		MessageMetadata MessageMetadata
+		Record          *persistencespb.HistoryEventFeedRecord
+		// Cursor to read the feed from the record after this one.
+		Cursor []byte
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
@@ -1401,6 +1401,21 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) DeleteTasks(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).DeleteTasks), ctx, request)
 }
 
+// DeleteTasksByID mocks base method.
+func (m *MockHistoryTaskQueueManager) DeleteTasksByID(ctx context.Context, request *DeleteTasksByIDRequest) (*DeleteTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTasksByID", ctx, request)
+	ret0, _ := ret[0].(*DeleteTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTasksByID indicates an expected call of DeleteTasksByID.
+func (mr *MockHistoryTaskQueueManagerMockRecorder) DeleteTasksByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasksByID", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).DeleteTasksByID), ctx, request)
+}
+
 // EnqueueTask mocks base method.
 func (m *MockHistoryTaskQueueManager) EnqueueTask(ctx context.Context, request *EnqueueTaskRequest) (*EnqueueTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -1460,3 +1475,69 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockHistoryEventFeedManager is a mock of HistoryEventFeedManager interface.
+type MockHistoryEventFeedManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryEventFeedManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryEventFeedManagerMockRecorder is the mock recorder for MockHistoryEventFeedManager.
+type MockHistoryEventFeedManagerMockRecorder struct {
+	mock *MockHistoryEventFeedManager
+}
+
+// NewMockHistoryEventFeedManager creates a new mock instance.
+func NewMockHistoryEventFeedManager(ctrl *gomock.Controller) *MockHistoryEventFeedManager {
+	mock := &MockHistoryEventFeedManager{ctrl: ctrl}
+	mock.recorder = &MockHistoryEventFeedManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryEventFeedManager) EXPECT() *MockHistoryEventFeedManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendHistoryEventFeedRecord mocks base method.
+func (m *MockHistoryEventFeedManager) AppendHistoryEventFeedRecord(ctx context.Context, request *AppendHistoryEventFeedRecordRequest) (*AppendHistoryEventFeedRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryEventFeedRecord", ctx, request)
+	ret0, _ := ret[0].(*AppendHistoryEventFeedRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryEventFeedRecord indicates an expected call of AppendHistoryEventFeedRecord.
+func (mr *MockHistoryEventFeedManagerMockRecorder) AppendHistoryEventFeedRecord(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEventFeedRecord", reflect.TypeOf((*MockHistoryEventFeedManager)(nil).AppendHistoryEventFeedRecord), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockHistoryEventFeedManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockHistoryEventFeedManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHistoryEventFeedManager)(nil).Close))
+}
+
+// ReadHistoryEventFeedRecords mocks base method.
+func (m *MockHistoryEventFeedManager) ReadHistoryEventFeedRecords(ctx context.Context, request *ReadHistoryEventFeedRecordsRequest) (*ReadHistoryEventFeedRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryEventFeedRecords", ctx, request)
+	ret0, _ := ret[0].(*ReadHistoryEventFeedRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryEventFeedRecords indicates an expected call of ReadHistoryEventFeedRecords.
+func (mr *MockHistoryEventFeedManagerMockRecorder) ReadHistoryEventFeedRecords(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryEventFeedRecords", reflect.TypeOf((*MockHistoryEventFeedManager)(nil).ReadHistoryEventFeedRecords), ctx, request)
+}
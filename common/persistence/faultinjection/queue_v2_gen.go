@@ -40,6 +40,15 @@ func (d faultInjectionQueueV2) CreateQueue(ctx context.Context, request *_source
 	return
 }
 
+// DeleteMessages wraps QueueV2.DeleteMessages.
+func (d faultInjectionQueueV2) DeleteMessages(ctx context.Context, request *_sourcePersistence.InternalDeleteMessagesRequest) (ip1 *_sourcePersistence.InternalDeleteMessagesResponse, err error) {
+	err = d.generator.generate("DeleteMessages", request).inject(func() error {
+		ip1, err = d.QueueV2.DeleteMessages(ctx, request)
+		return err
+	})
+	return
+}
+
 // EnqueueMessage wraps QueueV2.EnqueueMessage.
 func (d faultInjectionQueueV2) EnqueueMessage(ctx context.Context, request *_sourcePersistence.InternalEnqueueMessageRequest) (ip1 *_sourcePersistence.InternalEnqueueMessageResponse, err error) {
 	err = d.generator.generate("EnqueueMessage", request).inject(func() error {
@@ -0,0 +1,193 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// historyEventFeedTrimInterval is the number of records appended to a feed between two deletions of the records
+	// beyond its retention. It is also the page size used to find these records.
+	historyEventFeedTrimInterval = 100
+)
+
+var (
+	ErrHistoryEventFeedRecordIsNil = errors.New("history event feed record is nil")
+)
+
+type (
+	HistoryEventFeedManagerImpl struct {
+		queue QueueV2
+
+		// Feeds are written by all history shards of a host. Serialize writes to the same feed to avoid conflicts in
+		// the persistence layer.
+		locks        sync.Map // namespace ID -> *sync.Mutex
+		createdFeeds sync.Map // namespace ID -> struct{}
+	}
+)
+
+var _ HistoryEventFeedManager = (*HistoryEventFeedManagerImpl)(nil)
+
+func NewHistoryEventFeedManager(queue QueueV2) *HistoryEventFeedManagerImpl {
+	return &HistoryEventFeedManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *HistoryEventFeedManagerImpl) AppendHistoryEventFeedRecord(
+	ctx context.Context,
+	request *AppendHistoryEventFeedRecordRequest,
+) (*AppendHistoryEventFeedRecordResponse, error) {
+	if request.Record == nil {
+		return nil, ErrHistoryEventFeedRecordIsNil
+	}
+	blob, err := serialization.Encode(request.Record)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.createFeed(ctx, request.NamespaceID); err != nil {
+		return nil, err
+	}
+
+	lock, _ := m.locks.LoadOrStore(request.NamespaceID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	resp, err := m.queue.EnqueueMessage(ctx, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeHistoryEventFeed,
+		QueueName: request.NamespaceID,
+		Blob:      blob,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Retention > 0 && resp.Metadata.ID%historyEventFeedTrimInterval == 0 {
+		// Retention is best effort, the next trim deletes the records this one failed to delete.
+		_ = m.trimFeed(ctx, request.NamespaceID, request.Record.GetPublishTime().AsTime().Add(-request.Retention))
+	}
+	return &AppendHistoryEventFeedRecordResponse{
+		Metadata: resp.Metadata,
+	}, nil
+}
+
+// trimFeed deletes the records of a feed published before the given time. Records are deleted by age only, so that a
+// record is never deleted before it had the retention to be read, and readers which fall further behind are told how
+// many records they missed instead.
+func (m *HistoryEventFeedManagerImpl) trimFeed(ctx context.Context, namespaceID string, expiration time.Time) error {
+	var lastExpired *MessageMetadata
+	var nextPageToken []byte
+	for {
+		resp, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+			QueueType:     QueueTypeHistoryEventFeed,
+			QueueName:     namespaceID,
+			PageSize:      historyEventFeedTrimInterval,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		expired := true
+		for _, message := range resp.Messages {
+			record := &persistencespb.HistoryEventFeedRecord{}
+			if err := serialization.Decode(message.Data, record); err != nil {
+				return err
+			}
+			// Records are appended in publish order, the first record which isn't expired ends the expired records.
+			if !record.GetPublishTime().AsTime().Before(expiration) {
+				expired = false
+				break
+			}
+			lastExpired = &message.MetaData
+		}
+		if !expired || len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	if lastExpired == nil {
+		return nil
+	}
+	_, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeHistoryEventFeed,
+		QueueName:                   namespaceID,
+		InclusiveMaxMessageMetadata: *lastExpired,
+	})
+	return err
+}
+
+func (m *HistoryEventFeedManagerImpl) ReadHistoryEventFeedRecords(
+	ctx context.Context,
+	request *ReadHistoryEventFeedRecordsRequest,
+) (*ReadHistoryEventFeedRecordsResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrNonPositiveReadQueueMessagesPageSize, request.PageSize)
+	}
+	resp, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeHistoryEventFeed,
+		QueueName:     request.NamespaceID,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			// Nothing was published to the feed yet.
+			return &ReadHistoryEventFeedRecordsResponse{}, nil
+		}
+		return nil, err
+	}
+
+	var trimmedRecords int64
+	if len(request.NextPageToken) > 0 && len(resp.Messages) > 0 {
+		// Message IDs are consecutive, the records between the token and the first record read were trimmed.
+		minMessageID, err := GetMinMessageIDToReadForQueueV2(QueueTypeHistoryEventFeed, request.NamespaceID, request.NextPageToken, nil)
+		if err != nil {
+			return nil, err
+		}
+		trimmedRecords = max(resp.Messages[0].MetaData.ID-minMessageID, 0)
+	}
+
+	records := make([]HistoryEventFeedMessage, len(resp.Messages))
+	for i, message := range resp.Messages {
+		record := &persistencespb.HistoryEventFeedRecord{}
+		if err := serialization.Decode(message.Data, record); err != nil {
+			return nil, err
+		}
+		records[i] = HistoryEventFeedMessage{
+			MessageMetadata: message.MetaData,
+			Record:          record,
+			Cursor:          GetNextPageTokenForReadMessages(resp.Messages[i : i+1]),
+		}
+	}
+	return &ReadHistoryEventFeedRecordsResponse{
+		Records:        records,
+		NextPageToken:  resp.NextPageToken,
+		TrimmedRecords: trimmedRecords,
+	}, nil
+}
+
+func (m *HistoryEventFeedManagerImpl) Close() {
+}
+
+func (m *HistoryEventFeedManagerImpl) createFeed(ctx context.Context, namespaceID string) error {
+	if _, ok := m.createdFeeds.Load(namespaceID); ok {
+		return nil
+	}
+	_, err := m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: QueueTypeHistoryEventFeed,
+		QueueName: namespaceID,
+	})
+	if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+		return err
+	}
+	m.createdFeeds.Store(namespaceID, struct{}{})
+	return nil
+}
@@ -75,6 +75,7 @@ func (m *HistoryTaskQueueManagerImpl) EnqueueTask(
 	task := persistencespb.HistoryTask{
 		ShardId: int32(request.SourceShardID),
 		Blob:    blob,
+		Failure: request.Failure,
 	}
 	taskBytes, _ := task.Marshal()
 	blob = &commonpb.DataBlob{
@@ -205,6 +206,21 @@ func (m *HistoryTaskQueueManagerImpl) DeleteTasks(
 	return &DeleteTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
 }
 
+func (m *HistoryTaskQueueManagerImpl) DeleteTasksByID(
+	ctx context.Context,
+	request *DeleteTasksByIDRequest,
+) (*DeleteTasksResponse, error) {
+	resp, err := m.queue.DeleteMessages(ctx, &InternalDeleteMessagesRequest{
+		QueueType:  request.QueueKey.QueueType,
+		QueueName:  request.QueueKey.GetQueueName(),
+		MessageIDs: request.MessageIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
+}
+
 func (m HistoryTaskQueueManagerImpl) ListQueues(
 	ctx context.Context,
 	request *ListQueuesRequest,
@@ -1637,6 +1637,21 @@ func (mr *MockQueueV2MockRecorder) CreateQueue(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueue", reflect.TypeOf((*MockQueueV2)(nil).CreateQueue), ctx, request)
 }
 
+// DeleteMessages mocks base method.
+func (m *MockQueueV2) DeleteMessages(ctx context.Context, request *persistence.InternalDeleteMessagesRequest) (*persistence.InternalDeleteMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessages", ctx, request)
+	ret0, _ := ret[0].(*persistence.InternalDeleteMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMessages indicates an expected call of DeleteMessages.
+func (mr *MockQueueV2MockRecorder) DeleteMessages(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessages", reflect.TypeOf((*MockQueueV2)(nil).DeleteMessages), ctx, request)
+}
+
 // EnqueueMessage mocks base method.
 func (m *MockQueueV2) EnqueueMessage(ctx context.Context, request *persistence.InternalEnqueueMessageRequest) (*persistence.InternalEnqueueMessageResponse, error) {
 	m.ctrl.T.Helper()
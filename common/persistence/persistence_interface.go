@@ -828,6 +828,13 @@ type (
 			ctx context.Context,
 			request *InternalRangeDeleteMessagesRequest,
 		) (*InternalRangeDeleteMessagesResponse, error)
+		// DeleteMessages deletes individual messages by ID. IDs which are not in the queue are ignored. Unlike
+		// RangeDeleteMessages, this may delete the last message in the queue, so the queue metadata records the
+		// highest deleted ID to make sure that it is not reused.
+		DeleteMessages(
+			ctx context.Context,
+			request *InternalDeleteMessagesRequest,
+		) (*InternalDeleteMessagesResponse, error)
 		ListQueues(
 			ctx context.Context,
 			request *InternalListQueuesRequest,
@@ -887,6 +894,17 @@ type (
 		MessagesDeleted int64
 	}
 
+	// InternalDeleteMessagesRequest deletes the messages with the given IDs
+	InternalDeleteMessagesRequest struct {
+		QueueType  QueueV2Type
+		QueueName  string
+		MessageIDs []int64
+	}
+
+	InternalDeleteMessagesResponse struct {
+		MessagesDeleted int64
+	}
+
 	InternalListQueuesRequest struct {
 		QueueType     QueueV2Type
 		PageSize      int
@@ -8,6 +8,8 @@ const (
 	QueueTypeUnspecified   QueueV2Type = 0
 	QueueTypeHistoryNormal QueueV2Type = 1
 	QueueTypeHistoryDLQ    QueueV2Type = 2
+	// QueueTypeHistoryEventFeed is the type of the history event feeds of namespaces.
+	QueueTypeHistoryEventFeed QueueV2Type = 3
 
 	// FirstQueueMessageID is the ID of the first message written to a queue partition.
 	FirstQueueMessageID = 0
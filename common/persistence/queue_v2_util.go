@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"fmt"
+	"slices"
 
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -20,7 +21,11 @@ func GetNextPageTokenForReadMessages(result []QueueV2Message) []byte {
 	if len(result) == 0 {
 		return nil
 	}
-	lastReadMessageID := result[len(result)-1].MetaData.ID
+	return GetNextPageTokenForLastReadMessageID(result[len(result)-1].MetaData.ID)
+}
+
+// GetNextPageTokenForLastReadMessageID returns the page token to continue reading a queue after the given message.
+func GetNextPageTokenForLastReadMessageID(lastReadMessageID int64) []byte {
 	token := &persistencespb.ReadQueueMessagesNextPageToken{
 		LastReadMessageId: lastReadMessageID,
 	}
@@ -150,3 +155,26 @@ func GetDeleteRange(request DeleteRequest) (DeleteRange, bool) {
 		MessagesToDelete: min(request.LastIDToDeleteInclusive, request.ExistingMessageRange.MaxMessageID) - request.ExistingMessageRange.MinMessageID + 1,
 	}, true
 }
+
+// GetNextMessageIDForQueueV2 returns the ID to give to the next message enqueued into the partition, given the ID of
+// the last message in the partition, if there is one.
+func GetNextMessageIDForQueueV2(lastMessageID int64, ok bool, partition *persistencespb.QueuePartition) int64 {
+	nextMessageID := int64(FirstQueueMessageID)
+	if ok {
+		nextMessageID = lastMessageID + 1
+	}
+	return max(nextMessageID, partition.GetMinNextMessageId())
+}
+
+// GetMessageIDsToDelete returns the IDs in messageIDs which are within the existing message range, in increasing order
+// and without duplicates.
+func GetMessageIDsToDelete(messageIDs []int64, existing InclusiveMessageRange) []int64 {
+	var result []int64
+	for _, id := range messageIDs {
+		if id >= existing.MinMessageID && id <= existing.MaxMessageID {
+			result = append(result, id)
+		}
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
@@ -34,6 +34,8 @@ func serializeTransferTask(
 		transferTask = transferCloseTaskToProto(task)
 	case *tasks.ResetWorkflowTask:
 		transferTask = transferResetTaskToProto(task)
+	case *tasks.PublishHistoryEventFeedTask:
+		transferTask = transferPublishHistoryEventFeedTaskToProto(task)
 	case *tasks.DeleteExecutionTask:
 		transferTask = transferDeleteExecutionTaskToProto(task)
 	case *tasks.ChasmTask:
@@ -82,6 +84,8 @@ func deserializeTransferTask(
 		task = transferCloseTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_TRANSFER_RESET_WORKFLOW:
 		task = transferResetTaskFromProto(transferTask)
+	case enumsspb.TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED:
+		task = transferPublishHistoryEventFeedTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION:
 		task = transferDeleteExecutionTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_CHASM:
@@ -690,6 +694,35 @@ func transferResetTaskFromProto(
 	}
 }
 
+func transferPublishHistoryEventFeedTaskToProto(
+	publishTask *tasks.PublishHistoryEventFeedTask,
+) *persistencespb.TransferTaskInfo {
+	return &persistencespb.TransferTaskInfo{
+		NamespaceId:    publishTask.NamespaceID,
+		WorkflowId:     publishTask.WorkflowID,
+		RunId:          publishTask.RunID,
+		TaskType:       enumsspb.TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED,
+		Version:        publishTask.Version,
+		TaskId:         publishTask.TaskID,
+		VisibilityTime: timestamppb.New(publishTask.VisibilityTimestamp),
+	}
+}
+
+func transferPublishHistoryEventFeedTaskFromProto(
+	publishTask *persistencespb.TransferTaskInfo,
+) *tasks.PublishHistoryEventFeedTask {
+	return &tasks.PublishHistoryEventFeedTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			publishTask.NamespaceId,
+			publishTask.WorkflowId,
+			publishTask.RunId,
+		),
+		VisibilityTimestamp: publishTask.VisibilityTime.AsTime(),
+		TaskID:              publishTask.TaskId,
+		Version:             publishTask.Version,
+	}
+}
+
 func transferDeleteExecutionTaskToProto(
 	deleteExecutionTask *tasks.DeleteExecutionTask,
 ) *persistencespb.TransferTaskInfo {
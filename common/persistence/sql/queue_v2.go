@@ -47,10 +47,6 @@ func (q *queueV2) EnqueueMessage(
 	request *persistence.InternalEnqueueMessageRequest,
 ) (*persistence.InternalEnqueueMessageResponse, error) {
 
-	_, err := q.getQueueMetadata(ctx, q.DB, request.QueueType, request.QueueName)
-	if err != nil {
-		return nil, err
-	}
 	tx, err := q.DB.BeginTx(ctx)
 	if err != nil {
 		return nil, serviceerror.NewUnavailablef(
@@ -60,6 +56,16 @@ func (q *queueV2) EnqueueMessage(
 			err,
 		)
 	}
+	// The metadata is read within the transaction so that a concurrent DeleteMessages can't remove the last message
+	// between reading min_next_message_id and the last message ID.
+	qm, err := q.getQueueMetadata(ctx, tx, request.QueueType, request.QueueName)
+	if err != nil {
+		rollBackErr := tx.Rollback()
+		if rollBackErr != nil {
+			q.logger.Error("transaction rollback error", tag.Error(rollBackErr))
+		}
+		return nil, err
+	}
 	lastMessageID, ok, err := q.getMaxMessageID(ctx, request.QueueType, request.QueueName, tx)
 	if err != nil {
 		rollBackErr := tx.Rollback()
@@ -73,10 +79,7 @@ func (q *queueV2) EnqueueMessage(
 			err,
 		)
 	}
-	nextMessageID := int64(persistence.FirstQueueMessageID)
-	if ok {
-		nextMessageID = lastMessageID + 1
-	}
+	nextMessageID := persistence.GetNextMessageIDForQueueV2(lastMessageID, ok, qm.GetPartitions()[defaultPartition])
 	_, err = tx.InsertIntoQueueV2Messages(ctx, []sqlplugin.QueueV2MessageRow{
 		newQueueV2Row(request.QueueType, request.QueueName, nextMessageID, request.Blob),
 	})
@@ -315,6 +318,103 @@ func (q *queueV2) RangeDeleteMessages(
 	return resp, nil
 }
 
+func (q *queueV2) DeleteMessages(
+	ctx context.Context,
+	request *persistence.InternalDeleteMessagesRequest,
+) (*persistence.InternalDeleteMessagesResponse, error) {
+	resp := &persistence.InternalDeleteMessagesResponse{}
+	if len(request.MessageIDs) == 0 {
+		return resp, nil
+	}
+	err := q.txExecute(ctx, "DeleteMessages", func(tx sqlplugin.Tx) error {
+		qm, err := q.getQueueMetadata(ctx, tx, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		partition, err := persistence.GetPartitionForQueueV2(request.QueueType, request.QueueName, qm)
+		if err != nil {
+			return serviceerror.NewUnavailablef(
+				"DeleteMessages failed for queue with type: %v and name: %v. GetPartitionForQueueV2 operation failed. Error: %v",
+				request.QueueType,
+				request.QueueName,
+				err,
+			)
+		}
+		maxMessageID, ok, err := q.getMaxMessageID(ctx, request.QueueType, request.QueueName, tx)
+		if err != nil {
+			return serviceerror.NewUnavailablef(
+				"DeleteMessages failed for queue with type: %v and name: %v. failed to get MaxMessageID. Error: %v",
+				request.QueueType,
+				request.QueueName,
+				err,
+			)
+		}
+		if !ok {
+			return nil
+		}
+		messageIDs := persistence.GetMessageIDsToDelete(request.MessageIDs, persistence.InclusiveMessageRange{
+			MinMessageID: partition.MinMessageId,
+			MaxMessageID: maxMessageID,
+		})
+		if len(messageIDs) == 0 {
+			return nil
+		}
+		for _, messageID := range messageIDs {
+			result, err := tx.RangeDeleteFromQueueV2Messages(ctx, sqlplugin.QueueV2MessagesFilter{
+				QueueType:    request.QueueType,
+				QueueName:    request.QueueName,
+				Partition:    defaultPartition,
+				MinMessageID: messageID,
+				MaxMessageID: messageID,
+			})
+			if err != nil {
+				return serviceerror.NewUnavailablef(
+					"DeleteMessages failed for queue with type: %v and name: %v. RangeDeleteFromQueueV2Messages operation failed. Error: %v",
+					request.QueueType,
+					request.QueueName,
+					err,
+				)
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return serviceerror.NewUnavailablef(
+					"DeleteMessages failed for queue with type: %v and name: %v. RowsAffected operation failed. Error: %v",
+					request.QueueType,
+					request.QueueName,
+					err,
+				)
+			}
+			resp.MessagesDeleted += rowsAffected
+		}
+		lastDeletedID := messageIDs[len(messageIDs)-1]
+		if lastDeletedID < partition.MinNextMessageId {
+			return nil
+		}
+		partition.MinNextMessageId = lastDeletedID + 1
+		bytes, _ := qm.Marshal()
+		row := sqlplugin.QueueV2MetadataRow{
+			QueueType:        request.QueueType,
+			QueueName:        request.QueueName,
+			MetadataPayload:  bytes,
+			MetadataEncoding: enumspb.ENCODING_TYPE_PROTO3.String(),
+		}
+		_, err = tx.UpdateQueueV2Metadata(ctx, &row)
+		if err != nil {
+			return serviceerror.NewUnavailablef(
+				"DeleteMessages failed for queue with type: %v and name: %v. UpdateQueueV2Metadata operation failed. Error: %v",
+				request.QueueType,
+				request.QueueName,
+				err,
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (q *queueV2) getQueueMetadata(
 	ctx context.Context,
 	tc sqlplugin.TableCRUD,
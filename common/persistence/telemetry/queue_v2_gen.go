@@ -82,6 +82,48 @@ func (d telemetryQueueV2) CreateQueue(ctx context.Context, request *_sourcePersi
 	return
 }
 
+// DeleteMessages wraps QueueV2.DeleteMessages.
+func (d telemetryQueueV2) DeleteMessages(ctx context.Context, request *_sourcePersistence.InternalDeleteMessagesRequest) (ip1 *_sourcePersistence.InternalDeleteMessagesResponse, err error) {
+	ctx, span := d.tracer.Start(
+		ctx,
+		"persistence.QueueV2/DeleteMessages",
+		trace.WithAttributes(
+			attribute.Key("persistence.store").String("QueueV2"),
+			attribute.Key("persistence.method").String("DeleteMessages"),
+		))
+	defer span.End()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		span.SetAttributes(attribute.String("deadline", deadline.Format(time.RFC3339Nano)))
+		span.SetAttributes(attribute.String("timeout", time.Until(deadline).String()))
+	}
+
+	ip1, err = d.QueueV2.DeleteMessages(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	if d.debugMode {
+
+		requestPayload, err := json.MarshalIndent(request, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.InternalDeleteMessagesRequest for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.request.payload").String(string(requestPayload)))
+		}
+
+		responsePayload, err := json.MarshalIndent(ip1, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.InternalDeleteMessagesResponse for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.response.payload").String(string(responsePayload)))
+		}
+
+	}
+
+	return
+}
+
 // EnqueueMessage wraps QueueV2.EnqueueMessage.
 func (d telemetryQueueV2) EnqueueMessage(ctx context.Context, request *_sourcePersistence.InternalEnqueueMessageRequest) (ip1 *_sourcePersistence.InternalEnqueueMessageResponse, err error) {
 	ctx, span := d.tracer.Start(
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunHistoryEventFeedManagerTestSuite runs all tests for the history event feed manager against a given queue.
+func RunHistoryEventFeedManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewHistoryEventFeedManager(queue)
+	t.Run("ReadFromCursor", func(t *testing.T) {
+		t.Parallel()
+		testHistoryEventFeedManagerReadFromCursor(t, manager)
+	})
+	t.Run("ReadEmptyFeed", func(t *testing.T) {
+		t.Parallel()
+		testHistoryEventFeedManagerReadEmptyFeed(t, manager)
+	})
+	t.Run("Retention", func(t *testing.T) {
+		t.Parallel()
+		testHistoryEventFeedManagerRetention(t, manager)
+	})
+}
+
+func appendHistoryEventFeedRecords(
+	t *testing.T,
+	manager persistence.HistoryEventFeedManager,
+	namespaceID string,
+	count int,
+	publishTime func(i int) time.Time,
+	retention time.Duration,
+) {
+	for i := range count {
+		_, err := manager.AppendHistoryEventFeedRecord(context.Background(), &persistence.AppendHistoryEventFeedRecordRequest{
+			NamespaceID: namespaceID,
+			Record: &persistencespb.HistoryEventFeedRecord{
+				NamespaceId: namespaceID,
+				WorkflowId:  fmt.Sprintf("workflow-%d", i),
+				PublishTime: timestamppb.New(publishTime(i)),
+			},
+			Retention: retention,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func publishedNow(int) time.Time {
+	return time.Now()
+}
+
+func testHistoryEventFeedManagerReadFromCursor(t *testing.T, manager persistence.HistoryEventFeedManager) {
+	namespaceID := uuid.NewString()
+	appendHistoryEventFeedRecords(t, manager, namespaceID, 3, publishedNow, 0)
+
+	resp, err := manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 2)
+	require.Equal(t, "workflow-0", resp.Records[0].Record.GetWorkflowId())
+	require.Equal(t, "workflow-1", resp.Records[1].Record.GetWorkflowId())
+
+	// Resume after the first record.
+	resp, err = manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      10,
+		NextPageToken: resp.Records[0].Cursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 2)
+	require.Equal(t, "workflow-1", resp.Records[0].Record.GetWorkflowId())
+	require.Equal(t, "workflow-2", resp.Records[1].Record.GetWorkflowId())
+}
+
+func testHistoryEventFeedManagerReadEmptyFeed(t *testing.T, manager persistence.HistoryEventFeedManager) {
+	resp, err := manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID: uuid.NewString(),
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Records)
+	require.Empty(t, resp.NextPageToken)
+}
+
+func testHistoryEventFeedManagerRetention(t *testing.T, manager persistence.HistoryEventFeedManager) {
+	namespaceID := uuid.NewString()
+	// Records are trimmed every 100 records, by publish time only: the first 30 records are beyond the retention of
+	// the last one, the count of records doesn't matter.
+	start := time.Now().Add(-time.Hour)
+	appendHistoryEventFeedRecords(t, manager, namespaceID, 101, func(i int) time.Time {
+		return start.Add(time.Duration(i) * time.Minute)
+	}, 70*time.Minute)
+
+	resp, err := manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    200,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 71)
+	require.Equal(t, "workflow-30", resp.Records[0].Record.GetWorkflowId())
+	require.Zero(t, resp.TrimmedRecords)
+
+	// A reader whose cursor is behind the retention is told how many records it missed.
+	resp, err = manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      10,
+		NextPageToken: persistence.GetNextPageTokenForLastReadMessageID(persistence.FirstQueueMessageID + 9),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "workflow-30", resp.Records[0].Record.GetWorkflowId())
+	require.Equal(t, int64(20), resp.TrimmedRecords)
+
+	// Reading from a record that was not trimmed reports no gap.
+	resp, err = manager.ReadHistoryEventFeedRecords(context.Background(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      10,
+		NextPageToken: resp.Records[0].Cursor,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "workflow-31", resp.Records[0].Record.GetWorkflowId())
+	require.Zero(t, resp.TrimmedRecords)
+}
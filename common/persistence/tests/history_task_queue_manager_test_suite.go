@@ -72,6 +72,16 @@ func (q faultyQueue) RangeDeleteMessages(
 	return q.base.RangeDeleteMessages(ctx, req)
 }
 
+func (q faultyQueue) DeleteMessages(
+	ctx context.Context,
+	req *persistence.InternalDeleteMessagesRequest,
+) (*persistence.InternalDeleteMessagesResponse, error) {
+	if q.rangeDeleteMessagesErr != nil {
+		return nil, q.rangeDeleteMessagesErr
+	}
+	return q.base.DeleteMessages(ctx, req)
+}
+
 func (q faultyQueue) ListQueues(
 	ctx context.Context,
 	req *persistence.InternalListQueuesRequest,
@@ -118,6 +128,10 @@ func RunHistoryTaskQueueManagerTestSuite(t *testing.T, queue persistence.QueueV2
 		t.Parallel()
 		getdlqtaskstest.TestInvoke(t, historyTaskQueueManager)
 	})
+	t.Run("GetDLQTasksWithFilter", func(t *testing.T) {
+		t.Parallel()
+		getdlqtaskstest.TestInvokeWithFilter(t, historyTaskQueueManager)
+	})
 	t.Run("DeleteDLQTasks", func(t *testing.T) {
 		t.Parallel()
 		deletedlqtaskstest.TestInvoke(t, historyTaskQueueManager)
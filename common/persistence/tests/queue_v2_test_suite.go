@@ -126,10 +126,18 @@ func RunQueueV2TestSuite(t *testing.T, q persistence.QueueV2) {
 		t.Parallel()
 		testRangeDeleteMessages(ctx, t, q)
 	})
+	t.Run("TestDeleteMessages", func(t *testing.T) {
+		t.Parallel()
+		testDeleteMessages(ctx, t, q)
+	})
 	t.Run("HistoryTaskQueueManagerImpl", func(t *testing.T) {
 		t.Parallel()
 		RunHistoryTaskQueueManagerTestSuite(t, q)
 	})
+	t.Run("HistoryEventFeedManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunHistoryEventFeedManagerTestSuite(t, q)
+	})
 }
 
 func testHappyPath(
@@ -374,6 +382,146 @@ func testRangeDeleteMessages(ctx context.Context, t *testing.T, queue persistenc
 	})
 }
 
+func testDeleteMessages(ctx context.Context, t *testing.T, queue persistence.QueueV2) {
+	t.Run("DeleteInTheMiddle", func(t *testing.T) {
+		t.Parallel()
+
+		queueType := persistence.QueueTypeHistoryNormal
+		queueName := "test-queue-" + t.Name()
+		_, err := queue.CreateQueue(ctx, &persistence.InternalCreateQueueRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+		})
+		require.NoError(t, err)
+		for range 3 {
+			_, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+			require.NoError(t, err)
+		}
+		resp, err := queue.DeleteMessages(ctx, &persistence.InternalDeleteMessagesRequest{
+			QueueType:  queueType,
+			QueueName:  queueName,
+			MessageIDs: []int64{persistence.FirstQueueMessageID + 1, persistence.FirstQueueMessageID + 10},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.MessagesDeleted)
+		response, err := queue.ReadMessages(ctx, &persistence.InternalReadMessagesRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+			PageSize:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Messages, 2)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID), response.Messages[0].MetaData.ID)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+2), response.Messages[1].MetaData.ID)
+	})
+
+	t.Run("DeleteLastAndReEnqueue", func(t *testing.T) {
+		t.Parallel()
+
+		queueType := persistence.QueueTypeHistoryNormal
+		queueName := "test-queue-" + t.Name()
+		_, err := queue.CreateQueue(ctx, &persistence.InternalCreateQueueRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+		})
+		require.NoError(t, err)
+		for range 2 {
+			_, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+			require.NoError(t, err)
+		}
+		resp, err := queue.DeleteMessages(ctx, &persistence.InternalDeleteMessagesRequest{
+			QueueType:  queueType,
+			QueueName:  queueName,
+			MessageIDs: []int64{persistence.FirstQueueMessageID, persistence.FirstQueueMessageID + 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.MessagesDeleted)
+		msg, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+		require.NoError(t, err)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+2), msg.Metadata.ID, "The ID of a deleted last "+
+			"message should not be reused")
+	})
+
+	t.Run("DeleteLastAndReadPastIt", func(t *testing.T) {
+		t.Parallel()
+
+		queueType := persistence.QueueTypeHistoryNormal
+		queueName := "test-queue-" + t.Name()
+		_, err := queue.CreateQueue(ctx, &persistence.InternalCreateQueueRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+		})
+		require.NoError(t, err)
+		for range 2 {
+			_, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+			require.NoError(t, err)
+		}
+		resp, err := queue.DeleteMessages(ctx, &persistence.InternalDeleteMessagesRequest{
+			QueueType:  queueType,
+			QueueName:  queueName,
+			MessageIDs: []int64{persistence.FirstQueueMessageID + 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.MessagesDeleted)
+		_, err = persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+		require.NoError(t, err)
+
+		var ids []int64
+		var nextPageToken []byte
+		for {
+			response, err := queue.ReadMessages(ctx, &persistence.InternalReadMessagesRequest{
+				QueueType:     queueType,
+				QueueName:     queueName,
+				PageSize:      1,
+				NextPageToken: nextPageToken,
+			})
+			require.NoError(t, err)
+			for _, message := range response.Messages {
+				ids = append(ids, message.MetaData.ID)
+			}
+			nextPageToken = response.NextPageToken
+			if len(nextPageToken) == 0 {
+				break
+			}
+		}
+		assert.Equal(t, []int64{persistence.FirstQueueMessageID, persistence.FirstQueueMessageID + 2}, ids)
+	})
+
+	t.Run("DeleteBeforeMinMessageID", func(t *testing.T) {
+		t.Parallel()
+
+		queueType := persistence.QueueTypeHistoryNormal
+		queueName := "test-queue-" + t.Name()
+		_, err := queue.CreateQueue(ctx, &persistence.InternalCreateQueueRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+		})
+		require.NoError(t, err)
+		for range 2 {
+			_, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+			require.NoError(t, err)
+		}
+		_, err = queue.RangeDeleteMessages(ctx, &persistence.InternalRangeDeleteMessagesRequest{
+			QueueType: queueType,
+			QueueName: queueName,
+			InclusiveMaxMessageMetadata: persistence.MessageMetadata{
+				ID: persistence.FirstQueueMessageID + 1,
+			},
+		})
+		require.NoError(t, err)
+		resp, err := queue.DeleteMessages(ctx, &persistence.InternalDeleteMessagesRequest{
+			QueueType:  queueType,
+			QueueName:  queueName,
+			MessageIDs: []int64{persistence.FirstQueueMessageID + 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), resp.MessagesDeleted)
+		msg, err := persistencetest.EnqueueMessage(ctx, queue, queueType, queueName)
+		require.NoError(t, err)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+2), msg.Metadata.ID)
+	})
+}
+
 func RunQueueV2TestSuiteForSQL(t *testing.T, factory *sql.Factory) {
 	t.Run("Generic", func(t *testing.T) {
 		t.Parallel()
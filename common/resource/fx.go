@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.uber.org/fx"
@@ -94,6 +95,7 @@ var Module = fx.Options(
 		func(p namespace.Registry) pingable.Pingable { return p },
 		fx.ResultTags(`group:"deadlockDetectorRoots"`),
 	)),
+	fx.Provide(HistoryShardPoolsProvider),
 	fx.Provide(ClientFactoryProvider),
 	fx.Provide(ClientBeanProvider),
 	fx.Provide(FrontendClientProvider),
@@ -248,6 +250,14 @@ func NamespaceRegistryProvider(params NamespaceRegistryParams) namespace.Registr
 	)
 }
 
+func HistoryShardPoolsProvider(
+	dynamicCollection *dynamicconfig.Collection,
+	namespaceRegistry namespace.Registry,
+	persistenceConfig *config.Persistence,
+) *shardpool.Resolver {
+	return shardpool.NewResolver(dynamicCollection, namespaceRegistry, persistenceConfig.NumHistoryShards)
+}
+
 func ClientFactoryProvider(
 	factoryProvider client.FactoryProvider,
 	rpcFactory common.RPCFactory,
@@ -255,7 +265,7 @@ func ClientFactoryProvider(
 	metricsHandler metrics.Handler,
 	dynamicCollection *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	persistenceConfig *config.Persistence,
+	historyShardPools *shardpool.Resolver,
 	logger log.SnTaggedLogger,
 	throttledLogger log.ThrottledLogger,
 ) client.Factory {
@@ -265,7 +275,7 @@ func ClientFactoryProvider(
 		metricsHandler,
 		dynamicCollection,
 		testHooks,
-		persistenceConfig.NumHistoryShards,
+		historyShardPools,
 		logger,
 		throttledLogger,
 	)
@@ -25,6 +25,15 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.CloneWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.CloneWorkflowExecutionResponse:
+		return []tag.Tag{
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
@@ -40,6 +49,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DeleteWorkflowExecutionResponse:
 		return nil
+	case *adminservice.DescribeChainedActivityExecutionRequest:
+		return []tag.Tag{
+			tag.ActivityID(r.GetRequest().GetActivityId()),
+			tag.ChasmRunID(r.GetRequest().GetRunId()),
+		}
+	case *adminservice.DescribeChainedActivityExecutionResponse:
+		return nil
 	case *adminservice.DescribeClusterRequest:
 		return nil
 	case *adminservice.DescribeClusterResponse:
@@ -62,10 +78,28 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeMutableStateAtEventRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.DescribeMutableStateAtEventResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeTaskSchedulerRequest:
+		return nil
+	case *adminservice.DescribeTaskSchedulerResponse:
+		return nil
+	case *adminservice.DryRunResetWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetResetRequest().GetWorkflowExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetResetRequest().GetWorkflowExecution().GetRunId()),
+		}
+	case *adminservice.DryRunResetWorkflowExecutionResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -97,6 +131,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetNamespaceReplicationMessagesResponse:
 		return nil
+	case *adminservice.GetReplicationLagRequest:
+		return nil
+	case *adminservice.GetReplicationLagResponse:
+		return nil
 	case *adminservice.GetReplicationMessagesRequest:
 		return nil
 	case *adminservice.GetReplicationMessagesResponse:
@@ -117,6 +155,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetTaskQueueUserDataResponse:
 		return nil
+	case *adminservice.GetTaskTraceRequest:
+		return nil
+	case *adminservice.GetTaskTraceResponse:
+		return nil
 	case *adminservice.GetWorkflowExecutionRawHistoryRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -150,10 +192,21 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListHistoryTasksResponse:
 		return nil
+	case *adminservice.ListQueueMitigationsRequest:
+		return nil
+	case *adminservice.ListQueueMitigationsResponse:
+		return nil
 	case *adminservice.ListQueuesRequest:
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListWorkflowConflictResolutionsRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.ListWorkflowConflictResolutionsResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -166,6 +219,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MigrateScheduleResponse:
 		return nil
+	case *adminservice.MoveHistoryShardRequest:
+		return nil
+	case *adminservice.MoveHistoryShardResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -218,6 +275,12 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.StartAdminBatchOperationResponse:
 		return nil
+	case *adminservice.StartChainedActivityExecutionRequest:
+		return []tag.Tag{
+			tag.ActivityID(r.GetRequest().GetActivityId()),
+		}
+	case *adminservice.StartChainedActivityExecutionResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -225,6 +288,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UnpinHistoryShardRequest:
+		return nil
+	case *adminservice.UnpinHistoryShardResponse:
+		return nil
+	case *adminservice.UpdateNamespaceReplicationFilterRequest:
+		return nil
+	case *adminservice.UpdateNamespaceReplicationFilterResponse:
+		return nil
+	case *adminservice.UpdateTaskSchedulerNamespaceWeightRequest:
+		return nil
+	case *adminservice.UpdateTaskSchedulerNamespaceWeightResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -80,6 +80,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.DescribeMutableStateResponse:
 		return nil
+	case *historyservice.DescribeTaskSchedulerRequest:
+		return nil
+	case *historyservice.DescribeTaskSchedulerResponse:
+		return nil
 	case *historyservice.DescribeWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -141,6 +145,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.GetShardResponse:
 		return nil
+	case *historyservice.GetTaskTraceRequest:
+		return nil
+	case *historyservice.GetTaskTraceResponse:
+		return nil
 	case *historyservice.GetWorkflowExecutionHistoryRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -197,6 +205,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.IsWorkflowTaskValidResponse:
 		return nil
+	case *historyservice.ListQueueMitigationsRequest:
+		return nil
+	case *historyservice.ListQueueMitigationsResponse:
+		return nil
 	case *historyservice.ListQueuesRequest:
 		return nil
 	case *historyservice.ListQueuesResponse:
@@ -223,6 +235,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.PauseWorkflowExecutionResponse:
 		return nil
+	case *historyservice.PinShardRequest:
+		return nil
+	case *historyservice.PinShardResponse:
+		return nil
 	case *historyservice.PollMutableStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -452,6 +468,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UnpauseWorkflowExecutionResponse:
 		return nil
+	case *historyservice.UnpinShardRequest:
+		return nil
+	case *historyservice.UnpinShardResponse:
+		return nil
 	case *historyservice.UpdateActivityOptionsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetUpdateRequest().GetExecution().GetWorkflowId()),
@@ -459,6 +479,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UpdateActivityOptionsResponse:
 		return nil
+	case *historyservice.UpdateTaskSchedulerNamespaceWeightRequest:
+		return nil
+	case *historyservice.UpdateTaskSchedulerNamespaceWeightResponse:
+		return nil
 	case *historyservice.UpdateWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetWorkflowExecution().GetWorkflowId()),
@@ -7,6 +7,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
@@ -112,7 +113,8 @@ func (ni *NamespaceRateLimitInterceptorImpl) Intercept(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (any, error) {
-	return ni.InterceptN(ctx, req, info, handler, NamespaceRateLimitDefaultToken)
+	// Batch requests are charged one token per item, so that batching doesn't bypass the namespace rate limit.
+	return ni.InterceptN(ctx, req, info, handler, max(NamespaceRateLimitDefaultToken, BatchRequestItemCount(req)))
 }
 
 func (ni *NamespaceRateLimitInterceptorImpl) InterceptN(
@@ -244,3 +246,18 @@ func IsLongPollDescribeActivityExecutionRequest(
 	}
 	return false
 }
+
+// BatchRequestItemCount returns the number of items of a batch request, or 0 if the request is not a batch.
+func BatchRequestItemCount(
+	req any,
+) int {
+	switch request := req.(type) {
+	case *activitybatchservice.BatchStartActivityExecutionsRequest:
+		return len(request.GetRequests())
+	case *activitybatchservice.BatchDescribeActivityExecutionsRequest:
+		return len(request.GetRequests())
+	case *activitybatchservice.BatchPollActivityExecutionsRequest:
+		return len(request.GetRequests())
+	}
+	return 0
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -20,6 +21,8 @@ const (
 	pollWorkflowTaskQueueMethod = "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"
 	otherMethod                 = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
 	testNamespace               = "test-namespace"
+
+	batchStartActivityExecutionsMethod = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchStartActivityExecutions"
 )
 
 type namespaceRateLimitInterceptorSuite struct {
@@ -232,6 +235,29 @@ func (s *namespaceRateLimitInterceptorSuite) TestIntercept_PollMethod_WaitEnable
 	s.ErrorIs(err, ErrNamespaceRateLimitServerBusy)
 }
 
+func (s *namespaceRateLimitInterceptorSuite) TestIntercept_BatchRequest_ChargedPerItem() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(nil, nil)
+	s.mockRateLimiter.EXPECT().Allow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, request quotas.Request) bool {
+		s.Equal(3, request.Token)
+		return false
+	})
+
+	ni := s.newImpl(false)
+	req := &activitybatchservice.BatchStartActivityExecutionsRequest{
+		Namespace: testNamespace,
+		Requests: []*workflowservice.StartActivityExecutionRequest{
+			{Namespace: testNamespace, ActivityId: "activity-1"},
+			{Namespace: testNamespace, ActivityId: "activity-2"},
+			{Namespace: testNamespace, ActivityId: "activity-3"},
+		},
+	}
+	_, err := ni.Intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: batchStartActivityExecutionsMethod}, func(_ context.Context, _ any) (any, error) {
+		s.Fail("handler should not be called")
+		return nil, nil
+	})
+	s.ErrorIs(err, ErrNamespaceRateLimitServerBusy)
+}
+
 // noopHeaderGetter implements headers.HeaderGetter with empty values.
 type noopHeaderGetter struct{}
 
@@ -42,12 +42,17 @@ var (
 	historyAPIExcluded = map[string]struct{}{
 		"CloseShard":                  {},
 		"GetShard":                    {},
+		"PinShard":                    {},
+		"UnpinShard":                  {},
+		"DescribeTaskScheduler":       {},
 		"GetDLQMessages":              {},
 		"GetDLQReplicationMessages":   {},
 		"GetReplicationMessages":      {},
 		"MergeDLQMessages":            {},
 		"PurgeDLQMessages":            {},
 		"RemoveTask":                  {},
+		"GetTaskTrace":                {},
+		"ListQueueMitigations":        {},
 		"SyncShardStatus":             {},
 		"GetReplicationStatus":        {},
 		"GetDLQTasks":                 {},
@@ -186,6 +186,8 @@ type (
 		// they don't collide with the bareredirectResponsesByFullMethod-name maps) as globally-redirectable, each mapped to its
 		// response constructor. Nil by default, preserving the WorkflowService-only behavior.
 		redirectResponsesByFullMethod map[string]responseConstructorFn
+		// shadowTraffic mirrors read-only calls to the target cluster of a namespace migration. Nil by default.
+		shadowTraffic *shadowTraffic
 	}
 )
 
@@ -315,7 +317,12 @@ func (i *Redirection) handleRedirectAPIInvocation(
 	err = i.redirectionPolicy.WithNamespaceRedirect(ctx, namespaceName, methodName, req, func(targetDC string) error {
 		targetClusterName = targetDC
 		if targetClusterName == i.currentClusterName {
+			shadowReq := i.shadowTrafficTarget(namespaceName, methodName, req)
+			localStartTime := i.timeSource.Now()
 			resp, err = handler(ctx, req)
+			if err == nil && shadowReq != nil {
+				i.mirrorShadowTraffic(ctx, shadowReq, info, methodName, namespaceName, resp, i.timeSource.Now().Sub(localStartTime))
+			}
 		} else {
 			remoteClient, _, err := i.clientBean.GetRemoteFrontendClient(targetClusterName)
 			if err != nil {
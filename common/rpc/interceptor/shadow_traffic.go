@@ -0,0 +1,257 @@
+package interceptor
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/wideevents"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const shadowTrafficMetricsPrefix = "ShadowTraffic"
+
+// shadowTrafficAPIs are the read-only APIs mirrored to the shadow traffic target cluster, along with the API the
+// target cluster is called with.
+var shadowTrafficAPIs = map[string]string{
+	"DescribeWorkflowExecution": "DescribeWorkflowExecution",
+	// Queries are answered by the workers of the namespace, which only poll the source cluster until handover. The
+	// target cluster is instead checked to hold the queried execution in the same state.
+	"QueryWorkflow": "DescribeWorkflowExecution",
+}
+
+type (
+	// ShadowTrafficConfig controls the mirroring of read-only calls to the target cluster of a namespace migration.
+	ShadowTrafficConfig struct {
+		TargetCluster          dynamicconfig.StringPropertyFnWithNamespaceFilter
+		SampleRate             dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		MaxOutstandingRequests dynamicconfig.IntPropertyFn
+		Timeout                dynamicconfig.DurationPropertyFn
+	}
+
+	shadowTraffic struct {
+		config      ShadowTrafficConfig
+		eventLogger otellog.Logger
+		outstanding atomic.Int64
+	}
+
+	// shadowTrafficRequest is a request mirrored to the shadow traffic target cluster.
+	shadowTrafficRequest struct {
+		targetCluster string
+		methodName    string
+		request       proto.Message
+	}
+)
+
+// WithShadowTraffic returns a copy of the interceptor that mirrors the read-only calls it serves from the current
+// cluster to the shadow traffic target cluster of the namespace, and reports the latency and result mismatches of the
+// mirrored calls. Mirrored calls are sent asynchronously and never affect the response returned to the caller.
+func (i *Redirection) WithShadowTraffic(config ShadowTrafficConfig, eventLogger otellog.Logger) *Redirection {
+	clone := *i
+	clone.shadowTraffic = &shadowTraffic{
+		config:      config,
+		eventLogger: eventLogger,
+	}
+	return &clone
+}
+
+// shadowTrafficTarget returns the request to mirror to the shadow traffic target cluster, or nil if the request should
+// not be mirrored.
+func (i *Redirection) shadowTrafficTarget(
+	namespaceName namespace.Name,
+	methodName string,
+	req any,
+) *shadowTrafficRequest {
+	if i.shadowTraffic == nil {
+		return nil
+	}
+	shadowMethodName, ok := shadowTrafficAPIs[methodName]
+	if !ok {
+		return nil
+	}
+	targetCluster := i.shadowTraffic.config.TargetCluster(namespaceName.String())
+	if targetCluster == "" || targetCluster == i.currentClusterName {
+		return nil
+	}
+	if rand.Float64() >= i.shadowTraffic.config.SampleRate(namespaceName.String()) {
+		return nil
+	}
+	var shadowReq proto.Message
+	// The request is copied before it is handled locally, in case the handler modifies it.
+	switch r := req.(type) {
+	case *workflowservice.QueryWorkflowRequest:
+		shadowReq = &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: r.GetNamespace(),
+			Execution: proto.Clone(r.GetExecution()).(*commonpb.WorkflowExecution),
+		}
+	case proto.Message:
+		shadowReq = proto.Clone(r)
+	default:
+		return nil
+	}
+	return &shadowTrafficRequest{
+		targetCluster: targetCluster,
+		methodName:    shadowMethodName,
+		request:       shadowReq,
+	}
+}
+
+// mirrorShadowTraffic sends the request to the target cluster and compares the response with the one served locally.
+func (i *Redirection) mirrorShadowTraffic(
+	ctx context.Context,
+	shadowReq *shadowTrafficRequest,
+	info *grpc.UnaryServerInfo,
+	methodName string,
+	namespaceName namespace.Name,
+	localResp any,
+	localLatency time.Duration,
+) {
+	targetClusterName := shadowReq.targetCluster
+	metricsHandler := i.metricsHandler.WithTags(
+		metrics.OperationTag(shadowTrafficMetricsPrefix+methodName),
+		metrics.ServiceRoleTag(metrics.DCRedirectionRoleTagValue),
+		metrics.TargetClusterTag(targetClusterName),
+		metrics.NamespaceTag(namespaceName.String()),
+	)
+	maxOutstanding := int64(i.shadowTraffic.config.MaxOutstandingRequests())
+	if i.shadowTraffic.outstanding.Add(1) > maxOutstanding {
+		i.shadowTraffic.outstanding.Add(-1)
+		metrics.ShadowTrafficDropped.With(metricsHandler).Record(1)
+		return
+	}
+
+	// The mirrored call must outlive the local call, it is however bounded by its own timeout.
+	shadowCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), i.shadowTraffic.config.Timeout())
+	// Redirection is disabled so that the target cluster serves the call itself, even though the namespace is not
+	// active there yet, and does not mirror it any further.
+	shadowCtx = metadata.NewOutgoingContext(shadowCtx, metadata.Pairs(
+		DCRedirectionContextHeaderName, "false",
+		DCRedirectionSourceCellHeaderName, i.currentClusterName,
+	))
+	go func() {
+		defer cancel()
+		defer i.shadowTraffic.outstanding.Add(-1)
+
+		metrics.ShadowTrafficRequests.With(metricsHandler).Record(1)
+		startTime := i.timeSource.Now()
+		shadowResp, err := i.invokeShadowTraffic(shadowCtx, shadowReq, info, methodName)
+		shadowLatency := i.timeSource.Now().Sub(startTime)
+		metrics.ShadowTrafficLatency.With(metricsHandler).Record(shadowLatency)
+
+		var mismatches []string
+		if err != nil {
+			metrics.ShadowTrafficFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		} else {
+			mismatches = ShadowResponseMismatches(localResp, shadowResp)
+			if len(mismatches) == 0 {
+				return
+			}
+			metrics.ShadowTrafficMismatches.With(metricsHandler).Record(1)
+		}
+
+		payload := wideevents.ShadowTrafficMismatchPayload{
+			Namespace:     namespaceName.String(),
+			API:           methodName,
+			TargetCluster: targetClusterName,
+			LocalLatency:  localLatency,
+			ShadowLatency: shadowLatency,
+			Mismatches:    mismatches,
+		}
+		if getter, ok := shadowReq.request.(executionGetter); ok {
+			payload.WorkflowID = getter.GetExecution().GetWorkflowId()
+			payload.RunID = getter.GetExecution().GetRunId()
+		}
+		if err != nil {
+			payload.Error = err.Error()
+		}
+		wideevents.Emit(i.shadowTraffic.eventLogger, payload)
+	}()
+}
+
+func (i *Redirection) invokeShadowTraffic(
+	ctx context.Context,
+	shadowReq *shadowTrafficRequest,
+	info *grpc.UnaryServerInfo,
+	methodName string,
+) (any, error) {
+	remoteClient, _, err := i.clientBean.GetRemoteFrontendClient(shadowReq.targetCluster)
+	if err != nil {
+		return nil, err
+	}
+	fullMethod := strings.TrimSuffix(info.FullMethod, methodName) + shadowReq.methodName
+	resp := globalAPIResponses[shadowReq.methodName]()
+	if err := remoteClient.Invoke(ctx, fullMethod, shadowReq.request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ShadowResponseMismatches returns the fields whose value differ between a response served by the source cluster of a
+// namespace migration and the response served by the target cluster for the same read-only request. Fields expected
+// to differ between clusters, e.g. the pending workflow task attempt, are not compared. Until an execution is closed on
+// both clusters, the target cluster may not have caught up with the history of the source cluster, so only the fields
+// set when the execution started are compared, and the history of the target cluster must be a prefix of the one of
+// the source cluster.
+func ShadowResponseMismatches(local any, shadow any) []string {
+	var mismatches []string
+	mismatch := func(field string, equal bool) {
+		if !equal {
+			mismatches = append(mismatches, field)
+		}
+	}
+
+	switch localResp := local.(type) {
+	case *workflowservice.DescribeWorkflowExecutionResponse:
+		shadowResp, ok := shadow.(*workflowservice.DescribeWorkflowExecutionResponse)
+		if !ok {
+			return []string{"type"}
+		}
+		localInfo := localResp.GetWorkflowExecutionInfo()
+		shadowInfo := shadowResp.GetWorkflowExecutionInfo()
+		mismatch("workflow_id", localInfo.GetExecution().GetWorkflowId() == shadowInfo.GetExecution().GetWorkflowId())
+		if localInfo.GetExecution().GetRunId() != shadowInfo.GetExecution().GetRunId() {
+			// The current run of the target cluster may not have been replicated yet.
+			if localInfo.GetCloseTime() != nil && shadowInfo.GetCloseTime() != nil {
+				mismatch("run_id", false)
+			}
+			return mismatches
+		}
+		mismatch("workflow_type", localInfo.GetType().GetName() == shadowInfo.GetType().GetName())
+		mismatch("start_time", proto.Equal(localInfo.GetStartTime(), shadowInfo.GetStartTime()))
+		if localInfo.GetCloseTime() == nil || shadowInfo.GetCloseTime() == nil {
+			mismatch("history_length", shadowInfo.GetHistoryLength() <= localInfo.GetHistoryLength())
+			return mismatches
+		}
+		mismatch("status", localInfo.GetStatus() == shadowInfo.GetStatus())
+		mismatch("close_time", proto.Equal(localInfo.GetCloseTime(), shadowInfo.GetCloseTime()))
+		mismatch("history_length", localInfo.GetHistoryLength() == shadowInfo.GetHistoryLength())
+		mismatch("pending_activities", len(localResp.GetPendingActivities()) == len(shadowResp.GetPendingActivities()))
+		mismatch("pending_children", len(localResp.GetPendingChildren()) == len(shadowResp.GetPendingChildren()))
+	case *workflowservice.QueryWorkflowResponse:
+		// The target cluster served a describe of the queried execution, so it holds the execution.
+		shadowResp, ok := shadow.(*workflowservice.DescribeWorkflowExecutionResponse)
+		if !ok {
+			return []string{"type"}
+		}
+		// A query is rejected based on the status of the execution, without reaching the workers.
+		rejectedStatus := localResp.GetQueryRejected().GetStatus()
+		shadowInfo := shadowResp.GetWorkflowExecutionInfo()
+		if rejectedStatus != enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED &&
+			rejectedStatus != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING &&
+			shadowInfo.GetCloseTime() != nil {
+			mismatch("query_rejected_status", rejectedStatus == shadowInfo.GetStatus())
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,407 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type shadowClientConn struct {
+	response proto.Message
+	err      error
+	requests chan *shadowRequest
+}
+
+type shadowRequest struct {
+	method   string
+	request  any
+	metadata metadata.MD
+}
+
+var _ grpc.ClientConnInterface = (*shadowClientConn)(nil)
+
+func (c *shadowClientConn) Invoke(
+	ctx context.Context,
+	method string,
+	args any,
+	reply any,
+	_ ...grpc.CallOption,
+) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.requests <- &shadowRequest{method: method, request: args, metadata: md}
+	if c.err != nil {
+		return c.err
+	}
+	proto.Merge(reply.(proto.Message), c.response)
+	return nil
+}
+
+func (c *shadowClientConn) NewStream(
+	_ context.Context,
+	_ *grpc.StreamDesc,
+	_ string,
+	_ ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	panic("implement me")
+}
+
+func (s *redirectionInterceptorSuite) newShadowTrafficRedirector(
+	metricsHandler metrics.Handler,
+	targetCluster string,
+) *Redirection {
+	return NewRedirection(
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		s.namespaceCache,
+		config.DCRedirectionPolicy{Policy: DCRedirectionPolicyAllAPIsForwarding},
+		log.NewNoopLogger(),
+		s.clientBean,
+		metricsHandler,
+		clock.NewRealTimeSource(),
+		s.clusterMetadata,
+	).WithShadowTraffic(ShadowTrafficConfig{
+		TargetCluster:          dynamicconfig.GetStringPropertyFnFilteredByNamespace(targetCluster),
+		SampleRate:             dynamicconfig.GetFloatPropertyFnFilteredByNamespace(1),
+		MaxOutstandingRequests: dynamicconfig.GetIntPropertyFn(10),
+		Timeout:                dynamicconfig.GetDurationPropertyFn(time.Second),
+	}, nil)
+}
+
+func (s *redirectionInterceptorSuite) activeLocallyNamespace() namespace.Name {
+	namespaceName := namespace.Name("shadow-namespace")
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: uuid.NewString(), Name: namespaceName.String()},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		1,
+	)
+	s.namespaceCache.EXPECT().GetNamespace(namespaceName).Return(namespaceEntry, nil).AnyTimes()
+	return namespaceName
+}
+
+func (s *redirectionInterceptorSuite) TestShadowTraffic_Mismatch() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	redirector := s.newShadowTrafficRedirector(metricsHandler, cluster.TestAlternativeClusterName)
+	namespaceName := s.activeLocallyNamespace()
+
+	methodName := "DescribeWorkflowExecution"
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+	}
+	req := &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespaceName.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+	}
+	localResp := &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution:     req.Execution,
+			Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime:     timestamppb.New(time.Unix(100, 0)),
+			HistoryLength: 10,
+		},
+	}
+	grpcConn := &shadowClientConn{
+		response: &workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution:     req.Execution,
+				Status:        enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+				CloseTime:     timestamppb.New(time.Unix(100, 0)),
+				HistoryLength: 8,
+			},
+		},
+		requests: make(chan *shadowRequest, 1),
+	}
+	s.clientBean.EXPECT().GetRemoteFrontendClient(cluster.TestAlternativeClusterName).Return(grpcConn, nil, nil).Times(1)
+
+	resp, err := redirector.handleRedirectAPIInvocation(
+		context.Background(),
+		req,
+		info,
+		func(ctx context.Context, req any) (any, error) {
+			return localResp, nil
+		},
+		methodName,
+		globalAPIResponses[methodName],
+		namespaceName,
+	)
+	s.NoError(err)
+	s.Equal(localResp, resp)
+
+	shadowReq := <-grpcConn.requests
+	s.Equal(info.FullMethod, shadowReq.method)
+	s.True(proto.Equal(req, shadowReq.request.(proto.Message)))
+	s.Equal([]string{"false"}, shadowReq.metadata.Get(DCRedirectionContextHeaderName))
+	s.Equal([]string{cluster.TestCurrentClusterName}, shadowReq.metadata.Get(DCRedirectionSourceCellHeaderName))
+
+	s.Eventually(func() bool {
+		return len(capture.Snapshot()[metrics.ShadowTrafficMismatches.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+	snapshot := capture.Snapshot()
+	s.Len(snapshot[metrics.ShadowTrafficRequests.Name()], 1)
+	s.Empty(snapshot[metrics.ShadowTrafficFailures.Name()])
+	// Mirrored calls are not redirections.
+	s.Empty(snapshot[metrics.ClientRedirectionRequests.Name()])
+}
+
+func (s *redirectionInterceptorSuite) TestShadowTraffic_Failure() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	redirector := s.newShadowTrafficRedirector(metricsHandler, cluster.TestAlternativeClusterName)
+	namespaceName := s.activeLocallyNamespace()
+
+	methodName := "DescribeWorkflowExecution"
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+	}
+	grpcConn := &shadowClientConn{
+		err:      serviceerror.NewNotFound("workflow not found"),
+		requests: make(chan *shadowRequest, 1),
+	}
+	s.clientBean.EXPECT().GetRemoteFrontendClient(cluster.TestAlternativeClusterName).Return(grpcConn, nil, nil).Times(1)
+
+	_, err := redirector.handleRedirectAPIInvocation(
+		context.Background(),
+		&workflowservice.DescribeWorkflowExecutionRequest{Namespace: namespaceName.String()},
+		info,
+		func(ctx context.Context, req any) (any, error) {
+			return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+		},
+		methodName,
+		globalAPIResponses[methodName],
+		namespaceName,
+	)
+	s.NoError(err)
+
+	<-grpcConn.requests
+	s.Eventually(func() bool {
+		return len(capture.Snapshot()[metrics.ShadowTrafficFailures.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+	s.Empty(capture.Snapshot()[metrics.ShadowTrafficMismatches.Name()])
+}
+
+func (s *redirectionInterceptorSuite) TestShadowTraffic_Query() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	redirector := s.newShadowTrafficRedirector(metricsHandler, cluster.TestAlternativeClusterName)
+	namespaceName := s.activeLocallyNamespace()
+
+	methodName := "QueryWorkflow"
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow",
+	}
+	req := &workflowservice.QueryWorkflowRequest{
+		Namespace:            namespaceName.String(),
+		Execution:            &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+		Query:                &querypb.WorkflowQuery{QueryType: "query-type"},
+		QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+	}
+	localResp := &workflowservice.QueryWorkflowResponse{
+		QueryRejected: &querypb.QueryRejected{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED},
+	}
+	grpcConn := &shadowClientConn{
+		response: &workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: req.Execution,
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				CloseTime: timestamppb.New(time.Unix(100, 0)),
+			},
+		},
+		requests: make(chan *shadowRequest, 1),
+	}
+	s.clientBean.EXPECT().GetRemoteFrontendClient(cluster.TestAlternativeClusterName).Return(grpcConn, nil, nil).Times(1)
+
+	resp, err := redirector.handleRedirectAPIInvocation(
+		context.Background(),
+		req,
+		info,
+		func(ctx context.Context, req any) (any, error) {
+			return localResp, nil
+		},
+		methodName,
+		globalAPIResponses[methodName],
+		namespaceName,
+	)
+	s.NoError(err)
+	s.Equal(localResp, resp)
+
+	// The target cluster is not sent the query, its workers are not polling yet.
+	shadowReq := <-grpcConn.requests
+	s.Equal("/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution", shadowReq.method)
+	s.True(proto.Equal(&workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespaceName.String(),
+		Execution: req.Execution,
+	}, shadowReq.request.(proto.Message)))
+
+	s.Eventually(func() bool {
+		return len(capture.Snapshot()[metrics.ShadowTrafficMismatches.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+	s.Empty(capture.Snapshot()[metrics.ShadowTrafficFailures.Name()])
+}
+
+func (s *redirectionInterceptorSuite) TestShadowTraffic_NotMirrored() {
+	namespaceName := s.activeLocallyNamespace()
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+	}
+
+	testCases := []struct {
+		name          string
+		targetCluster string
+		methodName    string
+	}{
+		{
+			name:          "disabled",
+			targetCluster: "",
+			methodName:    "DescribeWorkflowExecution",
+		},
+		{
+			name:          "target is current cluster",
+			targetCluster: cluster.TestCurrentClusterName,
+			methodName:    "DescribeWorkflowExecution",
+		},
+		{
+			name:          "not a read-only API",
+			targetCluster: cluster.TestAlternativeClusterName,
+			methodName:    "SignalWorkflowExecution",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			redirector := s.newShadowTrafficRedirector(metrics.NoopMetricsHandler, tc.targetCluster)
+			// No remote frontend client is expected.
+			_, err := redirector.handleRedirectAPIInvocation(
+				context.Background(),
+				&workflowservice.DescribeWorkflowExecutionRequest{Namespace: namespaceName.String()},
+				info,
+				handler,
+				tc.methodName,
+				globalAPIResponses[tc.methodName],
+				namespaceName,
+			)
+			s.NoError(err)
+		})
+	}
+}
+
+func TestShadowResponseMismatches(t *testing.T) {
+	startTime := time.Unix(1700000000, 0)
+	describe := func(status enumspb.WorkflowExecutionStatus, historyLength int64, pendingActivities int) *workflowservice.DescribeWorkflowExecutionResponse {
+		resp := &workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution:     &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+				Type:          &commonpb.WorkflowType{Name: "workflow-type"},
+				Status:        status,
+				StartTime:     timestamp.TimePtr(startTime),
+				HistoryLength: historyLength,
+			},
+		}
+		if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			resp.WorkflowExecutionInfo.CloseTime = timestamp.TimePtr(startTime.Add(time.Minute))
+		}
+		for range pendingActivities {
+			resp.PendingActivities = append(resp.PendingActivities, &workflowpb.PendingActivityInfo{})
+		}
+		return resp
+	}
+
+	require.Empty(t, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 10, 0),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 10, 0),
+	))
+	require.Equal(t, []string{"status", "history_length", "pending_activities"}, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 12, 0),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, 10, 1),
+	))
+	// The target cluster may not have caught up yet with running executions.
+	require.Empty(t, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 12, 0),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 10, 1),
+	))
+	require.Empty(t, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 12, 0),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 10, 1),
+	))
+	// The fields set when a running execution started are still compared, and the target cluster cannot be ahead.
+	shadowRunning := describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 14, 0)
+	shadowRunning.WorkflowExecutionInfo.Type.Name = "other-workflow-type"
+	shadowRunning.WorkflowExecutionInfo.StartTime = timestamp.TimePtr(startTime.Add(time.Second))
+	require.Equal(t, []string{"workflow_type", "start_time", "history_length"}, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 12, 0),
+		shadowRunning,
+	))
+	// The target cluster may not have replicated the current run yet.
+	otherRun := describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 1, 0)
+	otherRun.WorkflowExecutionInfo.Execution.RunId = "other-run-id"
+	require.Empty(t, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 12, 0),
+		otherRun,
+	))
+	otherClosedRun := describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 10, 0)
+	otherClosedRun.WorkflowExecutionInfo.Execution.RunId = "other-run-id"
+	require.Equal(t, []string{"run_id"}, ShadowResponseMismatches(
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 10, 0),
+		otherClosedRun,
+	))
+
+	// Queries are compared with a describe of the queried execution.
+	rejected := func(status enumspb.WorkflowExecutionStatus) *workflowservice.QueryWorkflowResponse {
+		return &workflowservice.QueryWorkflowResponse{QueryRejected: &querypb.QueryRejected{Status: status}}
+	}
+	require.Empty(t, ShadowResponseMismatches(
+		&workflowservice.QueryWorkflowResponse{},
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 10, 0),
+	))
+	require.Empty(t, ShadowResponseMismatches(
+		rejected(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 10, 0),
+	))
+	require.Empty(t, ShadowResponseMismatches(
+		rejected(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 10, 0),
+	))
+	require.Equal(t, []string{"query_rejected_status"}, ShadowResponseMismatches(
+		rejected(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+		describe(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, 10, 0),
+	))
+
+	require.Equal(t, []string{"type"}, ShadowResponseMismatches(
+		&workflowservice.DescribeWorkflowExecutionResponse{},
+		&workflowservice.CountWorkflowExecutionsResponse{},
+	))
+}
@@ -0,0 +1,174 @@
+// Package shardpool places the workflows of namespaces assigned to a history shard pool on the shards of that pool.
+//
+// Shard pools isolate namespaces from each other inside one cluster: the workflows of a namespace assigned to a pool
+// are only placed on the shards of the pool, so its load is confined to the hosts owning those shards and it can be
+// given its own quotas. Pools are defined in dynamic config, and a namespace is assigned to one by recording the pool
+// and its shard range in the namespace config. Routing only uses the recorded range, so that every service routes
+// requests to the same shard and editing the dynamic config can't move existing executions.
+package shardpool
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// NamespaceRegistry is the subset of namespace.Registry the Resolver reads shard pool assignments from.
+	NamespaceRegistry interface {
+		GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error)
+	}
+
+	// Resolver maps namespace and workflow ID pairs to history shards, honoring shard pool assignments.
+	Resolver struct {
+		pools          dynamicconfig.TypedPropertyFn[map[string]dynamicconfig.ShardPool]
+		registry       NamespaceRegistry
+		numberOfShards int32
+	}
+)
+
+func NewResolver(
+	dc *dynamicconfig.Collection,
+	registry NamespaceRegistry,
+	numberOfShards int32,
+) *Resolver {
+	return &Resolver{
+		pools:          dynamicconfig.HistoryShardPools.Get(dc),
+		registry:       registry,
+		numberOfShards: numberOfShards,
+	}
+}
+
+// NumberOfShards returns the number of history shards in the cluster.
+func (r *Resolver) NumberOfShards() int32 {
+	return r.numberOfShards
+}
+
+// ShardID returns the shard of the given workflow. It returns an error if the namespace can't be looked up, since its
+// shard pool assignment is unknown then, or if the shard range recorded in its assignment is not valid.
+func (r *Resolver) ShardID(namespaceID string, workflowID string) (int32, error) {
+	assignment, err := r.Assignment(namespaceID)
+	if err != nil {
+		return 0, err
+	}
+	return WorkflowIDToHistoryShard(assignment, namespaceID, workflowID, r.numberOfShards)
+}
+
+// Assignment returns the shard pool the given namespace is assigned to, or nil if its workflows are placed on all
+// shards.
+func (r *Resolver) Assignment(namespaceID string) (*persistencespb.ShardPoolAssignment, error) {
+	ns, err := r.registry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		return nil, err
+	}
+	return ns.ShardPool(), nil
+}
+
+// AssignmentShardID returns the shard the given workflow is placed on when its namespace has the given assignment.
+// A nil assignment means all shards.
+func (r *Resolver) AssignmentShardID(
+	assignment *persistencespb.ShardPoolAssignment,
+	namespaceID string,
+	workflowID string,
+) (int32, error) {
+	return WorkflowIDToHistoryShard(assignment, namespaceID, workflowID, r.numberOfShards)
+}
+
+// NewAssignment returns an assignment to the pool with the given name, recording the shard range the pool is
+// currently defined with. It returns an error if the pool is not defined, not valid or overlaps another pool.
+func (r *Resolver) NewAssignment(poolName string) (*persistencespb.ShardPoolAssignment, error) {
+	pools := r.pools()
+	pool, ok := pools[poolName]
+	if !ok {
+		return nil, fmt.Errorf("shard pool %q is not defined", poolName)
+	}
+	if err := validateInPools(pools, poolName, r.numberOfShards); err != nil {
+		return nil, fmt.Errorf("shard pool %q: %w", poolName, err)
+	}
+	return &persistencespb.ShardPoolAssignment{
+		Name:         poolName,
+		FirstShardId: pool.FirstShardID,
+		LastShardId:  pool.LastShardID,
+	}, nil
+}
+
+// WorkflowIDToHistoryShard maps a namespace and workflow ID pair to a shard of the pool the namespace is assigned
+// to. Workflows of namespaces that are not assigned to a pool are mapped to all shards, exactly like
+// common.WorkflowIDToHistoryShard. An assignment whose shard range is not valid fails the lookup instead of falling
+// back to all shards, which would place existing executions on other shards.
+func WorkflowIDToHistoryShard(
+	assignment *persistencespb.ShardPoolAssignment,
+	namespaceID string,
+	workflowID string,
+	numberOfShards int32,
+) (int32, error) {
+	if assignment == nil {
+		return common.WorkflowIDToHistoryShard(namespaceID, workflowID, numberOfShards), nil
+	}
+	if err := validateRange(assignment.GetFirstShardId(), assignment.GetLastShardId(), numberOfShards); err != nil {
+		return 0, serviceerror.NewInternalf("namespace %s is assigned to shard pool %q with %v",
+			namespaceID, assignment.GetName(), err)
+	}
+	poolSize := assignment.GetLastShardId() - assignment.GetFirstShardId() + 1
+	return assignment.GetFirstShardId() + common.WorkflowIDToHistoryShard(namespaceID, workflowID, poolSize) - 1, nil
+}
+
+// Overlaps returns true if the shard ranges of the two assignments share a shard.
+func Overlaps(a *persistencespb.ShardPoolAssignment, b *persistencespb.ShardPoolAssignment) bool {
+	return a.GetFirstShardId() <= b.GetLastShardId() && b.GetFirstShardId() <= a.GetLastShardId()
+}
+
+// Validate returns an error if the given pool is not a valid shard range of a cluster with the given number of
+// shards.
+func Validate(pool dynamicconfig.ShardPool, numberOfShards int32) error {
+	if err := validateRange(pool.FirstShardID, pool.LastShardID, numberOfShards); err != nil {
+		return err
+	}
+	if pool.RPS < 0 {
+		return fmt.Errorf("invalid RPS %d", pool.RPS)
+	}
+	return nil
+}
+
+// ValidatePools returns an error if any of the given pools is not a valid shard range of a cluster with the given
+// number of shards, or overlaps another pool.
+func ValidatePools(pools map[string]dynamicconfig.ShardPool, numberOfShards int32) error {
+	for name := range pools {
+		if err := validateInPools(pools, name, numberOfShards); err != nil {
+			return fmt.Errorf("shard pool %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// validateInPools validates the pool with the given name, which must be defined in pools, and checks that it does not
+// overlap any other pool. Both pools of an overlapping pair are invalid, so that no shard is shared by two pools no
+// matter which of them is looked up.
+func validateInPools(pools map[string]dynamicconfig.ShardPool, poolName string, numberOfShards int32) error {
+	pool := pools[poolName]
+	if err := Validate(pool, numberOfShards); err != nil {
+		return err
+	}
+	for name, other := range pools {
+		if name == poolName {
+			continue
+		}
+		if pool.FirstShardID <= other.LastShardID && other.FirstShardID <= pool.LastShardID {
+			return fmt.Errorf("shard range [%d, %d] overlaps shard pool %q [%d, %d]",
+				pool.FirstShardID, pool.LastShardID, name, other.FirstShardID, other.LastShardID)
+		}
+	}
+	return nil
+}
+
+func validateRange(firstShardID int32, lastShardID int32, numberOfShards int32) error {
+	if firstShardID < 1 || lastShardID > numberOfShards || firstShardID > lastShardID {
+		return fmt.Errorf("invalid shard range [%d, %d], shard IDs must be in [1, %d]",
+			firstShardID, lastShardID, numberOfShards)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package shardpool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/protobuf/proto"
+)
+
+type testRegistry map[namespace.ID]*persistencespb.ShardPoolAssignment
+
+func (r testRegistry) GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error) {
+	assignment, ok := r[id]
+	if !ok {
+		return nil, serviceerror.NewNamespaceNotFound(id.String())
+	}
+	return namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: id.String(), Name: id.String()},
+		&persistencespb.NamespaceConfig{ShardPool: assignment},
+		"active",
+	), nil
+}
+
+func newTestResolver(numberOfShards int32) *Resolver {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.HistoryShardPools.Key(): map[string]any{
+			// The small pool was resized since ns-small was assigned to it.
+			"small":   map[string]any{"FirstShardID": 1, "LastShardID": 1, "RPS": 100},
+			"large":   map[string]any{"FirstShardID": 3, "LastShardID": 6},
+			"invalid": map[string]any{"FirstShardID": 7, "LastShardID": 20},
+		},
+	}, log.NewNoopLogger())
+	registry := testRegistry{
+		"ns-other":   nil,
+		"ns-small":   {Name: "small", FirstShardId: 1, LastShardId: 2},
+		"ns-large":   {Name: "large", FirstShardId: 3, LastShardId: 6},
+		"ns-invalid": {Name: "invalid", FirstShardId: 7, LastShardId: 20},
+	}
+	return NewResolver(dc, registry, numberOfShards)
+}
+
+func TestResolver_ShardID(t *testing.T) {
+	r := newTestResolver(8)
+
+	for i := range 100 {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		// The shard range recorded with the assignment is used, not the one in dynamic config.
+		shardID, err := r.ShardID("ns-small", workflowID)
+		require.NoError(t, err)
+		require.Contains(t, []int32{1, 2}, shardID)
+		shardID, err = r.ShardID("ns-large", workflowID)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, shardID, int32(3))
+		require.LessOrEqual(t, shardID, int32(6))
+
+		// Namespaces that are not assigned to a pool are placed on all shards.
+		shardID, err = r.ShardID("ns-other", workflowID)
+		require.NoError(t, err)
+		require.Equal(t, common.WorkflowIDToHistoryShard("ns-other", workflowID, 8), shardID)
+	}
+
+	// An invalid assignment fails instead of placing the workflows on all shards.
+	_, err := r.ShardID("ns-invalid", "workflow")
+	var internal *serviceerror.Internal
+	require.ErrorAs(t, err, &internal)
+
+	_, err = r.ShardID("ns-unknown", "workflow")
+	var notFound *serviceerror.NamespaceNotFound
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestResolver_Assignment(t *testing.T) {
+	r := newTestResolver(8)
+
+	assignment, err := r.Assignment("ns-small")
+	require.NoError(t, err)
+	require.Equal(t, "small", assignment.GetName())
+
+	assignment, err = r.Assignment("ns-other")
+	require.NoError(t, err)
+	require.Nil(t, assignment)
+
+	_, err = r.Assignment("ns-unknown")
+	require.Error(t, err)
+}
+
+func TestResolver_AssignmentShardID(t *testing.T) {
+	r := newTestResolver(8)
+
+	assignment, err := r.Assignment("ns-large")
+	require.NoError(t, err)
+	shardID, err := r.AssignmentShardID(assignment, "ns-large", "workflow")
+	require.NoError(t, err)
+	expectedShardID, err := r.ShardID("ns-large", "workflow")
+	require.NoError(t, err)
+	require.Equal(t, expectedShardID, shardID)
+
+	// The shard of a workflow of a namespace that is not assigned to the pool yet.
+	shardID, err = r.AssignmentShardID(assignment, "ns-small", "workflow")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, shardID, int32(3))
+	require.LessOrEqual(t, shardID, int32(6))
+
+	shardID, err = r.AssignmentShardID(nil, "ns-small", "workflow")
+	require.NoError(t, err)
+	require.Equal(t, common.WorkflowIDToHistoryShard("ns-small", "workflow", 8), shardID)
+
+	_, err = r.AssignmentShardID(&persistencespb.ShardPoolAssignment{Name: "empty"}, "ns-small", "workflow")
+	require.Error(t, err)
+}
+
+func TestResolver_NewAssignment(t *testing.T) {
+	r := newTestResolver(8)
+
+	assignment, err := r.NewAssignment("small")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 1}, assignment))
+
+	_, err = r.NewAssignment("invalid")
+	require.Error(t, err)
+	_, err = r.NewAssignment("undefined")
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(dynamicconfig.ShardPool{FirstShardID: 1, LastShardID: 4}, 4))
+	require.NoError(t, Validate(dynamicconfig.ShardPool{FirstShardID: 3, LastShardID: 3}, 4))
+	require.Error(t, Validate(dynamicconfig.ShardPool{FirstShardID: 0, LastShardID: 4}, 4))
+	require.Error(t, Validate(dynamicconfig.ShardPool{FirstShardID: 1, LastShardID: 5}, 4))
+	require.Error(t, Validate(dynamicconfig.ShardPool{FirstShardID: 3, LastShardID: 2}, 4))
+	require.Error(t, Validate(dynamicconfig.ShardPool{FirstShardID: 1, LastShardID: 4, RPS: -1}, 4))
+}
+
+func TestValidatePools(t *testing.T) {
+	require.NoError(t, ValidatePools(map[string]dynamicconfig.ShardPool{
+		"a": {FirstShardID: 1, LastShardID: 2},
+		"b": {FirstShardID: 3, LastShardID: 8},
+	}, 8))
+	require.Error(t, ValidatePools(map[string]dynamicconfig.ShardPool{
+		"a": {FirstShardID: 1, LastShardID: 3},
+		"b": {FirstShardID: 3, LastShardID: 8},
+	}, 8))
+	require.Error(t, ValidatePools(map[string]dynamicconfig.ShardPool{
+		"a": {FirstShardID: 1, LastShardID: 9},
+	}, 8))
+}
+
+func TestResolver_NewAssignment_Overlapping(t *testing.T) {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.HistoryShardPools.Key(): map[string]any{
+			"a": map[string]any{"FirstShardID": 1, "LastShardID": 4},
+			"b": map[string]any{"FirstShardID": 4, "LastShardID": 8},
+			"c": map[string]any{"FirstShardID": 9, "LastShardID": 10},
+		},
+	}, log.NewNoopLogger())
+	r := NewResolver(dc, testRegistry{}, 10)
+
+	// Both pools of an overlapping pair are rejected.
+	_, err := r.NewAssignment("a")
+	require.Error(t, err)
+	_, err = r.NewAssignment("b")
+	require.Error(t, err)
+	_, err = r.NewAssignment("c")
+	require.NoError(t, err)
+}
+
+func TestOverlaps(t *testing.T) {
+	require.True(t, Overlaps(
+		&persistencespb.ShardPoolAssignment{FirstShardId: 1, LastShardId: 4},
+		&persistencespb.ShardPoolAssignment{FirstShardId: 4, LastShardId: 8},
+	))
+	require.False(t, Overlaps(
+		&persistencespb.ShardPoolAssignment{FirstShardId: 1, LastShardId: 3},
+		&persistencespb.ShardPoolAssignment{FirstShardId: 4, LastShardId: 8},
+	))
+}
@@ -0,0 +1,27 @@
+// Package shardpooltest contains test helpers for [shardpool.Resolver].
+package shardpooltest
+
+import (
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardpool"
+)
+
+// Registry is a [shardpool.NamespaceRegistry] that assigns namespaces to the shard pools they are mapped to. Namespaces
+// that are not in the map exist and are not assigned to a pool.
+type Registry map[namespace.ID]*persistencespb.ShardPoolAssignment
+
+func (r Registry) GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error) {
+	return namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: id.String(), Name: id.String()},
+		&persistencespb.NamespaceConfig{ShardPool: r[id]},
+		"active",
+	), nil
+}
+
+// NewResolver returns a resolver for a cluster with the given number of shards and no shard pools, which places the
+// workflows of every namespace on all shards.
+func NewResolver(numberOfShards int32) *shardpool.Resolver {
+	return shardpool.NewResolver(dynamicconfig.NewNoopCollection(), Registry(nil), numberOfShards)
+}
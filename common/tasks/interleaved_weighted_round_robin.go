@@ -30,6 +30,9 @@ type (
 		ChannelWeightUpdateCh chan struct{}
 		// Optional, if specified, delete inactive channels after this duration
 		InactiveChannelDeletionDelay dynamicconfig.DurationPropertyFn
+		// Optional, if specified, adjust the weight of a task channel by its backlog
+		// when channel weights are re-evaluated
+		ChannelBacklogWeightFn ChannelBacklogWeightFn
 	}
 
 	// TaskChannelKeyFn is the function for mapping a task to its task channel (key)
@@ -38,6 +41,18 @@ type (
 	// ChannelWeightFn is the function for mapping a task channel (key) to its weight
 	ChannelWeightFn[K comparable] func(K) int
 
+	// ChannelBacklogWeightFn is the function for adjusting the weight of a task channel
+	// by the number of tasks pending in the channel
+	ChannelBacklogWeightFn func(weight int, backlog int) int
+
+	// ChannelState is a snapshot of a task channel of the scheduler
+	ChannelState[K comparable] struct {
+		Key            K
+		Weight         int
+		Backlog        int
+		LastActiveTime time.Time
+	}
+
 	// InterleavedWeightedRoundRobinScheduler is a round robin scheduler implementation
 	// ref: https://en.wikipedia.org/wiki/Weighted_round_robin#Interleaved_WRR
 	InterleavedWeightedRoundRobinScheduler[T Task, K comparable] struct {
@@ -321,8 +336,29 @@ func (s *InterleavedWeightedRoundRobinScheduler[T, K]) receiveWeightUpdateNotifi
 
 func (s *InterleavedWeightedRoundRobinScheduler[T, K]) updateChannelWeightLocked() {
 	for channelKey, weightedChannel := range s.weightedChannels {
-		weightedChannel.SetWeight(s.options.ChannelWeightFn(channelKey))
+		weight := s.options.ChannelWeightFn(channelKey)
+		if s.options.ChannelBacklogWeightFn != nil {
+			weight = s.options.ChannelBacklogWeightFn(weight, weightedChannel.Len())
+		}
+		weightedChannel.SetWeight(weight)
+	}
+}
+
+// ChannelStates returns a snapshot of the task channels of the scheduler
+func (s *InterleavedWeightedRoundRobinScheduler[T, K]) ChannelStates() []ChannelState[K] {
+	s.RLock()
+	defer s.RUnlock()
+
+	states := make([]ChannelState[K], 0, len(s.weightedChannels))
+	for channelKey, weightedChannel := range s.weightedChannels {
+		states = append(states, ChannelState[K]{
+			Key:            channelKey,
+			Weight:         weightedChannel.Weight(),
+			Backlog:        weightedChannel.Len(),
+			LastActiveTime: weightedChannel.LastActiveTime(),
+		})
 	}
+	return states
 }
 
 func (s *InterleavedWeightedRoundRobinScheduler[T, K]) dispatchTasksWithWeight() {
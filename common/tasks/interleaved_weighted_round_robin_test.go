@@ -377,6 +377,33 @@ func (s *interleavedWeightedRoundRobinSchedulerSuite) TestUpdateWeight() {
 	atomic.AddInt64(&s.scheduler.numInflightTask, -1)
 }
 
+func (s *interleavedWeightedRoundRobinSchedulerSuite) TestChannelStates_BacklogWeight() {
+	// need to manually set the number of pending task to 1
+	// so tasks are buffered in the task channels
+	atomic.AddInt64(&s.scheduler.numInflightTask, 1)
+	defer atomic.AddInt64(&s.scheduler.numInflightTask, -4)
+
+	s.scheduler.options.ChannelBacklogWeightFn = func(weight int, backlog int) int {
+		return weight + backlog
+	}
+	s.scheduler.Submit(newTestTask(s.controller, 0))
+	s.scheduler.Submit(newTestTask(s.controller, 1))
+	s.scheduler.Submit(newTestTask(s.controller, 1))
+
+	s.scheduler.Lock()
+	s.scheduler.updateChannelWeightLocked()
+	s.scheduler.flattenWeightedChannelsLocked()
+	s.scheduler.Unlock()
+
+	states := s.scheduler.ChannelStates()
+	slices.SortFunc(states, func(a, b ChannelState[int]) int { return a.Key - b.Key })
+	s.Equal([]ChannelState[int]{
+		{Key: 0, Weight: 6, Backlog: 1, LastActiveTime: s.ts.Now()},
+		{Key: 1, Weight: 5, Backlog: 2, LastActiveTime: s.ts.Now()},
+	}, states)
+	s.Len(s.scheduler.channels(), 11)
+}
+
 func (s *interleavedWeightedRoundRobinSchedulerSuite) TestDeleteInactiveChannels() {
 	s.mockFIFOScheduler.EXPECT().Start()
 	s.scheduler.Start()
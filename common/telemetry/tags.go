@@ -5,6 +5,7 @@ const (
 	ComponentQueueArchival   = "queue.archival"
 	ComponentQueueMemory     = "queue.memory"
 	ComponentQueueOutbound   = "queue.outbound"
+	ComponentQueueTask       = "queue.task"
 	ComponentQueueTimer      = "queue.timer"
 	ComponentQueueTransfer   = "queue.transfer"
 	ComponentQueueVisibility = "queue.visibility"
@@ -0,0 +1,63 @@
+package wideevents
+
+import "go.opentelemetry.io/otel/log"
+
+// ConflictResolutionEventName is the stable event name for the ConflictResolution wide event, emitted when a
+// cluster resolves a conflict between two history branches of a workflow, e.g. after a split brain, so application
+// owners can audit which events of the losing branch were reapplied or discarded.
+const ConflictResolutionEventName = "conflict_resolution"
+
+type (
+	ConflictResolutionPayload struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		// Type is how the conflict was resolved, e.g. Rebuild when the current branch lost.
+		Type string
+		// Last write version and last event ID of the winning and losing branches.
+		WinningBranchVersion     int64
+		WinningBranchLastEventID int64
+		LosingBranchVersion      int64
+		LosingBranchLastEventID  int64
+		// LowestCommonAncestorEventID is the last event shared by both branches.
+		LowestCommonAncestorEventID int64
+		// ReappliedEvents are the events of the losing branch reapplied, or sent to the active cluster to be reapplied,
+		// to the current run.
+		ReappliedEvents []ConflictResolutionEvent
+		// OmittedReappliedEventCount is the number of reapplied events not listed in ReappliedEvents.
+		OmittedReappliedEventCount int64
+		DiscardedEventCount        int64
+	}
+
+	ConflictResolutionEvent struct {
+		EventID   int64  `json:"event_id"`
+		Version   int64  `json:"version"`
+		EventType string `json:"event_type"`
+	}
+)
+
+func (p ConflictResolutionPayload) EventName() string {
+	return ConflictResolutionEventName
+}
+
+func (p ConflictResolutionPayload) Attributes() []log.KeyValue {
+	attrs := []log.KeyValue{
+		log.String("namespace_id", p.NamespaceID),
+		log.String("workflow_id", p.WorkflowID),
+		log.String("run_id", p.RunID),
+		log.String("type", p.Type),
+		log.Int64("winning_branch_version", p.WinningBranchVersion),
+		log.Int64("winning_branch_last_event_id", p.WinningBranchLastEventID),
+		log.Int64("losing_branch_version", p.LosingBranchVersion),
+		log.Int64("losing_branch_last_event_id", p.LosingBranchLastEventID),
+		log.Int64("lowest_common_ancestor_event_id", p.LowestCommonAncestorEventID),
+		log.Int64("discarded_event_count", p.DiscardedEventCount),
+	}
+	if len(p.ReappliedEvents) > 0 {
+		attrs = append(attrs, jsonAttr("reapplied_events", p.ReappliedEvents))
+	}
+	if p.OmittedReappliedEventCount > 0 {
+		attrs = append(attrs, log.Int64("omitted_reapplied_event_count", p.OmittedReappliedEventCount))
+	}
+	return attrs
+}
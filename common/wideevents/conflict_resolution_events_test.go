@@ -0,0 +1,48 @@
+package wideevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictResolutionEventName(t *testing.T) {
+	require.Equal(t, "conflict_resolution", ConflictResolutionPayload{}.EventName())
+}
+
+func TestConflictResolutionEncode(t *testing.T) {
+	p := ConflictResolutionPayload{
+		NamespaceID:                 "ns-id",
+		WorkflowID:                  "wf-id",
+		RunID:                       "run-id",
+		Type:                        "Backfill",
+		WinningBranchVersion:        2,
+		WinningBranchLastEventID:    12,
+		LosingBranchVersion:         1,
+		LosingBranchLastEventID:     11,
+		LowestCommonAncestorEventID: 9,
+		ReappliedEvents: []ConflictResolutionEvent{
+			{EventID: 10, Version: 1, EventType: "WorkflowExecutionSignaled"},
+		},
+		OmittedReappliedEventCount: 3,
+		DiscardedEventCount:        1,
+	}
+	require.Equal(t, map[string]any{
+		"namespace_id":                    "ns-id",
+		"workflow_id":                     "wf-id",
+		"run_id":                          "run-id",
+		"type":                            "Backfill",
+		"winning_branch_version":          int64(2),
+		"winning_branch_last_event_id":    int64(12),
+		"losing_branch_version":           int64(1),
+		"losing_branch_last_event_id":     int64(11),
+		"lowest_common_ancestor_event_id": int64(9),
+		"discarded_event_count":           int64(1),
+		"reapplied_events":                `[{"event_id":10,"version":1,"event_type":"WorkflowExecutionSignaled"}]`,
+		"omitted_reapplied_event_count":   int64(3),
+	}, valueMap(p.Attributes()))
+
+	// Empty fields are omitted.
+	require.NotContains(t, attrMap(ConflictResolutionPayload{}.Attributes()), "reapplied_events")
+	require.NotContains(t, attrMap(ConflictResolutionPayload{}.Attributes()), "omitted_reapplied_event_count")
+}
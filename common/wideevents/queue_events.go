@@ -0,0 +1,39 @@
+package wideevents
+
+import "go.opentelemetry.io/otel/log"
+
+// QueueMitigationEventName is the stable event name for the QueueMitigation wide event, which pages
+// operators when a history queue raised an alert that the configured mitigation policy could not resolve
+// on its own.
+const QueueMitigationEventName = "queue_mitigation"
+
+type QueueMitigationPayload struct {
+	// Shard and Category identify the queue that raised the alert.
+	Shard    int32
+	Category string
+	// Alert is the alert type, e.g. ReaderStuck.
+	Alert string
+	// Details describes the attributes of the alert, e.g. the stuck reader and its watermark.
+	Details string
+	// Actions are the mitigation actions that ran for the alert before the page, in order.
+	Actions []string
+}
+
+func (p QueueMitigationPayload) EventName() string {
+	return QueueMitigationEventName
+}
+
+func (p QueueMitigationPayload) Attributes() []log.KeyValue {
+	attrs := []log.KeyValue{
+		log.Int64("shard", int64(p.Shard)),
+		log.String("category", p.Category),
+		log.String("alert", p.Alert),
+	}
+	if p.Details != "" {
+		attrs = append(attrs, log.String("details", p.Details))
+	}
+	if len(p.Actions) > 0 {
+		attrs = append(attrs, jsonAttr("actions", p.Actions))
+	}
+	return attrs
+}
@@ -0,0 +1,31 @@
+package wideevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueMitigationEventName(t *testing.T) {
+	require.Equal(t, "queue_mitigation", QueueMitigationPayload{}.EventName())
+}
+
+func TestQueueMitigationEncode(t *testing.T) {
+	p := QueueMitigationPayload{
+		Shard:    3,
+		Category: "timer",
+		Alert:    "ReaderStuck",
+		Details:  "reader 0 stuck",
+		Actions:  []string{"dlq-stuck-tasks"},
+	}
+	require.Equal(t, map[string]any{
+		"shard":    int64(3),
+		"category": "timer",
+		"alert":    "ReaderStuck",
+		"details":  "reader 0 stuck",
+		"actions":  `["dlq-stuck-tasks"]`,
+	}, valueMap(p.Attributes()))
+
+	// Empty fields are omitted.
+	require.NotContains(t, attrMap(QueueMitigationPayload{Shard: 1}.Attributes()), "details")
+}
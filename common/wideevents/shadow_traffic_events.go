@@ -0,0 +1,49 @@
+package wideevents
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// ShadowTrafficMismatchEventName is the stable event name for the ShadowTrafficMismatch wide event, emitted when a
+// read-only call mirrored to the target cluster of a namespace migration fails or returns a result different from
+// the one served by the source cluster, so operators can tell whether the target is ready for handover.
+const ShadowTrafficMismatchEventName = "shadow_traffic_mismatch"
+
+type ShadowTrafficMismatchPayload struct {
+	Namespace     string
+	API           string
+	TargetCluster string
+	WorkflowID    string
+	RunID         string
+	LocalLatency  time.Duration
+	ShadowLatency time.Duration
+	// Mismatches are the fields whose value differ between the local and the shadow responses.
+	Mismatches []string
+	// Error is the error returned by the target cluster, if any.
+	Error string
+}
+
+func (p ShadowTrafficMismatchPayload) EventName() string {
+	return ShadowTrafficMismatchEventName
+}
+
+func (p ShadowTrafficMismatchPayload) Attributes() []log.KeyValue {
+	attrs := []log.KeyValue{
+		log.String("namespace", p.Namespace),
+		log.String("api", p.API),
+		log.String("target_cluster", p.TargetCluster),
+		log.String("workflow_id", p.WorkflowID),
+		log.String("run_id", p.RunID),
+		log.Int64("local_latency_ms", p.LocalLatency.Milliseconds()),
+		log.Int64("shadow_latency_ms", p.ShadowLatency.Milliseconds()),
+	}
+	if len(p.Mismatches) > 0 {
+		attrs = append(attrs, jsonAttr("mismatches", p.Mismatches))
+	}
+	if p.Error != "" {
+		attrs = append(attrs, log.String("error", p.Error))
+	}
+	return attrs
+}
@@ -0,0 +1,42 @@
+package wideevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowTrafficMismatchEventName(t *testing.T) {
+	require.Equal(t, "shadow_traffic_mismatch", ShadowTrafficMismatchPayload{}.EventName())
+}
+
+func TestShadowTrafficMismatchEncode(t *testing.T) {
+	p := ShadowTrafficMismatchPayload{
+		Namespace:     "ns",
+		API:           "DescribeWorkflowExecution",
+		TargetCluster: "cluster-b",
+		WorkflowID:    "wf-id",
+		RunID:         "run-id",
+		LocalLatency:  12 * time.Millisecond,
+		ShadowLatency: 250 * time.Millisecond,
+		Mismatches:    []string{"status", "history_length"},
+	}
+	require.Equal(t, map[string]any{
+		"namespace":         "ns",
+		"api":               "DescribeWorkflowExecution",
+		"target_cluster":    "cluster-b",
+		"workflow_id":       "wf-id",
+		"run_id":            "run-id",
+		"local_latency_ms":  int64(12),
+		"shadow_latency_ms": int64(250),
+		"mismatches":        `["status","history_length"]`,
+	}, valueMap(p.Attributes()))
+
+	// Empty fields are omitted.
+	attrs := attrMap(ShadowTrafficMismatchPayload{}.Attributes())
+	require.NotContains(t, attrs, "mismatches")
+	require.NotContains(t, attrs, "error")
+
+	require.Equal(t, "not found", valueMap(ShadowTrafficMismatchPayload{Error: "not found"}.Attributes())["error"])
+}
@@ -1,12 +1,16 @@
 package callbacks
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/callbackauth"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
@@ -28,6 +32,7 @@ func HTTPCallerProviderProvider(
 	namespaceRegistry namespace.Registry,
 	rpcFactory common.RPCFactory,
 	httpClientCache *cluster.FrontendHTTPClientCache,
+	dc *dynamicconfig.Collection,
 	logger log.Logger,
 ) (HTTPCallerProvider, error) {
 	localClient, err := rpcFactory.CreateLocalFrontendHTTPClient()
@@ -38,10 +43,26 @@ func HTTPCallerProviderProvider(
 	if err != nil {
 		return nil, err
 	}
-	defaultClient := &http.Client{Transport: defaultTransport}
+	tlsTransports := callbackauth.NewTLSTransportCache(func(tlsConfig *tls.Config) (http.RoundTripper, error) {
+		return common.NewHTTPTransport(tlsConfig)
+	})
+	secrets := callbackauth.NewSecretCache()
+	timeSource := clock.NewRealTimeSource()
 	callbackTokenGenerator := commonnexus.NewCallbackTokenGenerator()
 
-	m := collection.NewOnceMap(func(queuescommon.NamespaceIDAndDestination) HTTPCaller {
+	m := collection.NewOnceMap(func(key queuescommon.NamespaceIDAndDestination) HTTPCaller {
+		defaultClient := &http.Client{
+			Transport: callbackauth.NewRoundTripper(
+				namespace.ID(key.NamespaceID),
+				key.Destination,
+				namespaceRegistry,
+				dc,
+				defaultTransport,
+				tlsTransports,
+				secrets,
+				timeSource,
+			),
+		}
 		return func(r *http.Request) (*http.Response, error) {
 			return routeRequest(r,
 				clusterMetadata,
@@ -6,10 +6,10 @@ import (
 
 	chasmnexus "go.temporal.io/server/chasm/lib/nexusoperation"
 	"go.temporal.io/server/common/backoff"
-	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/shardpool"
 )
 
 var RequestTimeout = dynamicconfig.NewDestinationDurationSetting(
@@ -165,7 +165,7 @@ NexusOperationCancelRequestFailed events. Default true.`,
 )
 
 type Config struct {
-	NumHistoryShards                    int32
+	ShardPools                          *shardpool.Resolver
 	RequestTimeout                      dynamicconfig.DurationPropertyFnWithDestinationFilter
 	MinRequestTimeout                   dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	MaxConcurrentOperations             dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -184,7 +184,7 @@ type Config struct {
 	RetryPolicy                         func() backoff.RetryPolicy
 }
 
-func ConfigProvider(dc *dynamicconfig.Collection, cfg *config.Persistence) *Config {
+func ConfigProvider(dc *dynamicconfig.Collection, shardPools *shardpool.Resolver) *Config {
 	return &Config{
 		RequestTimeout:                      RequestTimeout.Get(dc),
 		MinRequestTimeout:                   MinRequestTimeout.Get(dc),
@@ -210,7 +210,7 @@ func ConfigProvider(dc *dynamicconfig.Collection, cfg *config.Persistence) *Conf
 				backoff.NoInterval,
 			)
 		},
-		NumHistoryShards: cfg.NumHistoryShards,
+		ShardPools: shardPools,
 	}
 }
 
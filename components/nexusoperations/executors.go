@@ -1131,9 +1131,13 @@ func (e taskExecutor) startOnHistoryService(
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", errOpProcessorFailed, err)
 	}
+	shardID, err := res.RoutingKey.ShardID(e.Config.ShardPools)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := e.HistoryClient.StartNexusOperation(ctx, &historyservice.StartNexusOperationRequest{
 		NamespaceId: ns.ID().String(),
-		ShardId:     res.RoutingKey.ShardID(e.Config.NumHistoryShards),
+		ShardId:     shardID,
 		// NOTE: Header is not allowed for system operations.
 		Request: &nexuspb.StartOperationRequest{
 			Service:        args.service,
@@ -1204,9 +1208,13 @@ func (e taskExecutor) cancelOnHistoryService(
 		return fmt.Errorf("%w: %w", errOpProcessorFailed, err)
 	}
 
+	shardID, err := res.RoutingKey.ShardID(e.Config.ShardPools)
+	if err != nil {
+		return err
+	}
 	_, err = e.HistoryClient.CancelNexusOperation(ctx, &historyservice.CancelNexusOperationRequest{
 		NamespaceId: ns.ID().String(),
-		ShardId:     res.RoutingKey.ShardID(e.Config.NumHistoryShards),
+		ShardId:     shardID,
 		Request: &nexuspb.CancelOperationRequest{
 			Service:        args.service,
 			Operation:      args.operation,
@@ -36,6 +36,8 @@ import (
 	"go.temporal.io/server/common/nexus/nexustest"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/shardpool"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/consts"
@@ -1468,7 +1470,7 @@ func TestProcessCancelationTask_SystemEndpoint(t *testing.T) {
 					RequestTimeout:                      dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Hour),
 					MinRequestTimeout:                   dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
 					RecordCancelRequestCompletionEvents: dynamicconfig.GetBoolPropertyFn(true),
-					NumHistoryShards:                    4,
+					ShardPools:                          shardpooltest.NewResolver(4),
 					RetryPolicy: func() backoff.RetryPolicy {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
@@ -1552,8 +1554,8 @@ type mockNexusOperationRoutingKey struct {
 	shardID int32
 }
 
-func (m mockNexusOperationRoutingKey) ShardID(numShards int32) int32 {
-	return m.shardID
+func (m mockNexusOperationRoutingKey) ShardID(*shardpool.Resolver) (int32, error) {
+	return m.shardID, nil
 }
 
 type testOperationProcessorInput struct {
@@ -1894,7 +1896,7 @@ func TestProcessInvocationTask_SystemEndpoint(t *testing.T) {
 					CallbackURLTemplate:     dynamicconfig.GetStringPropertyFn("http://localhost/callback"),
 					UseSystemCallbackURL:    dynamicconfig.GetBoolPropertyFn(true),
 					UseNewFailureWireFormat: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
-					NumHistoryShards:        4,
+					ShardPools:              shardpooltest.NewResolver(4),
 					RetryPolicy: func() backoff.RetryPolicy {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
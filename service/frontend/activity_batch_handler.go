@@ -0,0 +1,159 @@
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
+	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/common/log"
+)
+
+var _ activitybatchservice.ActivityBatchServiceServer = (*ActivityBatchHandler)(nil)
+
+type (
+	// ActivityBatchHandler - gRPC handler interface for the activity batch service
+	ActivityBatchHandler struct {
+		activitybatchservice.UnimplementedActivityBatchServiceServer
+
+		logger          log.Logger
+		activityHandler activity.FrontendHandler
+	}
+)
+
+// NewActivityBatchHandler creates a gRPC handler for the activity batch service
+func NewActivityBatchHandler(
+	logger log.Logger,
+	activityHandler activity.FrontendHandler,
+) *ActivityBatchHandler {
+	return &ActivityBatchHandler{
+		logger:          logger,
+		activityHandler: activityHandler,
+	}
+}
+
+// BatchStartActivityExecutions starts a batch of standalone activity executions in one namespace.
+func (h *ActivityBatchHandler) BatchStartActivityExecutions(
+	ctx context.Context,
+	request *activitybatchservice.BatchStartActivityExecutionsRequest,
+) (_ *activitybatchservice.BatchStartActivityExecutionsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	results, err := h.activityHandler.BatchStartActivityExecutions(ctx, request.GetNamespace(), request.GetRequests())
+	if err != nil {
+		return nil, err
+	}
+	return &activitybatchservice.BatchStartActivityExecutionsResponse{
+		Results: toBatchActivityExecutionResults(results, func(
+			response *workflowservice.StartActivityExecutionResponse,
+			failure *activitybatchservice.BatchActivityExecutionFailure,
+		) *activitybatchservice.BatchStartActivityExecutionsResponse_Result {
+			if failure != nil {
+				return &activitybatchservice.BatchStartActivityExecutionsResponse_Result{
+					Outcome: &activitybatchservice.BatchStartActivityExecutionsResponse_Result_Failure{Failure: failure},
+				}
+			}
+			return &activitybatchservice.BatchStartActivityExecutionsResponse_Result{
+				Outcome: &activitybatchservice.BatchStartActivityExecutionsResponse_Result_Response{Response: response},
+			}
+		}),
+	}, nil
+}
+
+// BatchDescribeActivityExecutions describes a batch of standalone activity executions in one namespace.
+func (h *ActivityBatchHandler) BatchDescribeActivityExecutions(
+	ctx context.Context,
+	request *activitybatchservice.BatchDescribeActivityExecutionsRequest,
+) (_ *activitybatchservice.BatchDescribeActivityExecutionsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	results, err := h.activityHandler.BatchDescribeActivityExecutions(ctx, request.GetNamespace(), request.GetRequests())
+	if err != nil {
+		return nil, err
+	}
+	return &activitybatchservice.BatchDescribeActivityExecutionsResponse{
+		Results: toBatchActivityExecutionResults(results, func(
+			response *workflowservice.DescribeActivityExecutionResponse,
+			failure *activitybatchservice.BatchActivityExecutionFailure,
+		) *activitybatchservice.BatchDescribeActivityExecutionsResponse_Result {
+			if failure != nil {
+				return &activitybatchservice.BatchDescribeActivityExecutionsResponse_Result{
+					Outcome: &activitybatchservice.BatchDescribeActivityExecutionsResponse_Result_Failure{Failure: failure},
+				}
+			}
+			return &activitybatchservice.BatchDescribeActivityExecutionsResponse_Result{
+				Outcome: &activitybatchservice.BatchDescribeActivityExecutionsResponse_Result_Response{Response: response},
+			}
+		}),
+	}, nil
+}
+
+// BatchPollActivityExecutions long-polls for the outcomes of a batch of standalone activity executions in one
+// namespace.
+func (h *ActivityBatchHandler) BatchPollActivityExecutions(
+	ctx context.Context,
+	request *activitybatchservice.BatchPollActivityExecutionsRequest,
+) (_ *activitybatchservice.BatchPollActivityExecutionsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	results, err := h.activityHandler.BatchPollActivityExecutions(ctx, request.GetNamespace(), request.GetRequests())
+	if err != nil {
+		return nil, err
+	}
+	return &activitybatchservice.BatchPollActivityExecutionsResponse{
+		Results: toBatchActivityExecutionResults(results, func(
+			response *workflowservice.PollActivityExecutionResponse,
+			failure *activitybatchservice.BatchActivityExecutionFailure,
+		) *activitybatchservice.BatchPollActivityExecutionsResponse_Result {
+			if failure != nil {
+				return &activitybatchservice.BatchPollActivityExecutionsResponse_Result{
+					Outcome: &activitybatchservice.BatchPollActivityExecutionsResponse_Result_Failure{Failure: failure},
+				}
+			}
+			return &activitybatchservice.BatchPollActivityExecutionsResponse_Result{
+				Outcome: &activitybatchservice.BatchPollActivityExecutionsResponse_Result_Response{Response: response},
+			}
+		}),
+	}, nil
+}
+
+// toBatchActivityExecutionResults converts the results of an activity batch, with item errors converted to their
+// gRPC status.
+func toBatchActivityExecutionResults[Resp any, Result any](
+	results []activity.BatchResult[Resp],
+	newResult func(response Resp, failure *activitybatchservice.BatchActivityExecutionFailure) Result,
+) []Result {
+	converted := make([]Result, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			st := serviceerror.ToStatus(result.Err).Proto()
+			converted[i] = newResult(result.Response, &activitybatchservice.BatchActivityExecutionFailure{
+				Code:    st.GetCode(),
+				Message: st.GetMessage(),
+				Details: st.GetDetails(),
+			})
+			continue
+		}
+		converted[i] = newResult(result.Response, nil)
+	}
+	return converted
+}
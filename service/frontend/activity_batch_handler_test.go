@@ -0,0 +1,67 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
+	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/common/log"
+	"google.golang.org/grpc/codes"
+)
+
+type batchActivityHandler struct {
+	activity.FrontendHandler
+	namespaceName string
+	requests      []*workflowservice.StartActivityExecutionRequest
+}
+
+func (h *batchActivityHandler) BatchStartActivityExecutions(
+	_ context.Context,
+	namespaceName string,
+	reqs []*workflowservice.StartActivityExecutionRequest,
+) ([]activity.BatchResult[*workflowservice.StartActivityExecutionResponse], error) {
+	h.namespaceName = namespaceName
+	h.requests = reqs
+	return []activity.BatchResult[*workflowservice.StartActivityExecutionResponse]{
+		{Response: &workflowservice.StartActivityExecutionResponse{RunId: "run-id", Started: true}},
+		{Err: serviceerror.NewInvalidArgument("invalid activity")},
+	}, nil
+}
+
+func TestBatchStartActivityExecutions(t *testing.T) {
+	activityHandler := &batchActivityHandler{}
+	handler := NewActivityBatchHandler(log.NewTestLogger(), activityHandler)
+	requests := []*workflowservice.StartActivityExecutionRequest{
+		{Namespace: "some name", ActivityId: "activity-1"},
+		{Namespace: "some name", ActivityId: "activity-2"},
+	}
+
+	resp, err := handler.BatchStartActivityExecutions(context.Background(), &activitybatchservice.BatchStartActivityExecutionsRequest{
+		Namespace: "some name",
+		Requests:  requests,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "some name", activityHandler.namespaceName)
+	require.Equal(t, requests, activityHandler.requests)
+	require.Len(t, resp.GetResults(), 2)
+	require.Equal(t, "run-id", resp.GetResults()[0].GetResponse().GetRunId())
+	require.Nil(t, resp.GetResults()[0].GetFailure())
+	require.Nil(t, resp.GetResults()[1].GetResponse())
+	require.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[1].GetFailure().GetCode())
+	require.Equal(t, "invalid activity", resp.GetResults()[1].GetFailure().GetMessage())
+}
+
+func TestBatchActivityExecutions_NamespaceNotSet(t *testing.T) {
+	handler := NewActivityBatchHandler(log.NewTestLogger(), &batchActivityHandler{})
+
+	_, err := handler.BatchStartActivityExecutions(context.Background(), &activitybatchservice.BatchStartActivityExecutionsRequest{})
+	require.ErrorIs(t, err, errNamespaceNotSet)
+	_, err = handler.BatchDescribeActivityExecutions(context.Background(), &activitybatchservice.BatchDescribeActivityExecutionsRequest{})
+	require.ErrorIs(t, err, errNamespaceNotSet)
+	_, err = handler.BatchPollActivityExecutions(context.Background(), &activitybatchservice.BatchPollActivityExecutionsRequest{})
+	require.ErrorIs(t, err, errNamespaceNotSet)
+}
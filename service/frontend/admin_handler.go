@@ -1,12 +1,14 @@
 package frontend
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -15,6 +17,7 @@ import (
 	otellog "go.opentelemetry.io/otel/log"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
@@ -28,11 +31,13 @@ import (
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	healthspb "go.temporal.io/server/api/health/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/activity"
 	chasmscheduler "go.temporal.io/server/chasm/lib/scheduler"
 	schedulerpb "go.temporal.io/server/chasm/lib/scheduler/gen/schedulerpb/v1"
 	"go.temporal.io/server/chasm/lib/workflow"
@@ -46,6 +51,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -56,11 +62,13 @@ import (
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/batcher"
@@ -69,6 +77,7 @@ import (
 	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/grpc/health"
 	grpchealthspb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -76,6 +85,8 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	cloneWorkflowHistoryPageSize            = 100
+	listQueuesPageSize                      = 100
 )
 
 type (
@@ -111,6 +122,8 @@ type (
 		historyHealthChecker       HealthChecker
 		chasmRegistry              *chasm.Registry
 		schedulerClient            schedulerpb.SchedulerServiceClient
+		historyShardPools          *shardpool.Resolver
+		activityHandler            activity.FrontendHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -146,6 +159,8 @@ type (
 		ChasmRegistry                       *chasm.Registry
 		NamespaceDataMerger                 nsreplication.NamespaceDataMerger
 		SchedulerClient                     schedulerpb.SchedulerServiceClient
+		HistoryShardPools                   *shardpool.Resolver
+		ActivityHandler                     activity.FrontendHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -204,6 +219,8 @@ func NewAdminHandler(
 		matchingClient:             args.matchingClient,
 		chasmRegistry:              args.ChasmRegistry,
 		schedulerClient:            args.SchedulerClient,
+		historyShardPools:          args.HistoryShardPools,
+		activityHandler:            args.ActivityHandler,
 	}
 }
 
@@ -385,6 +402,238 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// CloneWorkflowExecution copies the history of a workflow execution into a new run of the target workflow ID. The
+// copied events are imported through the history service, which rebuilds mutable state from them and regenerates
+// the pending timer and transfer tasks of the clone when the import is committed. The history is only truncated at
+// the end of an event batch, and the clone is rejected if it would start a child workflow, or signal or cancel an
+// external workflow, again on behalf of the original execution.
+func (adh *AdminHandler) CloneWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.CloneWorkflowExecutionRequest,
+) (_ *adminservice.CloneWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetTargetWorkflowId() == "" {
+		return nil, errTargetWorkflowIDNotSet
+	}
+	if request.GetLastEventId() < 0 {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid LastEventId %d.", request.GetLastEventId())
+	}
+	targetNamespaceName := request.GetTargetNamespace()
+	if targetNamespaceName == "" {
+		targetNamespaceName = request.GetNamespace()
+	}
+	if targetNamespaceName == request.GetNamespace() && request.GetTargetWorkflowId() == request.Execution.GetWorkflowId() {
+		return nil, errCloneTargetIsSource
+	}
+
+	sourceNamespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	targetNamespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(targetNamespaceName))
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := adh.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: sourceNamespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lastEventID := source.GetNextEventId() - 1
+	if request.GetLastEventId() != 0 {
+		if request.GetLastEventId() > lastEventID {
+			return nil, serviceerror.NewInvalidArgumentf(
+				"LastEventId %d is beyond the last event %d of the execution.", request.GetLastEventId(), lastEventID)
+		}
+		lastEventID = request.GetLastEventId()
+	}
+
+	target, err := adh.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: targetNamespaceEntry.ID().String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: request.GetTargetWorkflowId()},
+	})
+	switch err.(type) {
+	case nil:
+		if target.GetWorkflowStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(
+				fmt.Sprintf("Workflow %s is already running in namespace %s.", request.GetTargetWorkflowId(), targetNamespaceName),
+				"",
+				target.GetExecution().GetRunId(),
+			)
+		}
+	case *serviceerror.NotFound:
+	default:
+		return nil, err
+	}
+
+	cloneExecution := &commonpb.WorkflowExecution{
+		WorkflowId: request.GetTargetWorkflowId(),
+		RunId:      uuid.NewString(),
+	}
+	// All copied events are stamped with the target namespace's failover version so that the clone is owned by the
+	// current cluster, regardless of where the original execution's events were written.
+	cloneVersion := targetNamespaceEntry.FailoverVersion(cloneExecution.GetWorkflowId())
+	cloneVersionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(lastEventID, cloneVersion),
+	})
+	rewriter := &cloneEventRewriter{
+		execution:       cloneExecution,
+		version:         cloneVersion,
+		sourceTaskQueue: source.GetTaskQueue().GetName(),
+		targetTaskQueue: request.GetTargetTaskQueue(),
+	}
+
+	var token []byte
+	var pageToken []byte
+	for {
+		historyResp, err := adh.historyClient.GetWorkflowExecutionRawHistoryV2(ctx, &historyservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: sourceNamespaceID.String(),
+			Request: &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+				NamespaceId:     sourceNamespaceID.String(),
+				Execution:       source.GetExecution(),
+				StartEventId:    common.EmptyEventID,
+				EndEventId:      common.EmptyEventID,
+				MaximumPageSize: cloneWorkflowHistoryPageSize,
+				NextPageToken:   pageToken,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var batches []*commonpb.DataBlob
+		done := false
+		for _, blob := range historyResp.GetResponse().GetHistoryBatches() {
+			events, err := adh.eventSerializer.DeserializeEvents(blob)
+			if err != nil {
+				return nil, err
+			}
+			// Events of a batch are written in one transaction, so the clone may only end at the end of a batch.
+			if len(events) > 0 && events[0].GetEventId() <= lastEventID && events[len(events)-1].GetEventId() > lastEventID {
+				return nil, serviceerror.NewInvalidArgumentf(
+					"LastEventId %d is not the last event of its event batch, the batch ends at event %d.",
+					lastEventID,
+					events[len(events)-1].GetEventId(),
+				)
+			}
+			var cloned []*historypb.HistoryEvent
+			for _, event := range events {
+				if event.GetEventId() > lastEventID {
+					break
+				}
+				cloned = append(cloned, rewriter.rewrite(event))
+			}
+			if len(cloned) > 0 {
+				batch, err := adh.eventSerializer.SerializeEvents(cloned)
+				if err != nil {
+					return nil, err
+				}
+				batches = append(batches, batch)
+			}
+			if len(events) == 0 || events[len(events)-1].GetEventId() >= lastEventID {
+				done = true
+				break
+			}
+		}
+
+		if len(batches) > 0 {
+			importResp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+				NamespaceId:    targetNamespaceEntry.ID().String(),
+				Execution:      cloneExecution,
+				HistoryBatches: batches,
+				VersionHistory: cloneVersionHistory,
+				Token:          token,
+			})
+			if err != nil {
+				return nil, err
+			}
+			token = importResp.GetToken()
+		}
+
+		pageToken = historyResp.GetResponse().GetNextPageToken()
+		if done || len(pageToken) == 0 {
+			break
+		}
+	}
+
+	if err := rewriter.validateNoPendingInitiatedEvents(); err != nil {
+		return nil, err
+	}
+	// Importing without events commits the rebuilt mutable state, which regenerates the clone's pending tasks.
+	if _, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    targetNamespaceEntry.ID().String(),
+		Execution:      cloneExecution,
+		VersionHistory: cloneVersionHistory,
+		Token:          token,
+	}); err != nil {
+		return nil, err
+	}
+
+	adh.logger.Info("Cloned workflow execution.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowID(request.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(source.GetExecution().GetRunId()),
+		tag.NewStringTag("clone-wf-namespace", targetNamespaceName),
+		tag.NewStringTag("clone-wf-id", cloneExecution.GetWorkflowId()),
+		tag.NewStringTag("clone-wf-run-id", cloneExecution.GetRunId()),
+		tag.WorkflowEventID(lastEventID),
+	)
+
+	return &adminservice.CloneWorkflowExecutionResponse{
+		RunId:       cloneExecution.GetRunId(),
+		LastEventId: lastEventID,
+	}, nil
+}
+
+// DryRunResetWorkflowExecution computes the effect of a workflow reset without performing it. The returned result
+// lists the events that would be reapplied to and dropped from the reset run, the activities, timers and child
+// workflows it would have pending, and the child workflows that would be left running without a parent.
+func (adh *AdminHandler) DryRunResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DryRunResetWorkflowExecutionRequest,
+) (_ *adminservice.DryRunResetWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil || request.GetResetRequest() == nil {
+		return nil, errRequestNotSet
+	}
+	resetRequest := request.GetResetRequest()
+	if err := validateExecution(resetRequest.WorkflowExecution); err != nil {
+		return nil, err
+	}
+	enums.SetDefaultResetReapplyType(&resetRequest.ResetReapplyType)
+	if _, validType := enumspb.ResetReapplyType_name[int32(resetRequest.GetResetReapplyType())]; !validType {
+		return nil, serviceerror.NewInvalidArgumentf("unknown reset reapply type: %v", resetRequest.GetResetReapplyType())
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(resetRequest.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId:  namespaceID.String(),
+		ResetRequest: resetRequest,
+		DryRun:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DryRunResetWorkflowExecutionResponse{
+		Result: resp.GetDryRunResult(),
+	}, nil
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -455,7 +704,10 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		return nil, err
 	}
 
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
+	shardID, err := adh.historyShardPools.ShardID(namespaceID.String(), request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
 	shardIDStr := convert.Int32ToString(shardID)
 
 	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
@@ -487,6 +739,86 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}, nil
 }
 
+// DescribeMutableStateAtEvent returns the mutable state of a workflow execution rebuilt in memory up to an event
+func (adh *AdminHandler) DescribeMutableStateAtEvent(ctx context.Context, request *adminservice.DescribeMutableStateAtEventRequest) (_ *adminservice.DescribeMutableStateAtEventResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetEventId() <= 0 {
+		return nil, errInvalidEventID
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := adh.historyShardPools.ShardID(namespaceID.String(), request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	shardIDStr := convert.Int32ToString(shardID)
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	historyHost, err := resolver.Lookup(shardIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ArchetypeId: chasm.WorkflowArchetypeID,
+		AtEventId:   request.GetEventId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeMutableStateAtEventResponse{
+		ShardId:      shardIDStr,
+		HistoryAddr:  historyHost.GetAddress(),
+		MutableState: historyResponse.GetAtEventMutableState(),
+	}, nil
+}
+
+// ListWorkflowConflictResolutions returns the most recent conflicts between history branches of a workflow execution
+// resolved by this cluster
+func (adh *AdminHandler) ListWorkflowConflictResolutions(ctx context.Context, request *adminservice.ListWorkflowConflictResolutionsRequest) (_ *adminservice.ListWorkflowConflictResolutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ArchetypeId: chasm.WorkflowArchetypeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListWorkflowConflictResolutionsResponse{
+		Records: historyResponse.GetDatabaseMutableState().GetExecutionInfo().GetConflictResolutionRecords(),
+	}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.RemoveTaskRequest) (_ *adminservice.RemoveTaskResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -503,6 +835,46 @@ func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.R
 	return &adminservice.RemoveTaskResponse{}, err
 }
 
+// GetTaskTrace returns the recorded lifecycle of a sampled history task
+func (adh *AdminHandler) GetTaskTrace(ctx context.Context, request *adminservice.GetTaskTraceRequest) (_ *adminservice.GetTaskTraceResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateHistoryShardID(request.GetShardId()); err != nil {
+		return nil, err
+	}
+	resp, err := adh.historyClient.GetTaskTrace(ctx, &historyservice.GetTaskTraceRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+		TaskId:   request.GetTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetTaskTraceResponse{Trace: resp.GetTrace()}, nil
+}
+
+// ListQueueMitigations returns the mitigations recently applied by the history queues of a shard
+func (adh *AdminHandler) ListQueueMitigations(ctx context.Context, request *adminservice.ListQueueMitigationsRequest) (_ *adminservice.ListQueueMitigationsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateHistoryShardID(request.GetShardId()); err != nil {
+		return nil, err
+	}
+	resp, err := adh.historyClient.ListQueueMitigations(ctx, &historyservice.ListQueueMitigationsRequest{
+		ShardId: request.GetShardId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListQueueMitigationsResponse{Mitigations: resp.GetMitigations()}, nil
+}
+
 // GetShard returns information about the internal states of a shard
 func (adh *AdminHandler) GetShard(ctx context.Context, request *adminservice.GetShardRequest) (_ *adminservice.GetShardResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -527,6 +899,172 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// MoveHistoryShard pins a history shard to the given history host
+func (adh *AdminHandler) MoveHistoryShard(ctx context.Context, request *adminservice.MoveHistoryShardRequest) (_ *adminservice.MoveHistoryShardResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateHistoryShardID(request.GetShardId()); err != nil {
+		return nil, err
+	}
+	if request.GetTargetHost() == "" {
+		return nil, errTargetHostNotSet
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(resolver.Members(), func(host membership.HostInfo) bool {
+		return host.GetAddress() == request.GetTargetHost()
+	}) {
+		return nil, serviceerror.NewInvalidArgumentf(errUnknownHistoryHostMessage, request.GetTargetHost())
+	}
+
+	if _, err := adh.historyClient.PinShard(ctx, &historyservice.PinShardRequest{
+		HostAddress: request.GetTargetHost(),
+		ShardId:     request.GetShardId(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.MoveHistoryShardResponse{}, nil
+}
+
+// UnpinHistoryShard removes the pin of a history shard
+func (adh *AdminHandler) UnpinHistoryShard(ctx context.Context, request *adminservice.UnpinHistoryShardRequest) (_ *adminservice.UnpinHistoryShardResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateHistoryShardID(request.GetShardId()); err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.UnpinShard(ctx, &historyservice.UnpinShardRequest{
+		ShardId: request.GetShardId(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpinHistoryShardResponse{}, nil
+}
+
+// DescribeTaskScheduler describes the host level task schedulers of history hosts
+func (adh *AdminHandler) DescribeTaskScheduler(ctx context.Context, request *adminservice.DescribeTaskSchedulerRequest) (_ *adminservice.DescribeTaskSchedulerResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	addresses, err := adh.historyHostAddresses(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.DescribeTaskSchedulerResponse{}
+	for _, address := range addresses {
+		hostResp, err := adh.historyClient.DescribeTaskScheduler(ctx, &historyservice.DescribeTaskSchedulerRequest{
+			HostAddress: address,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Hosts = append(resp.Hosts, &adminservice.DescribeTaskSchedulerResponse_Host{
+			Address:                  address,
+			Schedulers:               hostResp.GetSchedulers(),
+			NamespaceWeightOverrides: hostResp.GetNamespaceWeightOverrides(),
+		})
+	}
+	return resp, nil
+}
+
+// UpdateTaskSchedulerNamespaceWeight sets or removes the namespace weight override of the task schedulers of all
+// history hosts
+func (adh *AdminHandler) UpdateTaskSchedulerNamespaceWeight(ctx context.Context, request *adminservice.UpdateTaskSchedulerNamespaceWeightRequest) (_ *adminservice.UpdateTaskSchedulerNamespaceWeightResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	var expireTime *timestamppb.Timestamp
+	if !request.GetRemove() {
+		if request.GetMultiplier() <= 0 {
+			return nil, errInvalidWeightMultiplier
+		}
+		if request.GetDuration().AsDuration() <= 0 {
+			return nil, errInvalidWeightDuration
+		}
+		expireTime = timestamppb.New(time.Now().Add(request.GetDuration().AsDuration()))
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := adh.historyHostAddresses("")
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the override to as many hosts as possible, a host that is unavailable during an incident should not
+	// prevent the others from being tuned.
+	resp := &adminservice.UpdateTaskSchedulerNamespaceWeightResponse{
+		ExpireTime: expireTime,
+	}
+	var failedAddresses []string
+	var errs []error
+	for _, address := range addresses {
+		if _, err := adh.historyClient.UpdateTaskSchedulerNamespaceWeight(ctx, &historyservice.UpdateTaskSchedulerNamespaceWeightRequest{
+			HostAddress: address,
+			NamespaceId: namespaceID.String(),
+			Multiplier:  request.GetMultiplier(),
+			ExpireTime:  expireTime,
+			Remove:      request.GetRemove(),
+		}); err != nil {
+			failedAddresses = append(failedAddresses, address)
+			errs = append(errs, err)
+			continue
+		}
+		resp.Hosts = append(resp.Hosts, address)
+	}
+	if len(errs) > 0 {
+		return nil, serviceerror.NewUnavailablef(errUpdateHistoryHostsMessage, failedAddresses, errors.Join(errs...))
+	}
+	return resp, nil
+}
+
+// historyHostAddresses returns the given history host address if it is a member of the cluster, or the addresses of
+// all history hosts if it is empty.
+func (adh *AdminHandler) historyHostAddresses(hostAddress string) ([]string, error) {
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	for _, host := range resolver.Members() {
+		addresses = append(addresses, host.GetAddress())
+	}
+	if hostAddress == "" {
+		slices.Sort(addresses)
+		return addresses, nil
+	}
+	if !slices.Contains(addresses, hostAddress) {
+		return nil, serviceerror.NewInvalidArgumentf(errUnknownHistoryHostMessage, hostAddress)
+	}
+	return []string{hostAddress}, nil
+}
+
+func (adh *AdminHandler) validateHistoryShardID(shardID int32) error {
+	if shardID <= 0 || shardID > adh.numberOfHistoryShards {
+		return serviceerror.NewInvalidArgumentf(errInvalidShardIDMessage, shardID, adh.numberOfHistoryShards)
+	}
+	return nil
+}
+
 func (adh *AdminHandler) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -603,10 +1141,13 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 	}
 
 	return &adminservice.DescribeHistoryHostResponse{
-		ShardsNumber:   resp.GetShardsNumber(),
-		ShardIds:       resp.GetShardIds(),
-		NamespaceCache: resp.GetNamespaceCache(),
-		Address:        resp.GetAddress(),
+		ShardsNumber:    resp.GetShardsNumber(),
+		ShardIds:        resp.GetShardIds(),
+		NamespaceCache:  resp.GetNamespaceCache(),
+		Address:         resp.GetAddress(),
+		PinnedShardIds:  resp.GetPinnedShardIds(),
+		Load:            resp.GetLoad(),
+		WorkflowShardId: resp.GetWorkflowShardId(),
 	}, err
 }
 
@@ -1105,6 +1646,244 @@ func (adh *AdminHandler) GetDLQReplicationMessages(ctx context.Context, request
 	return &adminservice.GetDLQReplicationMessagesResponse{ReplicationTasks: resp.GetReplicationTasks()}, nil
 }
 
+// GetReplicationLag returns the replication lag of each remote cluster and namespace, and whether it's safe to fail
+// over to the remote clusters.
+func (adh *AdminHandler) GetReplicationLag(ctx context.Context, request *adminservice.GetReplicationLagRequest) (_ *adminservice.GetReplicationLagResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	allClusterInfo := adh.clusterMetadata.GetAllClusterInfo()
+	remoteClusters := request.GetRemoteClusters()
+	if len(remoteClusters) == 0 {
+		for clusterName, clusterInfo := range allClusterInfo {
+			if clusterName != currentClusterName && clusterInfo.Enabled {
+				remoteClusters = append(remoteClusters, clusterName)
+			}
+		}
+	}
+	for _, clusterName := range remoteClusters {
+		if _, ok := allClusterInfo[clusterName]; !ok || clusterName == currentClusterName {
+			return nil, serviceerror.NewInvalidArgumentf("Unknown remote cluster: %v.", clusterName)
+		}
+	}
+	namespaceNames := make(map[string]string, len(request.GetNamespaces()))
+	for _, name := range request.GetNamespaces() {
+		namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(name))
+		if err != nil {
+			return nil, err
+		}
+		namespaceNames[namespaceID.String()] = name
+	}
+
+	statusResp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters:          remoteClusters,
+		IncludeNamespaceBacklog: true,
+		MaxScannedTasks:         request.GetMaxScannedTasks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.GetReplicationLagResponse{SafeToFailover: true}
+	for _, clusterName := range remoteClusters {
+		clusterLag := newClusterReplicationLag(clusterName, statusResp.GetShards(), namespaceNames)
+		clusterLag.DlqMessageCount, err = adh.getReplicationDLQMessageCount(ctx, currentClusterName, clusterName, allClusterInfo[clusterName])
+		if err != nil {
+			return nil, err
+		}
+		for _, nsLag := range clusterLag.Namespaces {
+			if nsLag.Namespace == "" {
+				if name, err := adh.namespaceRegistry.GetNamespaceName(namespace.ID(nsLag.NamespaceId)); err == nil {
+					nsLag.Namespace = name.String()
+				}
+			}
+		}
+		resp.UnsafeReasons = append(
+			resp.UnsafeReasons,
+			replicationLagUnsafeReasons(clusterLag, request.GetMaxLag().AsDuration(), len(namespaceNames) != 0)...,
+		)
+		resp.Clusters = append(resp.Clusters, clusterLag)
+	}
+	slices.SortFunc(resp.Clusters, func(a, b *adminservice.ClusterReplicationLag) int {
+		return strings.Compare(a.ClusterName, b.ClusterName)
+	})
+	resp.SafeToFailover = len(resp.UnsafeReasons) == 0
+	return resp, nil
+}
+
+// newClusterReplicationLag aggregates the replication status of the shards for a remote cluster. If namespaceNames
+// isn't empty, only the lag of these namespaces is reported.
+func newClusterReplicationLag(
+	clusterName string,
+	shards []*historyservice.ShardReplicationStatus,
+	namespaceNames map[string]string,
+) *adminservice.ClusterReplicationLag {
+	clusterLag := &adminservice.ClusterReplicationLag{ClusterName: clusterName}
+	namespaceLags := make(map[string]*adminservice.NamespaceReplicationLag)
+	for namespaceID, name := range namespaceNames {
+		namespaceLags[namespaceID] = &adminservice.NamespaceReplicationLag{NamespaceId: namespaceID, Namespace: name}
+	}
+	var lag time.Duration
+	for _, shard := range shards {
+		status, ok := shard.GetRemoteClusters()[clusterName]
+		if !ok {
+			continue
+		}
+		clusterLag.TaskIdLag = max(clusterLag.TaskIdLag, shard.GetMaxReplicationTaskId()-status.GetAckedTaskId())
+		clusterLag.BacklogTruncated = clusterLag.BacklogTruncated || status.GetBacklogTruncated()
+		if status.GetStreamStuck() {
+			clusterLag.StuckShardIds = append(clusterLag.StuckShardIds, shard.GetShardId())
+		}
+		for _, backlog := range status.GetNamespaceBacklogs() {
+			backlogLag := max(shard.GetShardLocalTime().AsTime().Sub(backlog.GetOldestTaskVisibilityTime().AsTime()), 0)
+			clusterLag.BacklogTaskCount += backlog.GetTaskCount()
+			lag = max(lag, backlogLag)
+
+			namespaceLag, ok := namespaceLags[backlog.GetNamespaceId()]
+			if !ok {
+				if len(namespaceNames) != 0 {
+					continue
+				}
+				namespaceLag = &adminservice.NamespaceReplicationLag{NamespaceId: backlog.GetNamespaceId()}
+				namespaceLags[backlog.GetNamespaceId()] = namespaceLag
+			}
+			namespaceLag.BacklogTaskCount += backlog.GetTaskCount()
+			if namespaceLag.OldestUnreplicatedExecution == nil || backlogLag > namespaceLag.GetLag().AsDuration() {
+				namespaceLag.Lag = durationpb.New(backlogLag)
+				namespaceLag.OldestUnreplicatedExecution = backlog.GetOldestExecution()
+			}
+		}
+	}
+	clusterLag.TaskIdLag = max(clusterLag.TaskIdLag, 0)
+	clusterLag.Lag = durationpb.New(lag)
+	slices.Sort(clusterLag.StuckShardIds)
+	for _, namespaceLag := range namespaceLags {
+		if namespaceLag.Lag == nil {
+			namespaceLag.Lag = durationpb.New(0)
+		}
+		clusterLag.Namespaces = append(clusterLag.Namespaces, namespaceLag)
+	}
+	// Most lagging namespaces first.
+	slices.SortFunc(clusterLag.Namespaces, func(a, b *adminservice.NamespaceReplicationLag) int {
+		if c := cmp.Compare(b.GetLag().AsDuration(), a.GetLag().AsDuration()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.NamespaceId, b.NamespaceId)
+	})
+	return clusterLag
+}
+
+// replicationLagUnsafeReasons returns why it's not safe to fail over to a remote cluster. With a zero maxLag, there
+// must be no unreplicated task. If perNamespace is set, the lag of the reported namespaces is checked instead of the
+// lag of the whole cluster.
+func replicationLagUnsafeReasons(
+	clusterLag *adminservice.ClusterReplicationLag,
+	maxLag time.Duration,
+	perNamespace bool,
+) []string {
+	exceedsMaxLag := func(backlogTaskCount int64, lag time.Duration) bool {
+		return backlogTaskCount > 0 && (maxLag == 0 || lag > maxLag)
+	}
+
+	var reasons []string
+	if perNamespace {
+		for _, namespaceLag := range clusterLag.Namespaces {
+			if exceedsMaxLag(namespaceLag.BacklogTaskCount, namespaceLag.GetLag().AsDuration()) {
+				reasons = append(reasons, fmt.Sprintf(
+					"cluster %v: namespace %v has %v unreplicated tasks, the oldest one is %v old",
+					clusterLag.ClusterName, namespaceLag.Namespace, namespaceLag.BacklogTaskCount, namespaceLag.GetLag().AsDuration(),
+				))
+			}
+		}
+	} else if exceedsMaxLag(clusterLag.BacklogTaskCount, clusterLag.GetLag().AsDuration()) {
+		reasons = append(reasons, fmt.Sprintf(
+			"cluster %v: %v unreplicated tasks, the oldest one is %v old",
+			clusterLag.ClusterName, clusterLag.BacklogTaskCount, clusterLag.GetLag().AsDuration(),
+		))
+	}
+	if len(clusterLag.StuckShardIds) != 0 {
+		reasons = append(reasons, fmt.Sprintf(
+			"cluster %v: replication streams of shards %v aren't making progress",
+			clusterLag.ClusterName, clusterLag.StuckShardIds,
+		))
+	}
+	if clusterLag.DlqMessageCount != 0 {
+		reasons = append(reasons, fmt.Sprintf(
+			"cluster %v: %v replication tasks in the DLQ",
+			clusterLag.ClusterName, clusterLag.DlqMessageCount,
+		))
+	}
+	return reasons
+}
+
+// getReplicationDLQMessageCount returns the number of replication tasks from the source cluster that the target
+// cluster failed to apply. These tasks are in the DLQ of the target cluster, so it's read through the admin API of
+// the target cluster, from the DLQ v2 queue of the source and target clusters, or from the DLQ v1 of each target
+// shard if HistoryReplicationDLQV2 is disabled.
+func (adh *AdminHandler) getReplicationDLQMessageCount(
+	ctx context.Context,
+	sourceClusterName string,
+	targetClusterName string,
+	targetClusterInfo cluster.ClusterInformation,
+) (int64, error) {
+	adminClient := adh.clientFactory.NewRemoteAdminClientWithTimeout(
+		targetClusterInfo.RPCAddress,
+		admin.DefaultTimeout,
+		admin.DefaultLargeTimeout,
+	)
+
+	if !adh.config.HistoryReplicationDLQV2() {
+		var count int64
+		for shardID := int32(1); shardID <= targetClusterInfo.ShardCount; shardID++ {
+			var nextPageToken []byte
+			for {
+				resp, err := adminClient.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+					Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+					ShardId:               shardID,
+					SourceCluster:         sourceClusterName,
+					InclusiveEndMessageId: common.EndMessageID,
+					MaximumPageSize:       primitives.ReadDLQMessagesPageSize,
+					NextPageToken:         nextPageToken,
+				})
+				if err != nil {
+					return 0, err
+				}
+				count += int64(len(resp.GetReplicationTasksInfo()))
+				nextPageToken = resp.GetNextPageToken()
+				if len(nextPageToken) == 0 {
+					break
+				}
+			}
+		}
+		return count, nil
+	}
+
+	queueName := persistence.GetHistoryTaskQueueName(tasks.CategoryReplication.ID(), sourceClusterName, targetClusterName)
+	var nextPageToken []byte
+	for {
+		resp, err := adminClient.ListQueues(ctx, &adminservice.ListQueuesRequest{
+			QueueType:     int32(persistence.QueueTypeHistoryDLQ),
+			PageSize:      listQueuesPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, queue := range resp.GetQueues() {
+			if queue.GetQueueName() == queueName {
+				return queue.GetMessageCount(), nil
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return 0, nil
+		}
+	}
+}
+
 // ReapplyEvents applies stale events to the current workflow and the current run
 func (adh *AdminHandler) ReapplyEvents(ctx context.Context, request *adminservice.ReapplyEventsRequest) (_ *adminservice.ReapplyEventsResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1851,10 +2630,64 @@ func (adh *AdminHandler) GetNamespace(ctx context.Context, request *adminservice
 		FailoverVersion:   resp.Namespace.GetFailoverVersion(),
 		IsGlobalNamespace: resp.IsGlobalNamespace,
 		FailoverHistory:   convertFailoverHistoryToReplicationProto(resp.Namespace.GetReplicationConfig().GetFailoverHistory()),
+		ReplicationFilter: replicationConfig.GetReplicationFilter(),
 	}
 	return nsResponse, nil
 }
 
+// UpdateNamespaceReplicationFilter sets the filter restricting the workflows of a global namespace which are replicated
+// from the current cluster to the other clusters.
+func (adh *AdminHandler) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+) (_ *adminservice.UpdateNamespaceReplicationFilterResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	for _, searchAttributeFilter := range request.GetReplicationFilter().GetSearchAttributes() {
+		if searchAttributeFilter.GetName() == "" || len(searchAttributeFilter.GetValues()) == 0 {
+			return nil, serviceerror.NewInvalidArgument("Search attribute replication filters must have a name and values.")
+		}
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	getResponse, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !getResponse.IsGlobalNamespace {
+		return nil, serviceerror.NewInvalidArgumentf("Namespace %v is not a global namespace.", request.GetNamespace())
+	}
+
+	replicationFilter := request.GetReplicationFilter()
+	if len(replicationFilter.GetWorkflowTypes()) == 0 && len(replicationFilter.GetSearchAttributes()) == 0 {
+		replicationFilter = nil
+	}
+	// The filter is local to the cluster and not replicated, so the config version is kept. Bumping it would make this
+	// cluster drop the next config update replicated from the other clusters.
+	getResponse.Namespace.ReplicationConfig.ReplicationFilter = replicationFilter
+	if err := adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           getResponse.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateNamespaceReplicationFilterResponse{}, nil
+}
+
 func (adh *AdminHandler) GetDLQTasks(
 	ctx context.Context,
 	request *adminservice.GetDLQTasksRequest,
@@ -1863,13 +2696,15 @@ func (adh *AdminHandler) GetDLQTasks(
 		DlqKey:        request.DlqKey,
 		PageSize:      request.PageSize,
 		NextPageToken: request.NextPageToken,
+		Filter:        request.Filter,
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &adminservice.GetDLQTasksResponse{
-		DlqTasks:      response.DlqTasks,
-		NextPageToken: response.NextPageToken,
+		DlqTasks:             response.DlqTasks,
+		NextPageToken:        response.NextPageToken,
+		LastReadTaskMetadata: response.LastReadTaskMetadata,
 	}, nil
 }
 
@@ -1895,6 +2730,8 @@ func (adh *AdminHandler) PurgeDLQTasks(
 				TargetCluster:  request.DlqKey.TargetCluster,
 			},
 			MaxMessageID: request.InclusiveMaxTaskMetadata.MessageId,
+			Filter:       dlqTaskFilterFromProto(request.Filter),
+			DryRun:       request.DryRun,
 		},
 	})
 	if err != nil {
@@ -1929,8 +2766,12 @@ func (adh *AdminHandler) MergeDLQTasks(ctx context.Context, request *adminservic
 				SourceCluster:  request.DlqKey.SourceCluster,
 				TargetCluster:  request.DlqKey.TargetCluster,
 			},
-			MaxMessageID: request.InclusiveMaxTaskMetadata.MessageId,
-			BatchSize:    int(request.BatchSize), // Let the workflow code validate and set the default value if needed.
+			MaxMessageID:        request.InclusiveMaxTaskMetadata.MessageId,
+			BatchSize:           int(request.BatchSize), // Let the workflow code validate and set the default value if needed.
+			Filter:              dlqTaskFilterFromProto(request.Filter),
+			SkipClosedWorkflows: request.SkipClosedWorkflows,
+			PurgeUnmatched:      request.PurgeUnmatched,
+			DryRun:              request.DryRun,
 		},
 	})
 	if err != nil {
@@ -1997,9 +2838,53 @@ func (adh *AdminHandler) DescribeDLQJob(ctx context.Context, request *adminservi
 		MessagesProcessed:      queryResponse.NumberOfMessagesProcessed,
 		StartTime:              execution.WorkflowExecutionInfo.StartTime,
 		EndTime:                execution.WorkflowExecutionInfo.CloseTime,
+		DryRun:                 queryResponse.DryRun,
+		MessagesMatched:        queryResponse.NumberOfMessagesMatched,
+		MessagesMerged:         queryResponse.NumberOfMessagesMerged,
+		MessagesPurged:         queryResponse.NumberOfMessagesPurged,
+		TaskOutcomes:           dlqTaskOutcomesToProto(queryResponse.TaskOutcomes),
 	}, nil
 }
 
+func dlqTaskFilterFromProto(filter *commonspb.HistoryDLQTaskFilter) *dlq.TaskFilter {
+	if filter == nil {
+		return nil
+	}
+	return &dlq.TaskFilter{
+		NamespaceIDs:     filter.NamespaceIds,
+		WorkflowIDPrefix: filter.WorkflowIdPrefix,
+		TaskTypes:        filter.TaskTypes,
+		FailureContains:  filter.FailureContains,
+	}
+}
+
+func dlqTaskOutcomesToProto(outcomes []dlq.TaskOutcome) []*adminservice.DLQTaskOutcome {
+	if len(outcomes) == 0 {
+		return nil
+	}
+	result := make([]*adminservice.DLQTaskOutcome, len(outcomes))
+	for i, outcome := range outcomes {
+		var outcomeType enumsspb.DLQTaskOutcome
+		switch outcome.Outcome {
+		case dlq.TaskOutcomeMerged:
+			outcomeType = enumsspb.DLQ_TASK_OUTCOME_MERGED
+		case dlq.TaskOutcomePurged:
+			outcomeType = enumsspb.DLQ_TASK_OUTCOME_PURGED
+		}
+		result[i] = &adminservice.DLQTaskOutcome{
+			MessageId:   outcome.MessageID,
+			ShardId:     outcome.ShardID,
+			NamespaceId: outcome.NamespaceID,
+			WorkflowId:  outcome.WorkflowID,
+			RunId:       outcome.RunID,
+			TaskType:    outcome.TaskType,
+			Outcome:     outcomeType,
+			Reason:      outcome.Reason,
+		}
+	}
+	return result
+}
+
 func (adh *AdminHandler) CancelDLQJob(ctx context.Context, request *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	jt := adminservice.DLQJobToken{}
 	err := jt.Unmarshal([]byte(request.JobToken))
@@ -2341,3 +3226,54 @@ func (adh *AdminHandler) migrateScheduleToWorkflow(
 	}
 	return &adminservice.MigrateScheduleResponse{}, nil
 }
+
+// StartChainedActivityExecution starts a standalone activity execution that is followed by a chain of activities.
+func (adh *AdminHandler) StartChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.StartChainedActivityExecutionRequest,
+) (_ *adminservice.StartChainedActivityExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateActivityExecutionNamespace(request.GetRequest().GetNamespace(), request.GetNamespace()); err != nil {
+		return nil, err
+	}
+	response, err := adh.activityHandler.StartChainedActivityExecution(ctx, request.GetRequest(), request.GetFollowUps())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartChainedActivityExecutionResponse{Response: response}, nil
+}
+
+// DescribeChainedActivityExecution describes a standalone activity execution together with the progress of its
+// chain.
+func (adh *AdminHandler) DescribeChainedActivityExecution(
+	ctx context.Context,
+	request *adminservice.DescribeChainedActivityExecutionRequest,
+) (_ *adminservice.DescribeChainedActivityExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateActivityExecutionNamespace(request.GetRequest().GetNamespace(), request.GetNamespace()); err != nil {
+		return nil, err
+	}
+	response, err := adh.activityHandler.DescribeChainedActivityExecution(ctx, request.GetRequest())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeChainedActivityExecutionResponse{
+		Response: response.GetFrontendResponse(),
+		Chain:    response.GetChain(),
+	}, nil
+}
+
+func validateActivityExecutionNamespace(requestNamespace, namespaceName string) error {
+	if requestNamespace != namespaceName {
+		return serviceerror.NewInvalidArgumentf("request namespace %q does not match namespace %q", requestNamespace, namespaceName)
+	}
+	return nil
+}
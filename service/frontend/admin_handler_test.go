@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -22,22 +23,28 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	activityspb "go.temporal.io/server/api/activity/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
 	chasmscheduler "go.temporal.io/server/chasm/lib/scheduler"
 	schedulerpb "go.temporal.io/server/chasm/lib/scheduler/gen/schedulerpb/v1"
 	chasmworkflow "go.temporal.io/server/chasm/lib/workflow"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -56,6 +63,7 @@ import (
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mockapi/operatorservicemock/v1"
@@ -72,6 +80,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -205,6 +215,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		chasmRegistry,
 		nsreplication.NewNoopDataMerger(),
 		nil, // schedulerClient - not needed for most admin handler tests
+		shardpooltest.NewResolver(persistenceConfig.NumHistoryShards),
+		nil, // activityHandler - not needed for most admin handler tests
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1190,6 +1202,164 @@ func (s *adminHandlerSuite) TestGetNamespace_EmptyRequest() {
 	s.Equal(errRequestNotSet, err)
 }
 
+func (s *adminHandlerSuite) TestUpdateNamespaceReplicationFilter() {
+	namespaceName := "some name"
+	replicationFilter := &persistencespb.ReplicationFilter{
+		WorkflowTypes: []string{"some-type"},
+		SearchAttributes: []*persistencespb.SearchAttributeReplicationFilter{
+			{Name: "CustomKeywordField", Values: []string{"some-value"}},
+		},
+	}
+	nsResponse := &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "some id",
+				Name: namespaceName,
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []string{
+					cluster.TestCurrentClusterName,
+					cluster.TestAlternativeClusterName,
+				},
+			},
+			ConfigVersion: 3,
+		},
+		IsGlobalNamespace: true,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 7,
+	}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: namespaceName,
+	}).Return(nsResponse, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(7), request.NotificationVersion)
+			s.True(request.IsGlobalNamespace)
+			s.Equal(int64(3), request.Namespace.GetConfigVersion())
+			s.Equal(replicationFilter, request.Namespace.GetReplicationConfig().GetReplicationFilter())
+			return nil
+		},
+	)
+
+	_, err := s.handler.UpdateNamespaceReplicationFilter(context.Background(), &adminservice.UpdateNamespaceReplicationFilterRequest{
+		Namespace:         namespaceName,
+		ReplicationFilter: replicationFilter,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUpdateNamespaceReplicationFilter_LocalNamespace() {
+	namespaceName := "some name"
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: namespaceName,
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:              &persistencespb.NamespaceInfo{Name: namespaceName},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil)
+
+	_, err := s.handler.UpdateNamespaceReplicationFilter(context.Background(), &adminservice.UpdateNamespaceReplicationFilterRequest{
+		Namespace:         namespaceName,
+		ReplicationFilter: &persistencespb.ReplicationFilter{WorkflowTypes: []string{"some-type"}},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestUpdateNamespaceReplicationFilter_InvalidSearchAttributeFilter() {
+	_, err := s.handler.UpdateNamespaceReplicationFilter(context.Background(), &adminservice.UpdateNamespaceReplicationFilterRequest{
+		Namespace: "some name",
+		ReplicationFilter: &persistencespb.ReplicationFilter{
+			SearchAttributes: []*persistencespb.SearchAttributeReplicationFilter{{Name: "CustomKeywordField"}},
+		},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+type chainedActivityHandler struct {
+	activity.FrontendHandler
+	startRequest    *workflowservice.StartActivityExecutionRequest
+	followUps       []*activityspb.ActivityChainStep
+	describeRequest *workflowservice.DescribeActivityExecutionRequest
+}
+
+func (h *chainedActivityHandler) StartChainedActivityExecution(
+	_ context.Context,
+	req *workflowservice.StartActivityExecutionRequest,
+	followUps []*activityspb.ActivityChainStep,
+) (*workflowservice.StartActivityExecutionResponse, error) {
+	h.startRequest = req
+	h.followUps = followUps
+	return &workflowservice.StartActivityExecutionResponse{RunId: "run-id", Started: true}, nil
+}
+
+func (h *chainedActivityHandler) DescribeChainedActivityExecution(
+	_ context.Context,
+	req *workflowservice.DescribeActivityExecutionRequest,
+) (*activitypb.DescribeActivityExecutionResponse, error) {
+	h.describeRequest = req
+	return &activitypb.DescribeActivityExecutionResponse{
+		FrontendResponse: &workflowservice.DescribeActivityExecutionResponse{RunId: "run-id"},
+		Chain: &activityspb.ActivityChainState{
+			Steps:     []*activityspb.ActivityChainStep{{ActivityType: &commonpb.ActivityType{Name: "step-2"}}},
+			Completed: []*activityspb.ActivityChainStepRecord{{ActivityType: &commonpb.ActivityType{Name: "step-1"}, Attempts: 1}},
+		},
+	}, nil
+}
+
+func (s *adminHandlerSuite) TestStartChainedActivityExecution() {
+	activityHandler := &chainedActivityHandler{}
+	s.handler.activityHandler = activityHandler
+	request := &workflowservice.StartActivityExecutionRequest{Namespace: "some name", ActivityId: "activity-1"}
+	followUps := []*activityspb.ActivityChainStep{{ActivityType: &commonpb.ActivityType{Name: "step-2"}}}
+
+	resp, err := s.handler.StartChainedActivityExecution(context.Background(), &adminservice.StartChainedActivityExecutionRequest{
+		Namespace: "some name",
+		Request:   request,
+		FollowUps: followUps,
+	})
+	s.NoError(err)
+	s.Equal(request, activityHandler.startRequest)
+	s.Equal(followUps, activityHandler.followUps)
+	s.Equal("run-id", resp.GetResponse().GetRunId())
+
+	_, err = s.handler.StartChainedActivityExecution(context.Background(), &adminservice.StartChainedActivityExecutionRequest{
+		Namespace: "other name",
+		Request:   request,
+		FollowUps: followUps,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDescribeChainedActivityExecution() {
+	activityHandler := &chainedActivityHandler{}
+	s.handler.activityHandler = activityHandler
+	request := &workflowservice.DescribeActivityExecutionRequest{Namespace: "some name", ActivityId: "activity-1"}
+
+	resp, err := s.handler.DescribeChainedActivityExecution(context.Background(), &adminservice.DescribeChainedActivityExecutionRequest{
+		Namespace: "some name",
+		Request:   request,
+	})
+	s.NoError(err)
+	s.Equal(request, activityHandler.describeRequest)
+	s.Equal("run-id", resp.GetResponse().GetRunId())
+	s.Equal("step-2", resp.GetChain().GetSteps()[0].GetActivityType().GetName())
+	s.Equal("step-1", resp.GetChain().GetCompleted()[0].GetActivityType().GetName())
+
+	_, err = s.handler.DescribeChainedActivityExecution(context.Background(), &adminservice.DescribeChainedActivityExecutionRequest{
+		Namespace: "other name",
+		Request:   request,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *adminHandlerSuite) TestGetDLQTasks() {
 	for _, tc := range []struct {
 		name string
@@ -1295,6 +1465,11 @@ func (s *adminHandlerSuite) TestPurgeDLQTasks() {
 							TargetCluster:  "test-target-cluster",
 						},
 						MaxMessageID: 42,
+						Filter: &dlq.TaskFilter{
+							NamespaceIDs:    []string{"test-namespace-id"},
+							FailureContains: "some failure",
+						},
+						DryRun: true,
 					},
 				},
 			)
@@ -1314,6 +1489,11 @@ func (s *adminHandlerSuite) TestPurgeDLQTasks() {
 				InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 					MessageId: 42,
 				},
+				Filter: &commonspb.HistoryDLQTaskFilter{
+					NamespaceIds:    []string{"test-namespace-id"},
+					FailureContains: "some failure",
+				},
+				DryRun: true,
 			})
 			if tc.err != nil {
 				s.ErrorIs(err, tc.err)
@@ -1457,6 +1637,70 @@ func (s *adminHandlerSuite) TestDescribeDLQJob() {
 				LastProcessedMessageId: 0,
 			},
 		},
+		{
+			name: "MergeDryRunOutcomes",
+			err:  nil,
+			progressQueryResponse: dlq.ProgressQueryResponse{
+				MaxMessageIDToProcess:     3,
+				LastProcessedMessageID:    3,
+				NumberOfMessagesProcessed: 2,
+				WorkflowType:              dlq.WorkflowTypeMerge,
+				DlqKey:                    defaultMergeQueryResponse.DlqKey,
+				DryRun:                    true,
+				NumberOfMessagesMatched:   2,
+				NumberOfMessagesMerged:    1,
+				NumberOfMessagesPurged:    1,
+				TaskOutcomes: []dlq.TaskOutcome{
+					{
+						MessageID:   1,
+						ShardID:     2,
+						NamespaceID: "test-namespace-id",
+						WorkflowID:  "test-workflow-id",
+						RunID:       "test-run-id",
+						TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+						Outcome:     dlq.TaskOutcomePurged,
+						Reason:      "workflow is closed",
+					},
+					{
+						MessageID: 3,
+						Outcome:   dlq.TaskOutcomeMerged,
+					},
+				},
+			},
+			workflowExecution: defaultWorkflowExecution,
+			expectedResponse: adminservice.DescribeDLQJobResponse{
+				DlqKey: &commonspb.HistoryDLQKey{
+					TaskCategory:  1,
+					SourceCluster: "test-source-cluster",
+					TargetCluster: "test-target-cluster",
+				},
+				OperationType:          enumsspb.DLQ_OPERATION_TYPE_MERGE,
+				OperationState:         enumsspb.DLQ_OPERATION_STATE_RUNNING,
+				MaxMessageId:           3,
+				LastProcessedMessageId: 3,
+				MessagesProcessed:      2,
+				DryRun:                 true,
+				MessagesMatched:        2,
+				MessagesMerged:         1,
+				MessagesPurged:         1,
+				TaskOutcomes: []*adminservice.DLQTaskOutcome{
+					{
+						MessageId:   1,
+						ShardId:     2,
+						NamespaceId: "test-namespace-id",
+						WorkflowId:  "test-workflow-id",
+						RunId:       "test-run-id",
+						TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+						Outcome:     enumsspb.DLQ_TASK_OUTCOME_PURGED,
+						Reason:      "workflow is closed",
+					},
+					{
+						MessageId: 3,
+						Outcome:   enumsspb.DLQ_TASK_OUTCOME_MERGED,
+					},
+				},
+			},
+		},
 	} {
 		s.Run(tc.name, func() {
 			jobToken := adminservice.DLQJobToken{
@@ -2144,6 +2388,970 @@ func (f *fakeSchedulerClient) MigrateToWorkflow(ctx context.Context, req *schedu
 	return f.migrateToWorkflowFn(ctx, req)
 }
 
+func (s *adminHandlerSuite) TestCloneWorkflowExecution() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	serializer := serialization.NewSerializer()
+
+	sourceRunID := tv.RunID()
+	sourceTaskQueue := tv.TaskQueue().GetName()
+	targetWorkflowID := tv.WorkflowID() + "-clone"
+	targetTaskQueue := sourceTaskQueue + "-clone"
+	newEvent := func(eventID int64, event *historypb.HistoryEvent) *historypb.HistoryEvent {
+		event.EventId = eventID
+		event.Version = 7
+		return event
+	}
+	history := [][]*historypb.HistoryEvent{
+		{
+			newEvent(1, &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+						WorkflowId:             tv.WorkflowID(),
+						TaskQueue:              tv.TaskQueue(),
+						OriginalExecutionRunId: sourceRunID,
+						FirstExecutionRunId:    sourceRunID,
+						ParentWorkflowExecution: &commonpb.WorkflowExecution{
+							WorkflowId: "parent",
+							RunId:      uuid.NewString(),
+						},
+						ParentInitiatedEventId: 5,
+					},
+				},
+			}),
+			newEvent(2, &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+				Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+					WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{
+						TaskQueue: tv.TaskQueue(),
+					},
+				},
+			}),
+		},
+		{
+			newEvent(3, &historypb.HistoryEvent{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED}),
+		},
+		{
+			newEvent(4, &historypb.HistoryEvent{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED}),
+			newEvent(5, &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+				Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+					ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+						TaskQueue: tv.TaskQueue(),
+					},
+				},
+			}),
+		},
+		{
+			newEvent(6, &historypb.HistoryEvent{EventType: enumspb.EVENT_TYPE_TIMER_STARTED}),
+		},
+	}
+	var historyBatches []*commonpb.DataBlob
+	for _, events := range history {
+		historyBatch, err := serializer.SerializeEvents(events)
+		s.NoError(err)
+		historyBatches = append(historyBatches, historyBatch)
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: tv.NamespaceID().String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution:   tv.WorkflowExecution(),
+		TaskQueue:   tv.TaskQueue(),
+		NextEventId: 7,
+	}, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: tv.NamespaceID().String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: targetWorkflowID},
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: targetWorkflowID, RunId: uuid.NewString()},
+		WorkflowStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}, nil)
+	s.mockHistoryClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetWorkflowExecutionRawHistoryV2Request, _ ...grpc.CallOption) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+			s.Equal(sourceRunID, request.GetRequest().GetExecution().GetRunId())
+			if len(request.GetRequest().GetNextPageToken()) == 0 {
+				return &historyservice.GetWorkflowExecutionRawHistoryV2Response{
+					Response: &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+						HistoryBatches: historyBatches[:2],
+						NextPageToken:  []byte("next"),
+					},
+				}, nil
+			}
+			return &historyservice.GetWorkflowExecutionRawHistoryV2Response{
+				Response: &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+					HistoryBatches: historyBatches[2:],
+				},
+			}, nil
+		}).Times(2)
+
+	expectedVersion := s.namespaceEntry.FailoverVersion(targetWorkflowID)
+	var importedEvents []*historypb.HistoryEvent
+	var cloneRunID string
+	var lastToken []byte
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+			s.Equal(tv.NamespaceID().String(), request.GetNamespaceId())
+			s.Equal(targetWorkflowID, request.GetExecution().GetWorkflowId())
+			if cloneRunID == "" {
+				cloneRunID = request.GetExecution().GetRunId()
+			}
+			s.Equal(cloneRunID, request.GetExecution().GetRunId())
+			s.Len(request.GetVersionHistory().GetItems(), 1)
+			s.Equal(int64(5), request.GetVersionHistory().GetItems()[0].GetEventId())
+			s.Equal(expectedVersion, request.GetVersionHistory().GetItems()[0].GetVersion())
+			s.Equal(lastToken, request.GetToken())
+			if len(request.GetHistoryBatches()) == 0 {
+				return &historyservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			for _, blob := range request.GetHistoryBatches() {
+				events, err := serializer.DeserializeEvents(blob)
+				s.NoError(err)
+				importedEvents = append(importedEvents, events...)
+			}
+			lastToken = fmt.Appendf(nil, "token-%d", len(importedEvents))
+			return &historyservice.ImportWorkflowExecutionResponse{
+				Token: lastToken,
+			}, nil
+		}).Times(3)
+
+	resp, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace:        tv.NamespaceName().String(),
+		Execution:        &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+		TargetWorkflowId: targetWorkflowID,
+		LastEventId:      5,
+		TargetTaskQueue:  targetTaskQueue,
+	})
+	s.NoError(err)
+	s.Equal(cloneRunID, resp.GetRunId())
+	s.NotEqual(sourceRunID, resp.GetRunId())
+	s.Equal(int64(5), resp.GetLastEventId())
+
+	s.Len(importedEvents, 5)
+	for i, event := range importedEvents {
+		s.Equal(int64(i+1), event.GetEventId())
+		s.Equal(expectedVersion, event.GetVersion())
+	}
+	startedAttributes := importedEvents[0].GetWorkflowExecutionStartedEventAttributes()
+	s.Equal(targetWorkflowID, startedAttributes.GetWorkflowId())
+	s.Equal(cloneRunID, startedAttributes.GetOriginalExecutionRunId())
+	s.Equal(cloneRunID, startedAttributes.GetFirstExecutionRunId())
+	s.Nil(startedAttributes.GetParentWorkflowExecution())
+	s.Zero(startedAttributes.GetParentInitiatedEventId())
+	s.Equal(targetTaskQueue, startedAttributes.GetTaskQueue().GetName())
+	s.Equal(targetTaskQueue, importedEvents[1].GetWorkflowTaskScheduledEventAttributes().GetTaskQueue().GetName())
+	s.Equal(targetTaskQueue, importedEvents[4].GetActivityTaskScheduledEventAttributes().GetTaskQueue().GetName())
+}
+
+// expectCloneSource sets up a clone of the given history, with one history page per batch, up to its last event.
+func (s *adminHandlerSuite) expectCloneSource(tv *testvars.TestVars, history [][]*historypb.HistoryEvent) {
+	serializer := serialization.NewSerializer()
+	var historyBatches []*commonpb.DataBlob
+	for _, events := range history {
+		historyBatch, err := serializer.SerializeEvents(events)
+		s.NoError(err)
+		historyBatches = append(historyBatches, historyBatch)
+	}
+	lastBatch := history[len(history)-1]
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:   tv.WorkflowExecution(),
+		TaskQueue:   tv.TaskQueue(),
+		NextEventId: lastBatch[len(lastBatch)-1].GetEventId() + 1,
+	}, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	s.mockHistoryClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetWorkflowExecutionRawHistoryV2Request, _ ...grpc.CallOption) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+			page := 0
+			if token := request.GetRequest().GetNextPageToken(); len(token) > 0 {
+				page = int(token[0])
+			}
+			response := &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+				HistoryBatches: historyBatches[page : page+1],
+			}
+			if page+1 < len(historyBatches) {
+				response.NextPageToken = []byte{byte(page + 1)}
+			}
+			return &historyservice.GetWorkflowExecutionRawHistoryV2Response{Response: response}, nil
+		}).Times(len(history))
+}
+
+func (s *adminHandlerSuite) TestCloneWorkflowExecution_NotAtBatchBoundary() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	s.expectCloneSource(tv, [][]*historypb.HistoryEvent{
+		{
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{TaskQueue: tv.TaskQueue()},
+			}},
+			{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		},
+	})
+
+	_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace:        tv.NamespaceName().String(),
+		Execution:        &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+		TargetWorkflowId: tv.WorkflowID() + "-clone",
+		LastEventId:      1,
+	})
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+	s.ErrorContains(err, "the batch ends at event 2")
+}
+
+func (s *adminHandlerSuite) TestCloneWorkflowExecution_PendingInitiatedEvents() {
+	newHistory := func(tv *testvars.TestVars, initiated *historypb.HistoryEvent, acknowledged *historypb.HistoryEvent) [][]*historypb.HistoryEvent {
+		initiated.EventId = 5
+		history := [][]*historypb.HistoryEvent{
+			{
+				{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{TaskQueue: tv.TaskQueue()},
+				}},
+				{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			},
+			{{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED}},
+			{{EventId: 4, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED}, initiated},
+		}
+		if acknowledged != nil {
+			acknowledged.EventId = 6
+			history = append(history, []*historypb.HistoryEvent{acknowledged})
+		}
+		return history
+	}
+
+	for _, tc := range []struct {
+		name         string
+		initiated    *historypb.HistoryEvent
+		acknowledged *historypb.HistoryEvent
+	}{
+		{
+			name: "child workflow",
+			initiated: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED,
+				Attributes: &historypb.HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes{
+					StartChildWorkflowExecutionInitiatedEventAttributes: &historypb.StartChildWorkflowExecutionInitiatedEventAttributes{},
+				},
+			},
+			acknowledged: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionStartedEventAttributes{
+					ChildWorkflowExecutionStartedEventAttributes: &historypb.ChildWorkflowExecutionStartedEventAttributes{InitiatedEventId: 5},
+				},
+			},
+		},
+		{
+			name: "signal external workflow",
+			initiated: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED,
+				Attributes: &historypb.HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes{
+					SignalExternalWorkflowExecutionInitiatedEventAttributes: &historypb.SignalExternalWorkflowExecutionInitiatedEventAttributes{},
+				},
+			},
+			acknowledged: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED,
+				Attributes: &historypb.HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes{
+					ExternalWorkflowExecutionSignaledEventAttributes: &historypb.ExternalWorkflowExecutionSignaledEventAttributes{InitiatedEventId: 5},
+				},
+			},
+		},
+		{
+			name: "cancel external workflow",
+			initiated: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED,
+				Attributes: &historypb.HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+					RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: &historypb.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{},
+				},
+			},
+			acknowledged: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED,
+				Attributes: &historypb.HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+					RequestCancelExternalWorkflowExecutionFailedEventAttributes: &historypb.RequestCancelExternalWorkflowExecutionFailedEventAttributes{InitiatedEventId: 5},
+				},
+			},
+		},
+		{
+			name: "nexus operation",
+			initiated: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED,
+				Attributes: &historypb.HistoryEvent_NexusOperationScheduledEventAttributes{
+					NexusOperationScheduledEventAttributes: &historypb.NexusOperationScheduledEventAttributes{},
+				},
+			},
+			acknowledged: &historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_NEXUS_OPERATION_COMPLETED,
+				Attributes: &historypb.HistoryEvent_NexusOperationCompletedEventAttributes{
+					NexusOperationCompletedEventAttributes: &historypb.NexusOperationCompletedEventAttributes{ScheduledEventId: 5},
+				},
+			},
+		},
+	} {
+		s.Run(tc.name+" pending", func() {
+			tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+			s.expectCloneSource(tv, newHistory(tv, common.CloneProto(tc.initiated), nil))
+			// Only the pages are imported, the clone is never committed.
+			s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.ImportWorkflowExecutionResponse{}, nil).Times(3)
+
+			_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+				Namespace:        tv.NamespaceName().String(),
+				Execution:        &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+				TargetWorkflowId: tv.WorkflowID() + "-clone",
+			})
+			var failedPreconditionErr *serviceerror.FailedPrecondition
+			s.ErrorAs(err, &failedPreconditionErr)
+			s.ErrorContains(err, "Event 5")
+		})
+
+		s.Run(tc.name+" acknowledged", func() {
+			tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+			s.expectCloneSource(tv, newHistory(tv, common.CloneProto(tc.initiated), common.CloneProto(tc.acknowledged)))
+			s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.ImportWorkflowExecutionResponse{}, nil).Times(5)
+
+			_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+				Namespace:        tv.NamespaceName().String(),
+				Execution:        &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+				TargetWorkflowId: tv.WorkflowID() + "-clone",
+			})
+			s.NoError(err)
+		})
+	}
+
+	s.Run("nexus operation started", func() {
+		// A started Nexus operation is completed through the original execution, it remains pending in the clone.
+		tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+		s.expectCloneSource(tv, newHistory(tv,
+			&historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED,
+				Attributes: &historypb.HistoryEvent_NexusOperationScheduledEventAttributes{
+					NexusOperationScheduledEventAttributes: &historypb.NexusOperationScheduledEventAttributes{},
+				},
+			},
+			&historypb.HistoryEvent{
+				EventType: enumspb.EVENT_TYPE_NEXUS_OPERATION_STARTED,
+				Attributes: &historypb.HistoryEvent_NexusOperationStartedEventAttributes{
+					NexusOperationStartedEventAttributes: &historypb.NexusOperationStartedEventAttributes{ScheduledEventId: 5},
+				},
+			},
+		))
+		s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.ImportWorkflowExecutionResponse{}, nil).Times(4)
+
+		_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+			Namespace:        tv.NamespaceName().String(),
+			Execution:        &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+			TargetWorkflowId: tv.WorkflowID() + "-clone",
+		})
+		var failedPreconditionErr *serviceerror.FailedPrecondition
+		s.ErrorAs(err, &failedPreconditionErr)
+		s.ErrorContains(err, "Event 5 (NexusOperationScheduled)")
+	})
+}
+
+func (s *adminHandlerSuite) TestCloneWorkflowExecution_TargetRunning() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	targetWorkflowID := tv.WorkflowID() + "-clone"
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:   tv.WorkflowExecution(),
+		NextEventId: 7,
+	}, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: targetWorkflowID, RunId: uuid.NewString()},
+		WorkflowStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, nil)
+
+	_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace:        tv.NamespaceName().String(),
+		Execution:        tv.WorkflowExecution(),
+		TargetWorkflowId: targetWorkflowID,
+	})
+	var alreadyStartedErr *serviceerror.WorkflowExecutionAlreadyStarted
+	s.ErrorAs(err, &alreadyStartedErr)
+}
+
+func (s *adminHandlerSuite) TestCloneWorkflowExecution_InvalidRequest() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+
+	_, err := s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.ErrorIs(err, errTargetWorkflowIDNotSet)
+
+	_, err = s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace:        tv.NamespaceName().String(),
+		Execution:        tv.WorkflowExecution(),
+		TargetNamespace:  tv.NamespaceName().String(),
+		TargetWorkflowId: tv.WorkflowID(),
+	})
+	s.ErrorIs(err, errCloneTargetIsSource)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:   tv.WorkflowExecution(),
+		NextEventId: 7,
+	}, nil)
+	_, err = s.handler.CloneWorkflowExecution(context.Background(), &adminservice.CloneWorkflowExecutionRequest{
+		Namespace:        tv.NamespaceName().String(),
+		Execution:        tv.WorkflowExecution(),
+		TargetWorkflowId: tv.WorkflowID() + "-clone",
+		LastEventId:      7,
+	})
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestDryRunResetWorkflowExecution() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	resetRequest := &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace:                 tv.NamespaceName().String(),
+		WorkflowExecution:         tv.WorkflowExecution(),
+		WorkflowTaskFinishEventId: 4,
+		Reason:                    "dry run",
+	}
+	result := &historyspb.ResetDryRunResult{
+		ResetRunId:      tv.RunID(),
+		ReappliedEvents: []*historyspb.ResetDryRunResult_Event{{RunId: tv.RunID(), EventId: 5}},
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ResetWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ResetWorkflowExecutionResponse, error) {
+			s.Equal(tv.NamespaceID().String(), request.GetNamespaceId())
+			s.True(request.GetDryRun())
+			s.Equal(enumspb.RESET_REAPPLY_TYPE_ALL_ELIGIBLE, request.GetResetRequest().GetResetReapplyType())
+			return &historyservice.ResetWorkflowExecutionResponse{RunId: tv.RunID(), DryRunResult: result}, nil
+		},
+	)
+
+	resp, err := s.handler.DryRunResetWorkflowExecution(context.Background(), &adminservice.DryRunResetWorkflowExecutionRequest{
+		ResetRequest: resetRequest,
+	})
+	s.NoError(err)
+	s.ProtoEqual(result, resp.GetResult())
+}
+
+func (s *adminHandlerSuite) TestDryRunResetWorkflowExecution_InvalidRequest() {
+	_, err := s.handler.DryRunResetWorkflowExecution(context.Background(), &adminservice.DryRunResetWorkflowExecutionRequest{})
+	s.ErrorIs(err, errRequestNotSet)
+
+	_, err = s.handler.DryRunResetWorkflowExecution(context.Background(), &adminservice.DryRunResetWorkflowExecutionRequest{
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{Namespace: s.namespace.String()},
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestDescribeMutableStateAtEvent() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	mutableState := &persistencespb.WorkflowMutableState{
+		NextEventId: 6,
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfoFromAddress("127.0.0.1:7234"), nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: tv.NamespaceID().String(),
+		Execution:   tv.WorkflowExecution(),
+		ArchetypeId: chasm.WorkflowArchetypeID,
+		AtEventId:   5,
+	}).Return(&historyservice.DescribeMutableStateResponse{AtEventMutableState: mutableState}, nil)
+
+	resp, err := s.handler.DescribeMutableStateAtEvent(context.Background(), &adminservice.DescribeMutableStateAtEventRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+		EventId:   5,
+	})
+	s.NoError(err)
+	s.Equal("127.0.0.1:7234", resp.GetHistoryAddr())
+	s.NotEmpty(resp.GetShardId())
+	s.ProtoEqual(mutableState, resp.GetMutableState())
+}
+
+func (s *adminHandlerSuite) TestDescribeMutableStateAtEvent_InvalidRequest() {
+	tv := testvars.New(s.T())
+
+	_, err := s.handler.DescribeMutableStateAtEvent(context.Background(), nil)
+	s.ErrorIs(err, errRequestNotSet)
+
+	_, err = s.handler.DescribeMutableStateAtEvent(context.Background(), &adminservice.DescribeMutableStateAtEventRequest{
+		Namespace: s.namespace.String(),
+		EventId:   5,
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+
+	_, err = s.handler.DescribeMutableStateAtEvent(context.Background(), &adminservice.DescribeMutableStateAtEventRequest{
+		Namespace: s.namespace.String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.ErrorIs(err, errInvalidEventID)
+}
+
+func (s *adminHandlerSuite) TestListWorkflowConflictResolutions() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	records := []*persistencespb.ConflictResolutionRecord{
+		{
+			ClusterName:                 "active",
+			Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD,
+			WinningBranchVersion:        2,
+			WinningBranchLastEventId:    10,
+			LosingBranchVersion:         1,
+			LosingBranchLastEventId:     12,
+			LowestCommonAncestorEventId: 8,
+			DiscardedEventCount:         4,
+		},
+		{
+			ClusterName:                 "active",
+			Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+			WinningBranchVersion:        2,
+			WinningBranchLastEventId:    14,
+			LosingBranchVersion:         1,
+			LosingBranchLastEventId:     13,
+			LowestCommonAncestorEventId: 8,
+			ReappliedEvents: []*persistencespb.ConflictResolutionEvent{
+				{EventId: 13, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+			},
+		},
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: tv.NamespaceID().String(),
+		Execution:   tv.WorkflowExecution(),
+		ArchetypeId: chasm.WorkflowArchetypeID,
+	}).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{ConflictResolutionRecords: records},
+		},
+	}, nil)
+
+	resp, err := s.handler.ListWorkflowConflictResolutions(context.Background(), &adminservice.ListWorkflowConflictResolutionsRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetRecords(), len(records))
+	for i, record := range records {
+		s.ProtoEqual(record, resp.GetRecords()[i])
+	}
+}
+
+func (s *adminHandlerSuite) TestListWorkflowConflictResolutions_InvalidRequest() {
+	_, err := s.handler.ListWorkflowConflictResolutions(context.Background(), nil)
+	s.ErrorIs(err, errRequestNotSet)
+
+	_, err = s.handler.ListWorkflowConflictResolutions(context.Background(), &adminservice.ListWorkflowConflictResolutionsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestMoveHistoryShard() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+		membership.NewHostInfoFromAddress("127.0.0.2:7234"),
+	})
+	s.mockHistoryClient.EXPECT().PinShard(gomock.Any(), &historyservice.PinShardRequest{
+		HostAddress: "127.0.0.2:7234",
+		ShardId:     1,
+	}).Return(&historyservice.PinShardResponse{}, nil)
+
+	_, err := s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:    1,
+		TargetHost: "127.0.0.2:7234",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestMoveHistoryShard_InvalidRequest() {
+	var invalidArgumentErr *serviceerror.InvalidArgument
+
+	_, err := s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:    2,
+		TargetHost: "127.0.0.2:7234",
+	})
+	s.ErrorAs(err, &invalidArgumentErr)
+
+	_, err = s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId: 1,
+	})
+	s.ErrorIs(err, errTargetHostNotSet)
+
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+	})
+	_, err = s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:    1,
+		TargetHost: "127.0.0.2:7234",
+	})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestUnpinHistoryShard() {
+	s.mockHistoryClient.EXPECT().UnpinShard(gomock.Any(), &historyservice.UnpinShardRequest{
+		ShardId: 1,
+	}).Return(&historyservice.UnpinShardResponse{}, nil)
+
+	_, err := s.handler.UnpinHistoryShard(context.Background(), &adminservice.UnpinHistoryShardRequest{ShardId: 1})
+	s.NoError(err)
+
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	_, err = s.handler.UnpinHistoryShard(context.Background(), &adminservice.UnpinHistoryShardRequest{})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestGetTaskTrace() {
+	taskTrace := &historyspb.TaskTrace{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTimer),
+		TaskId:   123,
+		TraceId:  "trace-id",
+		Events: []*historyspb.TaskTraceEvent{
+			{Stage: "generated"},
+			{Stage: "acked", Attempt: 2},
+		},
+	}
+	s.mockHistoryClient.EXPECT().GetTaskTrace(gomock.Any(), &historyservice.GetTaskTraceRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTimer),
+		TaskId:   123,
+	}).Return(&historyservice.GetTaskTraceResponse{Trace: taskTrace}, nil)
+
+	resp, err := s.handler.GetTaskTrace(context.Background(), &adminservice.GetTaskTraceRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTimer),
+		TaskId:   123,
+	})
+	s.NoError(err)
+	s.ProtoEqual(taskTrace, resp.GetTrace())
+
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	_, err = s.handler.GetTaskTrace(context.Background(), &adminservice.GetTaskTraceRequest{ShardId: 2, TaskId: 123})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestListQueueMitigations() {
+	mitigations := []*historyspb.QueueMitigation{
+		{
+			ShardId:     1,
+			Category:    int32(tasks.CategoryIDTimer),
+			AlertType:   "ReaderStuck",
+			Action:      "dlq-stuck-tasks",
+			ActionTaken: true,
+		},
+		{
+			ShardId:   1,
+			Category:  int32(tasks.CategoryIDTimer),
+			AlertType: "ReaderStuck",
+			Action:    "page",
+		},
+	}
+	s.mockHistoryClient.EXPECT().ListQueueMitigations(gomock.Any(), &historyservice.ListQueueMitigationsRequest{
+		ShardId: 1,
+	}).Return(&historyservice.ListQueueMitigationsResponse{Mitigations: mitigations}, nil)
+
+	resp, err := s.handler.ListQueueMitigations(context.Background(), &adminservice.ListQueueMitigationsRequest{ShardId: 1})
+	s.NoError(err)
+	s.Len(resp.GetMitigations(), 2)
+	for i, mitigation := range mitigations {
+		s.ProtoEqual(mitigation, resp.GetMitigations()[i])
+	}
+
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	_, err = s.handler.ListQueueMitigations(context.Background(), &adminservice.ListQueueMitigationsRequest{ShardId: 2})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestGetReplicationLag() {
+	now := time.Unix(1000, 0).UTC()
+	s.handler.config.HistoryReplicationDLQV2 = dynamicconfig.GetBoolPropertyFn(true)
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		s.currentClusterName: {Enabled: true},
+		"standby":            {Enabled: true, RPCAddress: "standby:7233", ShardCount: 2},
+		"disabled":           {Enabled: false},
+	}).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespace.ID("ns-1")).Return(namespace.Name("ns-1-name"), nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespace.ID("ns-2")).Return(namespace.Name("ns-2-name"), nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name("ns-3-name")).Return(namespace.ID("ns-3"), nil).AnyTimes()
+
+	shards := []*historyservice.ShardReplicationStatus{
+		{
+			ShardId:              1,
+			MaxReplicationTaskId: 100,
+			ShardLocalTime:       timestamppb.New(now),
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {
+					AckedTaskId: 90,
+					NamespaceBacklogs: []*historyservice.NamespaceReplicationBacklog{
+						{
+							NamespaceId:              "ns-1",
+							TaskCount:                3,
+							OldestExecution:          &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"},
+							OldestTaskVisibilityTime: timestamppb.New(now.Add(-time.Minute)),
+						},
+						{
+							NamespaceId:              "ns-2",
+							TaskCount:                1,
+							OldestExecution:          &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"},
+							OldestTaskVisibilityTime: timestamppb.New(now.Add(-time.Second)),
+						},
+					},
+				},
+			},
+		},
+		{
+			ShardId:              2,
+			MaxReplicationTaskId: 50,
+			ShardLocalTime:       timestamppb.New(now),
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {
+					AckedTaskId: 20,
+					StreamStuck: true,
+					NamespaceBacklogs: []*historyservice.NamespaceReplicationBacklog{
+						{
+							NamespaceId:              "ns-1",
+							TaskCount:                2,
+							OldestExecution:          &commonpb.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"},
+							OldestTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour)),
+						},
+					},
+					BacklogTruncated: true,
+				},
+			},
+		},
+	}
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters:          []string{"standby"},
+		IncludeNamespaceBacklog: true,
+		MaxScannedTasks:         10,
+	}).Return(&historyservice.GetReplicationStatusResponse{Shards: shards}, nil).Times(2)
+	// The tasks the standby cluster failed to apply are in its own DLQ.
+	s.mockClientFactory.EXPECT().NewRemoteAdminClientWithTimeout("standby:7233", gomock.Any(), gomock.Any()).Return(
+		s.mockAdminClient,
+	).Times(2)
+	s.mockAdminClient.EXPECT().ListQueues(gomock.Any(), &adminservice.ListQueuesRequest{
+		QueueType: int32(persistence.QueueTypeHistoryDLQ),
+		PageSize:  listQueuesPageSize,
+	}).Return(&adminservice.ListQueuesResponse{
+		Queues: []*adminservice.ListQueuesResponse_QueueInfo{
+			{
+				QueueName:    persistence.GetHistoryTaskQueueName(tasks.CategoryReplication.ID(), "standby", s.currentClusterName),
+				MessageCount: 3,
+			},
+			{
+				QueueName:    persistence.GetHistoryTaskQueueName(tasks.CategoryReplication.ID(), s.currentClusterName, "standby"),
+				MessageCount: 7,
+			},
+		},
+	}, nil).Times(2)
+
+	resp, err := s.handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{
+		MaxLag:          durationpb.New(time.Hour),
+		MaxScannedTasks: 10,
+	})
+	s.NoError(err)
+	s.False(resp.GetSafeToFailover())
+	s.Len(resp.GetUnsafeReasons(), 2)
+	s.Len(resp.GetClusters(), 1)
+	s.ProtoEqual(&adminservice.ClusterReplicationLag{
+		ClusterName:      "standby",
+		TaskIdLag:        30,
+		Lag:              durationpb.New(time.Hour),
+		BacklogTaskCount: 6,
+		BacklogTruncated: true,
+		DlqMessageCount:  7,
+		StuckShardIds:    []int32{2},
+		Namespaces: []*adminservice.NamespaceReplicationLag{
+			{
+				NamespaceId:                 "ns-1",
+				Namespace:                   "ns-1-name",
+				BacklogTaskCount:            5,
+				Lag:                         durationpb.New(time.Hour),
+				OldestUnreplicatedExecution: &commonpb.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"},
+			},
+			{
+				NamespaceId:                 "ns-2",
+				Namespace:                   "ns-2-name",
+				BacklogTaskCount:            1,
+				Lag:                         durationpb.New(time.Second),
+				OldestUnreplicatedExecution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"},
+			},
+		},
+	}, resp.GetClusters()[0])
+
+	resp, err = s.handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{
+		RemoteClusters:  []string{"standby"},
+		Namespaces:      []string{"ns-3-name"},
+		MaxScannedTasks: 10,
+	})
+	s.NoError(err)
+	s.Len(resp.GetClusters()[0].GetNamespaces(), 1)
+	s.ProtoEqual(&adminservice.NamespaceReplicationLag{
+		NamespaceId: "ns-3",
+		Namespace:   "ns-3-name",
+		Lag:         durationpb.New(0),
+	}, resp.GetClusters()[0].GetNamespaces()[0])
+	// The namespace is caught up, but the stuck stream and the DLQ still make the failover unsafe.
+	s.False(resp.GetSafeToFailover())
+	s.Len(resp.GetUnsafeReasons(), 2)
+
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	_, err = s.handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{
+		RemoteClusters: []string{s.currentClusterName},
+	})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestGetReplicationLag_DLQV1() {
+	s.handler.config.HistoryReplicationDLQV2 = dynamicconfig.GetBoolPropertyFn(false)
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		s.currentClusterName: {Enabled: true},
+		"standby":            {Enabled: true, RPCAddress: "standby:7233", ShardCount: 2},
+	}).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Return(&historyservice.GetReplicationStatusResponse{}, nil)
+	s.mockClientFactory.EXPECT().NewRemoteAdminClientWithTimeout("standby:7233", gomock.Any(), gomock.Any()).Return(s.mockAdminClient)
+	dlqRequest := func(shardID int32, nextPageToken []byte) *adminservice.GetDLQMessagesRequest {
+		return &adminservice.GetDLQMessagesRequest{
+			Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+			ShardId:               shardID,
+			SourceCluster:         s.currentClusterName,
+			InclusiveEndMessageId: common.EndMessageID,
+			MaximumPageSize:       primitives.ReadDLQMessagesPageSize,
+			NextPageToken:         nextPageToken,
+		}
+	}
+	s.mockAdminClient.EXPECT().GetDLQMessages(gomock.Any(), dlqRequest(1, nil)).Return(&adminservice.GetDLQMessagesResponse{
+		ReplicationTasksInfo: []*replicationspb.ReplicationTaskInfo{{TaskId: 1}, {TaskId: 2}},
+		NextPageToken:        []byte("page-2"),
+	}, nil)
+	s.mockAdminClient.EXPECT().GetDLQMessages(gomock.Any(), dlqRequest(1, []byte("page-2"))).Return(&adminservice.GetDLQMessagesResponse{
+		ReplicationTasksInfo: []*replicationspb.ReplicationTaskInfo{{TaskId: 3}},
+	}, nil)
+	s.mockAdminClient.EXPECT().GetDLQMessages(gomock.Any(), dlqRequest(2, nil)).Return(&adminservice.GetDLQMessagesResponse{}, nil)
+
+	resp, err := s.handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetClusters()[0].GetDlqMessageCount())
+	s.Equal([]string{"cluster standby: 3 replication tasks in the DLQ"}, resp.GetUnsafeReasons())
+}
+
+func (s *adminHandlerSuite) TestDescribeTaskScheduler() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.2:7234"),
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+	})
+	schedulers := []*historyspb.TaskSchedulerState{{
+		Name: "transfer",
+		Channels: []*historyspb.TaskSchedulerChannel{
+			{NamespaceId: s.namespaceID.String(), Namespace: s.namespace.String(), Priority: "High", Weight: 10, Backlog: 3},
+		},
+	}}
+	for _, address := range []string{"127.0.0.1:7234", "127.0.0.2:7234"} {
+		s.mockHistoryClient.EXPECT().DescribeTaskScheduler(gomock.Any(), &historyservice.DescribeTaskSchedulerRequest{
+			HostAddress: address,
+		}).Return(&historyservice.DescribeTaskSchedulerResponse{Address: address, Schedulers: schedulers}, nil)
+	}
+
+	resp, err := s.handler.DescribeTaskScheduler(context.Background(), &adminservice.DescribeTaskSchedulerRequest{})
+	s.NoError(err)
+	s.Len(resp.GetHosts(), 2)
+	s.Equal("127.0.0.1:7234", resp.GetHosts()[0].GetAddress())
+	s.Equal("127.0.0.2:7234", resp.GetHosts()[1].GetAddress())
+	s.Equal(int32(3), resp.GetHosts()[0].GetSchedulers()[0].GetChannels()[0].GetBacklog())
+
+	// Unknown hosts are rejected.
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+	})
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	_, err = s.handler.DescribeTaskScheduler(context.Background(), &adminservice.DescribeTaskSchedulerRequest{
+		HostAddress: "127.0.0.2:7234",
+	})
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskSchedulerNamespaceWeight() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+		membership.NewHostInfoFromAddress("127.0.0.2:7234"),
+	})
+	var requests []*historyservice.UpdateTaskSchedulerNamespaceWeightRequest
+	s.mockHistoryClient.EXPECT().UpdateTaskSchedulerNamespaceWeight(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.UpdateTaskSchedulerNamespaceWeightRequest, _ ...grpc.CallOption) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+			requests = append(requests, request)
+			return &historyservice.UpdateTaskSchedulerNamespaceWeightResponse{}, nil
+		},
+	).Times(2)
+
+	before := time.Now()
+	resp, err := s.handler.UpdateTaskSchedulerNamespaceWeight(context.Background(), &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Namespace:  s.namespace.String(),
+		Multiplier: 0.5,
+		Duration:   durationpb.New(time.Hour),
+	})
+	s.NoError(err)
+	s.Equal([]string{"127.0.0.1:7234", "127.0.0.2:7234"}, resp.GetHosts())
+	s.WithinRange(resp.GetExpireTime().AsTime(), before.Add(time.Hour), time.Now().Add(time.Hour))
+	s.Len(requests, 2)
+	for _, request := range requests {
+		s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+		s.Equal(0.5, request.GetMultiplier())
+		s.Equal(resp.GetExpireTime(), request.GetExpireTime())
+	}
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskSchedulerNamespaceWeight_PartialFailure() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("127.0.0.1:7234"),
+		membership.NewHostInfoFromAddress("127.0.0.2:7234"),
+	})
+	s.mockHistoryClient.EXPECT().UpdateTaskSchedulerNamespaceWeight(gomock.Any(), &historyservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		HostAddress: "127.0.0.1:7234",
+		NamespaceId: s.namespaceID.String(),
+		Remove:      true,
+	}).Return(nil, serviceerror.NewUnavailable("host is down"))
+	s.mockHistoryClient.EXPECT().UpdateTaskSchedulerNamespaceWeight(gomock.Any(), &historyservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		HostAddress: "127.0.0.2:7234",
+		NamespaceId: s.namespaceID.String(),
+		Remove:      true,
+	}).Return(&historyservice.UpdateTaskSchedulerNamespaceWeightResponse{}, nil)
+
+	_, err := s.handler.UpdateTaskSchedulerNamespaceWeight(context.Background(), &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Namespace: s.namespace.String(),
+		Remove:    true,
+	})
+	var unavailableErr *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailableErr)
+	s.ErrorContains(err, "127.0.0.1:7234")
+	s.NotContains(err.Error(), "127.0.0.2:7234")
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskSchedulerNamespaceWeight_InvalidRequest() {
+	_, err := s.handler.UpdateTaskSchedulerNamespaceWeight(context.Background(), &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Multiplier: 2,
+		Duration:   durationpb.New(time.Hour),
+	})
+	s.ErrorIs(err, errNamespaceNotSet)
+
+	_, err = s.handler.UpdateTaskSchedulerNamespaceWeight(context.Background(), &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Namespace: s.namespace.String(),
+		Duration:  durationpb.New(time.Hour),
+	})
+	s.ErrorIs(err, errInvalidWeightMultiplier)
+
+	_, err = s.handler.UpdateTaskSchedulerNamespaceWeight(context.Background(), &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Namespace:  s.namespace.String(),
+		Multiplier: 2,
+	})
+	s.ErrorIs(err, errInvalidWeightDuration)
+}
+
 func (s *adminHandlerSuite) TestMigrateScheduleToWorkflow() {
 	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
 	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
@@ -0,0 +1,121 @@
+package frontend
+
+import (
+	"maps"
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+)
+
+// cloneEventRewriter rewrites the events of a workflow execution so they can be imported as a new, independent
+// execution.
+type cloneEventRewriter struct {
+	execution *commonpb.WorkflowExecution
+	// version is the failover version all cloned events are written with.
+	version int64
+	// sourceTaskQueue is the task queue of the original execution.
+	sourceTaskQueue string
+	// targetTaskQueue replaces sourceTaskQueue in the clone if set.
+	targetTaskQueue string
+	// pendingInitiatedEvents holds the types of the child workflow starts, external signals and external cancellation
+	// requests that were initiated, but not yet started or acknowledged, and of the Nexus operations that were
+	// scheduled, but not yet completed, by the events rewritten so far, keyed by their initiated or scheduled event ID.
+	pendingInitiatedEvents map[int64]enumspb.EventType
+}
+
+// rewrite updates the event in place and returns it.
+//
+// The started event is detached from the original run chain, its parent and its completion callbacks, so that the
+// clone never reports back to callers of the original execution. Workflow and activity tasks that were dispatched
+// to the original task queue are moved to targetTaskQueue, if set, so the clone can be picked up by a separate worker.
+// Child workflow starts, external signals and external cancellation requests are tracked until they are started or
+// acknowledged, and Nexus operations until they complete; see validateNoPendingInitiatedEvents.
+func (r *cloneEventRewriter) rewrite(event *historypb.HistoryEvent) *historypb.HistoryEvent {
+	event.Version = r.version
+
+	switch attributes := event.GetAttributes().(type) {
+	case *historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes:
+		attr := attributes.WorkflowExecutionStartedEventAttributes
+		attr.WorkflowId = r.execution.GetWorkflowId()
+		attr.OriginalExecutionRunId = r.execution.GetRunId()
+		attr.FirstExecutionRunId = r.execution.GetRunId()
+		attr.ContinuedExecutionRunId = ""
+		attr.ParentWorkflowNamespace = ""
+		attr.ParentWorkflowNamespaceId = ""
+		attr.ParentWorkflowExecution = nil
+		attr.ParentInitiatedEventId = 0
+		attr.ParentInitiatedEventVersion = 0
+		attr.RootWorkflowExecution = nil
+		attr.CompletionCallbacks = nil
+		if r.targetTaskQueue != "" {
+			attr.TaskQueue = r.newTaskQueue()
+		}
+	case *historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes:
+		// Sticky task queues are replaced as well, since they are bound to workers of the original execution.
+		if r.targetTaskQueue != "" {
+			attributes.WorkflowTaskScheduledEventAttributes.TaskQueue = r.newTaskQueue()
+		}
+	case *historypb.HistoryEvent_ActivityTaskScheduledEventAttributes:
+		attr := attributes.ActivityTaskScheduledEventAttributes
+		if r.targetTaskQueue != "" && attr.GetTaskQueue().GetName() == r.sourceTaskQueue {
+			attr.TaskQueue = r.newTaskQueue()
+		}
+	case *historypb.HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes,
+		*historypb.HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes,
+		*historypb.HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes,
+		*historypb.HistoryEvent_NexusOperationScheduledEventAttributes:
+		if r.pendingInitiatedEvents == nil {
+			r.pendingInitiatedEvents = make(map[int64]enumspb.EventType)
+		}
+		r.pendingInitiatedEvents[event.GetEventId()] = event.GetEventType()
+	case *historypb.HistoryEvent_ChildWorkflowExecutionStartedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.ChildWorkflowExecutionStartedEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.StartChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.ExternalWorkflowExecutionSignaledEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.SignalExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.ExternalWorkflowExecutionCancelRequestedEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.RequestCancelExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventId())
+	case *historypb.HistoryEvent_NexusOperationCompletedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.NexusOperationCompletedEventAttributes.GetScheduledEventId())
+	case *historypb.HistoryEvent_NexusOperationFailedEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.NexusOperationFailedEventAttributes.GetScheduledEventId())
+	case *historypb.HistoryEvent_NexusOperationCanceledEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.NexusOperationCanceledEventAttributes.GetScheduledEventId())
+	case *historypb.HistoryEvent_NexusOperationTimedOutEventAttributes:
+		delete(r.pendingInitiatedEvents, attributes.NexusOperationTimedOutEventAttributes.GetScheduledEventId())
+	}
+	return event
+}
+
+// validateNoPendingInitiatedEvents fails the clone if a child workflow start, external signal, external cancellation
+// request or Nexus operation is pending at its last event. Committing the clone regenerates the tasks of these events,
+// so the clone would start the child workflow, signal or cancel the external workflow, or start the Nexus operation a
+// second time. A Nexus operation that was already started is not completed in the clone either, since its handler
+// delivers the completion to the original execution.
+func (r *cloneEventRewriter) validateNoPendingInitiatedEvents() error {
+	if len(r.pendingInitiatedEvents) == 0 {
+		return nil
+	}
+	initiatedEventID := slices.Min(slices.Collect(maps.Keys(r.pendingInitiatedEvents)))
+	return serviceerror.NewFailedPreconditionf(
+		"Event %d (%s) is still pending at the last cloned event. Clone the execution at an event where no child workflow start, external signal, external cancellation request or Nexus operation is pending.",
+		initiatedEventID,
+		r.pendingInitiatedEvents[initiatedEventID],
+	)
+}
+
+func (r *cloneEventRewriter) newTaskQueue() *taskqueuepb.TaskQueue {
+	return &taskqueuepb.TaskQueue{
+		Name: r.targetTaskQueue,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+}
@@ -22,6 +22,17 @@ const (
 	PollWorkflowHistoryAPIName = "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowExecutionHistory"
 	// PollActivityExecutionAPIName is used instead of DescribeActivityExecution if LongPollToken is set in request.
 	PollActivityExecutionAPIName = "/temporal.api.workflowservice.v1.WorkflowService/PollActivityExecutionDescription"
+	ReadHistoryEventFeedAPIName  = "/temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService/ReadHistoryEventFeed"
+
+	StartNexusFanOutExecutionAPIName         = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/StartNexusFanOutExecution"
+	DescribeNexusFanOutExecutionAPIName      = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/DescribeNexusFanOutExecution"
+	PollNexusFanOutExecutionAPIName          = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/PollNexusFanOutExecution"
+	RequestCancelNexusFanOutExecutionAPIName = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/RequestCancelNexusFanOutExecution"
+	TerminateNexusFanOutExecutionAPIName     = "/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/TerminateNexusFanOutExecution"
+
+	BatchStartActivityExecutionsAPIName    = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchStartActivityExecutions"
+	BatchDescribeActivityExecutionsAPIName = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchDescribeActivityExecutions"
+	BatchPollActivityExecutionsAPIName     = "/temporal.server.api.activitybatchservice.v1.ActivityBatchService/BatchPollActivityExecutions"
 )
 
 var (
@@ -42,6 +53,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/PollNexusTaskQueue":                1,
 		"/temporal.api.workflowservice.v1.WorkflowService/PollNexusOperationExecution":       1,
 		"/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowExecutionTimeSkipping": 1,
+		PollNexusFanOutExecutionAPIName:                                                      1,
+		BatchPollActivityExecutionsAPIName:                                                   1,
 
 		// Long-running if activity outcome is not already available
 		"/temporal.api.workflowservice.v1.WorkflowService/PollActivityExecution": 1,
@@ -54,6 +67,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeNexusOperationExecution": 1,
 		"/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory":     1,
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeActivityExecution":       1,
+		ReadHistoryEventFeedAPIName:         1,
+		DescribeNexusFanOutExecutionAPIName: 1,
 
 		// Potentially long-running, depending on the operations.
 		"/temporal.api.workflowservice.v1.WorkflowService/ExecuteMultiOperation": 1,
@@ -94,6 +109,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/StartBatchOperation":              1,
 		"/temporal.api.workflowservice.v1.WorkflowService/StartActivityExecution":           1,
 		"/temporal.api.workflowservice.v1.WorkflowService/StartNexusOperationExecution":     1,
+		StartNexusFanOutExecutionAPIName:                                                    1,
+		BatchStartActivityExecutionsAPIName:                                                 1,
 		DispatchNexusTaskByNamespaceAndTaskQueueAPIName:                                     1,
 		DispatchNexusTaskByEndpointAPIName:                                                  1,
 
@@ -150,6 +167,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/RequestCancelNexusOperationExecution":       2,
 		"/temporal.api.workflowservice.v1.WorkflowService/TerminateNexusOperationExecution":           2,
 		"/temporal.api.workflowservice.v1.WorkflowService/DeleteNexusOperationExecution":              2,
+		RequestCancelNexusFanOutExecutionAPIName:                                                      2,
+		TerminateNexusFanOutExecutionAPIName:                                                          2,
 		"/temporal.api.workflowservice.v1.WorkflowService/PauseWorkflowExecution":                     2,
 		"/temporal.api.workflowservice.v1.WorkflowService/UnpauseWorkflowExecution":                   2,
 
@@ -169,6 +188,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkerDeploymentVersion":              3,
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkerDeployment":                     3,
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeNexusOperationExecution":              3,
+		DescribeNexusFanOutExecutionAPIName:                                                             3,
+		BatchDescribeActivityExecutionsAPIName:                                                          3,
 		"/temporal.api.workflowservice.v1.WorkflowService/ValidateWorkerDeploymentVersionComputeConfig": 3,
 		"/temporal.api.workflowservice.v1.WorkflowService/ListWorkers":                                  3,
 		"/temporal.api.workflowservice.v1.WorkflowService/DescribeWorker":                               3,
@@ -196,6 +217,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/RecordWorkerHeartbeat":              4,
 		"/temporal.api.workflowservice.v1.WorkflowService/FetchWorkerConfig":                  4,
 		"/temporal.api.workflowservice.v1.WorkflowService/UpdateWorkerConfig":                 4,
+		PollNexusFanOutExecutionAPIName:                                                       4,
+		BatchPollActivityExecutionsAPIName:                                                    4,
 
 		// P5: Low priority APIs
 		// GetWorkflowExecutionHistory with WaitNewEvent set to true is a long poll API.
@@ -205,6 +228,8 @@ var (
 		PollActivityExecutionAPIName: 5,
 		// Test-support long-poll; not required for the service to function, so it yields to production traffic.
 		"/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowExecutionTimeSkipping": 5,
+		// History event feed consumers catch up with the feed once they are no longer throttled.
+		ReadHistoryEventFeedAPIName: 5,
 		// Informational API that aren't required for the temporal service to function
 		OpenAPIV3APIName: 5,
 		OpenAPIV2APIName: 5,
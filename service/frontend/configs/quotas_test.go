@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
+	"go.temporal.io/server/api/historyeventfeedservice/v1"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/quotas"
@@ -163,6 +166,21 @@ func (s *quotasSuite) TestAllAPIs() {
 		_, ok := apisWithPriority["/temporal.api.workflowservice.v1.WorkflowService/"+m.Name]
 		s.True(ok, "missing priority for API: %v", m.Name)
 	})
+	var feedService historyeventfeedservice.HistoryEventFeedServiceServer
+	temporalapi.WalkExportedMethods(&feedService, func(m reflect.Method) {
+		_, ok := apisWithPriority["/temporal.server.api.historyeventfeedservice.v1.HistoryEventFeedService/"+m.Name]
+		s.True(ok, "missing priority for API: %v", m.Name)
+	})
+	var fanOutService nexusoperationpb.NexusFanOutServiceServer
+	temporalapi.WalkExportedMethods(&fanOutService, func(m reflect.Method) {
+		_, ok := apisWithPriority["/temporal.server.chasm.lib.nexusoperation.proto.v1.NexusFanOutService/"+m.Name]
+		s.True(ok, "missing priority for API: %v", m.Name)
+	})
+	var batchService activitybatchservice.ActivityBatchServiceServer
+	temporalapi.WalkExportedMethods(&batchService, func(m reflect.Method) {
+		_, ok := apisWithPriority["/temporal.server.api.activitybatchservice.v1.ActivityBatchService/"+m.Name]
+		s.True(ok, "missing priority for API: %v", m.Name)
+	})
 	_, ok := apisWithPriority[DispatchNexusTaskByNamespaceAndTaskQueueAPIName]
 	s.Truef(ok, "missing priority for API: %q", DispatchNexusTaskByNamespaceAndTaskQueueAPIName)
 	_, ok = apisWithPriority[DispatchNexusTaskByEndpointAPIName]
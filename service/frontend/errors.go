@@ -77,6 +77,17 @@ var (
 	errTargetClusterNotSet = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken  = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
 
+	errTargetWorkflowIDNotSet  = serviceerror.NewInvalidArgument("TargetWorkflowId is not set on request.")
+	errCloneTargetIsSource     = serviceerror.NewInvalidArgument("Clone target must be a different workflow ID or namespace than the source.")
+	errTargetHostNotSet        = serviceerror.NewInvalidArgument("TargetHost is not set on request.")
+	errInvalidEventID          = serviceerror.NewInvalidArgument("EventId must be positive.")
+	errInvalidWeightMultiplier = serviceerror.NewInvalidArgument("Multiplier must be positive.")
+	errInvalidWeightDuration   = serviceerror.NewInvalidArgument("Duration must be positive.")
+
+	errInvalidShardIDMessage     = "Invalid ShardId %d, must be between 1 and %d."
+	errUnknownHistoryHostMessage = "Unknown history host %s."
+	errUpdateHistoryHostsMessage = "Unable to update history hosts %v: %v"
+
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/service"
@@ -125,6 +126,8 @@ var Module = fx.Options(
 	fx.Provide(AdminHandlerProvider),
 	fx.Provide(NamespaceDLQHandlerProvider),
 	fx.Provide(OperatorHandlerProvider),
+	fx.Provide(HistoryEventFeedHandlerProvider),
+	fx.Provide(ActivityBatchHandlerProvider),
 	fx.Provide(NewVersionChecker),
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(newNexusCompletionHandler),
@@ -157,6 +160,9 @@ func NewServiceProvider(
 	handler Handler,
 	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
+	feedHandler *HistoryEventFeedHandler,
+	activityBatchHandler *ActivityBatchHandler,
+	nexusOperationHandler chasmnexus.FrontendHandler,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
 	logger log.SnTaggedLogger,
@@ -172,6 +178,9 @@ func NewServiceProvider(
 		handler,
 		adminHandler,
 		operatorHandler,
+		feedHandler,
+		activityBatchHandler,
+		nexusOperationHandler,
 		versionChecker,
 		visibilityMgr,
 		logger,
@@ -396,6 +405,7 @@ func RedirectionInterceptorProvider(
 	metricsHandler metrics.Handler,
 	timeSource clock.TimeSource,
 	clusterMetadata cluster.Metadata,
+	eventLogger otellog.Logger,
 ) *interceptor.Redirection {
 	return interceptor.NewRedirection(
 		configuration.EnableNamespaceNotActiveAutoForwarding,
@@ -407,7 +417,12 @@ func RedirectionInterceptorProvider(
 		metricsHandler,
 		timeSource,
 		clusterMetadata,
-	)
+	).WithShadowTraffic(interceptor.ShadowTrafficConfig{
+		TargetCluster:          configuration.ShadowTrafficTargetCluster,
+		SampleRate:             configuration.ShadowTrafficSampleRate,
+		MaxOutstandingRequests: configuration.ShadowTrafficMaxOutstandingRequests,
+		Timeout:                configuration.ShadowTrafficTimeout,
+	}, eventLogger)
 }
 
 func BusinessIDInterceptorProvider(
@@ -805,6 +820,8 @@ func AdminHandlerProvider(
 	chasmRegistry *chasm.Registry,
 	namespaceDataMerger nsreplication.NamespaceDataMerger,
 	schedulerClient schedulerpb.SchedulerServiceClient,
+	historyShardPools *shardpool.Resolver,
+	activityHandler activity.FrontendHandler,
 	namespaceDLQHandler nsreplication.DLQMessageHandler,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -835,6 +852,8 @@ func AdminHandlerProvider(
 		chasmRegistry,
 		namespaceDataMerger,
 		schedulerClient,
+		historyShardPools,
+		activityHandler,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -901,6 +920,30 @@ func OperatorHandlerProvider(
 	return NewOperatorHandlerImpl(args)
 }
 
+func HistoryEventFeedHandlerProvider(
+	configuration *Config,
+	logger log.SnTaggedLogger,
+	namespaceRegistry namespace.Registry,
+	historyEventFeedManager persistence.HistoryEventFeedManager,
+) *HistoryEventFeedHandler {
+	return NewHistoryEventFeedHandler(
+		configuration,
+		logger,
+		namespaceRegistry,
+		historyEventFeedManager,
+	)
+}
+
+func ActivityBatchHandlerProvider(
+	logger log.SnTaggedLogger,
+	activityHandler activity.FrontendHandler,
+) *ActivityBatchHandler {
+	return NewActivityBatchHandler(
+		logger,
+		activityHandler,
+	)
+}
+
 // callbackValidatorProvider creates a callback Validator using the production dynamic config keys
 // so that existing operator configurations (callback.allowedAddresses) are honored.
 func callbackValidatorProvider(dc *dynamicconfig.Collection) callback.Validator {
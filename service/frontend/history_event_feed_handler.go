@@ -0,0 +1,114 @@
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyeventfeedservice/v1"
+	"go.temporal.io/server/common/historyfeed"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	defaultHistoryEventFeedPageSize = 100
+)
+
+var _ historyeventfeedservice.HistoryEventFeedServiceServer = (*HistoryEventFeedHandler)(nil)
+
+type (
+	// HistoryEventFeedHandler - gRPC handler interface for the history event feed service
+	HistoryEventFeedHandler struct {
+		historyeventfeedservice.UnimplementedHistoryEventFeedServiceServer
+
+		config                  *Config
+		logger                  log.Logger
+		namespaceRegistry       namespace.Registry
+		historyEventFeedManager persistence.HistoryEventFeedManager
+	}
+)
+
+// NewHistoryEventFeedHandler creates a gRPC handler for the history event feed service
+func NewHistoryEventFeedHandler(
+	config *Config,
+	logger log.Logger,
+	namespaceRegistry namespace.Registry,
+	historyEventFeedManager persistence.HistoryEventFeedManager,
+) *HistoryEventFeedHandler {
+	return &HistoryEventFeedHandler{
+		config:                  config,
+		logger:                  logger,
+		namespaceRegistry:       namespaceRegistry,
+		historyEventFeedManager: historyEventFeedManager,
+	}
+}
+
+// ReadHistoryEventFeed reads a page of the history event feed of a namespace, from the record after the request cursor.
+// Records deleted by the feed retention before they were read are reported in the response, so that readers know they
+// missed them.
+// When the request waits for new records and the feed has no record after the cursor, the feed is polled until new
+// records are published or the request is about to time out.
+func (h *HistoryEventFeedHandler) ReadHistoryEventFeed(
+	ctx context.Context,
+	request *historyeventfeedservice.ReadHistoryEventFeedRequest,
+) (_ *historyeventfeedservice.ReadHistoryEventFeedResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultHistoryEventFeedPageSize
+	}
+	if maxPageSize := h.config.HistoryMaxPageSize(request.GetNamespace()); pageSize > maxPageSize {
+		return nil, serviceerror.NewInvalidArgumentf(errPageSizeTooBigMessage, maxPageSize)
+	}
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	filter := historyfeed.Filter{
+		WorkflowTypes: request.GetWorkflowTypes(),
+		EventTypes:    request.GetEventTypes(),
+	}
+	response := &historyeventfeedservice.ReadHistoryEventFeedResponse{
+		NextCursor: request.GetCursor(),
+	}
+	for {
+		resp, err := h.historyEventFeedManager.ReadHistoryEventFeedRecords(ctx, &persistence.ReadHistoryEventFeedRecordsRequest{
+			NamespaceID:   namespaceID.String(),
+			PageSize:      pageSize,
+			NextPageToken: request.GetCursor(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.TrimmedRecords += resp.TrimmedRecords
+		for _, message := range resp.Records {
+			response.NextCursor = message.Cursor
+			if record := filter.Apply(message.Record); record != nil {
+				response.Entries = append(response.Entries, &historyeventfeedservice.HistoryEventFeedEntry{
+					Record: record,
+					Cursor: message.Cursor,
+				})
+			}
+		}
+		if len(resp.Records) > 0 || !request.GetWaitNewRecords() || contextNearDeadline(ctx, longPollTailRoom) {
+			return response, nil
+		}
+
+		// Caught up with the feed, wait for new records.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(h.config.HistoryEventFeedPollInterval()):
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyeventfeedservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testHistoryEventFeedNamespace   = namespace.Name("history-event-feed-namespace")
+	testHistoryEventFeedNamespaceID = namespace.ID("history-event-feed-namespace-id")
+)
+
+func newTestHistoryEventFeedHandler(t *testing.T) (*HistoryEventFeedHandler, *persistence.MockHistoryEventFeedManager) {
+	controller := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespaceID(testHistoryEventFeedNamespace).Return(testHistoryEventFeedNamespaceID, nil).AnyTimes()
+	historyEventFeedManager := persistence.NewMockHistoryEventFeedManager(controller)
+	config := &Config{
+		HistoryMaxPageSize:           dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		HistoryEventFeedPollInterval: dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+	}
+	return NewHistoryEventFeedHandler(config, log.NewTestLogger(), namespaceRegistry, historyEventFeedManager), historyEventFeedManager
+}
+
+func TestReadHistoryEventFeed(t *testing.T) {
+	handler, historyEventFeedManager := newTestHistoryEventFeedHandler(t)
+
+	historyEventFeedManager.EXPECT().ReadHistoryEventFeedRecords(gomock.Any(), &persistence.ReadHistoryEventFeedRecordsRequest{
+		NamespaceID:   testHistoryEventFeedNamespaceID.String(),
+		PageSize:      10,
+		NextPageToken: []byte("cursor-0"),
+	}).Return(&persistence.ReadHistoryEventFeedRecordsResponse{
+		Records: []persistence.HistoryEventFeedMessage{
+			{
+				Record: &persistencespb.HistoryEventFeedRecord{
+					WorkflowId:   "workflow-1",
+					WorkflowType: "selected",
+					Events: []*historypb.HistoryEvent{
+						{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+						{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+					},
+				},
+				Cursor: []byte("cursor-1"),
+			},
+			{
+				Record: &persistencespb.HistoryEventFeedRecord{
+					WorkflowId:   "workflow-2",
+					WorkflowType: "other",
+				},
+				Cursor: []byte("cursor-2"),
+			},
+		},
+		NextPageToken:  []byte("cursor-2"),
+		TrimmedRecords: 3,
+	}, nil)
+
+	resp, err := handler.ReadHistoryEventFeed(context.Background(), &historyeventfeedservice.ReadHistoryEventFeedRequest{
+		Namespace:     testHistoryEventFeedNamespace.String(),
+		Cursor:        []byte("cursor-0"),
+		WorkflowTypes: []string{"selected"},
+		EventTypes:    []enumspb.EventType{enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+		PageSize:      10,
+	})
+	require.NoError(t, err)
+	// Records filtered out still move the cursor past them.
+	require.Equal(t, []byte("cursor-2"), resp.GetNextCursor())
+	// Records trimmed before they were read are reported to the reader.
+	require.Equal(t, int64(3), resp.GetTrimmedRecords())
+	require.Len(t, resp.GetEntries(), 1)
+	require.Equal(t, "workflow-1", resp.GetEntries()[0].GetRecord().GetWorkflowId())
+	require.Equal(t, []byte("cursor-1"), resp.GetEntries()[0].GetCursor())
+	require.Len(t, resp.GetEntries()[0].GetRecord().GetEvents(), 1)
+	require.Equal(t, int64(2), resp.GetEntries()[0].GetRecord().GetEvents()[0].GetEventId())
+}
+
+func TestReadHistoryEventFeed_CaughtUp(t *testing.T) {
+	handler, historyEventFeedManager := newTestHistoryEventFeedHandler(t)
+
+	historyEventFeedManager.EXPECT().ReadHistoryEventFeedRecords(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryEventFeedRecordsResponse{}, nil)
+
+	resp, err := handler.ReadHistoryEventFeed(context.Background(), &historyeventfeedservice.ReadHistoryEventFeedRequest{
+		Namespace: testHistoryEventFeedNamespace.String(),
+		Cursor:    []byte("cursor-1"),
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetEntries())
+	require.Equal(t, []byte("cursor-1"), resp.GetNextCursor())
+}
+
+func TestReadHistoryEventFeed_WaitNewRecords(t *testing.T) {
+	handler, historyEventFeedManager := newTestHistoryEventFeedHandler(t)
+
+	// The feed is empty until the third read, the request waits for new records.
+	reads := 0
+	historyEventFeedManager.EXPECT().ReadHistoryEventFeedRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryEventFeedRecordsRequest) (*persistence.ReadHistoryEventFeedRecordsResponse, error) {
+			reads++
+			require.Equal(t, defaultHistoryEventFeedPageSize, request.PageSize)
+			require.Empty(t, request.NextPageToken)
+			if reads < 3 {
+				return &persistence.ReadHistoryEventFeedRecordsResponse{}, nil
+			}
+			return &persistence.ReadHistoryEventFeedRecordsResponse{
+				Records: []persistence.HistoryEventFeedMessage{{
+					Record: &persistencespb.HistoryEventFeedRecord{WorkflowId: "workflow-1"},
+					Cursor: []byte("cursor-1"),
+				}},
+				NextPageToken: []byte("cursor-1"),
+			}, nil
+		}).Times(3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := handler.ReadHistoryEventFeed(ctx, &historyeventfeedservice.ReadHistoryEventFeedRequest{
+		Namespace:      testHistoryEventFeedNamespace.String(),
+		WaitNewRecords: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 1)
+	require.Equal(t, "workflow-1", resp.GetEntries()[0].GetRecord().GetWorkflowId())
+	require.Equal(t, []byte("cursor-1"), resp.GetNextCursor())
+}
+
+func TestReadHistoryEventFeed_InvalidRequest(t *testing.T) {
+	handler, _ := newTestHistoryEventFeedHandler(t)
+
+	_, err := handler.ReadHistoryEventFeed(context.Background(), &historyeventfeedservice.ReadHistoryEventFeedRequest{})
+	require.Equal(t, errNamespaceNotSet, err)
+
+	_, err = handler.ReadHistoryEventFeed(context.Background(), &historyeventfeedservice.ReadHistoryEventFeedRequest{
+		Namespace: testHistoryEventFeedNamespace.String(),
+		PageSize:  1001,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
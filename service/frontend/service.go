@@ -9,10 +9,13 @@ import (
 
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/activitybatchservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyeventfeedservice/v1"
 	"go.temporal.io/server/chasm/lib/activity"
 	"go.temporal.io/server/chasm/lib/callback"
 	chasmnexus "go.temporal.io/server/chasm/lib/nexusoperation"
+	nexusoperationpb "go.temporal.io/server/chasm/lib/nexusoperation/gen/nexusoperationpb/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -106,6 +109,10 @@ type Config struct {
 	// Namespace specific config
 	EnableNamespaceNotActiveAutoForwarding  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ForceNamespaceSelectedAPIAutoForwarding dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	ShadowTrafficTargetCluster              dynamicconfig.StringPropertyFnWithNamespaceFilter
+	ShadowTrafficSampleRate                 dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	ShadowTrafficMaxOutstandingRequests     dynamicconfig.IntPropertyFn
+	ShadowTrafficTimeout                    dynamicconfig.DurationPropertyFn
 	NamespaceMinRetentionLocal              dynamicconfig.DurationPropertyFn
 	NamespaceMinRetentionGlobal             dynamicconfig.DurationPropertyFn
 
@@ -225,6 +232,10 @@ type Config struct {
 	MaxLinksPerRequest dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	AdminEnableListHistoryTasks dynamicconfig.BoolPropertyFn
+	// HistoryReplicationDLQV2 tells which DLQ the history service writes the replication tasks it fails to apply to.
+	HistoryReplicationDLQV2 dynamicconfig.BoolPropertyFn
+
+	HistoryEventFeedPollInterval dynamicconfig.DurationPropertyFn
 
 	MaskInternalErrorDetails dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -351,6 +362,10 @@ func NewConfig(
 		ShutdownFailHealthCheckDuration:          dynamicconfig.FrontendShutdownFailHealthCheckDuration.Get(dc),
 		EnableNamespaceNotActiveAutoForwarding:   dynamicconfig.EnableNamespaceNotActiveAutoForwarding.Get(dc),
 		ForceNamespaceSelectedAPIAutoForwarding:  dynamicconfig.ForceNamespaceSelectedAPIAutoForwarding.Get(dc),
+		ShadowTrafficTargetCluster:               dynamicconfig.FrontendShadowTrafficTargetCluster.Get(dc),
+		ShadowTrafficSampleRate:                  dynamicconfig.FrontendShadowTrafficSampleRate.Get(dc),
+		ShadowTrafficMaxOutstandingRequests:      dynamicconfig.FrontendShadowTrafficMaxOutstandingRequests.Get(dc),
+		ShadowTrafficTimeout:                     dynamicconfig.FrontendShadowTrafficTimeout.Get(dc),
 		NamespaceMinRetentionLocal:               dynamicconfig.NamespaceMinRetentionLocal.Get(dc),
 		NamespaceMinRetentionGlobal:              dynamicconfig.NamespaceMinRetentionGlobal.Get(dc),
 		SearchAttributesNumberOfKeysLimit:        dynamicconfig.SearchAttributesNumberOfKeysLimit.Get(dc),
@@ -420,6 +435,9 @@ func NewConfig(
 
 		CallbackEndpointConfigs:     callback.AllowedAddresses.Get(dc),
 		AdminEnableListHistoryTasks: dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		HistoryReplicationDLQV2:     dynamicconfig.EnableHistoryReplicationDLQV2.Get(dc),
+
+		HistoryEventFeedPollInterval: dynamicconfig.FrontendHistoryEventFeedPollInterval.Get(dc),
 
 		MaskInternalErrorDetails: dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc),
 
@@ -457,6 +475,9 @@ type Service struct {
 	handler           Handler
 	adminHandler      *AdminHandler
 	operatorHandler   *OperatorHandlerImpl
+	feedHandler       *HistoryEventFeedHandler
+	batchHandler      *ActivityBatchHandler
+	fanOutHandler     nexusoperationpb.NexusFanOutServiceServer
 	versionChecker    *VersionChecker
 	visibilityManager manager.VisibilityManager
 	server            *grpc.Server
@@ -476,6 +497,9 @@ func NewService(
 	handler Handler,
 	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
+	feedHandler *HistoryEventFeedHandler,
+	batchHandler *ActivityBatchHandler,
+	fanOutHandler nexusoperationpb.NexusFanOutServiceServer,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
 	logger log.Logger,
@@ -491,6 +515,9 @@ func NewService(
 		handler:           handler,
 		adminHandler:      adminHandler,
 		operatorHandler:   operatorHandler,
+		feedHandler:       feedHandler,
+		batchHandler:      batchHandler,
+		fanOutHandler:     fanOutHandler,
 		versionChecker:    versionChecker,
 		visibilityManager: visibilityMgr,
 		logger:            logger,
@@ -508,6 +535,9 @@ func (s *Service) Start() {
 	workflowservice.RegisterWorkflowServiceServer(s.server, s.handler)
 	adminservice.RegisterAdminServiceServer(s.server, s.adminHandler)
 	operatorservice.RegisterOperatorServiceServer(s.server, s.operatorHandler)
+	historyeventfeedservice.RegisterHistoryEventFeedServiceServer(s.server, s.feedHandler)
+	activitybatchservice.RegisterActivityBatchServiceServer(s.server, s.batchHandler)
+	nexusoperationpb.RegisterNexusFanOutServiceServer(s.server, s.fanOutHandler)
 
 	reflection.Register(s.server)
 
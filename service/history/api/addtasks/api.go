@@ -42,7 +42,6 @@ func Invoke(
 	ctx context.Context,
 	shardContext historyi.ShardContext,
 	deserializer TaskDeserializer,
-	numShards int,
 	req *historyservice.AddTasksRequest,
 	taskRegistry tasks.TaskCategoryRegistry,
 ) (*historyservice.AddTasksResponse, error) {
@@ -82,8 +81,11 @@ func Invoke(
 			return nil, err
 		}
 
-		shardID := tasks.GetShardIDForTask(deserializedTask, numShards)
-		if shardID != int(req.ShardId) {
+		shardID, err := shardContext.GetShardPools().ShardID(deserializedTask.GetNamespaceID(), deserializedTask.GetWorkflowID())
+		if err != nil {
+			return nil, err
+		}
+		if shardID != req.ShardId {
 			return nil, serviceerror.NewInvalidArgumentf(
 				"Task is for wrong shard: index = %d, task shard = %d, request shard = %d",
 				i, shardID, req.ShardId,
@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/service/history/api/addtasks"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -26,7 +27,7 @@ type (
 	testParams struct {
 		shardContext historyi.ShardContext
 		deserializer addtasks.TaskDeserializer
-		numShards    int
+		numShards    int32
 		req          *historyservice.AddTasksRequest
 		// expectation is invoked with the result of addtasks.Invoke.
 		expectation func(*historyservice.AddTasksResponse, error)
@@ -220,11 +221,12 @@ func TestInvoke(t *testing.T) {
 			t.Parallel()
 			params := getDefaultTestParams(t)
 			tc.configure(t, params)
+			params.shardContext.(*historyi.MockShardContext).EXPECT().GetShardPools().
+				Return(shardpooltest.NewResolver(params.numShards)).AnyTimes()
 			resp, err := addtasks.Invoke(
 				context.Background(),
 				params.shardContext,
 				params.deserializer,
-				params.numShards,
 				params.req,
 				tasks.NewDefaultTaskCategoryRegistry(),
 			)
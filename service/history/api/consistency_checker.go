@@ -4,9 +4,11 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	clockspb "go.temporal.io/server/api/clock/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/definition"
@@ -184,17 +186,47 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLeaseImpl(
 		return nil, err
 	}
 
+	var workflowLease WorkflowLease
+	var err error
 	if len(workflowKey.RunID) != 0 {
-		return c.getWorkflowLease(ctx, consistencyPredicate, workflowKey, archetypeID, lockPriority)
+		workflowLease, err = c.getWorkflowLease(ctx, consistencyPredicate, workflowKey, archetypeID, lockPriority)
+	} else {
+		workflowLease, err = c.getCurrentWorkflowLease(
+			ctx,
+			consistencyPredicate,
+			workflowKey.NamespaceID,
+			workflowKey.WorkflowID,
+			archetypeID,
+			lockPriority,
+		)
 	}
+	if err != nil {
+		return nil, c.explainFilteredReplication(workflowKey.NamespaceID, err)
+	}
+	return workflowLease, nil
+}
 
-	return c.getCurrentWorkflowLease(
-		ctx,
-		consistencyPredicate,
-		workflowKey.NamespaceID,
-		workflowKey.WorkflowID,
-		archetypeID,
-		lockPriority,
+// explainFilteredReplication adds the reason to a NotFound error on a standby cluster of a namespace which only
+// replicates the workflows matching its replication filter, as the workflow is most likely not replicated.
+func (c *WorkflowConsistencyCheckerImpl) explainFilteredReplication(
+	namespaceID string,
+	err error,
+) error {
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return err
+	}
+	namespaceEntry, nsErr := c.shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(namespaceID))
+	if nsErr != nil ||
+		!namespaceEntry.IsGlobalNamespace() ||
+		namespaceEntry.ActiveInCluster(c.shardContext.GetClusterMetadata().GetCurrentClusterName()) ||
+		namespaceEntry.ReplicationFilter() == nil {
+		return err
+	}
+	return serviceerror.NewNotFoundf(
+		"%s: namespace %s only replicates the workflows matching its replication filter to this cluster, retry on the active cluster",
+		notFound.Message,
+		namespaceEntry.Name(),
 	)
 }
 
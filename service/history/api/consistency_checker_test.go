@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -11,7 +12,9 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	clockspb "go.temporal.io/server/api/clock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
@@ -107,6 +110,68 @@ func (s *workflowConsistencyCheckerSuite) TestGetWorkflowContextValidatedByCheck
 	s.Equal(mutableState, workflowLease.GetMutableState())
 	s.False(released)
 }
+func (s *workflowConsistencyCheckerSuite) TestGetWorkflowLease_NotFound_FilteredReplication() {
+	ctx := context.Background()
+
+	namespaceRegistry := namespace.NewMockRegistry(s.controller)
+	clusterMetadata := cluster.NewMockMetadata(s.controller)
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(namespaceRegistry).AnyTimes()
+	s.shardContext.EXPECT().GetClusterMetadata().Return(clusterMetadata).AnyTimes()
+	for _, tc := range []struct {
+		name           string
+		currentCluster string
+		filter         *persistencespb.ReplicationFilter
+		explained      bool
+	}{
+		{
+			name:           "standby with filter",
+			currentCluster: cluster.TestAlternativeClusterName,
+			filter:         &persistencespb.ReplicationFilter{WorkflowTypes: []string{"replicated-type"}},
+			explained:      true,
+		},
+		{
+			name:           "standby without filter",
+			currentCluster: cluster.TestAlternativeClusterName,
+		},
+		{
+			name:           "active with filter",
+			currentCluster: cluster.TestCurrentClusterName,
+			filter:         &persistencespb.ReplicationFilter{WorkflowTypes: []string{"replicated-type"}},
+		},
+	} {
+		s.Run(tc.name, func() {
+			namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(s.namespaceID)).Return(namespace.NewGlobalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: "test-namespace"},
+				nil,
+				&persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+					ReplicationFilter: tc.filter,
+				},
+				1,
+			), nil)
+			clusterMetadata.EXPECT().GetCurrentClusterName().Return(tc.currentCluster)
+			s.workflowCache.EXPECT().GetOrCreateChasmExecution(
+				ctx,
+				s.shardContext,
+				namespace.ID(s.namespaceID),
+				gomock.Any(),
+				chasm.WorkflowArchetypeID,
+				locks.PriorityHigh,
+			).Return(nil, nil, serviceerror.NewNotFound("workflow execution not found"))
+
+			_, err := s.checker.GetWorkflowLease(
+				ctx, nil,
+				definition.NewWorkflowKey(s.namespaceID, s.workflowID, s.currentRunID),
+				locks.PriorityHigh,
+			)
+			var notFound *serviceerror.NotFound
+			s.ErrorAs(err, &notFound)
+			s.Equal(tc.explained, strings.Contains(err.Error(), "replication filter"))
+		})
+	}
+}
+
 func (s *workflowConsistencyCheckerSuite) TestGetCurrentRunID_Success() {
 	ctx := context.Background()
 
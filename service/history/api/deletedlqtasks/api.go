@@ -6,15 +6,22 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/tasks"
 )
 
+// readPageSize is the page size used to read the tasks which have to be tested against the filter of a request.
+const readPageSize = 1000
+
+// Invoke deletes the tasks of a DLQ up to the inclusive max message ID of the request. When the request has a filter,
+// only the matching tasks are deleted by their message ID, and the other tasks are left in place.
 func Invoke(
 	ctx context.Context,
 	historyTaskQueueManager persistence.HistoryTaskQueueManager,
 	req *historyservice.DeleteDLQTasksRequest,
 	registry tasks.TaskCategoryRegistry,
+	serializer serialization.Serializer,
 ) (*historyservice.DeleteDLQTasksResponse, error) {
 	category, err := api.GetTaskCategory(int(req.DlqKey.TaskCategory), registry)
 	if err != nil {
@@ -25,13 +32,22 @@ func Invoke(
 		return nil, serviceerror.NewInvalidArgument("must supply inclusive_max_task_metadata")
 	}
 
+	queueKey := persistence.QueueKey{
+		QueueType:     persistence.QueueTypeHistoryDLQ,
+		Category:      category,
+		SourceCluster: req.DlqKey.SourceCluster,
+		TargetCluster: req.DlqKey.TargetCluster,
+	}
+	if req.Filter != nil {
+		messagesDeleted, err := deleteMatchedTasks(ctx, historyTaskQueueManager, req, queueKey, serializer)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: messagesDeleted}, nil
+	}
+
 	resp, err := historyTaskQueueManager.DeleteTasks(ctx, &persistence.DeleteTasksRequest{
-		QueueKey: persistence.QueueKey{
-			QueueType:     persistence.QueueTypeHistoryDLQ,
-			Category:      category,
-			SourceCluster: req.DlqKey.SourceCluster,
-			TargetCluster: req.DlqKey.TargetCluster,
-		},
+		QueueKey: queueKey,
 		InclusiveMaxMessageMetadata: persistence.MessageMetadata{
 			ID: req.InclusiveMaxTaskMetadata.MessageId,
 		},
@@ -39,6 +55,63 @@ func Invoke(
 	if err != nil {
 		return nil, err
 	}
-
 	return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
 }
+
+// deleteMatchedTasks deletes the tasks up to the inclusive max message ID of the request which match its filter, one
+// page at a time, and returns the number of deleted tasks. Tasks enqueued after the max message ID are never read, so
+// this terminates even when the max message ID is unbounded.
+func deleteMatchedTasks(
+	ctx context.Context,
+	historyTaskQueueManager persistence.HistoryTaskQueueManager,
+	req *historyservice.DeleteDLQTasksRequest,
+	queueKey persistence.QueueKey,
+	serializer serialization.Serializer,
+) (int64, error) {
+	predicate := api.NewDLQTaskPredicate(req.Filter)
+	maxMessageID := req.InclusiveMaxTaskMetadata.MessageId
+
+	var (
+		messagesDeleted int64
+		nextPageToken   []byte
+	)
+	for {
+		response, err := historyTaskQueueManager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
+			QueueKey:      queueKey,
+			PageSize:      readPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		var matched []int64
+		for _, task := range response.Tasks {
+			if task.MessageMetadata.ID > maxMessageID {
+				break
+			}
+			dlqTask, err := api.DeserializeDLQTask(serializer, queueKey.Category, task)
+			if err != nil {
+				return 0, err
+			}
+			if predicate.Test(dlqTask) {
+				matched = append(matched, task.MessageMetadata.ID)
+			}
+		}
+		if len(matched) > 0 {
+			resp, err := historyTaskQueueManager.DeleteTasksByID(ctx, &persistence.DeleteTasksByIDRequest{
+				QueueKey:   queueKey,
+				MessageIDs: matched,
+			})
+			if err != nil {
+				return 0, err
+			}
+			messagesDeleted += resp.MessagesDeleted
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 ||
+			len(response.Tasks) == 0 ||
+			response.Tasks[len(response.Tasks)-1].MessageMetadata.ID >= maxMessageID {
+			return messagesDeleted, nil
+		}
+	}
+}
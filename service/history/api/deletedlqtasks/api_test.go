@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/persistencetest"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api/deletedlqtasks"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/grpc/codes"
@@ -26,7 +27,7 @@ func TestInvoke_InvalidCategory(t *testing.T) {
 			SourceCluster: queueKey.SourceCluster,
 			TargetCluster: queueKey.TargetCluster,
 		},
-	}, tasks.NewDefaultTaskCategoryRegistry())
+	}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
 	require.Error(t, err)
 	assert.Equal(t, codes.InvalidArgument, serviceerror.ToStatus(err).Code())
 	assert.ErrorContains(t, err, "-1")
@@ -42,7 +43,7 @@ func TestInvoke_ErrDeleteMissingMessageIDUpperBound(t *testing.T) {
 			SourceCluster: queueKey.SourceCluster,
 			TargetCluster: queueKey.TargetCluster,
 		},
-	}, tasks.NewDefaultTaskCategoryRegistry())
+	}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
 	require.Error(t, err)
 	assert.Equal(t, codes.InvalidArgument, serviceerror.ToStatus(err).Code())
 	assert.ErrorContains(t, err, "inclusive_max_task_metadata")
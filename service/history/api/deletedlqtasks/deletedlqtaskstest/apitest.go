@@ -2,6 +2,7 @@ package deletedlqtaskstest
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,8 +10,10 @@ import (
 	"go.temporal.io/api/serviceerror"
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/persistencetest"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api/deletedlqtasks"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/grpc/codes"
@@ -45,7 +48,7 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 				MessageId: persistence.FirstQueueMessageID + 1,
 			},
-		}, tasks.NewDefaultTaskCategoryRegistry())
+		}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
 		require.NoError(t, err)
 		resp, err := manager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
 			QueueKey: queueKey,
@@ -55,6 +58,114 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 		require.Len(t, resp.Tasks, 1)
 		assert.Equal(t, int64(persistence.FirstQueueMessageID+2), resp.Tasks[0].MessageMetadata.ID)
 	})
+	t.Run("Filter", func(t *testing.T) {
+		t.Parallel()
+
+		queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+		_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+			QueueKey: queueKey,
+		})
+		require.NoError(t, err)
+		for _, namespaceID := range []string{"ns-1", "ns-2", "ns-1", "ns-2"} {
+			_, err := manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+				QueueType:     queueKey.QueueType,
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+				Task: &tasks.WorkflowTask{
+					WorkflowKey: definition.NewWorkflowKey(namespaceID, "workflow", "run"),
+				},
+				SourceShardID: 1,
+				Failure:       "some failure",
+			})
+			require.NoError(t, err)
+		}
+		resp, err := deletedlqtasks.Invoke(ctx, manager, &historyservice.DeleteDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: persistence.FirstQueueMessageID + 2,
+			},
+			Filter: &commonspb.HistoryDLQTaskFilter{
+				NamespaceIds: []string{"ns-1"},
+			},
+		}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.MessagesDeleted)
+		readResp, err := manager.ReadTasks(ctx, &persistence.ReadTasksRequest{
+			QueueKey: queueKey,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		// The unmatched task in range and the task out of range are left in place.
+		require.Len(t, readResp.Tasks, 2)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+1), readResp.Tasks[0].MessageMetadata.ID)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+3), readResp.Tasks[1].MessageMetadata.ID)
+		assert.Equal(t, "ns-2", readResp.Tasks[0].Task.GetNamespaceID())
+		rawResp, err := manager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
+			QueueKey: queueKey,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, rawResp.Tasks, 2)
+		assert.Equal(t, "some failure", rawResp.Tasks[1].Payload.GetFailure())
+	})
+	t.Run("FilterUnboundedMaxMessageID", func(t *testing.T) {
+		t.Parallel()
+
+		queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+		_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+			QueueKey: queueKey,
+		})
+		require.NoError(t, err)
+		for _, namespaceID := range []string{"ns-1", "ns-2", "ns-1"} {
+			_, err := manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+				QueueType:     queueKey.QueueType,
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+				Task: &tasks.WorkflowTask{
+					WorkflowKey: definition.NewWorkflowKey(namespaceID, "workflow", "run"),
+				},
+				SourceShardID: 1,
+			})
+			require.NoError(t, err)
+		}
+		resp, err := deletedlqtasks.Invoke(ctx, manager, &historyservice.DeleteDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: math.MaxInt64,
+			},
+			Filter: &commonspb.HistoryDLQTaskFilter{
+				NamespaceIds: []string{"ns-1"},
+			},
+		}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.MessagesDeleted)
+		// The last task of the queue was deleted, but its ID must not be reused.
+		enqueueResp, err := manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+			QueueType:     queueKey.QueueType,
+			SourceCluster: queueKey.SourceCluster,
+			TargetCluster: queueKey.TargetCluster,
+			Task:          &tasks.WorkflowTask{},
+			SourceShardID: 1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+3), enqueueResp.Metadata.ID)
+		readResp, err := manager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
+			QueueKey: queueKey,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, readResp.Tasks, 2)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+1), readResp.Tasks[0].MessageMetadata.ID)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+3), readResp.Tasks[1].MessageMetadata.ID)
+	})
 	t.Run("QueueDoesNotExist", func(t *testing.T) {
 		t.Parallel()
 
@@ -68,7 +179,7 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 				MessageId: persistence.FirstQueueMessageID,
 			},
-		}, tasks.NewDefaultTaskCategoryRegistry())
+		}, tasks.NewDefaultTaskCategoryRegistry(), serialization.NewSerializer())
 		require.Error(t, err)
 		assert.Equal(t, codes.NotFound, serviceerror.ToStatus(err).Code(), err.Error())
 	})
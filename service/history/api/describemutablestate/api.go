@@ -2,14 +2,18 @@ package describemutablestate
 
 import (
 	"context"
+	"time"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/api"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/ndc"
 	"go.temporal.io/server/service/history/workflow"
 )
 
@@ -29,6 +33,12 @@ func Invoke(
 	if archetypeID == chasm.UnspecifiedArchetypeID {
 		archetypeID = chasm.WorkflowArchetypeID
 	}
+	if req.GetAtEventId() != 0 {
+		if archetypeID != chasm.WorkflowArchetypeID {
+			return nil, serviceerror.NewInvalidArgument("AtEventId is only supported for workflows.")
+		}
+		return describeAtEvent(ctx, req, shardContext, workflowConsistencyChecker)
+	}
 
 	chasmLease, err := workflowConsistencyChecker.GetChasmLease(
 		ctx,
@@ -65,3 +75,92 @@ func Invoke(
 	response.DatabaseMutableState = mutableState.CloneToProto()
 	return response, nil
 }
+
+// describeAtEvent rebuilds the mutable state of a workflow in memory from its current history branch up to the
+// requested event. Nothing is persisted, and the workflow lock is only held while reading its current mutable state.
+func describeAtEvent(
+	ctx context.Context,
+	req *historyservice.DescribeMutableStateRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.DescribeMutableStateResponse, error) {
+	workflowKey := definition.NewWorkflowKey(
+		req.NamespaceId,
+		req.Execution.WorkflowId,
+		req.Execution.RunId,
+	)
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		workflowKey,
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	mutableState := workflowLease.GetMutableState()
+	workflowKey = mutableState.GetWorkflowKey()
+	nextEventID := mutableState.GetNextEventID()
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	requestID := mutableState.GetExecutionState().GetCreateRequestId()
+	workflowLease.GetReleaseFn()(err)
+	if err != nil {
+		return nil, err
+	}
+
+	atEventID := req.GetAtEventId()
+	if atEventID < common.FirstEventID || atEventID >= nextEventID {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"AtEventId %v is out of range, the last event of the workflow is %v.",
+			atEventID,
+			nextEventID-1,
+		)
+	}
+
+	return rebuildAtEvent(
+		ctx,
+		ndc.NewStateRebuilder(shardContext, shardContext.GetLogger()),
+		shardContext.GetTimeSource().Now(),
+		workflowKey,
+		branchToken,
+		atEventID,
+		requestID,
+	)
+}
+
+// rebuildAtEvent replays history up to atEventID. The rebuilder always applies whole event batches, so an event ID
+// that is not the last event of its batch is rejected rather than describing a later state than the one requested.
+func rebuildAtEvent(
+	ctx context.Context,
+	stateRebuilder ndc.StateRebuilder,
+	now time.Time,
+	workflowKey definition.WorkflowKey,
+	branchToken []byte,
+	atEventID int64,
+	requestID string,
+) (*historyservice.DescribeMutableStateResponse, error) {
+	rebuiltMutableState, _, err := stateRebuilder.Rebuild(
+		ctx,
+		now,
+		workflowKey,
+		branchToken,
+		atEventID,
+		nil,
+		workflowKey,
+		branchToken,
+		requestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if lastAppliedEventID := rebuiltMutableState.GetNextEventID() - 1; lastAppliedEventID != atEventID {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"AtEventId %v is not the last event of its event batch, the batch ends at event %v.",
+			atEventID,
+			lastAppliedEventID,
+		)
+	}
+	return &historyservice.DescribeMutableStateResponse{
+		AtEventMutableState: rebuiltMutableState.CloneToProto(),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package describemutablestate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/ndc"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInvoke_AtEventOutOfRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), uuid.NewString(), uuid.NewString())
+
+	mutableState := historyi.NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetWorkflowKey().Return(workflowKey)
+	mutableState.EXPECT().GetNextEventID().Return(int64(10))
+	mutableState.EXPECT().GetCurrentBranchToken().Return([]byte("branch-token"), nil)
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{})
+	released := false
+	workflowLease := ndc.NewMockWorkflow(ctrl)
+	workflowLease.EXPECT().GetMutableState().Return(mutableState)
+	workflowLease.EXPECT().GetReleaseFn().Return(func(error) { released = true })
+	consistencyChecker := api.NewMockWorkflowConsistencyChecker(ctrl)
+	consistencyChecker.EXPECT().GetWorkflowLease(gomock.Any(), gomock.Any(), workflowKey, gomock.Any()).Return(workflowLease, nil)
+
+	_, err := Invoke(context.Background(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: workflowKey.NamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		},
+		AtEventId: 10,
+	}, historyi.NewMockShardContext(ctrl), consistencyChecker)
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+	require.ErrorContains(t, err, "the last event of the workflow is 9")
+	require.True(t, released)
+}
+
+func TestInvoke_AtEventRequiresWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := Invoke(context.Background(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: uuid.NewString(),
+		},
+		ArchetypeId: chasm.WorkflowArchetypeID + 1,
+		AtEventId:   5,
+	}, historyi.NewMockShardContext(ctrl), api.NewMockWorkflowConsistencyChecker(ctrl))
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestRebuildAtEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), uuid.NewString(), uuid.NewString())
+	now := time.Now()
+	branchToken := []byte("branch-token")
+	requestID := uuid.NewString()
+
+	mutableStateProto := &persistencespb.WorkflowMutableState{NextEventId: 6}
+	rebuiltMutableState := historyi.NewMockMutableState(ctrl)
+	rebuiltMutableState.EXPECT().GetNextEventID().Return(int64(6))
+	rebuiltMutableState.EXPECT().CloneToProto().Return(mutableStateProto)
+	stateRebuilder := ndc.NewMockStateRebuilder(ctrl)
+	stateRebuilder.EXPECT().Rebuild(
+		gomock.Any(), now, workflowKey, branchToken, int64(5), nil, workflowKey, branchToken, requestID,
+	).Return(rebuiltMutableState, ndc.RebuildStats{}, nil)
+
+	resp, err := rebuildAtEvent(context.Background(), stateRebuilder, now, workflowKey, branchToken, 5, requestID)
+	require.NoError(t, err)
+	require.Same(t, mutableStateProto, resp.GetAtEventMutableState())
+}
+
+func TestRebuildAtEvent_InsideEventBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), uuid.NewString(), uuid.NewString())
+
+	// Event 4 was written in the same batch as events 3 and 5.
+	rebuiltMutableState := historyi.NewMockMutableState(ctrl)
+	rebuiltMutableState.EXPECT().GetNextEventID().Return(int64(6))
+	stateRebuilder := ndc.NewMockStateRebuilder(ctrl)
+	stateRebuilder.EXPECT().Rebuild(
+		gomock.Any(), gomock.Any(), workflowKey, gomock.Any(), int64(4), nil, workflowKey, gomock.Any(), gomock.Any(),
+	).Return(rebuiltMutableState, ndc.RebuildStats{}, nil)
+
+	_, err := rebuildAtEvent(context.Background(), stateRebuilder, time.Now(), workflowKey, []byte("branch-token"), 4, uuid.NewString())
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+	require.ErrorContains(t, err, "the batch ends at event 5")
+}
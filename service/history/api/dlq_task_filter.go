@@ -0,0 +1,125 @@
+package api
+
+import (
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	commonspb "go.temporal.io/server/api/common/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// DLQTask is a task read from a history DLQ.
+	DLQTask struct {
+		Task tasks.Task
+		// Failure is the error which caused the task to be moved to the DLQ, if it was recorded.
+		Failure string
+	}
+
+	// DLQTaskPredicate selects tasks of a history DLQ.
+	DLQTaskPredicate = predicates.Predicate[DLQTask]
+
+	dlqTaskPredicate struct {
+		tasks.Predicate
+	}
+
+	workflowIDPrefixPredicate struct {
+		prefix string
+	}
+
+	failureSubstringPredicate struct {
+		substring string
+	}
+)
+
+var (
+	_ DLQTaskPredicate = (*dlqTaskPredicate)(nil)
+	_ DLQTaskPredicate = (*workflowIDPrefixPredicate)(nil)
+	_ DLQTaskPredicate = (*failureSubstringPredicate)(nil)
+)
+
+// NewDLQTaskPredicate returns the predicate selecting the DLQ tasks which match all the non-empty fields of the filter.
+// A nil filter matches every task.
+func NewDLQTaskPredicate(filter *commonspb.HistoryDLQTaskFilter) DLQTaskPredicate {
+	var filters []DLQTaskPredicate
+	if len(filter.GetNamespaceIds()) != 0 {
+		filters = append(filters, &dlqTaskPredicate{tasks.NewNamespacePredicate(filter.GetNamespaceIds())})
+	}
+	if filter.GetWorkflowIdPrefix() != "" {
+		filters = append(filters, &workflowIDPrefixPredicate{prefix: filter.GetWorkflowIdPrefix()})
+	}
+	if len(filter.GetTaskTypes()) != 0 {
+		filters = append(filters, &dlqTaskPredicate{tasks.NewTypePredicate(filter.GetTaskTypes())})
+	}
+	if filter.GetFailureContains() != "" {
+		filters = append(filters, &failureSubstringPredicate{substring: filter.GetFailureContains()})
+	}
+
+	switch len(filters) {
+	case 0:
+		return predicates.Universal[DLQTask]()
+	case 1:
+		return filters[0]
+	default:
+		return predicates.And(filters...)
+	}
+}
+
+// DeserializeDLQTask deserializes a task read from a history DLQ of the given category.
+func DeserializeDLQTask(
+	serializer serialization.Serializer,
+	category tasks.Category,
+	rawTask persistence.RawHistoryTask,
+) (DLQTask, error) {
+	task, err := serializer.DeserializeTask(category, rawTask.Payload.GetBlob())
+	if err != nil {
+		return DLQTask{}, serviceerror.NewInternalf(
+			"Unable to deserialize DLQ task with message ID %d: %v", rawTask.MessageMetadata.ID, err,
+		)
+	}
+	return DLQTask{
+		Task:    task,
+		Failure: rawTask.Payload.GetFailure(),
+	}, nil
+}
+
+func (p *dlqTaskPredicate) Test(task DLQTask) bool {
+	return p.Predicate.Test(task.Task)
+}
+
+func (p *dlqTaskPredicate) Equals(predicate DLQTaskPredicate) bool {
+	other, ok := predicate.(*dlqTaskPredicate)
+	if !ok {
+		return false
+	}
+	return p.Predicate.Equals(other.Predicate)
+}
+
+func (p *workflowIDPrefixPredicate) Test(task DLQTask) bool {
+	return strings.HasPrefix(task.Task.GetWorkflowID(), p.prefix)
+}
+
+func (p *workflowIDPrefixPredicate) Equals(predicate DLQTaskPredicate) bool {
+	other, ok := predicate.(*workflowIDPrefixPredicate)
+	return ok && p.prefix == other.prefix
+}
+
+func (p *workflowIDPrefixPredicate) Size() int {
+	return predicates.EmptyPredicateProtoSize + len(p.prefix)
+}
+
+func (p *failureSubstringPredicate) Test(task DLQTask) bool {
+	return strings.Contains(task.Failure, p.substring)
+}
+
+func (p *failureSubstringPredicate) Equals(predicate DLQTaskPredicate) bool {
+	other, ok := predicate.(*failureSubstringPredicate)
+	return ok && p.substring == other.substring
+}
+
+func (p *failureSubstringPredicate) Size() int {
+	return predicates.EmptyPredicateProtoSize + len(p.substring)
+}
@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func TestNewDLQTaskPredicate(t *testing.T) {
+	t.Parallel()
+
+	task := api.DLQTask{
+		Task: &tasks.ActivityTask{
+			WorkflowKey: definition.NewWorkflowKey("ns-1", "order-42", "run-1"),
+		},
+		Failure: "activity task failed: context deadline exceeded",
+	}
+	for _, tc := range []struct {
+		name    string
+		filter  *commonspb.HistoryDLQTaskFilter
+		matches bool
+	}{
+		{
+			name:    "nil filter",
+			matches: true,
+		},
+		{
+			name:    "empty filter",
+			filter:  &commonspb.HistoryDLQTaskFilter{},
+			matches: true,
+		},
+		{
+			name: "all fields match",
+			filter: &commonspb.HistoryDLQTaskFilter{
+				NamespaceIds:     []string{"ns-0", "ns-1"},
+				WorkflowIdPrefix: "order-",
+				TaskTypes:        []enumsspb.TaskType{enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK},
+				FailureContains:  "deadline exceeded",
+			},
+			matches: true,
+		},
+		{
+			name:   "namespace mismatch",
+			filter: &commonspb.HistoryDLQTaskFilter{NamespaceIds: []string{"ns-2"}},
+		},
+		{
+			name:   "workflow ID prefix mismatch",
+			filter: &commonspb.HistoryDLQTaskFilter{WorkflowIdPrefix: "invoice-"},
+		},
+		{
+			name:   "task type mismatch",
+			filter: &commonspb.HistoryDLQTaskFilter{TaskTypes: []enumsspb.TaskType{enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK}},
+		},
+		{
+			name: "failure mismatch",
+			filter: &commonspb.HistoryDLQTaskFilter{
+				WorkflowIdPrefix: "order-",
+				FailureContains:  "not found",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.matches, api.NewDLQTaskPredicate(tc.filter).Test(task))
+		})
+	}
+}
+
+func TestNewDLQTaskPredicate_Equals(t *testing.T) {
+	t.Parallel()
+
+	filter := &commonspb.HistoryDLQTaskFilter{
+		NamespaceIds:     []string{"ns-1"},
+		WorkflowIdPrefix: "order-",
+		FailureContains:  "deadline exceeded",
+	}
+	assert.True(t, api.NewDLQTaskPredicate(filter).Equals(api.NewDLQTaskPredicate(filter)))
+	assert.False(t, api.NewDLQTaskPredicate(filter).Equals(api.NewDLQTaskPredicate(&commonspb.HistoryDLQTaskFilter{
+		NamespaceIds:     []string{"ns-1"},
+		WorkflowIdPrefix: "invoice-",
+		FailureContains:  "deadline exceeded",
+	})))
+	assert.Equal(t, predicates.Universal[api.DLQTask](), api.NewDLQTaskPredicate(nil))
+}
+
+func TestDeserializeDLQTask(t *testing.T) {
+	t.Parallel()
+
+	serializer := serialization.NewSerializer()
+	inTask := &tasks.WorkflowTask{TaskID: 42}
+	blob, err := serializer.SerializeTask(inTask)
+	require.NoError(t, err)
+
+	dlqTask, err := api.DeserializeDLQTask(serializer, tasks.CategoryTransfer, persistence.RawHistoryTask{
+		Payload: &persistencespb.HistoryTask{
+			ShardId: 1,
+			Blob:    blob,
+			Failure: "some failure",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, inTask, dlqTask.Task)
+	assert.Equal(t, "some failure", dlqTask.Failure)
+
+	_, err = api.DeserializeDLQTask(serializer, tasks.CategoryTransfer, persistence.RawHistoryTask{
+		MessageMetadata: persistence.MessageMetadata{ID: 7},
+		Payload:         &persistencespb.HistoryTask{ShardId: 1},
+	})
+	assert.ErrorContains(t, err, "message ID 7")
+}
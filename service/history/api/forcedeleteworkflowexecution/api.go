@@ -123,6 +123,14 @@ func Invoke(
 		}
 	}
 
+	if request.GetKeepVisibilityRecord() {
+		return &historyservice.ForceDeleteWorkflowExecutionResponse{
+			Response: &adminservice.DeleteWorkflowExecutionResponse{
+				Warnings: warnings,
+			},
+		}, nil
+	}
+
 	// NOTE: the deletion is best effort, for sql visibility implementation,
 	// we can't guarantee there's no update or record close request for this workflow since
 	// visibility queue processing is async. Operator can call this api again to delete visibility
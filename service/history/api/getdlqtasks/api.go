@@ -9,17 +9,20 @@ import (
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/tasks"
 )
 
 // Invoke the GetDLQTasks API. All errors returned from this function are already translated into the appropriate type
-// from the [serviceerror] package.
+// from the [serviceerror] package. When the request has a filter, only the tasks of the page which match it are
+// returned.
 func Invoke(
 	ctx context.Context,
 	historyTaskQueueManager persistence.HistoryTaskQueueManager,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	serializer serialization.Serializer,
 	req *historyservice.GetDLQTasksRequest,
 ) (*historyservice.GetDLQTasksResponse, error) {
 	category, err := api.GetTaskCategory(int(req.DlqKey.TaskCategory), taskCategoryRegistry)
@@ -45,9 +48,19 @@ func Invoke(
 		return nil, serviceerror.NewUnavailablef("GetDLQTasks failed. Error: %v", err)
 	}
 
-	dlqTasks := make([]*commonspb.HistoryDLQTask, len(response.Tasks))
-	for i, task := range response.Tasks {
-		dlqTasks[i] = &commonspb.HistoryDLQTask{
+	predicate := api.NewDLQTaskPredicate(req.Filter)
+	dlqTasks := make([]*commonspb.HistoryDLQTask, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		if req.Filter != nil {
+			dlqTask, err := api.DeserializeDLQTask(serializer, category, task)
+			if err != nil {
+				return nil, err
+			}
+			if !predicate.Test(dlqTask) {
+				continue
+			}
+		}
+		dlqTasks = append(dlqTasks, &commonspb.HistoryDLQTask{
 			Metadata: &commonspb.HistoryDLQTaskMetadata{
 				MessageId: task.MessageMetadata.ID,
 			},
@@ -55,11 +68,20 @@ func Invoke(
 				ShardId: task.Payload.ShardId,
 				Blob:    task.Payload.Blob,
 			},
+			Failure: task.Payload.Failure,
+		})
+	}
+
+	var lastReadTaskMetadata *commonspb.HistoryDLQTaskMetadata
+	if len(response.Tasks) > 0 {
+		lastReadTaskMetadata = &commonspb.HistoryDLQTaskMetadata{
+			MessageId: response.Tasks[len(response.Tasks)-1].MessageMetadata.ID,
 		}
 	}
 
 	return &historyservice.GetDLQTasksResponse{
-		DlqTasks:      dlqTasks,
-		NextPageToken: response.NextPageToken,
+		DlqTasks:             dlqTasks,
+		NextPageToken:        response.NextPageToken,
+		LastReadTaskMetadata: lastReadTaskMetadata,
 	}, nil
 }
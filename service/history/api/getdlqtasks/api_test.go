@@ -11,6 +11,7 @@ import (
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/tasks"
@@ -27,6 +28,7 @@ func TestInvoke_InvalidTaskCategory(t *testing.T) {
 	_, err := getdlqtasks.Invoke(context.Background(),
 		nil,
 		tasks.NewDefaultTaskCategoryRegistry(),
+		serialization.NewSerializer(),
 		&historyservice.GetDLQTasksRequest{
 			DlqKey: &commonspb.HistoryDLQKey{
 				TaskCategory: -1,
@@ -47,6 +49,7 @@ func TestInvoke_ZeroPageSize(t *testing.T) {
 		context.Background(),
 		new(persistence.HistoryTaskQueueManagerImpl),
 		tasks.NewDefaultTaskCategoryRegistry(),
+		serialization.NewSerializer(),
 		&historyservice.GetDLQTasksRequest{
 			DlqKey: &commonspb.HistoryDLQKey{
 				TaskCategory: int32(tasks.CategoryTransfer.ID()),
@@ -65,6 +68,7 @@ func TestInvoke_UnavailableError(t *testing.T) {
 		context.Background(),
 		failingHistoryTaskQueueManager{},
 		tasks.NewDefaultTaskCategoryRegistry(),
+		serialization.NewSerializer(),
 		&historyservice.GetDLQTasksRequest{
 			DlqKey: &commonspb.HistoryDLQKey{
 				TaskCategory: int32(tasks.CategoryTransfer.ID()),
@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/require"
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/persistencetest"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/tasks"
@@ -45,6 +47,7 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 		context.Background(),
 		manager,
 		tasks.NewDefaultTaskCategoryRegistry(),
+		serialization.NewSerializer(),
 		&historyservice.GetDLQTasksRequest{
 			DlqKey: &commonspb.HistoryDLQKey{
 				TaskCategory:  int32(tasks.CategoryTransfer.ID()),
@@ -63,3 +66,52 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 	require.NoError(t, err)
 	assert.Equal(t, inTask, outTask)
 }
+
+// TestInvokeWithFilter verifies that [getdlqtasks.Invoke] only returns the tasks matching the filter of the request,
+// together with their failure, and reports the last task read.
+func TestInvokeWithFilter(t *testing.T, manager persistence.HistoryTaskQueueManager) {
+	ctx := context.Background()
+	queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+	_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+		QueueKey: queueKey,
+	})
+	require.NoError(t, err)
+	for i, workflowID := range []string{"order-1", "invoice-1", "order-2"} {
+		_, err = manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+			QueueType:     queueKey.QueueType,
+			SourceCluster: queueKey.SourceCluster,
+			TargetCluster: queueKey.TargetCluster,
+			Task: &tasks.WorkflowTask{
+				WorkflowKey: definition.NewWorkflowKey("ns", workflowID, "run"),
+				TaskID:      int64(i),
+			},
+			SourceShardID: 1,
+			Failure:       "failure of " + workflowID,
+		})
+		require.NoError(t, err)
+	}
+	res, err := getdlqtasks.Invoke(
+		ctx,
+		manager,
+		tasks.NewDefaultTaskCategoryRegistry(),
+		serialization.NewSerializer(),
+		&historyservice.GetDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			PageSize: 10,
+			Filter: &commonspb.HistoryDLQTaskFilter{
+				WorkflowIdPrefix: "order-",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, res.DlqTasks, 2)
+	assert.Equal(t, int64(persistence.FirstQueueMessageID), res.DlqTasks[0].Metadata.MessageId)
+	assert.Equal(t, "failure of order-1", res.DlqTasks[0].Failure)
+	assert.Equal(t, int64(persistence.FirstQueueMessageID+2), res.DlqTasks[1].Metadata.MessageId)
+	assert.Equal(t, "failure of order-2", res.DlqTasks[1].Failure)
+	assert.Equal(t, int64(persistence.FirstQueueMessageID+2), res.LastReadTaskMetadata.GetMessageId())
+}
@@ -92,11 +92,7 @@ func Invoke(
 	}
 
 	pageSize := int(req.GetMaximumPageSize())
-	shardID := common.WorkflowIDToHistoryShard(
-		ns.ID().String(),
-		execution.GetWorkflowId(),
-		shardContext.GetConfig().NumberOfShards,
-	)
+	shardID := shardContext.GetShardID()
 	rawHistoryResponse, err := shardContext.GetExecutionManager().ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken: targetVersionHistory.GetBranchToken(),
 		// GetWorkflowExecutionRawHistory is inclusive/inclusive.
@@ -48,6 +48,10 @@ func GenerateTask(
 	defer func() { chasmLease.GetReleaseFn()(retError) }()
 
 	mutableState := chasmLease.GetMutableState()
+	if mutableState.GetExecutionInfo().GetExcludedFromReplication() {
+		// The execution is excluded from replication by the namespace replication filter.
+		return &historyservice.GenerateLastHistoryReplicationTasksResponse{}, nil
+	}
 	replicationTasks, stateTransitionCount, err := mutableState.GenerateMigrationTasks(request.GetTargetClusters())
 	if err != nil {
 		return nil, err
@@ -2,13 +2,25 @@ package replication
 
 import (
 	"context"
+	"errors"
 
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/replication"
+	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultMaxScannedTasks = 1000
+	scanBatchSize          = 100
+)
+
 func GetStatus(
 	ctx context.Context,
 	request *historyservice.GetReplicationStatusRequest,
@@ -30,5 +42,93 @@ func GetStatus(
 	}
 	resp.RemoteClusters = remoteClusters
 	resp.HandoverNamespaces = handoverNamespaces
+
+	if request.GetIncludeNamespaceBacklog() {
+		maxScannedTasks := int(request.GetMaxScannedTasks())
+		if maxScannedTasks <= 0 {
+			maxScannedTasks = defaultMaxScannedTasks
+		}
+		for clusterName, status := range remoteClusters {
+			if status.AckedTaskId >= maxReplicationTaskId {
+				continue
+			}
+			if err := fillNamespaceBacklogs(
+				ctx,
+				shard,
+				clusterName,
+				status,
+				maxReplicationTaskId,
+				maxScannedTasks,
+			); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return resp, nil
 }
+
+// fillNamespaceBacklogs scans the replication tasks not acked by a remote cluster, oldest first, and sets the backlog
+// of each namespace replicated to the cluster.
+func fillNamespaceBacklogs(
+	ctx context.Context,
+	shard historyi.ShardContext,
+	clusterName string,
+	status *historyservice.ShardReplicationStatusPerCluster,
+	maxReplicationTaskID int64,
+	maxScannedTasks int,
+) error {
+	iter := collection.NewPagingIterator(func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+		response, err := shard.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shard.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			InclusiveMinTaskKey: tasks.NewImmediateKey(status.AckedTaskId + 1),
+			ExclusiveMaxTaskKey: tasks.NewImmediateKey(maxReplicationTaskID + 1),
+			BatchSize:           min(maxScannedTasks, scanBatchSize),
+			NextPageToken:       paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return response.Tasks, response.NextPageToken, nil
+	})
+
+	backlogs := make(map[string]*historyservice.NamespaceReplicationBacklog)
+	for scannedTasks := 0; iter.HasNext(); scannedTasks++ {
+		if scannedTasks == maxScannedTasks {
+			status.BacklogTruncated = true
+			break
+		}
+		task, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		namespaceEntry, err := shard.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(task.GetNamespaceID()))
+		if err != nil {
+			var nsNotFound *serviceerror.NamespaceNotFound
+			if errors.As(err, &nsNotFound) {
+				continue
+			}
+			return err
+		}
+		if !namespaceEntry.IsOnCluster(clusterName) {
+			continue
+		}
+
+		backlog, ok := backlogs[task.GetNamespaceID()]
+		if !ok {
+			backlog = &historyservice.NamespaceReplicationBacklog{
+				NamespaceId: task.GetNamespaceID(),
+				OldestExecution: &commonpb.WorkflowExecution{
+					WorkflowId: task.GetWorkflowID(),
+					RunId:      task.GetRunID(),
+				},
+				OldestTaskVisibilityTime: timestamppb.New(task.GetVisibilityTime()),
+			}
+			backlogs[task.GetNamespaceID()] = backlog
+			status.NamespaceBacklogs = append(status.NamespaceBacklogs, backlog)
+		}
+		backlog.TaskCount++
+	}
+	return nil
+}
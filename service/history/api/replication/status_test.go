@@ -0,0 +1,113 @@
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/replication"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetStatus_NamespaceBacklog(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	shardContext := historyi.NewMockShardContext(ctrl)
+	ackManager := replication.NewMockAckManager(ctrl)
+	registry := namespace.NewMockRegistry(ctrl)
+
+	now := time.Unix(1000, 0).UTC()
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetTimeSource().Return(clock.NewEventTimeSource().Update(now)).AnyTimes()
+	shardContext.EXPECT().GetNamespaceRegistry().Return(registry).AnyTimes()
+	ackManager.EXPECT().GetMaxTaskInfo().Return(int64(10), now)
+	shardContext.EXPECT().GetReplicationStatus([]string{"remote"}).Return(
+		map[string]*historyservice.ShardReplicationStatusPerCluster{
+			"remote": {AckedTaskId: 5},
+		},
+		nil,
+		nil,
+	)
+
+	replicatedNamespace := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "replicated"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{Clusters: []string{"local", "remote"}},
+		1,
+	)
+	localNamespace := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "local"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{Clusters: []string{"local"}},
+		1,
+	)
+	registry.EXPECT().GetNamespaceByID(namespace.ID("replicated")).Return(replicatedNamespace, nil).AnyTimes()
+	registry.EXPECT().GetNamespaceByID(namespace.ID("local")).Return(localNamespace, nil).AnyTimes()
+	registry.EXPECT().GetNamespaceByID(namespace.ID("deleted")).Return(nil, serviceerror.NewNamespaceNotFound("deleted")).AnyTimes()
+
+	newTask := func(taskID int64, namespaceID string, workflowID string) tasks.Task {
+		return &tasks.HistoryReplicationTask{
+			WorkflowKey:         definition.NewWorkflowKey(namespaceID, workflowID, workflowID+"-run"),
+			TaskID:              taskID,
+			VisibilityTimestamp: now.Add(time.Duration(taskID-10) * time.Second),
+		}
+	}
+	shardContext.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryReplication,
+		InclusiveMinTaskKey: tasks.NewImmediateKey(6),
+		ExclusiveMaxTaskKey: tasks.NewImmediateKey(11),
+		BatchSize:           4,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			newTask(6, "local", "wf-1"),
+			newTask(7, "replicated", "wf-2"),
+			newTask(8, "deleted", "wf-3"),
+			newTask(9, "replicated", "wf-4"),
+		},
+		NextPageToken: []byte{1},
+	}, nil)
+	shardContext.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryReplication,
+		InclusiveMinTaskKey: tasks.NewImmediateKey(6),
+		ExclusiveMaxTaskKey: tasks.NewImmediateKey(11),
+		BatchSize:           4,
+		NextPageToken:       []byte{1},
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{newTask(10, "replicated", "wf-5")},
+	}, nil)
+
+	resp, err := GetStatus(
+		context.Background(),
+		&historyservice.GetReplicationStatusRequest{
+			RemoteClusters:          []string{"remote"},
+			IncludeNamespaceBacklog: true,
+			MaxScannedTasks:         4,
+		},
+		shardContext,
+		ackManager,
+	)
+	require.NoError(t, err)
+
+	status := resp.RemoteClusters["remote"]
+	require.True(t, status.BacklogTruncated)
+	require.Len(t, status.NamespaceBacklogs, 1)
+	require.Equal(t, "replicated", status.NamespaceBacklogs[0].NamespaceId)
+	require.Equal(t, int64(2), status.NamespaceBacklogs[0].TaskCount)
+	require.Equal(t, &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "wf-2-run"}, status.NamespaceBacklogs[0].OldestExecution)
+	require.Equal(t, timestamppb.New(now.Add(-3*time.Second)), status.NamespaceBacklogs[0].OldestTaskVisibilityTime)
+}
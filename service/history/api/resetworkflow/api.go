@@ -42,8 +42,9 @@ func Invoke(
 
 	request := resetRequest.ResetRequest
 	workflowID := request.WorkflowExecution.GetWorkflowId()
+	dryRun := resetRequest.GetDryRun()
 
-	if rl := shardContext.BusinessIDReuseRateLimiter(namespaceID, workflowID, chasm.WorkflowArchetypeID); rl != nil && !rl.Allow() {
+	if rl := shardContext.BusinessIDReuseRateLimiter(namespaceID, workflowID, chasm.WorkflowArchetypeID); !dryRun && rl != nil && !rl.Allow() {
 		archetypeName, _ := shardContext.ChasmRegistry().ArchetypeDisplayName(chasm.WorkflowArchetypeID)
 		metrics.BusinessIDReuseRateLimited.With(shardContext.GetMetricsHandler()).Record(
 			1,
@@ -122,7 +123,8 @@ func Invoke(
 	}
 
 	// dedup by requestID
-	if currentWorkflowLease != nil &&
+	if !dryRun &&
+		currentWorkflowLease != nil &&
 		currentWorkflowLease.GetMutableState().GetExecutionState().CreateRequestId == request.GetRequestId() {
 		shardContext.GetLogger().Info("Duplicated reset request",
 			tag.WorkflowID(workflowID),
@@ -158,14 +160,6 @@ func Invoke(
 		return nil, err
 	}
 
-	metrics.WorkflowResetCount.With(
-		shardContext.GetMetricsHandler().WithTags(
-			metrics.NamespaceTag(namespaceEntry.Name().String()),
-			metrics.OperationTag(metrics.HistoryResetWorkflowScope),
-			metrics.VersioningBehaviorTag(baseMutableState.GetEffectiveVersioningBehavior()),
-		),
-	).Record(1)
-
 	var currentWorkflow ndc.Workflow
 	if currentWorkflowLease != nil {
 		currentWorkflow = ndc.NewWorkflow(
@@ -177,11 +171,48 @@ func Invoke(
 	}
 
 	allowResetWithPendingChildren := shardContext.GetConfig().AllowResetWithPendingChildren(namespaceEntry.Name().String())
-	if err := ndc.NewWorkflowResetter(
+	resetter := ndc.NewWorkflowResetter(
 		shardContext,
 		workflowConsistencyChecker.GetWorkflowCache(),
 		shardContext.GetLogger(),
-	).ResetWorkflow(
+	)
+	resetReapplyExcludeTypes := GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), request.GetResetReapplyType())
+
+	if dryRun {
+		dryRunResult, err := resetter.DryRunResetWorkflow(
+			ctx,
+			namespaceID,
+			workflowID,
+			baseRunID,
+			baseCurrentBranchToken,
+			baseRebuildLastEventID,
+			baseRebuildLastEventVersion,
+			baseNextEventID,
+			resetRunID,
+			baseWorkflow,
+			currentWorkflow,
+			request.GetReason(),
+			resetReapplyExcludeTypes,
+			allowResetWithPendingChildren,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.ResetWorkflowExecutionResponse{
+			RunId:        resetRunID,
+			DryRunResult: dryRunResult,
+		}, nil
+	}
+
+	metrics.WorkflowResetCount.With(
+		shardContext.GetMetricsHandler().WithTags(
+			metrics.NamespaceTag(namespaceEntry.Name().String()),
+			metrics.OperationTag(metrics.HistoryResetWorkflowScope),
+			metrics.VersioningBehaviorTag(baseMutableState.GetEffectiveVersioningBehavior()),
+		),
+	).Record(1)
+
+	if err := resetter.ResetWorkflow(
 		ctx,
 		namespaceID,
 		workflowID,
@@ -195,7 +226,7 @@ func Invoke(
 		currentWorkflow,
 		request.GetReason(),
 		nil,
-		GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), request.GetResetReapplyType()),
+		resetReapplyExcludeTypes,
 		allowResetWithPendingChildren,
 		resetRequest.ResetRequest.PostResetOperations,
 	); err != nil {
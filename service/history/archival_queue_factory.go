@@ -73,6 +73,12 @@ func newHostScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
 			ActiveNamespaceWeights:         dynamicconfig.GetMapPropertyFnFilteredByNamespace(ArchivalTaskPriorities),
 			StandbyNamespaceWeights:        dynamicconfig.GetMapPropertyFnFilteredByNamespace(ArchivalTaskPriorities),
 			InactiveNamespaceDeletionDelay: params.Config.TaskSchedulerInactiveChannelDeletionDelay,
+			Name:                           tasks.CategoryArchival.Name(),
+			NamespaceWeight:                params.Config.TaskSchedulerNamespaceWeight,
+			BacklogWeightMultiplier:        params.Config.TaskSchedulerBacklogWeightMultiplier,
+			MaxChannelWeight:               params.Config.TaskSchedulerMaxChannelWeight,
+			WeightRefreshInterval:          params.Config.TaskSchedulerWeightRefreshInterval,
+			Tuner:                          params.SchedulerTuner,
 			ExecutionAwareSchedulerOptions: ctasks.ExecutionAwareSchedulerOptions{
 				Enabled:          params.Config.TaskSchedulerEnableExecutionQueueScheduler,
 				MaxQueues:        params.Config.TaskSchedulerExecutionQueueSchedulerMaxQueues,
@@ -177,6 +183,8 @@ func (f *archivalQueueFactory) newScheduledQueue(shard historyi.ShardContext, ex
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		queues.WithShardID(shard.GetShardID()),
+		queues.WithTaskTracer(f.TaskTracer.ForShard(shard.GetShardID())),
 	)
 	return queues.NewScheduledQueue(
 		shard,
@@ -206,6 +214,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard historyi.ShardContext, ex
 			MoveGroupTaskCountBase:              f.Config.QueueMoveGroupTaskCountBase,
 			MoveGroupTaskCountMultiplier:        f.Config.QueueMoveGroupTaskCountMultiplier,
 			ShrinkPredicateMaxPendingKeys:       f.Config.QueueShrinkPredicateMaxPendingKeys,
+			Mitigations:                         f.Mitigations,
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -10,7 +10,6 @@ import (
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/chasm"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/contextutil"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
@@ -1192,12 +1191,9 @@ func (e *ChasmEngine) getShardContext(
 	ctx context.Context,
 	ref chasm.ComponentRef,
 ) (historyi.ShardContext, error) {
-	shardContext, err := e.shardController.GetShardByID(
-		common.WorkflowIDToHistoryShard(
-			ref.NamespaceID,
-			ref.BusinessID,
-			e.config.NumberOfShards,
-		),
+	shardContext, err := e.shardController.GetShardByNamespaceWorkflow(
+		namespace.ID(ref.NamespaceID),
+		ref.BusinessID,
 	)
 	if err != nil {
 		return nil, err
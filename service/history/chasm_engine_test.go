@@ -95,7 +95,7 @@ func (s *chasmEngineSuite) SetupTest() {
 	s.mockExecutionManager = s.mockShard.Resource.ExecutionMgr
 	s.mockClusterMetadata = s.mockShard.Resource.ClusterMetadata
 	s.mockNamespaceRegistry = s.mockShard.Resource.NamespaceCache
-	s.mockShardController.EXPECT().GetShardByID(gomock.Any()).Return(s.mockShard, nil).AnyTimes()
+	s.mockShardController.EXPECT().GetShardByNamespaceWorkflow(gomock.Any(), gomock.Any()).Return(s.mockShard, nil).AnyTimes()
 	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(cluster.TestCurrentClusterInitialFailoverVersion, tests.Version).Return(true).AnyTimes()
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
@@ -206,7 +206,7 @@ func (s *chasmEngineSuite) TestStartExecution_WaitsForShardEngine() {
 	s.engine.SetShardController(mockShardController)
 
 	expectedErr := serviceerror.NewUnavailable("shard not ready")
-	mockShardController.EXPECT().GetShardByID(gomock.Any()).Return(mockShardContext, nil).Times(1)
+	mockShardController.EXPECT().GetShardByNamespaceWorkflow(gomock.Any(), gomock.Any()).Return(mockShardContext, nil).Times(1)
 	mockShardContext.EXPECT().GetEngine(gomock.Any()).Return(nil, expectedErr).Times(1)
 
 	startFnCalled := false
@@ -3,10 +3,8 @@ package configs
 import (
 	"go.temporal.io/server/chasm/lib/callback"
 	"go.temporal.io/server/chasm/lib/nexusoperation"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/health"
-	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/retrypolicy"
 )
 
@@ -79,6 +77,7 @@ type Config struct {
 	MaxCallbacksPerUpdateID                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableChasm                                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableCHASMSkipPersistence                 dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	EnableCHASMSchemaUpgrade                   dynamicconfig.BoolPropertyFn
 	EnableChasmNexusWorkflowOperations         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ChasmNexusWorkflowOperationsRolloutPercent dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableCHASMCallbacks                       dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -106,6 +105,10 @@ type Config struct {
 	ShardLingerOwnershipCheckQPS dynamicconfig.IntPropertyFn
 	ShardLingerTimeLimit         dynamicconfig.DurationPropertyFn
 	ShardFinalizerTimeout        dynamicconfig.DurationPropertyFn
+	ShardBalancerEnabled         dynamicconfig.BoolPropertyFn
+	ShardBalancerInterval        dynamicconfig.DurationPropertyFn
+	ShardBalancerLoadThreshold   dynamicconfig.FloatPropertyFn
+	ShardPools                   dynamicconfig.TypedPropertyFn[map[string]dynamicconfig.ShardPool]
 
 	HistoryClientOwnershipCachingEnabled dynamicconfig.BoolPropertyFn
 
@@ -147,6 +150,18 @@ type Config struct {
 	TaskSchedulerExecutionQueueSchedulerQueueTTL         dynamicconfig.DurationPropertyFn
 	TaskSchedulerExecutionQueueSchedulerQueueConcurrency dynamicconfig.IntPropertyFn
 
+	// Namespace fairness settings of the host level task schedulers
+	TaskSchedulerNamespaceWeight         dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	TaskSchedulerBacklogWeightMultiplier dynamicconfig.FloatPropertyFn
+	TaskSchedulerMaxChannelWeight        dynamicconfig.IntPropertyFn
+	TaskSchedulerWeightRefreshInterval   dynamicconfig.DurationPropertyFn
+
+	QueueTaskTracingSampleRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	QueueTaskTracingBufferSize dynamicconfig.IntPropertyFn
+
+	QueueMitigationPolicy  dynamicconfig.TypedPropertyFn[dynamicconfig.QueueMitigationPolicySettings]
+	QueueMitigationLogSize dynamicconfig.IntPropertyFn
+
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                               dynamicconfig.IntPropertyFn
 	TimerProcessorSchedulerWorkerCount               dynamicconfig.TypedSubscribable[int]
@@ -312,6 +327,11 @@ type Config struct {
 	ReplicationStreamSenderErrorRetryMaxAttempts         dynamicconfig.IntPropertyFn
 	ReplicationStreamSenderErrorRetryExpiration          dynamicconfig.DurationPropertyFn
 	ReplicationStreamSenderSkipStuckTask                 dynamicconfig.BoolPropertyFn
+	ReplicationStreamSenderBatchMaxTasks                 dynamicconfig.IntPropertyFn
+	ReplicationStreamSenderBatchMaxBytes                 dynamicconfig.IntPropertyFn
+	ReplicationStreamSenderBatchMaxDelay                 dynamicconfig.DurationPropertyFn
+	ReplicationStreamSenderCompression                   dynamicconfig.StringPropertyFn
+	ReplicationStreamReceiverMaxUncompressedSize         dynamicconfig.IntPropertyFn
 
 	ReplicationExecutableTaskErrorRetryWait               dynamicconfig.DurationPropertyFn
 	ReplicationExecutableTaskErrorRetryBackoffCoefficient dynamicconfig.FloatPropertyFn
@@ -358,7 +378,11 @@ type Config struct {
 	// NDC Replication configuration
 	StandbyTaskReReplicationContextTimeout dynamicconfig.DurationPropertyFnWithNamespaceIDFilter
 
-	SkipReapplicationByNamespaceID dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+	SkipReapplicationByNamespaceID             dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+	ConflictResolutionRecordMaxCount           dynamicconfig.IntPropertyFnWithNamespaceIDFilter
+	ConflictResolutionRecordMaxReappliedEvents dynamicconfig.IntPropertyFnWithNamespaceIDFilter
+
+	HistoryEventFeedEnabled dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
 
 	// ===== Visibility related =====
 	// VisibilityQueueProcessor settings
@@ -526,6 +550,7 @@ func NewConfig(
 		MaxCallbacksPerUpdateID:                    dynamicconfig.MaxCallbacksPerUpdateID.Get(dc),
 		EnableChasm:                                dynamicconfig.EnableChasm.Get(dc),
 		EnableCHASMSkipPersistence:                 dynamicconfig.EnableCHASMSkipPersistence.Get(dc),
+		EnableCHASMSchemaUpgrade:                   dynamicconfig.EnableCHASMSchemaUpgrade.Get(dc),
 		EnableChasmNexusWorkflowOperations:         nexusoperation.EnableChasmWorkflowOperations.Get(dc),
 		ChasmNexusWorkflowOperationsRolloutPercent: nexusoperation.ChasmWorkflowOperationsRolloutPercent.Get(dc),
 		ChasmMaxInMemoryPureTasks:                  dynamicconfig.ChasmMaxInMemoryPureTasks.Get(dc),
@@ -552,6 +577,10 @@ func NewConfig(
 		ShardLingerOwnershipCheckQPS: dynamicconfig.ShardLingerOwnershipCheckQPS.Get(dc),
 		ShardLingerTimeLimit:         dynamicconfig.ShardLingerTimeLimit.Get(dc),
 		ShardFinalizerTimeout:        dynamicconfig.ShardFinalizerTimeout.Get(dc),
+		ShardBalancerEnabled:         dynamicconfig.ShardBalancerEnabled.Get(dc),
+		ShardBalancerInterval:        dynamicconfig.ShardBalancerInterval.Get(dc),
+		ShardBalancerLoadThreshold:   dynamicconfig.ShardBalancerLoadThreshold.Get(dc),
+		ShardPools:                   dynamicconfig.HistoryShardPools.Get(dc),
 
 		HistoryClientOwnershipCachingEnabled: dynamicconfig.HistoryClientOwnershipCachingEnabled.Get(dc),
 
@@ -587,6 +616,16 @@ func NewConfig(
 		TaskSchedulerExecutionQueueSchedulerMaxQueues:        dynamicconfig.TaskSchedulerExecutionQueueSchedulerMaxQueues.Get(dc),
 		TaskSchedulerExecutionQueueSchedulerQueueTTL:         dynamicconfig.TaskSchedulerExecutionQueueSchedulerQueueTTL.Get(dc),
 		TaskSchedulerExecutionQueueSchedulerQueueConcurrency: dynamicconfig.TaskSchedulerExecutionQueueSchedulerQueueConcurrency.Get(dc),
+		TaskSchedulerNamespaceWeight:                         dynamicconfig.TaskSchedulerNamespaceWeight.Get(dc),
+		TaskSchedulerBacklogWeightMultiplier:                 dynamicconfig.TaskSchedulerBacklogWeightMultiplier.Get(dc),
+		TaskSchedulerMaxChannelWeight:                        dynamicconfig.TaskSchedulerMaxChannelWeight.Get(dc),
+		TaskSchedulerWeightRefreshInterval:                   dynamicconfig.TaskSchedulerWeightRefreshInterval.Get(dc),
+
+		QueueTaskTracingSampleRate: dynamicconfig.QueueTaskTracingSampleRate.Get(dc),
+		QueueTaskTracingBufferSize: dynamicconfig.QueueTaskTracingBufferSize.Get(dc),
+
+		QueueMitigationPolicy:  dynamicconfig.QueueMitigationPolicy.Get(dc),
+		QueueMitigationLogSize: dynamicconfig.QueueMitigationLogSize.Get(dc),
 
 		TimerTaskBatchSize:                               dynamicconfig.TimerTaskBatchSize.Get(dc),
 		TimerProcessorSchedulerWorkerCount:               dynamicconfig.TimerProcessorSchedulerWorkerCount.Subscribe(dc),
@@ -752,6 +791,11 @@ func NewConfig(
 		ReplicationStreamSenderErrorRetryMaxAttempts:        dynamicconfig.ReplicationStreamSenderErrorRetryMaxAttempts.Get(dc),
 		ReplicationStreamSenderErrorRetryExpiration:         dynamicconfig.ReplicationStreamSenderErrorRetryExpiration.Get(dc),
 		ReplicationStreamSenderSkipStuckTask:                dynamicconfig.ReplicationStreamSenderSkipStuckTask.Get(dc),
+		ReplicationStreamSenderBatchMaxTasks:                dynamicconfig.ReplicationStreamSenderBatchMaxTasks.Get(dc),
+		ReplicationStreamSenderBatchMaxBytes:                dynamicconfig.ReplicationStreamSenderBatchMaxBytes.Get(dc),
+		ReplicationStreamSenderBatchMaxDelay:                dynamicconfig.ReplicationStreamSenderBatchMaxDelay.Get(dc),
+		ReplicationStreamSenderCompression:                  dynamicconfig.ReplicationStreamSenderCompression.Get(dc),
+		ReplicationStreamReceiverMaxUncompressedSize:        dynamicconfig.ReplicationStreamReceiverMaxUncompressedSize.Get(dc),
 
 		ReplicationExecutableTaskErrorRetryWait:               dynamicconfig.ReplicationExecutableTaskErrorRetryWait.Get(dc),
 		ReplicationExecutableTaskErrorRetryBackoffCoefficient: dynamicconfig.ReplicationExecutableTaskErrorRetryBackoffCoefficient.Get(dc),
@@ -766,7 +810,11 @@ func NewConfig(
 
 		StandbyTaskReReplicationContextTimeout: dynamicconfig.StandbyTaskReReplicationContextTimeout.Get(dc),
 
-		SkipReapplicationByNamespaceID: dynamicconfig.SkipReapplicationByNamespaceID.Get(dc),
+		SkipReapplicationByNamespaceID:             dynamicconfig.SkipReapplicationByNamespaceID.Get(dc),
+		ConflictResolutionRecordMaxCount:           dynamicconfig.ConflictResolutionRecordMaxCount.Get(dc),
+		ConflictResolutionRecordMaxReappliedEvents: dynamicconfig.ConflictResolutionRecordMaxReappliedEvents.Get(dc),
+
+		HistoryEventFeedEnabled: dynamicconfig.HistoryEventFeedEnabled.Get(dc),
 
 		// ===== Visibility related =====
 		VisibilityTaskBatchSize:                               dynamicconfig.VisibilityTaskBatchSize.Get(dc),
@@ -878,8 +926,3 @@ func NewConfig(
 
 	return cfg
 }
-
-// GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair
-func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
-}
@@ -52,6 +52,31 @@ func NewNamespaceRateLimiter(
 	)
 }
 
+// NewShardPoolRateLimiter returns a rate limiter that limits the requests of all the namespaces assigned to a shard
+// pool together. namespacePoolFn returns the pool of a namespace by name, or an empty string if the namespace is not
+// assigned to a pool, in which case its requests are not limited.
+func NewShardPoolRateLimiter(
+	namespacePoolFn func(namespaceName string) string,
+	poolRateFn func(pool string) float64,
+	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+) quotas.RequestRateLimiter {
+	return quotas.NewMapRequestRateLimiter(
+		func(req quotas.Request) quotas.RequestRateLimiter {
+			pool := namespacePoolFn(req.Caller)
+			if pool == "" {
+				return quotas.NoopRequestRateLimiter
+			}
+			return NewPriorityRateLimiter(
+				func() float64 { return poolRateFn(pool) },
+				operatorRPSRatio,
+			)
+		},
+		func(req quotas.Request) string {
+			return namespacePoolFn(req.Caller)
+		},
+	)
+}
+
 func RequestToPriority(req quotas.Request) int {
 	if priority, ok := CallerTypeToPriority[req.CallerType]; ok {
 		return priority
@@ -81,3 +81,53 @@ func (s *quotasSuite) TestOperatorPrioritized() {
 	}
 	s.Equal(2, limitCount)
 }
+
+func (s *quotasSuite) TestShardPoolRateLimiter() {
+	namespacePoolFn := func(namespaceName string) string {
+		if namespaceName == "unassigned" {
+			return ""
+		}
+		return "pool"
+	}
+	poolRateFn := func(pool string) float64 { return 5 }
+	newRequest := func(namespaceName string) quotas.Request {
+		return quotas.NewRequest(
+			"/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution",
+			1,
+			namespaceName,
+			headers.CallerTypeAPI,
+			-1,
+			"")
+	}
+	requestTime := time.Now()
+
+	// A single namespace gets the whole budget of the pool.
+	limiter := NewShardPoolRateLimiter(namespacePoolFn, poolRateFn, func() float64 { return 0 })
+	allowed := 0
+	for range 100 {
+		if limiter.Allow(requestTime, newRequest("a")) {
+			allowed++
+		}
+	}
+	s.Positive(allowed)
+	s.Less(allowed, 100)
+
+	// The namespaces of a pool share its budget.
+	limiter = NewShardPoolRateLimiter(namespacePoolFn, poolRateFn, func() float64 { return 0 })
+	sharedAllowed := 0
+	for i := range 100 {
+		namespaceName := "a"
+		if i%2 == 1 {
+			namespaceName = "b"
+		}
+		if limiter.Allow(requestTime, newRequest(namespaceName)) {
+			sharedAllowed++
+		}
+	}
+	s.Equal(allowed, sharedAllowed)
+
+	// Namespaces that are not assigned to a pool are not limited.
+	for range 100 {
+		s.True(limiter.Allow(requestTime, newRequest("unassigned")))
+	}
+}
@@ -18,22 +18,26 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyfeed"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/quotas/calculator"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/worker_versioning"
@@ -99,6 +103,7 @@ var Module = fx.Options(
 	fx.Provide(NewService),
 	fx.Provide(ReplicationProgressCacheProvider),
 	fx.Provide(VersionMembershipCacheProvider),
+	fx.Provide(HistoryEventFeedProvider),
 	workerdeployment.ClientModule,
 	fx.Provide(RoutingInfoCacheProvider),
 	fx.Invoke(ServiceLifetimeHooks),
@@ -167,10 +172,14 @@ func HandlerProvider(args NewHandlerArgs, lc fx.Lifecycle) (*Handler, error) {
 		timeSource:                   args.TimeSource,
 		namespaceRegistry:            args.NamespaceRegistry,
 		saProvider:                   args.SaProvider,
+		shardPools:                   args.ShardPools,
 		clusterMetadata:              args.ClusterMetadata,
 		archivalMetadata:             args.ArchivalMetadata,
 		hostInfoProvider:             args.HostInfoProvider,
 		controller:                   args.ShardController,
+		schedulerTuner:               args.SchedulerTuner,
+		taskTracer:                   args.TaskTracer,
+		mitigations:                  args.Mitigations,
 		eventNotifier:                args.EventNotifier,
 		tracer:                       args.TracerProvider.Tracer(consts.LibraryName),
 		taskQueueManager:             args.TaskQueueManager,
@@ -335,6 +344,7 @@ func HistoryAdditionalInterceptorsProvider(
 func NamespaceRateLimitInterceptorProvider(
 	serviceConfig *configs.Config,
 	namespaceRegistry namespace.Registry,
+	shardPools *shardpool.Resolver,
 	metricsHandler metrics.Handler,
 ) interceptor.NamespaceRateLimitInterceptor {
 
@@ -346,11 +356,37 @@ func NamespaceRateLimitInterceptorProvider(
 		return float64(serviceConfig.RPS())
 	}
 
+	namespacePoolFn := func(namespaceName string) string {
+		namespaceID, err := namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+		if err != nil {
+			return ""
+		}
+		assignment, err := shardPools.Assignment(namespaceID.String())
+		if err != nil {
+			return ""
+		}
+		return assignment.GetName()
+	}
+	poolRateFn := func(pool string) float64 {
+		if poolRPS := serviceConfig.ShardPools()[pool].RPS; poolRPS > 0 {
+			return float64(poolRPS)
+		}
+		// Pools without an RPS limit are only limited by the host level rps limit.
+		return float64(serviceConfig.RPS())
+	}
+
 	return interceptor.NewNamespaceRateLimitInterceptor(
 		namespaceRegistry,
-		configs.NewNamespaceRateLimiter(
-			namespaceRateFn,
-			serviceConfig.OperatorRPSRatio,
+		quotas.NewMultiRequestRateLimiter(
+			configs.NewNamespaceRateLimiter(
+				namespaceRateFn,
+				serviceConfig.OperatorRPSRatio,
+			),
+			configs.NewShardPoolRateLimiter(
+				namespacePoolFn,
+				poolRateFn,
+				serviceConfig.OperatorRPSRatio,
+			),
 		),
 		map[string]struct{}{}, // no long polls on history service
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false), // no long poll methods
@@ -480,7 +516,9 @@ func EventNotifierProvider(
 	return events.NewNotifier(
 		timeSource,
 		metricsHandler,
-		config.GetShardID,
+		func(namespaceID namespace.ID, workflowID string) int32 {
+			return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
+		},
 	)
 }
 
@@ -529,3 +567,13 @@ func RoutingInfoCacheProvider(
 	})
 	return worker_versioning.NewRoutingInfoCache(c, metricsHandler)
 }
+
+// HistoryEventFeedProvider provides the sink receiving the history event feeds of namespaces. Replace it with
+// fx.Decorate to publish the feeds somewhere else than the persistence layer.
+func HistoryEventFeedProvider(
+	manager persistence.HistoryEventFeedManager,
+	timeSource clock.TimeSource,
+	dc *dynamicconfig.Collection,
+) historyfeed.Sink {
+	return historyfeed.NewPersistenceSink(manager, timeSource, dc)
+}
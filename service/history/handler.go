@@ -19,6 +19,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/serviceerror"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -34,6 +35,7 @@ import (
 	"go.temporal.io/server/common/contextutil"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -51,6 +53,7 @@ import (
 	sdkconverter "go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/components/nexusoperations"
@@ -64,11 +67,14 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/fx"
 	"google.golang.org/grpc/health"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -95,10 +101,14 @@ type (
 		timeSource                   clock.TimeSource
 		namespaceRegistry            namespace.Registry
 		saProvider                   searchattribute.Provider
+		shardPools                   *shardpool.Resolver
 		clusterMetadata              cluster.Metadata
 		archivalMetadata             archiver.ArchivalMetadata
 		hostInfoProvider             membership.HostInfoProvider
 		controller                   shard.Controller
+		schedulerTuner               *queues.SchedulerTuner
+		taskTracer                   *queues.TaskTracer
+		mitigations                  *queues.Mitigations
 		tracer                       trace.Tracer
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
@@ -133,10 +143,14 @@ type (
 		TimeSource                   clock.TimeSource
 		NamespaceRegistry            namespace.Registry
 		SaProvider                   searchattribute.Provider
+		ShardPools                   *shardpool.Resolver
 		ClusterMetadata              cluster.Metadata
 		ArchivalMetadata             archiver.ArchivalMetadata
 		HostInfoProvider             membership.HostInfoProvider
 		ShardController              shard.Controller
+		SchedulerTuner               *queues.SchedulerTuner
+		TaskTracer                   *queues.TaskTracer
+		Mitigations                  *queues.Mitigations
 		EventNotifier                events.Notifier
 		TracerProvider               trace.TracerProvider
 		TaskQueueManager             persistence.HistoryTaskQueueManager
@@ -701,8 +715,14 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 	// This API supports describe history host by 1. address 2. shard id 3. namespace id + workflow id
 	// if option 2/3 is provided, we want to check on the shard ownership to return the correct host address.
 	shardID := req.GetShardId()
+	var workflowShardID int32
 	if len(req.GetNamespaceId()) != 0 && req.GetWorkflowExecution() != nil {
-		shardID = common.WorkflowIDToHistoryShard(req.GetNamespaceId(), req.GetWorkflowExecution().GetWorkflowId(), h.config.NumberOfShards)
+		var err error
+		workflowShardID, err = h.shardPools.ShardID(req.GetNamespaceId(), req.GetWorkflowExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
+		shardID = workflowShardID
 	}
 	if shardID > 0 {
 		_, err := h.controller.GetShardByID(shardID)
@@ -720,7 +740,11 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 			ItemsInCacheByIdCount:   itemsInRegistryByIDCount,
 			ItemsInCacheByNameCount: itemsInRegistryByNameCount,
 		},
-		Address: h.hostInfoProvider.HostInfo().GetAddress(),
+		Address:         h.hostInfoProvider.HostInfo().GetAddress(),
+		PinnedShardIds:  h.controller.PinnedShardIDs(),
+		Load:            h.controller.Load(),
+		ShardLoads:      h.controller.ShardLoads(),
+		WorkflowShardId: workflowShardID,
 	}
 	return resp, nil
 }
@@ -756,6 +780,159 @@ func (h *Handler) CloseShard(_ context.Context, request *historyservice.CloseSha
 	return &historyservice.CloseShardResponse{}, nil
 }
 
+// PinShard pins a shard to this host
+func (h *Handler) PinShard(_ context.Context, request *historyservice.PinShardRequest) (*historyservice.PinShardResponse, error) {
+	if err := h.controller.PinShard(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.PinShardResponse{}, nil
+}
+
+// UnpinShard removes the pin of a shard owned by this host
+func (h *Handler) UnpinShard(_ context.Context, request *historyservice.UnpinShardRequest) (*historyservice.UnpinShardResponse, error) {
+	// Make sure this host owns the shard, so the request is redirected to the host the shard is pinned to.
+	if _, err := h.controller.GetShardByID(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+	if err := h.controller.UnpinShard(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.UnpinShardResponse{}, nil
+}
+
+// DescribeTaskScheduler describes the host level task schedulers of this host
+func (h *Handler) DescribeTaskScheduler(_ context.Context, _ *historyservice.DescribeTaskSchedulerRequest) (*historyservice.DescribeTaskSchedulerResponse, error) {
+	namespaceName := func(namespaceID string) string {
+		name, err := h.namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+		if err != nil {
+			return ""
+		}
+		return name.String()
+	}
+
+	resp := &historyservice.DescribeTaskSchedulerResponse{
+		Address: h.hostInfoProvider.HostInfo().GetAddress(),
+	}
+	for _, state := range h.schedulerTuner.SchedulerStates() {
+		scheduler := &historyspb.TaskSchedulerState{
+			Name: state.Name,
+		}
+		for _, channel := range state.Channels {
+			scheduler.Channels = append(scheduler.Channels, &historyspb.TaskSchedulerChannel{
+				NamespaceId:    channel.Key.NamespaceID,
+				Namespace:      namespaceName(channel.Key.NamespaceID),
+				Priority:       channel.Key.Priority.String(),
+				Weight:         int32(channel.Weight),
+				Backlog:        int32(channel.Backlog),
+				LastActiveTime: timestamppb.New(channel.LastActiveTime),
+			})
+		}
+		resp.Schedulers = append(resp.Schedulers, scheduler)
+	}
+	for _, override := range h.schedulerTuner.NamespaceWeightOverrides() {
+		resp.NamespaceWeightOverrides = append(resp.NamespaceWeightOverrides, &historyspb.TaskSchedulerNamespaceWeightOverride{
+			NamespaceId: override.NamespaceID,
+			Namespace:   namespaceName(override.NamespaceID),
+			Multiplier:  override.Multiplier,
+			ExpireTime:  timestamppb.New(override.ExpireTime),
+		})
+	}
+	return resp, nil
+}
+
+// UpdateTaskSchedulerNamespaceWeight sets or removes the namespace weight override of the task schedulers of this host
+func (h *Handler) UpdateTaskSchedulerNamespaceWeight(_ context.Context, request *historyservice.UpdateTaskSchedulerNamespaceWeightRequest) (*historyservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetRemove() {
+		h.schedulerTuner.ResetNamespaceWeightOverride(request.GetNamespaceId())
+		return &historyservice.UpdateTaskSchedulerNamespaceWeightResponse{}, nil
+	}
+	if request.GetMultiplier() <= 0 {
+		return nil, serviceerror.NewInvalidArgument("Multiplier must be positive.")
+	}
+	if request.GetExpireTime() == nil {
+		return nil, serviceerror.NewInvalidArgument("ExpireTime not set on request.")
+	}
+	h.schedulerTuner.SetNamespaceWeightOverride(
+		request.GetNamespaceId(),
+		request.GetMultiplier(),
+		request.GetExpireTime().AsTime(),
+	)
+	return &historyservice.UpdateTaskSchedulerNamespaceWeightResponse{}, nil
+}
+
+// GetTaskTrace returns the recorded lifecycle of a sampled task of a shard owned by this host
+func (h *Handler) GetTaskTrace(_ context.Context, request *historyservice.GetTaskTraceRequest) (*historyservice.GetTaskTraceResponse, error) {
+	if _, ok := h.taskCategoryRegistry.GetCategoryByID(int(request.GetCategory())); !ok {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid task category ID: %v", request.GetCategory())
+	}
+	// Make sure this host owns the shard, so the request is redirected to the owner otherwise.
+	if _, err := h.controller.GetShardByID(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+
+	taskTrace, ok := h.taskTracer.GetTaskTrace(queues.TaskTraceKey{
+		ShardID:    request.GetShardId(),
+		CategoryID: int(request.GetCategory()),
+		TaskID:     request.GetTaskId(),
+	})
+	if !ok {
+		return nil, serviceerror.NewNotFoundf(
+			"No trace recorded for task %v of shard %v, the task may not be sampled by %v or was traced before the shard was loaded",
+			request.GetTaskId(),
+			request.GetShardId(),
+			dynamicconfig.QueueTaskTracingSampleRate.Key(),
+		)
+	}
+
+	resp := &historyspb.TaskTrace{
+		ShardId:     taskTrace.ShardID,
+		Category:    int32(taskTrace.CategoryID),
+		TaskId:      taskTrace.TaskID,
+		TaskType:    taskTrace.TaskType,
+		NamespaceId: taskTrace.NamespaceID,
+		WorkflowId:  taskTrace.WorkflowID,
+		RunId:       taskTrace.RunID,
+		TraceId:     taskTrace.TraceID.String(),
+	}
+	for _, event := range taskTrace.Events {
+		resp.Events = append(resp.Events, &historyspb.TaskTraceEvent{
+			Stage:      string(event.Stage),
+			EventTime:  timestamppb.New(event.Time),
+			Attempt:    int32(event.Attempt),
+			ReaderId:   event.ReaderID,
+			SliceRange: event.SliceRange,
+			Reason:     event.Reason,
+		})
+	}
+	return &historyservice.GetTaskTraceResponse{Trace: resp}, nil
+}
+
+// ListQueueMitigations returns the mitigations recently applied by the queues of a shard owned by this host
+func (h *Handler) ListQueueMitigations(_ context.Context, request *historyservice.ListQueueMitigationsRequest) (*historyservice.ListQueueMitigationsResponse, error) {
+	// Make sure this host owns the shard, so the request is redirected to the owner otherwise.
+	if _, err := h.controller.GetShardByID(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp := &historyservice.ListQueueMitigationsResponse{}
+	for _, record := range h.mitigations.ListMitigations(request.GetShardId()) {
+		resp.Mitigations = append(resp.Mitigations, &historyspb.QueueMitigation{
+			Time:         timestamppb.New(record.Time),
+			ShardId:      record.ShardID,
+			Category:     int32(record.Category.ID()),
+			AlertType:    record.AlertType.String(),
+			AlertDetails: record.AlertDetails,
+			Action:       record.Action,
+			ActionTaken:  record.ActionTaken,
+			Error:        record.Error,
+		})
+	}
+	return resp, nil
+}
+
 // GetShard gets a shard hosted by this instance
 func (h *Handler) GetShard(ctx context.Context, request *historyservice.GetShardRequest) (*historyservice.GetShardResponse, error) {
 	resp, err := h.persistenceShardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
@@ -811,7 +988,14 @@ func (h *Handler) ImportWorkflowExecution(ctx context.Context, request *historys
 	if runID == "" {
 		return nil, h.convertError(errRunIDNotValid)
 	}
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	var shardContext historyi.ShardContext
+	var err error
+	if request.GetShardId() != 0 {
+		// Executions are imported into the shards of another shard pool before the namespace is moved to it.
+		shardContext, err = h.controller.GetShardByID(request.GetShardId())
+	} else {
+		shardContext, err = h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	}
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1542,10 +1726,10 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 	taskInfoPerShard := map[int32][]*replicationspb.ReplicationTaskInfo{}
 	// do batch based on workflow ID and run ID
 	for _, taskInfo := range request.GetTaskInfos() {
-		shardID := h.config.GetShardID(
-			namespace.ID(taskInfo.GetNamespaceId()),
-			taskInfo.GetWorkflowId(),
-		)
+		shardID, err := h.shardPools.ShardID(taskInfo.GetNamespaceId(), taskInfo.GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
 		if _, ok := taskInfoPerShard[shardID]; !ok {
 			taskInfoPerShard[shardID] = []*replicationspb.ReplicationTaskInfo{}
 		}
@@ -1771,11 +1955,38 @@ func (h *Handler) GetReplicationStatus(
 		if err != nil {
 			return nil, err
 		}
+		h.setReplicationStreamStuck(status)
 		resp.Shards = append(resp.Shards, status)
 	}
 	return resp, nil
 }
 
+// setReplicationStreamStuck marks the remote clusters whose inbound replication streams to the shard aren't making
+// progress.
+func (h *Handler) setReplicationStreamStuck(status *historyservice.ShardReplicationStatus) {
+	allClusterInfo := h.clusterMetadata.GetAllClusterInfo()
+	currentClusterInfo := allClusterInfo[h.clusterMetadata.GetCurrentClusterName()]
+	serverKey := replication.NewClusterShardKey(int32(currentClusterInfo.InitialFailoverVersion), status.ShardId)
+	for clusterName, clusterStatus := range status.RemoteClusters {
+		clusterInfo, ok := allClusterInfo[clusterName]
+		if !ok {
+			continue
+		}
+		for _, clientShardID := range common.MapShardID(
+			currentClusterInfo.ShardCount,
+			clusterInfo.ShardCount,
+			status.ShardId,
+		) {
+			if h.streamReceiverMonitor.IsInboundStreamStuck(replication.ClusterShardKeyPair{
+				Client: replication.NewClusterShardKey(int32(clusterInfo.InitialFailoverVersion), clientShardID),
+				Server: serverKey,
+			}) {
+				clusterStatus.StreamStuck = true
+			}
+		}
+	}
+}
+
 func (h *Handler) DeleteWorkflowVisibilityRecord(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowVisibilityRecordRequest,
@@ -2027,11 +2238,13 @@ func (h *Handler) ForceDeleteWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	shardID := common.WorkflowIDToHistoryShard(
-		namespaceID.String(),
-		request.Request.Execution.WorkflowId,
-		h.config.NumberOfShards,
-	)
+	shardID := request.GetShardId()
+	if shardID == 0 {
+		shardID, err = h.shardPools.ShardID(namespaceID.String(), request.Request.Execution.WorkflowId)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	workflowExecution := request.GetRequest().GetExecution()
 	h.logger.Info("ForceDeleteWorkflowExecution requested",
@@ -2069,7 +2282,7 @@ func (h *Handler) GetDLQTasks(
 	ctx context.Context,
 	request *historyservice.GetDLQTasksRequest,
 ) (*historyservice.GetDLQTasksResponse, error) {
-	return getdlqtasks.Invoke(ctx, h.taskQueueManager, h.taskCategoryRegistry, request)
+	return getdlqtasks.Invoke(ctx, h.taskQueueManager, h.taskCategoryRegistry, h.payloadSerializer, request)
 }
 
 func (h *Handler) DeleteDLQTasks(
@@ -2086,7 +2299,7 @@ func (h *Handler) deleteDLQTasks(
 	ctx context.Context,
 	request *historyservice.DeleteDLQTasksRequest,
 ) (*historyservice.DeleteDLQTasksResponse, error) {
-	return deletedlqtasks.Invoke(ctx, h.taskQueueManager, request, h.taskCategoryRegistry)
+	return deletedlqtasks.Invoke(ctx, h.taskQueueManager, request, h.taskCategoryRegistry, h.payloadSerializer)
 }
 
 // AddTasks calls the [addtasks.Invoke] API with a [shard.Context] for the given shardID.
@@ -77,10 +77,16 @@ func TestDescribeHistoryHost(t *testing.T) {
 	controller.EXPECT().ShardIDs().Return([]int32{2})
 	namespaceRegistry.EXPECT().GetRegistrySize().Return(int64(0), int64(0))
 	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("0.0.0.0"))
-	_, err = h.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{
+	controller.EXPECT().PinnedShardIDs().Return([]int32{2})
+	controller.EXPECT().Load().Return(0.5)
+	controller.EXPECT().ShardLoads().Return(map[int32]float64{2: 0.5})
+	resp, err := h.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{
 		ShardId: 2,
 	})
 	assert.NoError(t, err)
+	assert.Equal(t, []int32{2}, resp.GetPinnedShardIds())
+	assert.Equal(t, 0.5, resp.GetLoad())
+	assert.Equal(t, map[int32]float64{2: 0.5}, resp.GetShardLoads())
 }
 
 // fakeNexusCompletionHandler is a CHASM component that records whether its completion
@@ -111,6 +111,7 @@ type (
 		clusterMetadata            cluster.Metadata
 		executionManager           persistence.ExecutionManager
 		queueProcessors            map[tasks.Category]queues.Queue
+		taskTracer                 *queues.ShardTaskTracer
 		replicationAckMgr          replication.AckManager
 		nDCHistoryReplicator       ndc.HistoryReplicator
 		nDCHistoryImporter         ndc.HistoryImporter
@@ -185,6 +186,7 @@ func NewEngineWithShardContext(
 	persistenceRateLimiter quotas.RequestRateLimiter,
 	testHooks testhooks.TestHooks,
 	chasmEngine chasm.Engine,
+	taskTracer *queues.TaskTracer,
 ) historyi.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -241,6 +243,7 @@ func NewEngineWithShardContext(
 		versionCache:               versionCache,
 		workerDeploymentClient:     workerDeploymentClient,
 		routingInfoCache:           routingInfoCache,
+		taskTracer:                 taskTracer.ForShard(shard.GetShardID()),
 	}
 
 	historyEngImpl.queueProcessors = make(map[tasks.Category]queues.Queue)
@@ -935,11 +938,30 @@ func (e *historyEngineImpl) NotifyNewTasks(
 				}
 				continue
 			}
+			e.traceGeneratedTasks(tasksByCategory)
 			proc.NotifyNewTasks(tasksByCategory)
 		}
 	}
 }
 
+func (e *historyEngineImpl) traceGeneratedTasks(
+	newTasks []tasks.Task,
+) {
+	if e.taskTracer == nil {
+		return
+	}
+	now := e.timeSource.Now()
+	for _, task := range newTasks {
+		if _, ok := task.(*tasks.FakeTask); ok {
+			continue
+		}
+		e.taskTracer.Record(task, queues.TaskTraceEvent{
+			Stage: queues.TaskTraceStageGenerated,
+			Time:  now,
+		})
+	}
+}
+
 func (e *historyEngineImpl) GetReplicationMessages(
 	ctx context.Context,
 	pollingCluster string,
@@ -1096,7 +1118,6 @@ func (e *historyEngineImpl) AddTasks(
 		ctx,
 		e.shardContext,
 		e.serializer,
-		int(e.config.NumberOfShards),
 		request,
 		e.taskCategoryRegistry,
 	)
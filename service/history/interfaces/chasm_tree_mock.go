@@ -244,6 +244,20 @@ func (mr *MockChasmTreeMockRecorder) IsStateDirty() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStateDirty", reflect.TypeOf((*MockChasmTree)(nil).IsStateDirty))
 }
 
+// NeedsSchemaUpgrade mocks base method.
+func (m *MockChasmTree) NeedsSchemaUpgrade() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsSchemaUpgrade")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsSchemaUpgrade indicates an expected call of NeedsSchemaUpgrade.
+func (mr *MockChasmTreeMockRecorder) NeedsSchemaUpgrade() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsSchemaUpgrade", reflect.TypeOf((*MockChasmTree)(nil).NeedsSchemaUpgrade))
+}
+
 // PartitionedSnapshot mocks base method.
 func (m *MockChasmTree) PartitionedSnapshot(arg0 *persistence.VersionedTransition) (chasm.NodesSnapshot, *persistence.ChasmLocalState) {
 	m.ctrl.T.Helper()
@@ -301,6 +315,20 @@ func (mr *MockChasmTreeMockRecorder) Terminate(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockChasmTree)(nil).Terminate), arg0)
 }
 
+// UpgradeSchema mocks base method.
+func (m *MockChasmTree) UpgradeSchema() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeSchema")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeSchema indicates an expected call of UpgradeSchema.
+func (mr *MockChasmTreeMockRecorder) UpgradeSchema() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeSchema", reflect.TypeOf((*MockChasmTree)(nil).UpgradeSchema))
+}
+
 // ValidateSideEffectTask mocks base method.
 func (m *MockChasmTree) ValidateSideEffectTask(ctx context.Context, task *tasks.ChasmTask) (bool, bool, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/wideevents"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/historybuilder"
 	"go.temporal.io/server/service/history/hsm"
@@ -412,6 +413,11 @@ type (
 		AddReapplyCandidateEvent(event *historypb.HistoryEvent)
 		GetReapplyCandidateEvents() []*historypb.HistoryEvent
 
+		// AddPendingWideEvent holds a wide event until the mutable state is persisted, the event is then emitted by
+		// the workflow context.
+		AddPendingWideEvent(event wideevents.Payload)
+		PopPendingWideEvents() []wideevents.Payload
+
 		CurrentVersionedTransition() *persistencespb.VersionedTransition
 
 		DeleteSubStateMachine(path *persistencespb.StateMachinePath) error
@@ -37,6 +37,7 @@ import (
 	definition "go.temporal.io/server/common/definition"
 	namespace "go.temporal.io/server/common/namespace"
 	persistence0 "go.temporal.io/server/common/persistence"
+	wideevents "go.temporal.io/server/common/wideevents"
 	worker_versioning "go.temporal.io/server/common/worker_versioning"
 	historybuilder "go.temporal.io/server/service/history/historybuilder"
 	hsm "go.temporal.io/server/service/history/hsm"
@@ -409,6 +410,18 @@ func (mr *MockMutableStateMockRecorder) AddHistorySize(size any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistorySize", reflect.TypeOf((*MockMutableState)(nil).AddHistorySize), size)
 }
 
+// AddPendingWideEvent mocks base method.
+func (m *MockMutableState) AddPendingWideEvent(event wideevents.Payload) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddPendingWideEvent", event)
+}
+
+// AddPendingWideEvent indicates an expected call of AddPendingWideEvent.
+func (mr *MockMutableStateMockRecorder) AddPendingWideEvent(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPendingWideEvent", reflect.TypeOf((*MockMutableState)(nil).AddPendingWideEvent), event)
+}
+
 // AddReapplyCandidateEvent mocks base method.
 func (m *MockMutableState) AddReapplyCandidateEvent(event *history.HistoryEvent) {
 	m.ctrl.T.Helper()
@@ -3402,6 +3415,20 @@ func (mr *MockMutableStateMockRecorder) Now() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockMutableState)(nil).Now))
 }
 
+// PopPendingWideEvents mocks base method.
+func (m *MockMutableState) PopPendingWideEvents() []wideevents.Payload {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopPendingWideEvents")
+	ret0, _ := ret[0].([]wideevents.Payload)
+	return ret0
+}
+
+// PopPendingWideEvents indicates an expected call of PopPendingWideEvents.
+func (mr *MockMutableStateMockRecorder) PopPendingWideEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopPendingWideEvents", reflect.TypeOf((*MockMutableState)(nil).PopPendingWideEvents))
+}
+
 // PopTasks mocks base method.
 func (m *MockMutableState) PopTasks() map[tasks.Category][]tasks.Task {
 	m.ctrl.T.Helper()
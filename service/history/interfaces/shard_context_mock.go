@@ -36,6 +36,7 @@ import (
 	pingable "go.temporal.io/server/common/pingable"
 	quotas "go.temporal.io/server/common/quotas"
 	searchattribute "go.temporal.io/server/common/searchattribute"
+	shardpool "go.temporal.io/server/common/shardpool"
 	configs "go.temporal.io/server/service/history/configs"
 	events "go.temporal.io/server/service/history/events"
 	hsm "go.temporal.io/server/service/history/hsm"
@@ -684,6 +685,20 @@ func (mr *MockShardContextMockRecorder) GetShardID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardID", reflect.TypeOf((*MockShardContext)(nil).GetShardID))
 }
 
+// GetShardPools mocks base method.
+func (m *MockShardContext) GetShardPools() *shardpool.Resolver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardPools")
+	ret0, _ := ret[0].(*shardpool.Resolver)
+	return ret0
+}
+
+// GetShardPools indicates an expected call of GetShardPools.
+func (mr *MockShardContextMockRecorder) GetShardPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardPools", reflect.TypeOf((*MockShardContext)(nil).GetShardPools))
+}
+
 // GetThrottledLogger mocks base method.
 func (m *MockShardContext) GetThrottledLogger() log0.Logger {
 	m.ctrl.T.Helper()
@@ -1557,6 +1572,20 @@ func (mr *MockControllableContextMockRecorder) GetShardID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardID", reflect.TypeOf((*MockControllableContext)(nil).GetShardID))
 }
 
+// GetShardPools mocks base method.
+func (m *MockControllableContext) GetShardPools() *shardpool.Resolver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardPools")
+	ret0, _ := ret[0].(*shardpool.Resolver)
+	return ret0
+}
+
+// GetShardPools indicates an expected call of GetShardPools.
+func (mr *MockControllableContextMockRecorder) GetShardPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardPools", reflect.TypeOf((*MockControllableContext)(nil).GetShardPools))
+}
+
 // GetThrottledLogger mocks base method.
 func (m *MockControllableContext) GetThrottledLogger() log0.Logger {
 	m.ctrl.T.Helper()
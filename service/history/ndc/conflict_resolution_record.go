@@ -0,0 +1,179 @@
+package ndc
+
+import (
+	"bytes"
+
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/wideevents"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newConflictResolutionRecord(
+	resolutionType enumsspb.ConflictResolutionType,
+	winningVersionHistory *historyspb.VersionHistory,
+	losingVersionHistory *historyspb.VersionHistory,
+) (*persistencespb.ConflictResolutionRecord, error) {
+	winningLastItem, err := versionhistory.GetLastVersionHistoryItem(winningVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+	losingLastItem, err := versionhistory.GetLastVersionHistoryItem(losingVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+	lcaItem, err := versionhistory.FindLCAVersionHistoryItem(winningVersionHistory, losingVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+	return &persistencespb.ConflictResolutionRecord{
+		Type:                        resolutionType,
+		WinningBranchVersion:        winningLastItem.GetVersion(),
+		WinningBranchLastEventId:    winningLastItem.GetEventId(),
+		LosingBranchVersion:         losingLastItem.GetVersion(),
+		LosingBranchLastEventId:     losingLastItem.GetEventId(),
+		LowestCommonAncestorEventId: lcaItem.GetEventId(),
+		DiscardedEventCount:         losingLastItem.GetEventId() - lcaItem.GetEventId(),
+	}, nil
+}
+
+// newBackfillConflictResolutionRecord returns the record of the conflict resolved by backfilling events to the
+// non-current branch identified by the branch token, or nil if no such branch exists.
+func newBackfillConflictResolutionRecord(
+	mutableState historyi.MutableState,
+	branchToken []byte,
+	backfilledEventCount int,
+	reappliedEvents []*historypb.HistoryEvent,
+) (*persistencespb.ConflictResolutionRecord, error) {
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	for index, losingVersionHistory := range versionHistories.GetHistories() {
+		if int32(index) == versionHistories.GetCurrentVersionHistoryIndex() ||
+			!bytes.Equal(losingVersionHistory.GetBranchToken(), branchToken) {
+			continue
+		}
+		winningVersionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+		if err != nil {
+			return nil, err
+		}
+		record, err := newConflictResolutionRecord(
+			enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+			winningVersionHistory,
+			losingVersionHistory,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// Only the backfilled events are accounted for, previous events of the branch were accounted for when they
+		// were backfilled.
+		record.ReappliedEvents = toConflictResolutionEvents(reappliedEvents)
+		record.DiscardedEventCount = int64(backfilledEventCount - len(reappliedEvents))
+		return record, nil
+	}
+	return nil, nil
+}
+
+// recordConflictResolution adds the record to the conflict resolution records of the mutable state, keeping at most
+// the configured number of records, and emits it as a wide event once the mutable state is persisted.
+func recordConflictResolution(
+	shardContext historyi.ShardContext,
+	mutableState historyi.MutableState,
+	record *persistencespb.ConflictResolutionRecord,
+) {
+	executionInfo := mutableState.GetExecutionInfo()
+	namespaceID := namespace.ID(executionInfo.GetNamespaceId())
+	record.ClusterName = shardContext.GetClusterMetadata().GetCurrentClusterName()
+	record.ResolveTime = timestamppb.New(shardContext.GetTimeSource().Now())
+	maxReappliedEvents := shardContext.GetConfig().ConflictResolutionRecordMaxReappliedEvents(namespaceID)
+	limitReappliedEvents(record, maxReappliedEvents)
+
+	// The conflict is resolved again if the mutable state fails to be persisted, only emit the outcome that stuck.
+	mutableState.AddPendingWideEvent(wideevents.ConflictResolutionPayload{
+		NamespaceID:                 executionInfo.GetNamespaceId(),
+		WorkflowID:                  executionInfo.GetWorkflowId(),
+		RunID:                       mutableState.GetExecutionState().GetRunId(),
+		Type:                        record.GetType().String(),
+		WinningBranchVersion:        record.GetWinningBranchVersion(),
+		WinningBranchLastEventID:    record.GetWinningBranchLastEventId(),
+		LosingBranchVersion:         record.GetLosingBranchVersion(),
+		LosingBranchLastEventID:     record.GetLosingBranchLastEventId(),
+		LowestCommonAncestorEventID: record.GetLowestCommonAncestorEventId(),
+		ReappliedEvents:             toConflictResolutionEventPayloads(record.GetReappliedEvents()),
+		OmittedReappliedEventCount:  record.GetOmittedReappliedEventCount(),
+		DiscardedEventCount:         record.GetDiscardedEventCount(),
+	})
+
+	maxCount := shardContext.GetConfig().ConflictResolutionRecordMaxCount(namespaceID)
+	if maxCount <= 0 {
+		return
+	}
+	records := executionInfo.GetConflictResolutionRecords()
+	// Events of a losing branch are usually backfilled in several batches, account for them in a single record.
+	if last := len(records) - 1; last >= 0 &&
+		record.GetType() == enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL &&
+		records[last].GetType() == enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL &&
+		records[last].GetLowestCommonAncestorEventId() == record.GetLowestCommonAncestorEventId() {
+		previous := records[last]
+		record.ReappliedEvents = append(previous.GetReappliedEvents(), record.GetReappliedEvents()...)
+		record.OmittedReappliedEventCount += previous.GetOmittedReappliedEventCount()
+		record.DiscardedEventCount += previous.GetDiscardedEventCount()
+		limitReappliedEvents(record, maxReappliedEvents)
+		records = records[:last]
+	}
+	records = append(records, record)
+	if len(records) > maxCount {
+		records = records[len(records)-maxCount:]
+	}
+	executionInfo.ConflictResolutionRecords = records
+}
+
+// limitReappliedEvents keeps the first maxCount reapplied events of the record, and only counts the others.
+func limitReappliedEvents(
+	record *persistencespb.ConflictResolutionRecord,
+	maxCount int,
+) {
+	maxCount = max(maxCount, 0)
+	if len(record.ReappliedEvents) <= maxCount {
+		return
+	}
+	record.OmittedReappliedEventCount += int64(len(record.ReappliedEvents) - maxCount)
+	record.ReappliedEvents = record.ReappliedEvents[:maxCount:maxCount]
+}
+
+func toConflictResolutionEvents(
+	events []*historypb.HistoryEvent,
+) []*persistencespb.ConflictResolutionEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	result := make([]*persistencespb.ConflictResolutionEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, &persistencespb.ConflictResolutionEvent{
+			EventId:   event.GetEventId(),
+			Version:   event.GetVersion(),
+			EventType: event.GetEventType(),
+		})
+	}
+	return result
+}
+
+func toConflictResolutionEventPayloads(
+	events []*persistencespb.ConflictResolutionEvent,
+) []wideevents.ConflictResolutionEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	result := make([]wideevents.ConflictResolutionEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, wideevents.ConflictResolutionEvent{
+			EventID:   event.GetEventId(),
+			Version:   event.GetVersion(),
+			EventType: event.GetEventType().String(),
+		})
+	}
+	return result
+}
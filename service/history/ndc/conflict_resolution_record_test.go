@@ -0,0 +1,217 @@
+package ndc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/wideevents"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewConflictResolutionRecord(t *testing.T) {
+	winning := versionhistory.NewVersionHistory([]byte("winning branch token"), []*historyspb.VersionHistoryItem{
+		{EventId: 5, Version: 1},
+		{EventId: 8, Version: 3},
+	})
+	losing := versionhistory.NewVersionHistory([]byte("losing branch token"), []*historyspb.VersionHistoryItem{
+		{EventId: 5, Version: 1},
+		{EventId: 11, Version: 2},
+	})
+
+	record, err := newConflictResolutionRecord(enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD, winning, losing)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD, record.GetType())
+	require.Equal(t, int64(3), record.GetWinningBranchVersion())
+	require.Equal(t, int64(8), record.GetWinningBranchLastEventId())
+	require.Equal(t, int64(2), record.GetLosingBranchVersion())
+	require.Equal(t, int64(11), record.GetLosingBranchLastEventId())
+	require.Equal(t, int64(5), record.GetLowestCommonAncestorEventId())
+	require.Equal(t, int64(6), record.GetDiscardedEventCount())
+}
+
+func TestRecordConflictResolution(t *testing.T) {
+	testCases := []struct {
+		name              string
+		maxCount          int
+		existingRecords   int
+		expectedRecords   int
+		expectedFirstType enumsspb.ConflictResolutionType
+	}{
+		{
+			name:              "append",
+			maxCount:          3,
+			existingRecords:   1,
+			expectedRecords:   2,
+			expectedFirstType: enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		},
+		{
+			name:              "drop oldest records",
+			maxCount:          2,
+			existingRecords:   2,
+			expectedRecords:   2,
+			expectedFirstType: enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		},
+		{
+			name:            "disabled",
+			maxCount:        0,
+			existingRecords: 1,
+			expectedRecords: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			mockShard := shard.NewTestContext(
+				controller,
+				&persistencespb.ShardInfo{ShardId: 10, RangeId: 1},
+				tests.NewDynamicConfig(),
+			)
+			defer mockShard.StopForTest()
+			mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+			mockShard.GetConfig().ConflictResolutionRecordMaxCount = dynamicconfig.GetIntPropertyFnFilteredByNamespaceID(tc.maxCount)
+
+			executionInfo := &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: tests.NamespaceID.String(),
+				WorkflowId:  tests.WorkflowID,
+			}
+			for i := 0; i < tc.existingRecords; i++ {
+				executionInfo.ConflictResolutionRecords = append(executionInfo.ConflictResolutionRecords, &persistencespb.ConflictResolutionRecord{
+					Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+					LowestCommonAncestorEventId: int64(i + 1),
+				})
+			}
+			mutableState := historyi.NewMockMutableState(controller)
+			mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+			mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: tests.RunID}).AnyTimes()
+			// the record is emitted even when it is not kept
+			mutableState.EXPECT().AddPendingWideEvent(gomock.Any())
+
+			record := &persistencespb.ConflictResolutionRecord{
+				Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD,
+				LowestCommonAncestorEventId: 1,
+			}
+			recordConflictResolution(mockShard, mutableState, record)
+
+			require.Equal(t, cluster.TestCurrentClusterName, record.GetClusterName())
+			require.NotNil(t, record.GetResolveTime())
+			records := executionInfo.GetConflictResolutionRecords()
+			require.Len(t, records, tc.expectedRecords)
+			if tc.maxCount > 0 {
+				require.Equal(t, tc.expectedFirstType, records[0].GetType())
+				require.Equal(t, record, records[len(records)-1])
+			}
+		})
+	}
+}
+
+func TestRecordConflictResolution_MergeBackfill(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 10, RangeId: 1},
+		tests.NewDynamicConfig(),
+	)
+	defer mockShard.StopForTest()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: tests.NamespaceID.String(),
+		WorkflowId:  tests.WorkflowID,
+	}
+	mutableState := historyi.NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: tests.RunID}).AnyTimes()
+	mutableState.EXPECT().AddPendingWideEvent(gomock.Any()).Times(3)
+
+	recordConflictResolution(mockShard, mutableState, &persistencespb.ConflictResolutionRecord{
+		Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		LosingBranchLastEventId:     4,
+		LowestCommonAncestorEventId: 2,
+		ReappliedEvents:             []*persistencespb.ConflictResolutionEvent{{EventId: 3}},
+		DiscardedEventCount:         1,
+	})
+	recordConflictResolution(mockShard, mutableState, &persistencespb.ConflictResolutionRecord{
+		Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		LosingBranchLastEventId:     7,
+		LowestCommonAncestorEventId: 2,
+		ReappliedEvents:             []*persistencespb.ConflictResolutionEvent{{EventId: 6}},
+		DiscardedEventCount:         2,
+	})
+	// a different losing branch
+	recordConflictResolution(mockShard, mutableState, &persistencespb.ConflictResolutionRecord{
+		Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		LosingBranchLastEventId:     9,
+		LowestCommonAncestorEventId: 8,
+		DiscardedEventCount:         1,
+	})
+
+	records := executionInfo.GetConflictResolutionRecords()
+	require.Len(t, records, 2)
+	require.Equal(t, int64(7), records[0].GetLosingBranchLastEventId())
+	require.Len(t, records[0].GetReappliedEvents(), 2)
+	require.Equal(t, int64(3), records[0].GetDiscardedEventCount())
+	require.Equal(t, int64(9), records[1].GetLosingBranchLastEventId())
+}
+
+func TestRecordConflictResolution_LimitReappliedEvents(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 10, RangeId: 1},
+		tests.NewDynamicConfig(),
+	)
+	defer mockShard.StopForTest()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.GetConfig().ConflictResolutionRecordMaxReappliedEvents = dynamicconfig.GetIntPropertyFnFilteredByNamespaceID(2)
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: tests.NamespaceID.String(),
+		WorkflowId:  tests.WorkflowID,
+	}
+	var payloads []wideevents.ConflictResolutionPayload
+	mutableState := historyi.NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: tests.RunID}).AnyTimes()
+	mutableState.EXPECT().AddPendingWideEvent(gomock.Any()).Do(func(event wideevents.Payload) {
+		payloads = append(payloads, event.(wideevents.ConflictResolutionPayload))
+	}).Times(2)
+
+	recordConflictResolution(mockShard, mutableState, &persistencespb.ConflictResolutionRecord{
+		Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		LowestCommonAncestorEventId: 2,
+		ReappliedEvents: []*persistencespb.ConflictResolutionEvent{
+			{EventId: 3}, {EventId: 4}, {EventId: 5},
+		},
+	})
+	recordConflictResolution(mockShard, mutableState, &persistencespb.ConflictResolutionRecord{
+		Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+		LowestCommonAncestorEventId: 2,
+		ReappliedEvents: []*persistencespb.ConflictResolutionEvent{
+			{EventId: 6}, {EventId: 7},
+		},
+	})
+
+	require.Len(t, payloads, 2)
+	require.Len(t, payloads[0].ReappliedEvents, 2)
+	require.Equal(t, int64(1), payloads[0].OmittedReappliedEventCount)
+	require.Len(t, payloads[1].ReappliedEvents, 2)
+	require.Zero(t, payloads[1].OmittedReappliedEventCount)
+
+	// the first events of the losing branch are kept when records are merged
+	records := executionInfo.GetConflictResolutionRecords()
+	require.Len(t, records, 1)
+	require.Len(t, records[0].GetReappliedEvents(), 2)
+	require.Equal(t, int64(3), records[0].GetReappliedEvents()[0].GetEventId())
+	require.Equal(t, int64(4), records[0].GetReappliedEvents()[1].GetEventId())
+	require.Equal(t, int64(3), records[0].GetOmittedReappliedEventCount())
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -158,6 +159,7 @@ func (r *ConflictResolverImpl) rebuild(
 	rebuildVersionHistory, err := versionhistory.GetCurrentVersionHistory(rebuildVersionHistories)
 	rebuildMutableState.GetExecutionInfo().PreviousTransitionHistory = r.mutableState.GetExecutionInfo().PreviousTransitionHistory
 	rebuildMutableState.GetExecutionInfo().LastTransitionHistoryBreakPoint = r.mutableState.GetExecutionInfo().LastTransitionHistoryBreakPoint
+	rebuildMutableState.GetExecutionInfo().ExcludedFromReplication = r.mutableState.GetExecutionInfo().GetExcludedFromReplication()
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +168,20 @@ func (r *ConflictResolverImpl) rebuild(
 		return nil, serviceerror.NewInternal("ConflictResolver encounter mismatch version history after Rebuild")
 	}
 
+	// the current branch loses the conflict, record it before switching to the target branch
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return nil, err
+	}
+	record, err := newConflictResolutionRecord(
+		enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD,
+		replayVersionHistory,
+		currentVersionHistory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// set the current branch index to target branch index
 	if err := versionhistory.SetCurrentVersionHistoryIndex(versionHistories, branchIndex); err != nil {
 		return nil, err
@@ -176,6 +192,8 @@ func (r *ConflictResolverImpl) rebuild(
 	rebuildMutableState.AddExternalPayloadCount(externalPayloadCount)
 	// set the update condition from original mutable state
 	rebuildMutableState.SetUpdateCondition(r.mutableState.GetUpdateCondition())
+	rebuildMutableState.GetExecutionInfo().ConflictResolutionRecords = r.mutableState.GetExecutionInfo().ConflictResolutionRecords
+	recordConflictResolution(r.shard, rebuildMutableState, record)
 
 	r.context.Clear()
 	return rebuildMutableState, nil
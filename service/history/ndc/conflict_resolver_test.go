@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -64,6 +66,8 @@ func (s *conflictResolverSuite) SetupTest() {
 		tests.NewDynamicConfig(),
 	)
 
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
 	s.logger = s.mockShard.GetLogger()
 
 	s.namespaceID = uuid.NewString()
@@ -129,21 +133,26 @@ func (s *conflictResolverSuite) TestRebuild() {
 		s.workflowID,
 		s.runID,
 	)
-	mockRebuildMutableState := historyi.NewMockMutableState(s.controller)
-	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(
-		&persistencespb.WorkflowExecutionInfo{
-			VersionHistories: versionhistory.NewVersionHistories(
-				versionhistory.NewVersionHistory(
-					branchToken1,
-					[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID1, version)},
-				),
+	rebuildExecutionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: s.namespaceID,
+		WorkflowId:  s.workflowID,
+		VersionHistories: versionhistory.NewVersionHistories(
+			versionhistory.NewVersionHistory(
+				branchToken1,
+				[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID1, version)},
 			),
-		},
-	).AnyTimes()
+		),
+	}
+	mockRebuildMutableState := historyi.NewMockMutableState(s.controller)
+	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(rebuildExecutionInfo).AnyTimes()
+	mockRebuildMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: s.runID,
+	}).AnyTimes()
 	mockRebuildMutableState.EXPECT().AddHistorySize(historySize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadSize(externalPayloadSize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadCount(externalPayloadCount)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition, dbVersion)
+	mockRebuildMutableState.EXPECT().AddPendingWideEvent(gomock.Any())
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -166,6 +175,19 @@ func (s *conflictResolverSuite) TestRebuild() {
 	s.NoError(err)
 	s.NotNil(rebuiltMutableState)
 	s.Equal(int32(1), versionHistories.GetCurrentVersionHistoryIndex())
+
+	records := rebuildExecutionInfo.GetConflictResolutionRecords()
+	s.Len(records, 1)
+	s.Equal(cluster.TestCurrentClusterName, records[0].GetClusterName())
+	s.NotNil(records[0].GetResolveTime())
+	s.Equal(enumsspb.CONFLICT_RESOLUTION_TYPE_REBUILD, records[0].GetType())
+	s.Equal(version, records[0].GetWinningBranchVersion())
+	s.Equal(lastEventID1, records[0].GetWinningBranchLastEventId())
+	s.Equal(version, records[0].GetLosingBranchVersion())
+	s.Equal(lastEventID0, records[0].GetLosingBranchLastEventId())
+	s.Equal(lastEventID1, records[0].GetLowestCommonAncestorEventId())
+	s.Empty(records[0].GetReappliedEvents())
+	s.Equal(lastEventID0-lastEventID1, records[0].GetDiscardedEventCount())
 }
 
 func (s *conflictResolverSuite) TestGetOrRebuildCurrentMutableState_NoRebuild_NotCurrent() {
@@ -276,10 +298,14 @@ func (s *conflictResolverSuite) TestGetOrRebuildCurrentMutableState_Rebuild() {
 			),
 		},
 	).AnyTimes()
+	mockRebuildMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: s.runID,
+	}).AnyTimes()
 	mockRebuildMutableState.EXPECT().AddHistorySize(historySize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadSize(externalPayloadSize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadCount(externalPayloadCount)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition, dbVersion)
+	mockRebuildMutableState.EXPECT().AddPendingWideEvent(gomock.Any())
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -383,10 +409,14 @@ func (s *conflictResolverSuite) TestGetOrRebuildMutableState_Rebuild() {
 			),
 		},
 	).AnyTimes()
+	mockRebuildMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: s.runID,
+	}).AnyTimes()
 	mockRebuildMutableState.EXPECT().AddHistorySize(historySize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadSize(externalPayloadSize)
 	mockRebuildMutableState.EXPECT().AddExternalPayloadCount(externalPayloadCount)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition, dbVersion)
+	mockRebuildMutableState.EXPECT().AddPendingWideEvent(gomock.Any())
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
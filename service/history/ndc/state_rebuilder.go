@@ -219,6 +219,8 @@ func copyToRebuildMutableState(
 	rebuiltMutableState.GetExecutionInfo().TransitionHistory = transitionhistory.CopyVersionedTransitions(currentMutableState.GetExecutionInfo().TransitionHistory)
 	rebuiltMutableState.GetExecutionInfo().PreviousTransitionHistory = transitionhistory.CopyVersionedTransitions(currentMutableState.GetExecutionInfo().PreviousTransitionHistory)
 	rebuiltMutableState.GetExecutionInfo().LastTransitionHistoryBreakPoint = transitionhistory.CopyVersionedTransition(currentMutableState.GetExecutionInfo().LastTransitionHistoryBreakPoint)
+	// The replication filter decision is made when the workflow starts and can't be derived from its events.
+	rebuiltMutableState.GetExecutionInfo().ExcludedFromReplication = currentMutableState.GetExecutionInfo().GetExcludedFromReplication()
 }
 
 func (r *StateRebuilderImpl) buildMutableStateFromEvent(
@@ -239,7 +239,7 @@ func (r *transactionMgrImpl) BackfillWorkflow(
 	}
 
 	targetWorkflow.GetMutableState().AddHistorySize(sizeSiff)
-	updateMode, transactionPolicy, err := r.backfillWorkflowEventsReapply(
+	updateMode, transactionPolicy, reappliedEvents, err := r.backfillWorkflowEventsReapply(
 		ctx,
 		targetWorkflow,
 		targetWorkflowEventsSlice...,
@@ -247,6 +247,13 @@ func (r *transactionMgrImpl) BackfillWorkflow(
 	if err != nil {
 		return err
 	}
+	if err := r.recordBackfillConflictResolution(
+		targetWorkflow.GetMutableState(),
+		reappliedEvents,
+		targetWorkflowEventsSlice...,
+	); err != nil {
+		return err
+	}
 
 	return targetWorkflow.GetContext().UpdateWorkflowExecutionWithNew(
 		ctx,
@@ -263,11 +270,11 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 	ctx context.Context,
 	targetWorkflow Workflow,
 	targetWorkflowEventsSlice ...*persistence.WorkflowEvents,
-) (persistence.UpdateWorkflowMode, historyi.TransactionPolicy, error) {
+) (persistence.UpdateWorkflowMode, historyi.TransactionPolicy, []*historypb.HistoryEvent, error) {
 
 	isCurrentWorkflow, err := r.isWorkflowCurrent(ctx, chasm.WorkflowArchetypeID, targetWorkflow)
 	if err != nil {
-		return 0, historyi.TransactionPolicyActive, err
+		return 0, historyi.TransactionPolicyActive, nil, err
 	}
 	isWorkflowRunning := targetWorkflow.GetMutableState().IsWorkflowExecutionRunning()
 	targetWorkflowActiveCluster := targetWorkflow.GetMutableState().GetNamespaceEntry().ActiveClusterName(namespace.RoutingKey{ID: targetWorkflow.GetMutableState().GetExecutionInfo().WorkflowId})
@@ -290,16 +297,17 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 
 		// case 1.a
 		if isWorkflowRunning {
-			if _, err := r.eventsReapplier.ReapplyEvents(
+			reappliedEvents, err := r.eventsReapplier.ReapplyEvents(
 				ctx,
 				targetWorkflow.GetMutableState(),
 				targetWorkflow.GetContext().UpdateRegistry(ctx),
 				totalEvents,
 				targetWorkflow.GetMutableState().GetExecutionState().GetRunId(),
-			); err != nil {
-				return 0, historyi.TransactionPolicyActive, err
+			)
+			if err != nil {
+				return 0, historyi.TransactionPolicyActive, nil, err
 			}
-			return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyActive, nil
+			return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyActive, reappliedEvents, nil
 		}
 
 		// case 1.b
@@ -331,11 +339,11 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 		baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 		baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
 		if err != nil {
-			return 0, historyi.TransactionPolicyActive, err
+			return 0, historyi.TransactionPolicyActive, nil, err
 		}
 		baseRebuildLastEventVersion, err := versionhistory.GetVersionHistoryEventVersion(baseCurrentVersionHistory, baseRebuildLastEventID)
 		if err != nil {
-			return 0, historyi.TransactionPolicyActive, err
+			return 0, historyi.TransactionPolicyActive, nil, err
 		}
 		baseCurrentBranchToken := baseCurrentVersionHistory.GetBranchToken()
 		baseNextEventID := baseMutableState.GetNextEventID()
@@ -363,13 +371,13 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 			// no-op. Usually this is due to reset workflow with pending child workflows
 			r.logger.Warn("Cannot reset workflow. Ignoring reapply events.", tag.Error(err))
 			// the target workflow is not reset so it is still the current workflow. It need to persist updated version histories.
-			return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyPassive, nil
+			return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyPassive, nil, nil
 		case nil:
 			// after the reset of target workflow (current workflow) with additional events to be reapplied
 			// target workflow is no longer the current workflow
-			return persistence.UpdateWorkflowModeBypassCurrent, historyi.TransactionPolicyPassive, nil
+			return persistence.UpdateWorkflowModeBypassCurrent, historyi.TransactionPolicyPassive, r.filterReapplyEvents(totalEvents), nil
 		default:
-			return 0, historyi.TransactionPolicyActive, err
+			return 0, historyi.TransactionPolicyActive, nil, err
 		}
 	}
 
@@ -380,13 +388,59 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 		r.shardContext,
 		targetWorkflowEventsSlice,
 	); err != nil {
-		return 0, historyi.TransactionPolicyActive, err
+		return 0, historyi.TransactionPolicyActive, nil, err
+	}
+
+	var reappliedEvents []*historypb.HistoryEvent
+	for _, events := range targetWorkflowEventsSlice {
+		reappliedEvents = append(reappliedEvents, r.filterReapplyEvents(events.Events)...)
 	}
 
 	if isCurrentWorkflow {
-		return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyPassive, nil
+		return persistence.UpdateWorkflowModeUpdateCurrent, historyi.TransactionPolicyPassive, reappliedEvents, nil
+	}
+	return persistence.UpdateWorkflowModeBypassCurrent, historyi.TransactionPolicyPassive, reappliedEvents, nil
+}
+
+// recordBackfillConflictResolution records the conflict resolved by backfilling the events of the losing branch to
+// the target workflow, along with the events reapplied to the current workflow.
+func (r *transactionMgrImpl) recordBackfillConflictResolution(
+	targetMutableState historyi.MutableState,
+	reappliedEvents []*historypb.HistoryEvent,
+	targetWorkflowEventsSlice ...*persistence.WorkflowEvents,
+) error {
+	if len(targetWorkflowEventsSlice) == 0 {
+		return nil
+	}
+	backfilledEventCount := 0
+	for _, events := range targetWorkflowEventsSlice {
+		backfilledEventCount += len(events.Events)
+	}
+	record, err := newBackfillConflictResolutionRecord(
+		targetMutableState,
+		targetWorkflowEventsSlice[0].BranchToken,
+		backfilledEventCount,
+		reappliedEvents,
+	)
+	if err != nil || record == nil {
+		return err
+	}
+	recordConflictResolution(r.shardContext, targetMutableState, record)
+	return nil
+}
+
+// filterReapplyEvents returns the events which are reapplied to the current workflow, the same way events are
+// filtered when reapplied.
+func (r *transactionMgrImpl) filterReapplyEvents(
+	events []*historypb.HistoryEvent,
+) []*historypb.HistoryEvent {
+	var reapplyEvents []*historypb.HistoryEvent
+	for _, event := range events {
+		if workflow.ShouldReapplyEvent(r.shardContext.StateMachineRegistry(), event) {
+			reapplyEvents = append(reapplyEvents, event)
+		}
 	}
-	return persistence.UpdateWorkflowModeBypassCurrent, historyi.TransactionPolicyPassive, nil
+	return reapplyEvents
 }
 
 func (r *transactionMgrImpl) CheckWorkflowExists(
@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/testing/protorequire"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tests"
@@ -160,7 +161,7 @@ func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Open()
 	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		NamespaceId: s.namespaceEntry.ID().String(),
 		WorkflowId:  workflowID,
-	})
+	}).AnyTimes()
 	weContext.EXPECT().PersistWorkflowEvents(gomock.Any(), s.mockShard, workflowEvents).Return(historySize, nil)
 	weContext.EXPECT().UpdateWorkflowExecutionWithNew(
 		gomock.Any(), s.mockShard, persistence.UpdateWorkflowModeUpdateCurrent, nil, nil, historyi.TransactionPolicyActive, (*historyi.TransactionPolicy)(nil),
@@ -771,7 +772,7 @@ func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Passive_Open(
 	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		NamespaceId: s.namespaceEntry.ID().String(),
 		WorkflowId:  workflowID,
-	})
+	}).AnyTimes()
 	weContext.EXPECT().ReapplyEvents(gomock.Any(), s.mockShard, []*persistence.WorkflowEvents{workflowEvents})
 	weContext.EXPECT().PersistWorkflowEvents(gomock.Any(), s.mockShard, workflowEvents).Return(historySize, nil)
 	weContext.EXPECT().UpdateWorkflowExecutionWithNew(
@@ -782,6 +783,99 @@ func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Passive_Open(
 	s.True(releaseCalled)
 }
 
+func (s *transactionMgrSuite) TestBackfillWorkflow_RecordConflictResolution() {
+	ctx := context.Background()
+
+	workflowID := "some random workflow ID"
+	runID := uuid.NewString()
+	currentVersion := s.namespaceEntry.FailoverVersion(workflowID)
+	incomingVersion := currentVersion - 1
+
+	// current branch
+	currentVersionHistory := versionhistory.NewVersionHistory([]byte("current branch token"), []*historyspb.VersionHistoryItem{
+		{EventId: 2, Version: incomingVersion},
+		{EventId: 6, Version: currentVersion},
+	})
+	// losing branch, to which incoming events are backfilled
+	incomingBranchToken := []byte("incoming branch token")
+	incomingVersionHistory := versionhistory.NewVersionHistory(incomingBranchToken, []*historyspb.VersionHistoryItem{
+		{EventId: 4, Version: incomingVersion},
+	})
+	versionHistories := versionhistory.NewVersionHistories(currentVersionHistory)
+	_, err := versionhistory.AddVersionHistory(versionHistories, incomingVersionHistory)
+	s.NoError(err)
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      s.namespaceEntry.ID().String(),
+		WorkflowId:       workflowID,
+		VersionHistories: versionHistories,
+		ConflictResolutionRecords: []*persistencespb.ConflictResolutionRecord{{
+			Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+			LowestCommonAncestorEventId: 2,
+			DiscardedEventCount:         1,
+		}},
+	}
+
+	releaseCalled := false
+	targetWorkflow := NewMockWorkflow(s.controller)
+	weContext := historyi.NewMockWorkflowContext(s.controller)
+	mutableState := historyi.NewMockMutableState(s.controller)
+	var releaseFn historyi.ReleaseWorkflowContextFunc = func(error) { releaseCalled = true }
+
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   4,
+		Version:   incomingVersion,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+	}
+	workflowEvents := &persistence.WorkflowEvents{
+		BranchToken: incomingBranchToken,
+		Events: []*historypb.HistoryEvent{
+			{EventId: 3, Version: incomingVersion, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED},
+			signalEvent,
+		},
+	}
+	historySize := rand.Int63()
+
+	targetWorkflow.EXPECT().GetContext().Return(weContext).AnyTimes()
+	targetWorkflow.EXPECT().GetMutableState().Return(mutableState).AnyTimes()
+	targetWorkflow.EXPECT().GetReleaseFn().Return(releaseFn).AnyTimes()
+
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(s.namespaceEntry.IsGlobalNamespace(), s.namespaceEntry.FailoverVersion(workflowID)).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestAlternativeClusterName).AnyTimes()
+
+	mutableState.EXPECT().IsCurrentWorkflowGuaranteed().Return(true).AnyTimes()
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetNamespaceEntry().Return(s.namespaceEntry).AnyTimes()
+	mutableState.EXPECT().AddHistorySize(historySize)
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: runID}).AnyTimes()
+	mutableState.EXPECT().AddPendingWideEvent(gomock.Any())
+	weContext.EXPECT().ReapplyEvents(gomock.Any(), s.mockShard, []*persistence.WorkflowEvents{workflowEvents})
+	weContext.EXPECT().PersistWorkflowEvents(gomock.Any(), s.mockShard, workflowEvents).Return(historySize, nil)
+	weContext.EXPECT().UpdateWorkflowExecutionWithNew(
+		gomock.Any(), s.mockShard, persistence.UpdateWorkflowModeUpdateCurrent, nil, nil, historyi.TransactionPolicyPassive, (*historyi.TransactionPolicy)(nil),
+	).Return(nil)
+	err = s.transactionMgr.BackfillWorkflow(ctx, targetWorkflow, workflowEvents)
+	s.NoError(err)
+	s.True(releaseCalled)
+
+	// events backfilled to the same losing branch are accounted for in the previous record
+	records := executionInfo.GetConflictResolutionRecords()
+	s.Len(records, 1)
+	s.Equal(cluster.TestAlternativeClusterName, records[0].GetClusterName())
+	s.Equal(enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL, records[0].GetType())
+	s.Equal(currentVersion, records[0].GetWinningBranchVersion())
+	s.Equal(int64(6), records[0].GetWinningBranchLastEventId())
+	s.Equal(incomingVersion, records[0].GetLosingBranchVersion())
+	s.Equal(int64(4), records[0].GetLosingBranchLastEventId())
+	s.Equal(int64(2), records[0].GetLowestCommonAncestorEventId())
+	protorequire.ProtoSliceEqual(s.T(), []*persistencespb.ConflictResolutionEvent{{
+		EventId:   signalEvent.GetEventId(),
+		Version:   signalEvent.GetVersion(),
+		EventType: signalEvent.GetEventType(),
+	}}, records[0].GetReappliedEvents())
+	s.Equal(int64(2), records[0].GetDiscardedEventCount())
+}
+
 func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Passive_Closed() {
 	ctx := context.Background()
 
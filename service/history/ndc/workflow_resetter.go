@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
@@ -48,6 +49,10 @@ var (
 type (
 	workflowResetReapplyEventsFn func(ctx context.Context, resetMutableState historyi.MutableState) error
 
+	// resetEventRecorderFn is called for every event written after the reset point to the base run or to a run it
+	// continued as new into, with whether the event was reapplied to the reset run.
+	resetEventRecorderFn func(runID string, event *historypb.HistoryEvent, reapplied bool)
+
 	WorkflowResetter interface {
 		ResetWorkflow(
 			ctx context.Context,
@@ -67,6 +72,22 @@ type (
 			allowResetWithPendingChildren bool,
 			postResetOperations []*workflowpb.PostResetOperation,
 		) error
+		DryRunResetWorkflow(
+			ctx context.Context,
+			namespaceID namespace.ID,
+			workflowID string,
+			baseRunID string,
+			baseBranchToken []byte,
+			baseRebuildLastEventID int64,
+			baseRebuildLastEventVersion int64,
+			baseNextEventID int64,
+			resetRunID string,
+			baseWorkflow Workflow,
+			currentWorkflow Workflow,
+			resetReason string,
+			resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+			allowResetWithPendingChildren bool,
+		) (*historyspb.ResetDryRunResult, error)
 	}
 
 	workflowResetterImpl struct {
@@ -159,6 +180,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 				baseNextEventID,
 				resetReapplyExcludeTypes,
 				allowResetWithPendingChildren,
+				nil,
 			)
 			return err
 		}
@@ -193,6 +215,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 				baseNextEventID,
 				resetReapplyExcludeTypes,
 				allowResetWithPendingChildren,
+				nil,
 			)
 			if err != nil {
 				return err
@@ -221,6 +244,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 				baseNextEventID,
 				resetReapplyExcludeTypes,
 				allowResetWithPendingChildren,
+				nil,
 			)
 			return err
 		}
@@ -254,6 +278,8 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	defer func() { resetWorkflow.GetReleaseFn()(retError) }()
 
 	resetMS := resetWorkflow.GetMutableState()
+	// The reset run keeps the replication filter decision made when the base run started.
+	resetMS.GetExecutionInfo().ExcludedFromReplication = baseWorkflow.GetMutableState().GetExecutionInfo().GetExcludedFromReplication()
 	if err := reapplyEventsFn(ctx, resetMS); err != nil {
 		return err
 	}
@@ -317,29 +343,56 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	// Reset expiration time
-	resetMutableState := resetWorkflow.GetMutableState()
+	if err := r.prepareResetMutableState(
+		ctx,
+		resetWorkflow.GetMutableState(),
+		baseRunID,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetWorkflowVersion,
+		resetReason,
+		allowResetWithPendingChildren,
+	); err != nil {
+		return nil, err
+	}
+	return resetWorkflow, nil
+}
 
+// prepareResetMutableState brings the mutable state replayed up to the reset point into the state the new run starts
+// in: it fails the workflow task at the reset point and the activities that were running at that time.
+func (r *workflowResetterImpl) prepareResetMutableState(
+	ctx context.Context,
+	resetMutableState historyi.MutableState,
+	baseRunID string,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	resetRunID string,
+	resetWorkflowVersion int64,
+	resetReason string,
+	allowResetWithPendingChildren bool,
+) error {
+	// Reset expiration time
 	// if workflow was reset after it was expired - at this point expiration task will
 	// already be fired since it is (re)created from the event, and event has old expiration time
 	// generate workflow execution task. again. this time with proper expiration time
 
 	if err := resetMutableState.RefreshExpirationTimeoutTask(ctx); err != nil {
-		return nil, err
+		return err
 	}
 
 	if resetMutableState.GetCurrentVersion() > resetWorkflowVersion {
-		return nil, serviceerror.NewInternal("WorkflowResetter encountered version mismatch.")
+		return serviceerror.NewInternal("WorkflowResetter encountered version mismatch.")
 	}
 	if err := resetMutableState.UpdateCurrentVersion(
 		resetWorkflowVersion,
 		false,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
 	if !allowResetWithPendingChildren && len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
-		return nil, serviceerror.NewInvalidArgument("WorkflowResetter encountered pending child workflows.")
+		return serviceerror.NewInvalidArgument("WorkflowResetter encountered pending child workflows.")
 	}
 
 	if err := r.failWorkflowTask(
@@ -350,18 +403,14 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		resetRunID,
 		resetReason,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := r.failInflightActivity(
+	return r.failInflightActivity(
 		resetMutableState.GetExecutionState().StartTime.AsTime(),
 		resetMutableState,
 		resetReason,
-	); err != nil {
-		return nil, err
-	}
-
-	return resetWorkflow, nil
+	)
 }
 
 func (r *workflowResetterImpl) persistToDB(
@@ -526,6 +575,34 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 		return nil, err
 	}
 
+	return r.rebuildResetWorkflow(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		newBaseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetRequestID,
+		resetBranchToken,
+	)
+}
+
+// rebuildResetWorkflow rebuilds the mutable state of the reset run in memory from the base run's history up to the
+// reset point.
+func (r *workflowResetterImpl) rebuildResetWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	resetRunID string,
+	resetRequestID string,
+	resetBranchToken []byte,
+) (Workflow, error) {
 	resetContext := workflow.NewContext(
 		r.shardContext.GetConfig(),
 		definition.NewWorkflowKey(
@@ -548,7 +625,7 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 			workflowID,
 			baseRunID,
 		),
-		newBaseBranchToken,
+		baseBranchToken,
 		baseRebuildLastEventID,
 		new(baseRebuildLastEventVersion),
 		definition.NewWorkflowKey(
@@ -729,16 +806,25 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 	baseNextEventID int64,
 	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
 	allowResetWithPendingChildren bool,
+	recordEvent resetEventRecorderFn,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
 	//  from visibility for better coverage of events eligible for re-application.
 
 	lastVisitedRunID := baseRunID
-	if r.shouldExcludeAllReapplyEvents(resetReapplyExcludeTypes) {
+	if r.shouldExcludeAllReapplyEvents(resetReapplyExcludeTypes) && recordEvent == nil {
 		// All subsequent events should be excluded from being re-applied. So, do nothing and return.
 		return lastVisitedRunID, nil
 	}
+	recordRunEvent := func(runID string) func(*historypb.HistoryEvent, bool) {
+		if recordEvent == nil {
+			return nil
+		}
+		return func(event *historypb.HistoryEvent, reapplied bool) {
+			recordEvent(runID, event, reapplied)
+		}
+	}
 
 	// track the child workflows initiated after reset.
 	// This will be saved in the parent workflow (in execution info) and used by the parent later to determine how to start these child workflows again.
@@ -754,6 +840,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 		resetReapplyExcludeTypes,
 		allowResetWithPendingChildren,
 		childrenInitializedAfterReset,
+		recordRunEvent(baseRunID),
 	)
 	switch err.(type) {
 	case nil:
@@ -828,6 +915,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 			resetReapplyExcludeTypes,
 			allowResetWithPendingChildren,
 			childrenInitializedAfterReset,
+			recordRunEvent(lastVisitedRunID),
 		)
 		switch err.(type) {
 		case nil:
@@ -855,6 +943,7 @@ func (r *workflowResetterImpl) reapplyEventsFromBranch(
 	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
 	allowResetWithPendingChildren bool,
 	childrenInitializedAfterReset map[string]*persistencespb.ResetChildInfo,
+	recordEvent func(event *historypb.HistoryEvent, reapplied bool),
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -877,8 +966,21 @@ func (r *workflowResetterImpl) reapplyEventsFromBranch(
 			return "", err
 		}
 		lastEvents = batch.Events
-		if _, err := r.reapplyEvents(ctx, mutableState, lastEvents, resetReapplyExcludeTypes); err != nil {
-			return "", err
+		var reappliedEvents []*historypb.HistoryEvent
+		if !r.shouldExcludeAllReapplyEvents(resetReapplyExcludeTypes) {
+			if reappliedEvents, err = r.reapplyEvents(ctx, mutableState, lastEvents, resetReapplyExcludeTypes); err != nil {
+				return "", err
+			}
+		}
+		if recordEvent != nil {
+			reappliedEventIDs := make(map[int64]struct{}, len(reappliedEvents))
+			for _, event := range reappliedEvents {
+				reappliedEventIDs[event.GetEventId()] = struct{}{}
+			}
+			for _, event := range lastEvents {
+				_, reapplied := reappliedEventIDs[event.GetEventId()]
+				recordEvent(event, reapplied)
+			}
 		}
 		// TODO: uncomment this code to enable phase 2 of reset workflow feature.
 		// track the child workflows initiated after reset-point
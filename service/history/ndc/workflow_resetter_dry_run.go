@@ -0,0 +1,274 @@
+package ndc
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+type (
+	// resetDryRunRecorder builds a ResetDryRunResult from the events visited while reapplying events to the reset run.
+	resetDryRunRecorder struct {
+		baseRunID string
+		result    *historyspb.ResetDryRunResult
+		// completedActivities holds the scheduled event IDs of the base run's activities that completed after the reset
+		// point.
+		completedActivities map[int64]struct{}
+		// children holds the child workflows initiated or started after the reset point, in the order they were seen.
+		children      map[resetDryRunChildKey]*historyspb.ResetDryRunResult_ChildWorkflow
+		childrenOrder []resetDryRunChildKey
+	}
+
+	resetDryRunChildKey struct {
+		parentRunID      string
+		initiatedEventID int64
+	}
+)
+
+// DryRunResetWorkflow computes the effect of resetting the base run, without persisting anything. The reset run is
+// rebuilt in memory from the base run's history without forking it, and the base and current runs are only read.
+//
+// Events the current run would write while being terminated by the reset, such as buffered signals, are not part of
+// the result.
+func (r *workflowResetterImpl) DryRunResetWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	baseNextEventID int64,
+	resetRunID string,
+	baseWorkflow Workflow,
+	currentWorkflow Workflow,
+	resetReason string,
+	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+	allowResetWithPendingChildren bool,
+) (*historyspb.ResetDryRunResult, error) {
+
+	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &historyspb.ResetDryRunResult{
+		ResetRunId: resetRunID,
+	}
+	resetWorkflowVersion := namespaceEntry.FailoverVersion(workflowID)
+	if currentWorkflow != nil {
+		if currentMutableState := currentWorkflow.GetMutableState(); currentMutableState.IsWorkflowExecutionRunning() {
+			result.TerminatedRunId = currentMutableState.GetWorkflowKey().RunID
+			resetWorkflowVersion = currentMutableState.GetCurrentVersion()
+		}
+	}
+
+	resetWorkflow, err := r.rebuildResetWorkflow(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		findStartRequestID(baseWorkflow.GetMutableState().GetExecutionState()),
+		baseBranchToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resetMutableState := resetWorkflow.GetMutableState()
+
+	for _, ai := range resetMutableState.GetPendingActivityInfos() {
+		if ai.StartedEventId != common.EmptyEventID {
+			result.FailedActivities = append(result.FailedActivities, newResetDryRunActivity(ai, false))
+		}
+	}
+	if err := r.prepareResetMutableState(
+		ctx,
+		resetMutableState,
+		baseRunID,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetWorkflowVersion,
+		resetReason,
+		allowResetWithPendingChildren,
+	); err != nil {
+		return nil, err
+	}
+
+	recorder := newResetDryRunRecorder(baseRunID, result)
+	if _, err := r.reapplyContinueAsNewWorkflowEvents(
+		ctx,
+		resetMutableState,
+		currentWorkflow,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID+1,
+		baseNextEventID,
+		resetReapplyExcludeTypes,
+		allowResetWithPendingChildren,
+		recorder.record,
+	); err != nil {
+		return nil, err
+	}
+	recorder.finish(resetMutableState)
+
+	slices.SortFunc(result.FailedActivities, compareResetDryRunActivities)
+	return result, nil
+}
+
+func newResetDryRunRecorder(baseRunID string, result *historyspb.ResetDryRunResult) *resetDryRunRecorder {
+	return &resetDryRunRecorder{
+		baseRunID:           baseRunID,
+		result:              result,
+		completedActivities: make(map[int64]struct{}),
+		children:            make(map[resetDryRunChildKey]*historyspb.ResetDryRunResult_ChildWorkflow),
+	}
+}
+
+func (d *resetDryRunRecorder) record(runID string, event *historypb.HistoryEvent, reapplied bool) {
+	resultEvent := &historyspb.ResetDryRunResult_Event{
+		RunId:     runID,
+		EventId:   event.GetEventId(),
+		EventType: event.GetEventType(),
+	}
+	if reapplied {
+		d.result.ReappliedEvents = append(d.result.ReappliedEvents, resultEvent)
+	} else {
+		d.result.DroppedEvents = append(d.result.DroppedEvents, resultEvent)
+	}
+
+	switch event.GetEventType() { // nolint:exhaustive
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		d.recordActivityCompleted(runID, event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId())
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		d.recordActivityCompleted(runID, event.GetActivityTaskFailedEventAttributes().GetScheduledEventId())
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		d.recordActivityCompleted(runID, event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId())
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		d.recordActivityCompleted(runID, event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId())
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		attributes := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		child := d.getOrCreateChild(runID, event.GetEventId())
+		child.WorkflowId = attributes.GetWorkflowId()
+		child.WorkflowType = attributes.GetWorkflowType().GetName()
+		child.ParentClosePolicy = attributes.GetParentClosePolicy()
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		attributes := event.GetChildWorkflowExecutionStartedEventAttributes()
+		child := d.getOrCreateChild(runID, attributes.GetInitiatedEventId())
+		child.WorkflowId = attributes.GetWorkflowExecution().GetWorkflowId()
+		child.RunId = attributes.GetWorkflowExecution().GetRunId()
+		child.WorkflowType = attributes.GetWorkflowType().GetName()
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		d.deleteChild(runID, event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		d.deleteChild(runID, event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId())
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		d.deleteChild(runID, event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		d.deleteChild(runID, event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId())
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		d.deleteChild(runID, event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId())
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		d.deleteChild(runID, event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId())
+	}
+}
+
+func (d *resetDryRunRecorder) recordActivityCompleted(runID string, scheduledEventID int64) {
+	// Activities of later runs have nothing in common with the activities of the reset run.
+	if runID == d.baseRunID {
+		d.completedActivities[scheduledEventID] = struct{}{}
+	}
+}
+
+func (d *resetDryRunRecorder) getOrCreateChild(runID string, initiatedEventID int64) *historyspb.ResetDryRunResult_ChildWorkflow {
+	key := resetDryRunChildKey{parentRunID: runID, initiatedEventID: initiatedEventID}
+	child, ok := d.children[key]
+	if !ok {
+		child = &historyspb.ResetDryRunResult_ChildWorkflow{
+			ParentRunId:      runID,
+			InitiatedEventId: initiatedEventID,
+		}
+		d.children[key] = child
+		d.childrenOrder = append(d.childrenOrder, key)
+	}
+	return child
+}
+
+func (d *resetDryRunRecorder) deleteChild(runID string, initiatedEventID int64) {
+	delete(d.children, resetDryRunChildKey{parentRunID: runID, initiatedEventID: initiatedEventID})
+}
+
+// finish records the pending work of the reset run, and the child workflows that are still open but no longer tracked
+// by it.
+func (d *resetDryRunRecorder) finish(resetMutableState historyi.MutableState) {
+	for _, ai := range resetMutableState.GetPendingActivityInfos() {
+		_, completed := d.completedActivities[ai.ScheduledEventId]
+		d.result.RescheduledActivities = append(d.result.RescheduledActivities, newResetDryRunActivity(ai, completed))
+	}
+	slices.SortFunc(d.result.RescheduledActivities, compareResetDryRunActivities)
+
+	for _, ti := range resetMutableState.GetPendingTimerInfos() {
+		d.result.RescheduledTimers = append(d.result.RescheduledTimers, &historyspb.ResetDryRunResult_Timer{
+			TimerId:        ti.GetTimerId(),
+			StartedEventId: ti.GetStartedEventId(),
+			ExpiryTime:     ti.GetExpiryTime(),
+		})
+	}
+	slices.SortFunc(d.result.RescheduledTimers, func(a, b *historyspb.ResetDryRunResult_Timer) int {
+		return cmp.Compare(a.GetStartedEventId(), b.GetStartedEventId())
+	})
+
+	pendingChildren := resetMutableState.GetPendingChildExecutionInfos()
+	for _, ci := range pendingChildren {
+		d.result.PendingChildWorkflows = append(d.result.PendingChildWorkflows, &historyspb.ResetDryRunResult_ChildWorkflow{
+			ParentRunId:       d.baseRunID,
+			InitiatedEventId:  ci.GetInitiatedEventId(),
+			WorkflowId:        ci.GetStartedWorkflowId(),
+			RunId:             ci.GetStartedRunId(),
+			WorkflowType:      ci.GetWorkflowTypeName(),
+			ParentClosePolicy: ci.GetParentClosePolicy(),
+		})
+	}
+	slices.SortFunc(d.result.PendingChildWorkflows, func(a, b *historyspb.ResetDryRunResult_ChildWorkflow) int {
+		return cmp.Compare(a.GetInitiatedEventId(), b.GetInitiatedEventId())
+	})
+
+	for _, key := range d.childrenOrder {
+		child, ok := d.children[key]
+		if !ok || child.GetRunId() == "" {
+			// Closed, or never started.
+			continue
+		}
+		if _, tracked := pendingChildren[key.initiatedEventID]; tracked && key.parentRunID == d.baseRunID {
+			continue
+		}
+		d.result.OrphanedChildWorkflows = append(d.result.OrphanedChildWorkflows, child)
+	}
+}
+
+func newResetDryRunActivity(ai *persistencespb.ActivityInfo, completedAfterResetPoint bool) *historyspb.ResetDryRunResult_Activity {
+	return &historyspb.ResetDryRunResult_Activity{
+		ScheduledEventId:         ai.GetScheduledEventId(),
+		ActivityId:               ai.GetActivityId(),
+		ActivityType:             ai.GetActivityType().GetName(),
+		CompletedAfterResetPoint: completedAfterResetPoint,
+	}
+}
+
+func compareResetDryRunActivities(a, b *historyspb.ResetDryRunResult_Activity) int {
+	return cmp.Compare(a.GetScheduledEventId(), b.GetScheduledEventId())
+}
@@ -0,0 +1,193 @@
+package ndc
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResetDryRunRecorder(t *testing.T) {
+	baseRunID := uuid.NewString()
+	nextRunID := uuid.NewString()
+	orphanedChildRunID := uuid.NewString()
+	trackedChildRunID := uuid.NewString()
+
+	result := &historyspb.ResetDryRunResult{}
+	recorder := newResetDryRunRecorder(baseRunID, result)
+
+	events := []struct {
+		runID     string
+		event     *historypb.HistoryEvent
+		reapplied bool
+	}{
+		{
+			runID: baseRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   20,
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+				Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+					ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{ScheduledEventId: 5},
+				},
+			},
+		},
+		{
+			runID: baseRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   21,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			},
+			reapplied: true,
+		},
+		{
+			// Child initiated before the reset point and tracked by the reset run.
+			runID: baseRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   22,
+				EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionStartedEventAttributes{
+					ChildWorkflowExecutionStartedEventAttributes: &historypb.ChildWorkflowExecutionStartedEventAttributes{
+						InitiatedEventId:  9,
+						WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "tracked-child", RunId: trackedChildRunID},
+					},
+				},
+			},
+		},
+		{
+			runID: baseRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   23,
+				EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED,
+				Attributes: &historypb.HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes{
+					StartChildWorkflowExecutionInitiatedEventAttributes: &historypb.StartChildWorkflowExecutionInitiatedEventAttributes{
+						WorkflowId:        "orphaned-child",
+						WorkflowType:      &commonpb.WorkflowType{Name: "child-type"},
+						ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+					},
+				},
+			},
+		},
+		{
+			runID: baseRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   24,
+				EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionStartedEventAttributes{
+					ChildWorkflowExecutionStartedEventAttributes: &historypb.ChildWorkflowExecutionStartedEventAttributes{
+						InitiatedEventId:  23,
+						WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "orphaned-child", RunId: orphanedChildRunID},
+						WorkflowType:      &commonpb.WorkflowType{Name: "child-type"},
+					},
+				},
+			},
+		},
+		{
+			// Child that completed before the base run closed.
+			runID: nextRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   5,
+				EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED,
+				Attributes: &historypb.HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes{
+					StartChildWorkflowExecutionInitiatedEventAttributes: &historypb.StartChildWorkflowExecutionInitiatedEventAttributes{
+						WorkflowId: "completed-child",
+					},
+				},
+			},
+		},
+		{
+			runID: nextRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   6,
+				EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionStartedEventAttributes{
+					ChildWorkflowExecutionStartedEventAttributes: &historypb.ChildWorkflowExecutionStartedEventAttributes{
+						InitiatedEventId:  5,
+						WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "completed-child", RunId: uuid.NewString()},
+					},
+				},
+			},
+		},
+		{
+			runID: nextRunID,
+			event: &historypb.HistoryEvent{
+				EventId:   7,
+				EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED,
+				Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes{
+					ChildWorkflowExecutionCompletedEventAttributes: &historypb.ChildWorkflowExecutionCompletedEventAttributes{InitiatedEventId: 5},
+				},
+			},
+		},
+	}
+	for _, e := range events {
+		recorder.record(e.runID, e.event, e.reapplied)
+	}
+
+	controller := gomock.NewController(t)
+	resetMutableState := historyi.NewMockMutableState(controller)
+	resetMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{
+		7: {ScheduledEventId: 7, ActivityId: "not-completed", ActivityType: &commonpb.ActivityType{Name: "activity-type"}},
+		5: {ScheduledEventId: 5, ActivityId: "completed", ActivityType: &commonpb.ActivityType{Name: "activity-type"}},
+	})
+	resetMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistencespb.TimerInfo{
+		"timer": {TimerId: "timer", StartedEventId: 8},
+	})
+	resetMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistencespb.ChildExecutionInfo{
+		9: {
+			InitiatedEventId:  9,
+			StartedWorkflowId: "tracked-child",
+			StartedRunId:      trackedChildRunID,
+			WorkflowTypeName:  "child-type",
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+		},
+	})
+	recorder.finish(resetMutableState)
+
+	protorequire.ProtoEqual(t, &historyspb.ResetDryRunResult{
+		ReappliedEvents: []*historyspb.ResetDryRunResult_Event{
+			{RunId: baseRunID, EventId: 21, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+		},
+		DroppedEvents: []*historyspb.ResetDryRunResult_Event{
+			{RunId: baseRunID, EventId: 20, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED},
+			{RunId: baseRunID, EventId: 22, EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED},
+			{RunId: baseRunID, EventId: 23, EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED},
+			{RunId: baseRunID, EventId: 24, EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED},
+			{RunId: nextRunID, EventId: 5, EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED},
+			{RunId: nextRunID, EventId: 6, EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED},
+			{RunId: nextRunID, EventId: 7, EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED},
+		},
+		RescheduledActivities: []*historyspb.ResetDryRunResult_Activity{
+			{ScheduledEventId: 5, ActivityId: "completed", ActivityType: "activity-type", CompletedAfterResetPoint: true},
+			{ScheduledEventId: 7, ActivityId: "not-completed", ActivityType: "activity-type"},
+		},
+		RescheduledTimers: []*historyspb.ResetDryRunResult_Timer{
+			{TimerId: "timer", StartedEventId: 8},
+		},
+		PendingChildWorkflows: []*historyspb.ResetDryRunResult_ChildWorkflow{
+			{
+				ParentRunId:       baseRunID,
+				InitiatedEventId:  9,
+				WorkflowId:        "tracked-child",
+				RunId:             trackedChildRunID,
+				WorkflowType:      "child-type",
+				ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+			},
+		},
+		OrphanedChildWorkflows: []*historyspb.ResetDryRunResult_ChildWorkflow{
+			{
+				ParentRunId:       baseRunID,
+				InitiatedEventId:  23,
+				WorkflowId:        "orphaned-child",
+				RunId:             orphanedChildRunID,
+				WorkflowType:      "child-type",
+				ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+			},
+		},
+	}, result)
+}
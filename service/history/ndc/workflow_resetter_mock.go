@@ -16,6 +16,7 @@ import (
 	enums "go.temporal.io/api/enums/v1"
 	history "go.temporal.io/api/history/v1"
 	workflow "go.temporal.io/api/workflow/v1"
+	history0 "go.temporal.io/server/api/history/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -44,6 +45,21 @@ func (m *MockWorkflowResetter) EXPECT() *MockWorkflowResetterMockRecorder {
 	return m.recorder
 }
 
+// DryRunResetWorkflow mocks base method.
+func (m *MockWorkflowResetter) DryRunResetWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, baseRunID string, baseBranchToken []byte, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID int64, resetRunID string, baseWorkflow, currentWorkflow Workflow, resetReason string, resetReapplyExcludeTypes map[enums.ResetReapplyExcludeType]struct{}, allowResetWithPendingChildren bool) (*history0.ResetDryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunResetWorkflow", ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, resetRunID, baseWorkflow, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren)
+	ret0, _ := ret[0].(*history0.ResetDryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunResetWorkflow indicates an expected call of DryRunResetWorkflow.
+func (mr *MockWorkflowResetterMockRecorder) DryRunResetWorkflow(ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, resetRunID, baseWorkflow, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunResetWorkflow", reflect.TypeOf((*MockWorkflowResetter)(nil).DryRunResetWorkflow), ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, resetRunID, baseWorkflow, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren)
+}
+
 // ResetWorkflow mocks base method.
 func (m *MockWorkflowResetter) ResetWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, baseRunID string, baseBranchToken []byte, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID int64, resetRunID string, baseWorkflow, currentWorkflow Workflow, resetReason string, additionalReapplyEvents []*history.HistoryEvent, resetReapplyExcludeTypes map[enums.ResetReapplyExcludeType]struct{}, allowResetWithPendingChildren bool, postResetOperations []*workflow.PostResetOperation) error {
 	m.ctrl.T.Helper()
@@ -807,6 +807,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_WithOutCo
 		baseNextEventID,
 		nil,
 		false, // allowResetWithPendingChildren
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(s.baseRunID, lastVisitedRunID)
@@ -937,6 +938,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_WithConti
 		baseNextEventID,
 		nil,
 		false, // allowResetWithPendingChildren
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, lastVisitedRunID)
@@ -1031,6 +1033,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_MissingCu
 		baseNextEventID,
 		nil,
 		false, // allowResetWithPendingChildren
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(runBID, lastVisitedRunID)
@@ -1085,6 +1088,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_MissingCu
 		baseNextEventID,
 		nil,
 		false, // allowResetWithPendingChildren
+		nil,
 	)
 	var unavailable *serviceerror.Unavailable
 	s.ErrorAs(err, &unavailable)
@@ -1153,6 +1157,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		nil,
 		false, // allowResetWithPendingChildren
 		map[string]*persistencespb.ResetChildInfo{},
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -1724,11 +1729,77 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_ExcludeAl
 		baseNextEventID,
 		optionExcludeAllReapplyEvents,
 		false, // allowResetWithPendingChildren
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(s.baseRunID, lastVisitedRunID)
 }
 
+func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_ExcludeAllEvents_RecordsEvents() {
+	ctx := context.Background()
+	baseFirstEventID := int64(124)
+	baseNextEventID := int64(456)
+	baseBranchToken := []byte("some random base branch token")
+	optionExcludeAllReapplyEvents := map[enumspb.ResetReapplyExcludeType]struct{}{
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL:         {},
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE:         {},
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_NEXUS:          {},
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_CANCEL_REQUEST: {},
+	}
+
+	baseEvents := []*historypb.HistoryEvent{
+		{
+			EventId:   124,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{SignalName: "some signal"},
+			},
+		},
+		{
+			EventId:   125,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+				WorkflowExecutionFailedEventAttributes: &historypb.WorkflowExecutionFailedEventAttributes{},
+			},
+		},
+	}
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{{Events: baseEvents}},
+	}, nil)
+
+	// Events must not be reapplied to the mutable state, only recorded.
+	mutableState := historyi.NewMockMutableState(s.controller)
+	currentWorkflow := NewMockWorkflow(s.controller)
+
+	type recordedEvent struct {
+		runID     string
+		eventID   int64
+		reapplied bool
+	}
+	var recorded []recordedEvent
+	_, err := s.workflowResetter.reapplyContinueAsNewWorkflowEvents(
+		ctx,
+		mutableState,
+		currentWorkflow,
+		s.namespaceID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseFirstEventID,
+		baseNextEventID,
+		optionExcludeAllReapplyEvents,
+		false, // allowResetWithPendingChildren
+		func(runID string, event *historypb.HistoryEvent, reapplied bool) {
+			recorded = append(recorded, recordedEvent{runID: runID, eventID: event.GetEventId(), reapplied: reapplied})
+		},
+	)
+	s.NoError(err)
+	s.Equal([]recordedEvent{
+		{runID: s.baseRunID, eventID: 124, reapplied: false},
+		{runID: s.baseRunID, eventID: 125, reapplied: false},
+	}, recorded)
+}
+
 func (s *workflowResetterSuite) TestPagination() {
 	firstEventID := common.FirstEventID
 	nextEventID := int64(101)
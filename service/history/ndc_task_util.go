@@ -84,7 +84,8 @@ func loadMutableStateForTransferTask(
 		// TODO: remove this logic multi-cursor is implemented and only one task processor is running in each cluster.
 		skipNotFoundLog :=
 			transferTask.GetType() == enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION ||
-				transferTask.GetType() == enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION
+				transferTask.GetType() == enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION ||
+				transferTask.GetType() == enumsspb.TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED
 
 		if !skipNotFoundLog {
 			switch err.(type) {
@@ -275,6 +275,8 @@ func (f *outboundQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		queues.WithShardID(shardContext.GetShardID()),
+		queues.WithTaskTracer(f.TaskTracer.ForShard(shardContext.GetShardID())),
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
@@ -304,6 +306,7 @@ func (f *outboundQueueFactory) CreateQueue(
 			MoveGroupTaskCountBase:              f.Config.QueueMoveGroupTaskCountBase,
 			MoveGroupTaskCountMultiplier:        f.Config.QueueMoveGroupTaskCountMultiplier,
 			ShrinkPredicateMaxPendingKeys:       f.Config.QueueShrinkPredicateMaxPendingKeys,
+			Mitigations:                         f.Mitigations,
 		},
 		f.hostReaderRateLimiter,
 		queues.GrouperStateMachineNamespaceIDAndDestination{},
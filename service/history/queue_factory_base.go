@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/quotas/calculator"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -51,6 +52,9 @@ type (
 		TracerProvider       trace.TracerProvider
 		Logger               log.SnTaggedLogger
 		SchedulerRateLimiter queues.SchedulerRateLimiter
+		SchedulerTuner       *queues.SchedulerTuner
+		TaskTracer           *queues.TaskTracer
+		Mitigations          *queues.Mitigations
 		DLQWriter            *queues.DLQWriter
 		ExecutorWrapper      queues.ExecutorWrapper `optional:"true"`
 		Serializer           serialization.Serializer
@@ -78,6 +82,9 @@ var QueueModule = fx.Options(
 	circuitbreakerpool.Module,
 	fx.Provide(
 		QueueSchedulerRateLimiterProvider,
+		queues.NewSchedulerTuner,
+		QueueTaskTracerProvider,
+		QueueMitigationsProvider,
 		func(tqm persistence.HistoryTaskQueueManager) queues.QueueWriter {
 			return tqm
 		},
@@ -139,6 +146,32 @@ func getOptionalQueueFactories(
 	}
 }
 
+func QueueTaskTracerProvider(
+	tracerProvider trace.TracerProvider,
+	namespaceRegistry namespace.Registry,
+	config *configs.Config,
+) *queues.TaskTracer {
+	return queues.NewTaskTracer(
+		tracerProvider.Tracer(telemetry.ComponentQueueTask),
+		namespaceRegistry,
+		config.QueueTaskTracingSampleRate,
+		config.QueueTaskTracingBufferSize,
+	)
+}
+
+func QueueMitigationsProvider(
+	schedulerTuner *queues.SchedulerTuner,
+	timeSource clock.TimeSource,
+	config *configs.Config,
+) *queues.Mitigations {
+	return queues.NewMitigations(
+		config.QueueMitigationPolicy,
+		config.QueueMitigationLogSize,
+		schedulerTuner,
+		timeSource,
+	)
+}
+
 func QueueSchedulerRateLimiterProvider(
 	ownershipBasedQuotaScaler shard.LazyLoadedOwnershipBasedQuotaScaler,
 	serviceResolver membership.ServiceResolver,
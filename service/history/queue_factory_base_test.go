@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyfeed"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
@@ -161,4 +162,5 @@ type unusedDependencies struct {
 	chasm.Engine
 	ChasmRegistry *chasm.Registry
 	worker_versioning.VersionMembershipAndReactivationStatusCache
+	historyfeed.Sink
 }
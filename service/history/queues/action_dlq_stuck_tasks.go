@@ -0,0 +1,81 @@
+package queues
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	ctasks "go.temporal.io/server/common/tasks"
+)
+
+var _ Action = (*actionDLQStuckTasks)(nil)
+
+const (
+	dlqStuckTasksActionName = "dlq-stuck-tasks"
+
+	defaultDLQStuckTasksMinAttempts = 100
+)
+
+type (
+	// actionDLQStuckTasks moves the tasks blocking a stuck reader to the DLQ once they have been attempted
+	// minAttempts times. The tasks are written to the DLQ on their next attempt, and are only retried if the DLQ
+	// is disabled.
+	actionDLQStuckTasks struct {
+		attributes  *AlertAttributesReaderStuck
+		minAttempts int
+		logger      log.Logger
+	}
+
+	// dlqRequester is implemented by executables that can be moved to the DLQ by a mitigation action.
+	dlqRequester interface {
+		requestDLQ()
+	}
+)
+
+func newDLQStuckTasksAction(
+	attributes *AlertAttributesReaderStuck,
+	minAttempts int,
+	logger log.Logger,
+) *actionDLQStuckTasks {
+	if minAttempts <= 0 {
+		minAttempts = defaultDLQStuckTasksMinAttempts
+	}
+	return &actionDLQStuckTasks{
+		attributes:  attributes,
+		minAttempts: minAttempts,
+		logger:      logger,
+	}
+}
+
+func (a *actionDLQStuckTasks) Name() string {
+	return dlqStuckTasksActionName
+}
+
+func (a *actionDLQStuckTasks) Run(readerGroup *ReaderGroup) bool {
+	stuckRange := readerStuckRange(a.attributes)
+
+	moved := false
+	// The stuck tasks may already have been moved to another reader by the reader-stuck action.
+	for _, reader := range readerGroup.Readers() {
+		reader.WalkSlices(func(s Slice) {
+			for _, executable := range s.PendingExecutables() {
+				if !stuckRange.ContainsKey(executable.GetKey()) ||
+					executable.State() != ctasks.TaskStatePending ||
+					executable.Attempt() < a.minAttempts {
+					continue
+				}
+				requester, ok := executable.(dlqRequester)
+				if !ok {
+					continue
+				}
+				a.logger.Warn("Moving stuck task to DLQ",
+					tag.QueueReaderID(a.attributes.ReaderID),
+					tag.TaskID(executable.GetTaskID()),
+					tag.TaskType(executable.GetType()),
+					tag.Attempt(int32(executable.Attempt())),
+				)
+				requester.requestDLQ()
+				moved = true
+			}
+		})
+	}
+	return moved
+}
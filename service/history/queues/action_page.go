@@ -0,0 +1,40 @@
+package queues
+
+import (
+	otellog "go.opentelemetry.io/otel/log"
+	"go.temporal.io/server/common/wideevents"
+)
+
+var _ Action = (*actionPage)(nil)
+
+const pageActionName = "page"
+
+type (
+	// actionPage emits a QueueMitigation wide event for the alert, which can be used to page operators.
+	actionPage struct {
+		payload     wideevents.QueueMitigationPayload
+		eventLogger otellog.Logger
+	}
+)
+
+func newPageAction(
+	payload wideevents.QueueMitigationPayload,
+	eventLogger otellog.Logger,
+) *actionPage {
+	return &actionPage{
+		payload:     payload,
+		eventLogger: eventLogger,
+	}
+}
+
+func (a *actionPage) Name() string {
+	return pageActionName
+}
+
+func (a *actionPage) Run(_ *ReaderGroup) bool {
+	if a.eventLogger == nil {
+		return false
+	}
+	wideevents.Emit(a.eventLogger, a.payload)
+	return true
+}
@@ -38,13 +38,7 @@ func (a *actionReaderStuck) Run(readerGroup *ReaderGroup) bool {
 		return false
 	}
 
-	stuckRange := NewRange(
-		a.attributes.CurrentWatermark,
-		tasks.NewKey(
-			a.attributes.CurrentWatermark.FireTime.Add(monitorWatermarkPrecision),
-			a.attributes.CurrentWatermark.TaskID,
-		),
-	)
+	stuckRange := readerStuckRange(a.attributes)
 
 	var splitSlices []Slice
 	reader.SplitSlices(func(s Slice) ([]Slice, bool) {
@@ -86,3 +80,14 @@ func (a *actionReaderStuck) Run(readerGroup *ReaderGroup) bool {
 	nextReader.MergeSlices(splitSlices...)
 	return true
 }
+
+// readerStuckRange returns the range of the tasks blocking the watermark of a stuck reader.
+func readerStuckRange(attributes *AlertAttributesReaderStuck) Range {
+	return NewRange(
+		attributes.CurrentWatermark,
+		tasks.NewKey(
+			attributes.CurrentWatermark.FireTime.Add(monitorWatermarkPrecision),
+			attributes.CurrentWatermark.TaskID,
+		),
+	)
+}
@@ -0,0 +1,98 @@
+package queues
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+var _ Action = (*actionSplitSliceByNamespace)(nil)
+
+const splitSliceByNamespaceActionName = "split-slice-by-namespace"
+
+type (
+	// actionSplitSliceByNamespace moves the tasks of the namespace with the most pending tasks in a reader to the
+	// next reader, so that the namespace stops blocking the other namespaces of the reader.
+	actionSplitSliceByNamespace struct {
+		readerID       int64
+		maxReaderCount int
+		logger         log.Logger
+	}
+)
+
+func newSplitSliceByNamespaceAction(
+	readerID int64,
+	maxReaderCount int,
+	logger log.Logger,
+) *actionSplitSliceByNamespace {
+	return &actionSplitSliceByNamespace{
+		readerID:       readerID,
+		maxReaderCount: maxReaderCount,
+		logger:         logger,
+	}
+}
+
+func (a *actionSplitSliceByNamespace) Name() string {
+	return splitSliceByNamespaceActionName
+}
+
+func (a *actionSplitSliceByNamespace) Run(readerGroup *ReaderGroup) bool {
+	if a.readerID+1 >= int64(a.maxReaderCount) {
+		return false
+	}
+	reader, ok := readerGroup.ReaderByID(a.readerID)
+	if !ok {
+		return false
+	}
+
+	namespaceID, pendingTaskCount := topPendingNamespace(reader)
+	if namespaceID == "" {
+		return false
+	}
+	a.logger.Info("Splitting namespace out of queue reader",
+		tag.QueueReaderID(a.readerID),
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.Counter(pendingTaskCount),
+	)
+
+	predicate := tasks.NewNamespacePredicate([]string{namespaceID})
+	var slicesToMove []Slice
+	reader.SplitSlices(func(s Slice) ([]Slice, bool) {
+		scope := s.Scope()
+		splitScope, _ := scope.SplitByPredicate(predicate)
+		if splitScope.IsEmpty() {
+			return nil, false
+		}
+
+		split, remain := s.SplitByPredicate(predicate)
+		slicesToMove = append(slicesToMove, split)
+		return []Slice{remain}, true
+	})
+	if len(slicesToMove) == 0 {
+		return false
+	}
+
+	readerGroup.GetOrCreateReader(a.readerID + 1).MergeSlices(slicesToMove...)
+	return true
+}
+
+// topPendingNamespace returns the namespace with the most pending tasks in the readers.
+func topPendingNamespace(readers ...Reader) (string, int) {
+	pendingPerNamespace := make(map[string]int)
+	for _, reader := range readers {
+		reader.WalkSlices(func(s Slice) {
+			for _, executable := range s.PendingExecutables() {
+				pendingPerNamespace[executable.GetNamespaceID()]++
+			}
+		})
+	}
+
+	var topNamespaceID string
+	var topCount int
+	for namespaceID, count := range pendingPerNamespace {
+		if count > topCount || (count == topCount && namespaceID < topNamespaceID) {
+			topNamespaceID, topCount = namespaceID, count
+		}
+	}
+	return topNamespaceID, topCount
+}
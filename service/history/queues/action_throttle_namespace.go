@@ -0,0 +1,92 @@
+package queues
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Action = (*actionThrottleNamespace)(nil)
+
+const (
+	throttleNamespaceActionName = "throttle-namespace"
+
+	defaultThrottleNamespaceMultiplier = 0.1
+	defaultThrottleNamespaceDuration   = 10 * time.Minute
+)
+
+type (
+	// actionThrottleNamespace reduces the weight of the namespace with the most pending tasks in the host level
+	// task schedulers for some time. Only the stuck reader is considered for ReaderStuck alerts, all readers
+	// otherwise.
+	actionThrottleNamespace struct {
+		alert          Alert
+		schedulerTuner *SchedulerTuner
+		multiplier     float64
+		duration       time.Duration
+		timeSource     clock.TimeSource
+		logger         log.Logger
+	}
+)
+
+func newThrottleNamespaceAction(
+	alert Alert,
+	schedulerTuner *SchedulerTuner,
+	multiplier float64,
+	duration time.Duration,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *actionThrottleNamespace {
+	if multiplier <= 0 {
+		multiplier = defaultThrottleNamespaceMultiplier
+	}
+	if duration <= 0 {
+		duration = defaultThrottleNamespaceDuration
+	}
+	return &actionThrottleNamespace{
+		alert:          alert,
+		schedulerTuner: schedulerTuner,
+		multiplier:     multiplier,
+		duration:       duration,
+		timeSource:     timeSource,
+		logger:         logger,
+	}
+}
+
+func (a *actionThrottleNamespace) Name() string {
+	return throttleNamespaceActionName
+}
+
+func (a *actionThrottleNamespace) Run(readerGroup *ReaderGroup) bool {
+	if a.schedulerTuner == nil {
+		return false
+	}
+
+	var readers []Reader
+	if attributes := a.alert.AlertAttributesReaderStuck; attributes != nil {
+		reader, ok := readerGroup.ReaderByID(attributes.ReaderID)
+		if !ok {
+			return false
+		}
+		readers = append(readers, reader)
+	} else {
+		for _, reader := range readerGroup.Readers() {
+			readers = append(readers, reader)
+		}
+	}
+
+	namespaceID, pendingTaskCount := topPendingNamespace(readers...)
+	if namespaceID == "" {
+		return false
+	}
+	a.logger.Warn("Throttling namespace in task schedulers",
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.Counter(pendingTaskCount),
+		tag.Value(a.multiplier),
+		tag.NewDurationTag("duration", a.duration),
+	)
+	a.schedulerTuner.SetNamespaceWeightOverride(namespaceID, a.multiplier, a.timeSource.Now().Add(a.duration))
+	return true
+}
@@ -1,6 +1,9 @@
 package queues
 
 import (
+	"fmt"
+	"time"
+
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -37,3 +40,36 @@ const (
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
 )
+
+func (t AlertType) String() string {
+	switch t {
+	case AlertTypeQueuePendingTaskCount:
+		return "QueuePendingTaskCount"
+	case AlertTypeReaderStuck:
+		return "ReaderStuck"
+	case AlertTypeSliceCount:
+		return "SliceCount"
+	default:
+		return "Unspecified"
+	}
+}
+
+// Details returns the attributes of the alert as a readable description.
+func (a *Alert) Details() string {
+	switch a.AlertType {
+	case AlertTypeQueuePendingTaskCount:
+		if attr := a.AlertAttributesQueuePendingTaskCount; attr != nil {
+			return fmt.Sprintf("%v pending tasks, critical count %v", attr.CurrentPendingTaskCount, attr.CiriticalPendingTaskCount)
+		}
+	case AlertTypeReaderStuck:
+		if attr := a.AlertAttributesReaderStuck; attr != nil {
+			return fmt.Sprintf("reader %v stuck at %v/%v", attr.ReaderID,
+				attr.CurrentWatermark.FireTime.UTC().Format(time.RFC3339Nano), attr.CurrentWatermark.TaskID)
+		}
+	case AlertTypeSliceCount:
+		if attr := a.AlertAttributesSliceCount; attr != nil {
+			return fmt.Sprintf("%v slices, critical count %v", attr.CurrentSliceCount, attr.CriticalSliceCount)
+		}
+	}
+	return ""
+}
@@ -60,12 +60,14 @@ func NewDLQWriter(
 	}
 }
 
-// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist.
+// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist. The failure, if
+// any, is recorded with the task so that DLQ tasks can be filtered by error.
 func (q *DLQWriter) WriteTaskToDLQ(
 	ctx context.Context,
 	sourceCluster, targetCluster string,
 	sourceShardID int,
 	task tasks.Task,
+	failure error,
 	isNamespaceActive bool,
 ) error {
 	queueKey := persistence.QueueKey{
@@ -96,6 +98,7 @@ func (q *DLQWriter) WriteTaskToDLQ(
 			TargetCluster: queueKey.TargetCluster,
 			Task:          task,
 			SourceShardID: sourceShardID,
+			Failure:       failureMessage(failure),
 		})
 	}()
 	if err != nil {
@@ -153,3 +156,10 @@ func (q *DLQWriter) getQueueMutex(queueKey persistence.QueueKey) *sync.Mutex {
 	actual, _ := q.enqueueMutex.LoadOrStore(queueKey, newMutex)
 	return actual.(*sync.Mutex) //nolint:revive
 }
+
+func failureMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -69,6 +69,7 @@ func TestDLQWriter_ErrGetNamespaceName(t *testing.T) {
 		"target-cluster",
 		tasks.GetShardIDForTask(task, 100),
 		task,
+		nil,
 		true,
 	)
 	require.NoError(t, err)
@@ -121,6 +122,7 @@ func TestDLQWriter_Ok(t *testing.T) {
 		"target-cluster",
 		tasks.GetShardIDForTask(task, 100),
 		task,
+		errors.New("some failure"),
 		true,
 	)
 	require.NoError(t, err)
@@ -128,6 +130,7 @@ func TestDLQWriter_Ok(t *testing.T) {
 	request := queueWriter.EnqueueTaskRequests[0]
 	expectedShardID := tasks.GetShardIDForTask(task, 100)
 	assert.Equal(t, expectedShardID, request.SourceShardID)
+	assert.Equal(t, "some failure", request.Failure)
 	assert.NotEmpty(t, logger.records)
 	assert.Contains(t, logger.records[0].msg, "Task enqueued to DLQ")
 	assert.Contains(t, logger.records[0].tags, tag.DLQMessageID(0))
@@ -211,6 +214,7 @@ func TestDLQWriter_ConcurrentWrites(t *testing.T) {
 				"target-cluster",
 				1, // same shard ID
 				task,
+				nil,
 				true,
 			)
 			require.NoError(t, err)
@@ -284,6 +288,7 @@ func TestDLQWriter_ConcurrentWritesDifferentQueues(t *testing.T) {
 				targetCluster,
 				1,
 				task,
+				nil,
 				true,
 			)
 			require.NoError(t, err)
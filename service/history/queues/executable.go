@@ -79,6 +79,7 @@ type (
 
 var (
 	ErrTerminalTaskFailure = errors.New("original task failed and this task is now to send the original to the DLQ")
+	errTaskMovedToDLQ      = errors.New("task was moved to the DLQ by a queue mitigation action")
 
 	// reschedulePolicy is the policy for determine reschedule backoff duration
 	// across multiple submissions to scheduler
@@ -125,7 +126,9 @@ type (
 		defaultMetricsHandler metrics.Handler
 		chasmMetricsHandler   metrics.Handler // contains archetype tag
 		tracer                trace.Tracer
+		taskTracer            *ShardTaskTracer // nil unless the task is sampled
 		dlqWriter             *DLQWriter
+		shardID               int32
 
 		readerID                   int64
 		attempt                    atomic.Int64
@@ -139,6 +142,7 @@ type (
 		resourceExhaustedCount     int // does NOT include consts.ErrResourceExhaustedBusyWorkflow
 		dlqEnabled                 dynamicconfig.BoolPropertyFn
 		terminalFailureCause       error
+		dlqRequested               atomic.Bool // set by queue mitigations, examined by Execute() on the next attempt
 		unexpectedErrorAttempts    int
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
@@ -150,6 +154,8 @@ type (
 		MaxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		DLQInternalErrors          dynamicconfig.BoolPropertyFn
 		DLQErrorPattern            dynamicconfig.StringPropertyFn
+		TaskTracer                 *ShardTaskTracer
+		ShardID                    int32
 	}
 	ExecutableOption func(*ExecutableParams)
 
@@ -235,11 +241,15 @@ func NewExecutable(
 		baseMetricsHandler:         metricsHandler,
 		tracer:                     tracer,
 		dlqWriter:                  params.DLQWriter,
+		shardID:                    params.ShardID,
 		dlqEnabled:                 params.DLQEnabled,
 		maxUnexpectedErrorAttempts: params.MaxUnexpectedErrorAttempts,
 		dlqInternalErrors:          params.DLQInternalErrors,
 		dlqErrorPattern:            params.DLQErrorPattern,
 	}
+	if params.TaskTracer.Sampled(task) {
+		e.taskTracer = params.TaskTracer
+	}
 	e.refreshMetricsHandlers(nil)
 	e.attempt.Store(1)
 	e.priority = priorityAssigner.Assign(e)
@@ -279,6 +289,21 @@ func (e *executableImpl) Execute() (retErr error) {
 	)
 	e.Unlock()
 
+	if e.taskTracer != nil {
+		// Report the execution span as part of the lifecycle trace of the task.
+		ctx = e.taskTracer.ContextWithTrace(ctx, e.GetTask())
+		attempt := e.Attempt()
+		defer func() {
+			e.taskTracer.record(e.GetTask(), TaskTraceEvent{
+				Stage:    TaskTraceStageAttempted,
+				Time:     startTime,
+				Attempt:  attempt,
+				ReaderID: e.readerID,
+				Reason:   errorReason(retErr),
+			})
+		}()
+	}
+
 	// Wrapped in if block to avoid unnecessary allocations when OTEL is disabled.
 	if telemetry.IsEnabled(e.tracer) {
 		var span trace.Span
@@ -364,6 +389,11 @@ func (e *executableImpl) Execute() (retErr error) {
 		// Not doing it here as for certain errors latency for the attempt should not be counted
 	}()
 
+	if e.dlqRequested.Swap(false) && e.terminalFailureCause == nil {
+		e.logger.Warn("Queue mitigation marked task as terminally failed, will send to DLQ", tag.Attempt(int32(e.Attempt())))
+		e.terminalFailureCause = errTaskMovedToDLQ
+	}
+
 	// A previous attempt has marked this executable as no longer retryable.
 	// Instead of executing it, we try to write to the DLQ if enabled, otherwise - drop it.
 	if e.terminalFailureCause != nil {
@@ -402,15 +432,22 @@ func (e *executableImpl) Execute() (retErr error) {
 func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 
 	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
-	numShards := e.clusterMetadata.GetAllClusterInfo()[currentClusterName].ShardCount
+	shardID := int(e.shardID)
+	if shardID == 0 {
+		// The task was not created by a shard queue, so the shard can only be derived from the task, which ignores
+		// shard pool assignments.
+		numShards := e.clusterMetadata.GetAllClusterInfo()[currentClusterName].ShardCount
+		shardID = tasks.GetShardIDForTask(e.Task, int(numShards))
+	}
 
 	start := e.timeSource.Now()
 	err := e.dlqWriter.WriteTaskToDLQ(
 		ctx,
 		currentClusterName,
 		currentClusterName,
-		tasks.GetShardIDForTask(e.Task, int(numShards)),
+		shardID,
 		e.GetTask(),
+		e.terminalFailureCause,
 		e.lastActiveness,
 	)
 	if err != nil {
@@ -667,6 +704,7 @@ func (e *executableImpl) Abort() {
 
 	if e.state == ctasks.TaskStatePending {
 		e.state = ctasks.TaskStateAborted
+		e.traceStage(TaskTraceStageAborted, "")
 	}
 }
 
@@ -676,6 +714,7 @@ func (e *executableImpl) Cancel() {
 
 	if e.state == ctasks.TaskStatePending {
 		e.state = ctasks.TaskStateCancelled
+		e.traceStage(TaskTraceStageCancelled, "")
 	}
 }
 
@@ -688,6 +727,7 @@ func (e *executableImpl) Ack() {
 	}
 
 	e.state = ctasks.TaskStateAcked
+	e.traceStage(TaskTraceStageAcked, "")
 
 	if e.invalidTask {
 		// do not emit metrics for invalid tasks
@@ -736,6 +776,7 @@ func (e *executableImpl) Nack(err error) {
 			e.inMemoryNoUserLatency += backoffDuration
 		}
 
+		e.traceStage(TaskTraceStageRescheduled, fmt.Sprintf("retry in %v after error: %v", backoffDuration, errorReason(err)))
 		e.rescheduler.Add(e, e.timeSource.Now().Add(backoffDuration))
 	}
 }
@@ -746,7 +787,9 @@ func (e *executableImpl) Reschedule() {
 		return
 	}
 
-	e.rescheduler.Add(e, e.timeSource.Now().Add(e.backoffDuration(nil)))
+	backoffDuration := e.backoffDuration(nil)
+	e.traceStage(TaskTraceStageRescheduled, fmt.Sprintf("retry in %v after task scheduler rejected the task", backoffDuration))
+	e.rescheduler.Add(e, e.timeSource.Now().Add(backoffDuration))
 }
 
 func (e *executableImpl) State() ctasks.State {
@@ -774,6 +817,7 @@ func (e *executableImpl) GetScheduledTime() time.Time {
 
 func (e *executableImpl) SetScheduledTime(t time.Time) {
 	e.scheduledTime = t
+	e.traceStage(TaskTraceStageScheduled, "")
 }
 
 // GetDestination returns the embedded task's destination if it exists. Defaults to an empty string.
@@ -850,6 +894,43 @@ func (e *executableImpl) backoffDuration(
 	return backoffDuration
 }
 
+func (e *executableImpl) requestDLQ() {
+	e.dlqRequested.Store(true)
+}
+
+func (e *executableImpl) traceLoaded(sliceRange Range) {
+	if e.taskTracer == nil {
+		return
+	}
+	e.taskTracer.record(e.GetTask(), TaskTraceEvent{
+		Stage:      TaskTraceStageLoaded,
+		Time:       e.timeSource.Now(),
+		Attempt:    e.Attempt(),
+		ReaderID:   e.readerID,
+		SliceRange: formatTaskTraceRange(sliceRange),
+	})
+}
+
+func (e *executableImpl) traceStage(stage TaskTraceStage, reason string) {
+	if e.taskTracer == nil {
+		return
+	}
+	e.taskTracer.record(e.GetTask(), TaskTraceEvent{
+		Stage:    stage,
+		Time:     e.timeSource.Now(),
+		Attempt:  e.Attempt(),
+		ReaderID: e.readerID,
+		Reason:   reason,
+	})
+}
+
+func errorReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
 func (e *executableImpl) incAttempt() {
 	attempt := e.attempt.Add(1)
 
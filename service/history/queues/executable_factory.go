@@ -38,6 +38,7 @@ type (
 		attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		opts                       []ExecutableOption
 	}
 )
 
@@ -63,6 +64,7 @@ func NewExecutableFactory(
 	attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn,
 	dlqInternalErrors dynamicconfig.BoolPropertyFn,
 	dlqErrorPattern dynamicconfig.StringPropertyFn,
+	opts ...ExecutableOption,
 ) *executableFactoryImpl {
 	return &executableFactoryImpl{
 		executor:                   executor,
@@ -82,10 +84,20 @@ func NewExecutableFactory(
 		attemptsBeforeSendingToDlq: attemptsBeforeSendingToDlq,
 		dlqInternalErrors:          dlqInternalErrors,
 		dlqErrorPattern:            dlqErrorPattern,
+		opts:                       opts,
 	}
 }
 
 func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) Executable {
+	opts := append([]ExecutableOption{
+		func(params *ExecutableParams) {
+			params.DLQEnabled = f.dlqEnabled
+			params.DLQWriter = f.dlqWriter
+			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
+			params.DLQInternalErrors = f.dlqInternalErrors
+			params.DLQErrorPattern = f.dlqErrorPattern
+		},
+	}, f.opts...)
 	return NewExecutable(
 		readerID,
 		task,
@@ -101,12 +113,21 @@ func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) E
 		f.logger,
 		f.metricsHandler,
 		f.tracer,
-		func(params *ExecutableParams) {
-			params.DLQEnabled = f.dlqEnabled
-			params.DLQWriter = f.dlqWriter
-			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
-			params.DLQInternalErrors = f.dlqInternalErrors
-			params.DLQErrorPattern = f.dlqErrorPattern
-		},
+		opts...,
 	)
 }
+
+// WithShardID sets the shard the tasks of the executables are read from, which is recorded as their source shard when
+// they are sent to the DLQ.
+func WithShardID(shardID int32) ExecutableOption {
+	return func(params *ExecutableParams) {
+		params.ShardID = shardID
+	}
+}
+
+// WithTaskTracer records the lifecycle of the sampled tasks of the executables.
+func WithTaskTracer(taskTracer *ShardTaskTracer) ExecutableOption {
+	return func(params *ExecutableParams) {
+		params.TaskTracer = taskTracer
+	}
+}
@@ -57,6 +57,7 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		shardID                    int32
 	}
 	option func(*params)
 )
@@ -592,6 +593,31 @@ func (s *executableSuite) TestExecute_SendToDLQAfterMaxAttempts() {
 	s.Len(queueWriter.EnqueueTaskRequests, 1)
 }
 
+func (s *executableSuite) TestExecute_SendToDLQFromReadingShard() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry, s.chasmRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.maxUnexpectedErrorAttempts = func() int {
+			return 1
+		}
+		// The namespace may be assigned to a shard pool, so the shard reading the task is recorded instead of the
+		// shard derived from the task.
+		p.shardID = 7
+	})
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionErr: errors.New("some random error"),
+	})
+
+	err := executable.Execute()
+	s.ErrorIs(executable.HandleErr(err), queues.ErrTerminalTaskFailure)
+	s.NoError(executable.Execute())
+	s.Len(queueWriter.EnqueueTaskRequests, 1)
+	s.Equal(7, queueWriter.EnqueueTaskRequests[0].SourceShardID)
+}
+
 func (s *executableSuite) TestExecute_DontSendToDLQAfterMaxAttemptsDLQDisabled() {
 	queueWriter := &queuestest.FakeQueueWriter{}
 	executable := s.newTestExecutable(func(p *params) {
@@ -1314,6 +1340,7 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 			params.MaxUnexpectedErrorAttempts = p.maxUnexpectedErrorAttempts
 			params.DLQInternalErrors = p.dlqInternalErrors
 			params.DLQErrorPattern = p.dlqErrorPattern
+			params.ShardID = p.shardID
 		},
 	)
 }
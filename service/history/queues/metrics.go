@@ -46,6 +46,8 @@ func GetActiveTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferActiveTaskStartChildExecution
 	case *tasks.ResetWorkflowTask:
 		return metrics.TaskTypeTransferActiveTaskResetWorkflow
+	case *tasks.PublishHistoryEventFeedTask:
+		return metrics.TaskTypeTransferActiveTaskPublishHistoryEventFeed
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferActiveTaskDeleteExecution
 	case *tasks.ChasmTask:
@@ -75,6 +77,8 @@ func GetStandbyTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferStandbyTaskStartChildExecution
 	case *tasks.ResetWorkflowTask:
 		return metrics.TaskTypeTransferStandbyTaskResetWorkflow
+	case *tasks.PublishHistoryEventFeedTask:
+		return metrics.TaskTypeTransferStandbyTaskPublishHistoryEventFeed
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferStandbyTaskDeleteExecution
 	case *tasks.ChasmTask:
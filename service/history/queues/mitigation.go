@@ -0,0 +1,256 @@
+package queues
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/wideevents"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// Mitigations holds the actions that can be run by the Mitigators of the queues of a history host, the policy
+	// selecting the actions run for each alert type, and a log of the applied mitigations. When the policy has no
+	// entry for an alert type, the default action of the alert type is run.
+	Mitigations struct {
+		policy         dynamicconfig.TypedPropertyFn[dynamicconfig.QueueMitigationPolicySettings]
+		logSize        dynamicconfig.IntPropertyFn
+		schedulerTuner *SchedulerTuner
+		timeSource     clock.TimeSource
+
+		sync.Mutex
+		factories map[string]MitigationActionFactory
+		records   []MitigationRecord // oldest first
+	}
+
+	// QueueMitigations selects and records the mitigations of a queue. A nil QueueMitigations only runs the
+	// default actions.
+	QueueMitigations struct {
+		*Mitigations
+		shardID     int32
+		category    tasks.Category
+		eventLogger otellog.Logger
+	}
+
+	// MitigationActionFactory creates the Action run for an alert. It returns an error if the action can't
+	// mitigate the alert.
+	MitigationActionFactory func(MitigationActionParams) (Action, error)
+
+	MitigationActionParams struct {
+		Alert          Alert
+		Settings       dynamicconfig.QueueMitigationActionSettings
+		ShardID        int32
+		Category       tasks.Category
+		Monitor        Monitor
+		Grouper        Grouper
+		MaxReaderCount int
+		SchedulerTuner *SchedulerTuner
+		TimeSource     clock.TimeSource
+		Logger         log.Logger
+		EventLogger    otellog.Logger
+		// PreviousActions are the names of the actions already run for the alert.
+		PreviousActions []string
+	}
+
+	// MitigationRecord is an entry of the log of applied mitigations.
+	MitigationRecord struct {
+		Time         time.Time
+		ShardID      int32
+		Category     tasks.Category
+		AlertType    AlertType
+		AlertDetails string
+		Action       string
+		ActionTaken  bool
+		Error        string
+	}
+)
+
+func NewMitigations(
+	policy dynamicconfig.TypedPropertyFn[dynamicconfig.QueueMitigationPolicySettings],
+	logSize dynamicconfig.IntPropertyFn,
+	schedulerTuner *SchedulerTuner,
+	timeSource clock.TimeSource,
+) *Mitigations {
+	return &Mitigations{
+		policy:         policy,
+		logSize:        logSize,
+		schedulerTuner: schedulerTuner,
+		timeSource:     timeSource,
+		factories:      maps.Clone(builtinMitigationActions),
+	}
+}
+
+// Register adds an action that can be referenced by name in the mitigation policy.
+func (m *Mitigations) Register(name string, factory MitigationActionFactory) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.factories[name]; ok {
+		return fmt.Errorf("queue mitigation action %q is already registered", name)
+	}
+	m.factories[name] = factory
+	return nil
+}
+
+// ListMitigations returns the applied mitigations of a shard, oldest first.
+func (m *Mitigations) ListMitigations(shardID int32) []MitigationRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	var records []MitigationRecord
+	for _, record := range m.records {
+		if record.ShardID == shardID {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// ForQueue returns the mitigations of the queue of a shard.
+func (m *Mitigations) ForQueue(
+	shardID int32,
+	category tasks.Category,
+	eventLogger otellog.Logger,
+) *QueueMitigations {
+	if m == nil {
+		return nil
+	}
+	return &QueueMitigations{
+		Mitigations: m,
+		shardID:     shardID,
+		category:    category,
+		eventLogger: eventLogger,
+	}
+}
+
+// actions returns the settings of the actions configured for the alert type, or nil if the default action
+// should be run.
+func (m *QueueMitigations) actions(alertType AlertType) []dynamicconfig.QueueMitigationActionSettings {
+	if m == nil {
+		return nil
+	}
+	for name, actions := range m.policy() {
+		if strings.EqualFold(name, alertType.String()) {
+			return actions
+		}
+	}
+	return nil
+}
+
+func (m *QueueMitigations) newAction(name string, params MitigationActionParams) (Action, error) {
+	m.Lock()
+	factory, ok := m.factories[name]
+	m.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown queue mitigation action %q", name)
+	}
+	params.ShardID = m.shardID
+	params.Category = m.category
+	params.SchedulerTuner = m.schedulerTuner
+	params.TimeSource = m.timeSource
+	params.EventLogger = m.eventLogger
+	return factory(params)
+}
+
+func (m *QueueMitigations) record(alert Alert, action string, actionTaken bool, err error) {
+	if m == nil {
+		return
+	}
+	record := MitigationRecord{
+		Time:         m.timeSource.Now(),
+		ShardID:      m.shardID,
+		Category:     m.category,
+		AlertType:    alert.AlertType,
+		AlertDetails: alert.Details(),
+		Action:       action,
+		ActionTaken:  actionTaken,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.records = append(m.records, record)
+	if overflow := len(m.records) - max(m.logSize(), 1); overflow > 0 {
+		m.records = m.records[overflow:]
+	}
+}
+
+var builtinMitigationActions = map[string]MitigationActionFactory{
+	"queue-pending-task": func(params MitigationActionParams) (Action, error) {
+		if params.Alert.AlertAttributesQueuePendingTaskCount == nil {
+			return nil, errMitigationAlertMismatch("queue-pending-task", params.Alert)
+		}
+		return newQueuePendingTaskAction(
+			params.Alert.AlertAttributesQueuePendingTaskCount,
+			params.Monitor,
+			params.MaxReaderCount,
+			params.Grouper,
+		), nil
+	},
+	readerStuckActionName: func(params MitigationActionParams) (Action, error) {
+		if params.Alert.AlertAttributesReaderStuck == nil {
+			return nil, errMitigationAlertMismatch(readerStuckActionName, params.Alert)
+		}
+		return newReaderStuckAction(params.Alert.AlertAttributesReaderStuck, params.Logger), nil
+	},
+	"slice-count": func(params MitigationActionParams) (Action, error) {
+		if params.Alert.AlertAttributesSliceCount == nil {
+			return nil, errMitigationAlertMismatch("slice-count", params.Alert)
+		}
+		return newSliceCountAction(params.Alert.AlertAttributesSliceCount, params.Monitor), nil
+	},
+	dlqStuckTasksActionName: func(params MitigationActionParams) (Action, error) {
+		if params.Alert.AlertAttributesReaderStuck == nil {
+			return nil, errMitigationAlertMismatch(dlqStuckTasksActionName, params.Alert)
+		}
+		return newDLQStuckTasksAction(
+			params.Alert.AlertAttributesReaderStuck,
+			params.Settings.MinAttempts,
+			params.Logger,
+		), nil
+	},
+	splitSliceByNamespaceActionName: func(params MitigationActionParams) (Action, error) {
+		readerID := DefaultReaderId
+		if params.Alert.AlertAttributesReaderStuck != nil {
+			readerID = params.Alert.AlertAttributesReaderStuck.ReaderID
+		}
+		return newSplitSliceByNamespaceAction(readerID, params.MaxReaderCount, params.Logger), nil
+	},
+	throttleNamespaceActionName: func(params MitigationActionParams) (Action, error) {
+		return newThrottleNamespaceAction(
+			params.Alert,
+			params.SchedulerTuner,
+			params.Settings.Multiplier,
+			params.Settings.Duration,
+			params.TimeSource,
+			params.Logger,
+		), nil
+	},
+	pageActionName: func(params MitigationActionParams) (Action, error) {
+		return newPageAction(
+			wideevents.QueueMitigationPayload{
+				Shard:    params.ShardID,
+				Category: params.Category.Name(),
+				Alert:    params.Alert.AlertType.String(),
+				Details:  params.Alert.Details(),
+				Actions:  params.PreviousActions,
+			},
+			params.EventLogger,
+		), nil
+	},
+}
+
+func errMitigationAlertMismatch(action string, alert Alert) error {
+	return fmt.Errorf("queue mitigation action %q can't mitigate %v alerts", action, alert.AlertType)
+}
@@ -0,0 +1,349 @@
+package queues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/predicates"
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/common/wideevents"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	testDLQExecutable struct {
+		*MockExecutable
+
+		dlqRequested bool
+	}
+
+	testAction struct{}
+
+	testEventLogger struct {
+		embedded.Logger
+
+		records []otellog.Record
+	}
+)
+
+func (e *testDLQExecutable) requestDLQ() {
+	e.dlqRequested = true
+}
+
+func (a *testAction) Name() string {
+	return "test"
+}
+
+func (a *testAction) Run(*ReaderGroup) bool {
+	return true
+}
+
+func (l *testEventLogger) Emit(_ context.Context, record otellog.Record) {
+	l.records = append(l.records, record)
+}
+
+func (l *testEventLogger) Enabled(context.Context, otellog.EnabledParameters) bool {
+	return true
+}
+
+func newTestMitigationReaderGroup(
+	t *testing.T,
+	executables map[int64][]Executable, // readerID -> executables
+) *ReaderGroup {
+	monitor := newMonitor(tasks.CategoryTypeScheduled, clock.NewRealTimeSource(), log.NewTestLogger(), metrics.NoopMetricsHandler, &MonitorOptions{
+		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
+		ReaderStuckShadowMode:       dynamicconfig.GetBoolPropertyFn(false),
+		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+	})
+	readerGroup := NewReaderGroup(func(readerID int64, slices []Slice) Reader {
+		return NewReader(
+			readerID,
+			slices,
+			&ReaderOptions{
+				BatchSize:            dynamicconfig.GetIntPropertyFn(10),
+				MaxPendingTasksCount: dynamicconfig.GetIntPropertyFn(100),
+				PollBackoffInterval:  dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
+				MaxPredicateSize:     dynamicconfig.GetIntPropertyFn(10),
+			},
+			nil,
+			nil,
+			clock.NewRealTimeSource(),
+			NewReaderPriorityRateLimiter(func() float64 { return 20 }, 1),
+			monitor,
+			NoopReaderCompletionFn,
+			log.NewTestLogger(),
+			metrics.NoopMetricsHandler,
+		)
+	})
+	for readerID, readerExecutables := range executables {
+		slice := NewSlice(
+			nil,
+			nil,
+			monitor,
+			NewScope(
+				NewRange(tasks.NewKey(time.Unix(0, 0), 1), tasks.NewKey(time.Unix(100, 0), 1)),
+				predicates.Universal[tasks.Task](),
+			),
+			GrouperNamespaceID{},
+			noPredicateSizeLimit,
+			defaultMaxPendingKeys,
+			metrics.NoopMetricsHandler,
+		)
+		slice.iterators = nil
+		for _, executable := range readerExecutables {
+			slice.add(executable)
+		}
+		readerGroup.NewReader(readerID, slice)
+	}
+	t.Cleanup(func() {
+		readerGroup.ForEach(func(_ int64, reader Reader) {
+			readerImpl := reader.(*ReaderImpl)
+			readerImpl.Lock()
+			defer readerImpl.Unlock()
+			if readerImpl.throttleTimer != nil {
+				readerImpl.throttleTimer.Stop()
+				readerImpl.throttleTimer = nil
+			}
+		})
+	})
+	return readerGroup
+}
+
+func newTestMitigationExecutable(
+	controller *gomock.Controller,
+	namespaceID string,
+	key tasks.Key,
+	attempt int,
+) *testDLQExecutable {
+	executable := NewMockExecutable(controller)
+	executable.EXPECT().GetKey().Return(key).AnyTimes()
+	executable.EXPECT().GetTaskID().Return(key.TaskID).AnyTimes()
+	executable.EXPECT().GetType().Return(enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK).AnyTimes()
+	executable.EXPECT().GetNamespaceID().Return(namespaceID).AnyTimes()
+	executable.EXPECT().State().Return(ctasks.TaskStatePending).AnyTimes()
+	executable.EXPECT().Attempt().Return(attempt).AnyTimes()
+	return &testDLQExecutable{MockExecutable: executable}
+}
+
+func TestMitigations_Register(t *testing.T) {
+	mitigations := NewMitigations(
+		func() dynamicconfig.QueueMitigationPolicySettings { return nil },
+		dynamicconfig.GetIntPropertyFn(10),
+		nil,
+		clock.NewRealTimeSource(),
+	)
+	require.Error(t, mitigations.Register(pageActionName, nil))
+
+	custom := &testAction{}
+	require.NoError(t, mitigations.Register("custom", func(MitigationActionParams) (Action, error) {
+		return custom, nil
+	}))
+	require.Error(t, mitigations.Register("custom", nil))
+
+	action, err := mitigations.ForQueue(1, tasks.CategoryTransfer, nil).newAction("custom", MitigationActionParams{})
+	require.NoError(t, err)
+	require.Equal(t, custom, action)
+
+	var nilMitigations *Mitigations
+	require.Nil(t, nilMitigations.ForQueue(1, tasks.CategoryTransfer, nil).actions(AlertTypeReaderStuck))
+}
+
+func TestDLQStuckTasksAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	watermark := tasks.NewKey(time.Unix(10, 0), 5)
+
+	stuck := newTestMitigationExecutable(controller, "ns", watermark, 20)
+	notEnoughAttempts := newTestMitigationExecutable(controller, "ns", tasks.NewKey(time.Unix(10, 0), 6), 2)
+	notStuck := newTestMitigationExecutable(controller, "ns", tasks.NewKey(time.Unix(20, 0), 1), 20)
+	readerGroup := newTestMitigationReaderGroup(t, map[int64][]Executable{
+		DefaultReaderId: {stuck, notEnoughAttempts},
+		1:               {notStuck},
+	})
+
+	action := newDLQStuckTasksAction(
+		&AlertAttributesReaderStuck{ReaderID: DefaultReaderId, CurrentWatermark: watermark},
+		10,
+		log.NewTestLogger(),
+	)
+	require.True(t, action.Run(readerGroup))
+	require.True(t, stuck.dlqRequested)
+	require.False(t, notEnoughAttempts.dlqRequested)
+	require.False(t, notStuck.dlqRequested)
+
+	stuck.dlqRequested = false
+	require.False(t, newDLQStuckTasksAction(
+		&AlertAttributesReaderStuck{ReaderID: DefaultReaderId, CurrentWatermark: watermark},
+		0, // default
+		log.NewTestLogger(),
+	).Run(readerGroup))
+}
+
+func TestDLQStuckTasksAction_Executable(t *testing.T) {
+	controller := gomock.NewController(t)
+	executor := NewMockExecutor(controller)
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	clusterMetadata := cluster.NewMockMetadata(controller)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		cluster.TestCurrentClusterName: {
+			ShardCount: 1,
+		},
+	}).AnyTimes()
+	queueWriter := persistence.NewMockHistoryTaskQueueManager(controller)
+	chasmRegistry := chasm.NewRegistry(log.NewTestLogger())
+
+	executable := NewExecutable(
+		DefaultReaderId,
+		newTracedTestTask(123),
+		executor,
+		NewMockScheduler(controller),
+		NewMockRescheduler(controller),
+		NewNoopPriorityAssigner(),
+		clock.NewRealTimeSource(),
+		namespaceRegistry,
+		clusterMetadata,
+		chasmRegistry,
+		GetTaskTypeTagValue,
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		telemetry.NoopTracer,
+		func(params *ExecutableParams) {
+			params.DLQEnabled = dynamicconfig.GetBoolPropertyFn(true)
+			params.DLQWriter = NewDLQWriter(queueWriter, metrics.NoopMetricsHandler, log.NewTestLogger(), namespaceRegistry, chasmRegistry)
+		},
+	)
+
+	executor.EXPECT().Execute(gomock.Any(), executable).Return(ExecuteResponse{ExecutionErr: consts.ErrTaskRetry})
+	require.Error(t, executable.HandleErr(executable.Execute()))
+
+	// The task is written to the DLQ instead of being executed on the next attempt.
+	executable.(dlqRequester).requestDLQ()
+	queueWriter.EXPECT().CreateQueue(gomock.Any(), gomock.Any()).Return(&persistence.CreateQueueResponse{}, nil).AnyTimes()
+	queueWriter.EXPECT().EnqueueTask(gomock.Any(), gomock.Any()).Return(&persistence.EnqueueTaskResponse{}, nil)
+	require.NoError(t, executable.Execute())
+}
+
+func TestSplitSliceByNamespaceAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	var executables []Executable
+	for i := range 5 {
+		executables = append(executables, newTestMitigationExecutable(controller, "busy", tasks.NewKey(time.Unix(10, 0), int64(i+1)), 1))
+	}
+	for i := range 2 {
+		executables = append(executables, newTestMitigationExecutable(controller, "other", tasks.NewKey(time.Unix(10, 0), int64(i+10)), 1))
+	}
+	readerGroup := newTestMitigationReaderGroup(t, map[int64][]Executable{DefaultReaderId: executables})
+
+	require.False(t, newSplitSliceByNamespaceAction(DefaultReaderId, 1, log.NewTestLogger()).Run(readerGroup))
+	require.True(t, newSplitSliceByNamespaceAction(DefaultReaderId, 2, log.NewTestLogger()).Run(readerGroup))
+
+	pendingPerReader := make(map[int64]map[string]int)
+	for readerID, reader := range readerGroup.Readers() {
+		pendingPerReader[readerID] = make(map[string]int)
+		reader.WalkSlices(func(s Slice) {
+			for _, executable := range s.PendingExecutables() {
+				pendingPerReader[readerID][executable.GetNamespaceID()]++
+			}
+		})
+	}
+	require.Equal(t, map[int64]map[string]int{
+		DefaultReaderId: {"other": 2},
+		1:               {"busy": 5},
+	}, pendingPerReader)
+}
+
+func TestThrottleNamespaceAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	readerGroup := newTestMitigationReaderGroup(t, map[int64][]Executable{
+		DefaultReaderId: {
+			newTestMitigationExecutable(controller, "stuck", tasks.NewKey(time.Unix(10, 0), 1), 1),
+		},
+		1: {
+			newTestMitigationExecutable(controller, "busy", tasks.NewKey(time.Unix(10, 0), 2), 1),
+			newTestMitigationExecutable(controller, "busy", tasks.NewKey(time.Unix(10, 0), 3), 1),
+		},
+	})
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	schedulerTuner := NewSchedulerTuner(timeSource)
+
+	require.True(t, newThrottleNamespaceAction(
+		Alert{AlertType: AlertTypeQueuePendingTaskCount},
+		schedulerTuner,
+		0,
+		0,
+		timeSource,
+		log.NewTestLogger(),
+	).Run(readerGroup))
+	require.Equal(t, []NamespaceWeightOverride{{
+		NamespaceID: "busy",
+		Multiplier:  defaultThrottleNamespaceMultiplier,
+		ExpireTime:  timeSource.Now().Add(defaultThrottleNamespaceDuration),
+	}}, schedulerTuner.NamespaceWeightOverrides())
+
+	// Only the stuck reader is considered for reader stuck alerts.
+	require.True(t, newThrottleNamespaceAction(
+		Alert{
+			AlertType:                  AlertTypeReaderStuck,
+			AlertAttributesReaderStuck: &AlertAttributesReaderStuck{ReaderID: DefaultReaderId},
+		},
+		schedulerTuner,
+		0.5,
+		time.Minute,
+		timeSource,
+		log.NewTestLogger(),
+	).Run(readerGroup))
+	require.Len(t, schedulerTuner.NamespaceWeightOverrides(), 2)
+
+	require.False(t, newThrottleNamespaceAction(Alert{}, nil, 0, 0, timeSource, log.NewTestLogger()).Run(readerGroup))
+}
+
+func TestPageAction(t *testing.T) {
+	eventLogger := &testEventLogger{}
+	mitigations := NewMitigations(
+		func() dynamicconfig.QueueMitigationPolicySettings { return nil },
+		dynamicconfig.GetIntPropertyFn(10),
+		nil,
+		clock.NewRealTimeSource(),
+	)
+	action, err := mitigations.ForQueue(3, tasks.CategoryTimer, eventLogger).newAction(pageActionName, MitigationActionParams{
+		Alert: Alert{
+			AlertType:                  AlertTypeReaderStuck,
+			AlertAttributesReaderStuck: &AlertAttributesReaderStuck{ReaderID: 1, CurrentWatermark: tasks.NewKey(time.Unix(0, 0), 5)},
+		},
+		PreviousActions: []string{readerStuckActionName},
+	})
+	require.NoError(t, err)
+	require.True(t, action.Run(nil))
+
+	require.Len(t, eventLogger.records, 1)
+	require.Equal(t, wideevents.QueueMitigationEventName, eventLogger.records[0].EventName())
+	attributes := make(map[string]string)
+	eventLogger.records[0].WalkAttributes(func(kv otellog.KeyValue) bool {
+		attributes[kv.Key] = kv.Value.String()
+		return true
+	})
+	require.Equal(t, "timer", attributes["category"])
+	require.Equal(t, "ReaderStuck", attributes["alert"])
+	require.Contains(t, attributes["details"], "reader 1 stuck")
+	require.Equal(t, `["reader-stuck"]`, attributes["actions"])
+}
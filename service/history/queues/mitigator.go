@@ -1,6 +1,7 @@
 package queues
 
 import (
+	"slices"
 	"sync"
 
 	"go.temporal.io/server/common/dynamicconfig"
@@ -12,12 +13,13 @@ import (
 var _ Mitigator = (*mitigatorImpl)(nil)
 
 type (
-	// Mitigator generates and runs an Action for resolving the given Alert
+	// Mitigator generates and runs the Actions for resolving the given Alert,
+	// as configured by the queue mitigation policy
 	Mitigator interface {
 		Mitigate(Alert)
 	}
 
-	actionRunner func(Action, *ReaderGroup, metrics.Handler)
+	actionRunner func(Action, *ReaderGroup, metrics.Handler) (actionTaken bool)
 
 	mitigatorImpl struct {
 		sync.Mutex
@@ -27,6 +29,7 @@ type (
 		logger         log.Logger
 		metricsHandler metrics.Handler
 		maxReaderCount dynamicconfig.IntPropertyFn
+		mitigations    *QueueMitigations
 
 		// this is for overriding the behavior in unit tests
 		// since we don't really want to run the action in Mitigator unit tests
@@ -42,6 +45,7 @@ func newMitigator(
 	metricsHandler metrics.Handler,
 	maxReaderCount dynamicconfig.IntPropertyFn,
 	grouper Grouper,
+	mitigations *QueueMitigations,
 ) *mitigatorImpl {
 	return &mitigatorImpl{
 		readerGroup:    readerGroup,
@@ -49,6 +53,7 @@ func newMitigator(
 		logger:         logger,
 		metricsHandler: metricsHandler,
 		maxReaderCount: maxReaderCount,
+		mitigations:    mitigations,
 
 		actionRunner: runAction,
 		grouper:      grouper,
@@ -59,48 +64,86 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 	m.Lock()
 	defer m.Unlock()
 
-	var action Action
+	settings := m.mitigations.actions(alert.AlertType)
+	if len(settings) == 0 {
+		action := m.defaultAction(alert)
+		if action == nil {
+			m.logger.Error("Unknown queue alert type", tag.QueueAlert(alert))
+			return
+		}
+
+		actionTaken := m.actionRunner(
+			action,
+			m.readerGroup,
+			m.metricsHandler,
+		)
+		m.mitigations.record(alert, action.Name(), actionTaken, nil)
+	} else {
+		var previousActions []string
+		for _, actionSettings := range settings {
+			action, err := m.mitigations.newAction(actionSettings.Action, MitigationActionParams{
+				Alert:           alert,
+				Settings:        actionSettings,
+				Monitor:         m.monitor,
+				Grouper:         m.grouper,
+				MaxReaderCount:  m.maxReaderCount(),
+				Logger:          m.logger,
+				PreviousActions: slices.Clone(previousActions),
+			})
+			if err != nil {
+				m.logger.Error("Failed to create queue mitigation action",
+					tag.QueueAlert(alert), tag.Value(actionSettings.Action), tag.Error(err))
+				m.mitigations.record(alert, actionSettings.Action, false, err)
+				continue
+			}
+
+			actionTaken := m.actionRunner(
+				action,
+				m.readerGroup,
+				m.metricsHandler,
+			)
+			m.mitigations.record(alert, action.Name(), actionTaken, nil)
+			previousActions = append(previousActions, action.Name())
+		}
+	}
+
+	m.monitor.ResolveAlert(alert.AlertType)
+}
+
+func (m *mitigatorImpl) defaultAction(alert Alert) Action {
 	switch alert.AlertType {
 	case AlertTypeQueuePendingTaskCount:
-		action = newQueuePendingTaskAction(
+		return newQueuePendingTaskAction(
 			alert.AlertAttributesQueuePendingTaskCount,
 			m.monitor,
 			m.maxReaderCount(),
 			m.grouper,
 		)
 	case AlertTypeReaderStuck:
-		action = newReaderStuckAction(
+		return newReaderStuckAction(
 			alert.AlertAttributesReaderStuck,
 			m.logger,
 		)
 	case AlertTypeSliceCount:
-		action = newSliceCountAction(
+		return newSliceCountAction(
 			alert.AlertAttributesSliceCount,
 			m.monitor,
 		)
 	default:
-		m.logger.Error("Unknown queue alert type", tag.QueueAlert(alert))
-		return
+		return nil
 	}
-
-	m.actionRunner(
-		action,
-		m.readerGroup,
-		m.metricsHandler,
-	)
-
-	m.monitor.ResolveAlert(alert.AlertType)
 }
 
 func runAction(
 	action Action,
 	readerGroup *ReaderGroup,
 	metricsHandler metrics.Handler,
-) {
+) bool {
 	if !action.Run(readerGroup) {
-		return
+		return false
 	}
 
 	metricsHandler = metricsHandler.WithTags(metrics.QueueActionTag(action.Name()))
 	metrics.QueueActionCounter.With(metricsHandler).Record(1)
+	return true
 }
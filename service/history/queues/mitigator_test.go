@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/tasks"
 )
 
 type (
@@ -49,6 +50,7 @@ func (s *mitigatorSuite) SetupTest() {
 		metrics.NoopMetricsHandler,
 		dynamicconfig.GetIntPropertyFn(3),
 		GrouperNamespaceID{},
+		nil,
 	)
 }
 
@@ -94,8 +96,9 @@ func (s *mitigatorSuite) TestMitigate_ActionMatchAlert() {
 		action Action,
 		_ *ReaderGroup,
 		_ metrics.Handler,
-	) {
+	) bool {
 		actualAction = action
+		return true
 	}
 
 	for _, tc := range testCases {
@@ -109,7 +112,8 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 		_ Action,
 		_ *ReaderGroup,
 		_ metrics.Handler,
-	) {
+	) bool {
+		return true
 	}
 
 	alert := Alert{
@@ -124,6 +128,78 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 	s.Equal(alert.AlertType, s.monitor.resolvedAlertType)
 }
 
+func (s *mitigatorSuite) TestMitigate_Policy() {
+	policy := dynamicconfig.QueueMitigationPolicySettings{
+		"readerstuck": {
+			{Action: dlqStuckTasksActionName, MinAttempts: 10},
+			{Action: "slice-count"},
+			{Action: "unknown"},
+			{Action: readerStuckActionName},
+		},
+	}
+	mitigations := NewMitigations(
+		func() dynamicconfig.QueueMitigationPolicySettings { return policy },
+		dynamicconfig.GetIntPropertyFn(10),
+		nil,
+		s.mockTimeSource,
+	)
+	s.mitigator.mitigations = mitigations.ForQueue(1, tasks.CategoryTimer, nil)
+
+	var actualActions []Action
+	s.mitigator.actionRunner = func(
+		action Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+	) bool {
+		actualActions = append(actualActions, action)
+		return true
+	}
+
+	alert := Alert{
+		AlertType: AlertTypeReaderStuck,
+		AlertAttributesReaderStuck: &AlertAttributesReaderStuck{
+			ReaderID:         1,
+			CurrentWatermark: NewRandomKey(),
+		},
+	}
+	s.mitigator.Mitigate(alert)
+	s.Len(actualActions, 2)
+	s.IsType(&actionDLQStuckTasks{}, actualActions[0])
+	s.Equal(10, actualActions[0].(*actionDLQStuckTasks).minAttempts)
+	s.IsType(&actionReaderStuck{}, actualActions[1])
+	s.Equal(alert.AlertType, s.monitor.resolvedAlertType)
+
+	records := mitigations.ListMitigations(1)
+	s.Len(records, 4)
+	s.Equal(dlqStuckTasksActionName, records[0].Action)
+	s.True(records[0].ActionTaken)
+	s.Equal("slice-count", records[1].Action)
+	s.Contains(records[1].Error, "can't mitigate ReaderStuck alerts")
+	s.Contains(records[2].Error, "unknown queue mitigation action")
+	s.Equal(readerStuckActionName, records[3].Action)
+	s.Equal(tasks.CategoryTimer, records[3].Category)
+	s.Empty(mitigations.ListMitigations(2))
+
+	// Alert types missing from the policy run their default action.
+	actualActions = nil
+	s.mitigator.Mitigate(Alert{
+		AlertType: AlertTypeSliceCount,
+		AlertAttributesSliceCount: &AlertAttributesSlicesCount{
+			CurrentSliceCount:  1000,
+			CriticalSliceCount: 500,
+		},
+	})
+	s.Len(actualActions, 1)
+	s.IsType(&actionSliceCount{}, actualActions[0])
+
+	// The log is bounded.
+	s.Len(mitigations.ListMitigations(1), 5)
+	for range 10 {
+		s.mitigator.Mitigate(alert)
+	}
+	s.Len(mitigations.ListMitigations(1), 10)
+}
+
 func (m *testMonitor) ResolveAlert(alertType AlertType) {
 	m.resolvedAlertType = alertType
 }
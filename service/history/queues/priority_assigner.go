@@ -48,6 +48,7 @@ func (a *priorityAssignerImpl) Assign(executable Executable) tasks.Priority {
 		return tasks.PriorityLow
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
 		enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION,
+		enumsspb.TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED,
 		enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION,
 		enumsspb.TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION,
 		enumsspb.TASK_TYPE_UNSPECIFIED:
@@ -99,6 +99,8 @@ type (
 		MoveGroupTaskCountBase              dynamicconfig.IntPropertyFn
 		MoveGroupTaskCountMultiplier        dynamicconfig.FloatPropertyFn
 		ShrinkPredicateMaxPendingKeys       dynamicconfig.IntPropertyFn
+		// Mitigations selects the actions run for the queue alerts. Only the default actions are run if nil.
+		Mitigations *Mitigations
 	}
 )
 
@@ -191,7 +193,11 @@ func newQueueBase(
 		exclusiveDeletionHighWatermark = tasks.MinKey(exclusiveDeletionHighWatermark, scopes[0].Range.InclusiveMin)
 	}
 
-	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, options.MaxReaderCount, grouper)
+	var mitigations *QueueMitigations
+	if options.Mitigations != nil {
+		mitigations = options.Mitigations.ForQueue(shard.GetShardID(), category, shard.GetEventLogger())
+	}
+	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, options.MaxReaderCount, grouper, mitigations)
 
 	return &queueBase{
 		shard: shard,
@@ -3,6 +3,9 @@
 package queues
 
 import (
+	"math"
+	"sync"
+
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
@@ -62,6 +65,19 @@ type (
 		StandbyNamespaceWeights        dynamicconfig.MapPropertyFnWithNamespaceFilter
 		InactiveNamespaceDeletionDelay dynamicconfig.DurationPropertyFn
 
+		// Name identifies the scheduler in the SchedulerTuner and in metrics
+		Name string
+		// Optional, multiplier applied to the round robin weights of a namespace
+		NamespaceWeight dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		// Optional, multiplier applied to the round robin weight of a task channel whose buffer is full
+		BacklogWeightMultiplier dynamicconfig.FloatPropertyFn
+		// Optional, cap of the round robin weight of a task channel after multipliers are applied
+		MaxChannelWeight dynamicconfig.IntPropertyFn
+		// Optional, if specified, periodically re-evaluate channel weights and emit channel metrics
+		WeightRefreshInterval dynamicconfig.DurationPropertyFn
+		// Optional, if specified, apply the namespace weight overrides of the tuner and register the scheduler to it
+		Tuner *SchedulerTuner
+
 		// ExecutionAwareSchedulerOptions contains options for sequential per-workflow scheduling
 		tasks.ExecutionAwareSchedulerOptions
 	}
@@ -74,11 +90,20 @@ type (
 
 	schedulerImpl struct {
 		tasks.Scheduler[Executable]
+		name              string
+		options           SchedulerOptions
 		namespaceRegistry namespace.Registry
+		logger            log.Logger
+		metricsHandler    metrics.Handler
+		timeSource        clock.TimeSource
 
 		taskChannelKeyFn      TaskChannelKeyFn
 		channelWeightFn       ChannelWeightFn
 		channelWeightUpdateCh chan struct{}
+		iwrrScheduler         *tasks.InterleavedWeightedRoundRobinScheduler[Executable, TaskChannelKey]
+		shutdownOnce          sync.Once
+		shutdownCh            chan struct{}
+		shutdownWG            sync.WaitGroup
 
 		// executionAwareScheduler holds a reference to the workflow-aware scheduler
 		// for handling busy workflow errors
@@ -140,7 +165,26 @@ func NewScheduler(
 			)
 			weight = configs.DefaultPriorityWeight
 		}
-		return weight
+
+		multiplier := 1.0
+		if options.NamespaceWeight != nil {
+			multiplier *= options.NamespaceWeight(namespaceName.String())
+		}
+		if options.Tuner != nil {
+			multiplier *= options.Tuner.namespaceWeightMultiplier(key.NamespaceID)
+		}
+		return scaleChannelWeight(weight, multiplier, options.MaxChannelWeight)
+	}
+	var channelBacklogWeightFn tasks.ChannelBacklogWeightFn
+	if options.BacklogWeightMultiplier != nil {
+		channelBacklogWeightFn = func(weight int, backlog int) int {
+			multiplier := options.BacklogWeightMultiplier()
+			if multiplier <= 1 {
+				return weight
+			}
+			backlogRatio := min(1, float64(backlog)/float64(tasks.WeightedChannelDefaultSize))
+			return scaleChannelWeight(weight, 1+(multiplier-1)*backlogRatio, options.MaxChannelWeight)
+		}
 	}
 	channelWeightUpdateCh := make(chan struct{}, 1)
 	fifoSchedulerOptions := &tasks.FIFOSchedulerOptions{
@@ -163,23 +207,32 @@ func NewScheduler(
 		timeSource,
 	)
 
-	scheduler = tasks.NewInterleavedWeightedRoundRobinScheduler(
+	iwrrScheduler := tasks.NewInterleavedWeightedRoundRobinScheduler(
 		tasks.InterleavedWeightedRoundRobinSchedulerOptions[Executable, TaskChannelKey]{
 			TaskChannelKeyFn:             taskChannelKeyFn,
 			ChannelWeightFn:              channelWeightFn,
 			ChannelWeightUpdateCh:        channelWeightUpdateCh,
 			InactiveChannelDeletionDelay: options.InactiveNamespaceDeletionDelay,
+			ChannelBacklogWeightFn:       channelBacklogWeightFn,
 		},
 		executionAwareScheduler,
 		logger,
 	)
+	scheduler = iwrrScheduler
 
 	return &schedulerImpl{
 		Scheduler:               scheduler,
+		name:                    options.Name,
+		options:                 options,
 		namespaceRegistry:       namespaceRegistry,
+		logger:                  logger,
+		metricsHandler:          metricsHandler,
+		timeSource:              timeSource,
 		taskChannelKeyFn:        taskChannelKeyFn,
 		channelWeightFn:         channelWeightFn,
 		channelWeightUpdateCh:   channelWeightUpdateCh,
+		iwrrScheduler:           iwrrScheduler,
+		shutdownCh:              make(chan struct{}),
 		executionAwareScheduler: executionAwareScheduler,
 	}
 }
@@ -194,6 +247,14 @@ func (s *schedulerImpl) Start() {
 		})
 	}
 	s.Scheduler.Start()
+
+	if s.options.Tuner != nil {
+		s.options.Tuner.register(s)
+	}
+	if s.options.WeightRefreshInterval != nil {
+		s.shutdownWG.Add(1)
+		go s.weightRefreshLoop()
+	}
 }
 
 func (s *schedulerImpl) Stop() {
@@ -208,6 +269,12 @@ func (s *schedulerImpl) Stop() {
 		// so Stop is only called when host is shutting down, and we don't need
 		// to worry about open channels
 	}
+	if s.options.Tuner != nil {
+		s.options.Tuner.unregister(s)
+	}
+	s.shutdownOnce.Do(func() { close(s.shutdownCh) })
+	s.shutdownWG.Wait()
+
 	s.Scheduler.Stop()
 }
 
@@ -215,6 +282,68 @@ func (s *schedulerImpl) TaskChannelKeyFn() TaskChannelKeyFn {
 	return s.taskChannelKeyFn
 }
 
+func (s *schedulerImpl) notifyChannelWeightUpdate() {
+	select {
+	case s.channelWeightUpdateCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *schedulerImpl) channelStates() []SchedulerChannelState {
+	return s.iwrrScheduler.ChannelStates()
+}
+
+// weightRefreshLoop periodically re-evaluates the channel weights, so that backlog based weights follow the backlog
+// and expired overrides are removed, and emits the weight and backlog of each channel.
+func (s *schedulerImpl) weightRefreshLoop() {
+	defer s.shutdownWG.Done()
+
+	timer, _ := s.timeSource.NewTimer(s.options.WeightRefreshInterval())
+	for {
+		select {
+		case <-timer:
+			s.notifyChannelWeightUpdate()
+			s.emitChannelMetrics()
+			timer, _ = s.timeSource.NewTimer(s.options.WeightRefreshInterval())
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *schedulerImpl) emitChannelMetrics() {
+	for _, state := range s.channelStates() {
+		namespaceName, err := s.namespaceRegistry.GetNamespaceName(namespace.ID(state.Key.NamespaceID))
+		if err != nil {
+			namespaceName = namespace.EmptyName
+		}
+		tags := []metrics.Tag{
+			metrics.QueueTypeTag(s.name),
+			metrics.NamespaceTag(namespaceName.String()),
+			metrics.TaskPriorityTag(state.Key.Priority.String()),
+		}
+		metrics.TaskSchedulerChannelWeight.With(s.metricsHandler).Record(float64(state.Weight), tags...)
+		metrics.TaskSchedulerChannelBacklog.With(s.metricsHandler).Record(float64(state.Backlog), tags...)
+	}
+}
+
+// scaleChannelWeight multiplies a task channel weight, keeping the result between 1 and maxWeight. A weight that is
+// already above maxWeight is never lowered by a multiplier above 1.
+func scaleChannelWeight(
+	weight int,
+	multiplier float64,
+	maxWeight dynamicconfig.IntPropertyFn,
+) int {
+	if multiplier == 1 || multiplier <= 0 {
+		return weight
+	}
+	scaled := max(1, int(math.Round(float64(weight)*multiplier)))
+	if maxWeight != nil {
+		scaled = min(scaled, max(weight, maxWeight()))
+	}
+	return scaled
+}
+
 // HandleBusyWorkflow implements BusyWorkflowHandler by delegating to the
 // underlying ExecutionAwareScheduler. This is called when a task encounters
 // a busy workflow error and needs to be routed to the sequential scheduler.
@@ -0,0 +1,173 @@
+package queues
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/tasks"
+)
+
+type (
+	// SchedulerTuner tracks the host level schedulers of a history host and holds the temporary namespace weight
+	// overrides applied to all of them. Overrides are used to boost or throttle a namespace during incidents and are
+	// not persisted, so they are lost when the host restarts.
+	SchedulerTuner struct {
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		overrides  map[string]NamespaceWeightOverride // namespaceID -> override
+		schedulers map[string]*schedulerImpl          // scheduler name -> scheduler
+	}
+
+	// NamespaceWeightOverride multiplies the task channel weights of a namespace until ExpireTime.
+	NamespaceWeightOverride struct {
+		NamespaceID string
+		Multiplier  float64
+		ExpireTime  time.Time
+	}
+
+	// SchedulerState is a snapshot of the task channels of a host level scheduler.
+	SchedulerState struct {
+		Name     string
+		Channels []SchedulerChannelState
+	}
+
+	SchedulerChannelState = tasks.ChannelState[TaskChannelKey]
+)
+
+func NewSchedulerTuner(
+	timeSource clock.TimeSource,
+) *SchedulerTuner {
+	return &SchedulerTuner{
+		timeSource: timeSource,
+		overrides:  make(map[string]NamespaceWeightOverride),
+		schedulers: make(map[string]*schedulerImpl),
+	}
+}
+
+// SetNamespaceWeightOverride multiplies the task channel weights of a namespace by multiplier until expireTime,
+// replacing any existing override of the namespace.
+func (t *SchedulerTuner) SetNamespaceWeightOverride(
+	namespaceID string,
+	multiplier float64,
+	expireTime time.Time,
+) {
+	t.Lock()
+	t.overrides[namespaceID] = NamespaceWeightOverride{
+		NamespaceID: namespaceID,
+		Multiplier:  multiplier,
+		ExpireTime:  expireTime,
+	}
+	t.Unlock()
+
+	t.notifySchedulers()
+}
+
+// ResetNamespaceWeightOverride removes the override of a namespace.
+func (t *SchedulerTuner) ResetNamespaceWeightOverride(
+	namespaceID string,
+) {
+	t.Lock()
+	delete(t.overrides, namespaceID)
+	t.Unlock()
+
+	t.notifySchedulers()
+}
+
+// NamespaceWeightOverrides returns the overrides that have not expired yet.
+func (t *SchedulerTuner) NamespaceWeightOverrides() []NamespaceWeightOverride {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeSource.Now()
+	overrides := make([]NamespaceWeightOverride, 0, len(t.overrides))
+	for namespaceID, override := range t.overrides {
+		if !now.Before(override.ExpireTime) {
+			delete(t.overrides, namespaceID)
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	slices.SortFunc(overrides, func(a, b NamespaceWeightOverride) int {
+		return cmp.Compare(a.NamespaceID, b.NamespaceID)
+	})
+	return overrides
+}
+
+// SchedulerStates returns a snapshot of the task channels of all registered schedulers.
+func (t *SchedulerTuner) SchedulerStates() []SchedulerState {
+	t.Lock()
+	schedulers := make([]*schedulerImpl, 0, len(t.schedulers))
+	for _, scheduler := range t.schedulers {
+		schedulers = append(schedulers, scheduler)
+	}
+	t.Unlock()
+
+	states := make([]SchedulerState, 0, len(schedulers))
+	for _, scheduler := range schedulers {
+		channels := scheduler.channelStates()
+		slices.SortFunc(channels, func(a, b SchedulerChannelState) int {
+			return cmp.Or(
+				cmp.Compare(a.Key.NamespaceID, b.Key.NamespaceID),
+				cmp.Compare(a.Key.Priority, b.Key.Priority),
+			)
+		})
+		states = append(states, SchedulerState{
+			Name:     scheduler.name,
+			Channels: channels,
+		})
+	}
+	slices.SortFunc(states, func(a, b SchedulerState) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return states
+}
+
+func (t *SchedulerTuner) namespaceWeightMultiplier(
+	namespaceID string,
+) float64 {
+	t.Lock()
+	defer t.Unlock()
+
+	override, ok := t.overrides[namespaceID]
+	if !ok {
+		return 1
+	}
+	if !t.timeSource.Now().Before(override.ExpireTime) {
+		delete(t.overrides, namespaceID)
+		return 1
+	}
+	return override.Multiplier
+}
+
+func (t *SchedulerTuner) register(
+	scheduler *schedulerImpl,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.schedulers[scheduler.name] = scheduler
+}
+
+func (t *SchedulerTuner) unregister(
+	scheduler *schedulerImpl,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.schedulers[scheduler.name] == scheduler {
+		delete(t.schedulers, scheduler.name)
+	}
+}
+
+func (t *SchedulerTuner) notifySchedulers() {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, scheduler := range t.schedulers {
+		scheduler.notifyChannelWeightUpdate()
+	}
+}
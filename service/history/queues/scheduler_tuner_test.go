@@ -0,0 +1,129 @@
+package queues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	"go.uber.org/mock/gomock"
+)
+
+func newTunedTestScheduler(
+	t *testing.T,
+	tuner *SchedulerTuner,
+	timeSource clock.TimeSource,
+) *schedulerImpl {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).DoAndReturn(func(id namespace.ID) (*namespace.Namespace, error) {
+		return namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: id.String(), Name: "name-" + id.String()},
+			nil,
+			cluster.TestCurrentClusterName,
+		), nil
+	}).AnyTimes()
+
+	activeWeights := configs.ConvertWeightsToDynamicConfigValue(configs.DefaultActiveTaskPriorityWeight)
+	return NewScheduler(
+		cluster.TestCurrentClusterName,
+		SchedulerOptions{
+			WorkerCount:             dynamicconfig.TimerProcessorSchedulerWorkerCount.Subscribe(dynamicconfig.NewNoopCollection()),
+			ActiveNamespaceWeights:  dynamicconfig.GetMapPropertyFnFilteredByNamespace(activeWeights),
+			StandbyNamespaceWeights: dynamicconfig.GetMapPropertyFnFilteredByNamespace(activeWeights),
+			Name:                    "transfer",
+			NamespaceWeight: func(namespaceName string) float64 {
+				if namespaceName == "name-weighted" {
+					return 2
+				}
+				return 1
+			},
+			BacklogWeightMultiplier: dynamicconfig.GetFloatPropertyFn(3),
+			MaxChannelWeight:        dynamicconfig.GetIntPropertyFn(25),
+			Tuner:                   tuner,
+			ExecutionAwareSchedulerOptions: ctasks.ExecutionAwareSchedulerOptions{
+				Enabled:   dynamicconfig.GetBoolPropertyFn(false),
+				MaxQueues: dynamicconfig.GetIntPropertyFn(500),
+				QueueTTL:  dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+			},
+		},
+		namespaceRegistry,
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		timeSource,
+	).(*schedulerImpl)
+}
+
+func TestScheduler_ChannelWeight(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	tuner := NewSchedulerTuner(timeSource)
+	scheduler := newTunedTestScheduler(t, tuner, timeSource)
+
+	highKey := func(namespaceID string) TaskChannelKey {
+		return TaskChannelKey{NamespaceID: namespaceID, Priority: ctasks.PriorityHigh}
+	}
+	require.Equal(t, 10, scheduler.channelWeightFn(highKey("plain")))
+	require.Equal(t, 20, scheduler.channelWeightFn(highKey("weighted")))
+
+	tuner.SetNamespaceWeightOverride("plain", 0.5, timeSource.Now().Add(time.Minute))
+	require.Equal(t, 5, scheduler.channelWeightFn(highKey("plain")))
+	// Overrides stack with the namespace weight and are capped by the max channel weight.
+	tuner.SetNamespaceWeightOverride("weighted", 4, timeSource.Now().Add(time.Minute))
+	require.Equal(t, 25, scheduler.channelWeightFn(highKey("weighted")))
+
+	timeSource.Advance(time.Minute)
+	require.Equal(t, 10, scheduler.channelWeightFn(highKey("plain")))
+	require.Empty(t, tuner.NamespaceWeightOverrides())
+}
+
+func TestSchedulerTuner_Overrides(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	tuner := NewSchedulerTuner(timeSource)
+
+	tuner.SetNamespaceWeightOverride("ns-b", 2, timeSource.Now().Add(time.Hour))
+	tuner.SetNamespaceWeightOverride("ns-a", 0.5, timeSource.Now().Add(time.Minute))
+	require.Equal(t, []NamespaceWeightOverride{
+		{NamespaceID: "ns-a", Multiplier: 0.5, ExpireTime: timeSource.Now().Add(time.Minute)},
+		{NamespaceID: "ns-b", Multiplier: 2, ExpireTime: timeSource.Now().Add(time.Hour)},
+	}, tuner.NamespaceWeightOverrides())
+
+	tuner.ResetNamespaceWeightOverride("ns-b")
+	timeSource.Advance(time.Minute)
+	require.Empty(t, tuner.NamespaceWeightOverrides())
+	require.Equal(t, 1.0, tuner.namespaceWeightMultiplier("ns-a"))
+}
+
+func TestSchedulerTuner_SchedulerStates(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	tuner := NewSchedulerTuner(timeSource)
+	scheduler := newTunedTestScheduler(t, tuner, timeSource)
+
+	tuner.register(scheduler)
+	states := tuner.SchedulerStates()
+	require.Len(t, states, 1)
+	require.Equal(t, "transfer", states[0].Name)
+	require.Empty(t, states[0].Channels)
+
+	tuner.unregister(scheduler)
+	require.Empty(t, tuner.SchedulerStates())
+}
+
+func TestScaleChannelWeight(t *testing.T) {
+	maxWeight := dynamicconfig.GetIntPropertyFn(20)
+
+	require.Equal(t, 10, scaleChannelWeight(10, 1, maxWeight))
+	require.Equal(t, 15, scaleChannelWeight(10, 1.5, maxWeight))
+	require.Equal(t, 20, scaleChannelWeight(10, 3, maxWeight))
+	require.Equal(t, 1, scaleChannelWeight(10, 0.01, maxWeight))
+	// Configured weights above the cap are not lowered by a boost.
+	require.Equal(t, 30, scaleChannelWeight(30, 2, maxWeight))
+	require.Equal(t, 30, scaleChannelWeight(10, 3, nil))
+}
@@ -36,6 +36,8 @@ type (
 		// TaskStats returns a shallow copy.
 		// Deep copy fields before using them outside a reader lock.
 		TaskStats() TaskStats
+		// PendingExecutables returns the loaded executables that are not acked yet.
+		PendingExecutables() []Executable
 		Clear()
 	}
 
@@ -43,6 +45,11 @@ type (
 		PendingPerKey map[any]int
 	}
 
+	// loadTracer is implemented by executables recording the slice they were loaded in when their task is traced.
+	loadTracer interface {
+		traceLoaded(sliceRange Range)
+	}
+
 	SliceImpl struct {
 		paginationFnProvider PaginationFnProvider
 		executableFactory    ExecutableFactory
@@ -398,6 +405,9 @@ func (s *SliceImpl) SelectTasks(readerID int64, batchSize int) ([]Executable, er
 			}
 
 			executable := s.executableFactory.NewExecutable(task, readerID)
+			if tracer, ok := executable.(loadTracer); ok {
+				tracer.traceLoaded(s.scope.Range)
+			}
 			s.add(executable)
 			executables = append(executables, executable)
 		} else {
@@ -422,6 +432,12 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	}
 }
 
+func (s *SliceImpl) PendingExecutables() []Executable {
+	s.stateSanityCheck()
+
+	return expmaps.Values(s.pendingExecutables)
+}
+
 func (s *SliceImpl) Clear() {
 	s.stateSanityCheck()
 
@@ -0,0 +1,249 @@
+package queues
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	TaskTraceStageGenerated   TaskTraceStage = "generated"
+	TaskTraceStageLoaded      TaskTraceStage = "loaded"
+	TaskTraceStageScheduled   TaskTraceStage = "scheduled"
+	TaskTraceStageAttempted   TaskTraceStage = "attempted"
+	TaskTraceStageRescheduled TaskTraceStage = "rescheduled"
+	TaskTraceStageAcked       TaskTraceStage = "acked"
+	TaskTraceStageAborted     TaskTraceStage = "aborted"
+	TaskTraceStageCancelled   TaskTraceStage = "cancelled"
+
+	// maxTaskTraceEvents bounds the events kept for a task that keeps being retried.
+	maxTaskTraceEvents = 100
+)
+
+type (
+	// TaskTracer follows sampled history tasks through their lifecycle, from their generation to their ack. Each
+	// stage is reported as a span of a trace derived from the task key, so that the stages recorded by different
+	// history hosts end up in the same trace without propagating a span context through persistence. The lifecycles
+	// of the most recent traced tasks are also kept in memory to be inspected with tdbg.
+	TaskTracer struct {
+		tracer            trace.Tracer
+		namespaceRegistry namespace.Registry
+		sampleRate        dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		bufferSize        dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		traces map[TaskTraceKey]*TaskTrace
+		keys   []TaskTraceKey // oldest first
+	}
+
+	// ShardTaskTracer records the lifecycle of the tasks of a shard. A nil ShardTaskTracer records nothing.
+	ShardTaskTracer struct {
+		*TaskTracer
+		shardID int32
+	}
+
+	TaskTraceKey struct {
+		ShardID    int32
+		CategoryID int
+		TaskID     int64
+	}
+
+	TaskTrace struct {
+		TaskTraceKey
+		TaskType    string
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		TraceID     trace.TraceID
+		Events      []TaskTraceEvent
+	}
+
+	TaskTraceEvent struct {
+		Stage      TaskTraceStage
+		Time       time.Time
+		Attempt    int
+		ReaderID   int64
+		SliceRange string
+		Reason     string
+	}
+
+	TaskTraceStage string
+)
+
+func NewTaskTracer(
+	tracer trace.Tracer,
+	namespaceRegistry namespace.Registry,
+	sampleRate dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	bufferSize dynamicconfig.IntPropertyFn,
+) *TaskTracer {
+	return &TaskTracer{
+		tracer:            tracer,
+		namespaceRegistry: namespaceRegistry,
+		sampleRate:        sampleRate,
+		bufferSize:        bufferSize,
+		traces:            make(map[TaskTraceKey]*TaskTrace),
+	}
+}
+
+// ForShard returns the tracer recording the tasks of a shard.
+func (t *TaskTracer) ForShard(shardID int32) *ShardTaskTracer {
+	if t == nil {
+		return nil
+	}
+	return &ShardTaskTracer{
+		TaskTracer: t,
+		shardID:    shardID,
+	}
+}
+
+// GetTaskTrace returns the recorded lifecycle of a task.
+func (t *TaskTracer) GetTaskTrace(key TaskTraceKey) (TaskTrace, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	taskTrace, ok := t.traces[key]
+	if !ok {
+		return TaskTrace{}, false
+	}
+	result := *taskTrace
+	result.Events = slices.Clone(taskTrace.Events)
+	return result, true
+}
+
+// Sampled returns whether the lifecycle of the task is traced.
+func (t *ShardTaskTracer) Sampled(task tasks.Task) bool {
+	if t == nil {
+		return false
+	}
+	ns, err := t.namespaceRegistry.GetNamespaceName(namespace.ID(task.GetNamespaceID()))
+	if err != nil {
+		return false
+	}
+	rate := t.sampleRate(ns.String())
+	if rate <= 0 {
+		return false
+	}
+	_, _, sample := taskTraceIDs(t.key(task))
+	return sample < rate
+}
+
+// Record records a stage of the lifecycle of the task if it is sampled.
+func (t *ShardTaskTracer) Record(task tasks.Task, event TaskTraceEvent) {
+	if t.Sampled(task) {
+		t.record(task, event)
+	}
+}
+
+// ContextWithTrace returns a context whose spans are part of the lifecycle trace of the task. The task must be
+// sampled.
+func (t *ShardTaskTracer) ContextWithTrace(ctx context.Context, task tasks.Task) context.Context {
+	traceID, spanID, _ := taskTraceIDs(t.key(task))
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
+// record records a stage of the lifecycle of a task that is known to be sampled.
+func (t *ShardTaskTracer) record(task tasks.Task, event TaskTraceEvent) {
+	key := t.key(task)
+	traceID, _, _ := taskTraceIDs(key)
+
+	if telemetry.IsEnabled(t.tracer) {
+		attributes := []attribute.KeyValue{
+			attribute.Key(telemetry.WorkflowIDKey).String(task.GetWorkflowID()),
+			attribute.Key(telemetry.RunIDKey).String(task.GetRunID()),
+			attribute.Key("queue.shard.id").Int64(int64(key.ShardID)),
+			attribute.Key("queue.task.type").String(task.GetType().String()),
+			attribute.Key("queue.task.id").Int64(key.TaskID),
+		}
+		if event.Attempt > 0 {
+			attributes = append(attributes, attribute.Key("queue.task.attempt").Int(event.Attempt))
+		}
+		if event.Stage == TaskTraceStageLoaded {
+			attributes = append(attributes,
+				attribute.Key("queue.reader.id").Int64(event.ReaderID),
+				attribute.Key("queue.slice.range").String(event.SliceRange),
+			)
+		}
+		if event.Reason != "" {
+			attributes = append(attributes, attribute.Key("queue.task.reason").String(event.Reason))
+		}
+		_, span := t.tracer.Start(
+			t.ContextWithTrace(context.Background(), task),
+			"queue.task/"+string(event.Stage),
+			trace.WithTimestamp(event.Time),
+			trace.WithAttributes(attributes...),
+		)
+		span.End(trace.WithTimestamp(event.Time))
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	taskTrace, ok := t.traces[key]
+	if !ok {
+		taskTrace = &TaskTrace{
+			TaskTraceKey: key,
+			TaskType:     task.GetType().String(),
+			NamespaceID:  task.GetNamespaceID(),
+			WorkflowID:   task.GetWorkflowID(),
+			RunID:        task.GetRunID(),
+			TraceID:      traceID,
+		}
+		t.traces[key] = taskTrace
+		t.keys = append(t.keys, key)
+		for len(t.keys) > max(t.bufferSize(), 1) {
+			delete(t.traces, t.keys[0])
+			t.keys = t.keys[1:]
+		}
+	}
+	if len(taskTrace.Events) >= maxTaskTraceEvents {
+		taskTrace.Events = slices.Delete(taskTrace.Events, 0, 1)
+	}
+	taskTrace.Events = append(taskTrace.Events, event)
+}
+
+func (t *ShardTaskTracer) key(task tasks.Task) TaskTraceKey {
+	return TaskTraceKey{
+		ShardID:    t.shardID,
+		CategoryID: task.GetCategory().ID(),
+		TaskID:     task.GetTaskID(),
+	}
+}
+
+func formatTaskTraceRange(r Range) string {
+	return fmt.Sprintf("[%v/%v, %v/%v)",
+		r.InclusiveMin.FireTime.UTC().Format(time.RFC3339Nano), r.InclusiveMin.TaskID,
+		r.ExclusiveMax.FireTime.UTC().Format(time.RFC3339Nano), r.ExclusiveMax.TaskID,
+	)
+}
+
+// taskTraceIDs derives the trace ID and parent span ID of the lifecycle trace of a task, and the value in [0, 1)
+// compared against the sample rate.
+func taskTraceIDs(key TaskTraceKey) (trace.TraceID, trace.SpanID, float64) {
+	var buf [16]byte
+	binary.BigEndian.PutUint32(buf[0:4], uint32(key.ShardID))
+	binary.BigEndian.PutUint32(buf[4:8], uint32(key.CategoryID))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(key.TaskID))
+	sum := sha256.Sum256(buf[:])
+
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[0:16])
+	copy(spanID[:], sum[16:24])
+	return traceID, spanID, float64(binary.BigEndian.Uint64(sum[24:32])>>11) / (1 << 53)
+}
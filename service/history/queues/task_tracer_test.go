@@ -0,0 +1,179 @@
+package queues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestTaskTracer(
+	t *testing.T,
+	tracer trace.Tracer,
+	sampleRate float64,
+	bufferSize int,
+) *TaskTracer {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).AnyTimes()
+	return NewTaskTracer(
+		tracer,
+		namespaceRegistry,
+		func(string) float64 { return sampleRate },
+		dynamicconfig.GetIntPropertyFn(bufferSize),
+	)
+}
+
+func newTracedTestTask(taskID int64) tasks.Task {
+	task := tasks.NewFakeTask(
+		definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		tasks.CategoryTransfer,
+		time.Now(),
+	)
+	task.SetTaskID(taskID)
+	return task
+}
+
+func TestTaskTracer_Sampled(t *testing.T) {
+	task := newTracedTestTask(123)
+	require.False(t, newTestTaskTracer(t, telemetry.NoopTracer, 0, 10).ForShard(1).Sampled(task))
+	require.True(t, newTestTaskTracer(t, telemetry.NoopTracer, 1, 10).ForShard(1).Sampled(task))
+
+	var nilTracer *TaskTracer
+	require.False(t, nilTracer.ForShard(1).Sampled(task))
+
+	tracer := newTestTaskTracer(t, telemetry.NoopTracer, 0.5, 10).ForShard(1)
+	sampled := 0
+	for taskID := range int64(1000) {
+		if tracer.Sampled(newTracedTestTask(taskID)) {
+			sampled++
+		}
+		// The decision only depends on the task.
+		require.Equal(t, tracer.Sampled(newTracedTestTask(taskID)), tracer.Sampled(newTracedTestTask(taskID)))
+	}
+	require.InDelta(t, 500, sampled, 100)
+}
+
+func TestTaskTracer_BufferSize(t *testing.T) {
+	tracer := newTestTaskTracer(t, telemetry.NoopTracer, 1, 2)
+	shardTracer := tracer.ForShard(1)
+	now := time.Now()
+	for taskID := range int64(3) {
+		shardTracer.Record(newTracedTestTask(taskID), TaskTraceEvent{Stage: TaskTraceStageGenerated, Time: now})
+	}
+	shardTracer.Record(newTracedTestTask(2), TaskTraceEvent{Stage: TaskTraceStageLoaded, Time: now})
+
+	key := func(taskID int64) TaskTraceKey {
+		return TaskTraceKey{ShardID: 1, CategoryID: tasks.CategoryIDTransfer, TaskID: taskID}
+	}
+	_, ok := tracer.GetTaskTrace(key(0))
+	require.False(t, ok)
+	taskTrace, ok := tracer.GetTaskTrace(key(2))
+	require.True(t, ok)
+	require.Equal(t, tests.WorkflowID, taskTrace.WorkflowID)
+	require.Len(t, taskTrace.Events, 2)
+
+	// Traces are per shard.
+	_, ok = tracer.GetTaskTrace(TaskTraceKey{ShardID: 2, CategoryID: tasks.CategoryIDTransfer, TaskID: 2})
+	require.False(t, ok)
+}
+
+func TestTaskTracer_ExecutableLifecycle(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	taskTracer := newTestTaskTracer(t, tracerProvider.Tracer(telemetry.ComponentQueueTask), 1, 10)
+
+	ctrl := gomock.NewController(t)
+	executor := NewMockExecutor(ctrl)
+	scheduler := NewMockScheduler(ctrl)
+	rescheduler := NewMockRescheduler(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+
+	task := newTracedTestTask(123)
+	executable := NewExecutable(
+		DefaultReaderId,
+		task,
+		executor,
+		scheduler,
+		rescheduler,
+		NewNoopPriorityAssigner(),
+		timeSource,
+		namespaceRegistry,
+		clusterMetadata,
+		chasm.NewRegistry(log.NewTestLogger()),
+		GetTaskTypeTagValue,
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		tracerProvider.Tracer(telemetry.ComponentQueueTransfer),
+		WithTaskTracer(taskTracer.ForShard(1)),
+	)
+
+	executable.(loadTracer).traceLoaded(NewRange(tasks.MinimumKey, tasks.MaximumKey))
+	executable.SetScheduledTime(timeSource.Now())
+	executor.EXPECT().Execute(gomock.Any(), executable).Return(ExecuteResponse{ExecutionErr: consts.ErrTaskRetry})
+	err := executable.HandleErr(executable.Execute())
+	require.Error(t, err)
+	rescheduler.EXPECT().Add(executable, gomock.Any())
+	executable.Nack(err)
+
+	executable.SetScheduledTime(timeSource.Now())
+	executor.EXPECT().Execute(gomock.Any(), executable).Return(ExecuteResponse{})
+	require.NoError(t, executable.HandleErr(executable.Execute()))
+	executable.Ack()
+
+	taskTrace, ok := taskTracer.GetTaskTrace(TaskTraceKey{ShardID: 1, CategoryID: tasks.CategoryIDTransfer, TaskID: 123})
+	require.True(t, ok)
+	var stages []TaskTraceStage
+	for _, event := range taskTrace.Events {
+		stages = append(stages, event.Stage)
+	}
+	require.Equal(t, []TaskTraceStage{
+		TaskTraceStageLoaded,
+		TaskTraceStageScheduled,
+		TaskTraceStageAttempted,
+		TaskTraceStageRescheduled,
+		TaskTraceStageScheduled,
+		TaskTraceStageAttempted,
+		TaskTraceStageAcked,
+	}, stages)
+	require.Equal(t, DefaultReaderId, taskTrace.Events[0].ReaderID)
+	require.NotEmpty(t, taskTrace.Events[0].SliceRange)
+	require.Equal(t, consts.ErrTaskRetry.Error(), taskTrace.Events[2].Reason)
+	require.Contains(t, taskTrace.Events[3].Reason, consts.ErrTaskRetry.Error())
+	require.Equal(t, 2, taskTrace.Events[5].Attempt)
+
+	// The lifecycle spans and the execution spans are all part of the same trace.
+	spans := recorder.Ended()
+	require.Len(t, spans, len(stages)+2)
+	for _, span := range spans {
+		require.Equal(t, taskTrace.TraceID, span.SpanContext().TraceID())
+	}
+}
+
+func TestTaskTracer_NotSampled(t *testing.T) {
+	taskTracer := newTestTaskTracer(t, telemetry.NoopTracer, 0, 10)
+	taskTracer.ForShard(1).Record(newTracedTestTask(1), TaskTraceEvent{Stage: TaskTraceStageGenerated, Time: time.Now()})
+	_, ok := taskTracer.GetTaskTrace(TaskTraceKey{ShardID: 1, CategoryID: tasks.CategoryIDTransfer, TaskID: 1})
+	require.False(t, ok)
+}
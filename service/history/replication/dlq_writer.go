@@ -130,7 +130,7 @@ func (d *DLQWriterAdapter) WriteTaskToDLQ(
 	if err != nil {
 		return err
 	}
-	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, int(request.SourceShardID), task, false)
+	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, int(request.SourceShardID), task, nil, false)
 }
 
 // This is a helper function to make it easier to change the DLQWriteRequest format in the future.
@@ -388,6 +388,8 @@ func (e *ExecutableTaskImpl) emitFinishMetrics(
 		if processingLatency > 10*time.Second && e.replicationTask != nil {
 			namespaceID, workflowID, runID := e.workflowKeyFromTask()
 			if workflowID != "" {
+				// The shard is only logged, so a failed lookup logs shard 0 instead of failing the task.
+				shardID, _ := e.ShardPools.ShardID(namespaceID, workflowID)
 				e.ThrottledLogger.Warn(fmt.Sprintf(
 					"replication task latency is too long: queue=%.2fs processing=%.2fs",
 					queueLatency.Seconds(),
@@ -397,7 +399,7 @@ func (e *ExecutableTaskImpl) emitFinishMetrics(
 					tag.WorkflowID(workflowID),
 					tag.WorkflowRunID(runID),
 					tag.ReplicationTask(e.replicationTask.GetRawTaskInfo()),
-					tag.ShardID(e.Config.GetShardID(namespace.ID(namespaceID), workflowID)),
+					tag.ShardID(shardID),
 					tag.AttemptCount(int64(e.Attempt())),
 				)
 			}
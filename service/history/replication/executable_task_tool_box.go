@@ -8,6 +8,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/shardpool"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/service/history/configs"
@@ -28,6 +29,7 @@ type (
 		ChasmEngine               chasm.Engine
 		ChasmRegistry             *chasm.Registry
 		NamespaceCache            namespace.Registry
+		ShardPools                *shardpool.Resolver
 		EagerNamespaceRefresher   EagerNamespaceRefresher
 		ResendHandler             eventhandler.ResendHandler
 		HighPriorityTaskScheduler ctasks.Scheduler[TrackableExecutableTask] `name:"HighPriorityTaskScheduler"`
@@ -41,15 +41,18 @@ func (p *StreamBiDirectionStreamClientProvider) Get(
 	if err != nil {
 		return nil, err
 	}
-	ctx = metadata.NewOutgoingContext(ctx, history.EncodeClusterShardMD(
-		history.ClusterShardID{
-			ClusterID: clientShardKey.ClusterID,
-			ShardID:   clientShardKey.ShardID,
-		},
-		history.ClusterShardID{
-			ClusterID: serverShardKey.ClusterID,
-			ShardID:   serverShardKey.ShardID,
-		},
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Join(
+		history.EncodeClusterShardMD(
+			history.ClusterShardID{
+				ClusterID: clientShardKey.ClusterID,
+				ShardID:   clientShardKey.ShardID,
+			},
+			history.ClusterShardID{
+				ClusterID: serverShardKey.ClusterID,
+				ShardID:   serverShardKey.ShardID,
+			},
+		),
+		metadata.Pairs(history.MetadataKeyClientReplicationCompression, supportedStreamCompressions),
 	))
 	return adminClient.StreamWorkflowReplicationMessages(ctx)
 }
@@ -0,0 +1,152 @@
+package replication
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/headers"
+)
+
+const (
+	streamCompressionGzip = "gzip"
+	streamCompressionZstd = "zstd"
+)
+
+var (
+	streamCompressions = map[string]enumsspb.ReplicationStreamCompression{
+		streamCompressionGzip: enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP,
+		streamCompressionZstd: enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+	}
+	// supportedStreamCompressions is advertised by the receiver when opening a replication stream.
+	supportedStreamCompressions = streamCompressionGzip + "," + streamCompressionZstd
+
+	// EncodeAll is safe for concurrent use.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+)
+
+// negotiateStreamCompression returns the compression the sender should use given the configured compression and
+// the compressions advertised by the receiver. Receivers of older versions don't advertise any compression, in
+// which case tasks are sent uncompressed.
+func negotiateStreamCompression(
+	configured string,
+	getter headers.HeaderGetter,
+) enumsspb.ReplicationStreamCompression {
+	compression, ok := streamCompressions[strings.ToLower(strings.TrimSpace(configured))]
+	if !ok {
+		return enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED
+	}
+	for advertised := range strings.SplitSeq(getter.Get(history.MetadataKeyClientReplicationCompression), ",") {
+		if streamCompressions[strings.TrimSpace(advertised)] == compression {
+			return compression
+		}
+	}
+	return enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED
+}
+
+func compressReplicationTasks(
+	compression enumsspb.ReplicationStreamCompression,
+	tasks []*replicationspb.ReplicationTask,
+) (*replicationspb.CompressedReplicationTasks, error) {
+	data, err := (&replicationspb.ReplicationTasks{ReplicationTasks: tasks}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var compressed []byte
+	switch compression {
+	case enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		compressed = buf.Bytes()
+	case enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD:
+		compressed = zstdEncoder.EncodeAll(data, nil)
+	default:
+		return nil, serviceerror.NewInternalf("unknown replication stream compression: %v", compression)
+	}
+	return &replicationspb.CompressedReplicationTasks{
+		Compression:      compression,
+		Data:             compressed,
+		TaskCount:        int32(len(tasks)),
+		UncompressedSize: int64(len(data)),
+	}, nil
+}
+
+// decompressReplicationTasks decompresses a batch of replication tasks. The uncompressed size declared by the sender
+// bounds the memory used to decompress the batch, so it is validated against maxUncompressedSize first.
+func decompressReplicationTasks(
+	compressed *replicationspb.CompressedReplicationTasks,
+	maxUncompressedSize int64,
+) ([]*replicationspb.ReplicationTask, error) {
+	if uncompressedSize := compressed.GetUncompressedSize(); uncompressedSize < 0 || uncompressedSize > maxUncompressedSize {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"replication tasks uncompressed size %v is out of range [0, %v]",
+			uncompressedSize,
+			maxUncompressedSize,
+		)
+	}
+
+	var data []byte
+	switch compressed.GetCompression() {
+	case enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP:
+		reader, err := gzip.NewReader(bytes.NewReader(compressed.GetData()))
+		if err != nil {
+			return nil, err
+		}
+		// Read one byte past the expected size to detect a corrupted batch without buffering it entirely.
+		data, err = io.ReadAll(io.LimitReader(reader, compressed.GetUncompressedSize()+1))
+		if err != nil {
+			return nil, err
+		}
+	case enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD:
+		reader, err := zstd.NewReader(
+			bytes.NewReader(compressed.GetData()),
+			zstd.WithDecoderConcurrency(1),
+			// Bounds the window the frame may ask the decoder to allocate. Frames always have a window of at
+			// least MinWindowSize, even if they are smaller.
+			zstd.WithDecoderMaxMemory(uint64(max(compressed.GetUncompressedSize()+1, zstd.MinWindowSize))),
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		data, err = io.ReadAll(io.LimitReader(reader, compressed.GetUncompressedSize()+1))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, serviceerror.NewInvalidArgumentf("unknown replication stream compression: %v", compressed.GetCompression())
+	}
+	if int64(len(data)) != compressed.GetUncompressedSize() {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"replication tasks uncompressed size mismatch: expected %v, actual %v",
+			compressed.GetUncompressedSize(),
+			len(data),
+		)
+	}
+
+	tasks := &replicationspb.ReplicationTasks{}
+	if err := tasks.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal replication tasks: %w", err)
+	}
+	if len(tasks.ReplicationTasks) != int(compressed.GetTaskCount()) {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"replication tasks count mismatch: expected %v, actual %v",
+			compressed.GetTaskCount(),
+			len(tasks.ReplicationTasks),
+		)
+	}
+	return tasks.ReplicationTasks, nil
+}
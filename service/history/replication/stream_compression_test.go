@@ -0,0 +1,167 @@
+package replication
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/client/history"
+	"google.golang.org/protobuf/proto"
+)
+
+const testMaxUncompressedSize = 1024 * 1024
+
+type mapHeaderGetter map[string]string
+
+func (g mapHeaderGetter) Get(key string) string {
+	return g[key]
+}
+
+func TestNegotiateStreamCompression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		configured string
+		advertised string
+		expected   enumsspb.ReplicationStreamCompression
+	}{
+		{
+			name:       "disabled",
+			configured: "",
+			advertised: supportedStreamCompressions,
+			expected:   enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED,
+		},
+		{
+			name:       "unknown compression",
+			configured: "lz4",
+			advertised: supportedStreamCompressions,
+			expected:   enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED,
+		},
+		{
+			name:       "receiver of older version",
+			configured: streamCompressionZstd,
+			advertised: "",
+			expected:   enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED,
+		},
+		{
+			name:       "not supported by receiver",
+			configured: streamCompressionZstd,
+			advertised: streamCompressionGzip,
+			expected:   enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED,
+		},
+		{
+			name:       "supported by receiver",
+			configured: " ZSTD",
+			advertised: supportedStreamCompressions,
+			expected:   enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			getter := mapHeaderGetter{history.MetadataKeyClientReplicationCompression: tc.advertised}
+			require.Equal(t, tc.expected, negotiateStreamCompression(tc.configured, getter))
+		})
+	}
+}
+
+func TestCompressReplicationTasks(t *testing.T) {
+	t.Parallel()
+
+	var tasks []*replicationspb.ReplicationTask
+	for i := range 10 {
+		tasks = append(tasks, &replicationspb.ReplicationTask{
+			TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+			SourceTaskId: int64(i),
+			Attributes: &replicationspb.ReplicationTask_HistoryTaskAttributes{
+				HistoryTaskAttributes: &replicationspb.HistoryTaskAttributes{
+					NamespaceId: "namespace-id",
+					WorkflowId:  "workflow-id",
+					RunId:       "run-id",
+				},
+			},
+		})
+	}
+
+	for _, compression := range []enumsspb.ReplicationStreamCompression{
+		enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP,
+		enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			t.Parallel()
+
+			compressed, err := compressReplicationTasks(compression, tasks)
+			require.NoError(t, err)
+			require.Equal(t, compression, compressed.Compression)
+			require.Equal(t, int32(len(tasks)), compressed.TaskCount)
+			require.Less(t, int64(len(compressed.Data)), compressed.UncompressedSize)
+
+			decompressed, err := decompressReplicationTasks(compressed, testMaxUncompressedSize)
+			require.NoError(t, err)
+			require.Len(t, decompressed, len(tasks))
+			for i := range tasks {
+				require.True(t, proto.Equal(tasks[i], decompressed[i]))
+			}
+
+			_, err = decompressReplicationTasks(compressed, compressed.UncompressedSize-1)
+			require.Error(t, err)
+
+			uncompressedSize := compressed.UncompressedSize
+			for _, size := range []int64{-1, testMaxUncompressedSize + 1, math.MaxInt64} {
+				compressed.UncompressedSize = size
+				_, err = decompressReplicationTasks(compressed, testMaxUncompressedSize)
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+			}
+
+			compressed.UncompressedSize = uncompressedSize - 1
+			_, err = decompressReplicationTasks(compressed, testMaxUncompressedSize)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := compressReplicationTasks(enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED, tasks)
+	require.Error(t, err)
+}
+
+func TestDecompressReplicationTasks_OutputBounded(t *testing.T) {
+	t.Parallel()
+
+	// Compresses to a few kilobytes, which must not be decompressed past the declared size.
+	bomb := make([]byte, 64*1024*1024)
+	for _, compressed := range []*replicationspb.CompressedReplicationTasks{
+		{
+			Compression:      enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+			Data:             zstdEncoder.EncodeAll(bomb, nil),
+			UncompressedSize: 1024,
+		},
+		{
+			Compression:      enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+			Data:             zstdEncoder.EncodeAll(bomb, nil),
+			UncompressedSize: testMaxUncompressedSize,
+		},
+	} {
+		_, err := decompressReplicationTasks(compressed, testMaxUncompressedSize)
+		require.Error(t, err)
+	}
+}
+
+func TestDecompressReplicationTasks_Empty(t *testing.T) {
+	t.Parallel()
+
+	for _, compression := range []enumsspb.ReplicationStreamCompression{
+		enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP,
+		enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD,
+	} {
+		compressed, err := compressReplicationTasks(compression, nil)
+		require.NoError(t, err)
+		decompressed, err := decompressReplicationTasks(compressed, testMaxUncompressedSize)
+		require.NoError(t, err)
+		require.Empty(t, decompressed)
+	}
+}
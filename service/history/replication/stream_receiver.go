@@ -357,8 +357,18 @@ func (r *StreamReceiverImpl) processMessages(
 			return NewStreamError("ReplicationTask wrong receiver mode", err)
 		}
 
-		if err = ValidateTasksHaveSamePriority(priority, messages.ReplicationTasks...); err != nil {
-			// This should not happen because source side is sending task 1 by 1. Validate here just in case.
+		replicationTasks := messages.ReplicationTasks
+		if messages.CompressedReplicationTasks != nil {
+			if replicationTasks, err = decompressReplicationTasks(
+				messages.CompressedReplicationTasks,
+				int64(r.Config.ReplicationStreamReceiverMaxUncompressedSize()),
+			); err != nil {
+				return NewStreamError("ReplicationTask decompression failed", err)
+			}
+		}
+
+		if err = ValidateTasksHaveSamePriority(priority, replicationTasks...); err != nil {
+			// This should not happen because source side only batches tasks of the same priority. Validate here just in case.
 			return NewStreamError("ReplicationTask priority check failed", err)
 		}
 
@@ -366,7 +376,7 @@ func (r *StreamReceiverImpl) processMessages(
 			clusterName,
 			r.clientShardKey,
 			r.serverShardKey,
-			messages.ReplicationTasks...,
+			replicationTasks...,
 		)
 		exclusiveHighWatermark := messages.ExclusiveHighWatermark
 		exclusiveHighWatermarkTime := timestamp.TimeValue(messages.ExclusiveHighWatermarkTime)
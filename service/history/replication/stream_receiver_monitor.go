@@ -26,6 +26,8 @@ const (
 type (
 	StreamReceiverMonitor interface {
 		RegisterInboundStream(streamSender StreamSender)
+		// IsInboundStreamStuck returns whether the inbound stream wasn't making progress when last evaluated.
+		IsInboundStreamStuck(streamKey ClusterShardKeyPair) bool
 		Start()
 		Stop()
 	}
@@ -41,6 +43,7 @@ type (
 		inboundStreams  map[ClusterShardKeyPair]StreamSender
 		outboundStreams map[ClusterShardKeyPair]StreamReceiver
 		previousStatus  map[ClusterShardKeyPair]*streamStatus
+		stuckStreams    map[ClusterShardKeyPair]struct{}
 	}
 	streamStatus struct {
 		defaultAckLevel      int64
@@ -130,6 +133,16 @@ func (m *StreamReceiverMonitorImpl) RegisterInboundStream(
 	m.inboundStreams[streamKey] = streamSender
 }
 
+func (m *StreamReceiverMonitorImpl) IsInboundStreamStuck(
+	streamKey ClusterShardKeyPair,
+) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	_, ok := m.stuckStreams[streamKey]
+	return ok
+}
+
 func (m *StreamReceiverMonitorImpl) eventLoop() {
 	defer m.Stop()
 	ticker := time.NewTicker(streamReceiverMonitorInterval)
@@ -331,9 +344,16 @@ func (m *StreamReceiverMonitorImpl) monitorStreamStatus() {
 }
 
 func (m *StreamReceiverMonitorImpl) evaluateStreamStatus(currentStatusMap map[ClusterShardKeyPair]*streamStatus) {
+	stuckStreams := make(map[ClusterShardKeyPair]struct{})
 	for key, currentStatus := range currentStatusMap {
-		m.evaluateSingleStreamConnection(&key, currentStatus, m.previousStatus[key])
+		if !m.evaluateSingleStreamConnection(&key, currentStatus, m.previousStatus[key]) {
+			stuckStreams[key] = struct{}{}
+		}
 	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.stuckStreams = stuckStreams
 }
 
 func (m *StreamReceiverMonitorImpl) evaluateSingleStreamConnection(key *ClusterShardKeyPair, current *streamStatus, previous *streamStatus) bool {
@@ -736,3 +736,31 @@ func (s *streamReceiverMonitorSuite) TestEvaluateStreamStatus() {
 	),
 	)
 }
+
+func (s *streamReceiverMonitorSuite) TestIsInboundStreamStuck() {
+	stuckKey := ClusterShardKeyPair{
+		Client: NewClusterShardKey(2, 1),
+		Server: NewClusterShardKey(1, 1),
+	}
+	healthyKey := ClusterShardKeyPair{
+		Client: NewClusterShardKey(2, 2),
+		Server: NewClusterShardKey(1, 2),
+	}
+	s.streamReceiverMonitor.previousStatus = map[ClusterShardKeyPair]*streamStatus{
+		stuckKey:   {defaultAckLevel: 50, maxReplicationTaskId: 500},
+		healthyKey: {defaultAckLevel: 50, maxReplicationTaskId: 500},
+	}
+	s.False(s.streamReceiverMonitor.IsInboundStreamStuck(stuckKey))
+
+	s.streamReceiverMonitor.evaluateStreamStatus(map[ClusterShardKeyPair]*streamStatus{
+		stuckKey:   {defaultAckLevel: 50, maxReplicationTaskId: 1000},
+		healthyKey: {defaultAckLevel: 100, maxReplicationTaskId: 1000},
+	})
+	s.True(s.streamReceiverMonitor.IsInboundStreamStuck(stuckKey))
+	s.False(s.streamReceiverMonitor.IsInboundStreamStuck(healthyKey))
+
+	s.streamReceiverMonitor.evaluateStreamStatus(map[ClusterShardKeyPair]*streamStatus{
+		stuckKey: {defaultAckLevel: 500, maxReplicationTaskId: 1000},
+	})
+	s.False(s.streamReceiverMonitor.IsInboundStreamStuck(stuckKey))
+}
@@ -304,6 +304,49 @@ func (s *streamReceiverSuite) TestProcessMessage_TrackSubmit_SingleStack() {
 	s.Equal(ReceiverModeSingleStack, s.streamReceiver.receiverMode)
 }
 
+func (s *streamReceiverSuite) TestProcessMessage_TrackSubmit_CompressedTasks() {
+	replicationTasks := []*replicationspb.ReplicationTask{
+		{
+			TaskType:       enumsspb.ReplicationTaskType(-1),
+			SourceTaskId:   rand.Int63(),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+		},
+		{
+			TaskType:       enumsspb.ReplicationTaskType(-1),
+			SourceTaskId:   rand.Int63(),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+		},
+	}
+	compressed, err := compressReplicationTasks(enumsspb.REPLICATION_STREAM_COMPRESSION_GZIP, replicationTasks)
+	s.NoError(err)
+	streamResp := StreamResp[*adminservice.StreamWorkflowReplicationMessagesResponse]{
+		Resp: &adminservice.StreamWorkflowReplicationMessagesResponse{
+			Attributes: &adminservice.StreamWorkflowReplicationMessagesResponse_Messages{
+				Messages: &replicationspb.WorkflowReplicationMessages{
+					CompressedReplicationTasks: compressed,
+					ExclusiveHighWatermark:     rand.Int63(),
+					ExclusiveHighWatermarkTime: timestamppb.New(time.Unix(0, rand.Int63())),
+				},
+			},
+		},
+		Err: nil,
+	}
+	s.stream.respChan <- streamResp
+	close(s.stream.respChan)
+
+	s.highPriorityTaskTracker.EXPECT().TrackTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(highWatermarkInfo WatermarkInfo, tasks ...TrackableExecutableTask) []TrackableExecutableTask {
+			s.Equal(streamResp.Resp.GetMessages().ExclusiveHighWatermark, highWatermarkInfo.Watermark)
+			s.Len(tasks, 2)
+			return tasks
+		},
+	)
+
+	err = s.streamReceiver.processMessages(s.stream)
+	s.NoError(err)
+	s.Len(s.taskScheduler.tasks, 2)
+}
+
 func (s *streamReceiverSuite) TestProcessMessage_TrackSubmit_SingleStack_ReceivedPrioritizedTask() {
 	s.streamReceiver.receiverMode = ReceiverModeSingleStack
 	replicationTask := &replicationspb.ReplicationTask{
@@ -33,6 +33,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -66,6 +67,22 @@ type (
 		flowController          SenderFlowController
 		sendLock                sync.Mutex
 		ssRateLimiter           ServerSchedulerRateLimiter
+		compression             enumsspb.ReplicationStreamCompression
+	}
+
+	// replicationTaskBatch holds the replication tasks of one priority waiting to be sent in one message. It is
+	// locked while it is added to or sent, as flushTimer sends it from its own goroutine.
+	replicationTaskBatch struct {
+		sync.Mutex
+		tasks     []*replicationspb.ReplicationTask
+		size      int
+		startTime time.Time
+		// flushTimer sends the batch once it has been held for the max batch delay, in case no later task does.
+		flushTimer *time.Timer
+		generation int
+		// flushErr is the error of sending the batch from flushTimer. It is returned by the next send of the batch,
+		// which tears the stream down.
+		flushErr error
 	}
 )
 
@@ -111,6 +128,10 @@ func NewStreamSender(
 		readerGroup:             newReaderGroupIfEnabled(config.EnableReplicationReaderGroup, shardContext, clientShardKey, tieredStackEnabled, logger),
 		flowController:          NewSenderFlowController(config, logger),
 		ssRateLimiter:           ssRateLimiter,
+		compression: negotiateStreamCompression(
+			config.ReplicationStreamSenderCompression(),
+			headers.NewGRPCHeaderGetter(server.Context()),
+		),
 	}
 }
 
@@ -530,6 +551,8 @@ func (s *StreamSenderImpl) sendTasks(
 		return err
 	}
 	skipCount := 0
+	batch := &replicationTaskBatch{}
+	defer batch.stop()
 Loop:
 	for iter.HasNext() {
 		if s.shutdownChan.IsShutdown() {
@@ -546,15 +569,12 @@ Loop:
 		// so it will not ACK back to sender, sender will not update the ACK level.
 		// i.e. in tiered stack, if no low priority task in queue, we should still send watermark info to receiver to let it update ACK level.
 		if skipCount > TaskMaxSkipCount {
-			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
-				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-					Messages: &replicationspb.WorkflowReplicationMessages{
-						ExclusiveHighWatermark:     item.GetTaskID(),
-						ExclusiveHighWatermarkTime: timestamppb.New(item.GetVisibilityTime()),
-						Priority:                   priority,
-					},
-				},
-			}); err != nil {
+			if err := s.sendBatch(
+				priority,
+				batch,
+				item.GetTaskID(),
+				timestamppb.New(item.GetVisibilityTime()),
+			); err != nil {
 				return err
 			}
 			skipCount = 0
@@ -606,6 +626,12 @@ Loop:
 			}
 			task.Priority = priority
 			if s.isTieredStackEnabled {
+				// Don't hold the batched tasks while the receiver is paused.
+				if batch.hasTasks() && s.flowController.IsPaused(priority) {
+					if err := s.sendBatchUpToLastTask(priority, batch); err != nil {
+						return s.recordRetry(item, task.GetTaskType(), priority, attempt, wideevents.ReplOperationStreamSend, fmt.Errorf("send: %w", err))
+					}
+				}
 				if err := s.flowController.Wait(s.server.Context(), priority); err != nil {
 					if errors.Is(err, context.Canceled) {
 						return err
@@ -637,25 +663,10 @@ Loop:
 			if s.config.EmitReplicationLifecycleEvents() {
 				s.emitReplicationSent(task, item)
 			}
-			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
-				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-					Messages: &replicationspb.WorkflowReplicationMessages{
-						ReplicationTasks:           []*replicationspb.ReplicationTask{task},
-						ExclusiveHighWatermark:     task.SourceTaskId + 1,
-						ExclusiveHighWatermarkTime: task.VisibilityTime,
-						Priority:                   priority,
-					},
-				},
-			}); err != nil {
+			if err := s.addToBatch(priority, batch, task); err != nil {
 				return s.recordRetry(item, task.GetTaskType(), priority, attempt, wideevents.ReplOperationStreamSend, fmt.Errorf("send: %w", err))
 			}
 			skipCount = 0
-			metrics.ReplicationTasksSend.With(s.metrics).Record(
-				int64(1),
-				metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
-				metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
-				metrics.OperationTag(TaskOperationTag(task)),
-			)
 			return nil
 		}
 
@@ -714,16 +725,169 @@ Loop:
 			return fmt.Errorf("failed to send task: %v, cause: %w", item, err)
 		}
 	}
-	return s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
+	return s.sendBatch(priority, batch, endExclusiveWatermark, timestamp.TimeNowPtrUtc())
+}
+
+// addToBatch adds the task to the batch, and sends the batch once it is full. The batch is sent before adding the
+// task if the task would make it exceed the max batch size. A batch which is not full is sent by its flush timer once
+// it has been held for the max batch delay.
+func (s *StreamSenderImpl) addToBatch(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+	task *replicationspb.ReplicationTask,
+) error {
+	batch.Lock()
+	defer batch.Unlock()
+	if batch.flushErr != nil {
+		return batch.flushErr
+	}
+
+	taskSize := proto.Size(task)
+	maxBytes := s.config.ReplicationStreamSenderBatchMaxBytes()
+	maxDelay := s.config.ReplicationStreamSenderBatchMaxDelay()
+	if !batch.isEmpty() && batch.size+taskSize > maxBytes {
+		if err := s.sendBatchUpToLastTaskLocked(priority, batch); err != nil {
+			return err
+		}
+	}
+	if batch.isEmpty() {
+		generation := batch.generation
+		batch.flushTimer = time.AfterFunc(maxDelay, func() {
+			s.flushDelayedBatch(priority, batch, generation)
+		})
+	}
+	batch.add(task, taskSize)
+	if len(batch.tasks) >= s.config.ReplicationStreamSenderBatchMaxTasks() ||
+		batch.size >= maxBytes ||
+		time.Since(batch.startTime) >= maxDelay {
+		return s.sendBatchUpToLastTaskLocked(priority, batch)
+	}
+	return nil
+}
+
+// flushDelayedBatch sends the batch from its flush timer, unless it was sent since the timer was started.
+func (s *StreamSenderImpl) flushDelayedBatch(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+	generation int,
+) {
+	batch.Lock()
+	defer batch.Unlock()
+	if batch.generation != generation || batch.isEmpty() || batch.flushErr != nil {
+		return
+	}
+	batch.flushErr = s.sendBatchUpToLastTaskLocked(priority, batch)
+}
+
+// sendBatchUpToLastTask sends the batched tasks, if any, with the watermark right after the last task.
+func (s *StreamSenderImpl) sendBatchUpToLastTask(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+) error {
+	batch.Lock()
+	defer batch.Unlock()
+	if batch.flushErr != nil {
+		return batch.flushErr
+	}
+	if batch.isEmpty() {
+		return nil
+	}
+	return s.sendBatchUpToLastTaskLocked(priority, batch)
+}
+
+func (s *StreamSenderImpl) sendBatchUpToLastTaskLocked(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+) error {
+	lastTask := batch.tasks[len(batch.tasks)-1]
+	return s.sendBatchLocked(priority, batch, lastTask.SourceTaskId+1, lastTask.VisibilityTime)
+}
+
+// sendBatch sends the batched tasks, if any, along with the watermark, compressing the tasks if the receiver
+// supports it. The batch is always emptied: on error the stream is torn down and the receiver will resume from its
+// own ack level.
+func (s *StreamSenderImpl) sendBatch(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+	exclusiveHighWatermark int64,
+	exclusiveHighWatermarkTime *timestamppb.Timestamp,
+) error {
+	batch.Lock()
+	defer batch.Unlock()
+	if batch.flushErr != nil {
+		return batch.flushErr
+	}
+	return s.sendBatchLocked(priority, batch, exclusiveHighWatermark, exclusiveHighWatermarkTime)
+}
+
+func (s *StreamSenderImpl) sendBatchLocked(
+	priority enumsspb.TaskPriority,
+	batch *replicationTaskBatch,
+	exclusiveHighWatermark int64,
+	exclusiveHighWatermarkTime *timestamppb.Timestamp,
+) error {
+	defer batch.reset()
+
+	messages := &replicationspb.WorkflowReplicationMessages{
+		ReplicationTasks:           batch.tasks,
+		ExclusiveHighWatermark:     exclusiveHighWatermark,
+		ExclusiveHighWatermarkTime: exclusiveHighWatermarkTime,
+		Priority:                   priority,
+	}
+	if !batch.isEmpty() && s.compression != enumsspb.REPLICATION_STREAM_COMPRESSION_UNSPECIFIED {
+		compressed, err := compressReplicationTasks(s.compression, batch.tasks)
+		if err != nil {
+			return err
+		}
+		messages.ReplicationTasks = nil
+		messages.CompressedReplicationTasks = compressed
+		s.recordCompression(priority, compressed)
+	}
+	if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
 		Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-			Messages: &replicationspb.WorkflowReplicationMessages{
-				ReplicationTasks:           nil,
-				ExclusiveHighWatermark:     endExclusiveWatermark,
-				ExclusiveHighWatermarkTime: timestamp.TimeNowPtrUtc(),
-				Priority:                   priority,
-			},
+			Messages: messages,
 		},
-	})
+	}); err != nil {
+		return err
+	}
+
+	if batch.isEmpty() {
+		return nil
+	}
+	metrics.ReplicationStreamBatchSize.With(s.metrics).Record(
+		int64(len(batch.tasks)),
+		metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+		metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+		metrics.ReplicationTaskPriorityTag(priority),
+	)
+	for _, task := range batch.tasks {
+		metrics.ReplicationTasksSend.With(s.metrics).Record(
+			int64(1),
+			metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+			metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+			metrics.OperationTag(TaskOperationTag(task)),
+		)
+	}
+	return nil
+}
+
+func (s *StreamSenderImpl) recordCompression(
+	priority enumsspb.TaskPriority,
+	compressed *replicationspb.CompressedReplicationTasks,
+) {
+	tags := []metrics.Tag{
+		metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+		metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+		metrics.ReplicationTaskPriorityTag(priority),
+	}
+	metrics.ReplicationStreamUncompressedBytes.With(s.metrics).Record(compressed.GetUncompressedSize(), tags...)
+	metrics.ReplicationStreamCompressedBytes.With(s.metrics).Record(int64(len(compressed.GetData())), tags...)
+	if compressed.GetUncompressedSize() > 0 {
+		metrics.ReplicationStreamCompressionRatio.With(s.metrics).Record(
+			int64(len(compressed.GetData()))*100/compressed.GetUncompressedSize(),
+			tags...,
+		)
+	}
 }
 
 func (s *StreamSenderImpl) sendToStream(payload *historyservice.StreamWorkflowReplicationMessagesResponse) error {
@@ -737,7 +901,14 @@ func (s *StreamSenderImpl) sendToStream(payload *historyservice.StreamWorkflowRe
 }
 
 func (s *StreamSenderImpl) shouldProcessTask(item tasks.Task) bool {
-	clientShardID := common.WorkflowIDToHistoryShard(item.GetNamespaceID(), item.GetWorkflowID(), s.clientClusterShardCount)
+	// The task is on the shard its workflow is placed on in this cluster. A client cluster with the same number of
+	// shards places it on the same shard, which also holds for namespaces assigned to a shard pool as long as both
+	// clusters define the pool the same way. Shard pools are not supported between clusters with different numbers of
+	// shards, so workflows are mapped to all shards of the client cluster then.
+	clientShardID := s.serverShardKey.ShardID
+	if s.clientClusterShardCount != s.config.NumberOfShards {
+		clientShardID = common.WorkflowIDToHistoryShard(item.GetNamespaceID(), item.GetWorkflowID(), s.clientClusterShardCount)
+	}
 	if clientShardID != s.clientShardKey.ShardID {
 		return false
 	}
@@ -884,3 +1055,39 @@ func isSkippable(err error) bool {
 	var convErr *convertError
 	return errors.As(err, &convErr) && isRetryableError(err)
 }
+
+func (b *replicationTaskBatch) add(task *replicationspb.ReplicationTask, size int) {
+	if b.isEmpty() {
+		b.startTime = time.Now()
+	}
+	b.tasks = append(b.tasks, task)
+	b.size += size
+}
+
+func (b *replicationTaskBatch) isEmpty() bool {
+	return len(b.tasks) == 0
+}
+
+func (b *replicationTaskBatch) reset() {
+	if b.flushTimer != nil {
+		b.flushTimer.Stop()
+		b.flushTimer = nil
+	}
+	b.generation++
+	b.tasks = nil
+	b.size = 0
+}
+
+// hasTasks reports whether the batch holds tasks which were not sent yet.
+func (b *replicationTaskBatch) hasTasks() bool {
+	b.Lock()
+	defer b.Unlock()
+	return !b.isEmpty()
+}
+
+// stop drops the batch and its flush timer once the tasks are no longer sent.
+func (b *replicationTaskBatch) stop() {
+	b.Lock()
+	defer b.Unlock()
+	b.reset()
+}
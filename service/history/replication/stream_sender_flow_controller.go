@@ -26,6 +26,8 @@ type (
 	SenderFlowController interface {
 		// Wait will block go routine until the sender is allowed to send a task
 		Wait(ctx context.Context, priority enumsspb.TaskPriority) error
+		// IsPaused returns true if the receiver asked the sender to pause sending tasks of the priority
+		IsPaused(priority enumsspb.TaskPriority) bool
 		RefreshReceiverFlowControlInfo(syncState *replicationspb.SyncReplicationState)
 	}
 	SenderFlowControllerImpl struct {
@@ -113,3 +115,13 @@ func (s *SenderFlowControllerImpl) Wait(ctx context.Context, priority enumsspb.T
 	state.mu.Unlock()
 	return waitForRateLimiter(state.rateLimiter)
 }
+
+func (s *SenderFlowControllerImpl) IsPaused(priority enumsspb.TaskPriority) bool {
+	state, ok := s.flowControlStates[priority]
+	if !ok {
+		return false
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return !state.resume
+}
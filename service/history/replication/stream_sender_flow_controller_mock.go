@@ -42,6 +42,20 @@ func (m *MockSenderFlowController) EXPECT() *MockSenderFlowControllerMockRecorde
 	return m.recorder
 }
 
+// IsPaused mocks base method.
+func (m *MockSenderFlowController) IsPaused(priority enums.TaskPriority) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused", priority)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockSenderFlowControllerMockRecorder) IsPaused(priority any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockSenderFlowController)(nil).IsPaused), priority)
+}
+
 // RefreshReceiverFlowControlInfo mocks base method.
 func (m *MockSenderFlowController) RefreshReceiverFlowControlInfo(syncState *repication.SyncReplicationState) {
 	m.ctrl.T.Helper()
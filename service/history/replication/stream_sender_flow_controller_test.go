@@ -124,6 +124,9 @@ func (s *senderFlowControllerSuite) TestRefreshReceiverFlowControlInfo() {
 
 	s.True(senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_HIGH].resume)
 	s.False(senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_LOW].resume)
+	s.False(senderFlowCtrlImpl.IsPaused(enumsspb.TASK_PRIORITY_HIGH))
+	s.True(senderFlowCtrlImpl.IsPaused(enumsspb.TASK_PRIORITY_LOW))
+	s.False(senderFlowCtrlImpl.IsPaused(enumsspb.TASK_PRIORITY_UNSPECIFIED))
 }
 
 func (s *senderFlowControllerSuite) TestPauseToResume() {
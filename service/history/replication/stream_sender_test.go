@@ -895,6 +895,222 @@ func (s *streamSenderSuite) TestSendTasks_WithTasks() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestSendTasks_BatchedAndCompressed() {
+	s.streamSender.isTieredStackEnabled = false
+	s.streamSender.compression = enumsspb.REPLICATION_STREAM_COMPRESSION_ZSTD
+	s.config.ReplicationStreamSenderBatchMaxTasks = dynamicconfig.GetIntPropertyFn(2)
+	s.config.ReplicationStreamSenderBatchMaxDelay = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+
+	var items []tasks.Task
+	var replicationTasks []*replicationspb.ReplicationTask
+	for i, workflowID := range []string{"1", "2", "1"} {
+		item := tasks.NewMockTask(s.controller)
+		item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+		item.EXPECT().GetWorkflowID().Return(workflowID).AnyTimes()
+		item.EXPECT().GetVisibilityTime().Return(time.Now().UTC()).AnyTimes()
+		item.EXPECT().GetType().Return(enumsspb.TASK_TYPE_REPLICATION_HISTORY).AnyTimes()
+		task := &replicationspb.ReplicationTask{
+			TaskType:       enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+			SourceTaskId:   beginInclusiveWatermark + int64(i),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+		}
+		s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID, enumsspb.TASK_PRIORITY_UNSPECIFIED).Return(task, nil)
+		items = append(items, item)
+		replicationTasks = append(replicationTasks, task)
+	}
+
+	iter := collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			return items, nil, nil
+		},
+	)
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(iter, nil)
+
+	expectBatch := func(expectedTasks []*replicationspb.ReplicationTask, exclusiveHighWatermark int64) func(*historyservice.StreamWorkflowReplicationMessagesResponse) error {
+		return func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+			messages := resp.GetMessages()
+			s.Empty(messages.ReplicationTasks)
+			s.Equal(exclusiveHighWatermark, messages.ExclusiveHighWatermark)
+			actualTasks, err := decompressReplicationTasks(
+				messages.CompressedReplicationTasks,
+				int64(s.config.ReplicationStreamReceiverMaxUncompressedSize()),
+			)
+			s.NoError(err)
+			s.Len(actualTasks, len(expectedTasks))
+			for i := range expectedTasks {
+				s.True(proto.Equal(expectedTasks[i], actualTasks[i]))
+			}
+			return nil
+		}
+	}
+	gomock.InOrder(
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(expectBatch(replicationTasks[:2], replicationTasks[1].SourceTaskId+1)),
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(expectBatch(replicationTasks[2:], endExclusiveWatermark)),
+	)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_UNSPECIFIED,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+}
+
+func (s *streamSenderSuite) TestSendTasks_BatchFlushedAfterMaxDelay() {
+	s.streamSender.isTieredStackEnabled = false
+	s.config.ReplicationStreamSenderBatchMaxTasks = dynamicconfig.GetIntPropertyFn(10)
+	s.config.ReplicationStreamSenderBatchMaxDelay = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+
+	item := tasks.NewMockTask(s.controller)
+	item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+	item.EXPECT().GetWorkflowID().Return("1").AnyTimes()
+	item.EXPECT().GetVisibilityTime().Return(time.Now().UTC()).AnyTimes()
+	item.EXPECT().GetType().Return(enumsspb.TASK_TYPE_REPLICATION_HISTORY).AnyTimes()
+	task := &replicationspb.ReplicationTask{
+		TaskType:       enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+		SourceTaskId:   beginInclusiveWatermark,
+		VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+	}
+	s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID, enumsspb.TASK_PRIORITY_UNSPECIFIED).Return(task, nil)
+
+	// The second page is only loaded once the first task was sent by the flush timer.
+	flushed := make(chan struct{})
+	iter := collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			if paginationToken == nil {
+				return []tasks.Task{item}, []byte("next"), nil
+			}
+			<-flushed
+			return nil, nil, nil
+		},
+	)
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(iter, nil)
+
+	gomock.InOrder(
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+			s.Len(resp.GetMessages().GetReplicationTasks(), 1)
+			s.True(proto.Equal(task, resp.GetMessages().GetReplicationTasks()[0]))
+			s.Equal(task.SourceTaskId+1, resp.GetMessages().GetExclusiveHighWatermark())
+			close(flushed)
+			return nil
+		}),
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+			s.Empty(resp.GetMessages().GetReplicationTasks())
+			s.Equal(endExclusiveWatermark, resp.GetMessages().GetExclusiveHighWatermark())
+			return nil
+		}),
+	)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_UNSPECIFIED,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+}
+
+func (s *streamSenderSuite) TestSendTasks_TieredStack_FlushBatchOnPause() {
+	s.streamSender.isTieredStackEnabled = true
+	s.config.ReplicationStreamSenderBatchMaxTasks = dynamicconfig.GetIntPropertyFn(10)
+	s.config.ReplicationStreamSenderBatchMaxDelay = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+
+	var items []tasks.Task
+	var replicationTasks []*replicationspb.ReplicationTask
+	for i, workflowID := range []string{"1", "2"} {
+		item := &tasks.SyncWorkflowStateTask{
+			WorkflowKey:         definition.NewWorkflowKey("1", workflowID, ""),
+			VisibilityTimestamp: time.Now().UTC(),
+			TaskID:              beginInclusiveWatermark + int64(i),
+			Priority:            enumsspb.TASK_PRIORITY_LOW,
+		}
+		task := &replicationspb.ReplicationTask{
+			TaskType:       enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK,
+			SourceTaskId:   item.TaskID,
+			VisibilityTime: timestamppb.New(item.VisibilityTimestamp),
+			Priority:       enumsspb.TASK_PRIORITY_LOW,
+		}
+		s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID, enumsspb.TASK_PRIORITY_LOW).Return(task, nil)
+		items = append(items, item)
+		replicationTasks = append(replicationTasks, task)
+	}
+
+	iter := collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			return items, nil, nil
+		},
+	)
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	mockRegistry.EXPECT().GetNamespaceName(namespace.ID("1")).Return(namespace.Name("test"), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(iter, nil)
+
+	gomock.InOrder(
+		s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_LOW).Return(nil),
+		s.senderFlowController.EXPECT().IsPaused(enumsspb.TASK_PRIORITY_LOW).Return(true),
+		// the first task is sent before blocking on the paused receiver
+		s.server.EXPECT().Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
+			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
+				Messages: &replicationspb.WorkflowReplicationMessages{
+					ReplicationTasks:           replicationTasks[:1],
+					ExclusiveHighWatermark:     replicationTasks[0].SourceTaskId + 1,
+					ExclusiveHighWatermarkTime: replicationTasks[0].VisibilityTime,
+					Priority:                   enumsspb.TASK_PRIORITY_LOW,
+				},
+			},
+		}).Return(nil),
+		s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_LOW).Return(nil),
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+			s.Equal(replicationTasks[1:], resp.GetMessages().ReplicationTasks)
+			s.Equal(endExclusiveWatermark, resp.GetMessages().ExclusiveHighWatermark)
+			return nil
+		}),
+	)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_LOW,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+}
+
 func (s *streamSenderSuite) TestSendTasks_TieredStack_HighPriority() {
 	s.streamSender.isTieredStackEnabled = true
 	beginInclusiveWatermark := rand.Int63()
@@ -22,7 +22,6 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
-	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -353,12 +352,15 @@ func (p *taskProcessorImpl) handleReplicationDLQTask(
 	// Route the DLQ entry to the shard that owns the workflow on this (target) cluster,
 	// not the processor's shard. In cross-shard replication (source shard count < target
 	// shard count), the processor shard does not always equal the owner shard. Using
-	// p.config.GetShardID matches what handler.GetDLQReplicationMessages uses to dispatch,
-	// so the operator-facing "DLQ for workflow W is at GetShardID(W)" model holds.
-	targetShardID := p.config.GetShardID(
-		namespace.ID(request.TaskInfo.GetNamespaceId()),
+	// the shard pool resolver matches what handler.GetDLQReplicationMessages uses to dispatch,
+	// so the operator-facing "DLQ for workflow W is at the shard of W" model holds.
+	targetShardID, err := p.shard.GetShardPools().ShardID(
+		request.TaskInfo.GetNamespaceId(),
 		request.TaskInfo.GetWorkflowId(),
 	)
+	if err != nil {
+		return err
+	}
 
 	p.logger.Info("enqueue replication task to DLQ",
 		tag.TargetShardID(targetShardID),
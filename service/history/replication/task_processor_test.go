@@ -20,6 +20,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -246,7 +247,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_SyncActivity() {
 		},
 	}
 	persistedRequest := &persistence.PutReplicationTaskToDLQRequest{
-		ShardID:           s.config.GetShardID(namespace.ID(namespaceID), workflowID),
+		ShardID:           s.expectShardID(namespaceID, workflowID),
 		SourceClusterName: request.SourceClusterName,
 		TaskInfo:          request.TaskInfo,
 	}
@@ -272,7 +273,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_SyncWorkflowState() {
 		},
 	}
 	persistedRequest := &persistence.PutReplicationTaskToDLQRequest{
-		ShardID:           s.config.GetShardID(namespace.ID(namespaceID), workflowID),
+		ShardID:           s.expectShardID(namespaceID, workflowID),
 		SourceClusterName: request.SourceClusterName,
 		TaskInfo:          request.TaskInfo,
 	}
@@ -300,7 +301,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_History() {
 		},
 	}
 	persistedRequest := &persistence.PutReplicationTaskToDLQRequest{
-		ShardID:           s.config.GetShardID(namespace.ID(namespaceID), workflowID),
+		ShardID:           s.expectShardID(namespaceID, workflowID),
 		SourceClusterName: request.SourceClusterName,
 		TaskInfo:          request.TaskInfo,
 	}
@@ -644,3 +645,12 @@ func (s *taskProcessorSuite) TestPaginationFn_Error() {
 		s.Equal(rxTaskBackoff, s.replicationTaskProcessor.rxTaskBackoff)
 	}
 }
+
+// expectShardID returns the shard of the given workflow of a namespace that is not assigned to a shard pool.
+func (s *taskProcessorSuite) expectShardID(namespaceID string, workflowID string) int32 {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespace.ID(namespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: namespaceID}, nil, cluster.TestCurrentClusterName),
+		nil,
+	).AnyTimes()
+	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.config.NumberOfShards)
+}
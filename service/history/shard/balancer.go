@@ -0,0 +1,261 @@
+package shard
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	shardBalancerRPCTimeout = 10 * time.Second
+	// shardBalancerLeaderKey is the key membership assigns to the history host which balances the shards.
+	shardBalancerLeaderKey = "shard-balancer"
+)
+
+type (
+	// shardBalancer periodically samples the load of the shards owned by this host. When the shard balancer is enabled,
+	// the leader of the history hosts, i.e. the host membership assigns the balancer to, gathers the load of every host
+	// and, if the most loaded host is more loaded than the others, pins one of its shards to the least loaded host.
+	// Shards pinned to a host are never moved by the balancer, so shards it moved are not moved again unless they are
+	// unpinned.
+	//
+	// The load of a shard is the number of write requests and tasks it handles per second. Hosts report their load,
+	// as of their last sample, through DescribeHistoryHost.
+	shardBalancer struct {
+		config                 *configs.Config
+		controller             *ControllerImpl
+		historyClient          historyservice.HistoryServiceClient
+		historyServiceResolver membership.ServiceResolver
+		hostInfoProvider       membership.HostInfoProvider
+		logger                 log.Logger
+		goros                  goro.Group
+
+		sync.Mutex
+		lastSampleTime time.Time
+		lastCounts     map[int32]int64
+		hostLoad       float64
+		hostShardLoads map[int32]float64
+	}
+
+	// historyHostLoad is the load of a history host as of its last sample.
+	historyHostLoad struct {
+		host           membership.HostInfo
+		load           float64
+		shardLoads     map[int32]float64
+		pinnedShardIDs []int32
+	}
+
+	// loadCounter is implemented by shard contexts that count their load.
+	loadCounter interface {
+		loadCount() int64
+	}
+)
+
+func newShardBalancer(
+	config *configs.Config,
+	controller *ControllerImpl,
+	historyClient historyservice.HistoryServiceClient,
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+) *shardBalancer {
+	return &shardBalancer{
+		config:                 config,
+		controller:             controller,
+		historyClient:          historyClient,
+		historyServiceResolver: historyServiceResolver,
+		hostInfoProvider:       hostInfoProvider,
+		logger:                 logger,
+	}
+}
+
+func (b *shardBalancer) start() {
+	b.goros.Go(func(ctx context.Context) error {
+		b.loop(ctx)
+		return nil
+	})
+}
+
+func (b *shardBalancer) stop() {
+	b.goros.Cancel()
+	b.goros.Wait()
+}
+
+func (b *shardBalancer) loop(ctx context.Context) {
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundHighCallerInfo)
+	timer := time.NewTimer(b.config.ShardBalancerInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			b.sample()
+			if b.config.ShardBalancerEnabled() && b.isLeader() {
+				b.balance(ctx)
+			}
+			timer.Reset(b.config.ShardBalancerInterval())
+		}
+	}
+}
+
+// load returns the load of this host as of the last sample.
+func (b *shardBalancer) load() float64 {
+	b.Lock()
+	defer b.Unlock()
+	return b.hostLoad
+}
+
+// shardLoads returns the load of each shard owned by this host as of the last sample.
+func (b *shardBalancer) shardLoads() map[int32]float64 {
+	b.Lock()
+	defer b.Unlock()
+	return maps.Clone(b.hostShardLoads)
+}
+
+// sample returns the load of each shard owned by this host since the previous sample. Shards acquired since the
+// previous sample are left out.
+func (b *shardBalancer) sample() map[int32]float64 {
+	counts := b.controller.shardLoadCounts()
+	now := time.Now()
+
+	b.Lock()
+	defer b.Unlock()
+
+	shardLoads := make(map[int32]float64, len(counts))
+	hostLoad := 0.0
+	if elapsed := now.Sub(b.lastSampleTime).Seconds(); b.lastCounts != nil && elapsed > 0 {
+		for shardID, count := range counts {
+			lastCount, ok := b.lastCounts[shardID]
+			if !ok || count < lastCount {
+				// The shard was acquired, or re-acquired, since the last sample.
+				continue
+			}
+			shardLoads[shardID] = float64(count-lastCount) / elapsed
+			hostLoad += shardLoads[shardID]
+		}
+	}
+	b.lastSampleTime = now
+	b.lastCounts = counts
+	b.hostLoad = hostLoad
+	b.hostShardLoads = shardLoads
+	return shardLoads
+}
+
+// isLeader returns true if this host balances the shards of all history hosts, so that the load of the hosts is
+// gathered once per interval rather than by every host.
+func (b *shardBalancer) isLeader() bool {
+	leader, err := b.historyServiceResolver.Lookup(shardBalancerLeaderKey)
+	if err != nil {
+		return false
+	}
+	return leader.Identity() == b.hostInfoProvider.HostInfo().Identity()
+}
+
+// balance moves at most one shard from the most loaded history host to the least loaded one, if the most loaded
+// host's load exceeds the average load of all history hosts by more than the configured threshold.
+func (b *shardBalancer) balance(ctx context.Context) {
+	self := b.hostInfoProvider.HostInfo()
+	hosts := []historyHostLoad{{
+		host:           self,
+		load:           b.load(),
+		shardLoads:     b.shardLoads(),
+		pinnedShardIDs: b.controller.placement.pinnedShardIDs(),
+	}}
+	for _, member := range b.historyServiceResolver.AvailableMembers() {
+		if member.Identity() == self.Identity() {
+			continue
+		}
+		hostLoad, err := b.describeLoad(ctx, member)
+		if err != nil {
+			b.logger.Warn("Shard balancer unable to get load of history host", tag.Address(member.GetAddress()), tag.Error(err))
+			return
+		}
+		hosts = append(hosts, hostLoad)
+	}
+	if len(hosts) < 2 {
+		return
+	}
+
+	source, target := hosts[0], hosts[0]
+	totalLoad := 0.0
+	for _, host := range hosts {
+		totalLoad += host.load
+		if host.load > source.load {
+			source = host
+		}
+		if host.load < target.load {
+			target = host
+		}
+	}
+	averageLoad := totalLoad / float64(len(hosts))
+	if averageLoad <= 0 || source.load <= averageLoad*(1+b.config.ShardBalancerLoadThreshold()) {
+		return
+	}
+
+	// Only move a shard that leaves the target no more loaded than the source.
+	shardID, ok := pickShard(source, (source.load-target.load)/2)
+	if !ok {
+		return
+	}
+
+	rpcCtx, cancel := context.WithTimeout(ctx, shardBalancerRPCTimeout)
+	defer cancel()
+	if _, err := b.historyClient.PinShard(rpcCtx, &historyservice.PinShardRequest{
+		HostAddress: target.host.GetAddress(),
+		ShardId:     shardID,
+	}); err != nil {
+		b.logger.Warn("Shard balancer unable to move shard", tag.ShardID(shardID), tag.Address(target.host.GetAddress()), tag.Error(err))
+		return
+	}
+	b.logger.Info("Shard balancer moved shard",
+		tag.ShardID(shardID),
+		tag.NewStringTag("source-address", source.host.GetAddress()),
+		tag.Address(target.host.GetAddress()),
+		tag.NewFloat64("shard-load", source.shardLoads[shardID]),
+		tag.NewFloat64("source-load", source.load),
+		tag.NewFloat64("target-load", target.load),
+	)
+}
+
+// pickShard returns the most loaded shard of the host, not pinned to it, whose load does not exceed maxLoad.
+func pickShard(host historyHostLoad, maxLoad float64) (int32, bool) {
+	var pick int32
+	pickLoad := 0.0
+	for shardID, shardLoad := range host.shardLoads {
+		if shardLoad <= pickLoad || shardLoad > maxLoad || slices.Contains(host.pinnedShardIDs, shardID) {
+			continue
+		}
+		pick = shardID
+		pickLoad = shardLoad
+	}
+	return pick, pickLoad > 0
+}
+
+func (b *shardBalancer) describeLoad(ctx context.Context, host membership.HostInfo) (historyHostLoad, error) {
+	ctx, cancel := context.WithTimeout(ctx, shardBalancerRPCTimeout)
+	defer cancel()
+	resp, err := b.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+		HostAddress: host.GetAddress(),
+	})
+	if err != nil {
+		return historyHostLoad{}, err
+	}
+	return historyHostLoad{
+		host:           host,
+		load:           resp.GetLoad(),
+		shardLoads:     resp.GetShardLoads(),
+		pinnedShardIDs: resp.GetPinnedShardIds(),
+	}, nil
+}
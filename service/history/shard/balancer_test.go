@@ -0,0 +1,148 @@
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+type countingShard struct {
+	*historyi.MockControllableContext
+	count int64
+}
+
+func (s *countingShard) loadCount() int64 {
+	return s.count
+}
+
+func newTestShardBalancer(t *testing.T) (
+	*shardBalancer,
+	*membership.MockServiceResolver,
+	*historyservicemock.MockHistoryServiceClient,
+) {
+	controller := gomock.NewController(t)
+	resolver := membership.NewMockServiceResolver(controller)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	hostInfoProvider := membership.NewMockHostInfoProvider(controller)
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("self")).AnyTimes()
+	monitor := membership.NewMockMonitor(controller)
+	monitor.EXPECT().SetLabel(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	config := tests.NewDynamicConfig()
+	config.ShardBalancerLoadThreshold = dynamicconfig.GetFloatPropertyFn(0.25)
+	shardController := &ControllerImpl{
+		historyShards: make(map[int32]historyi.ControllableContext),
+		placement:     newPlacement(resolver, hostInfoProvider, monitor, log.NewNoopLogger()),
+	}
+	balancer := newShardBalancer(config, shardController, historyClient, resolver, hostInfoProvider, log.NewNoopLogger())
+	return balancer, resolver, historyClient
+}
+
+func TestShardBalancer_Sample(t *testing.T) {
+	b, _, _ := newTestShardBalancer(t)
+	controller := gomock.NewController(t)
+
+	shards := map[int32]*countingShard{}
+	for _, shardID := range []int32{1, 2} {
+		shard := &countingShard{MockControllableContext: historyi.NewMockControllableContext(controller)}
+		shard.EXPECT().IsValid().Return(true).AnyTimes()
+		shards[shardID] = shard
+		b.controller.historyShards[shardID] = shard
+	}
+
+	// The first sample has nothing to compare with.
+	require.Empty(t, b.sample())
+	require.Zero(t, b.load())
+
+	shards[1].count = 100
+	shards[2].count = 10
+	shard3 := &countingShard{MockControllableContext: historyi.NewMockControllableContext(controller), count: 1000}
+	shard3.EXPECT().IsValid().Return(true).AnyTimes()
+	b.controller.historyShards[3] = shard3
+
+	loads := b.sample()
+	require.Len(t, loads, 2)
+	require.Greater(t, loads[1], loads[2])
+	require.NotContains(t, loads, int32(3))
+	require.InDelta(t, loads[1]+loads[2], b.load(), 0.0001)
+}
+
+func TestShardBalancer_IsLeader(t *testing.T) {
+	b, resolver, _ := newTestShardBalancer(t)
+
+	resolver.EXPECT().Lookup(shardBalancerLeaderKey).Return(membership.NewHostInfoFromAddress("self"), nil)
+	require.True(t, b.isLeader())
+	resolver.EXPECT().Lookup(shardBalancerLeaderKey).Return(membership.NewHostInfoFromAddress("a"), nil)
+	require.False(t, b.isLeader())
+}
+
+func TestShardBalancer_MovesShardToLeastLoadedHost(t *testing.T) {
+	b, resolver, historyClient := newTestShardBalancer(t)
+	b.hostLoad = 135
+	b.hostShardLoads = map[int32]float64{1: 80, 2: 20, 3: 30, 4: 5}
+
+	resolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("self"),
+		membership.NewHostInfoFromAddress("a"),
+		membership.NewHostInfoFromAddress("b"),
+	})
+	historyClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: "a"}).
+		Return(&historyservice.DescribeHistoryHostResponse{Load: 40}, nil)
+	historyClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: "b"}).
+		Return(&historyservice.DescribeHistoryHostResponse{Load: 10}, nil)
+	// Shard 1 would leave b more loaded than this host, and shard 3 is pinned to this host.
+	historyClient.EXPECT().PinShard(gomock.Any(), &historyservice.PinShardRequest{HostAddress: "b", ShardId: 2}).
+		Return(&historyservice.PinShardResponse{}, nil)
+	require.NoError(t, b.controller.placement.pin(3))
+
+	b.balance(context.Background())
+}
+
+func TestShardBalancer_MovesShardOfOtherHost(t *testing.T) {
+	b, resolver, historyClient := newTestShardBalancer(t)
+	b.hostLoad = 20
+	b.hostShardLoads = map[int32]float64{1: 20}
+
+	resolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("self"),
+		membership.NewHostInfoFromAddress("a"),
+		membership.NewHostInfoFromAddress("b"),
+	})
+	historyClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: "a"}).
+		Return(&historyservice.DescribeHistoryHostResponse{
+			Load:           120,
+			ShardLoads:     map[int32]float64{5: 50, 6: 40, 7: 30},
+			PinnedShardIds: []int32{5},
+		}, nil)
+	historyClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: "b"}).
+		Return(&historyservice.DescribeHistoryHostResponse{Load: 10}, nil)
+	// Shard 5 is pinned to a.
+	historyClient.EXPECT().PinShard(gomock.Any(), &historyservice.PinShardRequest{HostAddress: "b", ShardId: 6}).
+		Return(&historyservice.PinShardResponse{}, nil)
+
+	b.balance(context.Background())
+}
+
+func TestShardBalancer_BalancedHosts(t *testing.T) {
+	b, resolver, historyClient := newTestShardBalancer(t)
+	b.hostLoad = 100
+	b.hostShardLoads = map[int32]float64{1: 60, 2: 40}
+
+	resolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("self"),
+		membership.NewHostInfoFromAddress("a"),
+	})
+	historyClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).
+		Return(&historyservice.DescribeHistoryHostResponse{Load: 90}, nil)
+
+	b.balance(context.Background())
+}
@@ -18,6 +18,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
@@ -56,6 +57,7 @@ type (
 		PersistenceShardManager     persistence.ShardManager
 		SaMapperProvider            searchattribute.MapperProvider
 		SaProvider                  searchattribute.Provider
+		ShardPools                  *shardpool.Resolver
 		ThrottledLogger             log.ThrottledLogger
 		TimeSource                  clock.TimeSource
 		TaskCategoryRegistry        tasks.TaskCategoryRegistry
@@ -100,6 +102,7 @@ func (c *contextFactoryImpl) CreateContext(
 		c.PayloadSerializer,
 		c.TimeSource,
 		c.NamespaceRegistry,
+		c.ShardPools,
 		c.SaProvider,
 		c.SaMapperProvider,
 		c.ClusterMetadata,
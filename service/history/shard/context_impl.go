@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/wideevents"
 	"go.temporal.io/server/service/history/configs"
@@ -109,6 +110,7 @@ type (
 		payloadSerializer       serialization.Serializer
 		timeSource              cclock.TimeSource
 		namespaceRegistry       namespace.Registry
+		shardPools              *shardpool.Resolver
 		saProvider              searchattribute.Provider
 		saMapperProvider        searchattribute.MapperProvider
 		clusterMetadata         cluster.Metadata
@@ -228,6 +230,12 @@ func (s *ContextImpl) GetOwner() string {
 	return s.owner
 }
 
+// loadCount returns the number of write requests and tasks handled by this
+// shard so far, it is used by the shard balancer to estimate the load of the shard.
+func (s *ContextImpl) loadCount() int64 {
+	return s.taskKeyManager.loadCount()
+}
+
 func (s *ContextImpl) GetExecutionManager() persistence.ExecutionManager {
 	// constant from initialization, no need for locks
 	return s.executionManager
@@ -2165,6 +2173,7 @@ func newContext(
 	payloadSerializer serialization.Serializer,
 	timeSource cclock.TimeSource,
 	namespaceRegistry namespace.Registry,
+	shardPools *shardpool.Resolver,
 	saProvider searchattribute.Provider,
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
@@ -2213,6 +2222,7 @@ func newContext(
 		payloadSerializer:       payloadSerializer,
 		timeSource:              timeSource,
 		namespaceRegistry:       namespaceRegistry,
+		shardPools:              shardPools,
 		saProvider:              saProvider,
 		saMapperProvider:        saMapperProvider,
 		clusterMetadata:         clusterMetadata,
@@ -2332,6 +2342,10 @@ func (s *ContextImpl) GetNamespaceRegistry() namespace.Registry {
 	return s.namespaceRegistry
 }
 
+func (s *ContextImpl) GetShardPools() *shardpool.Resolver {
+	return s.shardPools
+}
+
 func (s *ContextImpl) GetSearchAttributesProvider() searchattribute.Provider {
 	return s.saProvider
 }
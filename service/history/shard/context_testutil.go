@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/finalizer"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/locks"
@@ -21,6 +22,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
@@ -150,6 +152,7 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 		clusterMetadata:      clusterMetadata,
 		timeSource:           t.TimeSource,
 		namespaceRegistry:    registry,
+		shardPools:           shardpool.NewResolver(dynamicconfig.NewNoopCollection(), registry, config.Config.NumberOfShards),
 		stateMachineRegistry: hsm.NewRegistry(),
 		chasmRegistry:        chasm.NewRegistry(t.GetLogger()),
 		businessIDRateLimiters: cache.New(
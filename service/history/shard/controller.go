@@ -18,6 +18,16 @@ type (
 		GetShardByNamespaceWorkflow(namespaceID namespace.ID, workflowID string) (historyi.ShardContext, error)
 		CloseShardByID(shardID int32)
 		ShardIDs() []int32
+		// PinShard pins the shard to this host, which acquires it from its current owner.
+		PinShard(shardID int32) error
+		// UnpinShard removes the pin of the shard from this host, if any.
+		UnpinShard(shardID int32) error
+		// PinnedShardIDs returns the shards pinned to this host.
+		PinnedShardIDs() []int32
+		// Load returns the number of write requests and tasks per second handled by the shards of this host.
+		Load() float64
+		// ShardLoads returns the load of each shard owned by this host.
+		ShardLoads() map[int32]float64
 		Start()
 		Stop()
 		// InitialShardsAcquired blocks until initial shard acquisition is complete, context timeout,
@@ -19,7 +19,9 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/pingable"
+	"go.temporal.io/server/common/resource"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"golang.org/x/sync/semaphore"
@@ -50,6 +52,9 @@ type (
 		contextTaggedLogger  log.Logger
 		hostInfoProvider     membership.HostInfoProvider
 		ownership            *ownership
+		placement            *placement
+		balancer             *shardBalancer
+		shardPools           *shardpool.Resolver
 		status               atomic.Int32
 		taggedMetricsHandler metrics.Handler
 		// shardCountSubscriptions is a set of subscriptions that receive shard count updates whenever the set of
@@ -73,16 +78,26 @@ func ControllerProvider(
 	historyServiceResolver membership.ServiceResolver,
 	metricsHandler metrics.Handler,
 	hostInfoProvider membership.HostInfoProvider,
+	membershipMonitor membership.Monitor,
+	historyClient resource.HistoryClient,
 	contextFactory ContextFactory,
+	shardPools *shardpool.Resolver,
 ) *ControllerImpl {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	contextTaggedLogger := log.With(logger, tag.ComponentShardController, tag.Address(hostIdentity))
 	taggedMetricsHandler := metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryShardControllerScope))
 
+	placement := newPlacement(
+		historyServiceResolver,
+		hostInfoProvider,
+		membershipMonitor,
+		contextTaggedLogger,
+	)
 	ownership := newOwnership(
 		config,
 		historyServiceResolver,
 		hostInfoProvider,
+		placement,
 		contextTaggedLogger,
 		taggedMetricsHandler,
 	)
@@ -94,11 +109,21 @@ func ControllerProvider(
 		historyShards:           make(map[int32]historyi.ControllableContext),
 		hostInfoProvider:        hostInfoProvider,
 		ownership:               ownership,
+		placement:               placement,
+		shardPools:              shardPools,
 		taggedMetricsHandler:    taggedMetricsHandler,
 		shardCountSubscriptions: map[*shardCountSubscription]struct{}{},
 		initialShardsAcquired:   future.NewFuture[struct{}](),
 	}
 	c.lingerState.shards = make(map[historyi.ControllableContext]struct{})
+	c.balancer = newShardBalancer(
+		config,
+		c,
+		historyClient,
+		historyServiceResolver,
+		hostInfoProvider,
+		contextTaggedLogger,
+	)
 	return c
 }
 
@@ -108,6 +133,7 @@ func (c *ControllerImpl) Start() {
 	}
 
 	c.ownership.start(c)
+	c.balancer.start()
 
 	c.contextTaggedLogger.Info("", tag.LifeCycleStarted)
 }
@@ -119,6 +145,7 @@ func (c *ControllerImpl) Stop() {
 
 	c.initialShardsAcquired.SetIfNotReady(struct{}{}, context.Canceled)
 
+	c.balancer.stop()
 	c.ownership.stop()
 
 	c.doShutdown()
@@ -160,7 +187,10 @@ func (c *ControllerImpl) GetShardByNamespaceWorkflow(
 	namespaceID namespace.ID,
 	workflowID string,
 ) (historyi.ShardContext, error) {
-	shardID := c.config.GetShardID(namespaceID, workflowID)
+	shardID, err := c.shardPools.ShardID(namespaceID.String(), workflowID)
+	if err != nil {
+		return nil, err
+	}
 	return c.GetShardByID(shardID)
 }
 
@@ -203,6 +233,66 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+// PinShard pins the given shard to this host, and schedules its acquisition. Other hosts release the shard once
+// they see the pin in membership.
+func (c *ControllerImpl) PinShard(shardID int32) error {
+	if err := c.validateShardId(shardID); err != nil {
+		return err
+	}
+	if err := c.placement.pin(shardID); err != nil {
+		return err
+	}
+	c.contextTaggedLogger.Info("Pinned shard to host", tag.ShardID(shardID))
+	c.ownership.scheduleAcquire()
+	return nil
+}
+
+// UnpinShard removes the pin of the given shard from this host, if any. The shard is then released to the host
+// membership assigns it to.
+func (c *ControllerImpl) UnpinShard(shardID int32) error {
+	if err := c.validateShardId(shardID); err != nil {
+		return err
+	}
+	unpinned, err := c.placement.unpin(shardID)
+	if err != nil || !unpinned {
+		return err
+	}
+	c.contextTaggedLogger.Info("Unpinned shard from host", tag.ShardID(shardID))
+	c.ownership.scheduleAcquire()
+	return nil
+}
+
+// PinnedShardIDs returns the shards pinned to this host.
+func (c *ControllerImpl) PinnedShardIDs() []int32 {
+	return c.placement.pinnedShardIDs()
+}
+
+// Load returns the number of write requests and tasks per second handled by the shards of this host, as of the last
+// sample of the shard balancer.
+func (c *ControllerImpl) Load() float64 {
+	return c.balancer.load()
+}
+
+// ShardLoads returns the number of write requests and tasks per second handled by each shard of this host, as of the
+// last sample of the shard balancer.
+func (c *ControllerImpl) ShardLoads() map[int32]float64 {
+	return c.balancer.shardLoads()
+}
+
+// shardLoadCounts returns the load count of each valid shard owned by this host.
+func (c *ControllerImpl) shardLoadCounts() map[int32]int64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	counts := make(map[int32]int64, len(c.historyShards))
+	for shardID, shard := range c.historyShards {
+		if counter, ok := shard.(loadCounter); ok && shard.IsValid() {
+			counts[shardID] = counter.loadCount()
+		}
+	}
+	return counts
+}
+
 func (c *ControllerImpl) shardRemoveAndStop(shard historyi.ControllableContext) {
 	startTime := time.Now().UTC()
 	defer func() {
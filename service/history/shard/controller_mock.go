@@ -113,6 +113,48 @@ func (mr *MockControllerMockRecorder) InitialShardsAcquired(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialShardsAcquired", reflect.TypeOf((*MockController)(nil).InitialShardsAcquired), arg0)
 }
 
+// Load mocks base method.
+func (m *MockController) Load() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockControllerMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockController)(nil).Load))
+}
+
+// PinShard mocks base method.
+func (m *MockController) PinShard(shardID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinShard", shardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinShard indicates an expected call of PinShard.
+func (mr *MockControllerMockRecorder) PinShard(shardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinShard", reflect.TypeOf((*MockController)(nil).PinShard), shardID)
+}
+
+// PinnedShardIDs mocks base method.
+func (m *MockController) PinnedShardIDs() []int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinnedShardIDs")
+	ret0, _ := ret[0].([]int32)
+	return ret0
+}
+
+// PinnedShardIDs indicates an expected call of PinnedShardIDs.
+func (mr *MockControllerMockRecorder) PinnedShardIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinnedShardIDs", reflect.TypeOf((*MockController)(nil).PinnedShardIDs))
+}
+
 // ShardIDs mocks base method.
 func (m *MockController) ShardIDs() []int32 {
 	m.ctrl.T.Helper()
@@ -127,6 +169,20 @@ func (mr *MockControllerMockRecorder) ShardIDs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardIDs", reflect.TypeOf((*MockController)(nil).ShardIDs))
 }
 
+// ShardLoads mocks base method.
+func (m *MockController) ShardLoads() map[int32]float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardLoads")
+	ret0, _ := ret[0].(map[int32]float64)
+	return ret0
+}
+
+// ShardLoads indicates an expected call of ShardLoads.
+func (mr *MockControllerMockRecorder) ShardLoads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardLoads", reflect.TypeOf((*MockController)(nil).ShardLoads))
+}
+
 // Start mocks base method.
 func (m *MockController) Start() {
 	m.ctrl.T.Helper()
@@ -150,3 +206,17 @@ func (mr *MockControllerMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockController)(nil).Stop))
 }
+
+// UnpinShard mocks base method.
+func (m *MockController) UnpinShard(shardID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinShard", shardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinShard indicates an expected call of UnpinShard.
+func (mr *MockControllerMockRecorder) UnpinShard(shardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockController)(nil).UnpinShard), shardID)
+}
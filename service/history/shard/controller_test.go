@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -99,7 +100,10 @@ func NewTestController(
 		resource.GetHistoryServiceResolver(),
 		metricsTestHandler,
 		resource.GetHostInfoProvider(),
+		resource.GetMembershipMonitor(),
+		resource.GetHistoryClient(),
 		contextFactory,
+		shardpooltest.NewResolver(config.NumberOfShards),
 	)
 }
 
@@ -123,6 +127,8 @@ func (s *controllerSuite) SetupTest() {
 	s.otherHostInfo = membership.NewHostInfoFromAddress("other")
 	s.mockHostInfoProvider = s.mockResource.HostInfoProvider
 	s.mockHostInfoProvider.EXPECT().HostInfo().Return(s.hostInfo).AnyTimes()
+	// No shards are pinned to other hosts.
+	s.mockServiceResolver.EXPECT().Members().Return(nil).AnyTimes()
 
 	s.logger = s.mockResource.Logger
 	s.config = tests.NewDynamicConfig()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/goro"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -23,8 +22,8 @@ type (
 	// Upon receiving membership update events, it calls the controller's
 	// acquireShards method, which acquires or closes shards as needed.
 	// The controller calls its verifyOwnership method when asked to
-	// acquire a shard, to check that membership believes this host should
-	// own the shard.
+	// acquire a shard, to check that membership, and the shard placement,
+	// believe this host should own the shard.
 	ownership struct {
 		acquireCh              chan struct{}
 		config                 *configs.Config
@@ -34,6 +33,7 @@ type (
 		logger                 log.Logger
 		membershipUpdateCh     chan *membership.ChangedEvent
 		metricsHandler         metrics.Handler
+		placement              *placement
 	}
 )
 
@@ -41,6 +41,7 @@ func newOwnership(
 	config *configs.Config,
 	historyServiceResolver membership.ServiceResolver,
 	hostInfoProvider membership.HostInfoProvider,
+	placement *placement,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) *ownership {
@@ -54,10 +55,13 @@ func newOwnership(
 		logger:                 logger,
 		membershipUpdateCh:     make(chan *membership.ChangedEvent, 1),
 		metricsHandler:         metricsHandler,
+		placement:              placement,
 	}
 }
 
 func (o *ownership) start(controller *ControllerImpl) {
+	o.placement.refresh()
+
 	o.goros.Go(func(ctx context.Context) error {
 		o.eventLoop(ctx)
 		return nil
@@ -95,6 +99,7 @@ func (o *ownership) eventLoop(ctx context.Context) {
 				tag.NumberChanged(len(changedEvent.HostsChanged)),
 			)
 
+			o.placement.refresh()
 			o.scheduleAcquire()
 		}
 	}
@@ -130,10 +135,10 @@ func (o *ownership) stop() {
 }
 
 // verifyOwnership checks if the shard should be owned by this host's shard
-// controller. If membership lists another host as the owner, it returns a
-// ShardOwnershipLost error with the correct owner.
+// controller. If the shard placement lists another host as the owner, it
+// returns a ShardOwnershipLost error with the correct owner.
 func (o *ownership) verifyOwnership(shardID int32) error {
-	ownerInfo, err := o.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	ownerInfo, err := o.placement.owner(shardID)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tests"
@@ -40,6 +41,8 @@ func (s *ownershipSuite) SetupTest() {
 	s.config = tests.NewDynamicConfig()
 
 	s.resource.HostInfoProvider.EXPECT().HostInfo().Return(s.resource.GetHostInfo()).AnyTimes()
+	// No shards are pinned to other hosts.
+	s.resource.HistoryServiceResolver.EXPECT().Members().Return(nil).AnyTimes()
 }
 
 func (s *ownershipSuite) TearDownTest() {
@@ -53,7 +56,10 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 		s.resource.GetHistoryServiceResolver(),
 		s.resource.GetMetricsHandler(),
 		s.resource.GetHostInfoProvider(),
+		s.resource.GetMembershipMonitor(),
+		s.resource.GetHistoryClient(),
 		contextFactory,
+		shardpooltest.NewResolver(s.config.NumberOfShards),
 	)
 }
 
@@ -0,0 +1,245 @@
+package shard
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+)
+
+const (
+	// shardPlacementLabel is the membership label a history host uses to advertise the shards pinned to it. Its value
+	// is a comma separated list of <shard ID>:<pin sequence> entries.
+	shardPlacementLabel = "pinnedShards"
+)
+
+type (
+	// placement decides which history host should own a shard. A shard pinned to a member of the history ring is
+	// owned by that member, and any other shard by the member the ring assigns it to.
+	//
+	// A host advertises the shards pinned to it through a membership label, so pins are visible to every member and
+	// are dropped together with the host when it leaves the ring. Changing a pin is a membership change like any
+	// other: every shard controller re-acquires its shards, and the previous owner lingers on the shard until the new
+	// owner has acquired it.
+	//
+	// A host pins a shard with a sequence above the one of any pin of the shard it has seen, so a pin supersedes the
+	// pins it was made after, regardless of the clocks of the hosts. If a shard is pinned to several hosts, the pin
+	// with the highest sequence wins, pins made concurrently with the same sequence are ordered by host identity, and
+	// the other hosts drop their pin once they see the winning one.
+	placement struct {
+		historyServiceResolver membership.ServiceResolver
+		hostInfoProvider       membership.HostInfoProvider
+		membershipMonitor      membership.Monitor
+		logger                 log.Logger
+
+		sync.RWMutex
+		// localPins holds the sequence of the shards pinned to this host, it is the source of this host's label.
+		localPins map[int32]int64
+		// remotePins holds the winning pin of other hosts for each shard, as of the last refresh.
+		remotePins map[int32]shardPin
+	}
+
+	shardPin struct {
+		host     membership.HostInfo
+		sequence int64
+	}
+)
+
+func newPlacement(
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	membershipMonitor membership.Monitor,
+	logger log.Logger,
+) *placement {
+	return &placement{
+		historyServiceResolver: historyServiceResolver,
+		hostInfoProvider:       hostInfoProvider,
+		membershipMonitor:      membershipMonitor,
+		logger:                 logger,
+		localPins:              make(map[int32]int64),
+		remotePins:             make(map[int32]shardPin),
+	}
+}
+
+// owner returns the host that should own the given shard.
+func (p *placement) owner(shardID int32) (membership.HostInfo, error) {
+	self := p.hostInfoProvider.HostInfo()
+	p.RLock()
+	localSequence, pinnedLocally := p.localPins[shardID]
+	remotePin, pinnedRemotely := p.remotePins[shardID]
+	p.RUnlock()
+
+	switch {
+	case pinnedRemotely && (!pinnedLocally || remotePin.supersedes(shardPin{host: self, sequence: localSequence})):
+		return remotePin.host, nil
+	case pinnedLocally:
+		return self, nil
+	default:
+		return p.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	}
+}
+
+// pin pins the given shard to this host.
+func (p *placement) pin(shardID int32) error {
+	p.Lock()
+	defer p.Unlock()
+
+	sequence, pinned := p.localPins[shardID]
+	p.localPins[shardID] = max(sequence, p.remotePins[shardID].sequence) + 1
+	if err := p.publishLocked(); err != nil {
+		if pinned {
+			p.localPins[shardID] = sequence
+		} else {
+			delete(p.localPins, shardID)
+		}
+		return err
+	}
+	return nil
+}
+
+// unpin removes the pin of the given shard from this host. It returns false if the shard was not pinned to this host.
+func (p *placement) unpin(shardID int32) (bool, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	sequence, pinned := p.localPins[shardID]
+	if !pinned {
+		return false, nil
+	}
+	delete(p.localPins, shardID)
+	if err := p.publishLocked(); err != nil {
+		p.localPins[shardID] = sequence
+		return false, err
+	}
+	return true, nil
+}
+
+// pinnedShardIDs returns the shards pinned to this host, in ascending order.
+func (p *placement) pinnedShardIDs() []int32 {
+	p.RLock()
+	defer p.RUnlock()
+
+	shardIDs := make([]int32, 0, len(p.localPins))
+	for shardID := range p.localPins {
+		shardIDs = append(shardIDs, shardID)
+	}
+	slices.Sort(shardIDs)
+	return shardIDs
+}
+
+// isPinned returns true if the given shard is pinned to this host.
+func (p *placement) isPinned(shardID int32) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	_, ok := p.localPins[shardID]
+	return ok
+}
+
+// refresh reloads the pins of other hosts from membership, and drops the pins of this host that were superseded by a
+// pin on another host. It is called whenever membership changes.
+func (p *placement) refresh() {
+	self := p.hostInfoProvider.HostInfo()
+	remotePins := make(map[int32]shardPin)
+	for _, member := range p.historyServiceResolver.Members() {
+		if member.Identity() == self.Identity() {
+			continue
+		}
+		labeled, ok := member.(membership.LabeledHostInfo)
+		if !ok {
+			continue
+		}
+		value, ok := labeled.Label(shardPlacementLabel)
+		if !ok {
+			continue
+		}
+		pins, err := parseShardPins(value)
+		if err != nil {
+			p.logger.Warn("Ignoring invalid shard placement label", tag.Address(member.GetAddress()), tag.Error(err))
+			continue
+		}
+		for shardID, sequence := range pins {
+			pin := shardPin{host: member, sequence: sequence}
+			if current, ok := remotePins[shardID]; ok && !pin.supersedes(current) {
+				continue
+			}
+			remotePins[shardID] = pin
+		}
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.remotePins = remotePins
+	superseded := false
+	for shardID, sequence := range p.localPins {
+		if remotePin, ok := remotePins[shardID]; ok && remotePin.supersedes(shardPin{host: self, sequence: sequence}) {
+			p.logger.Info("Shard pin superseded by another host",
+				tag.ShardID(shardID),
+				tag.Address(remotePin.host.GetAddress()),
+			)
+			delete(p.localPins, shardID)
+			superseded = true
+		}
+	}
+	if superseded {
+		if err := p.publishLocked(); err != nil {
+			p.logger.Error("Unable to update shard placement label", tag.Error(err))
+		}
+	}
+}
+
+// supersedes returns true if the pin takes precedence over the other pin of the same shard.
+func (pin shardPin) supersedes(other shardPin) bool {
+	if pin.sequence != other.sequence {
+		return pin.sequence > other.sequence
+	}
+	return pin.host.Identity() > other.host.Identity()
+}
+
+func (p *placement) publishLocked() error {
+	return p.membershipMonitor.SetLabel(shardPlacementLabel, formatShardPins(p.localPins))
+}
+
+func formatShardPins(pins map[int32]int64) string {
+	shardIDs := make([]int32, 0, len(pins))
+	for shardID := range pins {
+		shardIDs = append(shardIDs, shardID)
+	}
+	slices.Sort(shardIDs)
+
+	entries := make([]string, len(shardIDs))
+	for i, shardID := range shardIDs {
+		entries[i] = fmt.Sprintf("%d:%d", shardID, pins[shardID])
+	}
+	return strings.Join(entries, ",")
+}
+
+func parseShardPins(value string) (map[int32]int64, error) {
+	pins := make(map[int32]int64)
+	if value == "" {
+		return pins, nil
+	}
+	for entry := range strings.SplitSeq(value, ",") {
+		shardIDStr, sequenceStr, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid shard pin %q", entry)
+		}
+		shardID, err := strconv.ParseInt(shardIDStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shard pin %q: %w", entry, err)
+		}
+		sequence, err := strconv.ParseInt(sequenceStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shard pin %q: %w", entry, err)
+		}
+		pins[int32(shardID)] = sequence
+	}
+	return pins, nil
+}
@@ -0,0 +1,172 @@
+package shard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.uber.org/mock/gomock"
+)
+
+type labeledHost struct {
+	address string
+	labels  map[string]string
+}
+
+func (h labeledHost) Identity() string {
+	return h.address
+}
+
+func (h labeledHost) GetAddress() string {
+	return h.address
+}
+
+func (h labeledHost) Label(key string) (string, bool) {
+	value, ok := h.labels[key]
+	return value, ok
+}
+
+func newTestPlacement(t *testing.T) (*placement, *membership.MockServiceResolver, *membership.MockMonitor) {
+	controller := gomock.NewController(t)
+	resolver := membership.NewMockServiceResolver(controller)
+	monitor := membership.NewMockMonitor(controller)
+	hostInfoProvider := membership.NewMockHostInfoProvider(controller)
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("self")).AnyTimes()
+	return newPlacement(resolver, hostInfoProvider, monitor, log.NewNoopLogger()), resolver, monitor
+}
+
+func TestPlacement_Owner(t *testing.T) {
+	p, resolver, monitor := newTestPlacement(t)
+
+	hostA := labeledHost{address: "a", labels: map[string]string{
+		shardPlacementLabel: formatShardPins(map[int32]int64{1: 1, 2: 1, 4: 1}),
+	}}
+	hostB := labeledHost{address: "b", labels: map[string]string{
+		shardPlacementLabel: formatShardPins(map[int32]int64{2: 2, 4: 1}),
+	}}
+	resolver.EXPECT().Members().Return([]membership.HostInfo{
+		hostA,
+		hostB,
+		membership.NewHostInfoFromAddress("c"),
+	})
+	p.refresh()
+
+	owner, err := p.owner(1)
+	require.NoError(t, err)
+	require.Equal(t, "a", owner.Identity())
+
+	// The pin with the highest sequence wins.
+	owner, err = p.owner(2)
+	require.NoError(t, err)
+	require.Equal(t, "b", owner.Identity())
+
+	// Concurrent pins are ordered by host.
+	owner, err = p.owner(4)
+	require.NoError(t, err)
+	require.Equal(t, "b", owner.Identity())
+
+	// Shards that are not pinned are placed by membership.
+	resolver.EXPECT().Lookup("3").Return(membership.NewHostInfoFromAddress("c"), nil)
+	owner, err = p.owner(3)
+	require.NoError(t, err)
+	require.Equal(t, "c", owner.Identity())
+
+	// Pinning a shard to this host supersedes the pins it has seen.
+	monitor.EXPECT().SetLabel(shardPlacementLabel, "1:2").Return(nil)
+	require.NoError(t, p.pin(1))
+	owner, err = p.owner(1)
+	require.NoError(t, err)
+	require.Equal(t, "self", owner.Identity())
+	require.Equal(t, []int32{1}, p.pinnedShardIDs())
+}
+
+func TestPlacement_PinUnpin(t *testing.T) {
+	p, _, monitor := newTestPlacement(t)
+
+	var label string
+	monitor.EXPECT().SetLabel(shardPlacementLabel, gomock.Any()).DoAndReturn(func(_ string, value string) error {
+		label = value
+		return nil
+	}).Times(3)
+
+	require.NoError(t, p.pin(3))
+	require.NoError(t, p.pin(1))
+	pins, err := parseShardPins(label)
+	require.NoError(t, err)
+	require.Len(t, pins, 2)
+	require.Contains(t, pins, int32(1))
+	require.Contains(t, pins, int32(3))
+	require.True(t, p.isPinned(3))
+
+	unpinned, err := p.unpin(3)
+	require.NoError(t, err)
+	require.True(t, unpinned)
+	pins, err = parseShardPins(label)
+	require.NoError(t, err)
+	require.Len(t, pins, 1)
+	require.Contains(t, pins, int32(1))
+
+	// Unpinning a shard that is not pinned does not change the label.
+	unpinned, err = p.unpin(3)
+	require.NoError(t, err)
+	require.False(t, unpinned)
+	require.Equal(t, []int32{1}, p.pinnedShardIDs())
+}
+
+func TestPlacement_PinFailure(t *testing.T) {
+	p, _, monitor := newTestPlacement(t)
+
+	monitor.EXPECT().SetLabel(shardPlacementLabel, gomock.Any()).Return(errors.New("not bootstrapped"))
+	require.Error(t, p.pin(1))
+	require.False(t, p.isPinned(1))
+}
+
+func TestPlacement_RefreshDropsSupersededPins(t *testing.T) {
+	p, resolver, monitor := newTestPlacement(t)
+
+	monitor.EXPECT().SetLabel(shardPlacementLabel, gomock.Any()).Return(nil).Times(2)
+	require.NoError(t, p.pin(1))
+	require.NoError(t, p.pin(2))
+
+	// Shard 1 was pinned to a after this host pinned it, shard 2 concurrently, and "self" orders after "a".
+	resolver.EXPECT().Members().Return([]membership.HostInfo{
+		labeledHost{address: "a", labels: map[string]string{
+			shardPlacementLabel: formatShardPins(map[int32]int64{1: 2, 2: 1}),
+		}},
+	})
+	var label string
+	monitor.EXPECT().SetLabel(shardPlacementLabel, gomock.Any()).DoAndReturn(func(_ string, value string) error {
+		label = value
+		return nil
+	})
+	p.refresh()
+
+	require.Equal(t, []int32{2}, p.pinnedShardIDs())
+	pins, err := parseShardPins(label)
+	require.NoError(t, err)
+	require.Len(t, pins, 1)
+	require.Contains(t, pins, int32(2))
+	owner, err := p.owner(1)
+	require.NoError(t, err)
+	require.Equal(t, "a", owner.Identity())
+	owner, err = p.owner(2)
+	require.NoError(t, err)
+	require.Equal(t, "self", owner.Identity())
+}
+
+func TestParseShardPins(t *testing.T) {
+	pins, err := parseShardPins(formatShardPins(map[int32]int64{7: 3, 12: 1}))
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int64{7: 3, 12: 1}, pins)
+
+	pins, err = parseShardPins("")
+	require.NoError(t, err)
+	require.Empty(t, pins)
+
+	_, err = parseShardPins("7")
+	require.Error(t, err)
+	_, err = parseShardPins("x:1")
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"sync/atomic"
 	"time"
 
 	"go.temporal.io/server/common"
@@ -15,6 +16,10 @@ type (
 		generator *taskKeyGenerator
 		tracker   *taskRequestTracker
 
+		// load counts the requests and tasks whose keys were set, it is the
+		// only field that can be accessed without holding the shard's rwLock.
+		load atomic.Int64
+
 		timeSource clock.TimeSource
 		config     *configs.Config
 		logger     log.Logger
@@ -50,9 +55,23 @@ func (m *taskKeyManager) setAndTrackTaskKeys(
 		return nil, err
 	}
 
+	load := int64(1)
+	for _, taskMap := range taskMaps {
+		for _, tasksPerCategory := range taskMap {
+			load += int64(len(tasksPerCategory))
+		}
+	}
+	m.load.Add(load)
+
 	return m.tracker.track(taskMaps...), nil
 }
 
+// loadCount returns the total number of requests and tasks whose keys were set
+// by this manager.
+func (m *taskKeyManager) loadCount() int64 {
+	return m.load.Load()
+}
+
 func (m *taskKeyManager) peekTaskKey(
 	category tasks.Category,
 ) tasks.Key {
@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"fmt"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
+)
+
+var _ Task = (*PublishHistoryEventFeedTask)(nil)
+
+type (
+	// PublishHistoryEventFeedTask publishes the history events of a closed workflow execution to the history event
+	// feed of its namespace. Events are published page by page, the mutable state records the first event which is
+	// not published yet.
+	PublishHistoryEventFeedTask struct {
+		definition.WorkflowKey
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+)
+
+func (a *PublishHistoryEventFeedTask) GetKey() Key {
+	return NewImmediateKey(a.TaskID)
+}
+
+func (a *PublishHistoryEventFeedTask) GetVersion() int64 {
+	return a.Version
+}
+
+func (a *PublishHistoryEventFeedTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+func (a *PublishHistoryEventFeedTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+func (a *PublishHistoryEventFeedTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+func (a *PublishHistoryEventFeedTask) GetVisibilityTime() time.Time {
+	return a.VisibilityTimestamp
+}
+
+func (a *PublishHistoryEventFeedTask) SetVisibilityTime(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
+func (a *PublishHistoryEventFeedTask) GetCategory() Category {
+	return CategoryTransfer
+}
+
+func (a *PublishHistoryEventFeedTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_TRANSFER_PUBLISH_HISTORY_EVENT_FEED
+}
+
+func (a *PublishHistoryEventFeedTask) String() string {
+	return fmt.Sprintf("PublishHistoryEventFeedTask{WorkflowKey: %s, VisibilityTimestamp: %v, TaskID: %v, Version: %v}",
+		a.WorkflowKey.String(),
+		a.VisibilityTimestamp,
+		a.TaskID,
+		a.Version,
+	)
+}
@@ -52,7 +52,8 @@ type (
 )
 
 // GetShardIDForTask computes the shardID for a given task using the task's namespace, workflow ID and the number of
-// history shards in the cluster.
+// history shards in the cluster. It ignores shard pool assignments, so it is only correct for namespaces that are not
+// assigned to a pool.
 func GetShardIDForTask(task Task, numShards int) int {
 	return int(common.WorkflowIDToHistoryShard(task.GetNamespaceID(), task.GetWorkflowID(), int32(numShards)))
 }
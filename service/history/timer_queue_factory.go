@@ -49,6 +49,12 @@ func NewTimerQueueFactory(
 					ActiveNamespaceWeights:         params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:        params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
 					InactiveNamespaceDeletionDelay: params.Config.TaskSchedulerInactiveChannelDeletionDelay,
+					Name:                           tasks.CategoryTimer.Name(),
+					NamespaceWeight:                params.Config.TaskSchedulerNamespaceWeight,
+					BacklogWeightMultiplier:        params.Config.TaskSchedulerBacklogWeightMultiplier,
+					MaxChannelWeight:               params.Config.TaskSchedulerMaxChannelWeight,
+					WeightRefreshInterval:          params.Config.TaskSchedulerWeightRefreshInterval,
+					Tuner:                          params.SchedulerTuner,
 					ExecutionAwareSchedulerOptions: ctasks.ExecutionAwareSchedulerOptions{
 						Enabled:          params.Config.TaskSchedulerEnableExecutionQueueScheduler,
 						MaxQueues:        params.Config.TaskSchedulerExecutionQueueSchedulerMaxQueues,
@@ -173,6 +179,8 @@ func (f *timerQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		queues.WithShardID(shardContext.GetShardID()),
+		queues.WithTaskTracer(f.TaskTracer.ForShard(shardContext.GetShardID())),
 	)
 	return queues.NewScheduledQueue(
 		shardContext,
@@ -202,6 +210,7 @@ func (f *timerQueueFactory) CreateQueue(
 			MoveGroupTaskCountBase:              f.Config.QueueMoveGroupTaskCountBase,
 			MoveGroupTaskCountMultiplier:        f.Config.QueueMoveGroupTaskCountMultiplier,
 			ShrinkPredicateMaxPendingKeys:       f.Config.QueueShrinkPredicateMaxPendingKeys,
+			Mitigations:                         f.Mitigations,
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/historyfeed"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -57,6 +58,7 @@ type (
 		parentClosePolicyClient parentclosepolicy.Client
 		versionCache            worker_versioning.VersionMembershipAndReactivationStatusCache
 		testHooks               testhooks.TestHooks
+		historyEventFeed        historyfeed.Sink
 	}
 )
 
@@ -73,6 +75,7 @@ func newTransferQueueActiveTaskExecutor(
 	chasmEngine chasm.Engine,
 	versionCache worker_versioning.VersionMembershipAndReactivationStatusCache,
 	testHooks testhooks.TestHooks,
+	historyEventFeed historyfeed.Sink,
 ) queues.Executor {
 	return &transferQueueActiveTaskExecutor{
 		transferQueueTaskExecutorBase: newTransferQueueTaskExecutorBase(
@@ -96,8 +99,9 @@ func newTransferQueueActiveTaskExecutor(
 			sdkClientFactory,
 			config.NumParentClosePolicySystemWorkflows(),
 		),
-		versionCache: versionCache,
-		testHooks:    testHooks,
+		versionCache:     versionCache,
+		testHooks:        testHooks,
+		historyEventFeed: historyEventFeed,
 	}
 }
 
@@ -168,6 +172,8 @@ func (t *transferQueueActiveTaskExecutor) execute(
 		err = t.processStartChildExecution(ctx, task)
 	case *tasks.ResetWorkflowTask:
 		err = t.processResetWorkflow(ctx, task)
+	case *tasks.PublishHistoryEventFeedTask:
+		err = t.processPublishHistoryEventFeed(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task)
 	case *tasks.ChasmTask:
@@ -508,6 +514,133 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 	return err
 }
 
+// processPublishHistoryEventFeed publishes the history of a closed workflow execution to the history event feed of
+// its namespace, one record per page of history events. The first event which is not published yet is checkpointed in
+// the mutable state after every page, so a retried task resumes after the last published page.
+func (t *transferQueueActiveTaskExecutor) processPublishHistoryEventFeed(
+	ctx context.Context,
+	task *tasks.PublishHistoryEventFeedTask,
+) error {
+	if t.historyEventFeed == nil || !t.config.HistoryEventFeedEnabled(namespace.ID(task.GetNamespaceID())) {
+		return nil
+	}
+
+	for {
+		done, err := t.publishHistoryEventFeedPage(ctx, task)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// publishHistoryEventFeedPage publishes the page of history events after the checkpoint and moves the checkpoint past
+// it. It returns true once all history events are published.
+func (t *transferQueueActiveTaskExecutor) publishHistoryEventFeedPage(
+	ctx context.Context,
+	task *tasks.PublishHistoryEventFeedTask,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	record, readRequest, err := t.loadHistoryEventFeedCheckpoint(ctx, task)
+	if err != nil || record == nil {
+		return true, err
+	}
+
+	historyEvents, _, _, err := persistence.ReadFullPageEvents(ctx, t.shardContext.GetExecutionManager(), readRequest)
+	if err != nil {
+		return false, err
+	}
+	if len(historyEvents) == 0 {
+		return true, nil
+	}
+	record.Events = historyEvents
+	if err := t.historyEventFeed.Publish(ctx, record); err != nil {
+		return false, err
+	}
+
+	checkpoint := historyEvents[len(historyEvents)-1].GetEventId() + 1
+	if err := t.updateHistoryEventFeedCheckpoint(ctx, task, checkpoint); err != nil {
+		return false, err
+	}
+	return checkpoint >= readRequest.MaxEventID, nil
+}
+
+// loadHistoryEventFeedCheckpoint returns the feed record of the workflow execution and the request reading the page of
+// history events after the checkpoint, or nil if there is nothing left to publish.
+func (t *transferQueueActiveTaskExecutor) loadHistoryEventFeedCheckpoint(
+	ctx context.Context,
+	task *tasks.PublishHistoryEventFeedTask,
+) (_ *persistencespb.HistoryEventFeedRecord, _ *persistence.ReadHistoryBranchRequest, retError error) {
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.shardContext, t.cache, task)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, t.shardContext, weContext, task, t.metricHandler, t.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil, nil, nil
+	}
+
+	closeVersion, err := mutableState.GetCloseVersion()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = CheckTaskVersion(t.shardContext, t.logger, mutableState.GetNamespaceEntry(), closeVersion, task.Version, task)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	checkpoint := max(executionInfo.GetHistoryEventFeedNextEventId(), common.FirstEventID)
+	nextEventID := mutableState.GetNextEventID()
+	if checkpoint >= nextEventID {
+		return nil, nil, nil
+	}
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	record := &persistencespb.HistoryEventFeedRecord{
+		NamespaceId:  task.GetNamespaceID(),
+		WorkflowId:   task.GetWorkflowID(),
+		RunId:        task.GetRunID(),
+		WorkflowType: executionInfo.WorkflowTypeName,
+		CloseTime:    executionInfo.CloseTime,
+	}
+	readRequest := &persistence.ReadHistoryBranchRequest{
+		ShardID:     t.shardContext.GetShardID(),
+		BranchToken: branchToken,
+		MinEventID:  checkpoint,
+		MaxEventID:  nextEventID,
+		PageSize:    t.config.HistoryMaxPageSize(mutableState.GetNamespaceEntry().Name().String()),
+	}
+	return record, readRequest, nil
+}
+
+func (t *transferQueueActiveTaskExecutor) updateHistoryEventFeedCheckpoint(
+	ctx context.Context,
+	task *tasks.PublishHistoryEventFeedTask,
+	checkpoint int64,
+) (retError error) {
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.shardContext, t.cache, task)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	return t.updateWorkflowExecution(ctx, weContext, false, func(mutableState historyi.MutableState) error {
+		executionInfo := mutableState.GetExecutionInfo()
+		executionInfo.HistoryEventFeedNextEventId = max(executionInfo.HistoryEventFeedNextEventId, checkpoint)
+		return nil
+	})
+}
+
 func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 	ctx context.Context,
 	task *tasks.CancelExecutionTask,
@@ -26,6 +26,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/backoff"
@@ -33,6 +34,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -47,6 +49,7 @@ import (
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/configs"
@@ -107,6 +110,7 @@ type (
 		now                             time.Time
 		timeSource                      *clock.EventTimeSource
 		transferQueueActiveTaskExecutor *transferQueueActiveTaskExecutor
+		historyEventFeed                *recordingHistoryEventFeed
 	}
 )
 
@@ -234,6 +238,7 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 		s.mockChasmEngine,
 		nil,
 		testhooks.TestHooks{},
+		nil,
 	).(*transferQueueActiveTaskExecutor)
 	s.transferQueueActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
 }
@@ -369,6 +374,7 @@ func (s *transferQueueActiveTaskExecutorSuite) TestExecuteChasmSideEffectTransfe
 		s.mockChasmEngine,
 		nil,
 		testhooks.TestHooks{},
+		nil,
 	).(*transferQueueActiveTaskExecutor)
 
 	// Execution should succeed.
@@ -959,6 +965,157 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParen
 	s.NoError(resp.ExecutionErr)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessPublishHistoryEventFeed() {
+	mutableState, transferTask, workflowType := s.setupPublishHistoryEventFeed(0)
+	nextEventID := mutableState.GetNextEventID()
+	historyEvents := make([]*historypb.HistoryEvent, 0, nextEventID-common.FirstEventID)
+	for eventID := common.FirstEventID; eventID < nextEventID; eventID++ {
+		historyEvents = append(historyEvents, &historypb.HistoryEvent{EventId: eventID})
+	}
+	pageSize := int(nextEventID-common.FirstEventID+1) / 2
+	s.transferQueueActiveTaskExecutor.config.HistoryMaxPageSize = dynamicconfig.GetIntPropertyFnFilteredByNamespace(pageSize)
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, nextEventID-1, s.version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			s.Equal(common.FirstEventID, request.MinEventID)
+			s.Equal(nextEventID, request.MaxEventID)
+			return &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: historyEvents[:pageSize],
+				NextPageToken: []byte("next page"),
+			}, nil
+		},
+	)
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			s.Equal(common.FirstEventID+int64(pageSize), request.MinEventID)
+			s.Equal(nextEventID, request.MaxEventID)
+			s.Empty(request.NextPageToken)
+			return &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: historyEvents[pageSize:],
+			}, nil
+		},
+	)
+	var checkpoints []int64
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			checkpoints = append(checkpoints, request.UpdateWorkflowMutation.ExecutionInfo.GetHistoryEventFeedNextEventId())
+			return tests.UpdateWorkflowExecutionResponse, nil
+		},
+	).Times(2)
+
+	resp := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(resp.ExecutionErr)
+	s.Equal([]int64{common.FirstEventID + int64(pageSize), nextEventID}, checkpoints)
+	s.Len(s.historyEventFeed.records, 2)
+	for i, record := range s.historyEventFeed.records {
+		s.Equal(s.namespaceID.String(), record.GetNamespaceId())
+		s.Equal(transferTask.GetWorkflowID(), record.GetWorkflowId())
+		s.Equal(transferTask.GetRunID(), record.GetRunId())
+		s.Equal(workflowType, record.GetWorkflowType())
+		s.NotNil(record.GetCloseTime())
+		if i == 0 {
+			protorequire.ProtoSliceEqual(s.T(), historyEvents[:pageSize], record.GetEvents())
+		} else {
+			protorequire.ProtoSliceEqual(s.T(), historyEvents[pageSize:], record.GetEvents())
+		}
+	}
+}
+
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessPublishHistoryEventFeed_ResumeFromCheckpoint() {
+	checkpoint := common.FirstEventID + 2
+	mutableState, transferTask, _ := s.setupPublishHistoryEventFeed(checkpoint)
+	nextEventID := mutableState.GetNextEventID()
+	var historyEvents []*historypb.HistoryEvent
+	for eventID := checkpoint; eventID < nextEventID; eventID++ {
+		historyEvents = append(historyEvents, &historypb.HistoryEvent{EventId: eventID})
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, nextEventID-1, s.version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			s.Equal(checkpoint, request.MinEventID)
+			s.Equal(nextEventID, request.MaxEventID)
+			return &persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents}, nil
+		},
+	)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(nextEventID, request.UpdateWorkflowMutation.ExecutionInfo.GetHistoryEventFeedNextEventId())
+			return tests.UpdateWorkflowExecutionResponse, nil
+		},
+	)
+
+	resp := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(resp.ExecutionErr)
+	s.Len(s.historyEventFeed.records, 1)
+	protorequire.ProtoSliceEqual(s.T(), historyEvents, s.historyEventFeed.records[0].GetEvents())
+}
+
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessPublishHistoryEventFeed_AllEventsPublished() {
+	mutableState, transferTask, _ := s.setupPublishHistoryEventFeed(0)
+	mutableState.GetExecutionInfo().HistoryEventFeedNextEventId = mutableState.GetNextEventID()
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, mutableState.GetNextEventID()-1, s.version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	resp := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(resp.ExecutionErr)
+	s.Empty(s.historyEventFeed.records)
+}
+
+func (s *transferQueueActiveTaskExecutorSuite) setupPublishHistoryEventFeed(
+	checkpoint int64,
+) (historyi.MutableState, *tasks.PublishHistoryEventFeedTask, string) {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.NewString())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+	addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+	mutableState.GetExecutionInfo().HistoryEventFeedNextEventId = checkpoint
+
+	s.historyEventFeed = &recordingHistoryEventFeed{}
+	s.transferQueueActiveTaskExecutor.historyEventFeed = s.historyEventFeed
+	s.transferQueueActiveTaskExecutor.config.HistoryEventFeedEnabled = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(true)
+
+	transferTask := &tasks.PublishHistoryEventFeedTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              s.mustGenerateTaskID(),
+		VisibilityTimestamp: time.Now().UTC(),
+	}
+	return mutableState, transferTask, workflowType
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -3435,3 +3592,12 @@ func (s *transferQueueActiveTaskExecutorSuite) mustGenerateTaskID() int64 {
 	s.NoError(err)
 	return taskID
 }
+
+type recordingHistoryEventFeed struct {
+	records []*persistencespb.HistoryEventFeedRecord
+}
+
+func (f *recordingHistoryEventFeed) Publish(_ context.Context, record *persistencespb.HistoryEventFeedRecord) error {
+	f.records = append(f.records, record)
+	return nil
+}
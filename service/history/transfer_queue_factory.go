@@ -2,6 +2,7 @@ package history
 
 import (
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/historyfeed"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -35,6 +36,7 @@ type (
 		VisibilityManager      manager.VisibilityManager
 		VersionMembershipCache worker_versioning.VersionMembershipAndReactivationStatusCache
 		TestHooks              testhooks.TestHooks
+		HistoryEventFeed       historyfeed.Sink
 	}
 
 	transferQueueFactory struct {
@@ -56,6 +58,12 @@ func NewTransferQueueFactory(
 					ActiveNamespaceWeights:         params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:        params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
 					InactiveNamespaceDeletionDelay: params.Config.TaskSchedulerInactiveChannelDeletionDelay,
+					Name:                           tasks.CategoryTransfer.Name(),
+					NamespaceWeight:                params.Config.TaskSchedulerNamespaceWeight,
+					BacklogWeightMultiplier:        params.Config.TaskSchedulerBacklogWeightMultiplier,
+					MaxChannelWeight:               params.Config.TaskSchedulerMaxChannelWeight,
+					WeightRefreshInterval:          params.Config.TaskSchedulerWeightRefreshInterval,
+					Tuner:                          params.SchedulerTuner,
 					ExecutionAwareSchedulerOptions: ctasks.ExecutionAwareSchedulerOptions{
 						Enabled:          params.Config.TaskSchedulerEnableExecutionQueueScheduler,
 						MaxQueues:        params.Config.TaskSchedulerExecutionQueueSchedulerMaxQueues,
@@ -129,6 +137,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.ChasmEngine,
 		f.VersionMembershipCache,
 		f.TestHooks,
+		f.HistoryEventFeed,
 	)
 
 	standbyExecutor := newTransferQueueStandbyTaskExecutor(
@@ -173,6 +182,8 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		queues.WithShardID(shardContext.GetShardID()),
+		queues.WithTaskTracer(f.TaskTracer.ForShard(shardContext.GetShardID())),
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
@@ -201,6 +212,7 @@ func (f *transferQueueFactory) CreateQueue(
 			MoveGroupTaskCountBase:              f.Config.QueueMoveGroupTaskCountBase,
 			MoveGroupTaskCountMultiplier:        f.Config.QueueMoveGroupTaskCountMultiplier,
 			ShrinkPredicateMaxPendingKeys:       f.Config.QueueShrinkPredicateMaxPendingKeys,
+			Mitigations:                         f.Mitigations,
 		},
 		f.HostReaderRateLimiter,
 		queues.GrouperNamespaceID{},
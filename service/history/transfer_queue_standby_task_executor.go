@@ -102,6 +102,8 @@ func (t *transferQueueStandbyTaskExecutor) Execute(
 		// no reset needed for standby
 		// TODO: add error logs
 		err = nil
+	case *tasks.PublishHistoryEventFeedTask:
+		err = t.processPublishHistoryEventFeed(ctx, task)
 	case *tasks.CloseExecutionTask:
 		err = t.processCloseExecution(ctx, task)
 	case *tasks.DeleteExecutionTask:
@@ -291,6 +293,52 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 	)
 }
 
+// processPublishHistoryEventFeed keeps the task until the checkpoint replicated from the active cluster shows that
+// all history events are published, so the task publishes the remaining events if the namespace fails over first.
+func (t *transferQueueStandbyTaskExecutor) processPublishHistoryEventFeed(
+	ctx context.Context,
+	transferTask *tasks.PublishHistoryEventFeedTask,
+) error {
+	if !t.config.HistoryEventFeedEnabled(namespace.ID(transferTask.GetNamespaceID())) {
+		return nil
+	}
+
+	processTaskIfClosed := true
+	actionFn := func(_ context.Context, _ historyi.WorkflowContext, mutableState historyi.MutableState, _ historyi.ReleaseWorkflowContextFunc) (any, error) {
+		if mutableState.IsWorkflowExecutionRunning() {
+			// this can happen if workflow is reset.
+			return nil, nil
+		}
+
+		closeVersion, err := mutableState.GetCloseVersion()
+		if err != nil {
+			return nil, err
+		}
+		err = CheckTaskVersion(t.shardContext, t.logger, mutableState.GetNamespaceEntry(), closeVersion, transferTask.Version, transferTask)
+		if err != nil {
+			return nil, err
+		}
+
+		if mutableState.GetExecutionInfo().GetHistoryEventFeedNextEventId() < mutableState.GetNextEventID() {
+			return &struct{}{}, nil
+		}
+		return nil, nil
+	}
+
+	return t.processTransfer(
+		ctx,
+		processTaskIfClosed,
+		transferTask,
+		actionFn,
+		getStandbyPostActionFn(
+			transferTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			standbyTransferTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *transferQueueStandbyTaskExecutor) processCloseExecution(
 	ctx context.Context,
 	transferTask *tasks.CloseExecutionTask,
@@ -45,6 +45,12 @@ func NewVisibilityQueueFactory(
 					ActiveNamespaceWeights:         params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:        params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
 					InactiveNamespaceDeletionDelay: params.Config.TaskSchedulerInactiveChannelDeletionDelay,
+					Name:                           tasks.CategoryVisibility.Name(),
+					NamespaceWeight:                params.Config.TaskSchedulerNamespaceWeight,
+					BacklogWeightMultiplier:        params.Config.TaskSchedulerBacklogWeightMultiplier,
+					MaxChannelWeight:               params.Config.TaskSchedulerMaxChannelWeight,
+					WeightRefreshInterval:          params.Config.TaskSchedulerWeightRefreshInterval,
+					Tuner:                          params.SchedulerTuner,
 					ExecutionAwareSchedulerOptions: ctasks.ExecutionAwareSchedulerOptions{
 						Enabled:          params.Config.TaskSchedulerEnableExecutionQueueScheduler,
 						MaxQueues:        params.Config.TaskSchedulerExecutionQueueSchedulerMaxQueues,
@@ -136,6 +142,8 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		queues.WithShardID(shard.GetShardID()),
+		queues.WithTaskTracer(f.TaskTracer.ForShard(shard.GetShardID())),
 	)
 	return queues.NewImmediateQueue(
 		shard,
@@ -164,6 +172,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 			MoveGroupTaskCountBase:              f.Config.QueueMoveGroupTaskCountBase,
 			MoveGroupTaskCountMultiplier:        f.Config.QueueMoveGroupTaskCountMultiplier,
 			ShrinkPredicateMaxPendingKeys:       f.Config.QueueShrinkPredicateMaxPendingKeys,
+			Mitigations:                         f.Mitigations,
 		},
 		f.HostReaderRateLimiter,
 		queues.GrouperNamespaceID{},
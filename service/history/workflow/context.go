@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/softassert"
+	"go.temporal.io/server/common/wideevents"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -542,6 +543,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 	}
 	NotifyOnExecutionSnapshot(engine, c.archetypeID, newWorkflow)
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), newMutableState)
+	emitPendingWideEvents(shardContext, newMutableState)
 
 	return nil
 }
@@ -660,6 +662,9 @@ func (c *ContextImpl) ConflictResolveWorkflowExecution(
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), resetMutableState)
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), newMutableState)
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), currentMutableState)
+	emitPendingWideEvents(shardContext, resetMutableState)
+	emitPendingWideEvents(shardContext, newMutableState)
+	emitPendingWideEvents(shardContext, currentMutableState)
 
 	return nil
 }
@@ -898,6 +903,8 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), c.MutableState)
 	emitStateTransitionCount(c.metricsHandler, shardContext.GetClusterMetadata(), newMutableState)
+	emitPendingWideEvents(shardContext, c.MutableState)
+	emitPendingWideEvents(shardContext, newMutableState)
 
 	// finally emit session stats
 	emitWorkflowHistoryStats(
@@ -945,11 +952,16 @@ func (c *ContextImpl) SubmitClosedWorkflowSnapshot(
 		c.logger.Warn("SetWorkflowExecution encountered new events")
 	}
 
-	return NewTransaction(shardContext).SetWorkflowExecution(
+	if err := NewTransaction(shardContext).SetWorkflowExecution(
 		ctx,
 		c.archetypeID,
 		resetWorkflowSnapshot,
-	)
+	); err != nil {
+		return err
+	}
+
+	emitPendingWideEvents(shardContext, c.MutableState)
+	return nil
 }
 
 func (c *ContextImpl) mergeUpdateWithNewReplicationTasks(
@@ -1152,7 +1164,7 @@ func (c *ContextImpl) ReapplyEvents(
 
 		for _, e := range events.Events {
 			event := e
-			if shouldReapplyEvent(shardContext.StateMachineRegistry(), event) {
+			if ShouldReapplyEvent(shardContext.StateMachineRegistry(), event) {
 				reapplyEvents = append(reapplyEvents, event)
 			}
 		}
@@ -1461,6 +1473,19 @@ func (c *ContextImpl) CacheSize() int {
 	return size
 }
 
+// emitPendingWideEvents emits the wide events held by the mutable state until it is persisted.
+func emitPendingWideEvents(
+	shardContext historyi.ShardContext,
+	mutableState historyi.MutableState,
+) {
+	if mutableState == nil {
+		return
+	}
+	for _, event := range mutableState.PopPendingWideEvents() {
+		wideevents.Emit(shardContext.GetEventLogger(), event)
+	}
+}
+
 func emitStateTransitionCount(
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
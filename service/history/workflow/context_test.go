@@ -25,6 +25,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/wideevents"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -584,6 +585,68 @@ func (s *contextSuite) TestRefreshTask() {
 		})
 	}
 }
+func (s *contextSuite) TestPendingWideEvents_EmittedOncePersisted() {
+	now := time.Now()
+	persistedMutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: tests.NamespaceID.String(),
+			WorkflowId:  tests.WorkflowID,
+			CloseTime:   timestamppb.New(now),
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{
+					{
+						BranchToken: []byte("token#1"),
+						Items: []*historyspb.VersionHistoryItem{
+							{EventId: 2, Version: common.EmptyVersion},
+						},
+					},
+				},
+			},
+			TransitionHistory: []*persistencespb.VersionedTransition{
+				{
+					NamespaceFailoverVersion: common.EmptyVersion,
+					TransitionCount:          2,
+				},
+			},
+			ExecutionStats: &persistencespb.ExecutionStats{},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     tests.RunID,
+			State:     enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			StartTime: timestamppb.New(now),
+		},
+		NextEventId: 3,
+	}
+	event := wideevents.ConflictResolutionPayload{WorkflowID: tests.WorkflowID}
+
+	for _, persistErr := range []error{&persistence.WorkflowConditionFailedError{Msg: "condition failed"}, nil} {
+		mutableState, err := NewMutableStateFromDB(
+			s.mockShard,
+			s.mockShard.MockEventsCache,
+			s.mockShard.GetLogger(),
+			tests.LocalNamespaceEntry,
+			common.CloneProto(persistedMutableState),
+			1,
+		)
+		s.NoError(err)
+		mutableState.AddPendingWideEvent(event)
+		s.workflowContext.MutableState = mutableState
+
+		s.mockShard.Resource.ExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(tests.UpdateWorkflowExecutionResponse, persistErr)
+		err = s.workflowContext.RefreshTasks(context.Background(), s.mockShard)
+		if persistErr != nil {
+			s.Error(err)
+			// Nothing was persisted, the event is discarded along with the mutable state.
+			s.Equal([]wideevents.Payload{event}, mutableState.PopPendingWideEvents())
+		} else {
+			s.NoError(err)
+			s.Empty(mutableState.PopPendingWideEvents())
+		}
+	}
+}
+
 func intermediatePage(pageNumber int32, markerName string) *workflowservice.RespondWorkflowTaskCompletedRequest {
 	return &workflowservice.RespondWorkflowTaskCompletedRequest{
 		IntermediatePage: true,
@@ -64,6 +64,7 @@ import (
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/common/wideevents"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/configs"
@@ -226,6 +227,10 @@ type (
 		// event inside history builder. This is only for x-run reapply (from zombie wf to current wf)
 		reapplyEventsCandidate []*historypb.HistoryEvent
 
+		// in memory wide events to emit once the mutable state is persisted, they are kept across transactions until
+		// then
+		pendingWideEvents []wideevents.Payload
+
 		InsertTasks map[tasks.Category][]tasks.Task
 
 		// BestEffortDeleteTasks holds keys of history tasks to be deleted after a successful
@@ -698,6 +703,10 @@ func (ms *MutableStateImpl) ChasmSkipPersistenceEnabled() bool {
 		ms.config.EnableCHASMSkipPersistence(ms.GetNamespaceEntry().Name().String())
 }
 
+func (ms *MutableStateImpl) ChasmSchemaUpgradeEnabled() bool {
+	return ms.config.EnableCHASMSchemaUpgrade != nil && ms.config.EnableCHASMSchemaUpgrade()
+}
+
 // chasmCallbacksEnabled returns true if CHASM callbacks are enabled for this workflow.
 func (ms *MutableStateImpl) chasmCallbacksEnabled() bool {
 	if !ms.ChasmEnabled() {
@@ -3045,6 +3054,17 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 		return nil, err
 	}
 
+	// The replication filter is only evaluated once, by the cluster which starts the workflow, and the decision is
+	// kept in the mutable state: it is not evaluated again when the mutable state is rebuilt from the started event,
+	// since the filter may have changed since. Workflows replicated from another cluster matched its filter.
+	if filter := ms.namespaceEntry.ReplicationFilter(); filter != nil {
+		ms.executionInfo.ExcludedFromReplication = !IsReplicationFilterMatched(
+			filter,
+			ms.executionInfo.WorkflowTypeName,
+			ms.executionInfo.SearchAttributes,
+		)
+	}
+
 	// TODO merge active & passive task generation
 	var err error
 	ms.executionInfo.WorkflowExecutionTimerTaskStatus, err = ms.taskGenerator.GenerateWorkflowStartTasks(
@@ -3207,7 +3227,6 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 	if event.SearchAttributes != nil {
 		ms.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
-
 	if event.GetVersioningOverride() != nil {
 		if ms.executionInfo.VersioningInfo == nil {
 			ms.executionInfo.VersioningInfo = &workflowpb.WorkflowExecutionVersioningInfo{}
@@ -7586,7 +7605,34 @@ func (ms *MutableStateImpl) StartTransaction(
 		return false, err
 	}
 
-	return flushBeforeReady, nil
+	schemaUpgraded, err := ms.startTransactionHandleChasmSchemaUpgrade()
+	if err != nil {
+		return false, err
+	}
+
+	return flushBeforeReady || schemaUpgraded, nil
+}
+
+// startTransactionHandleChasmSchemaUpgrade lazily upgrades CHASM components whose state was
+// persisted with an older schema version. Like any other state change, the upgrade must be
+// persisted and replicated, so it is only applied when the execution is active in the current
+// cluster, and only once the upgrade is enabled, as older server versions can't load the upgraded
+// state. Returns true if the upgrade needs to be flushed before the mutable state can be used.
+func (ms *MutableStateImpl) startTransactionHandleChasmSchemaUpgrade() (bool, error) {
+	if !ms.ChasmSchemaUpgradeEnabled() || !ms.chasmTree.NeedsSchemaUpgrade() {
+		return false, nil
+	}
+
+	activeCluster := ms.clusterMetadata.ClusterNameForFailoverVersion(ms.namespaceEntry.IsGlobalNamespace(), ms.GetCurrentVersion())
+	if activeCluster != ms.clusterMetadata.GetCurrentClusterName() {
+		// The active cluster upgrades the state and replicates it here.
+		return false, nil
+	}
+
+	if err := ms.chasmTree.UpgradeSchema(); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (ms *MutableStateImpl) CloseTransactionAsMutation(
@@ -9100,7 +9146,8 @@ func (ms *MutableStateImpl) closeTransactionCollapseVisibilityTasks() {
 }
 
 func (ms *MutableStateImpl) generateReplicationTask() bool {
-	return len(ms.namespaceEntry.ClusterNames(ms.GetWorkflowKey().WorkflowID)) > 1
+	return len(ms.namespaceEntry.ClusterNames(ms.GetWorkflowKey().WorkflowID)) > 1 &&
+		!ms.executionInfo.GetExcludedFromReplication()
 }
 
 func (ms *MutableStateImpl) checkMutability(
@@ -9623,6 +9670,7 @@ func (ms *MutableStateImpl) syncExecutionInfo(current *persistencespb.WorkflowEx
 			&info.WorkflowTaskBuildId,
 			&info.WorkflowTaskBuildIdRedirectCounter,
 			&info.VersionHistories,
+			&info.ConflictResolutionRecords,
 			&info.ExecutionStats,
 			&info.LastFirstEventTxnId,
 			&info.ParentClock,
@@ -10004,7 +10052,7 @@ func (ms *MutableStateImpl) reschedulePendingWorkflowTask() error {
 }
 
 func (ms *MutableStateImpl) AddReapplyCandidateEvent(event *historypb.HistoryEvent) {
-	if shouldReapplyEvent(ms.shard.StateMachineRegistry(), event) {
+	if ShouldReapplyEvent(ms.shard.StateMachineRegistry(), event) {
 		ms.reapplyEventsCandidate = append(ms.reapplyEventsCandidate, event)
 	}
 }
@@ -10013,6 +10061,16 @@ func (ms *MutableStateImpl) GetReapplyCandidateEvents() []*historypb.HistoryEven
 	return ms.reapplyEventsCandidate
 }
 
+func (ms *MutableStateImpl) AddPendingWideEvent(event wideevents.Payload) {
+	ms.pendingWideEvents = append(ms.pendingWideEvents, event)
+}
+
+func (ms *MutableStateImpl) PopPendingWideEvents() []wideevents.Payload {
+	events := ms.pendingWideEvents
+	ms.pendingWideEvents = nil
+	return events
+}
+
 func (ms *MutableStateImpl) IsSubStateMachineDeleted() bool {
 	return ms.subStateMachineDeleted
 }
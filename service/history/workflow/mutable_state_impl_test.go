@@ -4821,6 +4821,78 @@ func (s *mutableStateSuite) TestMaxAllowedTimer() {
 	}
 }
 
+func (s *mutableStateSuite) TestAddWorkflowExecutionStartedEvent_ReplicationFilter() {
+	testCases := []struct {
+		name         string
+		workflowType string
+		excluded     bool
+	}{
+		{
+			name:         "matched",
+			workflowType: "replicated-type",
+			excluded:     false,
+		},
+		{
+			name:         "not matched",
+			workflowType: "local-type",
+			excluded:     true,
+		},
+	}
+
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+			ReplicationFilter: &persistencespb.ReplicationFilter{WorkflowTypes: []string{"replicated-type"}},
+		},
+		tests.Version,
+	)
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).Times(2)
+			s.mutableState = NewMutableState(s.mockShard, s.mockEventsCache, s.logger, namespaceEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+
+			workflowKey := s.mutableState.GetWorkflowKey()
+			execution := &commonpb.WorkflowExecution{
+				WorkflowId: workflowKey.WorkflowID,
+				RunId:      workflowKey.RunID,
+			}
+			event, err := s.mutableState.AddWorkflowExecutionStartedEvent(
+				execution,
+				&historyservice.StartWorkflowExecutionRequest{
+					NamespaceId: workflowKey.NamespaceID,
+					StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+						Namespace:  namespaceEntry.Name().String(),
+						WorkflowId: workflowKey.WorkflowID,
+						WorkflowType: &commonpb.WorkflowType{
+							Name: tc.workflowType,
+						},
+						TaskQueue: &taskqueuepb.TaskQueue{
+							Name: "test-task-queue",
+						},
+					},
+				},
+			)
+			s.NoError(err)
+			s.Equal(tc.excluded, s.mutableState.GetExecutionInfo().GetExcludedFromReplication())
+
+			snapshot, _, err := s.mutableState.CloseTransactionAsSnapshot(context.Background(), historyi.TransactionPolicyActive)
+			s.NoError(err)
+			s.Equal(tc.excluded, len(snapshot.Tasks[tasks.CategoryReplication]) == 0)
+
+			// The filter is not evaluated again when the mutable state is rebuilt from the started event.
+			rebuiltMutableState := NewMutableState(s.mockShard, s.mockEventsCache, s.logger, namespaceEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+			s.NoError(rebuiltMutableState.ApplyWorkflowExecutionStartedEvent(nil, execution, "", event))
+			s.False(rebuiltMutableState.GetExecutionInfo().GetExcludedFromReplication())
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_SyncHSMTask() {
 	version := s.mutableState.GetCurrentVersion()
 	stateMachineDef := hsmtest.NewDefinition("test")
@@ -7011,6 +7083,28 @@ func (s *mutableStateSuite) TestDeleteCHASMPureTasks() {
 	}
 }
 
+func (s *mutableStateSuite) TestStartTransaction_ChasmSchemaUpgrade() {
+	dbState := s.buildWorkflowMutableState()
+	mutableState, err := NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, s.namespaceEntry, dbState, 123)
+	s.NoError(err)
+	mockChasmTree := historyi.NewMockChasmTree(s.controller)
+	mutableState.chasmTree = mockChasmTree
+
+	// Older server versions can't load upgraded state, so nothing is upgraded until the upgrade is enabled.
+	mockChasmTree.EXPECT().IsDirty().Return(false).Times(1)
+	flushBeforeReady, err := mutableState.StartTransaction(s.namespaceEntry)
+	s.NoError(err)
+	s.False(flushBeforeReady)
+
+	s.mockConfig.EnableCHASMSchemaUpgrade = dynamicconfig.GetBoolPropertyFn(true)
+	mockChasmTree.EXPECT().IsDirty().Return(false).Times(1)
+	mockChasmTree.EXPECT().NeedsSchemaUpgrade().Return(true).Times(1)
+	mockChasmTree.EXPECT().UpgradeSchema().Return(nil).Times(1)
+	flushBeforeReady, err = mutableState.StartTransaction(s.namespaceEntry)
+	s.NoError(err)
+	s.True(flushBeforeReady)
+}
+
 func (s *mutableStateSuite) TestCHASMNodeSize() {
 	dbState := s.buildWorkflowMutableState()
 	dbState = &persistencespb.WorkflowMutableState{
@@ -45,6 +45,14 @@ func (*noopChasmTree) RefreshTasks() error {
 	return nil
 }
 
+func (*noopChasmTree) NeedsSchemaUpgrade() bool {
+	return false
+}
+
+func (*noopChasmTree) UpgradeSchema() error {
+	return nil
+}
+
 func (*noopChasmTree) IsStateDirty() bool {
 	return false
 }
@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+// IsReplicationFilterMatched returns true if a workflow with the given type and search attributes should be replicated
+// according to the namespace replication filter. An empty filter replicates every workflow.
+func IsReplicationFilterMatched(
+	filter *persistencespb.ReplicationFilter,
+	workflowType string,
+	searchAttributes map[string]*commonpb.Payload,
+) bool {
+	if len(filter.GetWorkflowTypes()) == 0 && len(filter.GetSearchAttributes()) == 0 ||
+		slices.Contains(filter.GetWorkflowTypes(), workflowType) {
+		return true
+	}
+	for _, searchAttributeFilter := range filter.GetSearchAttributes() {
+		if searchAttributeMatches(searchAttributes[searchAttributeFilter.GetName()], searchAttributeFilter.GetValues()) {
+			return true
+		}
+	}
+	return false
+}
+
+func searchAttributeMatches(value *commonpb.Payload, filterValues []string) bool {
+	if value == nil {
+		return false
+	}
+	var keyword string
+	if err := payload.Decode(value, &keyword); err == nil {
+		return slices.Contains(filterValues, keyword)
+	}
+	var keywordList []string
+	if err := payload.Decode(value, &keywordList); err == nil {
+		for _, keyword := range keywordList {
+			if slices.Contains(filterValues, keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
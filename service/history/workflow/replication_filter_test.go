@@ -0,0 +1,75 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestIsReplicationFilterMatched(t *testing.T) {
+	t.Parallel()
+
+	keywordList, err := payload.Encode([]string{"gold", "silver"})
+	require.NoError(t, err)
+	searchAttributes := map[string]*commonpb.Payload{
+		"Tier":  payload.EncodeString("bronze"),
+		"Tags":  keywordList,
+		"Other": payload.EncodeString("platinum"),
+	}
+
+	testCases := []struct {
+		name    string
+		filter  *persistencespb.ReplicationFilter
+		matched bool
+	}{
+		{
+			name:    "no filter",
+			matched: true,
+		},
+		{
+			name:    "empty filter",
+			filter:  &persistencespb.ReplicationFilter{},
+			matched: true,
+		},
+		{
+			name:    "workflow type",
+			filter:  &persistencespb.ReplicationFilter{WorkflowTypes: []string{"other-type", "payment"}},
+			matched: true,
+		},
+		{
+			name: "keyword search attribute",
+			filter: &persistencespb.ReplicationFilter{SearchAttributes: []*persistencespb.SearchAttributeReplicationFilter{
+				{Name: "Tier", Values: []string{"bronze"}},
+			}},
+			matched: true,
+		},
+		{
+			name: "keyword list search attribute",
+			filter: &persistencespb.ReplicationFilter{SearchAttributes: []*persistencespb.SearchAttributeReplicationFilter{
+				{Name: "Tags", Values: []string{"silver"}},
+			}},
+			matched: true,
+		},
+		{
+			name: "no match",
+			filter: &persistencespb.ReplicationFilter{
+				WorkflowTypes: []string{"other-type"},
+				SearchAttributes: []*persistencespb.SearchAttributeReplicationFilter{
+					{Name: "Tier", Values: []string{"platinum"}},
+					{Name: "Missing", Values: []string{"bronze"}},
+				},
+			},
+			matched: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.matched, IsReplicationFilterMatched(tc.filter, "payment", searchAttributes))
+		})
+	}
+}
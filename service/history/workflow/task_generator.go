@@ -244,6 +244,13 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 				Version:     closeVersion,
 			},
 		)
+		if r.config.HistoryEventFeedEnabled(namespace.ID(r.mutableState.GetExecutionInfo().GetNamespaceId())) {
+			closeTasks = append(closeTasks, &tasks.PublishHistoryEventFeedTask{
+				// TaskID, VisibilityTimestamp is set by shard
+				WorkflowKey: r.mutableState.GetWorkflowKey(),
+				Version:     closeVersion,
+			})
+		}
 		if r.archivalEnabled() {
 			retention, err := r.getRetention()
 			if err != nil {
@@ -57,8 +57,10 @@ type testParams struct {
 	HistoryArchivalEnabledInNamespace    bool
 	VisibilityArchivalEnabledForCluster  bool
 	VisibilityArchivalEnabledInNamespace bool
+	HistoryEventFeedEnabled              bool
 
 	ExpectCloseExecutionVisibilityTask              bool
+	ExpectPublishHistoryEventFeedTask               bool
 	ExpectArchiveExecutionTask                      bool
 	ExpectDeleteHistoryEventTask                    bool
 	ExpectedArchiveExecutionTaskVisibilityTimestamp time.Time
@@ -161,6 +163,27 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				p.ExpectArchiveExecutionTask = false
 			},
 		},
+		{
+			Name: "history event feed enabled",
+			ConfigFn: func(p *testParams) {
+				p.HistoryEventFeedEnabled = true
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectPublishHistoryEventFeedTask = true
+				p.ExpectArchiveExecutionTask = true
+			},
+		},
+		{
+			Name: "delete after close skips history event feed",
+			ConfigFn: func(p *testParams) {
+				p.DeleteAfterClose = true
+				p.HistoryEventFeedEnabled = true
+
+				p.ExpectCloseExecutionVisibilityTask = false
+				p.ExpectPublishHistoryEventFeedTask = false
+				p.ExpectArchiveExecutionTask = false
+			},
+		},
 	} {
 		t.Run(c.Name, func(t *testing.T) {
 			t.Parallel()
@@ -242,6 +265,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				ArchivalProcessorArchiveDelay: func() time.Duration {
 					return p.ArchivalProcessorArchiveDelay
 				},
+				HistoryEventFeedEnabled: dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(p.HistoryEventFeedEnabled),
 			}
 			closeTime := time.Unix(0, 0)
 			var allTasks []tasks.Task
@@ -271,6 +295,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				closeExecutionTask           *tasks.CloseExecutionTask
 				deleteHistoryEventTask       *tasks.DeleteHistoryEventTask
 				closeExecutionVisibilityTask *tasks.CloseExecutionVisibilityTask
+				publishHistoryEventFeedTask  *tasks.PublishHistoryEventFeedTask
 				archiveExecutionTask         *tasks.ArchiveExecutionTask
 			)
 			for _, task := range allTasks {
@@ -281,6 +306,8 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 					deleteHistoryEventTask = t
 				case *tasks.CloseExecutionVisibilityTask:
 					closeExecutionVisibilityTask = t
+				case *tasks.PublishHistoryEventFeedTask:
+					publishHistoryEventFeedTask = t
 				case *tasks.ArchiveExecutionTask:
 					archiveExecutionTask = t
 				}
@@ -293,6 +320,14 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			} else {
 				assert.Nil(t, closeExecutionVisibilityTask)
 			}
+			if p.ExpectPublishHistoryEventFeedTask {
+				require.NotNil(t, publishHistoryEventFeedTask)
+				assert.Equal(t, namespaceEntry.ID().String(), publishHistoryEventFeedTask.NamespaceID)
+				assert.Equal(t, tests.WorkflowID, publishHistoryEventFeedTask.WorkflowID)
+				assert.Equal(t, tests.RunID, publishHistoryEventFeedTask.RunID)
+			} else {
+				assert.Nil(t, publishHistoryEventFeedTask)
+			}
 			if p.ExpectArchiveExecutionTask {
 				require.NotNil(t, archiveExecutionTask)
 				assert.Equal(t, archiveExecutionTask.NamespaceID, namespaceEntry.ID().String())
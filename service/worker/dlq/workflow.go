@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
@@ -15,11 +17,16 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/api/adminservice/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tasks"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -50,11 +57,25 @@ type (
 		TargetCluster string
 	}
 
+	// TaskFilter selects the tasks processed by a DLQ job. A task matches the filter if it matches all the non-empty
+	// fields of the filter. It is the workflow-friendly version of [commonspb.HistoryDLQTaskFilter].
+	TaskFilter struct {
+		NamespaceIDs     []string
+		WorkflowIDPrefix string
+		TaskTypes        []enumsspb.TaskType
+		// FailureContains matches tasks whose recorded failure contains this substring.
+		FailureContains string
+	}
+
 	// DeleteParams contain the target DLQ and the max message ID to delete up to.
 	DeleteParams struct {
 		Key
 		// MaxMessageID is inclusive.
 		MaxMessageID int64
+		// Filter selects the tasks to delete. The other tasks up to MaxMessageID are left in the DLQ.
+		Filter *TaskFilter
+		// DryRun reports the tasks which would be deleted without deleting them.
+		DryRun bool
 	}
 
 	// MergeParams contain the target DLQ and the max message ID to merge up to.
@@ -65,6 +86,15 @@ type (
 		// BatchSize controls the number of tasks to both read and re-enqueue at a time.
 		// The maximum is MaxMergeBatchSize. The default is DefaultMergeBatchSize.
 		BatchSize int
+		// Filter selects the tasks to merge. The other tasks up to MaxMessageID are left in the DLQ, unless
+		// PurgeUnmatched is set.
+		Filter *TaskFilter
+		// SkipClosedWorkflows deletes the selected tasks of closed or deleted workflows instead of re-enqueueing them.
+		SkipClosedWorkflows bool
+		// PurgeUnmatched deletes the tasks which don't match Filter instead of keeping them in the DLQ.
+		PurgeUnmatched bool
+		// DryRun reports the tasks which would be merged or deleted without changing the DLQ.
+		DryRun bool
 	}
 	// ProgressQueryResponse is the response to progress query.
 	ProgressQueryResponse struct {
@@ -73,6 +103,28 @@ type (
 		NumberOfMessagesProcessed int64
 		WorkflowType              string
 		DlqKey                    Key
+		DryRun                    bool
+		// NumberOfMessagesMatched is the number of messages which matched the filter of the job.
+		NumberOfMessagesMatched int64
+		NumberOfMessagesMerged  int64
+		NumberOfMessagesPurged  int64
+		// TaskOutcomes are the outcomes of the last MaxTaskOutcomes messages which matched the filter of the job. They
+		// are only reported for jobs with a filter, SkipClosedWorkflows or DryRun.
+		TaskOutcomes []TaskOutcome
+	}
+
+	// TaskOutcome describes what a DLQ job did, or would do for a dry run, with a task.
+	TaskOutcome struct {
+		MessageID   int64
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		TaskType    enumsspb.TaskType
+		// Outcome options are available via the TaskOutcome* constants.
+		Outcome string
+		// Reason explains why the task was not processed as requested.
+		Reason string
 	}
 
 	// HistoryClient contains the subset of methods from [historyservice.HistoryServiceClient] that we need, to make it
@@ -88,6 +140,11 @@ type (
 			in *historyservice.GetDLQTasksRequest,
 			opts ...grpc.CallOption,
 		) (*historyservice.GetDLQTasksResponse, error)
+		DescribeMutableState(
+			ctx context.Context,
+			in *historyservice.DescribeMutableStateRequest,
+			opts ...grpc.CallOption,
+		) (*historyservice.DescribeMutableStateResponse, error)
 	}
 
 	// TaskClient contains the subset of methods from [adminservice.AdminServiceClient] that we need, to make it easier
@@ -122,15 +179,25 @@ type (
 
 	workerComponentParams struct {
 		fx.In
-		HistoryClient      HistoryClient
-		CurrentClusterName CurrentClusterName
-		TaskClientDialer   TaskClientDialer
+		HistoryClient        HistoryClient
+		CurrentClusterName   CurrentClusterName
+		TaskClientDialer     TaskClientDialer
+		TaskCategoryRegistry tasks.TaskCategoryRegistry
+		Serializer           serialization.Serializer
 	}
 
 	workerComponent struct {
-		historyClient      HistoryClient
-		taskClientDialer   TaskClientDialer
-		currentClusterName string
+		historyClient        HistoryClient
+		taskClientDialer     TaskClientDialer
+		currentClusterName   string
+		taskCategoryRegistry tasks.TaskCategoryRegistry
+		serializer           serialization.Serializer
+	}
+
+	// executionKey identifies the execution of a DLQ task, which is a workflow or another CHASM archetype.
+	executionKey struct {
+		definition.WorkflowKey
+		archetypeID chasm.ArchetypeID
 	}
 )
 
@@ -150,12 +217,19 @@ const (
 	DefaultMergeBatchSize = 100
 	// QueryTypeProgress is the query to get the progress of the DLQ workflow.
 	QueryTypeProgress = "dlq-job-progress-query"
+	// TaskOutcomeMerged is the outcome of a task which was re-enqueued and deleted from the DLQ.
+	TaskOutcomeMerged = "merged"
+	// TaskOutcomePurged is the outcome of a task which was deleted from the DLQ without being re-enqueued.
+	TaskOutcomePurged = "purged"
+	// MaxTaskOutcomes is the maximum number of task outcomes reported by the progress query.
+	MaxTaskOutcomes = 1000
 
 	errorTypeInvalidWorkflowType = "dlq-error-type-invalid-workflow-type"
 	errorTypeInvalidRequest      = "dlq-error-type-invalid-request"
 	deleteTasksActivityName      = "dlq-delete-tasks-activity"
 	readTasksActivityName        = "dlq-read-tasks-activity"
 	reEnqueueTasksActivityName   = "dlq-re-enqueue-tasks-activity"
+	describeTasksActivityName    = "dlq-describe-tasks-activity"
 
 	// deleteTasksActivityTimeout is long because all tasks are deleted in a single go. This only applies when using the
 	// purge workflow, not when the delete activity is used in the merge workflow.
@@ -193,9 +267,11 @@ var (
 
 func newComponent(params workerComponentParams) workercommon.WorkerComponent {
 	return &workerComponent{
-		historyClient:      params.HistoryClient,
-		currentClusterName: string(params.CurrentClusterName),
-		taskClientDialer:   params.TaskClientDialer,
+		historyClient:        params.HistoryClient,
+		currentClusterName:   string(params.CurrentClusterName),
+		taskClientDialer:     params.TaskClientDialer,
+		taskCategoryRegistry: params.TaskCategoryRegistry,
+		serializer:           params.Serializer,
 	}
 }
 
@@ -217,6 +293,15 @@ func (c *workerComponent) workflow(ctx workflow.Context, params WorkflowParams)
 			SourceCluster:  params.DeleteParams.SourceCluster,
 			TargetCluster:  params.DeleteParams.TargetCluster,
 		}
+		if params.DeleteParams.Filter != nil || params.DeleteParams.DryRun {
+			// Filtered and dry-run deletes are processed in batches to report the outcome of each task.
+			return c.processTasks(ctx, MergeParams{
+				Key:          params.DeleteParams.Key,
+				MaxMessageID: params.DeleteParams.MaxMessageID,
+				Filter:       params.DeleteParams.Filter,
+				DryRun:       params.DeleteParams.DryRun,
+			}, false, &queryResponse)
+		}
 		var response historyservice.DeleteDLQTasksResponse
 		err = workflow.ExecuteActivity(
 			workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
@@ -240,7 +325,7 @@ func (c *workerComponent) workflow(ctx workflow.Context, params WorkflowParams)
 			SourceCluster:  params.MergeParams.SourceCluster,
 			TargetCluster:  params.MergeParams.TargetCluster,
 		}
-		return c.mergeTasks(ctx, params.MergeParams, &queryResponse.LastProcessedMessageID, &queryResponse.NumberOfMessagesProcessed)
+		return c.processTasks(ctx, params.MergeParams, true, &queryResponse)
 	}
 
 	return temporal.NewNonRetryableApplicationError(params.WorkflowType, errorTypeInvalidWorkflowType, nil)
@@ -256,6 +341,7 @@ func (c *workerComponent) deleteTasks(ctx context.Context, params DeleteParams)
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: params.MaxMessageID,
 		},
+		Filter: params.Filter.toProto(),
 	}
 
 	resp, err := c.historyClient.DeleteDLQTasks(ctx, req)
@@ -266,16 +352,19 @@ func (c *workerComponent) deleteTasks(ctx context.Context, params DeleteParams)
 	return resp, err
 }
 
-func (c *workerComponent) mergeTasks(
+// processTasks merges, or deletes if merge is false, the tasks of the DLQ up to the max message ID in batches, and
+// records the progress in the query response.
+func (c *workerComponent) processTasks(
 	ctx workflow.Context,
 	params MergeParams,
-	lastProcessedMessageID *int64,
-	numberOfMessagesProcessed *int64,
+	merge bool,
+	progress *ProgressQueryResponse,
 ) error {
 	params, err := parseMergeParams(params)
 	if err != nil {
 		return err
 	}
+	progress.DryRun = params.DryRun
 
 	var nextPageToken []byte
 
@@ -295,47 +384,79 @@ func (c *workerComponent) mergeTasks(
 			return err
 		}
 
-		if len(response.DlqTasks) == 0 {
+		// A filtered page may have no matching task even though tasks were read.
+		if len(response.DlqTasks) == 0 && response.LastReadTaskMetadata == nil {
 			return nil
 		}
 
 		nextPageToken = response.NextPageToken
 
 		// 1.b. Filter out tasks from messages beyond the last-desired message.
-		historyTasks := make([]*commonspb.HistoryTask, 0, len(response.DlqTasks))
+		dlqTasks := make([]*commonspb.HistoryDLQTask, 0, len(response.DlqTasks))
 		maxBatchMessageID := int64(persistence.FirstQueueMessageID)
 
 		for _, task := range response.DlqTasks {
 			if task.Metadata.MessageId <= params.MaxMessageID {
-				historyTasks = append(historyTasks, task.Payload)
+				dlqTasks = append(dlqTasks, task)
 				maxBatchMessageID = max(maxBatchMessageID, task.Metadata.MessageId)
 			}
 		}
+		if lastRead := response.LastReadTaskMetadata; lastRead != nil {
+			maxBatchMessageID = max(maxBatchMessageID, min(lastRead.MessageId, params.MaxMessageID))
+		}
 
-		// 2. Re-enqueue tasks.
-		err = workflow.ExecuteActivity(ctx, reEnqueueTasksActivityName, params, historyTasks).Get(ctx, nil)
-		if err != nil {
-			return err
+		// 1.c. Decide what to do with each task of selective jobs.
+		var outcomes []TaskOutcome
+		if params.selective() {
+			err = workflow.ExecuteActivity(ctx, describeTasksActivityName, params, merge, dlqTasks).Get(ctx, &outcomes)
+			if err != nil {
+				return err
+			}
+		}
+		var historyTasks []*commonspb.HistoryTask
+		for i, task := range dlqTasks {
+			if merge && (outcomes == nil || outcomes[i].Outcome == TaskOutcomeMerged) {
+				historyTasks = append(historyTasks, task.Payload)
+			}
 		}
 
-		// 3. Delete tasks from the DLQ.
-		err = workflow.ExecuteActivity(
-			workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
-				TaskQueue:           primitives.DLQActivityTQ,
-				RetryPolicy:         deleteActivityRetryPolicy,
-				StartToCloseTimeout: deleteTasksActivityTimeout,
-			}),
-			deleteTasksActivityName,
-			DeleteParams{
+		var messagesDeleted int64
+		if !params.DryRun {
+			// 2. Re-enqueue tasks.
+			if merge {
+				err = workflow.ExecuteActivity(ctx, reEnqueueTasksActivityName, params, historyTasks).Get(ctx, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			// 3. Delete tasks from the DLQ.
+			deleteParams := DeleteParams{
 				Key:          params.Key,
 				MaxMessageID: maxBatchMessageID,
-			},
-		).Get(ctx, nil)
-		if err != nil {
-			return err
+			}
+			if !merge || !params.PurgeUnmatched {
+				deleteParams.Filter = params.Filter
+			}
+			var deleteResponse historyservice.DeleteDLQTasksResponse
+			err = workflow.ExecuteActivity(
+				workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+					TaskQueue:           primitives.DLQActivityTQ,
+					RetryPolicy:         deleteActivityRetryPolicy,
+					StartToCloseTimeout: deleteTasksActivityTimeout,
+				}),
+				deleteTasksActivityName,
+				deleteParams,
+			).Get(ctx, &deleteResponse)
+			if err != nil {
+				return err
+			}
+			messagesDeleted = deleteResponse.MessagesDeleted
 		}
-		*lastProcessedMessageID = maxBatchMessageID
-		*numberOfMessagesProcessed += int64(len(historyTasks))
+
+		progress.LastProcessedMessageID = maxBatchMessageID
+		progress.NumberOfMessagesProcessed += int64(len(dlqTasks))
+		progress.recordBatch(int64(len(dlqTasks)), int64(len(historyTasks)), messagesDeleted, outcomes)
 		// 4. Check if we're done.
 		if len(nextPageToken) == 0 {
 			return nil
@@ -347,6 +468,35 @@ func (c *workerComponent) mergeTasks(
 	}
 }
 
+// recordBatch updates the counters and outcomes of the progress with a processed batch. The messages deleted beyond the
+// matched ones are the unmatched tasks purged by a merge.
+func (r *ProgressQueryResponse) recordBatch(matched, merged, deleted int64, outcomes []TaskOutcome) {
+	r.NumberOfMessagesMatched += matched
+	r.NumberOfMessagesMerged += merged
+	r.NumberOfMessagesPurged += matched - merged + max(deleted-matched, 0)
+	r.TaskOutcomes = append(r.TaskOutcomes, outcomes...)
+	if overflow := len(r.TaskOutcomes) - MaxTaskOutcomes; overflow > 0 {
+		r.TaskOutcomes = r.TaskOutcomes[overflow:]
+	}
+}
+
+// selective returns true if the outcome of each task must be decided and reported.
+func (p MergeParams) selective() bool {
+	return p.Filter != nil || p.SkipClosedWorkflows || p.DryRun
+}
+
+func (f *TaskFilter) toProto() *commonspb.HistoryDLQTaskFilter {
+	if f == nil {
+		return nil
+	}
+	return &commonspb.HistoryDLQTaskFilter{
+		NamespaceIds:     f.NamespaceIDs,
+		WorkflowIdPrefix: f.WorkflowIDPrefix,
+		TaskTypes:        f.TaskTypes,
+		FailureContains:  f.FailureContains,
+	}
+}
+
 func parseMergeParams(params MergeParams) (MergeParams, error) {
 	// Note that it's not strictly necessary to return a non-retryable error here because this is only called from
 	// within the workflow, and any errors returned from workflows are already non-retryable. However, we're returning
@@ -438,6 +588,7 @@ func (c *workerComponent) readTasks(
 		},
 		PageSize:      int32(params.BatchSize),
 		NextPageToken: nextPageToken,
+		Filter:        params.Filter.toProto(),
 	}
 
 	resp, err := c.historyClient.GetDLQTasks(ctx, req)
@@ -448,6 +599,96 @@ func (c *workerComponent) readTasks(
 	return resp, nil
 }
 
+// describeTasks decides the outcome of each task: merge jobs re-enqueue tasks unless their workflow is closed and
+// SkipClosedWorkflows is set, and delete jobs delete all of them.
+func (c *workerComponent) describeTasks(
+	ctx context.Context,
+	params MergeParams,
+	merge bool,
+	dlqTasks []*commonspb.HistoryDLQTask,
+) ([]TaskOutcome, error) {
+	category, ok := c.taskCategoryRegistry.GetCategoryByID(params.TaskCategoryID)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Invalid task category id: %v", params.TaskCategoryID),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+
+	closedExecutions := make(map[executionKey]bool)
+	outcomes := make([]TaskOutcome, len(dlqTasks))
+	for i, dlqTask := range dlqTasks {
+		outcome := TaskOutcome{
+			MessageID: dlqTask.Metadata.MessageId,
+			ShardID:   dlqTask.Payload.ShardId,
+			Outcome:   TaskOutcomePurged,
+		}
+		if merge {
+			outcome.Outcome = TaskOutcomeMerged
+		}
+		task, err := c.serializer.DeserializeTask(category, dlqTask.Payload.Blob)
+		if err != nil {
+			outcome.Reason = fmt.Sprintf("unable to deserialize task: %v", err)
+			outcomes[i] = outcome
+			continue
+		}
+		outcome.NamespaceID = task.GetNamespaceID()
+		outcome.WorkflowID = task.GetWorkflowID()
+		outcome.RunID = task.GetRunID()
+		outcome.TaskType = task.GetType()
+
+		if merge && params.SkipClosedWorkflows {
+			// Tasks of CHASM executions must be looked up with their archetype, the lookup of another archetype
+			// fails with NotFound.
+			key := executionKey{
+				WorkflowKey: definition.NewWorkflowKey(task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID()),
+				archetypeID: chasm.WorkflowArchetypeID,
+			}
+			if hasArchetypeID, ok := task.(tasks.HasArchetypeID); ok &&
+				hasArchetypeID.GetArchetypeID() != chasm.UnspecifiedArchetypeID {
+				key.archetypeID = hasArchetypeID.GetArchetypeID()
+			}
+			closed, ok := closedExecutions[key]
+			if !ok {
+				closed, err = c.isWorkflowClosed(ctx, key)
+				if err != nil {
+					return nil, err
+				}
+				closedExecutions[key] = closed
+			}
+			if closed {
+				outcome.Outcome = TaskOutcomePurged
+				outcome.Reason = "workflow is closed"
+			}
+		}
+		outcomes[i] = outcome
+	}
+	return outcomes, nil
+}
+
+// isWorkflowClosed returns true if the workflow, or the CHASM execution, is closed or doesn't exist anymore.
+func (c *workerComponent) isWorkflowClosed(ctx context.Context, key executionKey) (bool, error) {
+	resp, err := c.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: key.NamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: key.WorkflowID,
+			RunId:      key.RunID,
+		},
+		ArchetypeId: key.archetypeID,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		var namespaceNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) || errors.As(err, &namespaceNotFound) {
+			return true, nil
+		}
+		return false, c.convertServerErr(err, "DescribeMutableState failed")
+	}
+	status := resp.GetDatabaseMutableState().GetExecutionState().GetStatus()
+	return status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, nil
+}
+
 func (c *workerComponent) RegisterWorkflow(registry sdkworker.Registry) {
 	registry.RegisterWorkflowWithOptions(c.workflow, workflow.RegisterOptions{
 		Name: WorkflowName,
@@ -469,6 +710,9 @@ func (c *workerComponent) RegisterActivities(registry sdkworker.Registry) {
 	registry.RegisterActivityWithOptions(c.reEnqueueTasks, activity.RegisterOptions{
 		Name: reEnqueueTasksActivityName,
 	})
+	registry.RegisterActivityWithOptions(c.describeTasks, activity.RegisterOptions{
+		Name: describeTasksActivityName,
+	})
 }
 
 func (c *workerComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
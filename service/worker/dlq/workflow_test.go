@@ -2,17 +2,22 @@ package dlq_test
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/server/api/adminservice/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/tasks"
@@ -41,8 +46,9 @@ type (
 	}
 	// This client allows the test to set custom functions for each of its methods.
 	testHistoryClient struct {
-		getTasksFn    func(req *historyservice.GetDLQTasksRequest) (*historyservice.GetDLQTasksResponse, error)
-		deleteTasksFn func(req *historyservice.DeleteDLQTasksRequest) (*historyservice.DeleteDLQTasksResponse, error)
+		getTasksFn             func(req *historyservice.GetDLQTasksRequest) (*historyservice.GetDLQTasksResponse, error)
+		deleteTasksFn          func(req *historyservice.DeleteDLQTasksRequest) (*historyservice.DeleteDLQTasksResponse, error)
+		describeMutableStateFn func(req *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error)
 	}
 )
 
@@ -406,6 +412,217 @@ func TestModule(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "merge_filter_skip_closed_workflows",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.MaxMessageID = 3
+				params.workflowParams.MergeParams.Filter = &dlq.TaskFilter{WorkflowIDPrefix: "order-"}
+				params.workflowParams.MergeParams.SkipClosedWorkflows = true
+				params.expectedQueryResp.MaxMessageIDToProcess = 3
+				params.expectedQueryResp.LastProcessedMessageID = 3
+				params.expectedQueryResp.NumberOfMessagesProcessed = 2
+				params.client.getTasksFn = func(
+					req *historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return getFilteredResponse(t, req), nil
+				}
+				params.client.describeMutableStateFn = describeMutableState
+				var deleteRequests []*historyservice.DeleteDLQTasksRequest
+				params.client.deleteTasksFn = func(
+					req *historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					deleteRequests = append(deleteRequests, req)
+					return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: 2}, nil
+				}
+				var addRequests []*adminservice.AddTasksRequest
+				params.taskClientDialer = dlq.TaskClientDialerFn(func(ctx context.Context, address string) (dlq.TaskClient, error) {
+					return dlq.AddTasksFn(func(ctx context.Context, req *adminservice.AddTasksRequest) (*adminservice.AddTasksResponse, error) {
+						addRequests = append(addRequests, req)
+						return nil, nil
+					}), nil
+				})
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					require.Len(t, addRequests, 1)
+					assert.Len(t, addRequests[0].GetTasks(), 1)
+					require.Len(t, deleteRequests, 1)
+					assert.Equal(t, int64(3), deleteRequests[0].GetInclusiveMaxTaskMetadata().GetMessageId())
+					assert.Equal(t, "order-", deleteRequests[0].GetFilter().GetWorkflowIdPrefix())
+				}
+				queryExpectation := params.queryExpectation
+				params.queryExpectation = func(response dlq.ProgressQueryResponse) {
+					queryExpectation(response)
+					assert.False(t, response.DryRun)
+					assert.Equal(t, int64(2), response.NumberOfMessagesMatched)
+					assert.Equal(t, int64(1), response.NumberOfMessagesMerged)
+					assert.Equal(t, int64(1), response.NumberOfMessagesPurged)
+					assert.Equal(t, []dlq.TaskOutcome{
+						{
+							MessageID:   0,
+							ShardID:     1,
+							NamespaceID: "ns",
+							WorkflowID:  "order-closed",
+							RunID:       "run",
+							TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+							Outcome:     dlq.TaskOutcomePurged,
+							Reason:      "workflow is closed",
+						},
+						{
+							MessageID:   2,
+							ShardID:     1,
+							NamespaceID: "ns",
+							WorkflowID:  "order-running",
+							RunID:       "run",
+							TaskType:    enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+							Outcome:     dlq.TaskOutcomeMerged,
+						},
+					}, response.TaskOutcomes)
+				}
+			},
+		},
+		{
+			name: "merge_skip_closed_workflows_chasm",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.MaxMessageID = 3
+				params.workflowParams.MergeParams.Filter = &dlq.TaskFilter{WorkflowIDPrefix: "order-"}
+				params.workflowParams.MergeParams.SkipClosedWorkflows = true
+				params.expectedQueryResp.MaxMessageIDToProcess = 3
+				params.expectedQueryResp.LastProcessedMessageID = 3
+				params.expectedQueryResp.NumberOfMessagesProcessed = 1
+				blob, err := serialization.NewSerializer().SerializeTask(&tasks.ChasmTask{
+					WorkflowKey: definition.NewWorkflowKey("ns", "order-chasm", "run"),
+					Category:    tasks.CategoryTransfer,
+					Info:        &persistencespb.ChasmTaskInfo{ArchetypeId: 42},
+				})
+				require.NoError(t, err)
+				params.client.getTasksFn = func(
+					req *historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return &historyservice.GetDLQTasksResponse{
+						DlqTasks: []*commonspb.HistoryDLQTask{
+							{
+								Metadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 1},
+								Payload:  &commonspb.HistoryTask{ShardId: 1, Blob: blob},
+							},
+						},
+						LastReadTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 3},
+					}, nil
+				}
+				params.client.describeMutableStateFn = func(
+					req *historyservice.DescribeMutableStateRequest,
+				) (*historyservice.DescribeMutableStateResponse, error) {
+					// Looking up a CHASM execution as a workflow fails as if it didn't exist.
+					if req.GetArchetypeId() != 42 {
+						return nil, serviceerror.NewNotFound("CHASM Archetype missmatch")
+					}
+					return &historyservice.DescribeMutableStateResponse{
+						DatabaseMutableState: &persistencespb.WorkflowMutableState{
+							ExecutionState: &persistencespb.WorkflowExecutionState{
+								Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+							},
+						},
+					}, nil
+				}
+				params.client.deleteTasksFn = func(
+					req *historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: 1}, nil
+				}
+				var addRequests []*adminservice.AddTasksRequest
+				params.taskClientDialer = dlq.TaskClientDialerFn(func(ctx context.Context, address string) (dlq.TaskClient, error) {
+					return dlq.AddTasksFn(func(ctx context.Context, req *adminservice.AddTasksRequest) (*adminservice.AddTasksResponse, error) {
+						addRequests = append(addRequests, req)
+						return nil, nil
+					}), nil
+				})
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					require.Len(t, addRequests, 1)
+					assert.Len(t, addRequests[0].GetTasks(), 1)
+				}
+				queryExpectation := params.queryExpectation
+				params.queryExpectation = func(response dlq.ProgressQueryResponse) {
+					queryExpectation(response)
+					assert.Equal(t, int64(1), response.NumberOfMessagesMerged)
+					assert.Equal(t, int64(0), response.NumberOfMessagesPurged)
+					require.Len(t, response.TaskOutcomes, 1)
+					assert.Equal(t, dlq.TaskOutcomeMerged, response.TaskOutcomes[0].Outcome)
+				}
+			},
+		},
+		{
+			name: "merge_purge_unmatched",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.MaxMessageID = 3
+				params.workflowParams.MergeParams.Filter = &dlq.TaskFilter{WorkflowIDPrefix: "order-"}
+				params.workflowParams.MergeParams.PurgeUnmatched = true
+				params.expectedQueryResp.MaxMessageIDToProcess = 3
+				params.expectedQueryResp.LastProcessedMessageID = 3
+				params.expectedQueryResp.NumberOfMessagesProcessed = 2
+				params.client.getTasksFn = func(
+					req *historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return getFilteredResponse(t, req), nil
+				}
+				var deleteRequests []*historyservice.DeleteDLQTasksRequest
+				params.client.deleteTasksFn = func(
+					req *historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					deleteRequests = append(deleteRequests, req)
+					return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: 4}, nil
+				}
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					require.Len(t, deleteRequests, 1)
+					assert.Nil(t, deleteRequests[0].GetFilter())
+				}
+				queryExpectation := params.queryExpectation
+				params.queryExpectation = func(response dlq.ProgressQueryResponse) {
+					queryExpectation(response)
+					assert.Equal(t, int64(2), response.NumberOfMessagesMatched)
+					assert.Equal(t, int64(2), response.NumberOfMessagesMerged)
+					assert.Equal(t, int64(2), response.NumberOfMessagesPurged)
+					assert.Len(t, response.TaskOutcomes, 2)
+				}
+			},
+		},
+		{
+			name: "delete_filter_dry_run",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultDeleteParams(t)
+				params.workflowParams.DeleteParams.MaxMessageID = 3
+				params.workflowParams.DeleteParams.Filter = &dlq.TaskFilter{WorkflowIDPrefix: "order-"}
+				params.workflowParams.DeleteParams.DryRun = true
+				params.expectedQueryResp.MaxMessageIDToProcess = 3
+				params.expectedQueryResp.LastProcessedMessageID = 3
+				params.expectedQueryResp.NumberOfMessagesProcessed = 2
+				params.client.getTasksFn = func(
+					req *historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return getFilteredResponse(t, req), nil
+				}
+				params.client.deleteTasksFn = func(
+					*historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					return nil, errors.New("dry run should not delete tasks")
+				}
+				queryExpectation := params.queryExpectation
+				params.queryExpectation = func(response dlq.ProgressQueryResponse) {
+					queryExpectation(response)
+					assert.True(t, response.DryRun)
+					assert.Equal(t, int64(2), response.NumberOfMessagesMatched)
+					assert.Equal(t, int64(0), response.NumberOfMessagesMerged)
+					assert.Equal(t, int64(2), response.NumberOfMessagesPurged)
+					require.Len(t, response.TaskOutcomes, 2)
+					for _, outcome := range response.TaskOutcomes {
+						assert.Equal(t, dlq.TaskOutcomePurged, outcome.Outcome)
+					}
+				}
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -428,6 +645,10 @@ func TestModule(t *testing.T) {
 					func() dlq.CurrentClusterName {
 						return dlq.CurrentClusterName(params.currentClusterName)
 					},
+					func() tasks.TaskCategoryRegistry {
+						return tasks.NewDefaultTaskCategoryRegistry()
+					},
+					serialization.NewSerializer,
 				),
 				fx.Populate(fx.Annotate(&components, fx.ParamTags(workercommon.WorkerComponentTag))),
 			)
@@ -509,6 +730,50 @@ func getPaginatedResponse(req *historyservice.GetDLQTasksRequest) (*historyservi
 	}, nil
 }
 
+// getFilteredResponse returns the tasks of two workflows as if the filter of the request had matched them out of four
+// tasks read.
+func getFilteredResponse(t *testing.T, req *historyservice.GetDLQTasksRequest) *historyservice.GetDLQTasksResponse {
+	require.Equal(t, "order-", req.GetFilter().GetWorkflowIdPrefix())
+	var dlqTasks []*commonspb.HistoryDLQTask
+	for i, workflowID := range []string{"order-closed", "order-running"} {
+		blob, err := serialization.NewSerializer().SerializeTask(&tasks.ActivityTask{
+			WorkflowKey: definition.NewWorkflowKey("ns", workflowID, "run"),
+		})
+		require.NoError(t, err)
+		dlqTasks = append(dlqTasks, &commonspb.HistoryDLQTask{
+			Metadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: int64(2 * i),
+			},
+			Payload: &commonspb.HistoryTask{
+				ShardId: 1,
+				Blob:    blob,
+			},
+		})
+	}
+	return &historyservice.GetDLQTasksResponse{
+		DlqTasks: dlqTasks,
+		LastReadTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+			MessageId: 3,
+		},
+	}
+}
+
+func describeMutableState(
+	req *historyservice.DescribeMutableStateRequest,
+) (*historyservice.DescribeMutableStateResponse, error) {
+	status := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	if req.GetExecution().GetWorkflowId() == "order-closed" {
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	}
+	return &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				Status: status,
+			},
+		},
+	}, nil
+}
+
 func (p *testParams) setDefaultDeleteParams(t *testing.T) {
 	p.setDefaultParams(t)
 	p.workflowParams = dlq.WorkflowParams{
@@ -592,3 +857,9 @@ func (c *testHistoryClient) DeleteDLQTasks(
 ) (*historyservice.DeleteDLQTasksResponse, error) {
 	return c.deleteTasksFn(req)
 }
+
+func (c *testHistoryClient) DescribeMutableState(
+	_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...grpc.CallOption,
+) (*historyservice.DescribeMutableStateResponse, error) {
+	return c.describeMutableStateFn(req)
+}
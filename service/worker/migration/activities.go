@@ -17,7 +17,9 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/chasm"
 	chasmactivity "go.temporal.io/server/chasm/lib/activity"
@@ -33,9 +35,11 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/wideevents"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
@@ -86,6 +90,57 @@ type (
 		VerifiedWorkflowCount int64
 	}
 
+	compareReplicatedExecutionsRequest struct {
+		Namespace         string
+		NamespaceID       string
+		TargetClusterName string
+		Executions        []*ExecutionInfo
+		RPS               float64
+		ReReplicate       bool // generate replication tasks for executions which don't match
+	}
+
+	compareReplicatedExecutionsResponse struct {
+		VerifiedWorkflowCount     int64
+		SkippedWorkflowCount      int64
+		ReReplicatedWorkflowCount int64
+		Mismatches                []ExecutionMismatch
+	}
+
+	compareReplicatedExecutionsHeartbeatDetails struct {
+		NextIndex int
+		Response  compareReplicatedExecutionsResponse
+	}
+
+	shadowTrafficRequest struct {
+		Namespace         string
+		NamespaceID       string
+		TargetClusterName string
+		Executions        []*ExecutionInfo
+		RPS               float64
+	}
+
+	shadowTrafficResponse struct {
+		SkippedWorkflowCount int64
+		MirroredCallCount    int64
+		FailedCallCount      int64
+		LocalLatency         ShadowTrafficLatency
+		ShadowLatency        ShadowTrafficLatency
+		Mismatches           []ExecutionMismatch
+	}
+
+	shadowTrafficHeartbeatDetails struct {
+		NextIndex int
+		Response  shadowTrafficResponse
+	}
+
+	// ExecutionMismatch describes an execution whose mutable state on the target cluster doesn't match the one on
+	// the source cluster.
+	ExecutionMismatch struct {
+		Execution    *ExecutionInfo
+		Reasons      []string
+		ReReplicated bool
+	}
+
 	MetadataRequest struct {
 		Namespace string
 	}
@@ -117,6 +172,7 @@ type (
 	activities struct {
 		HistoryShardCount                int32
 		executionManager                 persistence.ExecutionManager
+		metadataManager                  persistence.MetadataManager
 		taskManager                      persistence.TaskManager
 		NamespaceRegistry                namespace.Registry
 		HistoryClient                    historyservice.HistoryServiceClient
@@ -134,6 +190,8 @@ type (
 		emitNamespaceLifecycleEvents     dynamicconfig.BoolPropertyFn
 		workflowVerifier                 WorkflowVerifier
 		chasmRegistry                    *chasm.Registry
+		shardPools                       *shardpool.Resolver
+		namespaceCacheRefreshInterval    dynamicconfig.DurationPropertyFn
 	}
 
 	shardStatus struct {
@@ -160,6 +218,7 @@ const (
 	reasonZombieWorkflow           = "Zombie workflow"
 	reasonWorkflowNotFound         = "Workflow not found"
 	reasonWorkflowCloseToRetention = "Workflow close to retention"
+	reasonExcludedFromReplication  = "Workflow excluded from replication"
 
 	notVerified verifyStatus = 0
 	verified    verifyStatus = 1
@@ -939,6 +998,16 @@ func (a *activities) checkSkipWorkflowExecution(
 		}, nil
 	}
 
+	// No replication task is generated for workflows excluded by the namespace replication filter, so they are not
+	// expected on the target cluster.
+	if resp.GetDatabaseMutableState().GetExecutionInfo().GetExcludedFromReplication() {
+		a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace)).Counter(metrics.EncounterExcludedFromReplicationWorkflowCount.Name()).Record(1)
+		return verifyResult{
+			status: skipped,
+			reason: reasonExcludedFromReplication,
+		}, nil
+	}
+
 	// Skip verifying workflow which has already passed retention time.
 	if closeTime := resp.GetDatabaseMutableState().GetExecutionInfo().GetCloseTime(); closeTime != nil && ns != nil && ns.Retention() > 0 {
 		deleteTime := closeTime.AsTime().Add(ns.Retention())
@@ -1136,6 +1205,297 @@ func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verify
 	}
 }
 
+// CompareReplicatedExecutions compares the mutable state of each execution on the current cluster with the one on the
+// target cluster, optionally generating replication tasks for the executions which don't match. Progress is recorded
+// in activity heartbeat so that a retried attempt doesn't compare the same executions again.
+func (a *activities) CompareReplicatedExecutions(ctx context.Context, request *compareReplicatedExecutionsRequest) (*compareReplicatedExecutionsResponse, error) {
+	var details compareReplicatedExecutionsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(request.TargetClusterName)
+	if err != nil {
+		return nil, err
+	}
+	nsEntry, err := a.NamespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptor.DCRedirectionContextHeaderName, "false"))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	generateViaFrontend := a.generateMigrationTaskViaFrontend()
+
+	for i := details.NextIndex; i < len(request.Executions); i++ {
+		execution := request.Executions[i]
+		if err := rateLimiter.WaitN(ctx, 1); err != nil {
+			return nil, err
+		}
+
+		reasons, skip, err := a.compareReplicatedExecution(ctx, request, remoteAdminClient, nsEntry, execution)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case skip:
+			details.Response.SkippedWorkflowCount++
+		case len(reasons) == 0:
+			details.Response.VerifiedWorkflowCount++
+		default:
+			a.Logger.Warn("verify-replication found mismatched execution",
+				tag.WorkflowNamespaceID(request.NamespaceID),
+				tag.WorkflowID(execution.BusinessID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.ClusterName(request.TargetClusterName),
+				tag.NewStringsTag("mismatches", reasons))
+
+			mismatch := ExecutionMismatch{
+				Execution: execution,
+				Reasons:   reasons,
+			}
+			if request.ReReplicate {
+				if err := a.generateWorkflowReplicationTask(
+					ctx,
+					rateLimiter,
+					request.Namespace,
+					request.NamespaceID,
+					execution,
+					[]string{request.TargetClusterName},
+					generateViaFrontend,
+				); err != nil && !common.IsNotFoundError(err) {
+					return nil, err
+				}
+				mismatch.ReReplicated = true
+				details.Response.ReReplicatedWorkflowCount++
+			}
+			details.Response.Mismatches = append(details.Response.Mismatches, mismatch)
+		}
+
+		details.NextIndex = i + 1
+		activity.RecordHeartbeat(ctx, details)
+	}
+
+	return &details.Response, nil
+}
+
+// compareReplicatedExecution returns the differences between the mutable state of the execution on the current
+// cluster and on the target cluster. Executions which are not expected to be replicated (deleted, zombie or past
+// retention) are skipped.
+func (a *activities) compareReplicatedExecution(
+	ctx context.Context,
+	request *compareReplicatedExecutionsRequest,
+	remoteAdminClient adminservice.AdminServiceClient,
+	ns *namespace.Namespace,
+	execution *ExecutionInfo,
+) (_ []string, skip bool, _ error) {
+	sourceResp, err := a.HistoryClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: request.NamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution.BusinessID,
+			RunId:      execution.RunID,
+		},
+		ArchetypeId:     execution.ArchetypeID,
+		SkipForceReload: true,
+	})
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			// The execution may be deleted (due to retention) after it was listed.
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+	source := sourceResp.GetDatabaseMutableState()
+	if source.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE {
+		return nil, true, nil
+	}
+	if closeTime := source.GetExecutionInfo().GetCloseTime(); closeTime != nil && ns.Retention() > 0 {
+		if closeTime.AsTime().Add(ns.Retention()).Before(time.Now()) {
+			return nil, true, nil
+		}
+	}
+	// Workflows excluded by the namespace replication filter are not expected on the target cluster.
+	if source.GetExecutionInfo().GetExcludedFromReplication() {
+		return nil, true, nil
+	}
+
+	archetype, err := a.archetypeIDToName(ctx, execution.ArchetypeID)
+	if err != nil {
+		return nil, false, err
+	}
+	targetResp, err := remoteAdminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: request.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution.BusinessID,
+			RunId:      execution.RunID,
+		},
+		Archetype:       archetype,
+		ArchetypeId:     execution.ArchetypeID,
+		SkipForceReload: true,
+	})
+	switch err.(type) {
+	case nil:
+		return compareMutableStates(source, targetResp.GetDatabaseMutableState()), false, nil
+	case *serviceerror.NotFound:
+		return []string{mismatchMissingOnTarget}, false, nil
+	default:
+		return nil, false, fmt.Errorf("failed to describe execution from the target cluster: %w", err)
+	}
+}
+
+// MirrorShadowTraffic sends the describe call of each execution to both the current and the target cluster, and
+// compares the responses. Calls which fail on the target cluster or return a different result are reported as
+// mismatches.
+func (a *activities) MirrorShadowTraffic(ctx context.Context, request *shadowTrafficRequest) (*shadowTrafficResponse, error) {
+	var details shadowTrafficHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	_, remoteFrontendClient, err := a.clientBean.GetRemoteFrontendClient(request.TargetClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	// Both clusters must serve the calls themselves, even though the namespace is not active on the target cluster.
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptor.DCRedirectionContextHeaderName, "false"))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	for i := details.NextIndex; i < len(request.Executions); i++ {
+		execution := request.Executions[i]
+		if err := rateLimiter.WaitN(ctx, 1); err != nil {
+			return nil, err
+		}
+
+		reasons, skip, err := a.mirrorShadowTraffic(ctx, request, remoteFrontendClient, execution, &details.Response)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case skip:
+			details.Response.SkippedWorkflowCount++
+		case len(reasons) > 0:
+			a.Logger.Warn("shadow-traffic found mismatched execution",
+				tag.WorkflowNamespaceID(request.NamespaceID),
+				tag.WorkflowID(execution.BusinessID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.ClusterName(request.TargetClusterName),
+				tag.NewStringsTag("mismatches", reasons))
+			details.Response.Mismatches = append(details.Response.Mismatches, ExecutionMismatch{
+				Execution: execution,
+				Reasons:   reasons,
+			})
+		}
+
+		details.NextIndex = i + 1
+		activity.RecordHeartbeat(ctx, details)
+	}
+
+	return &details.Response, nil
+}
+
+// mirrorShadowTraffic returns the differences between the responses of the current and the target cluster to the
+// read-only calls of the execution. Executions which are not workflows or which are not found on the current cluster
+// are skipped.
+func (a *activities) mirrorShadowTraffic(
+	ctx context.Context,
+	request *shadowTrafficRequest,
+	remoteFrontendClient workflowservice.WorkflowServiceClient,
+	execution *ExecutionInfo,
+	response *shadowTrafficResponse,
+) (_ []string, skip bool, _ error) {
+	if execution.ArchetypeID != chasm.UnspecifiedArchetypeID && execution.ArchetypeID != chasm.WorkflowArchetypeID {
+		return nil, true, nil
+	}
+	workflowExecution := &commonpb.WorkflowExecution{
+		WorkflowId: execution.BusinessID,
+		RunId:      execution.RunID,
+	}
+
+	describeRequest := &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: request.Namespace,
+		Execution: workflowExecution,
+	}
+	reasons, err := a.mirrorShadowTrafficCall(ctx, request, execution, "DescribeWorkflowExecution", remoteFrontendClient, response,
+		func(client workflowservice.WorkflowServiceClient) (any, error) {
+			return client.DescribeWorkflowExecution(ctx, describeRequest)
+		})
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			// The execution may be deleted (due to retention) after it was listed.
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+	return reasons, false, nil
+}
+
+// mirrorShadowTrafficCall sends the call to the current cluster, and then to the target cluster if the current cluster
+// served it. It returns the differences between the two responses, or the error returned by the current cluster.
+func (a *activities) mirrorShadowTrafficCall(
+	ctx context.Context,
+	request *shadowTrafficRequest,
+	execution *ExecutionInfo,
+	api string,
+	remoteFrontendClient workflowservice.WorkflowServiceClient,
+	response *shadowTrafficResponse,
+	call func(client workflowservice.WorkflowServiceClient) (any, error),
+) ([]string, error) {
+	startTime := time.Now()
+	localResp, err := call(a.frontendClient)
+	localLatency := time.Since(startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime = time.Now()
+	shadowResp, shadowErr := call(remoteFrontendClient)
+	shadowLatency := time.Since(startTime)
+	response.MirroredCallCount++
+	response.LocalLatency.record(localLatency)
+	response.ShadowLatency.record(shadowLatency)
+
+	var mismatches []string
+	if shadowErr != nil {
+		response.FailedCallCount++
+	} else {
+		mismatches = interceptor.ShadowResponseMismatches(localResp, shadowResp)
+		if len(mismatches) == 0 {
+			return nil, nil
+		}
+	}
+
+	payload := wideevents.ShadowTrafficMismatchPayload{
+		Namespace:     request.Namespace,
+		API:           api,
+		TargetCluster: request.TargetClusterName,
+		WorkflowID:    execution.BusinessID,
+		RunID:         execution.RunID,
+		LocalLatency:  localLatency,
+		ShadowLatency: shadowLatency,
+		Mismatches:    mismatches,
+	}
+	if shadowErr != nil {
+		payload.Error = shadowErr.Error()
+	}
+	wideevents.Emit(a.EventLogger, payload)
+
+	if shadowErr != nil {
+		return []string{fmt.Sprintf("%s: %v", api, shadowErr)}, nil
+	}
+	reasons := make([]string, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		reasons = append(reasons, api+"."+mismatch)
+	}
+	return reasons, nil
+}
+
 // WaitCatchup waits for the CatchupCluster to catch necessary data from the current cluster,
 // ensuring it has caught up to the TargetCluster's ack level for the specified namespace.
 func (a *activities) WaitCatchup(ctx context.Context, params CatchUpParams) error {
@@ -1321,3 +1681,296 @@ func (a *activities) archetypeIDToName(ctx context.Context, archetypeID chasm.Ar
 	}
 	return archetype, nil
 }
+
+// ValidateShardPoolMigration checks that the namespace can be moved to the target pool and returns the pool it is
+// currently assigned to, and the assignment to the target pool with the shard range the pool is currently defined
+// with.
+func (a *activities) ValidateShardPoolMigration(ctx context.Context, request validateShardPoolMigrationRequest) (*validateShardPoolMigrationResponse, error) {
+	source, err := a.shardPools.Assignment(request.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if source.GetName() == request.TargetPool {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: namespace is already placed on shard pool %q", request.TargetPool), "InvalidArgument", nil)
+	}
+	var target *persistencespb.ShardPoolAssignment
+	if request.TargetPool != "" {
+		if target, err = a.shardPools.NewAssignment(request.TargetPool); err != nil {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("InvalidArgument: %v", err), "InvalidArgument", nil)
+		}
+		if err := a.validateShardPoolAssignment(ctx, request.NamespaceID, target); err != nil {
+			return nil, err
+		}
+	}
+	return &validateShardPoolMigrationResponse{Source: source, Target: target}, nil
+}
+
+// CopyExecutionsToShardPool copies the given executions from the shards of the source pool to the shards of the
+// target pool. Executions placed on the same shard in both pools are skipped.
+func (a *activities) CopyExecutionsToShardPool(ctx context.Context, request *moveExecutionsRequest) (*moveExecutionsResponse, error) {
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	return a.moveExecutions(ctx, request, func(execution *ExecutionInfo, _ int32, targetShardID int32) error {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		return a.copyExecutionToShard(ctx, request.NamespaceID, execution, targetShardID)
+	})
+}
+
+// DeleteShardPoolSourceExecutions deletes the copies of the given executions left on the shards of the source pool.
+// Visibility records are kept since they are shared with the copies on the shards of the target pool.
+func (a *activities) DeleteShardPoolSourceExecutions(ctx context.Context, request *moveExecutionsRequest) (*moveExecutionsResponse, error) {
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	return a.moveExecutions(ctx, request, func(execution *ExecutionInfo, sourceShardID int32, _ int32) error {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		_, err := a.HistoryClient.ForceDeleteWorkflowExecution(ctx, &historyservice.ForceDeleteWorkflowExecutionRequest{
+			NamespaceId: request.NamespaceID,
+			Request: &adminservice.DeleteWorkflowExecutionRequest{
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: execution.BusinessID,
+					RunId:      execution.RunID,
+				},
+			},
+			ShardId:              sourceShardID,
+			KeepVisibilityRecord: true,
+		})
+		if common.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	})
+}
+
+func (a *activities) moveExecutions(
+	ctx context.Context,
+	request *moveExecutionsRequest,
+	moveFn func(execution *ExecutionInfo, sourceShardID int32, targetShardID int32) error,
+) (*moveExecutionsResponse, error) {
+	startIndex := 0
+	var movedCount int64
+	if activity.HasHeartbeatDetails(ctx) {
+		var details []int64
+		if err := activity.GetHeartbeatDetails(ctx, &details); err == nil && len(details) == 2 {
+			startIndex = int(details[0]) + 1 // start from next one
+			movedCount = details[1]
+		}
+	}
+
+	for i := startIndex; i < len(request.Executions); i++ {
+		execution := request.Executions[i]
+		if execution.ArchetypeID != chasm.UnspecifiedArchetypeID && execution.ArchetypeID != chasm.WorkflowArchetypeID {
+			// Only workflows can be imported from their history.
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("execution %v/%v is not a workflow and cannot be moved to another shard pool", execution.BusinessID, execution.RunID),
+				"UnsupportedArchetype",
+				nil,
+			)
+		}
+		sourceShardID, err := a.shardPools.AssignmentShardID(request.Source, request.NamespaceID, execution.BusinessID)
+		if err != nil {
+			return nil, err
+		}
+		targetShardID, err := a.shardPools.AssignmentShardID(request.Target, request.NamespaceID, execution.BusinessID)
+		if err != nil {
+			return nil, err
+		}
+		if sourceShardID != targetShardID {
+			if err := moveFn(execution, sourceShardID, targetShardID); err != nil {
+				a.Logger.Error("shard-pool-migration failed to move execution",
+					tag.WorkflowNamespaceID(request.NamespaceID),
+					tag.WorkflowID(execution.BusinessID),
+					tag.WorkflowRunID(execution.RunID),
+					tag.Error(err))
+				return nil, err
+			}
+			movedCount++
+		}
+		activity.RecordHeartbeat(ctx, []int64{int64(i), movedCount})
+	}
+
+	return &moveExecutionsResponse{MovedCount: movedCount}, nil
+}
+
+func (a *activities) copyExecutionToShard(
+	ctx context.Context,
+	namespaceID string,
+	execution *ExecutionInfo,
+	targetShardID int32,
+) error {
+	workflowExecution := &commonpb.WorkflowExecution{
+		WorkflowId: execution.BusinessID,
+		RunId:      execution.RunID,
+	}
+	importFn := func(blobs []*commonpb.DataBlob, versionHistory *historyspb.VersionHistory, token []byte) ([]byte, error) {
+		resp, err := a.HistoryClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:    namespaceID,
+			Execution:      workflowExecution,
+			HistoryBatches: blobs,
+			VersionHistory: versionHistory,
+			Token:          token,
+			ShardId:        targetShardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetToken(), nil
+	}
+
+	var token, nextPageToken []byte
+	var versionHistory *historyspb.VersionHistory
+	for {
+		// The namespace is still assigned to the source pool, so the history is read from its shard.
+		resp, err := a.HistoryClient.GetWorkflowExecutionRawHistoryV2(ctx, &historyservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: namespaceID,
+			Request: &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+				NamespaceId:     namespaceID,
+				Execution:       workflowExecution,
+				MaximumPageSize: shardPoolMigrationHistoryPageSize,
+				NextPageToken:   nextPageToken,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		versionHistory = resp.GetResponse().GetVersionHistory()
+		if len(resp.GetResponse().GetHistoryBatches()) > 0 {
+			if token, err = importFn(resp.GetResponse().GetHistoryBatches(), versionHistory, token); err != nil {
+				return err
+			}
+		}
+		nextPageToken = resp.GetResponse().GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	// call with empty history to commit
+	token, err := importFn(nil, versionHistory, token)
+	if err != nil {
+		return err
+	}
+	if len(token) != 0 {
+		return serviceerror.NewInternal("Failed to commit import transaction")
+	}
+	return nil
+}
+
+// AssignShardPool assigns the namespace to the target pool in its namespace config, recording the shard range of the
+// pool. The assignment is validated again against the other namespaces, since they may have been assigned to
+// overlapping pools since the migration started.
+func (a *activities) AssignShardPool(ctx context.Context, request assignShardPoolRequest) error {
+	if request.Target != nil {
+		if err := a.validateShardPoolAssignment(ctx, request.NamespaceID, request.Target); err != nil {
+			return err
+		}
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: request.NamespaceID})
+	if err != nil {
+		return err
+	}
+	if proto.Equal(getResponse.Namespace.GetConfig().GetShardPool(), request.Target) {
+		return nil
+	}
+
+	// The pool is local to the cluster and not replicated, so the config version is kept. Bumping it would make this
+	// cluster drop the next config update replicated from the other clusters.
+	getResponse.Namespace.Config.ShardPool = request.Target
+	return a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           getResponse.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+	})
+}
+
+// validateShardPoolAssignment checks the assignment of a namespace to a pool against the shard ranges recorded for the
+// other namespaces: namespaces assigned to the same pool must be placed on the same range, and no other pool may
+// overlap it. Otherwise a pool that was resized or redefined in dynamic config would share shards with another one.
+func (a *activities) validateShardPoolAssignment(
+	ctx context.Context,
+	namespaceID string,
+	target *persistencespb.ShardPoolAssignment,
+) error {
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       shardPoolListNamespacesPageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			other := ns.Namespace.GetConfig().GetShardPool()
+			if other == nil || ns.Namespace.GetInfo().GetId() == namespaceID {
+				continue
+			}
+			if other.GetName() == target.GetName() && !proto.Equal(other, target) {
+				return temporal.NewNonRetryableApplicationError(fmt.Sprintf(
+					"InvalidArgument: shard pool %q is defined with shard range [%d, %d] but namespace %s was assigned to it with shard range [%d, %d], move that namespace out of the pool before resizing it",
+					target.GetName(), target.GetFirstShardId(), target.GetLastShardId(),
+					ns.Namespace.GetInfo().GetName(), other.GetFirstShardId(), other.GetLastShardId(),
+				), "InvalidArgument", nil)
+			}
+			if other.GetName() != target.GetName() && shardpool.Overlaps(other, target) {
+				return temporal.NewNonRetryableApplicationError(fmt.Sprintf(
+					"InvalidArgument: shard pool %q with shard range [%d, %d] overlaps shard pool %q with shard range [%d, %d] namespace %s is assigned to",
+					target.GetName(), target.GetFirstShardId(), target.GetLastShardId(),
+					other.GetName(), other.GetFirstShardId(), other.GetLastShardId(), ns.Namespace.GetInfo().GetName(),
+				), "InvalidArgument", nil)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+// WaitShardPoolAssignment waits until the namespace registry reflects the assignment of the namespace to the target
+// pool, then for another namespace registry refresh interval. Every host reloads the namespace from persistence
+// within that interval, so none of them routes the executions of the namespace to the source pool afterwards.
+func (a *activities) WaitShardPoolAssignment(ctx context.Context, request waitShardPoolAssignmentRequest) error {
+	for {
+		assignment, err := a.shardPools.Assignment(request.NamespaceID)
+		if err != nil {
+			return err
+		}
+		if proto.Equal(assignment, request.Target) {
+			break
+		}
+		// keep waiting and check again
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(shardPoolAssignmentCheckInterval):
+		}
+		activity.RecordHeartbeat(ctx, nil)
+	}
+
+	refreshed := time.After(a.namespaceCacheRefreshInterval())
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-refreshed:
+			return nil
+		case <-time.After(shardPoolAssignmentCheckInterval):
+		}
+		activity.RecordHeartbeat(ctx, nil)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/testsuite"
@@ -96,6 +97,17 @@ var (
 		},
 	}
 
+	excludedFromReplicationState = &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				ExcludedFromReplication: true,
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			},
+		},
+	}
+
 	testNamespace = namespace.Namespace{}
 )
 
@@ -252,6 +264,12 @@ func (s *activitiesSuite) TestVerifyReplicationTasks_SkipWorkflowExecution() {
 			reasonWorkflowNotFound,
 			nil,
 		},
+		{
+			excludedFromReplicationState,
+			nil,
+			reasonExcludedFromReplication,
+			nil,
+		},
 		{
 			nil, mockErr,
 			"",
@@ -836,6 +854,194 @@ func (s *activitiesSuite) TestGenerateReplicationTasks_Success_ViaFrontend() {
 	s.Equal(1, lastHeartBeat)
 }
 
+func (s *activitiesSuite) TestCompareReplicatedExecutions() {
+	env, iceptor := s.initEnv()
+
+	execution3 := &ExecutionInfo{
+		BusinessID:  "workflow3",
+		RunID:       "run3",
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	}
+	request := compareReplicatedExecutionsRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*ExecutionInfo{execution1, execution2, execution3},
+		RPS:               10,
+		ReReplicate:       true,
+	}
+
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventId: 5,
+	}
+	for _, execution := range request.Executions {
+		localResp := &historyservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState}
+		var localErr error
+		if execution == execution3 {
+			localResp, localErr = nil, serviceerror.NewNotFound("")
+		}
+		s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+			NamespaceId: mockedNamespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: execution.BusinessID,
+				RunId:      execution.RunID,
+			},
+			ArchetypeId:     execution.ArchetypeID,
+			SkipForceReload: true,
+		})).Return(localResp, localErr).Times(1)
+	}
+
+	// execution1 matches, execution2 is missing on the target cluster.
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution1.BusinessID,
+			RunId:      execution1.RunID,
+		},
+		Archetype:       chasm.WorkflowArchetype,
+		ArchetypeId:     execution1.ArchetypeID,
+		SkipForceReload: true,
+	})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState}, nil).Times(1)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution2.BusinessID,
+			RunId:      execution2.RunID,
+		},
+		Archetype:       chasm.WorkflowArchetype,
+		ArchetypeId:     execution2.ArchetypeID,
+		SkipForceReload: true,
+	})).Return(nil, serviceerror.NewNotFound("")).Times(1)
+	s.mockHistoryClient.EXPECT().GenerateLastHistoryReplicationTasks(gomock.Any(), protomock.Eq(&historyservice.GenerateLastHistoryReplicationTasksRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution2.BusinessID,
+			RunId:      execution2.RunID,
+		},
+		ArchetypeId:    execution2.ArchetypeID,
+		TargetClusters: []string{remoteCluster},
+	})).Return(&historyservice.GenerateLastHistoryReplicationTasksResponse{}, nil).Times(1)
+
+	future, err := env.ExecuteActivity(s.a.CompareReplicatedExecutions, &request)
+	s.NoError(err)
+
+	var resp compareReplicatedExecutionsResponse
+	s.NoError(future.Get(&resp))
+	s.Equal(compareReplicatedExecutionsResponse{
+		VerifiedWorkflowCount:     1,
+		SkippedWorkflowCount:      1,
+		ReReplicatedWorkflowCount: 1,
+		Mismatches: []ExecutionMismatch{
+			{
+				Execution:    execution2,
+				Reasons:      []string{mismatchMissingOnTarget},
+				ReReplicated: true,
+			},
+		},
+	}, resp)
+	s.Len(iceptor.compareRecordedHeartbeats, 3)
+	s.Equal(3, iceptor.compareRecordedHeartbeats[2].NextIndex)
+}
+
+func (s *activitiesSuite) TestCompareReplicatedExecutions_ExcludedByReplicationFilter() {
+	env, _ := s.initEnv()
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{ExcludedFromReplication: true},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			},
+		},
+	}, nil).Times(1)
+
+	future, err := env.ExecuteActivity(s.a.CompareReplicatedExecutions, &compareReplicatedExecutionsRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*ExecutionInfo{execution1},
+		RPS:               10,
+	})
+	s.NoError(err)
+
+	var resp compareReplicatedExecutionsResponse
+	s.NoError(future.Get(&resp))
+	s.Equal(compareReplicatedExecutionsResponse{SkippedWorkflowCount: 1}, resp)
+}
+
+func (s *activitiesSuite) TestMirrorShadowTraffic() {
+	env, iceptor := s.initEnv()
+	mockRemoteFrontendClient := workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockClientBean.EXPECT().GetRemoteFrontendClient(remoteCluster).Return(nil, mockRemoteFrontendClient, nil).Times(1)
+
+	execution3 := &ExecutionInfo{
+		BusinessID:  "workflow3",
+		RunID:       "run3",
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	}
+	describeRequest := func(execution *ExecutionInfo) *workflowservice.DescribeWorkflowExecutionRequest {
+		return &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: mockedNamespace,
+			Execution: &commonpb.WorkflowExecution{WorkflowId: execution.BusinessID, RunId: execution.RunID},
+		}
+	}
+	describeResponse := &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime:     timestamppb.New(time.Unix(100, 0)),
+			HistoryLength: 5,
+		},
+	}
+
+	// execution1 is closed on both clusters, but with a different history on the target cluster.
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), protomock.Eq(describeRequest(execution1))).Return(describeResponse, nil).Times(1)
+	mockRemoteFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), protomock.Eq(describeRequest(execution1))).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime:     timestamppb.New(time.Unix(100, 0)),
+			HistoryLength: 4,
+		},
+	}, nil).Times(1)
+	// execution2 was deleted from the current cluster after it was listed.
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), protomock.Eq(describeRequest(execution2))).Return(nil, serviceerror.NewNotFound("")).Times(1)
+	// execution3 can't be described by the target cluster.
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), protomock.Eq(describeRequest(execution3))).Return(describeResponse, nil).Times(1)
+	mockRemoteFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), protomock.Eq(describeRequest(execution3))).Return(nil, serviceerror.NewUnavailable("unavailable")).Times(1)
+
+	future, err := env.ExecuteActivity(s.a.MirrorShadowTraffic, &shadowTrafficRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*ExecutionInfo{execution1, execution2, execution3},
+		RPS:               10,
+	})
+	s.NoError(err)
+
+	var resp shadowTrafficResponse
+	s.NoError(future.Get(&resp))
+	s.Equal(int64(1), resp.SkippedWorkflowCount)
+	s.Equal(int64(2), resp.MirroredCallCount)
+	s.Equal(int64(1), resp.FailedCallCount)
+	s.Equal(int64(2), resp.LocalLatency.Count)
+	s.Equal(int64(2), resp.ShadowLatency.Count)
+	s.Equal([]ExecutionMismatch{
+		{
+			Execution: execution1,
+			Reasons:   []string{"DescribeWorkflowExecution.history_length"},
+		},
+		{
+			Execution: execution3,
+			Reasons:   []string{"DescribeWorkflowExecution: unavailable"},
+		},
+	}, resp.Mismatches)
+	s.Len(iceptor.shadowTrafficRecordedHeartbeats, 3)
+	s.Equal(3, iceptor.shadowTrafficRecordedHeartbeats[2].NextIndex)
+}
+
 func (s *activitiesSuite) TestCountWorkflows() {
 	env, _ := s.initEnv()
 
@@ -730,6 +730,8 @@ type heartbeatRecordingInterceptor struct {
 	seedRecordedHeartbeats                []seedReplicationQueueWithUserDataEntriesHeartbeatDetails
 	replicationRecordedHeartbeats         []replicationTasksHeartbeatDetails
 	generateReplicationRecordedHeartbeats []int
+	compareRecordedHeartbeats             []compareReplicatedExecutionsHeartbeatDetails
+	shadowTrafficRecordedHeartbeats       []shadowTrafficHeartbeatDetails
 	T                                     *testing.T
 }
 
@@ -750,6 +752,10 @@ func (i *heartbeatRecordingInterceptor) RecordHeartbeat(ctx context.Context, det
 		i.replicationRecordedHeartbeats = append(i.replicationRecordedHeartbeats, d)
 	} else if d, ok := details[0].(int); ok {
 		i.generateReplicationRecordedHeartbeats = append(i.generateReplicationRecordedHeartbeats, d)
+	} else if d, ok := details[0].(compareReplicatedExecutionsHeartbeatDetails); ok {
+		i.compareRecordedHeartbeats = append(i.compareRecordedHeartbeats, d)
+	} else if d, ok := details[0].(shadowTrafficHeartbeatDetails); ok {
+		i.shadowTrafficRecordedHeartbeats = append(i.shadowTrafficRecordedHeartbeats, d)
 	} else {
 		assert.Fail(i.T, "invalid heartbeat details")
 	}
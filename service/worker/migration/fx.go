@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/shardpool"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
 )
@@ -28,6 +29,7 @@ type (
 		fx.In
 		PersistenceConfig         *config.Persistence
 		ExecutionManager          persistence.ExecutionManager
+		MetadataManager           persistence.MetadataManager
 		NamespaceRegistry         namespace.Registry
 		HistoryClient             resource.HistoryClient
 		FrontendClient            workflowservice.WorkflowServiceClient
@@ -41,6 +43,7 @@ type (
 		DynamicCollection         *dynamicconfig.Collection
 		WorkflowVerifier          WorkflowVerifier
 		ChasmRegistry             *chasm.Registry
+		HistoryShardPools         *shardpool.Resolver
 	}
 
 	fxResult struct {
@@ -73,6 +76,9 @@ func (wc *replicationWorkerComponent) RegisterWorkflow(registry sdkworker.Regist
 	registry.RegisterWorkflowWithOptions(ForceReplicationWorkflowV2, workflow.RegisterOptions{Name: forceReplicationWorkflowV2Name})
 	registry.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
 	registry.RegisterWorkflowWithOptions(NamespaceHandoverWorkflowV2, workflow.RegisterOptions{Name: namespaceHandoverWorkflowV2Name})
+	registry.RegisterWorkflowWithOptions(ShardPoolMigrationWorkflow, workflow.RegisterOptions{Name: shardPoolMigrationWorkflowName})
+	registry.RegisterWorkflowWithOptions(VerifyReplicationWorkflow, workflow.RegisterOptions{Name: verifyReplicationWorkflowName})
+	registry.RegisterWorkflowWithOptions(ShadowTrafficWorkflow, workflow.RegisterOptions{Name: shadowTrafficWorkflowName})
 	registry.RegisterWorkflowWithOptions(ForceTaskQueueUserDataReplicationWorkflow, workflow.RegisterOptions{Name: forceTaskQueueUserDataReplicationWorkflow})
 }
 
@@ -114,6 +120,7 @@ func (wc *replicationWorkerComponent) activities() *activities {
 	return &activities{
 		HistoryShardCount:                wc.PersistenceConfig.NumHistoryShards,
 		executionManager:                 wc.ExecutionManager,
+		metadataManager:                  wc.MetadataManager,
 		NamespaceRegistry:                wc.NamespaceRegistry,
 		HistoryClient:                    wc.HistoryClient,
 		frontendClient:                   wc.FrontendClient,
@@ -130,5 +137,7 @@ func (wc *replicationWorkerComponent) activities() *activities {
 		emitNamespaceLifecycleEvents:     dynamicconfig.EmitNamespaceLifecycleEvents.Get(wc.DynamicCollection),
 		workflowVerifier:                 wc.WorkflowVerifier,
 		chasmRegistry:                    wc.ChasmRegistry,
+		shardPools:                       wc.HistoryShardPools,
+		namespaceCacheRefreshInterval:    dynamicconfig.NamespaceCacheRefreshInterval.Get(wc.DynamicCollection),
 	}
 }
@@ -0,0 +1,175 @@
+package migration
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/transitionhistory"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	mismatchMissingOnTarget   = "execution missing on target cluster"
+	mismatchExecutionState    = "execution state"
+	mismatchVersionHistory    = "version history"
+	mismatchNextEventID       = "next event ID"
+	mismatchTransitionHistory = "transition history"
+	mismatchPendingActivities = "pending activities"
+	mismatchPendingTimers     = "pending timers"
+	mismatchPendingChildren   = "pending child executions"
+	mismatchPendingCancels    = "pending request cancels"
+	mismatchPendingSignals    = "pending signals"
+	mismatchChasmTree         = "CHASM tree digest"
+)
+
+// compareMutableStates compares the replicated parts of the mutable state of an execution on the source cluster with
+// the one on the target cluster, and returns a description of every difference found. Fields that are maintained
+// independently by each cluster (e.g. task statuses and DB record versions) are not compared.
+func compareMutableStates(source, target *persistencespb.WorkflowMutableState) []string {
+	var mismatches []string
+
+	sourceState := source.GetExecutionState()
+	targetState := target.GetExecutionState()
+	if sourceState.GetState() != targetState.GetState() || sourceState.GetStatus() != targetState.GetStatus() {
+		mismatches = append(mismatches, fmt.Sprintf("%s: source %v/%v, target %v/%v",
+			mismatchExecutionState, sourceState.GetState(), sourceState.GetStatus(), targetState.GetState(), targetState.GetStatus()))
+	}
+
+	if mismatch := compareVersionHistories(source, target); mismatch != "" {
+		mismatches = append(mismatches, mismatch)
+	}
+
+	if source.GetNextEventId() != target.GetNextEventId() {
+		mismatches = append(mismatches, fmt.Sprintf("%s: source %d, target %d",
+			mismatchNextEventID, source.GetNextEventId(), target.GetNextEventId()))
+	}
+
+	// Transition history is only available when state-based replication is enabled for the execution.
+	sourceTransition := transitionhistory.LastVersionedTransition(source.GetExecutionInfo().GetTransitionHistory())
+	targetTransition := transitionhistory.LastVersionedTransition(target.GetExecutionInfo().GetTransitionHistory())
+	if sourceTransition != nil && transitionhistory.Compare(sourceTransition, targetTransition) != 0 {
+		mismatches = append(mismatches, fmt.Sprintf("%s: source %v, target %v",
+			mismatchTransitionHistory, sourceTransition, targetTransition))
+	}
+
+	mismatches = appendKeysMismatch(mismatches, mismatchPendingActivities, source.GetActivityInfos(), target.GetActivityInfos())
+	mismatches = appendKeysMismatch(mismatches, mismatchPendingTimers, source.GetTimerInfos(), target.GetTimerInfos())
+	mismatches = appendKeysMismatch(mismatches, mismatchPendingChildren, source.GetChildExecutionInfos(), target.GetChildExecutionInfos())
+	mismatches = appendKeysMismatch(mismatches, mismatchPendingCancels, source.GetRequestCancelInfos(), target.GetRequestCancelInfos())
+	mismatches = appendKeysMismatch(mismatches, mismatchPendingSignals, source.GetSignalInfos(), target.GetSignalInfos())
+
+	sourceDigest, err := chasmTreeDigest(source.GetChasmNodes())
+	if err != nil {
+		return append(mismatches, fmt.Sprintf("%s: %v", mismatchChasmTree, err))
+	}
+	targetDigest, err := chasmTreeDigest(target.GetChasmNodes())
+	if err != nil {
+		return append(mismatches, fmt.Sprintf("%s: %v", mismatchChasmTree, err))
+	}
+	if sourceDigest != targetDigest {
+		mismatches = append(mismatches, fmt.Sprintf("%s: source %x (%d nodes), target %x (%d nodes)",
+			mismatchChasmTree, sourceDigest, len(source.GetChasmNodes()), targetDigest, len(target.GetChasmNodes())))
+	}
+
+	return mismatches
+}
+
+func compareVersionHistories(source, target *persistencespb.WorkflowMutableState) string {
+	sourceHistories := source.GetExecutionInfo().GetVersionHistories()
+	if sourceHistories == nil {
+		// Executions without events (e.g. non-workflow CHASM executions) have no version history to compare.
+		return ""
+	}
+	sourceHistory, err := versionhistory.GetCurrentVersionHistory(sourceHistories)
+	if err != nil {
+		return fmt.Sprintf("%s: %v", mismatchVersionHistory, err)
+	}
+	targetHistories := target.GetExecutionInfo().GetVersionHistories()
+	if targetHistories == nil {
+		return fmt.Sprintf("%s: source %v, target missing", mismatchVersionHistory, sourceHistory.GetItems())
+	}
+	targetHistory, err := versionhistory.GetCurrentVersionHistory(targetHistories)
+	if err != nil {
+		return fmt.Sprintf("%s: %v", mismatchVersionHistory, err)
+	}
+	if !versionhistory.IsEqualVersionHistoryItems(sourceHistory.GetItems(), targetHistory.GetItems()) {
+		return fmt.Sprintf("%s: source %v, target %v", mismatchVersionHistory, sourceHistory.GetItems(), targetHistory.GetItems())
+	}
+	return ""
+}
+
+func appendKeysMismatch[K comparable, V any](mismatches []string, name string, source, target map[K]V) []string {
+	missing, unexpected := 0, 0
+	for key := range source {
+		if _, ok := target[key]; !ok {
+			missing++
+		}
+	}
+	for key := range target {
+		if _, ok := source[key]; !ok {
+			unexpected++
+		}
+	}
+	if missing == 0 && unexpected == 0 {
+		return mismatches
+	}
+	return append(mismatches, fmt.Sprintf("%s: source %d, target %d, missing on target %d, unexpected on target %d",
+		name, len(source), len(target), missing, unexpected))
+}
+
+// chasmTreeDigest returns a digest of the CHASM tree which is independent of map iteration order. Cluster-local fields
+// are left out, since they legitimately differ between clusters.
+func chasmTreeDigest(nodes map[string]*persistencespb.ChasmNode) (uint64, error) {
+	paths := make([]string, 0, len(nodes))
+	for path := range nodes {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	h := fnv.New64a()
+	marshalOptions := proto.MarshalOptions{Deterministic: true}
+	for _, path := range paths {
+		blob, err := marshalOptions.Marshal(withoutClusterLocalFields(nodes[path]))
+		if err != nil {
+			return 0, err
+		}
+		_, _ = h.Write([]byte(path))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write(blob)
+	}
+	return h.Sum64(), nil
+}
+
+// withoutClusterLocalFields returns a copy of the node with its cluster-local fields zeroed, like
+// chasm.Node.PartitionedSnapshot does before replicating the tree. The node itself is not modified.
+func withoutClusterLocalFields(node *persistencespb.ChasmNode) *persistencespb.ChasmNode {
+	componentAttr := node.GetMetadata().GetComponentAttributes()
+	if len(componentAttr.GetSideEffectTasks())+len(componentAttr.GetPureTasks()) == 0 {
+		return node
+	}
+	// The data is only read, so only the metadata holding the physical task statuses is copied.
+	clean := &persistencespb.ChasmNode{Metadata: proto.CloneOf(node.GetMetadata()), Data: node.GetData()}
+	cleanAttr := clean.GetMetadata().GetComponentAttributes()
+	for _, task := range cleanAttr.GetSideEffectTasks() {
+		task.PhysicalTaskStatus = 0
+	}
+	for _, task := range cleanAttr.GetPureTasks() {
+		task.PhysicalTaskStatus = 0
+	}
+	return clean
+}
+
+// isExecutionSampled deterministically decides whether an execution is part of a sample of the given rate, so that
+// re-running a verification samples the same executions.
+func isExecutionSampled(execution *ExecutionInfo, sampleRate float64) bool {
+	if sampleRate <= 0 || sampleRate >= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(execution.BusinessID))
+	_, _ = h.Write([]byte(execution.RunID))
+	return float64(h.Sum32()%10000) < sampleRate*10000
+}
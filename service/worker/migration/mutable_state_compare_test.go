@@ -0,0 +1,153 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCompareMutableStates(t *testing.T) {
+	t.Parallel()
+
+	newMutableState := func() *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+					[]byte("branch-token"),
+					[]*historyspb.VersionHistoryItem{{EventId: 10, Version: 1}},
+				)),
+				TransitionHistory: []*persistencespb.VersionedTransition{
+					{NamespaceFailoverVersion: 1, TransitionCount: 7},
+				},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+			NextEventId:   11,
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{5: {}},
+			TimerInfos:    map[string]*persistencespb.TimerInfo{"timer": {}},
+			ChasmNodes: map[string]*persistencespb.ChasmNode{
+				"": {Data: &commonpb.DataBlob{Data: []byte("root")}},
+				"child": {
+					Metadata: &persistencespb.ChasmNodeMetadata{
+						Attributes: &persistencespb.ChasmNodeMetadata_ComponentAttributes{
+							ComponentAttributes: &persistencespb.ChasmComponentAttributes{
+								SideEffectTasks: []*persistencespb.ChasmComponentAttributes_Task{{TypeId: 1, PhysicalTaskStatus: 1}},
+								PureTasks:       []*persistencespb.ChasmComponentAttributes_Task{{TypeId: 2, PhysicalTaskStatus: 1}},
+							},
+						},
+					},
+					Data: &commonpb.DataBlob{Data: []byte("child")},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		mutate   func(target *persistencespb.WorkflowMutableState)
+		expected []string
+	}{
+		{
+			name:   "match",
+			mutate: func(*persistencespb.WorkflowMutableState) {},
+		},
+		{
+			name: "target is behind",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ExecutionInfo.VersionHistories.Histories[0].Items[0].EventId = 8
+				target.ExecutionInfo.TransitionHistory[0].TransitionCount = 6
+				target.NextEventId = 9
+			},
+			expected: []string{mismatchVersionHistory, mismatchNextEventID, mismatchTransitionHistory},
+		},
+		{
+			name: "different branch token",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ExecutionInfo.VersionHistories.Histories[0].BranchToken = []byte("target-branch-token")
+			},
+		},
+		{
+			name: "different state",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+				target.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+			},
+			expected: []string{mismatchExecutionState},
+		},
+		{
+			name: "different pending items",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ActivityInfos = map[int64]*persistencespb.ActivityInfo{6: {}}
+				target.TimerInfos = nil
+				target.SignalInfos = map[int64]*persistencespb.SignalInfo{7: {}}
+			},
+			expected: []string{mismatchPendingActivities, mismatchPendingTimers, mismatchPendingSignals},
+		},
+		{
+			name: "different CHASM tree",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ChasmNodes["child"].Data.Data = []byte("stale child")
+			},
+			expected: []string{mismatchChasmTree},
+		},
+		{
+			// Physical task statuses are local to each cluster.
+			name: "different physical task status",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				componentAttr := target.ChasmNodes["child"].GetMetadata().GetComponentAttributes()
+				componentAttr.SideEffectTasks[0].PhysicalTaskStatus = 0
+				componentAttr.PureTasks[0].PhysicalTaskStatus = 0
+			},
+		},
+		{
+			name: "different CHASM task",
+			mutate: func(target *persistencespb.WorkflowMutableState) {
+				target.ChasmNodes["child"].GetMetadata().GetComponentAttributes().PureTasks[0].TypeId = 3
+			},
+			expected: []string{mismatchChasmTree},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := newMutableState()
+			target := proto.Clone(source).(*persistencespb.WorkflowMutableState)
+			tc.mutate(target)
+
+			mismatches := compareMutableStates(source, target)
+			require.True(t, proto.Equal(newMutableState(), source), "comparison must not modify the source")
+			require.Len(t, mismatches, len(tc.expected))
+			for i, expected := range tc.expected {
+				require.Contains(t, mismatches[i], expected)
+			}
+		})
+	}
+}
+
+func TestIsExecutionSampled(t *testing.T) {
+	t.Parallel()
+
+	sampled := 0
+	for i := range 1000 {
+		execution := &ExecutionInfo{BusinessID: "workflow", RunID: fmt.Sprintf("run-%d", i)}
+		require.True(t, isExecutionSampled(execution, 0))
+		require.True(t, isExecutionSampled(execution, 1))
+		if isExecutionSampled(execution, 0.1) {
+			sampled++
+			require.True(t, isExecutionSampled(execution, 0.1))
+		}
+	}
+	require.InDelta(t, 100, sampled, 50)
+}
@@ -0,0 +1,220 @@
+package migration
+
+import (
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+	// ShadowTrafficParams configures a read-only verification of the target cluster of a namespace migration: the
+	// describe calls of the executions of the namespace are sent to both the current and the target cluster, and the
+	// latency and result mismatches of the target cluster are reported, before any handover.
+	ShadowTrafficParams struct {
+		Namespace             string
+		Query                 string // query to list executions to mirror calls for, all executions of the namespace if empty
+		TargetClusterName     string
+		SampleRate            float64 // fraction of listed executions to mirror calls for, all listed executions if not in (0, 1)
+		RPS                   float64 // RPS for mirroring calls
+		MaxReportedMismatches int     // maximum number of mismatched executions kept in the output
+		ListWorkflowsPageSize int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution int     // number of pages to be processed before continue as new, max is 1000.
+		NextPageToken         []byte  // used by continue as new
+
+		// Carried over continue-as-new to report the overall result.
+		ContinuedAsNewCount int
+		Result              ShadowTrafficOutput
+	}
+
+	ShadowTrafficOutput struct {
+		ListedWorkflowCount     int64
+		SkippedWorkflowCount    int64
+		MismatchedWorkflowCount int64
+		MirroredCallCount       int64
+		FailedCallCount         int64 // mirrored calls which failed on the target cluster
+		LocalLatency            ShadowTrafficLatency
+		ShadowLatency           ShadowTrafficLatency
+		// Mismatches are the first MaxReportedMismatches mismatched executions.
+		Mismatches []ExecutionMismatch
+	}
+
+	// ShadowTrafficLatency summarizes the latency of the calls served by a cluster.
+	ShadowTrafficLatency struct {
+		Count int64
+		Total time.Duration
+		Max   time.Duration
+	}
+
+	ShadowTrafficStatus struct {
+		ShadowTrafficOutput
+		ContinuedAsNewCount int
+		PageTokenForRestart []byte
+	}
+)
+
+const (
+	shadowTrafficWorkflowName    = "shadow-traffic"
+	shadowTrafficStatusQueryType = "shadow-traffic-status"
+
+	defaultShadowTrafficRPS = 10
+)
+
+// ShadowTrafficWorkflow lists the executions of a namespace, and mirrors the read-only calls of every (or a sample of)
+// execution to the target cluster. Unlike VerifyReplicationWorkflow, which compares the replicated mutable states, it
+// verifies that the target cluster serves the calls, in a timely manner, with the same results as the current cluster.
+func ShadowTrafficWorkflow(ctx workflow.Context, params ShadowTrafficParams) (ShadowTrafficOutput, error) {
+	startPageToken := params.NextPageToken
+	_ = workflow.SetQueryHandler(ctx, shadowTrafficStatusQueryType, func() (ShadowTrafficStatus, error) {
+		return ShadowTrafficStatus{
+			ShadowTrafficOutput: params.Result,
+			ContinuedAsNewCount: params.ContinuedAsNewCount,
+			PageTokenForRestart: startPageToken,
+		}, nil
+	})
+
+	if err := validateAndSetShadowTrafficParams(&params); err != nil {
+		return ShadowTrafficOutput{}, err
+	}
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	var a *activities
+	var metadataResp MetadataResponse
+	if err := workflow.ExecuteLocalActivity(
+		workflow.WithLocalActivityOptions(ctx, lao),
+		a.GetMetadata,
+		MetadataRequest{Namespace: params.Namespace},
+	).Get(ctx, &metadataResp); err != nil {
+		return ShadowTrafficOutput{}, err
+	}
+
+	listCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	})
+	mirrorCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	})
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(
+			listCtx,
+			a.ListWorkflows,
+			&workflowservice.ListWorkflowExecutionsRequest{
+				Namespace:     params.Namespace,
+				PageSize:      int32(params.ListWorkflowsPageSize),
+				NextPageToken: params.NextPageToken,
+				Query:         params.Query,
+			}).Get(ctx, &listResp); err != nil {
+			return ShadowTrafficOutput{}, err
+		}
+		params.Result.ListedWorkflowCount += int64(len(listResp.Executions))
+
+		var executions []*ExecutionInfo
+		for _, execution := range listResp.Executions {
+			if isExecutionSampled(execution, params.SampleRate) {
+				executions = append(executions, execution)
+			}
+		}
+		if len(executions) > 0 {
+			var mirrorResp shadowTrafficResponse
+			if err := workflow.ExecuteActivity(
+				mirrorCtx,
+				a.MirrorShadowTraffic,
+				&shadowTrafficRequest{
+					Namespace:         params.Namespace,
+					NamespaceID:       metadataResp.NamespaceID,
+					TargetClusterName: params.TargetClusterName,
+					Executions:        executions,
+					RPS:               params.RPS,
+				}).Get(ctx, &mirrorResp); err != nil {
+				return ShadowTrafficOutput{}, err
+			}
+			mergeShadowTrafficResponse(&params.Result, mirrorResp, params.MaxReportedMismatches)
+		}
+
+		params.NextPageToken = listResp.NextPageToken
+		if params.NextPageToken == nil {
+			return params.Result, nil
+		}
+	}
+
+	params.ContinuedAsNewCount++
+	return ShadowTrafficOutput{}, workflow.NewContinueAsNewError(ctx, ShadowTrafficWorkflow, params)
+}
+
+// Average returns the average latency of the calls.
+func (l ShadowTrafficLatency) Average() time.Duration {
+	if l.Count == 0 {
+		return 0
+	}
+	return l.Total / time.Duration(l.Count)
+}
+
+func (l *ShadowTrafficLatency) record(latency time.Duration) {
+	l.Count++
+	l.Total += latency
+	l.Max = max(l.Max, latency)
+}
+
+func (l *ShadowTrafficLatency) merge(other ShadowTrafficLatency) {
+	l.Count += other.Count
+	l.Total += other.Total
+	l.Max = max(l.Max, other.Max)
+}
+
+func mergeShadowTrafficResponse(
+	result *ShadowTrafficOutput,
+	resp shadowTrafficResponse,
+	maxReportedMismatches int,
+) {
+	result.SkippedWorkflowCount += resp.SkippedWorkflowCount
+	result.MismatchedWorkflowCount += int64(len(resp.Mismatches))
+	result.MirroredCallCount += resp.MirroredCallCount
+	result.FailedCallCount += resp.FailedCallCount
+	result.LocalLatency.merge(resp.LocalLatency)
+	result.ShadowLatency.merge(resp.ShadowLatency)
+	for _, mismatch := range resp.Mismatches {
+		if len(result.Mismatches) >= maxReportedMismatches {
+			break
+		}
+		result.Mismatches = append(result.Mismatches, mismatch)
+	}
+}
+
+func validateAndSetShadowTrafficParams(params *ShadowTrafficParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+	if len(params.TargetClusterName) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: TargetClusterName is required", "InvalidArgument", nil)
+	}
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: SampleRate must be between 0 and 1", "InvalidArgument", nil)
+	}
+
+	if params.RPS <= 0 {
+		params.RPS = defaultShadowTrafficRPS
+	}
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = defaultMaxReportedMismatches
+	}
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestShadowTrafficWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	const pageCount = 3
+	env.OnActivity(a.GetMetadata, mock.Anything, MetadataRequest{Namespace: "test-ns"}).Return(&MetadataResponse{NamespaceID: "test-ns-id"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		page := 0
+		if request.NextPageToken != nil {
+			page = int(request.NextPageToken[0])
+		}
+		resp := &listWorkflowsResponse{
+			Executions: []*ExecutionInfo{{BusinessID: fmt.Sprintf("workflow-%d", page), RunID: "run"}},
+		}
+		if page+1 < pageCount {
+			resp.NextPageToken = []byte{byte(page + 1)}
+		}
+		return resp, nil
+	}).Times(pageCount)
+	env.OnActivity(a.MirrorShadowTraffic, mock.Anything, mock.Anything).Return(func(_ context.Context, request *shadowTrafficRequest) (*shadowTrafficResponse, error) {
+		require.Equal(t, "test-ns-id", request.NamespaceID)
+		require.Equal(t, "target", request.TargetClusterName)
+		return &shadowTrafficResponse{
+			MirroredCallCount: 2,
+			FailedCallCount:   1,
+			LocalLatency:      ShadowTrafficLatency{Count: 2, Total: 20 * time.Millisecond, Max: 15 * time.Millisecond},
+			ShadowLatency:     ShadowTrafficLatency{Count: 2, Total: 200 * time.Millisecond, Max: 150 * time.Millisecond},
+			Mismatches: []ExecutionMismatch{
+				{Execution: request.Executions[0], Reasons: []string{"DescribeWorkflowExecution.history_length"}},
+			},
+		}, nil
+	}).Times(pageCount)
+
+	env.ExecuteWorkflow(ShadowTrafficWorkflow, ShadowTrafficParams{
+		Namespace:             "test-ns",
+		TargetClusterName:     "target",
+		MaxReportedMismatches: 2,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var output ShadowTrafficOutput
+	require.NoError(t, env.GetWorkflowResult(&output))
+	require.Equal(t, int64(pageCount), output.ListedWorkflowCount)
+	require.Equal(t, int64(pageCount), output.MismatchedWorkflowCount)
+	require.Equal(t, int64(2*pageCount), output.MirroredCallCount)
+	require.Equal(t, int64(pageCount), output.FailedCallCount)
+	require.Equal(t, 10*time.Millisecond, output.LocalLatency.Average())
+	require.Equal(t, 150*time.Millisecond, output.ShadowLatency.Max)
+	require.Len(t, output.Mismatches, 2)
+	require.Equal(t, "workflow-0", output.Mismatches[0].Execution.BusinessID)
+	env.AssertExpectations(t)
+}
+
+func TestShadowTrafficWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&MetadataResponse{NamespaceID: "test-ns-id"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions:    []*ExecutionInfo{{BusinessID: "workflow", RunID: "run"}},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	env.OnActivity(a.MirrorShadowTraffic, mock.Anything, mock.Anything).Return(&shadowTrafficResponse{
+		MirroredCallCount: 1,
+	}, nil).Once()
+
+	env.ExecuteWorkflow(ShadowTrafficWorkflow, ShadowTrafficParams{
+		Namespace:             "test-ns",
+		TargetClusterName:     "target",
+		PageCountPerExecution: 1,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(t)
+}
+
+func TestShadowTrafficWorkflow_InvalidParams(t *testing.T) {
+	for _, params := range []ShadowTrafficParams{
+		{TargetClusterName: "target"},
+		{Namespace: "test-ns"},
+		{Namespace: "test-ns", TargetClusterName: "target", SampleRate: 1.5},
+	} {
+		testSuite := &testsuite.WorkflowTestSuite{}
+		env := testSuite.NewTestWorkflowEnvironment()
+		env.ExecuteWorkflow(ShadowTrafficWorkflow, params)
+		require.True(t, env.IsWorkflowCompleted())
+
+		var applicationErr *temporal.ApplicationError
+		require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+		require.Equal(t, "InvalidArgument", applicationErr.Type())
+	}
+}
@@ -0,0 +1,259 @@
+package migration
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	shardPoolMigrationWorkflowName = "shard-pool-migration"
+
+	defaultShardPoolMigrationRPS             = 100
+	defaultShardPoolAssignmentTimeoutSeconds = 30 * 60
+	shardPoolAssignmentCheckInterval         = 5 * time.Second
+	shardPoolMigrationHistoryPageSize        = 100
+	shardPoolListNamespacesPageSize          = 100
+
+	shardPoolMigrationStageCopy                   = "copy"
+	shardPoolMigrationStageAssignPool             = "assign-pool"
+	shardPoolMigrationStageDeleteSourceExecutions = "delete-source-executions"
+)
+
+type (
+	// ShardPoolMigrationParams are the parameters of the workflow moving a namespace to another history shard pool.
+	//
+	// The namespace is put into handover state for the whole migration, which stops it from serving traffic. Handover
+	// requires a global namespace, so single cluster namespaces have to be promoted first. Executions are copied to
+	// the shards of the target pool, then the namespace is assigned to the target pool in its namespace config, and
+	// finally the executions left on the shards of the source pool are deleted. The target pool must be defined in
+	// dynamic config (history.shardPools) of the cluster running the migration. Its shard range is read once, when the
+	// migration starts, and is the one recorded in the namespace config.
+	ShardPoolMigrationParams struct {
+		Namespace string
+		// TargetPool is the pool to move the namespace to. Empty moves the namespace out of its pool to all shards.
+		TargetPool string
+		// Query selects the executions to move. Defaults to all executions of the namespace.
+		Query                 string
+		RPS                   float64 // RPS for copying and deleting executions
+		ListWorkflowsPageSize int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution int     // number of pages to be processed before continue as new, max is 1000.
+		// How long to wait for the namespace registry to reflect the assignment to the target pool on every host.
+		PoolAssignmentTimeoutSeconds int
+
+		// Used by continue as new
+		NamespaceID         string
+		Source              *persistencespb.ShardPoolAssignment
+		Target              *persistencespb.ShardPoolAssignment
+		Stage               string
+		NextPageToken       []byte
+		CopiedCount         int64
+		DeletedCount        int64
+		ContinuedAsNewCount int
+	}
+
+	validateShardPoolMigrationRequest struct {
+		NamespaceID string
+		TargetPool  string
+	}
+
+	validateShardPoolMigrationResponse struct {
+		Source *persistencespb.ShardPoolAssignment
+		Target *persistencespb.ShardPoolAssignment
+	}
+
+	moveExecutionsRequest struct {
+		NamespaceID string
+		Source      *persistencespb.ShardPoolAssignment
+		Target      *persistencespb.ShardPoolAssignment
+		Executions  []*ExecutionInfo
+		RPS         float64
+	}
+
+	moveExecutionsResponse struct {
+		MovedCount int64
+	}
+
+	assignShardPoolRequest struct {
+		NamespaceID string
+		Target      *persistencespb.ShardPoolAssignment
+	}
+
+	waitShardPoolAssignmentRequest struct {
+		NamespaceID string
+		Target      *persistencespb.ShardPoolAssignment
+	}
+)
+
+// ShardPoolMigrationWorkflow moves the executions of a namespace to the shards of another history shard pool.
+func ShardPoolMigrationWorkflow(ctx workflow.Context, params ShardPoolMigrationParams) (retErr error) {
+	if err := validateAndSetShardPoolMigrationParams(&params); err != nil {
+		return err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	if params.Stage == "" {
+		var metadataResp MetadataResponse
+		if err := workflow.ExecuteActivity(ctx, a.GetMetadata, MetadataRequest{Namespace: params.Namespace}).Get(ctx, &metadataResp); err != nil {
+			return err
+		}
+		params.NamespaceID = metadataResp.NamespaceID
+
+		var validateResp validateShardPoolMigrationResponse
+		if err := workflow.ExecuteActivity(ctx, a.ValidateShardPoolMigration, validateShardPoolMigrationRequest{
+			NamespaceID: params.NamespaceID,
+			TargetPool:  params.TargetPool,
+		}).Get(ctx, &validateResp); err != nil {
+			return err
+		}
+		params.Source = validateResp.Source
+		params.Target = validateResp.Target
+	}
+
+	// Lift the fence whether the migration failed or succeeded, but not when continuing as new.
+	defer func() {
+		if !workflow.IsContinueAsNewError(retErr) {
+			detachCtx, cancel := workflow.NewDisconnectedContext(ctx)
+			defer cancel()
+			resetStateCtx := workflow.WithActivityOptions(detachCtx, workflow.ActivityOptions{StartToCloseTimeout: time.Second * 10})
+			err := workflow.ExecuteActivity(resetStateCtx, a.UpdateNamespaceState, updateStateRequest{
+				Namespace: params.Namespace,
+				NewState:  enumspb.REPLICATION_STATE_NORMAL,
+			}).Get(resetStateCtx, nil)
+			if err != nil && retErr == nil {
+				retErr = err
+			}
+		}
+	}()
+
+	if params.Stage == "" {
+		// WARNING: Namespace cannot serve traffic while in this state.
+		if err := workflow.ExecuteActivity(ctx, a.UpdateNamespaceState, updateStateRequest{
+			Namespace: params.Namespace,
+			NewState:  enumspb.REPLICATION_STATE_HANDOVER,
+		}).Get(ctx, nil); err != nil {
+			return err
+		}
+		params.Stage = shardPoolMigrationStageCopy
+	}
+
+	if params.Stage == shardPoolMigrationStageCopy {
+		done, err := moveExecutionsPages(ctx, &params, a.CopyExecutionsToShardPool, &params.CopiedCount)
+		if err != nil {
+			return err
+		}
+		if !done {
+			params.ContinuedAsNewCount++
+			return workflow.NewContinueAsNewError(ctx, ShardPoolMigrationWorkflow, params)
+		}
+		params.Stage = shardPoolMigrationStageAssignPool
+	}
+
+	if params.Stage == shardPoolMigrationStageAssignPool {
+		if err := workflow.ExecuteActivity(ctx, a.AssignShardPool, assignShardPoolRequest{
+			NamespaceID: params.NamespaceID,
+			Target:      params.Target,
+		}).Get(ctx, nil); err != nil {
+			return err
+		}
+		waitCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Duration(params.PoolAssignmentTimeoutSeconds) * time.Second,
+			HeartbeatTimeout:    time.Second * 30,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 1},
+		})
+		if err := workflow.ExecuteActivity(waitCtx, a.WaitShardPoolAssignment, waitShardPoolAssignmentRequest{
+			NamespaceID: params.NamespaceID,
+			Target:      params.Target,
+		}).Get(waitCtx, nil); err != nil {
+			return err
+		}
+		params.Stage = shardPoolMigrationStageDeleteSourceExecutions
+	}
+
+	done, err := moveExecutionsPages(ctx, &params, a.DeleteShardPoolSourceExecutions, &params.DeletedCount)
+	if err != nil {
+		return err
+	}
+	if !done {
+		params.ContinuedAsNewCount++
+		return workflow.NewContinueAsNewError(ctx, ShardPoolMigrationWorkflow, params)
+	}
+	return nil
+}
+
+// moveExecutionsPages lists up to PageCountPerExecution pages of executions and runs the given activity on each of
+// them. It returns true once all pages were processed.
+func moveExecutionsPages(
+	ctx workflow.Context,
+	params *ShardPoolMigrationParams,
+	activityFn any,
+	movedCount *int64,
+) (bool, error) {
+	actx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	})
+	var a *activities
+	for range params.PageCountPerExecution {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(actx, a.ListWorkflows, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     params.Namespace,
+			PageSize:      int32(params.ListWorkflowsPageSize),
+			NextPageToken: params.NextPageToken,
+			Query:         params.Query,
+		}).Get(ctx, &listResp); err != nil {
+			return false, err
+		}
+
+		var moveResp moveExecutionsResponse
+		if err := workflow.ExecuteActivity(actx, activityFn, &moveExecutionsRequest{
+			NamespaceID: params.NamespaceID,
+			Source:      params.Source,
+			Target:      params.Target,
+			Executions:  listResp.Executions,
+			RPS:         params.RPS,
+		}).Get(ctx, &moveResp); err != nil {
+			return false, err
+		}
+		*movedCount += moveResp.MovedCount
+
+		params.NextPageToken = listResp.NextPageToken
+		if params.NextPageToken == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func validateAndSetShardPoolMigrationParams(params *ShardPoolMigrationParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+	if params.RPS <= 0 {
+		params.RPS = defaultShardPoolMigrationRPS
+	}
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+	if params.PoolAssignmentTimeoutSeconds <= 0 {
+		params.PoolAssignmentTimeoutSeconds = defaultShardPoolAssignmentTimeoutSeconds
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/shardpool"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestShardPoolMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	namespaceID := uuid.NewString()
+	executions := []*ExecutionInfo{{BusinessID: "wf-1", RunID: uuid.NewString()}}
+	target := &persistencespb.ShardPoolAssignment{Name: "target", FirstShardId: 1, LastShardId: 2}
+
+	env.OnActivity(a.GetMetadata, mock.Anything, MetadataRequest{Namespace: "test-ns"}).
+		Return(&MetadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+	env.OnActivity(a.ValidateShardPoolMigration, mock.Anything, validateShardPoolMigrationRequest{NamespaceID: namespaceID, TargetPool: "target"}).
+		Return(&validateShardPoolMigrationResponse{Target: target}, nil)
+	var stateUpdates []enumspb.ReplicationState
+	var steps []string
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stateUpdates = append(stateUpdates, args.Get(1).(updateStateRequest).NewState)
+		}).
+		Return(nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).
+		Return(&listWorkflowsResponse{Executions: executions}, nil).
+		Twice()
+	env.OnActivity(a.CopyExecutionsToShardPool, mock.Anything, &moveExecutionsRequest{
+		NamespaceID: namespaceID,
+		Target:      target,
+		Executions:  executions,
+		RPS:         defaultShardPoolMigrationRPS,
+	}).
+		Run(func(mock.Arguments) { steps = append(steps, "copy") }).
+		Return(&moveExecutionsResponse{MovedCount: 1}, nil).
+		Once()
+	env.OnActivity(a.AssignShardPool, mock.Anything, assignShardPoolRequest{NamespaceID: namespaceID, Target: target}).
+		Run(func(mock.Arguments) { steps = append(steps, "assign") }).
+		Return(nil).
+		Once()
+	env.OnActivity(a.WaitShardPoolAssignment, mock.Anything, waitShardPoolAssignmentRequest{NamespaceID: namespaceID, Target: target}).
+		Run(func(mock.Arguments) { steps = append(steps, "wait") }).
+		Return(nil).
+		Once()
+	env.OnActivity(a.DeleteShardPoolSourceExecutions, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { steps = append(steps, "delete") }).
+		Return(&moveExecutionsResponse{MovedCount: 1}, nil).
+		Once()
+
+	env.ExecuteWorkflow(ShardPoolMigrationWorkflow, ShardPoolMigrationParams{
+		Namespace:  "test-ns",
+		TargetPool: "target",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Equal(t, []string{"copy", "assign", "wait", "delete"}, steps)
+	require.Equal(t, []enumspb.ReplicationState{
+		enumspb.REPLICATION_STATE_HANDOVER,
+		enumspb.REPLICATION_STATE_NORMAL,
+	}, stateUpdates)
+	env.AssertExpectations(t)
+}
+
+func TestShardPoolMigrationWorkflow_ContinueAsNewKeepsFence(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	namespaceID := uuid.NewString()
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).
+		Return(&MetadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+	env.OnActivity(a.ValidateShardPoolMigration, mock.Anything, mock.Anything).
+		Return(&validateShardPoolMigrationResponse{
+			Source: &persistencespb.ShardPoolAssignment{Name: "source", FirstShardId: 1, LastShardId: 2},
+			Target: &persistencespb.ShardPoolAssignment{Name: "target", FirstShardId: 3, LastShardId: 4},
+		}, nil)
+	var stateUpdates []enumspb.ReplicationState
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stateUpdates = append(stateUpdates, args.Get(1).(updateStateRequest).NewState)
+		}).
+		Return(nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).
+		Return(&listWorkflowsResponse{NextPageToken: []byte("token")}, nil)
+	env.OnActivity(a.CopyExecutionsToShardPool, mock.Anything, mock.Anything).
+		Return(&moveExecutionsResponse{}, nil)
+
+	env.ExecuteWorkflow(ShardPoolMigrationWorkflow, ShardPoolMigrationParams{
+		Namespace:             "test-ns",
+		TargetPool:            "target",
+		PageCountPerExecution: 1,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	require.Equal(t, []enumspb.ReplicationState{enumspb.REPLICATION_STATE_HANDOVER}, stateUpdates)
+}
+
+func TestValidateShardPoolMigration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.HistoryShardPools.Key(): map[string]any{
+			"small": map[string]any{"FirstShardID": 1, "LastShardID": 2},
+		},
+	}, log.NewNoopLogger())
+	small := &persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 2}
+	a := &activities{
+		metadataManager: metadataManager,
+		shardPools:      shardpool.NewResolver(dc, shardpooltest.Registry{"ns-small": small}, 4),
+	}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListNamespacesResponse{Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: "ns-small", Name: "small"},
+				Config: &persistencespb.NamespaceConfig{ShardPool: small},
+			}},
+		}}, nil).AnyTimes()
+
+	resp, err := a.ValidateShardPoolMigration(context.Background(), validateShardPoolMigrationRequest{NamespaceID: "ns-small", TargetPool: ""})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(small, resp.Source))
+	require.Nil(t, resp.Target)
+
+	resp, err = a.ValidateShardPoolMigration(context.Background(), validateShardPoolMigrationRequest{NamespaceID: "ns-other", TargetPool: "small"})
+	require.NoError(t, err)
+	require.Nil(t, resp.Source)
+	require.True(t, proto.Equal(small, resp.Target))
+
+	_, err = a.ValidateShardPoolMigration(context.Background(), validateShardPoolMigrationRequest{NamespaceID: "ns-small", TargetPool: "small"})
+	require.Error(t, err)
+	_, err = a.ValidateShardPoolMigration(context.Background(), validateShardPoolMigrationRequest{NamespaceID: "ns-other", TargetPool: "undefined"})
+	require.Error(t, err)
+}
+
+func TestValidateShardPoolAssignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	a := &activities{metadataManager: metadataManager}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListNamespacesResponse{Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: "ns-small", Name: "small"},
+				Config: &persistencespb.NamespaceConfig{ShardPool: &persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 2}},
+			}},
+			{Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: "ns-other", Name: "other"},
+				Config: &persistencespb.NamespaceConfig{},
+			}},
+		}}, nil).AnyTimes()
+
+	require.NoError(t, a.validateShardPoolAssignment(context.Background(), "ns-id",
+		&persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 2}))
+	require.NoError(t, a.validateShardPoolAssignment(context.Background(), "ns-id",
+		&persistencespb.ShardPoolAssignment{Name: "large", FirstShardId: 3, LastShardId: 8}))
+	// The namespace's own assignment doesn't count.
+	require.NoError(t, a.validateShardPoolAssignment(context.Background(), "ns-small",
+		&persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 4}))
+
+	// The small pool was resized in dynamic config while a namespace is still assigned to its old range.
+	err := a.validateShardPoolAssignment(context.Background(), "ns-id",
+		&persistencespb.ShardPoolAssignment{Name: "small", FirstShardId: 1, LastShardId: 4})
+	require.ErrorContains(t, err, "move that namespace out of the pool before resizing it")
+	// Another pool was redefined over the range of the small pool.
+	err = a.validateShardPoolAssignment(context.Background(), "ns-id",
+		&persistencespb.ShardPoolAssignment{Name: "large", FirstShardId: 2, LastShardId: 8})
+	require.ErrorContains(t, err, "overlaps shard pool")
+}
+
+func TestAssignShardPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	a := &activities{metadataManager: metadataManager}
+
+	target := &persistencespb.ShardPoolAssignment{Name: "target", FirstShardId: 1, LastShardId: 2}
+	detail := &persistencespb.NamespaceDetail{
+		Info:          &persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+		Config:        &persistencespb.NamespaceConfig{},
+		ConfigVersion: 3,
+	}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListNamespacesResponse{}, nil).Times(2)
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(2)
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: "ns-id"}).
+		Return(&persistence.GetNamespaceResponse{Namespace: detail, IsGlobalNamespace: true}, nil).Times(2)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.True(t, proto.Equal(target, request.Namespace.GetConfig().GetShardPool()))
+			require.Equal(t, int64(3), request.Namespace.GetConfigVersion())
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.True(t, request.IsGlobalNamespace)
+			return nil
+		})
+
+	require.NoError(t, a.AssignShardPool(context.Background(), assignShardPoolRequest{NamespaceID: "ns-id", Target: target}))
+	// Already assigned namespaces are not updated again.
+	require.NoError(t, a.AssignShardPool(context.Background(), assignShardPoolRequest{NamespaceID: "ns-id", Target: target}))
+}
+
+func TestWaitShardPoolAssignment(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	target := &persistencespb.ShardPoolAssignment{Name: "target", FirstShardId: 1, LastShardId: 2}
+	a := &activities{
+		shardPools:                    shardpool.NewResolver(dynamicconfig.NewNoopCollection(), shardpooltest.Registry{"ns-id": target}, 4),
+		namespaceCacheRefreshInterval: dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+	}
+	env.RegisterActivity(a.WaitShardPoolAssignment)
+
+	_, err := env.ExecuteActivity(a.WaitShardPoolAssignment, waitShardPoolAssignmentRequest{NamespaceID: "ns-id", Target: target})
+	require.NoError(t, err)
+}
@@ -0,0 +1,191 @@
+package migration
+
+import (
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+	// VerifyReplicationParams configures a verification that the executions of a namespace on the target cluster
+	// match the ones on the current cluster, e.g. to certify that a standby cluster is ready before a failover.
+	VerifyReplicationParams struct {
+		Namespace             string
+		Query                 string // query to list executions to verify, all executions of the namespace if empty
+		TargetClusterName     string
+		SampleRate            float64 // fraction of listed executions to verify, all listed executions if not in (0, 1)
+		RPS                   float64 // RPS for comparing executions
+		ReReplicate           bool    // generate replication tasks for executions which don't match
+		MaxReportedMismatches int     // maximum number of mismatched executions kept in the output
+		ListWorkflowsPageSize int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution int     // number of pages to be processed before continue as new, max is 1000.
+		NextPageToken         []byte  // used by continue as new
+
+		// Carried over continue-as-new to report the overall result.
+		ContinuedAsNewCount int
+		Result              VerifyReplicationOutput
+	}
+
+	VerifyReplicationOutput struct {
+		ListedWorkflowCount       int64
+		VerifiedWorkflowCount     int64
+		SkippedWorkflowCount      int64
+		MismatchedWorkflowCount   int64
+		ReReplicatedWorkflowCount int64
+		// Mismatches are the first MaxReportedMismatches mismatched executions.
+		Mismatches []ExecutionMismatch
+	}
+
+	VerifyReplicationStatus struct {
+		VerifyReplicationOutput
+		ContinuedAsNewCount int
+		PageTokenForRestart []byte
+	}
+)
+
+const (
+	verifyReplicationWorkflowName    = "verify-replication"
+	verifyReplicationStatusQueryType = "verify-replication-status"
+
+	defaultVerifyReplicationRPS  = 10
+	defaultMaxReportedMismatches = 100
+)
+
+// VerifyReplicationWorkflow lists the executions of a namespace, and compares the mutable state of every (or a sample
+// of) execution with the one on the target cluster. Replication should have caught up before running it (see
+// CatchupWorkflow), as executions updated while verifying may be reported as mismatched due to replication lag.
+func VerifyReplicationWorkflow(ctx workflow.Context, params VerifyReplicationParams) (VerifyReplicationOutput, error) {
+	startPageToken := params.NextPageToken
+	_ = workflow.SetQueryHandler(ctx, verifyReplicationStatusQueryType, func() (VerifyReplicationStatus, error) {
+		return VerifyReplicationStatus{
+			VerifyReplicationOutput: params.Result,
+			ContinuedAsNewCount:     params.ContinuedAsNewCount,
+			PageTokenForRestart:     startPageToken,
+		}, nil
+	})
+
+	if err := validateAndSetVerifyReplicationParams(&params); err != nil {
+		return VerifyReplicationOutput{}, err
+	}
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	var a *activities
+	var metadataResp MetadataResponse
+	if err := workflow.ExecuteLocalActivity(
+		workflow.WithLocalActivityOptions(ctx, lao),
+		a.GetMetadata,
+		MetadataRequest{Namespace: params.Namespace},
+	).Get(ctx, &metadataResp); err != nil {
+		return VerifyReplicationOutput{}, err
+	}
+
+	listCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	})
+	compareCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	})
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(
+			listCtx,
+			a.ListWorkflows,
+			&workflowservice.ListWorkflowExecutionsRequest{
+				Namespace:     params.Namespace,
+				PageSize:      int32(params.ListWorkflowsPageSize),
+				NextPageToken: params.NextPageToken,
+				Query:         params.Query,
+			}).Get(ctx, &listResp); err != nil {
+			return VerifyReplicationOutput{}, err
+		}
+		params.Result.ListedWorkflowCount += int64(len(listResp.Executions))
+
+		var executions []*ExecutionInfo
+		for _, execution := range listResp.Executions {
+			if isExecutionSampled(execution, params.SampleRate) {
+				executions = append(executions, execution)
+			}
+		}
+		if len(executions) > 0 {
+			var compareResp compareReplicatedExecutionsResponse
+			if err := workflow.ExecuteActivity(
+				compareCtx,
+				a.CompareReplicatedExecutions,
+				&compareReplicatedExecutionsRequest{
+					Namespace:         params.Namespace,
+					NamespaceID:       metadataResp.NamespaceID,
+					TargetClusterName: params.TargetClusterName,
+					Executions:        executions,
+					RPS:               params.RPS,
+					ReReplicate:       params.ReReplicate,
+				}).Get(ctx, &compareResp); err != nil {
+				return VerifyReplicationOutput{}, err
+			}
+			mergeCompareReplicatedExecutionsResponse(&params.Result, compareResp, params.MaxReportedMismatches)
+		}
+
+		params.NextPageToken = listResp.NextPageToken
+		if params.NextPageToken == nil {
+			return params.Result, nil
+		}
+	}
+
+	params.ContinuedAsNewCount++
+	return VerifyReplicationOutput{}, workflow.NewContinueAsNewError(ctx, VerifyReplicationWorkflow, params)
+}
+
+func mergeCompareReplicatedExecutionsResponse(
+	result *VerifyReplicationOutput,
+	resp compareReplicatedExecutionsResponse,
+	maxReportedMismatches int,
+) {
+	result.VerifiedWorkflowCount += resp.VerifiedWorkflowCount
+	result.SkippedWorkflowCount += resp.SkippedWorkflowCount
+	result.MismatchedWorkflowCount += int64(len(resp.Mismatches))
+	result.ReReplicatedWorkflowCount += resp.ReReplicatedWorkflowCount
+	for _, mismatch := range resp.Mismatches {
+		if len(result.Mismatches) >= maxReportedMismatches {
+			break
+		}
+		result.Mismatches = append(result.Mismatches, mismatch)
+	}
+}
+
+func validateAndSetVerifyReplicationParams(params *VerifyReplicationParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+	if len(params.TargetClusterName) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: TargetClusterName is required", "InvalidArgument", nil)
+	}
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: SampleRate must be between 0 and 1", "InvalidArgument", nil)
+	}
+
+	if params.RPS <= 0 {
+		params.RPS = defaultVerifyReplicationRPS
+	}
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = defaultMaxReportedMismatches
+	}
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+
+	return nil
+}
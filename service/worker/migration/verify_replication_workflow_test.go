@@ -0,0 +1,106 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestVerifyReplicationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	const pageCount = 3
+	env.OnActivity(a.GetMetadata, mock.Anything, MetadataRequest{Namespace: "test-ns"}).Return(&MetadataResponse{NamespaceID: "test-ns-id"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		page := 0
+		if request.NextPageToken != nil {
+			page = int(request.NextPageToken[0])
+		}
+		resp := &listWorkflowsResponse{
+			Executions: []*ExecutionInfo{{BusinessID: fmt.Sprintf("workflow-%d", page), RunID: "run"}},
+		}
+		if page+1 < pageCount {
+			resp.NextPageToken = []byte{byte(page + 1)}
+		}
+		return resp, nil
+	}).Times(pageCount)
+	env.OnActivity(a.CompareReplicatedExecutions, mock.Anything, mock.Anything).Return(func(_ context.Context, request *compareReplicatedExecutionsRequest) (*compareReplicatedExecutionsResponse, error) {
+		require.Equal(t, "test-ns-id", request.NamespaceID)
+		require.Equal(t, "standby", request.TargetClusterName)
+		require.True(t, request.ReReplicate)
+		return &compareReplicatedExecutionsResponse{
+			ReReplicatedWorkflowCount: 1,
+			Mismatches: []ExecutionMismatch{
+				{Execution: request.Executions[0], Reasons: []string{mismatchMissingOnTarget}, ReReplicated: true},
+			},
+		}, nil
+	}).Times(pageCount)
+
+	env.ExecuteWorkflow(VerifyReplicationWorkflow, VerifyReplicationParams{
+		Namespace:             "test-ns",
+		TargetClusterName:     "standby",
+		ReReplicate:           true,
+		MaxReportedMismatches: 2,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var output VerifyReplicationOutput
+	require.NoError(t, env.GetWorkflowResult(&output))
+	require.Equal(t, int64(pageCount), output.ListedWorkflowCount)
+	require.Equal(t, int64(pageCount), output.MismatchedWorkflowCount)
+	require.Equal(t, int64(pageCount), output.ReReplicatedWorkflowCount)
+	require.Len(t, output.Mismatches, 2)
+	require.Equal(t, "workflow-0", output.Mismatches[0].Execution.BusinessID)
+	env.AssertExpectations(t)
+}
+
+func TestVerifyReplicationWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&MetadataResponse{NamespaceID: "test-ns-id"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions:    []*ExecutionInfo{{BusinessID: "workflow", RunID: "run"}},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	env.OnActivity(a.CompareReplicatedExecutions, mock.Anything, mock.Anything).Return(&compareReplicatedExecutionsResponse{
+		VerifiedWorkflowCount: 1,
+	}, nil).Once()
+
+	env.ExecuteWorkflow(VerifyReplicationWorkflow, VerifyReplicationParams{
+		Namespace:             "test-ns",
+		TargetClusterName:     "standby",
+		PageCountPerExecution: 1,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(t)
+}
+
+func TestVerifyReplicationWorkflow_InvalidParams(t *testing.T) {
+	for _, params := range []VerifyReplicationParams{
+		{TargetClusterName: "standby"},
+		{Namespace: "test-ns"},
+		{Namespace: "test-ns", TargetClusterName: "standby", SampleRate: 1.5},
+	} {
+		testSuite := &testsuite.WorkflowTestSuite{}
+		env := testSuite.NewTestWorkflowEnvironment()
+		env.ExecuteWorkflow(VerifyReplicationWorkflow, params)
+		require.True(t, env.IsWorkflowCompleted())
+
+		var applicationErr *temporal.ApplicationError
+		require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+		require.Equal(t, "InvalidArgument", applicationErr.Type())
+	}
+}
@@ -0,0 +1,56 @@
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/server/chasm"
+)
+
+const (
+	chasmSchemaUpgradeFailureType = "chasm_schema_validator_upgrade"
+)
+
+type (
+	// chasmSchemaValidator is a validator that checks whether any CHASM component of the execution
+	// is persisted with a schema version older than the one currently registered.
+	chasmSchemaValidator struct {
+		chasmRegistry *chasm.Registry
+	}
+)
+
+var _ Validator = (*chasmSchemaValidator)(nil)
+
+// NewChasmSchemaValidator returns new instance.
+func NewChasmSchemaValidator(
+	chasmRegistry *chasm.Registry,
+) *chasmSchemaValidator {
+	return &chasmSchemaValidator{
+		chasmRegistry: chasmRegistry,
+	}
+}
+
+func (v *chasmSchemaValidator) Validate(
+	_ context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	if v.chasmRegistry == nil {
+		return nil, nil
+	}
+
+	for path, node := range mutableState.GetChasmNodes() {
+		componentAttr := node.GetMetadata().GetComponentAttributes()
+		if componentAttr == nil {
+			continue
+		}
+		if v.chasmRegistry.ComponentNeedsSchemaUpgrade(componentAttr.GetTypeId(), componentAttr.GetSchemaVersion()) {
+			// One result per execution is enough, the upgrade is applied to all components at once.
+			return []MutableStateValidationResult{{
+				failureType: chasmSchemaUpgradeFailureType,
+				failureDetails: fmt.Sprintf("component at path %q has stale schema version %d",
+					path, componentAttr.GetSchemaVersion()),
+			}}, nil
+		}
+	}
+	return nil, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -33,6 +34,7 @@ type (
 
 		executionManager              persistence.ExecutionManager
 		registry                      namespace.Registry
+		chasmRegistry                 *chasm.Registry
 		historyClient                 historyservice.HistoryServiceClient
 		adminClient                   adminservice.AdminServiceClient
 		executor                      executor.Executor
@@ -40,6 +42,7 @@ type (
 		perShardQPS                   dynamicconfig.IntPropertyFn
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		enableChasmSchemaUpgrade      dynamicconfig.BoolPropertyFn
 		metricsHandler                metrics.Handler
 		logger                        log.Logger
 
@@ -66,8 +69,10 @@ func NewScavenger(
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	executionTaskWorker dynamicconfig.IntPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	enableChasmSchemaUpgrade dynamicconfig.BoolPropertyFn,
 	executionManager persistence.ExecutionManager,
 	registry namespace.Registry,
+	chasmRegistry *chasm.Registry,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
 	metricsHandler metrics.Handler,
@@ -78,6 +83,7 @@ func NewScavenger(
 		numHistoryShards: numHistoryShards,
 		executionManager: executionManager,
 		registry:         registry,
+		chasmRegistry:    chasmRegistry,
 		historyClient:    historyClient,
 		adminClient:      adminClient,
 		executor: executor.NewFixedSizePoolExecutor(
@@ -92,6 +98,7 @@ func NewScavenger(
 		perShardQPS:                   perShardQPS,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		enableChasmSchemaUpgrade:      enableChasmSchemaUpgrade,
 		metricsHandler:                metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
 		logger:                        logger,
 
@@ -143,6 +150,7 @@ func (s *Scavenger) run() {
 			shardID,
 			s.executionManager,
 			s.registry,
+			s.chasmRegistry,
 			s.historyClient,
 			s.adminClient,
 			s.metricsHandler,
@@ -156,6 +164,7 @@ func (s *Scavenger) run() {
 			}),
 			s.executionDataDurationBuffer,
 			s.enableHistoryEventIDValidator,
+			s.enableChasmSchemaUpgrade,
 		))
 		if !submitted {
 			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
@@ -36,6 +36,7 @@ type (
 		shardID          int32
 		executionManager persistence.ExecutionManager
 		registry         namespace.Registry
+		chasmRegistry    *chasm.Registry
 		historyClient    historyservice.HistoryServiceClient
 		adminClient      adminservice.AdminServiceClient
 		metricsHandler   metrics.Handler
@@ -46,6 +47,7 @@ type (
 		rateLimiter                   quotas.RateLimiter
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		enableChasmSchemaUpgrade      dynamicconfig.BoolPropertyFn
 		paginationToken               []byte
 	}
 )
@@ -56,6 +58,7 @@ func newTask(
 	shardID int32,
 	executionManager persistence.ExecutionManager,
 	registry namespace.Registry,
+	chasmRegistry *chasm.Registry,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
 	metricsHandler metrics.Handler,
@@ -64,11 +67,13 @@ func newTask(
 	rateLimiter quotas.RateLimiter,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	enableChasmSchemaUpgrade dynamicconfig.BoolPropertyFn,
 ) executor.Task {
 	return &task{
 		shardID:          shardID,
 		executionManager: executionManager,
 		registry:         registry,
+		chasmRegistry:    chasmRegistry,
 		historyClient:    historyClient,
 		adminClient:      adminClient,
 
@@ -80,6 +85,7 @@ func newTask(
 		rateLimiter:                   rateLimiter,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		enableChasmSchemaUpgrade:      enableChasmSchemaUpgrade,
 	}
 }
 
@@ -161,6 +167,23 @@ func (t *task) validate(
 		results = append(results, validationResults...)
 	}
 
+	// Stale schema versions are expected until the upgrade is enabled.
+	if t.enableChasmSchemaUpgrade() {
+		if validationResults, err := NewChasmSchemaValidator(
+			t.chasmRegistry,
+		).Validate(t.ctx, mutableState); err != nil {
+			t.logger.Error("unable to validate chasm component schema versions",
+				tag.ShardID(t.shardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+		} else {
+			results = append(results, validationResults...)
+		}
+	}
+
 	// Fail fast if the mutable is corrupted, no need to validate history.
 	if len(results) > 0 {
 		return results
@@ -244,6 +267,10 @@ func (t *task) handleFailures(
 			default:
 				return err
 			}
+		case chasmSchemaUpgradeFailureType:
+			if err := t.upgradeChasmSchema(mutableState); err != nil {
+				return err
+			}
 		default:
 			// no-op
 			continue
@@ -252,6 +279,56 @@ func (t *task) handleFailures(
 	return nil
 }
 
+// upgradeChasmSchema loads the execution in history, which upgrades and persists the state of its
+// CHASM components to their current schema versions, if the execution is active in this cluster.
+// Task refresh is used for that as it's idempotent. It's not free of side effects though: all the
+// tasks of the execution are generated again, and task processing tolerates the duplicates like it
+// does for any other refresh, which is why only executions with a stale schema version are refreshed.
+func (t *task) upgradeChasmSchema(
+	mutableState *MutableState,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	ns, err := t.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case *serviceerror.NotFound,
+		*serviceerror.NamespaceNotFound:
+		return nil
+	case nil:
+	default:
+		return err
+	}
+
+	var archetypeID chasm.ArchetypeID
+	if rootNode, ok := mutableState.GetChasmNodes()[""]; ok {
+		archetypeID = chasm.ArchetypeID(rootNode.GetMetadata().GetComponentAttributes().GetTypeId())
+	}
+	_, err = t.historyClient.RefreshWorkflowTasks(t.ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: executionInfo.GetNamespaceId(),
+		ArchetypeId: archetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: executionInfo.GetNamespaceId(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: executionInfo.GetWorkflowId(),
+				RunId:      mutableState.GetExecutionState().GetRunId(),
+			},
+		},
+	})
+	switch err.(type) {
+	case *serviceerror.NotFound,
+		*serviceerror.NamespaceNotFound:
+		return nil
+	case nil:
+		t.logger.Info("upgraded chasm component schema",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.WorkflowID(executionInfo.GetWorkflowId()),
+			tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+		)
+		return nil
+	default:
+		return err
+	}
+}
+
 func printValidationResult(
 	mutableState *MutableState,
 	results []MutableStateValidationResult,
@@ -6,8 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
@@ -19,6 +23,8 @@ type taskTestSuite struct {
 	controller       *gomock.Controller
 	executionManager *persistence.MockExecutionManager
 	rateLimiter      *quotas.MockRateLimiter
+	registry         *namespace.MockRegistry
+	historyClient    *historyservicemock.MockHistoryServiceClient
 }
 
 func TestTaskTestSuite(t *testing.T) {
@@ -29,6 +35,8 @@ func (s *taskTestSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 	s.executionManager = persistence.NewMockExecutionManager(s.controller)
 	s.rateLimiter = quotas.NewMockRateLimiter(s.controller)
+	s.registry = namespace.NewMockRegistry(s.controller)
+	s.historyClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
 }
 
 func (s *taskTestSuite) TearDownTest() {
@@ -39,6 +47,8 @@ func (s *taskTestSuite) createTask() *task {
 	return &task{
 		shardID:          1,
 		executionManager: s.executionManager,
+		registry:         s.registry,
+		historyClient:    s.historyClient,
 		metricsHandler:   metrics.NoopMetricsHandler,
 		logger:           log.NewNoopLogger(),
 		scavenger:        &Scavenger{numHistoryShards: 4},
@@ -69,3 +79,37 @@ func (s *taskTestSuite) TestRun_PaginationError() {
 	status := task.Run()
 	s.Equal(executor.TaskStatusDefer, status)
 }
+
+func (s *taskTestSuite) TestHandleFailures_ChasmSchemaUpgrade() {
+	task := s.createTask()
+	mutableState := &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: "namespace-id",
+			WorkflowId:  "business-id",
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-id"},
+		ChasmNodes: map[string]*persistencespb.ChasmNode{
+			"": {
+				Metadata: &persistencespb.ChasmNodeMetadata{
+					Attributes: &persistencespb.ChasmNodeMetadata_ComponentAttributes{
+						ComponentAttributes: &persistencespb.ChasmComponentAttributes{TypeId: 1234},
+					},
+				},
+			},
+		},
+	}}
+
+	s.registry.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).
+		Return(namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"}, nil, ""), nil)
+	s.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...any) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			s.Equal("namespace-id", request.GetNamespaceId())
+			s.Equal(uint32(1234), request.GetArchetypeId())
+			s.Equal("business-id", request.GetRequest().GetExecution().GetWorkflowId())
+			s.Equal("run-id", request.GetRequest().GetExecution().GetRunId())
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		})
+
+	err := task.handleFailures(mutableState, []MutableStateValidationResult{{failureType: chasmSchemaUpgradeFailureType}})
+	s.NoError(err)
+}
@@ -2,6 +2,7 @@ package history
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -13,7 +14,6 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -24,6 +24,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/shardpool"
 )
 
 type (
@@ -39,7 +40,7 @@ type (
 
 	// Scavenger is the type that holds the state for history scavenger daemon
 	Scavenger struct {
-		numShards      int32
+		shardPools     *shardpool.Resolver
 		db             persistence.ExecutionManager
 		client         historyservice.HistoryServiceClient
 		adminClient    adminservice.AdminServiceClient
@@ -82,7 +83,7 @@ const (
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
 func NewScavenger(
-	numShards int32,
+	shardPools *shardpool.Resolver,
 	db persistence.ExecutionManager,
 	rps int,
 	client historyservice.HistoryServiceClient,
@@ -97,7 +98,7 @@ func NewScavenger(
 	serializer serialization.Serializer,
 ) *Scavenger {
 	return &Scavenger{
-		numShards:   numShards,
+		shardPools:  shardPools,
 		db:          db,
 		client:      client,
 		adminClient: adminClient,
@@ -226,7 +227,22 @@ func (s *Scavenger) filterTask(
 		s.hbd.ErrorCount++
 		return nil
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numShards)
+	shardID, err := s.shardPools.ShardID(namespaceID, workflowID)
+	var nsNotFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &nsNotFound) {
+		// The shard pool assignment of a deleted namespace is gone with it, so its branches can only be found on
+		// the shards of no pool.
+		shardID, err = s.shardPools.AssignmentShardID(nil, namespaceID, workflowID)
+	}
+	if err != nil {
+		s.logger.Error("unable to resolve the history shard", tag.DetailInfo(branch.Info), tag.Error(err))
+		metrics.HistoryScavengerErrorCount.With(s.metricsHandler).Record(1)
+
+		s.Lock()
+		defer s.Unlock()
+		s.hbd.ErrorCount++
+		return nil
+	}
 
 	branchToken, err := s.serializer.HistoryBranchToBlob(branch.BranchInfo)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/common/testing/protomock"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -92,7 +93,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	executionDataAge := dynamicconfig.GetDurationPropertyFn(time.Second)
 	enableRetentionVerification := dynamicconfig.GetBoolPropertyFn(true)
 	s.scavenger = NewScavenger(
-		s.numShards,
+		shardpooltest.NewResolver(s.numShards),
 		s.mockExecutionManager,
 		rps,
 		s.mockHistoryClient,
@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -28,6 +29,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 	"go.temporal.io/server/service/worker/scanner/scheduleinvariants"
 )
@@ -67,6 +69,8 @@ type (
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
 		// ExecutionScannerHistoryEventIdValidator indicates if the execution scavenger to validate history event id.
 		ExecutionScannerHistoryEventIdValidator dynamicconfig.BoolPropertyFn
+		// EnableCHASMSchemaUpgrade indicates if the execution scavenger upgrades CHASM components with a stale schema version.
+		EnableCHASMSchemaUpgrade dynamicconfig.BoolPropertyFn
 
 		// RemovableBuildIdDurationSinceDefault is the minimum duration since a build ID was last default in its
 		// containing set for it to be considered for removal.
@@ -94,6 +98,8 @@ type (
 		matchingClient     matchingservice.MatchingServiceClient
 		adminClient        adminservice.AdminServiceClient
 		namespaceRegistry  namespace.Registry
+		historyShardPools  *shardpool.Resolver
+		chasmRegistry      *chasm.Registry
 		currentClusterName string
 		hostInfo           membership.HostInfo
 		serializer         serialization.Serializer
@@ -128,6 +134,8 @@ func New(
 	adminClient adminservice.AdminServiceClient,
 	matchingClient matchingservice.MatchingServiceClient,
 	registry namespace.Registry,
+	historyShardPools *shardpool.Resolver,
+	chasmRegistry *chasm.Registry,
 	currentClusterName string,
 	hostInfo membership.HostInfo,
 	serializer serialization.Serializer,
@@ -146,6 +154,8 @@ func New(
 			matchingClient:     matchingClient,
 			adminClient:        adminClient,
 			namespaceRegistry:  registry,
+			historyShardPools:  historyShardPools,
+			chasmRegistry:      chasmRegistry,
 			currentClusterName: currentClusterName,
 			hostInfo:           hostInfo,
 			serializer:         serializer,
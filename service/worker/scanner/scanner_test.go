@@ -213,6 +213,8 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockAdminClient,
 				nil,
 				mockNamespaceRegistry,
+				nil,
+				nil,
 				"active-cluster",
 				membership.NewHostInfoFromAddress("localhost"),
 				serialization.NewSerializer(),
@@ -292,6 +294,8 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockAdminClient,
 		nil,
 		mockNamespaceRegistry,
+		nil,
+		nil,
 		"active-cluster",
 		membership.NewHostInfoFromAddress("localhost"),
 		serialization.NewSerializer(),
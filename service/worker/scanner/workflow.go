@@ -111,7 +111,6 @@ func HistoryScavengerActivity(
 
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
 	rps := ctx.cfg.PersistenceMaxQPS()
-	numShards := ctx.cfg.Persistence.NumHistoryShards
 
 	hbd := history.ScavengerHeartbeatDetails{}
 	if activity.HasHeartbeatDetails(activityCtx) {
@@ -121,7 +120,7 @@ func HistoryScavengerActivity(
 	}
 
 	scavenger := history.NewScavenger(
-		numShards,
+		ctx.historyShardPools,
 		ctx.executionManager,
 		rps,
 		ctx.historyClient,
@@ -173,8 +172,10 @@ func ExecutionsScavengerActivity(
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.ExecutionScannerWorkerCount,
 		ctx.cfg.ExecutionScannerHistoryEventIdValidator,
+		ctx.cfg.EnableCHASMSchemaUpgrade,
 		ctx.executionManager,
 		ctx.namespaceRegistry,
+		ctx.chasmRegistry,
 		ctx.historyClient,
 		ctx.adminClient,
 		metricsHandler,
@@ -9,6 +9,7 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/server/api/matchingservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -25,6 +26,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardpool"
 	"go.temporal.io/server/common/wideevents"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
@@ -58,6 +60,8 @@ type (
 		taskManager            persistence.TaskManager
 		historyClient          resource.HistoryClient
 		namespaceRegistry      namespace.Registry
+		historyShardPools      *shardpool.Resolver
+		chasmRegistry          *chasm.Registry
 		workerServiceResolver  membership.ServiceResolver
 		visibilityManager      manager.VisibilityManager
 
@@ -124,6 +128,8 @@ func NewService(
 	clientBean client.Bean,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	namespaceRegistry namespace.Registry,
+	historyShardPools *shardpool.Resolver,
+	chasmRegistry *chasm.Registry,
 	executionManager persistence.ExecutionManager,
 	membershipMonitor membership.Monitor,
 	hostInfoProvider membership.HostInfoProvider,
@@ -157,6 +163,8 @@ func NewService(
 		clientBean:                clientBean,
 		clusterMetadataManager:    clusterMetadataManager,
 		namespaceRegistry:         namespaceRegistry,
+		historyShardPools:         historyShardPools,
+		chasmRegistry:             chasmRegistry,
 		executionManager:          executionManager,
 		workerServiceResolver:     workerServiceResolver,
 		membershipMonitor:         membershipMonitor,
@@ -220,6 +228,7 @@ func NewConfig(
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
 			ExecutionScannerWorkerCount:             dynamicconfig.ExecutionScannerWorkerCount.Get(dc),
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
+			EnableCHASMSchemaUpgrade:                dynamicconfig.EnableCHASMSchemaUpgrade.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
 
@@ -361,6 +370,8 @@ func (s *Service) initScanner(serializer serialization.Serializer) error {
 		adminClient,
 		s.matchingClient,
 		s.namespaceRegistry,
+		s.historyShardPools,
+		s.chasmRegistry,
 		currentCluster,
 		s.hostInfo,
 		serializer,
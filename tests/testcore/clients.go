@@ -30,6 +30,8 @@ import (
 	"go.temporal.io/server/common/rpc/auth"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardpool"
+	"go.temporal.io/server/common/shardpool/shardpooltest"
 	"go.temporal.io/server/common/testing/testhooks"
 	"google.golang.org/grpc"
 )
@@ -187,7 +189,8 @@ func (c *clients) ensureMatching() {
 			c.metricsHandler,
 			dynamicconfig.NewCollection(c.dcClient, c.logger),
 			c.testHooks,
-			c.numHistoryShards,
+			// Only the matching client is created, which doesn't route by history shard.
+			shardpooltest.NewResolver(c.numHistoryShards),
 			c.logger,
 			c.logger,
 		)
@@ -277,7 +280,7 @@ func (p *clientFactoryProvider) NewFactory(
 	metricsHandler metrics.Handler,
 	dc *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	numberOfHistoryShards int32,
+	historyShardPools *shardpool.Resolver,
 	logger log.Logger,
 	throttledLogger log.Logger,
 ) client.Factory {
@@ -287,7 +290,7 @@ func (p *clientFactoryProvider) NewFactory(
 		metricsHandler,
 		dc,
 		testHooks,
-		numberOfHistoryShards,
+		historyShardPools,
 		logger,
 		throttledLogger,
 	)
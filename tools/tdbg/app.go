@@ -35,7 +35,6 @@ func NewCliApp(opts ...Option) *cli.App {
 	taskCategoryRegistry := tasks.NewDefaultTaskCategoryRegistry()
 	taskCategoryRegistry.AddCategory(tasks.CategoryArchival)
 	params := Params{
-		ClientFactory:        NewClientFactory(),
 		TaskCategoryRegistry: taskCategoryRegistry,
 		Writer:               os.Stdout,
 		ErrWriter:            os.Stderr,
@@ -44,6 +43,9 @@ func NewCliApp(opts ...Option) *cli.App {
 	for _, opt := range opts {
 		opt(&params)
 	}
+	if params.ClientFactory == nil {
+		params.ClientFactory = NewClientFactory(WithTaskCategoryRegistry(params.TaskCategoryRegistry))
+	}
 	app := cli.NewApp()
 	app.Name = "tdbg"
 	app.Usage = "A command-line tool for Temporal server debugging"
@@ -102,13 +104,23 @@ func NewCliApp(opts ...Option) *cli.App {
 			Usage:   "Override for target server name",
 			EnvVars: []string{"TEMPORAL_CLI_TLS_SERVER_NAME"},
 		},
+		&cli.StringFlag{
+			Name: FlagOfflineConfig,
+			Usage: "Path to a server config file. tdbg reads the persistence configured in it directly, read-only, " +
+				"instead of calling the frontend, e.g. to inspect a backup. Only execution describe and show, and shard " +
+				"describe and list-tasks are supported",
+			EnvVars: []string{"TEMPORAL_CLI_OFFLINE_CONFIG"},
+		},
 		&cli.StringFlag{
 			Name:  "color",
 			Usage: fmt.Sprintf("When to use color: %v, %v, %v.", "auto", "always", "never"),
 			Value: "auto",
 		},
 	}
-	prompterFactory := NewPrompterFactory()
+	// Prompts exit through cli.OsExiter, like errors, so that the shell can keep running when a prompt is declined.
+	prompterFactory := NewPrompterFactory(func(params *PrompterParams) {
+		params.Exiter = func(code int) { cli.OsExiter(code) }
+	})
 	app.Before = func(ctx *cli.Context) error {
 		colorFlag := ctx.String("color")
 		switch colorFlag {
@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
@@ -320,6 +321,45 @@ func AdminDescribeExecution(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminDescribeExecutionAtEvent describes the mutable state of a workflow rebuilt up to an event.
+func AdminDescribeExecutionAtEvent(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	bid, err := getRequiredOption(c, FlagBusinessID)
+	if err != nil {
+		return err
+	}
+	eventID := c.Int64(FlagAtEvent)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeMutableStateAtEvent(ctx, &adminservice.DescribeMutableStateAtEventRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: bid,
+			RunId:      c.String(FlagRunID),
+		},
+		EventId: eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rebuild Mutable State: %s", err)
+	}
+
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintln(c.App.Writer, color.GreenString("Mutable state at event %d:", eventID))
+	prettyPrintJSONObject(c, resp.GetMutableState())
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "History service address: %s\n", resp.GetHistoryAddr())
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "Shard Id: %s\n", resp.GetShardId())
+	return nil
+}
+
 func dumpChasmTree(resp *adminservice.DescribeMutableStateResponse, c *cli.Context) error {
 	chasmNodes := resp.GetDatabaseMutableState().GetChasmNodes()
 	if len(chasmNodes) == 0 {
@@ -393,7 +433,9 @@ func AdminDeleteWorkflow(c *cli.Context, clientFactory ClientFactory, prompter *
 	rid := c.String(FlagRunID)
 
 	msg := fmt.Sprintf("Namespace: %s WorkflowID: %s RunID: %s\nForce delete above workflow execution?", namespace, wid, rid)
-	prompter.Prompt(msg)
+	if err := prompter.Prompt(msg); err != nil {
+		return err
+	}
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -427,19 +469,33 @@ func AdminDeleteWorkflow(c *cli.Context, clientFactory ClientFactory, prompter *
 	return nil
 }
 
-// AdminGetShardID get shardID
-func AdminGetShardID(c *cli.Context) error {
-	namespaceID := c.String(FlagNamespaceID)
+// AdminGetShardID get shardID. The shard is resolved by the cluster, since it depends on the shard pool the namespace
+// is assigned to.
+func AdminGetShardID(c *cli.Context, clientFactory ClientFactory) error {
+	namespaceID, err := getRequiredOption(c, FlagNamespaceID)
+	if err != nil {
+		return err
+	}
 	wid, err := getRequiredOption(c, FlagWorkflowID)
 	if err != nil {
 		return err
 	}
-	numberOfShards := int32(c.Int(FlagNumberOfShards))
 
-	if numberOfShards <= 0 {
-		return fmt.Errorf("missing required parameter number of Shards")
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	nsResp, err := clientFactory.WorkflowClient(c).DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Id: namespaceID})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace: %s", err)
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, wid, numberOfShards)
+	resp, err := clientFactory.AdminClient(c).DescribeHistoryHost(ctx, &adminservice.DescribeHistoryHostRequest{
+		Namespace:         nsResp.GetNamespaceInfo().GetName(),
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: wid},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to resolve shard: %s", err)
+	}
+	shardID := resp.GetWorkflowShardId()
 	// nolint:errcheck // assuming that write will succeed.
 	fmt.Fprintf(c.App.Writer, "ShardId for namespace, workflowId: %v, %v is %v \n", namespaceID, wid, shardID)
 	return nil
@@ -555,6 +611,104 @@ func AdminRemoveTask(
 	return nil
 }
 
+// AdminTraceTask shows the recorded lifecycle of a sampled history task
+func AdminTraceTask(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	adminClient := clientFactory.AdminClient(c)
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetTaskTrace(ctx, &adminservice.GetTaskTraceRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+		TaskId:   c.Int64(FlagTaskID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get task trace: %s", err)
+	}
+	prettyPrintJSONObject(c, resp.GetTrace())
+	return nil
+}
+
+// AdminListQueueMitigations lists the mitigations recently applied by the history queues of a shard
+func AdminListQueueMitigations(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListQueueMitigations(ctx, &adminservice.ListQueueMitigationsRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list queue mitigations: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminGetReplicationStatus shows the replication lag of the remote clusters, and fails if it's not safe to fail over
+func AdminGetReplicationStatus(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetReplicationLag(ctx, &adminservice.GetReplicationLagRequest{
+		RemoteClusters:  c.StringSlice(FlagCluster),
+		Namespaces:      c.StringSlice(FlagNamespace),
+		MaxLag:          durationpb.New(c.Duration(FlagMaxLag)),
+		MaxScannedTasks: int32(c.Int(FlagMaxScannedTasks)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get replication lag: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	if !resp.GetSafeToFailover() {
+		return fmt.Errorf("not safe to fail over: %s", strings.Join(resp.GetUnsafeReasons(), "; "))
+	}
+	return nil
+}
+
+// AdminSetReplicationFilter sets the filter restricting the workflows of a namespace replicated from the current cluster
+func AdminSetReplicationFilter(c *cli.Context, clientFactory ClientFactory) error {
+	filter := &persistencespb.ReplicationFilter{
+		WorkflowTypes: c.StringSlice(FlagWorkflowType),
+	}
+	searchAttributeFilters := make(map[string]*persistencespb.SearchAttributeReplicationFilter)
+	for _, searchAttribute := range c.StringSlice(FlagSearchAttribute) {
+		name, value, ok := strings.Cut(searchAttribute, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid search attribute %q, expected name=value", searchAttribute)
+		}
+		searchAttributeFilter, ok := searchAttributeFilters[name]
+		if !ok {
+			searchAttributeFilter = &persistencespb.SearchAttributeReplicationFilter{Name: name}
+			searchAttributeFilters[name] = searchAttributeFilter
+			filter.SearchAttributes = append(filter.SearchAttributes, searchAttributeFilter)
+		}
+		searchAttributeFilter.Values = append(searchAttributeFilter.Values, value)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if _, err := adminClient.UpdateNamespaceReplicationFilter(ctx, &adminservice.UpdateNamespaceReplicationFilterRequest{
+		Namespace:         c.String(FlagNamespace),
+		ReplicationFilter: filter,
+	}); err != nil {
+		return fmt.Errorf("unable to set replication filter: %s", err)
+	}
+	return nil
+}
+
 // AdminDescribeShard describes shard by shard id
 func AdminDescribeShard(c *cli.Context, clientFactory ClientFactory) error {
 	sid := c.Int(FlagShardID)
@@ -589,6 +743,49 @@ func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminMoveShard pins a shard to a history host
+func AdminMoveShard(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	sid := c.Int(FlagShardID)
+	targetHost, err := getRequiredOption(c, FlagTargetHost)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.MoveHistoryShard(ctx, &adminservice.MoveHistoryShardRequest{
+		ShardId:    int32(sid),
+		TargetHost: targetHost,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to move shard: %s", err)
+	}
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "Shard %d pinned to %s.\n", sid, targetHost)
+	return nil
+}
+
+// AdminUnpinShard removes the pin of a shard
+func AdminUnpinShard(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	sid := c.Int(FlagShardID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.UnpinHistoryShard(ctx, &adminservice.UnpinHistoryShardRequest{
+		ShardId: int32(sid),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpin shard: %s", err)
+	}
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "Shard %d unpinned.\n", sid)
+	return nil
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context, clientFactory ClientFactory) error {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -696,6 +893,58 @@ func AdminDescribeHistoryHost(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminDescribeTaskScheduler describes the task schedulers of history hosts
+func AdminDescribeTaskScheduler(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeTaskScheduler(ctx, &adminservice.DescribeTaskSchedulerRequest{
+		HostAddress: c.String(FlagHistoryAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe task scheduler: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpdateTaskSchedulerNamespaceWeight sets or removes the task scheduler weight multiplier of a namespace
+func AdminUpdateTaskSchedulerNamespaceWeight(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	remove := c.Bool(FlagRemove)
+	if !remove && !c.IsSet(FlagMultiplier) {
+		return fmt.Errorf("either --%s or --%s must be set", FlagMultiplier, FlagRemove)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateTaskSchedulerNamespaceWeight(ctx, &adminservice.UpdateTaskSchedulerNamespaceWeightRequest{
+		Namespace:  namespace,
+		Multiplier: c.Float64(FlagMultiplier),
+		Duration:   durationpb.New(c.Duration(FlagDuration)),
+		Remove:     remove,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update task scheduler namespace weight: %s", err)
+	}
+	if remove {
+		// nolint:errcheck // assuming that write will succeed.
+		fmt.Fprintf(c.App.Writer, "Removed task scheduler weight multiplier of namespace %s on %d history hosts.\n", namespace, len(resp.GetHosts()))
+		return nil
+	}
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "Task scheduler weight of namespace %s multiplied by %v on %d history hosts until %s.\n",
+		namespace, c.Float64(FlagMultiplier), len(resp.GetHosts()), resp.GetExpireTime().AsTime().Format(time.RFC3339))
+	return nil
+}
+
 func adminRefreshWorkflowTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
 	if c.IsSet(FlagVisibilityQuery) && c.IsSet(FlagWorkflowID) && c.IsSet(FlagRunID) {
 		return errors.New("setting parameter visibility query with workflow ID and run ID is not allowed")
@@ -786,7 +1035,9 @@ func AdminBatchRefreshWorkflowTasks(c *cli.Context, clientFactory ClientFactory,
 
 	msg := fmt.Sprintf("Will refresh tasks for %d execution(s) matching query %q in namespace %q. Continue Y/N?",
 		countResp.GetCount(), query, nsName)
-	prompter.Prompt(msg)
+	if err := prompter.Prompt(msg); err != nil {
+		return err
+	}
 
 	_, err = adminClient.StartAdminBatchOperation(ctx, &adminservice.StartAdminBatchOperationRequest{
 		Namespace:       nsName,
@@ -840,6 +1091,124 @@ func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminCloneWorkflow copies the history of a workflow execution into a new workflow ID
+func AdminCloneWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	targetWid, err := getRequiredOption(c, FlagTargetWorkflowID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.CloneWorkflowExecution(ctx, &adminservice.CloneWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      c.String(FlagRunID),
+		},
+		TargetNamespace:  c.String(FlagTargetNamespace),
+		TargetWorkflowId: targetWid,
+		LastEventId:      c.Int64(FlagLastEventID),
+		TargetTaskQueue:  c.String(FlagTargetTaskQueue),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to clone workflow: %s", err)
+	}
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "Cloned workflow up to event %d. Workflow ID: %s, Run ID: %s\n", resp.GetLastEventId(), targetWid, resp.GetRunId())
+	return nil
+}
+
+// AdminDryRunResetWorkflow prints the effect of resetting a workflow execution without resetting it
+func AdminDryRunResetWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	var excludeTypes []enumspb.ResetReapplyExcludeType
+	for _, exclude := range c.StringSlice(FlagReapplyExclude) {
+		excludeType, err := enumspb.ResetReapplyExcludeTypeFromString(exclude)
+		if err != nil {
+			return fmt.Errorf("invalid reapply exclude type %q: %w", exclude, err)
+		}
+		excludeTypes = append(excludeTypes, excludeType)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DryRunResetWorkflowExecution(ctx, &adminservice.DryRunResetWorkflowExecutionRequest{
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace: nsName,
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      c.String(FlagRunID),
+			},
+			WorkflowTaskFinishEventId: c.Int64(FlagEventID),
+			Reason:                    c.String(FlagReason),
+			ResetReapplyExcludeTypes:  excludeTypes,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to dry run workflow reset: %s", err)
+	}
+	prettyPrintJSONObject(c, resp.GetResult())
+	return nil
+}
+
+// AdminListWorkflowConflictResolutions lists the conflicts between history branches of a workflow execution
+// resolved by the cluster.
+func AdminListWorkflowConflictResolutions(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListWorkflowConflictResolutions(ctx, &adminservice.ListWorkflowConflictResolutionsRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      c.String(FlagRunID),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list workflow conflict resolutions: %s", err)
+	}
+	if len(resp.GetRecords()) == 0 {
+		// nolint:errcheck // assuming that write will succeed.
+		fmt.Fprintln(c.App.Writer, "No conflict resolution recorded.")
+		return nil
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
 // AdminReplicateWorkflow force replicates a workflow by generating replication tasks
 func AdminReplicateWorkflow(
 	c *cli.Context,
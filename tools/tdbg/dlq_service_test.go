@@ -13,6 +13,8 @@ import (
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/tools/tdbg"
@@ -30,6 +32,7 @@ type (
 		maxMessageCount string
 		lastMessageID   string
 		outputFileName  string
+		extraArgs       []string
 		adminClient     *fakeAdminClient
 		clientFactory   tdbg.ClientFactory
 		// expectedErrSubstrings is a list of substrings that are expected to be found in the error message. We don't use an
@@ -42,6 +45,7 @@ type (
 		override       func(p *dlqTestParams)
 		validateStdout func(t *testing.T, b *bytes.Buffer)
 		validateStderr func(t *testing.T, b *bytes.Buffer)
+		validateClient func(t *testing.T, c *fakeAdminClient)
 	}
 	fakeClientFactory struct {
 		adminClient adminservice.AdminServiceClient
@@ -58,6 +62,9 @@ type (
 		// GetDLQTasks (for V2)
 		getDLQTasksResponses    []*adminservice.GetDLQTasksResponse
 		nextGetDLQTasksResponse int
+
+		purgeDLQTasksRequest *adminservice.PurgeDLQTasksRequest
+		mergeDLQTasksRequest *adminservice.MergeDLQTasksRequest
 	}
 )
 
@@ -97,6 +104,7 @@ func (tc *dlqTestCase) Run(t *testing.T) {
 		runArgs = appendArg(runArgs, tdbg.FlagLastMessageID, p.lastMessageID)
 	}
 	runArgs = appendArg(runArgs, tdbg.FlagOutputFilename, p.outputFileName)
+	runArgs = append(runArgs, p.extraArgs...)
 
 	t.Logf("Running %v", runArgs)
 	err := app.Run(runArgs)
@@ -115,6 +123,9 @@ func (tc *dlqTestCase) Run(t *testing.T) {
 	if tc.validateStderr != nil {
 		tc.validateStderr(t, &stderr)
 	}
+	if tc.validateClient != nil {
+		tc.validateClient(t, p.adminClient)
+	}
 }
 
 func TestDLQCommand_V2(t *testing.T) {
@@ -236,6 +247,57 @@ func TestDLQCommand_V2(t *testing.T) {
 				p.expectedErrSubstrings = []string{"some error", "MergeDLQTasks"}
 			},
 		},
+		{
+			name: "merge with filter",
+			override: func(p *dlqTestParams) {
+				p.command = "merge"
+				p.lastMessageID = "100"
+				p.adminClient.err = nil
+				p.extraArgs = []string{
+					"--" + tdbg.FlagNamespaceID, "ns-1",
+					"--" + tdbg.FlagNamespaceID, "ns-2",
+					"--" + tdbg.FlagWorkflowIDPrefix, "order-",
+					"--" + tdbg.FlagTaskType, "TransferActivityTask",
+					"--" + tdbg.FlagFailureContains, "deadline exceeded",
+					"--" + tdbg.FlagSkipClosedWorkflows,
+					"--" + tdbg.FlagDryRun,
+				}
+			},
+			validateClient: func(t *testing.T, c *fakeAdminClient) {
+				require.NotNil(t, c.mergeDLQTasksRequest)
+				assert.Equal(t, &commonspb.HistoryDLQTaskFilter{
+					NamespaceIds:     []string{"ns-1", "ns-2"},
+					WorkflowIdPrefix: "order-",
+					TaskTypes:        []enumsspb.TaskType{enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK},
+					FailureContains:  "deadline exceeded",
+				}, c.mergeDLQTasksRequest.GetFilter())
+				assert.True(t, c.mergeDLQTasksRequest.GetSkipClosedWorkflows())
+				assert.False(t, c.mergeDLQTasksRequest.GetPurgeUnmatched())
+				assert.True(t, c.mergeDLQTasksRequest.GetDryRun())
+			},
+		},
+		{
+			name: "purge without filter",
+			override: func(p *dlqTestParams) {
+				p.command = "purge"
+				p.lastMessageID = "100"
+				p.adminClient.err = nil
+			},
+			validateClient: func(t *testing.T, c *fakeAdminClient) {
+				require.NotNil(t, c.purgeDLQTasksRequest)
+				assert.Nil(t, c.purgeDLQTasksRequest.GetFilter())
+				assert.False(t, c.purgeDLQTasksRequest.GetDryRun())
+			},
+		},
+		{
+			name: "purge invalid task type",
+			override: func(p *dlqTestParams) {
+				p.command = "purge"
+				p.lastMessageID = "100"
+				p.extraArgs = []string{"--" + tdbg.FlagTaskType, "NotATaskType"}
+				p.expectedErrSubstrings = []string{tdbg.FlagTaskType, "NotATaskType"}
+			},
+		},
 		{
 			name: "list no queues",
 			override: func(p *dlqTestParams) {
@@ -460,24 +522,26 @@ func (f *fakeAdminClient) GetDLQTasks(
 }
 
 func (f *fakeAdminClient) PurgeDLQTasks(
-	context.Context,
-	*adminservice.PurgeDLQTasksRequest,
-	...grpc.CallOption,
+	_ context.Context,
+	req *adminservice.PurgeDLQTasksRequest,
+	_ ...grpc.CallOption,
 ) (*adminservice.PurgeDLQTasksResponse, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
+	f.purgeDLQTasksRequest = req
 	return &adminservice.PurgeDLQTasksResponse{}, nil
 }
 
 func (f *fakeAdminClient) MergeDLQTasks(
-	context.Context,
-	*adminservice.MergeDLQTasksRequest,
-	...grpc.CallOption,
+	_ context.Context,
+	req *adminservice.MergeDLQTasksRequest,
+	_ ...grpc.CallOption,
 ) (*adminservice.MergeDLQTasksResponse, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
+	f.mergeDLQTasksRequest = req
 	return &adminservice.MergeDLQTasksResponse{}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/tasks"
@@ -42,6 +43,8 @@ type (
 		ShardID int32 `json:"shard_id"`
 		// Payload contains the parsed task metadata from the server.
 		Payload *TaskPayload `json:"payload"`
+		// Failure is the error which caused the task to be moved to the DLQ, if it was recorded.
+		Failure string `json:"failure,omitempty"`
 	}
 	// TaskPayload implements both [json.Marshaler] and [json.Unmarshaler]. This allows us to pretty-print tasks using
 	// jsonpb when serializing and then store the raw bytes of the task payload for later use when deserializing. We
@@ -153,6 +156,10 @@ func (ac *DLQV2Service) ReadMessages(c *cli.Context) (err error) {
 	defer func() {
 		err = multierr.Append(err, outputFile.Close())
 	}()
+	filter, err := getDLQTaskFilter(c)
+	if err != nil {
+		return err
+	}
 	adminClient := ac.clientFactory.AdminClient(c)
 
 	pageSize := c.Int(FlagPageSize)
@@ -166,6 +173,7 @@ func (ac *DLQV2Service) ReadMessages(c *cli.Context) (err error) {
 				},
 				PageSize:      int32(pageSize),
 				NextPageToken: paginationToken,
+				Filter:        filter,
 			}
 			res, err := adminClient.GetDLQTasks(ctx, request)
 			if err != nil {
@@ -200,6 +208,7 @@ func (ac *DLQV2Service) ReadMessages(c *cli.Context) (err error) {
 			MessageID: dlqTask.Metadata.MessageId,
 			ShardID:   dlqTask.Payload.ShardId,
 			Payload:   payload,
+			Failure:   dlqTask.Failure,
 		}
 		err = newEncoder(outputFile).Encode(message)
 		if err != nil {
@@ -220,6 +229,10 @@ func (ac *DLQV2Service) PurgeMessages(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	filter, err := getDLQTaskFilter(c)
+	if err != nil {
+		return err
+	}
 	ctx, cancel := newContext(c)
 	defer cancel()
 	response, err := adminClient.PurgeDLQTasks(ctx, &adminservice.PurgeDLQTasksRequest{
@@ -227,6 +240,8 @@ func (ac *DLQV2Service) PurgeMessages(c *cli.Context) error {
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: lastMessageID,
 		},
+		Filter: filter,
+		DryRun: c.Bool(FlagDryRun),
 	})
 	if err != nil {
 		return fmt.Errorf("call to PurgeDLQTasks failed: %w", err)
@@ -240,6 +255,10 @@ func (ac *DLQV2Service) PurgeMessages(c *cli.Context) error {
 
 func (ac *DLQV2Service) MergeMessages(c *cli.Context) error {
 	adminClient := ac.clientFactory.AdminClient(c)
+	filter, err := getDLQTaskFilter(c)
+	if err != nil {
+		return err
+	}
 
 	var lastMessageID int64
 	if c.IsSet(FlagLastMessageID) {
@@ -255,7 +274,6 @@ func (ac *DLQV2Service) MergeMessages(c *cli.Context) error {
 	} else {
 		_, _ = fmt.Fprint(c.App.Writer, "Note: No last message ID provided. Using ListQueues to find the last message ID.\n")
 
-		var err error
 		var ok bool
 		lastMessageID, ok, err = ac.findLastMessageIDFromListQueues(c)
 		if err != nil {
@@ -277,7 +295,11 @@ func (ac *DLQV2Service) MergeMessages(c *cli.Context) error {
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: lastMessageID,
 		},
-		BatchSize: int32(c.Int(FlagPageSize)), // let the server handle validation and defaulting of batch size.
+		BatchSize:           int32(c.Int(FlagPageSize)), // let the server handle validation and defaulting of batch size.
+		Filter:              filter,
+		SkipClosedWorkflows: c.Bool(FlagSkipClosedWorkflows),
+		PurgeUnmatched:      c.Bool(FlagPurgeUnmatched),
+		DryRun:              c.Bool(FlagDryRun),
 	})
 	if err != nil {
 		return fmt.Errorf("call to MergeDLQTasks failed: %w", err)
@@ -289,6 +311,29 @@ func (ac *DLQV2Service) MergeMessages(c *cli.Context) error {
 	return nil
 }
 
+// getDLQTaskFilter returns the filter selecting the DLQ tasks to operate on, or nil if no filter flag is set.
+func getDLQTaskFilter(c *cli.Context) (*commonspb.HistoryDLQTaskFilter, error) {
+	var taskTypes []enumsspb.TaskType
+	for _, name := range c.StringSlice(FlagTaskType) {
+		taskType, err := enumsspb.TaskTypeFromString(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: %w", FlagTaskType, name, err)
+		}
+		taskTypes = append(taskTypes, taskType)
+	}
+	filter := &commonspb.HistoryDLQTaskFilter{
+		NamespaceIds:     c.StringSlice(FlagNamespaceID),
+		WorkflowIdPrefix: c.String(FlagWorkflowIDPrefix),
+		TaskTypes:        taskTypes,
+		FailureContains:  c.String(FlagFailureContains),
+	}
+	if len(filter.NamespaceIds) == 0 && filter.WorkflowIdPrefix == "" && len(filter.TaskTypes) == 0 &&
+		filter.FailureContains == "" {
+		return nil, nil
+	}
+	return filter, nil
+}
+
 func (ac *DLQV2Service) getDLQKey() *commonspb.HistoryDLQKey {
 	return &commonspb.HistoryDLQKey{
 		TaskCategory:  int32(ac.category.ID()),
@@ -304,7 +349,9 @@ func (ac *DLQV2Service) getLastMessageID(c *cli.Context, action string) (int64,
 			FlagLastMessageID,
 			action,
 		)
-		ac.prompter.Prompt(msg)
+		if err := ac.prompter.Prompt(msg); err != nil {
+			return 0, err
+		}
 		return persistence.MaxQueueMessageID, nil
 	}
 	lastMessageID := c.Int64(FlagLastMessageID)
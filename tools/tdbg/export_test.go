@@ -0,0 +1,7 @@
+package tdbg
+
+// Export unexported functions for testing.
+var (
+	CompleteShellLine = completeShellLine
+	SplitShellLine    = splitShellLine
+)
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/urfave/cli/v2"
@@ -19,6 +20,7 @@ import (
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/multierr"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -44,9 +46,14 @@ type (
 	clientFactory struct {
 		logger                  log.Logger
 		frontendAddressProvider frontendAddressProvider
+		taskCategoryRegistry    tasks.TaskCategoryRegistry
+
+		offlineLock  sync.Mutex
+		offlineConns map[string]*offlineClientConn
 	}
 	clientFactoryParams struct {
 		frontendAddressProvider frontendAddressProvider
+		taskCategoryRegistry    tasks.TaskCategoryRegistry
 	}
 	frontendAddressProvider interface {
 		GetFrontendAddress(c *cli.Context) string
@@ -61,8 +68,11 @@ var netClient HttpGetter = &http.Client{
 // NewClientFactory creates a new ClientFactory
 func NewClientFactory(opts ...ClientFactoryOption) ClientFactory {
 	logger := log.NewCLILogger()
+	taskCategoryRegistry := tasks.NewDefaultTaskCategoryRegistry()
+	taskCategoryRegistry.AddCategory(tasks.CategoryArchival)
 	params := &clientFactoryParams{
 		frontendAddressProvider: DefaultFrontendAddressProvider{},
+		taskCategoryRegistry:    taskCategoryRegistry,
 	}
 	for _, opt := range opts {
 		opt(params)
@@ -71,6 +81,8 @@ func NewClientFactory(opts ...ClientFactoryOption) ClientFactory {
 	return &clientFactory{
 		logger:                  logger,
 		frontendAddressProvider: params.frontendAddressProvider,
+		taskCategoryRegistry:    params.taskCategoryRegistry,
+		offlineConns:            make(map[string]*offlineClientConn),
 	}
 }
 
@@ -81,19 +93,51 @@ func WithFrontendAddress(address string) ClientFactoryOption {
 	}
 }
 
+// WithTaskCategoryRegistry sets the task categories known to clients created by the factory in offline mode.
+func WithTaskCategoryRegistry(registry tasks.TaskCategoryRegistry) ClientFactoryOption {
+	return func(params *clientFactoryParams) {
+		params.taskCategoryRegistry = registry
+	}
+}
+
 // AdminClient builds an admin client.
 func (b *clientFactory) AdminClient(c *cli.Context) adminservice.AdminServiceClient {
+	if configFile := c.String(FlagOfflineConfig); configFile != "" {
+		return adminservice.NewAdminServiceClient(b.offlineConnection(configFile))
+	}
 	connection, _ := b.createGRPCConnection(c)
 
 	return adminservice.NewAdminServiceClient(connection)
 }
 
 func (b *clientFactory) WorkflowClient(c *cli.Context) workflowservice.WorkflowServiceClient {
+	if configFile := c.String(FlagOfflineConfig); configFile != "" {
+		return workflowservice.NewWorkflowServiceClient(b.offlineConnection(configFile))
+	}
 	connection, _ := b.createGRPCConnection(c)
 
 	return workflowservice.NewWorkflowServiceClient(connection)
 }
 
+// offlineConnection returns a connection which serves requests from the persistence configured in the given
+// config file. The persistence is opened once and reused by the following commands, e.g. in the shell.
+func (b *clientFactory) offlineConnection(configFile string) *offlineClientConn {
+	b.offlineLock.Lock()
+	defer b.offlineLock.Unlock()
+
+	if conn, ok := b.offlineConns[configFile]; ok {
+		return conn
+	}
+	backend, err := newOfflineBackend(configFile, b.taskCategoryRegistry, b.logger)
+	if err != nil {
+		b.logger.Fatal("Failed to open persistence", tag.Error(err))
+		return nil
+	}
+	conn := newOfflineClientConn(backend)
+	b.offlineConns[configFile] = conn
+	return conn
+}
+
 func (b *clientFactory) createGRPCConnection(c *cli.Context) (*grpc.ClientConn, error) {
 	frontendAddress := b.frontendAddressProvider.GetFrontendAddress(c)
 
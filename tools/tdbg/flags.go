@@ -16,7 +16,6 @@ var (
 	FlagBusinessIDAlias            = []string{"bid", FlagWorkflowID, FlagWorkflowIDAlias[0]}
 	FlagArchetype                  = "archetype"
 	FlagArchetypeID                = "archetype-id"
-	FlagNumberOfShards             = "number-of-shards"
 	FlagMinEventID                 = "min-event-id"
 	FlagMaxEventID                 = "max-event-id"
 	FlagTaskQueue                  = "task-queue"
@@ -49,6 +48,7 @@ var (
 	FlagJobToken                   = "job-token"
 	FlagReason                     = "reason"
 	FlagYes                        = "yes"
+	FlagOfflineConfig              = "offline-config"
 	FlagMore                       = "more"
 	FlagMinEventVersion            = "min-event-version"
 	FlagMaxEventVersion            = "max-event-version"
@@ -84,6 +84,29 @@ var (
 	FlagExecute                    = "execute"
 	FlagWorkers                    = "workers"
 	FlagOutputLog                  = "output-log"
+	FlagTargetNamespace            = "target-namespace"
+	FlagTargetWorkflowID           = "target-workflow-id"
+	FlagTargetTaskQueue            = "target-task-queue"
+	FlagLastEventID                = "last-event-id"
+	FlagEventID                    = "event-id"
+	FlagReapplyExclude             = "reapply-exclude"
+	FlagTargetHost                 = "target-host"
+	FlagAtEvent                    = "at-event"
+	FlagMultiplier                 = "multiplier"
+	FlagDuration                   = "duration"
+	FlagRemove                     = "remove"
+	FlagWorkflowIDPrefix           = "workflow-id-prefix"
+	FlagTaskType                   = "task-type"
+	FlagFailureContains            = "failure-contains"
+	FlagDryRun                     = "dry-run"
+	FlagSkipClosedWorkflows        = "skip-closed-workflows"
+	FlagPurgeUnmatched             = "purge-unmatched"
+	FlagMaxLag                     = "max-lag"
+	FlagMaxScannedTasks            = "max-scanned-tasks"
+	FlagHistoryFile                = "history-file"
+	FlagStuckThreshold             = "stuck-threshold"
+	FlagWorkflowType               = "workflow-type"
+	FlagSearchAttribute            = "search-attribute"
 )
 
 const defaultMigrateWorkers = 5
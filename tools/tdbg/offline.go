@@ -0,0 +1,466 @@
+package tdbg
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/shardpool"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistoryv2"
+	"go.temporal.io/server/service/history/api/listtasks"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// offlineBackend reads namespaces, executions, histories and shards directly from the persistence of a
+	// cluster, typically one restored from a backup, without any Temporal service running. It never writes to
+	// persistence, and stays open until tdbg exits.
+	offlineBackend struct {
+		registry         tasks.TaskCategoryRegistry
+		serializer       serialization.Serializer
+		shardResolver    *shardpool.Resolver
+		shardStore       persistence.ShardStore
+		executionManager persistence.ExecutionManager
+		metadataManager  persistence.MetadataManager
+	}
+
+	// offlineNamespaceRegistry reads the shard pool assignments of namespaces from persistence.
+	offlineNamespaceRegistry struct {
+		backend *offlineBackend
+	}
+
+	// offlineAdminHandler serves the admin APIs supported in offline mode from an offlineBackend.
+	offlineAdminHandler struct {
+		adminservice.UnimplementedAdminServiceServer
+
+		backend *offlineBackend
+	}
+
+	// offlineWorkflowHandler serves the workflow APIs supported in offline mode from an offlineBackend.
+	offlineWorkflowHandler struct {
+		workflowservice.UnimplementedWorkflowServiceServer
+
+		backend *offlineBackend
+	}
+
+	// offlineClientConn is a grpc.ClientConnInterface which dispatches calls to the offline handlers in process,
+	// so that tdbg commands work unchanged against a persistence backup.
+	offlineClientConn struct {
+		methods map[string]offlineMethod
+	}
+
+	offlineMethod struct {
+		server  any
+		handler grpc.MethodHandler
+	}
+)
+
+var _ grpc.ClientConnInterface = (*offlineClientConn)(nil)
+
+// newOfflineBackend opens the persistence configured in the given server config file.
+func newOfflineBackend(
+	configFile string,
+	registry tasks.TaskCategoryRegistry,
+	logger log.Logger,
+) (*offlineBackend, error) {
+	cfg, err := config.Load(config.WithConfigFile(configFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load config file %s: %s", configFile, err)
+	}
+	if cfg.Persistence.NumHistoryShards <= 0 {
+		return nil, fmt.Errorf("config file %s does not set persistence.numHistoryShards", configFile)
+	}
+	if _, ok := cfg.Persistence.DataStores[cfg.Persistence.DefaultStore]; !ok {
+		return nil, fmt.Errorf("config file %s does not define the default datastore %q", configFile, cfg.Persistence.DefaultStore)
+	}
+
+	// Shard pools are defined in dynamic config, so it is needed to place workflows on the same shards as the
+	// cluster did.
+	dc := dynamicconfig.NewNoopCollection()
+	if cfg.DynamicConfigClient != nil {
+		dcClient, err := dynamicconfig.NewFileBasedClient(cfg.DynamicConfigClient, logger, make(chan any))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load dynamic config: %s", err)
+		}
+		dc = dynamicconfig.NewCollection(dcClient, logger)
+	}
+
+	var clusterName persistenceClient.ClusterName
+	if cfg.ClusterMetadata != nil {
+		clusterName = persistenceClient.ClusterName(cfg.ClusterMetadata.CurrentClusterName)
+	}
+	serializer := serialization.NewSerializer()
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		clusterName,
+		resolver.NewNoopResolver(),
+		&cfg.Persistence,
+		nil,
+		logger,
+		metrics.NoopMetricsHandler,
+		telemetry.NoopTracerProvider,
+		serializer,
+	)
+	factory := persistenceClient.FactoryProvider(persistenceClient.NewFactoryParams{
+		DataStoreFactory: dataStoreFactory,
+		Cfg:              &cfg.Persistence,
+		ClusterName:      clusterName,
+		Logger:           logger,
+		Serializer:       serializer,
+	})
+	backend := &offlineBackend{
+		registry:   registry,
+		serializer: serializer,
+	}
+	backend.shardResolver = shardpool.NewResolver(dc, offlineNamespaceRegistry{backend: backend}, cfg.Persistence.NumHistoryShards)
+	// The shard manager creates missing shards, so shards are read from the shard store instead.
+	if backend.shardStore, err = dataStoreFactory.NewShardStore(); err != nil {
+		factory.Close()
+		return nil, fmt.Errorf("unable to initialize shard store: %s", err)
+	}
+	if backend.executionManager, err = factory.NewExecutionManager(); err != nil {
+		factory.Close()
+		return nil, fmt.Errorf("unable to initialize execution manager: %s", err)
+	}
+	if backend.metadataManager, err = factory.NewMetadataManager(); err != nil {
+		factory.Close()
+		return nil, fmt.Errorf("unable to initialize metadata manager: %s", err)
+	}
+	return backend, nil
+}
+
+func (b *offlineBackend) getNamespace(
+	ctx context.Context,
+	request *persistence.GetNamespaceRequest,
+) (*persistence.GetNamespaceResponse, error) {
+	return b.metadataManager.GetNamespace(ctx, request)
+}
+
+func (r offlineNamespaceRegistry) GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error) {
+	resp, err := r.backend.metadataManager.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{ID: id.String()})
+	if err != nil {
+		return nil, err
+	}
+	return namespace.FromPersistentState(
+		resp.Namespace,
+		namespace.NewDefaultReplicationResolverFactory()(resp.Namespace),
+		namespace.WithGlobalFlag(resp.IsGlobalNamespace),
+	)
+}
+
+// getMutableState reads the mutable state of an execution. The current run is read if the run ID is empty.
+func (b *offlineBackend) getMutableState(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	archetypeID chasm.ArchetypeID,
+) (int32, *persistencespb.WorkflowMutableState, error) {
+	if execution.GetWorkflowId() == "" {
+		return 0, nil, serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
+	}
+	shardID, err := b.shardResolver.ShardID(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return 0, nil, err
+	}
+	runID := execution.GetRunId()
+	if runID == "" {
+		current, err := b.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: namespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+			ArchetypeID: archetypeID,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		runID = current.RunID
+	}
+	resp, err := b.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       runID,
+		ArchetypeID: archetypeID,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return shardID, resp.State, nil
+}
+
+// DescribeNamespace returns the namespace as stored in persistence. Settings which come from dynamic config, such
+// as capabilities and limits, are not returned.
+func (h *offlineWorkflowHandler) DescribeNamespace(
+	ctx context.Context,
+	request *workflowservice.DescribeNamespaceRequest,
+) (*workflowservice.DescribeNamespaceResponse, error) {
+	if request.GetNamespace() == "" && request.GetId() == "" {
+		return nil, serviceerror.NewInvalidArgument("Namespace not set on request.")
+	}
+	resp, err := h.backend.getNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+		ID:   request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	info := resp.Namespace.GetInfo()
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{
+			Name:        info.GetName(),
+			State:       info.GetState(),
+			Description: info.GetDescription(),
+			OwnerEmail:  info.GetOwner(),
+			Data:        info.GetData(),
+			Id:          info.GetId(),
+		},
+		IsGlobalNamespace: resp.IsGlobalNamespace,
+	}, nil
+}
+
+// DescribeMutableState returns the mutable state of an execution as stored in persistence.
+func (h *offlineAdminHandler) DescribeMutableState(
+	ctx context.Context,
+	request *adminservice.DescribeMutableStateRequest,
+) (*adminservice.DescribeMutableStateResponse, error) {
+	ns, err := h.backend.getNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	archetypeID := chasm.ArchetypeID(request.GetArchetypeId())
+	if archetypeID == chasm.UnspecifiedArchetypeID {
+		// The archetypes registered in the server are not known offline, but their IDs are derived from their
+		// names.
+		archetypeID = chasm.WorkflowArchetypeID
+		if request.GetArchetype() != "" {
+			archetypeID = chasm.GenerateTypeID(request.GetArchetype())
+		}
+	}
+	shardID, mutableState, err := h.backend.getMutableState(ctx, ns.Namespace.GetInfo().GetId(), request.GetExecution(), archetypeID)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeMutableStateResponse{
+		ShardId:              strconv.Itoa(int(shardID)),
+		DatabaseMutableState: mutableState,
+	}, nil
+}
+
+// GetWorkflowExecutionRawHistoryV2 returns the raw history of an execution, with the same pagination and range
+// semantics as the history service.
+func (h *offlineAdminHandler) GetWorkflowExecutionRawHistoryV2(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	if err := api.ValidateNamespaceUUID(namespace.ID(request.GetNamespaceId())); err != nil {
+		return nil, err
+	}
+	historyRequest := &historyservice.GetWorkflowExecutionRawHistoryV2Request{
+		NamespaceId: request.GetNamespaceId(),
+		Request:     request,
+	}
+	execution := request.GetExecution()
+
+	var pageToken *tokenspb.RawHistoryContinuation
+	var targetVersionHistory *historyspb.VersionHistory
+	if request.NextPageToken == nil {
+		_, mutableState, err := h.backend.getMutableState(ctx, request.GetNamespaceId(), execution, chasm.WorkflowArchetypeID)
+		if err != nil {
+			return nil, err
+		}
+		versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+		targetVersionHistory, err = getworkflowexecutionrawhistoryv2.SetRequestDefaultValueAndGetTargetVersionHistory(
+			historyRequest,
+			versionHistories,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pageToken = api.GeneratePaginationTokenV2Request(historyRequest, versionHistories)
+	} else {
+		var err error
+		pageToken, err = api.DeserializeRawHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		versionHistories := pageToken.GetVersionHistories()
+		if versionHistories == nil {
+			return nil, consts.ErrInvalidVersionHistories
+		}
+		targetVersionHistory, err = getworkflowexecutionrawhistoryv2.SetRequestDefaultValueAndGetTargetVersionHistory(
+			historyRequest,
+			versionHistories,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := api.ValidatePaginationTokenV2Request(historyRequest, pageToken); err != nil {
+		return nil, err
+	}
+
+	if pageToken.GetStartEventId()+1 == pageToken.GetEndEventId() {
+		// API is exclusive-exclusive. Return empty response here.
+		return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+			HistoryBatches: []*commonpb.DataBlob{},
+			VersionHistory: targetVersionHistory,
+		}, nil
+	}
+	shardID, err := h.backend.shardResolver.ShardID(request.GetNamespaceId(), execution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	rawHistoryResponse, err := h.backend.executionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: targetVersionHistory.GetBranchToken(),
+		// GetWorkflowExecutionRawHistoryV2 is exclusive exclusive.
+		// ReadRawHistoryBranch is inclusive exclusive.
+		MinEventID:    pageToken.GetStartEventId() + 1,
+		MaxEventID:    pageToken.GetEndEventId(),
+		PageSize:      int(request.GetMaximumPageSize()),
+		NextPageToken: pageToken.PersistenceToken,
+		ShardID:       shardID,
+	})
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			// when no events can be returned from DB, DB layer will return
+			// EntityNotExistsError, this API shall return empty response
+			return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+				HistoryBatches: []*commonpb.DataBlob{},
+				VersionHistory: targetVersionHistory,
+			}, nil
+		}
+		return nil, err
+	}
+
+	historyBlobs, err := serialization.ReencodeEventBlobsAsProto3(h.backend.serializer, rawHistoryResponse.HistoryEventBlobs)
+	if err != nil {
+		return nil, err
+	}
+	result := &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: historyBlobs,
+		VersionHistory: targetVersionHistory,
+		HistoryNodeIds: rawHistoryResponse.NodeIDs,
+	}
+	pageToken.PersistenceToken = rawHistoryResponse.NextPageToken
+	if len(pageToken.PersistenceToken) != 0 {
+		if result.NextPageToken, err = api.SerializeRawHistoryToken(pageToken); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetShard returns the shard info as stored in persistence. Unlike the history service, it never creates the
+// shard.
+func (h *offlineAdminHandler) GetShard(
+	ctx context.Context,
+	request *adminservice.GetShardRequest,
+) (*adminservice.GetShardResponse, error) {
+	resp, err := h.backend.shardStore.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+		ShardID: request.GetShardId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	shardInfo, err := h.backend.serializer.ShardInfoFromBlob(resp.ShardInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetShardResponse{ShardInfo: shardInfo}, nil
+}
+
+// ListHistoryTasks lists the history tasks of a shard as stored in persistence.
+func (h *offlineAdminHandler) ListHistoryTasks(
+	ctx context.Context,
+	request *adminservice.ListHistoryTasksRequest,
+) (*adminservice.ListHistoryTasksResponse, error) {
+	resp, err := listtasks.Invoke(ctx, h.backend.registry, h.backend.executionManager, &historyservice.ListTasksRequest{
+		Request: request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+func newOfflineClientConn(backend *offlineBackend) *offlineClientConn {
+	conn := &offlineClientConn{methods: map[string]offlineMethod{}}
+	conn.register(&adminservice.AdminService_ServiceDesc, &offlineAdminHandler{backend: backend})
+	conn.register(&workflowservice.WorkflowService_ServiceDesc, &offlineWorkflowHandler{backend: backend})
+	return conn
+}
+
+func (c *offlineClientConn) register(desc *grpc.ServiceDesc, server any) {
+	for _, method := range desc.Methods {
+		c.methods["/"+desc.ServiceName+"/"+method.MethodName] = offlineMethod{
+			server:  server,
+			handler: method.Handler,
+		}
+	}
+}
+
+// Invoke calls the offline handler of the method.
+func (c *offlineClientConn) Invoke(
+	ctx context.Context,
+	method string,
+	args any,
+	reply any,
+	_ ...grpc.CallOption,
+) error {
+	m, ok := c.methods[method]
+	if !ok {
+		return errOfflineUnsupported(method)
+	}
+	resp, err := m.handler(m.server, ctx, func(req any) error {
+		proto.Merge(req.(proto.Message), args.(proto.Message))
+		return nil
+	}, nil)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return errOfflineUnsupported(method)
+		}
+		return err
+	}
+	proto.Merge(reply.(proto.Message), resp.(proto.Message))
+	return nil
+}
+
+// NewStream is not supported, as none of the APIs available offline are streaming.
+func (c *offlineClientConn) NewStream(
+	_ context.Context,
+	_ *grpc.StreamDesc,
+	method string,
+	_ ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return nil, errOfflineUnsupported(method)
+}
+
+func errOfflineUnsupported(method string) error {
+	return status.Errorf(codes.Unimplemented, "%s is not supported in offline mode", method[strings.LastIndex(method, "/")+1:])
+}
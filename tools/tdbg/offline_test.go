@@ -0,0 +1,276 @@
+package tdbg_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite" // needed to load sqlite plugin
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	offlineNumHistoryShards = 4
+	offlineNamespace        = "offline-ns"
+	offlineWorkflowID       = "offline-wf"
+	offlineTaskID           = 1001
+)
+
+type offlineBackup struct {
+	configFile  string
+	namespaceID string
+	runID       string
+	shardID     int32
+}
+
+// newOfflineBackup creates a SQLite database with a namespace and a running workflow, and a config file which opens
+// it read-only.
+func newOfflineBackup(t *testing.T) offlineBackup {
+	t.Helper()
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbFile := filepath.Join(dir, "temporal.db")
+
+	serializer := serialization.NewSerializer()
+	cfg := &config.Persistence{
+		DefaultStore:         "sqlite",
+		NumHistoryShards:     offlineNumHistoryShards,
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(primitives.DefaultTransactionSizeLimit),
+		DataStores: map[string]config.DataStore{
+			"sqlite": {SQL: &config.SQL{
+				PluginName:        "sqlite",
+				DatabaseName:      dbFile,
+				ConnectAttributes: map[string]string{"setup": "true"},
+			}},
+		},
+	}
+	logger := log.NewNoopLogger()
+	factory := persistenceClient.FactoryProvider(persistenceClient.NewFactoryParams{
+		DataStoreFactory: persistenceClient.DataStoreFactoryProvider(
+			"active",
+			resolver.NewNoopResolver(),
+			cfg,
+			nil,
+			logger,
+			metrics.NoopMetricsHandler,
+			telemetry.NoopTracerProvider,
+			serializer,
+		),
+		Cfg:         cfg,
+		ClusterName: "active",
+		Logger:      logger,
+		Serializer:  serializer,
+	})
+	defer factory.Close()
+
+	backup := offlineBackup{
+		configFile:  filepath.Join(dir, "config.yaml"),
+		namespaceID: uuid.NewString(),
+		runID:       uuid.NewString(),
+	}
+	backup.shardID = common.WorkflowIDToHistoryShard(backup.namespaceID, offlineWorkflowID, offlineNumHistoryShards)
+
+	metadataManager, err := factory.NewMetadataManager()
+	require.NoError(t, err)
+	defer metadataManager.Close()
+	_, err = metadataManager.CreateNamespace(ctx, &persistence.CreateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    backup.namespaceID,
+				Name:  offlineNamespace,
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &persistencespb.NamespaceConfig{
+				Retention: durationpb.New(24 * time.Hour),
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "active",
+				Clusters:          []string{"active"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	shardManager, err := factory.NewShardManager()
+	require.NoError(t, err)
+	defer shardManager.Close()
+	_, err = shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID:          backup.shardID,
+		InitialShardInfo: &persistencespb.ShardInfo{RangeId: 1},
+	})
+	require.NoError(t, err)
+
+	executionManager, err := factory.NewExecutionManager()
+	require.NoError(t, err)
+	defer executionManager.Close()
+	branchToken, err := persistence.NewHistoryBranchUtil(serializer).NewHistoryBranch(
+		backup.namespaceID,
+		offlineWorkflowID,
+		backup.runID,
+		uuid.NewString(),
+		nil,
+		nil,
+		0,
+		0,
+		0,
+	)
+	require.NoError(t, err)
+	snapshot := &persistence.WorkflowSnapshot{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    backup.namespaceID,
+			WorkflowId:     offlineWorkflowID,
+			ExecutionStats: &persistencespb.ExecutionStats{},
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+				branchToken,
+				[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(common.FirstEventID, 0)},
+			)),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			CreateRequestId: uuid.NewString(),
+			RunId:           backup.runID,
+			State:           enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status:          enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventID:     common.FirstEventID + 1,
+		Tasks:           map[tasks.Category][]tasks.Task{},
+		DBRecordVersion: 1,
+	}
+	events := []*persistence.WorkflowEvents{{
+		NamespaceID: backup.namespaceID,
+		WorkflowID:  offlineWorkflowID,
+		RunID:       backup.runID,
+		BranchToken: branchToken,
+		Events: []*historypb.HistoryEvent{{
+			EventId:   common.FirstEventID,
+			EventTime: timestamppb.Now(),
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &commonpb.WorkflowType{Name: "offline-type"},
+					TaskQueue:    &taskqueuepb.TaskQueue{Name: "offline-tq"},
+				},
+			},
+		}},
+	}}
+	snapshot.Tasks[tasks.CategoryTransfer] = []tasks.Task{&tasks.WorkflowTask{
+		WorkflowKey:         definition.NewWorkflowKey(backup.namespaceID, offlineWorkflowID, backup.runID),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              offlineTaskID,
+		TaskQueue:           "offline-tq",
+		ScheduledEventID:    2,
+	}}
+	_, err = executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID:             backup.shardID,
+		RangeID:             1,
+		Mode:                persistence.CreateWorkflowModeBrandNew,
+		ArchetypeID:         chasm.WorkflowArchetypeID,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(backup.configFile, fmt.Appendf(nil, `persistence:
+  defaultStore: sqlite
+  numHistoryShards: %d
+  datastores:
+    sqlite:
+      sql:
+        pluginName: sqlite
+        databaseName: %s
+        connectAddr: localhost
+        connectProtocol: tcp
+        connectAttributes:
+          mode: ro
+`, offlineNumHistoryShards, dbFile), 0o600))
+	return backup
+}
+
+func runOffline(t *testing.T, backup offlineBackup, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+		params.Writer = &stdout
+		params.ErrWriter = &stderr
+	})
+	runArgs := append([]string{"tdbg", "--offline-config", backup.configFile, "-n", offlineNamespace}, args...)
+	err := app.Run(runArgs)
+	return stdout.String(), err
+}
+
+func TestOffline_DescribeExecution(t *testing.T) {
+	backup := newOfflineBackup(t)
+
+	stdout, err := runOffline(t, backup, "workflow", "describe", "--workflow-id", offlineWorkflowID)
+	require.NoError(t, err)
+	require.Contains(t, stdout, fmt.Sprintf("Shard Id: %d", backup.shardID))
+	require.Contains(t, stdout, backup.runID)
+}
+
+func TestOffline_ShowHistory(t *testing.T) {
+	backup := newOfflineBackup(t)
+
+	stdout, err := runOffline(t, backup, "workflow", "show", "--workflow-id", offlineWorkflowID, "--run-id", backup.runID)
+	require.NoError(t, err)
+	require.Contains(t, stdout, "======== total batches 1,")
+	require.Contains(t, stdout, "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED")
+	require.Contains(t, stdout, "offline-type")
+}
+
+func TestOffline_Shard(t *testing.T) {
+	backup := newOfflineBackup(t)
+	shardID := fmt.Sprint(backup.shardID)
+
+	stdout, err := runOffline(t, backup, "shard", "describe", "--shard-id", shardID)
+	require.NoError(t, err)
+	require.Regexp(t, fmt.Sprintf(`"shardId":\s+%d,`, backup.shardID), stdout)
+	require.Regexp(t, `"rangeId":\s+"1"`, stdout)
+
+	stdout, err = runOffline(t, backup, "shard", "list-tasks", "--shard-id", shardID,
+		"--task-category", "transfer", "--max-task-id", "2000")
+	require.NoError(t, err)
+	require.Contains(t, stdout, offlineWorkflowID)
+	require.Contains(t, stdout, fmt.Sprint(offlineTaskID))
+
+	// Shards are read, never created.
+	missingShardID := fmt.Sprint(backup.shardID%offlineNumHistoryShards + 1)
+	_, err = runOffline(t, backup, "shard", "describe", "--shard-id", missingShardID)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestOffline_UnsupportedCommand(t *testing.T) {
+	backup := newOfflineBackup(t)
+
+	_, err := runOffline(t, backup, "shard", "close-shard", "--shard-id", "1")
+	require.ErrorContains(t, err, "CloseShard is not supported in offline mode")
+}
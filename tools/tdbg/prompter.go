@@ -2,6 +2,7 @@ package tdbg
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -34,6 +35,9 @@ type (
 	PrompterFactory func(c BoolFlagLookup) *Prompter
 )
 
+// ErrPromptDeclined is returned by [Prompter.Prompt] when the user does not confirm and the exiter returns.
+var ErrPromptDeclined = errors.New("not confirmed")
+
 func NewPrompterFactory(opts ...PrompterOption) PrompterFactory {
 	return func(c BoolFlagLookup) *Prompter {
 		return NewPrompter(c, opts...)
@@ -59,10 +63,11 @@ func NewPrompter(c BoolFlagLookup, opts ...PrompterOption) *Prompter {
 }
 
 // Prompt the user for confirmation. If the user does not respond with "y" or "yes" (case-insensitive and without
-// leading or trailing space), the process will exit with code 1.
-func (p *Prompter) Prompt(msg string) {
+// leading or trailing space), the exiter is called with code 1, which exits the process by default. If the exiter
+// returns, e.g. in the tdbg shell, ErrPromptDeclined is returned and the command must not proceed.
+func (p *Prompter) Prompt(msg string) error {
 	if p.flagLookup.Bool(FlagYes) {
-		return
+		return nil
 	}
 	_, err := p.writer.Write([]byte(msg + " [y/N]: "))
 	if err != nil {
@@ -76,5 +81,7 @@ func (p *Prompter) Prompt(msg string) {
 	textLower := strings.ToLower(strings.TrimSpace(text))
 	if textLower != "y" && textLower != "yes" {
 		p.exiter(1)
+		return ErrPromptDeclined
 	}
+	return nil
 }
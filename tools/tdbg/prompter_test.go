@@ -43,6 +43,7 @@ func TestPrompter(t *testing.T) {
 		writerErr         error
 		readerErr         error
 		expectedPanic     string
+		expectedErr       error
 		expectedExitCodes []int
 		expectedPrompt    string
 	}{
@@ -66,6 +67,7 @@ func TestPrompter(t *testing.T) {
 			name:              "no",
 			autoConfirm:       false,
 			response:          "n\n",
+			expectedErr:       tdbg.ErrPromptDeclined,
 			expectedExitCodes: []int{1},
 			expectedPrompt:    "test prompt [y/N]: ",
 		},
@@ -104,10 +106,11 @@ func TestPrompter(t *testing.T) {
 			// Conduct the test based on the expected outcome: Panic or standard output.
 			if tc.expectedPanic != "" {
 				require.PanicsWithError(t, tc.expectedPanic, func() {
-					prompter.Prompt("test prompt")
+					_ = prompter.Prompt("test prompt")
 				})
 			} else {
-				prompter.Prompt("test prompt")
+				err := prompter.Prompt("test prompt")
+				assert.ErrorIs(t, err, tc.expectedErr)
 				assert.Equal(t, tc.expectedPrompt, writer.buffer.String())
 				assert.Equal(t, tc.expectedExitCodes, exitCodes)
 			}
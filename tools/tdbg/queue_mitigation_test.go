@@ -0,0 +1,53 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"google.golang.org/grpc"
+)
+
+type queueMitigationAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	requests []*adminservice.ListQueueMitigationsRequest
+}
+
+func (c *queueMitigationAdminClient) ListQueueMitigations(
+	_ context.Context,
+	req *adminservice.ListQueueMitigationsRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.ListQueueMitigationsResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.ListQueueMitigationsResponse{
+		Mitigations: []*historyspb.QueueMitigation{
+			{ShardId: req.GetShardId(), AlertType: "ReaderStuck", Action: "dlq-stuck-tasks", ActionTaken: true},
+			{ShardId: req.GetShardId(), AlertType: "ReaderStuck", Action: "page", ActionTaken: true},
+		},
+	}, nil
+}
+
+func TestListQueueMitigations(t *testing.T) {
+	admin := &queueMitigationAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory, "shard", "list-mitigations", "--shard-id", "3")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	require.Equal(t, int32(3), admin.requests[0].GetShardId())
+	require.Contains(t, stdout, "dlq-stuck-tasks")
+	require.Contains(t, stdout, "ReaderStuck")
+
+	admin.err = errors.New("boom")
+	_, _, err = runMigrate(t, factory, "shard", "list-mitigations", "--shard-id", "3")
+	require.ErrorContains(t, err, "unable to list queue mitigations: boom")
+}
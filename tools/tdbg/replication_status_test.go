@@ -0,0 +1,59 @@
+package tdbg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type replicationLagAdminClient struct {
+	adminservice.AdminServiceClient
+	unsafeReasons []string
+
+	requests []*adminservice.GetReplicationLagRequest
+}
+
+func (c *replicationLagAdminClient) GetReplicationLag(
+	_ context.Context,
+	req *adminservice.GetReplicationLagRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	c.requests = append(c.requests, req)
+	return &adminservice.GetReplicationLagResponse{
+		Clusters: []*adminservice.ClusterReplicationLag{
+			{ClusterName: "standby", TaskIdLag: 42},
+		},
+		SafeToFailover: len(c.unsafeReasons) == 0,
+		UnsafeReasons:  c.unsafeReasons,
+	}, nil
+}
+
+func TestReplicationStatus(t *testing.T) {
+	admin := &replicationLagAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory, "replication", "status",
+		"--cluster", "standby",
+		"--namespace", "ns-1",
+		"--namespace", "ns-2",
+		"--max-lag", "30s",
+		"--max-scanned-tasks", "100",
+	)
+	require.NoError(t, err)
+	require.Len(t, admin.requests, 1)
+	require.Equal(t, []string{"standby"}, admin.requests[0].GetRemoteClusters())
+	require.Equal(t, []string{"ns-1", "ns-2"}, admin.requests[0].GetNamespaces())
+	require.Equal(t, 30*time.Second, admin.requests[0].GetMaxLag().AsDuration())
+	require.Equal(t, int32(100), admin.requests[0].GetMaxScannedTasks())
+	require.Contains(t, stdout, "standby")
+	require.Contains(t, stdout, "safeToFailover")
+
+	admin.unsafeReasons = []string{"cluster standby: 7 replication tasks in the DLQ"}
+	stdout, _, err = runMigrate(t, factory, "replication", "status")
+	require.ErrorContains(t, err, "not safe to fail over: cluster standby: 7 replication tasks in the DLQ")
+	require.Contains(t, stdout, "unsafeReasons")
+}
@@ -0,0 +1,76 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type shardPlacementAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	moveRequests  []*adminservice.MoveHistoryShardRequest
+	unpinRequests []*adminservice.UnpinHistoryShardRequest
+}
+
+func (c *shardPlacementAdminClient) MoveHistoryShard(
+	_ context.Context,
+	req *adminservice.MoveHistoryShardRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.MoveHistoryShardResponse, error) {
+	c.moveRequests = append(c.moveRequests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.MoveHistoryShardResponse{}, nil
+}
+
+func (c *shardPlacementAdminClient) UnpinHistoryShard(
+	_ context.Context,
+	req *adminservice.UnpinHistoryShardRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.UnpinHistoryShardResponse, error) {
+	c.unpinRequests = append(c.unpinRequests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.UnpinHistoryShardResponse{}, nil
+}
+
+func TestShardMove(t *testing.T) {
+	admin := &shardPlacementAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory, "shard", "move", "--shard-id", "3", "--target-host", "10.0.0.2:7234")
+	require.NoError(t, err)
+
+	require.Len(t, admin.moveRequests, 1)
+	require.Equal(t, int32(3), admin.moveRequests[0].GetShardId())
+	require.Equal(t, "10.0.0.2:7234", admin.moveRequests[0].GetTargetHost())
+	require.Contains(t, stdout, "Shard 3 pinned to 10.0.0.2:7234.")
+}
+
+func TestShardMove_Error(t *testing.T) {
+	admin := &shardPlacementAdminClient{err: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory, "shard", "move", "--shard-id", "3", "--target-host", "10.0.0.2:7234")
+	require.ErrorContains(t, err, "unable to move shard: boom")
+}
+
+func TestShardUnpin(t *testing.T) {
+	admin := &shardPlacementAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory, "shard", "unpin", "--shard-id", "3")
+	require.NoError(t, err)
+
+	require.Len(t, admin.unpinRequests, 1)
+	require.Equal(t, int32(3), admin.unpinRequests[0].GetShardId())
+	require.Contains(t, stdout, "Shard 3 unpinned.")
+}
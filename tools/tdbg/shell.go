@@ -0,0 +1,553 @@
+package tdbg
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
+)
+
+const (
+	shellCommandName       = "shell"
+	defaultShellHistory    = ".tdbg_history"
+	maxShellHistoryEntries = 1000
+)
+
+// shellContextFlags are the flags whose value is kept in the shell session, and added to every command accepting them
+// when they are not explicitly specified. Namespace is a global flag and is handled separately.
+var shellContextFlags = []string{FlagWorkflowID, FlagRunID, FlagShardID}
+
+var shellBuiltinsUsage = `Shell commands:
+  set <namespace|workflow-id|run-id|shard-id> <value>  set the session context
+  unset <namespace|workflow-id|run-id|shard-id>        clear the session context
+  context                                             show the session context
+  exit, quit                                          exit the shell
+Any other input is run as a tdbg command, e.g. "workflow describe". The session context is added to the command
+flags which are not specified. The output of a command can be written to a file with "> file" or ">> file".
+`
+
+type (
+	tdbgShell struct {
+		app *cli.App
+		// globalArgs are the global flags tdbg was started with, passed to every command.
+		globalArgs []string
+		writer     io.Writer
+		errWriter  io.Writer
+		history    *os.File
+
+		namespace string
+		context   map[string]string
+	}
+
+	shellLineReader interface {
+		ReadLine(prompt string) (string, error)
+	}
+
+	scannerLineReader struct {
+		scanner *bufio.Scanner
+	}
+
+	terminalLineReader struct {
+		fd       int
+		terminal *term.Terminal
+	}
+)
+
+func newShellCommand() *cli.Command {
+	return &cli.Command{
+		Name:  shellCommandName,
+		Usage: "Start an interactive shell running tdbg commands within a session context (namespace, workflow, shard)",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        FlagHistoryFile,
+				Usage:       "File the shell history is persisted to, history is not persisted if empty",
+				DefaultText: "$HOME/" + defaultShellHistory,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return RunShell(c)
+		},
+	}
+}
+
+// RunShell reads tdbg commands from the application reader and runs them until the input is exhausted or the shell is
+// exited. Line editing, tab completion and history are available if the reader is a terminal.
+func RunShell(c *cli.Context) error {
+	s := &tdbgShell{
+		app:        c.App,
+		globalArgs: shellGlobalArgs(c),
+		writer:     c.App.Writer,
+		errWriter:  c.App.ErrWriter,
+		namespace:  c.String(FlagNamespace),
+		context:    make(map[string]string),
+	}
+
+	var reader shellLineReader
+	if f, ok := c.App.Reader.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		terminal := term.NewTerminal(struct {
+			io.Reader
+			io.Writer
+		}{f, c.App.Writer}, "")
+		terminal.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+			return s.autoComplete(terminal, line, pos, key)
+		}
+		if err := s.openHistory(c, terminal); err != nil {
+			return err
+		}
+		defer func() { _ = s.history.Close() }()
+		reader = &terminalLineReader{fd: int(f.Fd()), terminal: terminal}
+		_, _ = fmt.Fprintln(s.writer, `tdbg shell, type "help" for the list of commands.`)
+	} else {
+		reader = &scannerLineReader{scanner: bufio.NewScanner(c.App.Reader)}
+	}
+
+	for {
+		line, err := reader.ReadLine(s.prompt())
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		s.recordHistory(line)
+		exit, err := s.execute(c, line)
+		if err != nil {
+			_, _ = fmt.Fprintf(s.errWriter, "%s %+v\n", color.RedString("Error:"), err)
+		}
+		if exit {
+			return nil
+		}
+	}
+}
+
+// execute runs a line of input, and returns whether the shell should exit.
+func (s *tdbgShell) execute(c *cli.Context, line string) (bool, error) {
+	args, err := splitShellLine(line)
+	if err != nil {
+		return false, err
+	}
+	if len(args) == 0 {
+		return false, nil
+	}
+	args, outputFile, appendOutput, err := parseShellRedirect(args)
+	if err != nil {
+		return false, err
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		if len(args) == 1 {
+			_, _ = fmt.Fprint(s.writer, shellBuiltinsUsage)
+		}
+	case "context":
+		s.printContext()
+		return false, nil
+	case "set":
+		if len(args) != 3 {
+			return false, errors.New("usage: set <namespace|workflow-id|run-id|shard-id> <value>")
+		}
+		return false, s.setContext(args[1], args[2])
+	case "unset":
+		if len(args) != 2 {
+			return false, errors.New("usage: unset <namespace|workflow-id|run-id|shard-id>")
+		}
+		return false, s.setContext(args[1], "")
+	}
+
+	// The namespace is a global flag, which must precede the command.
+	namespace := s.namespace
+	args, namespace = extractShellFlag(args, append([]string{FlagNamespace}, FlagNamespaceAlias...), namespace)
+	command, depth := findShellCommand(s.app.Commands, args)
+	if command != nil && command.Name == shellCommandName {
+		return false, errors.New("already in a shell")
+	}
+
+	writer := s.writer
+	if outputFile != "" {
+		flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		if appendOutput {
+			flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		}
+		f, err := os.OpenFile(outputFile, flags, 0644)
+		if err != nil {
+			return false, fmt.Errorf("unable to open output file: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		writer = f
+	}
+	return false, s.runCommand(c, s.commandArgs(namespace, command, depth, args), writer)
+}
+
+// runCommand runs the tdbg command with the given writer. Errors, and prompts which are not confirmed, are returned
+// instead of exiting the shell.
+func (s *tdbgShell) runCommand(c *cli.Context, args []string, writer io.Writer) error {
+	exitErrHandler := s.app.ExitErrHandler
+	osExiter := cli.OsExiter
+	s.app.Writer = writer
+	s.app.ExitErrHandler = func(*cli.Context, error) {}
+	cli.OsExiter = func(int) {}
+	defer func() {
+		s.app.Writer = s.writer
+		s.app.ExitErrHandler = exitErrHandler
+		cli.OsExiter = osExiter
+	}()
+	return s.app.RunContext(c.Context, args)
+}
+
+// commandArgs returns the arguments to run the command with, including the global flags and the session context. The
+// command is named by the first depth arguments, the session context flags are added right after them.
+func (s *tdbgShell) commandArgs(namespace string, command *cli.Command, depth int, args []string) []string {
+	result := append([]string{s.app.Name}, s.globalArgs...)
+	if namespace != "" {
+		result = append(result, "--"+FlagNamespace, namespace)
+	}
+	result = append(result, args[:depth]...)
+	if command == nil {
+		return append(result, args[depth:]...)
+	}
+	for _, flag := range command.Flags {
+		names := flag.Names()
+		if hasShellFlag(args, names) {
+			continue
+		}
+		for _, contextFlag := range shellContextFlags {
+			if value := s.context[contextFlag]; value != "" && slices.Contains(names, contextFlag) {
+				result = append(result, "--"+names[0], value)
+				break
+			}
+		}
+	}
+	return append(result, args[depth:]...)
+}
+
+func (s *tdbgShell) setContext(key string, value string) error {
+	switch key {
+	case FlagNamespace:
+		s.namespace = value
+	case FlagWorkflowID:
+		// The run of the previous workflow doesn't belong to the new one.
+		delete(s.context, FlagRunID)
+		fallthrough
+	case FlagRunID, FlagShardID:
+		if value == "" {
+			delete(s.context, key)
+		} else {
+			s.context[key] = value
+		}
+	default:
+		return fmt.Errorf("unknown session context %q, expected one of: %s, %s", key, FlagNamespace, strings.Join(shellContextFlags, ", "))
+	}
+	return nil
+}
+
+func (s *tdbgShell) printContext() {
+	_, _ = fmt.Fprintf(s.writer, "%s: %s\n", FlagNamespace, s.namespace)
+	for _, key := range shellContextFlags {
+		if value, ok := s.context[key]; ok {
+			_, _ = fmt.Fprintf(s.writer, "%s: %s\n", key, value)
+		}
+	}
+}
+
+func (s *tdbgShell) prompt() string {
+	parts := []string{s.namespace}
+	if workflowID, ok := s.context[FlagWorkflowID]; ok {
+		parts = append(parts, workflowID)
+	}
+	if runID, ok := s.context[FlagRunID]; ok {
+		parts = append(parts, runID)
+	}
+	if shardID, ok := s.context[FlagShardID]; ok {
+		parts = append(parts, "shard "+shardID)
+	}
+	return fmt.Sprintf("tdbg [%s]> ", strings.Join(parts, " | "))
+}
+
+func (s *tdbgShell) openHistory(c *cli.Context, terminal *term.Terminal) error {
+	path := c.String(FlagHistoryFile)
+	if !c.IsSet(FlagHistoryFile) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		path = filepath.Join(home, defaultShellHistory)
+	}
+	if path == "" {
+		return nil
+	}
+
+	if content, err := os.ReadFile(path); err == nil {
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		if len(lines) > maxShellHistoryEntries {
+			lines = lines[len(lines)-maxShellHistoryEntries:]
+		}
+		for _, line := range lines {
+			if line != "" {
+				terminal.History.Add(line)
+			}
+		}
+	}
+	history, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open shell history file: %w", err)
+	}
+	s.history = history
+	return nil
+}
+
+func (s *tdbgShell) recordHistory(line string) {
+	if s.history != nil {
+		_, _ = fmt.Fprintln(s.history, line)
+	}
+}
+
+func (s *tdbgShell) autoComplete(terminal *term.Terminal, line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	prefix := line[:pos]
+	candidates := completeShellLine(s.app.Commands, prefix)
+	if len(candidates) == 0 {
+		return "", 0, false
+	}
+
+	word := prefix[strings.LastIndexAny(prefix, " \t")+1:]
+	completion := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, completion) {
+			completion = completion[:len(completion)-1]
+		}
+	}
+	if len(candidates) == 1 {
+		completion += " "
+	} else if completion == word {
+		_, _ = fmt.Fprintln(terminal, strings.Join(candidates, "  "))
+		return "", 0, false
+	}
+	newPrefix := prefix[:len(prefix)-len(word)] + completion
+	return newPrefix + line[pos:], len(newPrefix), true
+}
+
+// completeShellLine returns the command names, or the flag names if the last word starts with "-", which complete the
+// last word of the line.
+func completeShellLine(commands []*cli.Command, line string) []string {
+	words := strings.Fields(line)
+	word := ""
+	if len(words) > 0 && !strings.HasSuffix(line, " ") && !strings.HasSuffix(line, "\t") {
+		word = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	var names []string
+	if len(words) == 0 {
+		names = []string{"context", "exit", "help", "quit", "set", "unset"}
+	}
+	command, _ := findShellCommand(commands, words)
+	if command != nil {
+		commands = command.Subcommands
+	} else if len(words) > 0 {
+		commands = nil
+	}
+
+	if strings.HasPrefix(word, "-") {
+		if command == nil {
+			return nil
+		}
+		names = nil
+		for _, flag := range command.Flags {
+			names = append(names, "--"+flag.Names()[0])
+		}
+	} else {
+		for _, subcommand := range commands {
+			if !subcommand.Hidden {
+				names = append(names, subcommand.Name)
+			}
+		}
+	}
+
+	var candidates []string
+	for _, name := range names {
+		if strings.HasPrefix(name, word) {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+// findShellCommand returns the most nested command named by the leading arguments along with the number of arguments
+// naming it, or nil if the first argument is not a command.
+func findShellCommand(commands []*cli.Command, args []string) (*cli.Command, int) {
+	var command *cli.Command
+	depth := 0
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		var next *cli.Command
+		for _, candidate := range commands {
+			if slices.Contains(candidate.Names(), arg) {
+				next = candidate
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		command = next
+		commands = next.Subcommands
+		depth++
+	}
+	return command, depth
+}
+
+// shellGlobalArgs returns the global flags explicitly specified when starting the shell, except the namespace which
+// is part of the session context.
+func shellGlobalArgs(c *cli.Context) []string {
+	var args []string
+	for _, flag := range c.App.Flags {
+		name := flag.Names()[0]
+		if name == FlagNamespace || !c.IsSet(name) {
+			continue
+		}
+		args = append(args, fmt.Sprintf("--%s=%v", name, c.Value(name)))
+	}
+	return args
+}
+
+func hasShellFlag(args []string, names []string) bool {
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if slices.Contains(names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractShellFlag removes the flag with any of the given names from the arguments, and returns its value or the default
+// value if the flag is not specified.
+func extractShellFlag(args []string, names []string, defaultValue string) ([]string, string) {
+	value := defaultValue
+	result := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			result = append(result, arg)
+			continue
+		}
+		name, flagValue, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !slices.Contains(names, name) {
+			result = append(result, arg)
+			continue
+		}
+		if !hasValue && i+1 < len(args) {
+			i++
+			flagValue = args[i]
+		}
+		value = flagValue
+	}
+	return result, value
+}
+
+// parseShellRedirect removes the trailing "> file" or ">> file" redirection from the arguments.
+func parseShellRedirect(args []string) (_ []string, outputFile string, appendOutput bool, _ error) {
+	for i, arg := range args {
+		if arg != ">" && arg != ">>" {
+			continue
+		}
+		if i != len(args)-2 {
+			return nil, "", false, fmt.Errorf("expected a single file name after %q", arg)
+		}
+		if i == 0 {
+			return nil, "", false, errors.New("missing command before output redirection")
+		}
+		return args[:i], args[i+1], arg == ">>", nil
+	}
+	return args, "", false, nil
+}
+
+// splitShellLine splits the line into arguments separated by spaces, honoring single and double quotes and backslash
+// escapes.
+func splitShellLine(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func (r *scannerLineReader) ReadLine(string) (string, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return r.scanner.Text(), nil
+}
+
+// ReadLine reads a line in raw mode, the terminal is restored while the command runs so that commands can prompt for
+// confirmation.
+func (r *terminalLineReader) ReadLine(prompt string) (string, error) {
+	state, err := term.MakeRaw(r.fd)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = term.Restore(r.fd, state) }()
+	r.terminal.SetPrompt(prompt)
+	return r.terminal.ReadLine()
+}
@@ -0,0 +1,138 @@
+package tdbg_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+)
+
+func runShell(t *testing.T, factory tdbg.ClientFactory, input string, args ...string) (stdoutStr, stderrStr string, err error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+		params.ClientFactory = factory
+		params.Writer = &stdout
+		params.ErrWriter = &stderr
+	})
+	app.Reader = strings.NewReader(input)
+	runArgs := append(append([]string{"tdbg"}, args...), "shell")
+	err = app.Run(runArgs)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestShell_SessionContext(t *testing.T) {
+	admin := &conflictsAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, stderr, err := runShell(t, factory, strings.Join([]string{
+		"set workflow-id wf",
+		"set run-id run",
+		"workflow conflicts",
+		// Explicit flags take precedence over the session context.
+		"workflow conflicts --workflow-id other -n other-ns",
+		"set workflow-id wf2",
+		"workflow conflicts",
+		"context",
+	}, "\n"), "-n", "my-ns")
+	require.NoError(t, err)
+	require.Empty(t, stderr)
+
+	require.Len(t, admin.requests, 3)
+	require.Equal(t, "my-ns", admin.requests[0].GetNamespace())
+	require.Equal(t, "wf", admin.requests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run", admin.requests[0].GetExecution().GetRunId())
+	require.Equal(t, "other-ns", admin.requests[1].GetNamespace())
+	require.Equal(t, "other", admin.requests[1].GetExecution().GetWorkflowId())
+	// Changing the workflow clears the run.
+	require.Equal(t, "wf2", admin.requests[2].GetExecution().GetWorkflowId())
+	require.Empty(t, admin.requests[2].GetExecution().GetRunId())
+	require.Contains(t, stdout, "namespace: my-ns\nworkflow-id: wf2\n")
+}
+
+func TestShell_ErrorsDoNotExit(t *testing.T) {
+	admin := &conflictsAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	_, stderr, err := runShell(t, factory, strings.Join([]string{
+		"workflow conflicts",
+		"set unknown value",
+		"shell",
+		"set workflow-id wf",
+		"workflow conflicts",
+		"exit",
+		"workflow conflicts",
+	}, "\n"))
+	require.NoError(t, err)
+
+	require.Contains(t, stderr, "Required flag \"workflow-id\" not set")
+	require.Contains(t, stderr, `unknown session context "unknown"`)
+	require.Contains(t, stderr, "already in a shell")
+	// Commands after exit are not run.
+	require.Len(t, admin.requests, 1)
+}
+
+func TestShell_DeclinedPromptDoesNotExit(t *testing.T) {
+	admin := &conflictsAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	var stderr string
+	var err error
+	withStdin(t, "n\n", func() {
+		_, stderr, err = runShell(t, factory, strings.Join([]string{
+			"set workflow-id wf",
+			"workflow delete",
+			"workflow conflicts",
+		}, "\n"))
+	})
+	require.NoError(t, err)
+
+	require.Contains(t, stderr, tdbg.ErrPromptDeclined.Error())
+	// The shell keeps running after the prompt is declined.
+	require.Len(t, admin.requests, 1)
+}
+
+func TestShell_OutputRedirection(t *testing.T) {
+	admin := &conflictsAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+	output := filepath.Join(t.TempDir(), "out.txt")
+
+	stdout, _, err := runShell(t, factory, strings.Join([]string{
+		"set workflow-id wf",
+		"workflow conflicts > " + output,
+		"workflow conflicts >> '" + output + "'",
+	}, "\n"))
+	require.NoError(t, err)
+	require.NotContains(t, stdout, "No conflict resolution recorded.")
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(content), "No conflict resolution recorded."))
+}
+
+func TestSplitShellLine(t *testing.T) {
+	args, err := tdbg.SplitShellLine(`workflow describe --workflow-id "my workflow" --run-id 'run\id' a\ b`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"workflow", "describe", "--workflow-id", "my workflow", "--run-id", `run\id`, "a b"}, args)
+
+	args, err = tdbg.SplitShellLine(`set workflow-id ""`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"set", "workflow-id", ""}, args)
+
+	_, err = tdbg.SplitShellLine(`set workflow-id "wf`)
+	require.Error(t, err)
+}
+
+func TestCompleteShellLine(t *testing.T) {
+	commands := tdbgtest.NewCliApp().Commands
+
+	require.Equal(t, []string{"schedule", "set", "shard", "shell"}, tdbg.CompleteShellLine(commands, "s"))
+	require.Equal(t, []string{"conflicts"}, tdbg.CompleteShellLine(commands, "workflow con"))
+	require.Equal(t, []string{"--run-id", "--workflow-id"}, tdbg.CompleteShellLine(commands, "workflow conflicts --"))
+	require.Empty(t, tdbg.CompleteShellLine(commands, "unknown "))
+}
@@ -0,0 +1,109 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type taskSchedulerAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	describeRequests []*adminservice.DescribeTaskSchedulerRequest
+	updateRequests   []*adminservice.UpdateTaskSchedulerNamespaceWeightRequest
+}
+
+func (c *taskSchedulerAdminClient) DescribeTaskScheduler(
+	_ context.Context,
+	req *adminservice.DescribeTaskSchedulerRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DescribeTaskSchedulerResponse, error) {
+	c.describeRequests = append(c.describeRequests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.DescribeTaskSchedulerResponse{
+		Hosts: []*adminservice.DescribeTaskSchedulerResponse_Host{{
+			Address: "127.0.0.1:7234",
+			Schedulers: []*historyspb.TaskSchedulerState{{
+				Name: "transfer",
+				Channels: []*historyspb.TaskSchedulerChannel{
+					{NamespaceId: "ns-id", Namespace: "busy-ns", Priority: "High", Weight: 10, Backlog: 42},
+				},
+			}},
+		}},
+	}, nil
+}
+
+func (c *taskSchedulerAdminClient) UpdateTaskSchedulerNamespaceWeight(
+	_ context.Context,
+	req *adminservice.UpdateTaskSchedulerNamespaceWeightRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.UpdateTaskSchedulerNamespaceWeightResponse, error) {
+	c.updateRequests = append(c.updateRequests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.UpdateTaskSchedulerNamespaceWeightResponse{
+		Hosts:      []string{"127.0.0.1:7234", "127.0.0.2:7234"},
+		ExpireTime: timestamppb.New(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}, nil
+}
+
+func TestDescribeTaskScheduler(t *testing.T) {
+	admin := &taskSchedulerAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"history-host", "describe-scheduler", "--history-address", "127.0.0.1:7234")
+	require.NoError(t, err)
+
+	require.Len(t, admin.describeRequests, 1)
+	require.Equal(t, "127.0.0.1:7234", admin.describeRequests[0].GetHostAddress())
+	require.Contains(t, stdout, "busy-ns")
+	require.Contains(t, stdout, "42")
+}
+
+func TestUpdateTaskSchedulerNamespaceWeight(t *testing.T) {
+	admin := &taskSchedulerAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "busy-ns", "history-host", "set-namespace-weight", "--multiplier", "0.25", "--duration", "30m")
+	require.NoError(t, err)
+
+	require.Len(t, admin.updateRequests, 1)
+	require.Equal(t, "busy-ns", admin.updateRequests[0].GetNamespace())
+	require.Equal(t, 0.25, admin.updateRequests[0].GetMultiplier())
+	require.Equal(t, 30*time.Minute, admin.updateRequests[0].GetDuration().AsDuration())
+	require.False(t, admin.updateRequests[0].GetRemove())
+	require.Contains(t, stdout, "multiplied by 0.25 on 2 history hosts until 2026-01-02T03:04:05Z")
+
+	stdout, _, err = runMigrate(t, factory,
+		"-n", "busy-ns", "history-host", "set-namespace-weight", "--remove")
+	require.NoError(t, err)
+	require.Len(t, admin.updateRequests, 2)
+	require.True(t, admin.updateRequests[1].GetRemove())
+	require.Contains(t, stdout, "Removed task scheduler weight multiplier of namespace busy-ns on 2 history hosts")
+}
+
+func TestUpdateTaskSchedulerNamespaceWeight_Errors(t *testing.T) {
+	admin := &taskSchedulerAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory, "-n", "busy-ns", "history-host", "set-namespace-weight")
+	require.ErrorContains(t, err, "either --multiplier or --remove must be set")
+	require.Empty(t, admin.updateRequests)
+
+	admin.err = errors.New("boom")
+	_, _, err = runMigrate(t, factory, "-n", "busy-ns", "history-host", "set-namespace-weight", "--multiplier", "2")
+	require.ErrorContains(t, err, "unable to update task scheduler namespace weight: boom")
+}
@@ -0,0 +1,64 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+)
+
+type taskTraceAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	requests []*adminservice.GetTaskTraceRequest
+}
+
+func (c *taskTraceAdminClient) GetTaskTrace(
+	_ context.Context,
+	req *adminservice.GetTaskTraceRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.GetTaskTraceResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.GetTaskTraceResponse{
+		Trace: &historyspb.TaskTrace{
+			ShardId:  req.GetShardId(),
+			Category: req.GetCategory(),
+			TaskId:   req.GetTaskId(),
+			TraceId:  "0af7651916cd43dd8448eb211c80319c",
+			Events: []*historyspb.TaskTraceEvent{
+				{Stage: "loaded", ReaderId: 1, SliceRange: "[a, b)"},
+				{Stage: "rescheduled", Attempt: 2, Reason: "task scheduler rejected the task"},
+			},
+		},
+	}, nil
+}
+
+func TestTraceTask(t *testing.T) {
+	admin := &taskTraceAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"shard", "trace-task", "--shard-id", "3", "--task-id", "42", "--task-category", "timer")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	require.Equal(t, int32(3), admin.requests[0].GetShardId())
+	require.Equal(t, int32(tasks.CategoryIDTimer), admin.requests[0].GetCategory())
+	require.Equal(t, int64(42), admin.requests[0].GetTaskId())
+	require.Contains(t, stdout, "0af7651916cd43dd8448eb211c80319c")
+	require.Contains(t, stdout, "task scheduler rejected the task")
+
+	admin.err = errors.New("boom")
+	_, _, err = runMigrate(t, factory,
+		"shard", "trace-task", "--shard-id", "3", "--task-id", "42", "--task-category", "timer")
+	require.ErrorContains(t, err, "unable to get task trace: boom")
+}
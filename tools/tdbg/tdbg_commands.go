@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -26,7 +27,7 @@ func getCommands(
 			Name:        "execution",
 			Aliases:     []string{"e", "w", "workflow"},
 			Usage:       "Run admin operation on an execution (workflow)",
-			Subcommands: newAdminExecutionCommands(clientFactory, prompterFactory),
+			Subcommands: newAdminExecutionCommands(clientFactory, prompterFactory, taskCategoryRegistry),
 		},
 		{
 			Name:        "shard",
@@ -40,6 +41,12 @@ func getCommands(
 			Usage:       "Run admin operation on history host",
 			Subcommands: newAdminHistoryHostCommands(clientFactory),
 		},
+		{
+			Name:        "replication",
+			Aliases:     []string{"r"},
+			Usage:       "Run admin operation on replication",
+			Subcommands: newAdminReplicationCommands(clientFactory),
+		},
 		{
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
@@ -75,10 +82,15 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		newShellCommand(),
 	}
 }
 
-func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+func newAdminExecutionCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "import",
@@ -170,8 +182,15 @@ func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory Prom
 					Name:  FlagArchetypeID,
 					Usage: "Archetype ID (optional, overrides --archetype if specified)",
 				},
+				&cli.Int64Flag{
+					Name:  FlagAtEvent,
+					Usage: "Rebuild the workflow mutable state in memory up to this event ID instead of describing the current state",
+				},
 			},
 			Action: func(c *cli.Context) error {
+				if c.IsSet(FlagAtEvent) {
+					return AdminDescribeExecutionAtEvent(c, clientFactory)
+				}
 				return AdminDescribeExecution(c, clientFactory)
 			},
 		},
@@ -236,6 +255,127 @@ func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory Prom
 				return AdminRebuildMutableState(c, clientFactory)
 			},
 		},
+		{
+			Name:  "clone",
+			Usage: "Clone a workflow execution into a new workflow ID, optionally truncating its history at an event",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID of the execution to clone",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID of the execution to clone (optional, uses latest if not specified)",
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetWorkflowID,
+					Usage:    "Workflow ID of the clone",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetNamespace,
+					Usage: "Namespace to create the clone in (optional, defaults to --namespace)",
+				},
+				&cli.Int64Flag{
+					Name:  FlagLastEventID,
+					Usage: "Last event ID to copy into the clone, inclusive; must be the last event of its event batch (optional, copies the whole history if not specified)",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetTaskQueue,
+					Usage: "Task queue for the clone's workflow and activity tasks (optional, defaults to the task queue of the original execution)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCloneWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:  "reset-dry-run",
+			Usage: "Show what resetting a workflow execution would change, without resetting it",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID of the execution to reset (optional, uses latest if not specified)",
+				},
+				&cli.Int64Flag{
+					Name:     FlagEventID,
+					Usage:    "Event ID of the WorkflowTaskCompleted, WorkflowTaskTimedOut, WorkflowTaskFailed or WorkflowTaskStarted event to reset to",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the reset",
+					Value: "dry run",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagReapplyExclude,
+					Usage: "Event types to exclude from reapplication (Signal, Update, Nexus, CancelRequest)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDryRunResetWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:  "conflicts",
+			Usage: "List the most recent conflicts between history branches of a workflow execution resolved by this cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID (optional, uses latest if not specified)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListWorkflowConflictResolutions(c, clientFactory)
+			},
+		},
+		{
+			Name:  "diagnose",
+			Usage: "Gather the state of a workflow execution from all subsystems and report the detected problems",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID (optional, uses latest if not specified)",
+				},
+				&cli.DurationFlag{
+					Name:  FlagStuckThreshold,
+					Value: defaultDiagnoseStuckThreshold,
+					Usage: "Duration after which a pending task, backlog or replication lag is reported as a problem",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxScannedTasks,
+					Value: defaultDiagnoseMaxScannedTasks,
+					Usage: "Max number of tasks of the shard scanned per task category to find the tasks of the execution",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDiagnoseWorkflow(c, clientFactory, taskCategoryRegistry)
+			},
+		},
 		{
 			Name:    "replicate",
 			Aliases: []string{},
@@ -451,6 +591,39 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminShardManagement(c, clientFactory)
 			},
 		},
+		{
+			Name:  "move",
+			Usage: "Move a shard to a history host, and pin it there until it is unpinned",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard to move",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetHost,
+					Usage:    "Address of the history host to move the shard to (ip:port)",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMoveShard(c, clientFactory)
+			},
+		},
+		{
+			Name:  "unpin",
+			Usage: "Unpin a shard, moving it back to the history host membership assigns it to",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard to unpin",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpinShard(c, clientFactory)
+			},
+		},
 		{
 			Name:    "remove-task",
 			Aliases: []string{"rmtk"},
@@ -476,6 +649,40 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminRemoveTask(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "trace-task",
+			Usage: "Show when a task sampled by history.queueTaskTracingSampleRate was generated, loaded, scheduled, attempted, rescheduled and acked",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagTaskID,
+					Usage:    "The ID of the task",
+					Required: true,
+				},
+				taskCategoryFlag,
+			},
+			Action: func(c *cli.Context) error {
+				return AdminTraceTask(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "list-mitigations",
+			Usage: "List the actions recently run by the queues of a shard to mitigate stuck readers, too many pending tasks or too many slices",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListQueueMitigations(c, clientFactory)
+			},
+		},
 	}
 }
 
@@ -533,6 +740,66 @@ func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
+func newAdminReplicationCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Show the replication lag, backlog and DLQ size of each remote cluster and namespace",
+			Description: "This command fails if it's not safe to fail over to the remote clusters, i.e. if a replication " +
+				"lag exceeds --max-lag, a replication stream isn't making progress or a replication DLQ isn't empty.",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  FlagCluster,
+					Usage: "Remote clusters to check. If not provided, all remote clusters are checked.",
+				},
+				&cli.StringSliceFlag{
+					Name:    FlagNamespace,
+					Aliases: FlagNamespaceAlias,
+					Usage:   "Namespaces to check. If not provided, the lag of the whole clusters is checked.",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMaxLag,
+					Usage: "Replication lag under which it's safe to fail over. If not provided, there must be no unreplicated task.",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxScannedTasks,
+					Usage: "Max number of unreplicated tasks scanned per shard and remote cluster. Will use server default if not provided.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetReplicationStatus(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set-filter",
+			Usage: "Set the filter restricting the workflows of a global namespace replicated from the current cluster",
+			Description: "A workflow is replicated if its type or the value of one of the search attributes it starts with " +
+				"matches the filter. The filter only applies to workflows started afterwards, and is local to the current " +
+				"cluster, so it should be set on every cluster of the namespace. Without --workflow-type and " +
+				"--search-attribute, every workflow is replicated.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNamespace,
+					Aliases:  FlagNamespaceAlias,
+					Usage:    "Namespace to set the replication filter of",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagWorkflowType,
+					Usage: "Workflow type to replicate",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagSearchAttribute,
+					Usage: "Keyword or keyword list search attribute value to replicate, in the format name=value",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetReplicationFilter(c, clientFactory)
+			},
+		},
+	}
+}
+
 func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
@@ -562,6 +829,41 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminDescribeHistoryHost(c, clientFactory)
 			},
 		},
+		{
+			Name:  "describe-scheduler",
+			Usage: "Describe the per namespace task channels of the task schedulers of history hosts",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagHistoryAddress,
+					Usage: "History Host address(IP:PORT), all history hosts are described if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskScheduler(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set-namespace-weight",
+			Usage: "Temporarily boost or throttle the task processing of the namespace on all history hosts",
+			Flags: []cli.Flag{
+				&cli.Float64Flag{
+					Name:  FlagMultiplier,
+					Usage: "Multiplier of the task channel weights of the namespace, above 1 to boost and below 1 to throttle",
+				},
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Usage: "How long the multiplier is applied for",
+					Value: time.Hour,
+				},
+				&cli.BoolFlag{
+					Name:  FlagRemove,
+					Usage: "Remove the multiplier of the namespace instead of setting it",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskSchedulerNamespaceWeight(c, clientFactory)
+			},
+		},
 		{
 			Name:  "get-shardid",
 			Usage: "Get shardId for a namespaceId and workflowId combination",
@@ -575,13 +877,9 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 					Aliases: FlagWorkflowIDAlias,
 					Usage:   "Workflow ID",
 				},
-				&cli.IntFlag{
-					Name:  FlagNumberOfShards,
-					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
-				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminGetShardID(c)
+				return AdminGetShardID(c, clientFactory)
 			},
 		},
 	}
@@ -768,7 +1066,7 @@ func newAdminDLQCommands(
 			Aliases: []string{"r"},
 			Usage:   "Read DLQ Messages",
 			Flags: append(
-				getDLQFlags(taskCategoryRegistry),
+				append(getDLQFlags(taskCategoryRegistry), getDLQTaskFilterFlags()...),
 				&cli.IntFlag{
 					Name: FlagMaxMessageCount,
 					Usage: fmt.Sprintf(
@@ -798,7 +1096,13 @@ func newAdminDLQCommands(
 			Name:    "purge",
 			Aliases: []string{"p"},
 			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
-			Flags:   getDLQFlags(taskCategoryRegistry),
+			Flags: append(
+				append(getDLQFlags(taskCategoryRegistry), getDLQTaskFilterFlags()...),
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only report the messages which would be deleted, v2 only",
+				},
+			),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
 				if err != nil {
@@ -812,12 +1116,25 @@ func newAdminDLQCommands(
 			Aliases:     []string{"m"},
 			Usage:       "Merge DLQ messages with equal or smaller ids than the provided task id",
 			Description: "This command will delete messages after they've been re-enqueued if using v2.",
-			Flags: append(getDLQFlags(taskCategoryRegistry),
+			Flags: append(
+				append(getDLQFlags(taskCategoryRegistry), getDLQTaskFilterFlags()...),
 				&cli.IntFlag{
 					Name: FlagPageSize,
 					Usage: "Batch size to use when purging messages from the DB, v2 only. Will use server default if " +
 						"not provided.",
 				},
+				&cli.BoolFlag{
+					Name:  FlagSkipClosedWorkflows,
+					Usage: "Purge instead of merging the messages of closed workflows, v2 only",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPurgeUnmatched,
+					Usage: "Purge the messages which don't match the filter instead of keeping them in the DLQ, v2 only",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only report the messages which would be merged or purged, v2 only",
+				},
 			),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
@@ -938,6 +1255,28 @@ func getDLQFlags(taskCategoryRegistry tasks.TaskCategoryRegistry) []cli.Flag {
 	}
 }
 
+// getDLQTaskFilterFlags returns the flags selecting the DLQ messages to operate on. They are only supported for v2.
+func getDLQTaskFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  FlagNamespaceID,
+			Usage: "Only operate on messages of the given namespace IDs, v2 only",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowIDPrefix,
+			Usage: "Only operate on messages of workflows whose ID starts with the given prefix, v2 only",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagTaskType,
+			Usage: "Only operate on messages of the given task types (e.g. TransferActivityTask), v2 only",
+		},
+		&cli.StringFlag{
+			Name:  FlagFailureContains,
+			Usage: "Only operate on messages whose recorded failure contains the given text, v2 only",
+		},
+	}
+}
+
 func newDecodeCommands(
 	taskBlobEncoder TaskBlobEncoder,
 ) []*cli.Command {
@@ -0,0 +1,81 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type cloneAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	requests []*adminservice.CloneWorkflowExecutionRequest
+}
+
+func (c *cloneAdminClient) CloneWorkflowExecution(
+	_ context.Context,
+	req *adminservice.CloneWorkflowExecutionRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.CloneWorkflowExecutionResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.CloneWorkflowExecutionResponse{
+		RunId:       "clone-run-id",
+		LastEventId: 12,
+	}, nil
+}
+
+func TestCloneWorkflow(t *testing.T) {
+	admin := &cloneAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "clone",
+		"--workflow-id", "wf", "--run-id", "run",
+		"--target-workflow-id", "wf-clone", "--target-namespace", "debug-ns",
+		"--last-event-id", "12", "--target-task-queue", "patched-tq")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	req := admin.requests[0]
+	require.Equal(t, "my-ns", req.GetNamespace())
+	require.Equal(t, "wf", req.GetExecution().GetWorkflowId())
+	require.Equal(t, "run", req.GetExecution().GetRunId())
+	require.Equal(t, "debug-ns", req.GetTargetNamespace())
+	require.Equal(t, "wf-clone", req.GetTargetWorkflowId())
+	require.Equal(t, int64(12), req.GetLastEventId())
+	require.Equal(t, "patched-tq", req.GetTargetTaskQueue())
+	require.Contains(t, stdout, "Workflow ID: wf-clone, Run ID: clone-run-id")
+}
+
+func TestCloneWorkflow_Defaults(t *testing.T) {
+	admin := &cloneAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "clone", "--workflow-id", "wf", "--target-workflow-id", "wf-clone")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	req := admin.requests[0]
+	require.Empty(t, req.GetExecution().GetRunId())
+	require.Empty(t, req.GetTargetNamespace())
+	require.Zero(t, req.GetLastEventId())
+	require.Empty(t, req.GetTargetTaskQueue())
+}
+
+func TestCloneWorkflow_Error(t *testing.T) {
+	admin := &cloneAdminClient{err: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "clone", "--workflow-id", "wf", "--target-workflow-id", "wf-clone")
+	require.ErrorContains(t, err, "unable to clone workflow: boom")
+}
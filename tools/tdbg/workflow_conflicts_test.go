@@ -0,0 +1,80 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/grpc"
+)
+
+type conflictsAdminClient struct {
+	adminservice.AdminServiceClient
+	records []*persistencespb.ConflictResolutionRecord
+	err     error
+
+	requests []*adminservice.ListWorkflowConflictResolutionsRequest
+}
+
+func (c *conflictsAdminClient) ListWorkflowConflictResolutions(
+	_ context.Context,
+	req *adminservice.ListWorkflowConflictResolutionsRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.ListWorkflowConflictResolutionsResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.ListWorkflowConflictResolutionsResponse{Records: c.records}, nil
+}
+
+func TestWorkflowConflicts(t *testing.T) {
+	admin := &conflictsAdminClient{
+		records: []*persistencespb.ConflictResolutionRecord{{
+			ClusterName:                 "cluster-b",
+			Type:                        enumsspb.CONFLICT_RESOLUTION_TYPE_BACKFILL,
+			LowestCommonAncestorEventId: 8,
+			ReappliedEvents: []*persistencespb.ConflictResolutionEvent{
+				{EventId: 9, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+			},
+			DiscardedEventCount: 2,
+		}},
+	}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "conflicts", "--workflow-id", "wf", "--run-id", "run")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	require.Equal(t, "my-ns", admin.requests[0].GetNamespace())
+	require.Equal(t, "wf", admin.requests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run", admin.requests[0].GetExecution().GetRunId())
+	require.Contains(t, stdout, "cluster-b")
+	require.Contains(t, stdout, "CONFLICT_RESOLUTION_TYPE_BACKFILL")
+	require.Contains(t, stdout, "EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED")
+}
+
+func TestWorkflowConflicts_NoRecords(t *testing.T) {
+	admin := &conflictsAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "conflicts", "--workflow-id", "wf")
+	require.NoError(t, err)
+	require.Contains(t, stdout, "No conflict resolution recorded.")
+}
+
+func TestWorkflowConflicts_Error(t *testing.T) {
+	admin := &conflictsAdminClient{err: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "conflicts", "--workflow-id", "wf")
+	require.ErrorContains(t, err, "unable to list workflow conflict resolutions: boom")
+}
@@ -0,0 +1,67 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/grpc"
+)
+
+type describeAtEventAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	requests []*adminservice.DescribeMutableStateAtEventRequest
+}
+
+func (c *describeAtEventAdminClient) DescribeMutableStateAtEvent(
+	_ context.Context,
+	req *adminservice.DescribeMutableStateAtEventRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateAtEventResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.DescribeMutableStateAtEventResponse{
+		ShardId:     "3",
+		HistoryAddr: "127.0.0.1:7234",
+		MutableState: &persistencespb.WorkflowMutableState{
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ScheduledEventId: 5, ActivityId: "pending-activity"},
+			},
+			NextEventId: 8,
+		},
+	}, nil
+}
+
+func TestDescribeAtEvent(t *testing.T) {
+	admin := &describeAtEventAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "describe", "--workflow-id", "wf", "--run-id", "run", "--at-event", "7")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	require.Equal(t, "my-ns", admin.requests[0].GetNamespace())
+	require.Equal(t, "wf", admin.requests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run", admin.requests[0].GetExecution().GetRunId())
+	require.Equal(t, int64(7), admin.requests[0].GetEventId())
+	require.Contains(t, stdout, "Mutable state at event 7")
+	require.Contains(t, stdout, "pending-activity")
+	require.Contains(t, stdout, "Shard Id: 3")
+}
+
+func TestDescribeAtEvent_Error(t *testing.T) {
+	admin := &describeAtEventAdminClient{err: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "describe", "--workflow-id", "wf", "--at-event", "7")
+	require.ErrorContains(t, err, "unable to rebuild Mutable State: boom")
+}
@@ -0,0 +1,632 @@
+package tdbg
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/sqlparser"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDiagnoseStuckThreshold  = 10 * time.Minute
+	defaultDiagnoseMaxScannedTasks = 1000
+)
+
+type (
+	// workflowDiagnosis accumulates the state of a workflow execution gathered from the different subsystems, and the
+	// problems detected by correlating them.
+	workflowDiagnosis struct {
+		c               *cli.Context
+		adminClient     adminservice.AdminServiceClient
+		workflowClient  workflowservice.WorkflowServiceClient
+		registry        tasks.TaskCategoryRegistry
+		namespace       string
+		execution       *commonpb.WorkflowExecution
+		mutableState    *persistencespb.WorkflowMutableState
+		shardID         int32
+		threshold       time.Duration
+		maxScannedTasks int
+		now             time.Time
+
+		// pollers and backlog of the task queues the execution has pending tasks on, keyed by task queue type and name.
+		taskQueues map[enumspb.TaskQueueType]map[string]*diagnosedTaskQueue
+		problems   []string
+	}
+
+	diagnosedTaskQueue struct {
+		pollerCount  int
+		backlogCount int64
+		backlogAge   time.Duration
+		err          error
+	}
+)
+
+// AdminDiagnoseWorkflow gathers the mutable state of a workflow execution, its pending history tasks, the task queues
+// it's waiting on, its DLQ tasks, the replication status of its namespace and its visibility record, and prints the
+// problems detected by correlating them.
+func AdminDiagnoseWorkflow(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	d := &workflowDiagnosis{
+		c:               c,
+		adminClient:     clientFactory.AdminClient(c),
+		workflowClient:  clientFactory.WorkflowClient(c),
+		registry:        registry,
+		namespace:       nsName,
+		execution:       &commonpb.WorkflowExecution{WorkflowId: wid, RunId: c.String(FlagRunID)},
+		threshold:       c.Duration(FlagStuckThreshold),
+		maxScannedTasks: c.Int(FlagMaxScannedTasks),
+		now:             time.Now().UTC(),
+		taskQueues:      make(map[enumspb.TaskQueueType]map[string]*diagnosedTaskQueue),
+	}
+	if d.maxScannedTasks <= 0 {
+		d.maxScannedTasks = defaultDiagnoseMaxScannedTasks
+	}
+
+	// Every other subsystem is looked up by the identifiers of the mutable state, so it's the only required part.
+	if err := d.diagnoseMutableState(); err != nil {
+		return err
+	}
+	d.diagnoseHistoryTasks()
+	d.diagnoseTaskQueues()
+	d.diagnoseDLQ()
+	d.diagnoseReplication()
+	d.diagnoseVisibility()
+	d.detectPendingTaskProblems()
+
+	d.section("Detected problems:")
+	if len(d.problems) == 0 {
+		d.printf("  No problem detected.\n")
+		return nil
+	}
+	for _, problem := range d.problems {
+		d.printf("  - %s\n", problem)
+	}
+	return nil
+}
+
+func (d *workflowDiagnosis) printf(format string, args ...any) {
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(d.c.App.Writer, format, args...)
+}
+
+func (d *workflowDiagnosis) section(title string) {
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintln(d.c.App.Writer, color.GreenString(title))
+}
+
+func (d *workflowDiagnosis) problemf(format string, args ...any) {
+	d.problems = append(d.problems, fmt.Sprintf(format, args...))
+}
+
+// age returns how long ago the given time was, or zero if it's unset or in the future.
+func (d *workflowDiagnosis) age(t *timestamppb.Timestamp) time.Duration {
+	if t == nil {
+		return 0
+	}
+	return max(d.now.Sub(t.AsTime()), 0)
+}
+
+func (d *workflowDiagnosis) isRunning() bool {
+	return d.mutableState.GetExecutionState().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+}
+
+func (d *workflowDiagnosis) diagnoseMutableState() error {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	resp, err := d.adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: d.namespace,
+		Execution: d.execution,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get Mutable State: %s", err)
+	}
+	d.mutableState = resp.GetDatabaseMutableState()
+	if d.mutableState == nil {
+		return fmt.Errorf("unable to get Mutable State: no mutable state returned")
+	}
+	shardID, err := strconv.ParseInt(resp.GetShardId(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("unable to parse shard ID %q: %s", resp.GetShardId(), err)
+	}
+	d.shardID = int32(shardID)
+	d.execution = &commonpb.WorkflowExecution{
+		WorkflowId: d.execution.GetWorkflowId(),
+		RunId:      d.mutableState.GetExecutionState().GetRunId(),
+	}
+
+	executionInfo := d.mutableState.GetExecutionInfo()
+	d.section("Mutable state:")
+	d.printf("  Run ID: %s\n", d.execution.GetRunId())
+	d.printf("  Workflow type: %s\n", executionInfo.GetWorkflowTypeName())
+	d.printf("  Status: %s\n", d.mutableState.GetExecutionState().GetStatus())
+	d.printf("  Task queue: %s\n", executionInfo.GetTaskQueue())
+	d.printf("  Shard Id: %d, history service address: %s\n", d.shardID, resp.GetHistoryAddr())
+	d.printf("  Next event ID: %d\n", d.mutableState.GetNextEventId())
+	if executionInfo.GetWorkflowTaskScheduledEventId() != common.EmptyEventID {
+		d.printf("  Workflow task: scheduled event ID %d, started event ID %d, attempt %d\n",
+			executionInfo.GetWorkflowTaskScheduledEventId(),
+			executionInfo.GetWorkflowTaskStartedEventId(),
+			executionInfo.GetWorkflowTaskAttempt(),
+		)
+	}
+	d.printf("  Pending activities: %d, timers: %d, child workflows: %d\n",
+		len(d.mutableState.GetActivityInfos()),
+		len(d.mutableState.GetTimerInfos()),
+		len(d.mutableState.GetChildExecutionInfos()),
+	)
+	if len(d.mutableState.GetBufferedEvents()) > 0 {
+		d.printf("  Buffered events: %d\n", len(d.mutableState.GetBufferedEvents()))
+	}
+
+	if d.isRunning() && executionInfo.GetWorkflowTaskAttempt() > 1 {
+		d.problemf("workflow task failed %d times in a row", executionInfo.GetWorkflowTaskAttempt()-1)
+	}
+	return nil
+}
+
+func (d *workflowDiagnosis) diagnoseHistoryTasks() {
+	d.section("History tasks:")
+	categories := make([]tasks.Category, 0, len(d.registry.GetCategories()))
+	for _, category := range d.registry.GetCategories() {
+		categories = append(categories, category)
+	}
+	slices.SortFunc(categories, func(a, b tasks.Category) int {
+		return a.ID() - b.ID()
+	})
+
+	for _, category := range categories {
+		executionTasks, truncated, err := d.listHistoryTasks(category)
+		if err != nil {
+			d.printf("  %s: unavailable\n", category.Name())
+			d.problemf("unable to list %s tasks of shard %d: %s", category.Name(), d.shardID, err)
+			continue
+		}
+		d.printf("  %s: %d task(s)", category.Name(), len(executionTasks))
+		if truncated {
+			d.printf(" in the first %d task(s) of the shard", d.maxScannedTasks)
+		}
+		d.printf("\n")
+		for _, task := range executionTasks {
+			if category.Type() != tasks.CategoryTypeScheduled {
+				d.printf("    %s, task ID %d\n", task.GetTaskType(), task.GetTaskId())
+				continue
+			}
+			d.printf("    %s, task ID %d, fire time %s\n",
+				task.GetTaskType(), task.GetTaskId(), task.GetFireTime().AsTime().Format(time.RFC3339))
+			if overdue := d.age(task.GetFireTime()); overdue > d.threshold {
+				d.problemf("%s task %s overdue by %v", category.Name(), task.GetTaskType(), overdue.Round(time.Second))
+			}
+		}
+	}
+}
+
+// listHistoryTasks scans the tasks of a category of the shard of the execution, up to maxScannedTasks, and returns the
+// ones of the execution and whether the scan was truncated.
+func (d *workflowDiagnosis) listHistoryTasks(category tasks.Category) ([]*adminservice.Task, bool, error) {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	namespaceID := d.mutableState.GetExecutionInfo().GetNamespaceId()
+	req := &adminservice.ListHistoryTasksRequest{
+		ShardId:  d.shardID,
+		Category: int32(category.ID()),
+		TaskRange: &historyspb.TaskRange{
+			InclusiveMinTaskKey: &historyspb.TaskKey{
+				FireTime: timestamppb.New(tasks.MinimumKey.FireTime),
+				TaskId:   tasks.MinimumKey.TaskID,
+			},
+			ExclusiveMaxTaskKey: &historyspb.TaskKey{
+				FireTime: timestamppb.New(tasks.MaximumKey.FireTime),
+				TaskId:   tasks.MaximumKey.TaskID,
+			},
+		},
+		BatchSize: int32(min(defaultPageSize*10, d.maxScannedTasks)),
+	}
+	var executionTasks []*adminservice.Task
+	scanned := 0
+	for {
+		resp, err := d.adminClient.ListHistoryTasks(ctx, req)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, task := range resp.GetTasks() {
+			if task.GetNamespaceId() == namespaceID &&
+				task.GetWorkflowId() == d.execution.GetWorkflowId() &&
+				task.GetRunId() == d.execution.GetRunId() {
+				executionTasks = append(executionTasks, task)
+			}
+		}
+		scanned += len(resp.GetTasks())
+		if len(resp.GetNextPageToken()) == 0 {
+			return executionTasks, false, nil
+		}
+		if scanned >= d.maxScannedTasks {
+			return executionTasks, true, nil
+		}
+		req.NextPageToken = resp.GetNextPageToken()
+	}
+}
+
+func (d *workflowDiagnosis) diagnoseTaskQueues() {
+	d.section("Task queues:")
+	if !d.isRunning() {
+		d.printf("  Skipped, the execution is not running.\n")
+		return
+	}
+	executionInfo := d.mutableState.GetExecutionInfo()
+	if executionInfo.GetWorkflowTaskScheduledEventId() != common.EmptyEventID {
+		d.describeTaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW, executionInfo.GetTaskQueue(), "")
+		// Workflow tasks are dispatched to the sticky task queue of the worker caching the execution, if any.
+		if executionInfo.GetStickyTaskQueue() != "" {
+			d.describeTaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW, executionInfo.GetTaskQueue(), executionInfo.GetStickyTaskQueue())
+		}
+	}
+	var activityTaskQueues []string
+	for _, activity := range d.mutableState.GetActivityInfos() {
+		if activity.GetStartedEventId() == common.EmptyEventID && !slices.Contains(activityTaskQueues, activity.GetTaskQueue()) {
+			activityTaskQueues = append(activityTaskQueues, activity.GetTaskQueue())
+		}
+	}
+	slices.Sort(activityTaskQueues)
+	for _, taskQueue := range activityTaskQueues {
+		d.describeTaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY, taskQueue, "")
+	}
+	if len(d.taskQueues) == 0 {
+		d.printf("  No pending task waiting for a poller.\n")
+	}
+}
+
+// describeTaskQueue describes the root partition of a task queue, which is enough to tell whether workers are polling
+// it, but only accounts for the backlog of that partition. If stickyTaskQueue is set, the sticky partition of that name
+// is described instead, and recorded under the sticky task queue name.
+func (d *workflowDiagnosis) describeTaskQueue(taskQueueType enumspb.TaskQueueType, taskQueue string, stickyTaskQueue string) {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	partition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     taskQueue,
+		TaskQueueType: taskQueueType,
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 0},
+	}
+	if stickyTaskQueue != "" {
+		partition.PartitionId = &taskqueuespb.TaskQueuePartition_StickyName{StickyName: stickyTaskQueue}
+		taskQueue = stickyTaskQueue
+	}
+
+	info := &diagnosedTaskQueue{}
+	if d.taskQueues[taskQueueType] == nil {
+		d.taskQueues[taskQueueType] = make(map[string]*diagnosedTaskQueue)
+	}
+	d.taskQueues[taskQueueType][taskQueue] = info
+
+	resp, err := d.adminClient.DescribeTaskQueuePartition(ctx, &adminservice.DescribeTaskQueuePartitionRequest{
+		Namespace:          d.namespace,
+		TaskQueuePartition: partition,
+		BuildIds: &taskqueuepb.TaskQueueVersionSelection{
+			Unversioned: true,
+			AllActive:   true,
+		},
+	})
+	if err != nil {
+		info.err = err
+		d.printf("  %s %s: unavailable\n", taskQueueType, taskQueue)
+		d.problemf("unable to describe %s task queue %s: %s", taskQueueType, taskQueue, err)
+		return
+	}
+	for _, version := range resp.GetVersionsInfoInternal() {
+		physicalInfo := version.GetPhysicalTaskQueueInfo()
+		info.pollerCount += len(physicalInfo.GetPollers())
+		info.backlogCount += physicalInfo.GetTaskQueueStats().GetApproximateBacklogCount()
+		info.backlogAge = max(info.backlogAge, physicalInfo.GetTaskQueueStats().GetApproximateBacklogAge().AsDuration())
+	}
+	d.printf("  %s %s: %d poller(s), backlog of %d task(s), oldest %v\n",
+		taskQueueType, taskQueue, info.pollerCount, info.backlogCount, info.backlogAge.Round(time.Second))
+	if info.backlogAge > d.threshold {
+		d.problemf("%s task queue %s has a backlog of %d task(s) older than %v",
+			taskQueueType, taskQueue, info.backlogCount, info.backlogAge.Round(time.Second))
+	}
+}
+
+// detectPendingTaskProblems correlates the pending workflow and activity tasks of the mutable state with the pollers
+// of their task queues.
+func (d *workflowDiagnosis) detectPendingTaskProblems() {
+	if !d.isRunning() {
+		return
+	}
+	executionInfo := d.mutableState.GetExecutionInfo()
+	if executionInfo.GetWorkflowTaskScheduledEventId() != common.EmptyEventID {
+		if executionInfo.GetWorkflowTaskStartedEventId() == common.EmptyEventID {
+			scheduled := d.age(executionInfo.GetWorkflowTaskScheduledTime())
+			taskQueue := executionInfo.GetTaskQueue()
+			if executionInfo.GetStickyTaskQueue() != "" {
+				taskQueue = executionInfo.GetStickyTaskQueue()
+			}
+			d.detectNotStarted("workflow task", enumspb.TASK_QUEUE_TYPE_WORKFLOW, taskQueue, scheduled)
+		} else if started := d.age(executionInfo.GetWorkflowTaskStartedTime()); started > d.threshold {
+			d.problemf("workflow task started but not completed for %v", started.Round(time.Second))
+		}
+	}
+
+	scheduledEventIDs := make([]int64, 0, len(d.mutableState.GetActivityInfos()))
+	for scheduledEventID := range d.mutableState.GetActivityInfos() {
+		scheduledEventIDs = append(scheduledEventIDs, scheduledEventID)
+	}
+	slices.Sort(scheduledEventIDs)
+	for _, scheduledEventID := range scheduledEventIDs {
+		activity := d.mutableState.GetActivityInfos()[scheduledEventID]
+		if activity.GetStartedEventId() != common.EmptyEventID {
+			continue
+		}
+		d.detectNotStarted(
+			fmt.Sprintf("activity %s", activity.GetActivityId()),
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			activity.GetTaskQueue(),
+			d.age(activity.GetScheduledTime()),
+		)
+	}
+}
+
+func (d *workflowDiagnosis) detectNotStarted(
+	name string,
+	taskQueueType enumspb.TaskQueueType,
+	taskQueue string,
+	scheduled time.Duration,
+) {
+	if scheduled <= d.threshold {
+		return
+	}
+	info := d.taskQueues[taskQueueType][taskQueue]
+	switch {
+	case info == nil || info.err != nil:
+		d.problemf("%s scheduled but not started for %v", name, scheduled.Round(time.Second))
+	case info.pollerCount == 0:
+		d.problemf("%s scheduled but no pollers for %v", name, scheduled.Round(time.Second))
+	default:
+		d.problemf("%s scheduled but not started for %v despite %d poller(s)", name, scheduled.Round(time.Second), info.pollerCount)
+	}
+}
+
+func (d *workflowDiagnosis) diagnoseDLQ() {
+	d.section("DLQ tasks:")
+	queueNames, err := d.listNonEmptyDLQs()
+	if err != nil {
+		d.printf("  Unavailable\n")
+		d.problemf("unable to list DLQs: %s", err)
+		return
+	}
+
+	serializer := serialization.NewSerializer()
+	found := false
+	for _, queueName := range queueNames {
+		dlqKey, category, ok := d.parseHistoryDLQKey(queueName)
+		if !ok {
+			continue
+		}
+		messageIDs, err := d.listExecutionDLQTasks(dlqKey, category, serializer)
+		if err != nil {
+			d.printf("  %s: unavailable\n", queueName)
+			d.problemf("unable to read DLQ %s: %s", queueName, err)
+			continue
+		}
+		if len(messageIDs) == 0 {
+			continue
+		}
+		found = true
+		d.printf("  %s (%s -> %s): message ID(s) %v\n",
+			category.Name(), dlqKey.GetSourceCluster(), dlqKey.GetTargetCluster(), messageIDs)
+		d.problemf("%d %s task(s) of the execution in the DLQ from %s to %s",
+			len(messageIDs), category.Name(), dlqKey.GetSourceCluster(), dlqKey.GetTargetCluster())
+	}
+	if !found {
+		d.printf("  None\n")
+	}
+}
+
+func (d *workflowDiagnosis) listNonEmptyDLQs() ([]string, error) {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	var queueNames []string
+	var nextPageToken []byte
+	for {
+		resp, err := d.adminClient.ListQueues(ctx, &adminservice.ListQueuesRequest{
+			QueueType:     int32(persistence.QueueTypeHistoryDLQ),
+			PageSize:      int32(defaultPageSize),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, queueInfo := range resp.GetQueues() {
+			if queueInfo.GetMessageCount() > 0 {
+				queueNames = append(queueNames, queueInfo.GetQueueName())
+			}
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			return queueNames, nil
+		}
+		nextPageToken = resp.GetNextPageToken()
+	}
+}
+
+// parseHistoryDLQKey returns the key of a history DLQ from its name. Cluster names may contain the separator of the
+// name, so every split of the clusters is checked against the hash suffix of the name.
+func (d *workflowDiagnosis) parseHistoryDLQKey(queueName string) (*commonspb.HistoryDLQKey, tasks.Category, bool) {
+	categoryID, err := persistence.GetHistoryTaskQueueCategoryID(queueName)
+	if err != nil {
+		return nil, tasks.Category{}, false
+	}
+	category, ok := d.registry.GetCategoryByID(categoryID)
+	if !ok {
+		return nil, tasks.Category{}, false
+	}
+	separator := strings.LastIndex(queueName, "_")
+	clusters := strings.TrimPrefix(queueName[:max(separator, 0)], strconv.Itoa(categoryID)+"_")
+	for i := range len(clusters) {
+		if clusters[i] != '_' {
+			continue
+		}
+		sourceCluster, targetCluster := clusters[:i], clusters[i+1:]
+		if persistence.GetHistoryTaskQueueName(categoryID, sourceCluster, targetCluster) == queueName {
+			return &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(categoryID),
+				SourceCluster: sourceCluster,
+				TargetCluster: targetCluster,
+			}, category, true
+		}
+	}
+	return nil, tasks.Category{}, false
+}
+
+// listExecutionDLQTasks returns the message IDs of the tasks of the execution in a DLQ. The server only filters by
+// workflow ID prefix, so the tasks are decoded to only keep the ones of the execution.
+func (d *workflowDiagnosis) listExecutionDLQTasks(
+	dlqKey *commonspb.HistoryDLQKey,
+	category tasks.Category,
+	serializer serialization.Serializer,
+) ([]int64, error) {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	var messageIDs []int64
+	var nextPageToken []byte
+	for {
+		resp, err := d.adminClient.GetDLQTasks(ctx, &adminservice.GetDLQTasksRequest{
+			DlqKey:        dlqKey,
+			PageSize:      int32(defaultPageSize * 10),
+			NextPageToken: nextPageToken,
+			Filter: &commonspb.HistoryDLQTaskFilter{
+				NamespaceIds:     []string{d.mutableState.GetExecutionInfo().GetNamespaceId()},
+				WorkflowIdPrefix: d.execution.GetWorkflowId(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, dlqTask := range resp.GetDlqTasks() {
+			task, err := serializer.DeserializeTask(category, dlqTask.GetPayload().GetBlob())
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode DLQ message %d: %s", dlqTask.GetMetadata().GetMessageId(), err)
+			}
+			if task.GetWorkflowID() == d.execution.GetWorkflowId() && task.GetRunID() == d.execution.GetRunId() {
+				messageIDs = append(messageIDs, dlqTask.GetMetadata().GetMessageId())
+			}
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			return messageIDs, nil
+		}
+		nextPageToken = resp.GetNextPageToken()
+	}
+}
+
+func (d *workflowDiagnosis) diagnoseReplication() {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	d.section("Replication:")
+	resp, err := d.adminClient.GetReplicationLag(ctx, &adminservice.GetReplicationLagRequest{
+		Namespaces: []string{d.namespace},
+	})
+	if err != nil {
+		d.printf("  Unavailable\n")
+		d.problemf("unable to get replication lag: %s", err)
+		return
+	}
+	if len(resp.GetClusters()) == 0 {
+		d.printf("  No remote cluster\n")
+		return
+	}
+	for _, cluster := range resp.GetClusters() {
+		var nsLag *adminservice.NamespaceReplicationLag
+		for _, lag := range cluster.GetNamespaces() {
+			if lag.GetNamespace() == d.namespace {
+				nsLag = lag
+			}
+		}
+		d.printf("  %s: %d unreplicated task(s) of the namespace, lag %v\n",
+			cluster.GetClusterName(), nsLag.GetBacklogTaskCount(), nsLag.GetLag().AsDuration().Round(time.Second))
+
+		if slices.Contains(cluster.GetStuckShardIds(), d.shardID) {
+			d.problemf("replication stream of shard %d to cluster %s is stuck", d.shardID, cluster.GetClusterName())
+		}
+		oldest := nsLag.GetOldestUnreplicatedExecution()
+		if oldest.GetWorkflowId() == d.execution.GetWorkflowId() && oldest.GetRunId() == d.execution.GetRunId() &&
+			nsLag.GetLag().AsDuration() > d.threshold {
+			d.problemf("replication of the execution to cluster %s lagging by %v",
+				cluster.GetClusterName(), nsLag.GetLag().AsDuration().Round(time.Second))
+		}
+	}
+}
+
+func (d *workflowDiagnosis) diagnoseVisibility() {
+	ctx, cancel := newContext(d.c)
+	defer cancel()
+
+	d.section("Visibility:")
+	resp, err := d.workflowClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: d.namespace,
+		PageSize:  1,
+		Query: fmt.Sprintf("WorkflowId = %s AND RunId = %s",
+			sqlparser.String(sqlparser.NewStrVal([]byte(d.execution.GetWorkflowId()))),
+			sqlparser.String(sqlparser.NewStrVal([]byte(d.execution.GetRunId())))),
+	})
+	if err != nil {
+		d.printf("  Unavailable\n")
+		d.problemf("unable to get visibility record: %s", err)
+		return
+	}
+
+	executionInfo := d.mutableState.GetExecutionInfo()
+	status := d.mutableState.GetExecutionState().GetStatus()
+	if len(resp.GetExecutions()) == 0 {
+		d.printf("  No record\n")
+		if started := d.age(d.mutableState.GetExecutionState().GetStartTime()); started > d.threshold {
+			d.problemf("visibility record missing for %v", started.Round(time.Second))
+		}
+		return
+	}
+	record := resp.GetExecutions()[0]
+	d.printf("  Status: %s\n", record.GetStatus())
+	if record.GetCloseTime() != nil {
+		d.printf("  Close time: %s\n", record.GetCloseTime().AsTime().Format(time.RFC3339))
+	}
+
+	// Visibility is updated asynchronously, so only report the differences older than the threshold.
+	if d.age(executionInfo.GetLastUpdateTime()) <= d.threshold {
+		return
+	}
+	if record.GetStatus() != status {
+		d.problemf("visibility record stale: status %s, mutable state status %s", record.GetStatus(), status)
+	} else if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING &&
+		!record.GetCloseTime().AsTime().Equal(executionInfo.GetCloseTime().AsTime()) {
+		d.problemf("visibility record stale: close time %s, mutable state close time %s",
+			record.GetCloseTime().AsTime().Format(time.RFC3339), executionInfo.GetCloseTime().AsTime().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,317 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type diagnoseAdminClient struct {
+	adminservice.AdminServiceClient
+	mutableState   *persistencespb.WorkflowMutableState
+	historyTasks   map[int32][]*adminservice.Task
+	taskQueues     map[string]*taskqueuespb.PhysicalTaskQueueInfo
+	dlqs           map[string][]*commonspb.HistoryDLQTask
+	replicationLag *adminservice.GetReplicationLagResponse
+	describeErr    error
+	listQueuesErr  error
+	replicationErr error
+
+	dlqRequests []*adminservice.GetDLQTasksRequest
+}
+
+func (c *diagnoseAdminClient) DescribeMutableState(
+	context.Context,
+	*adminservice.DescribeMutableStateRequest,
+	...grpc.CallOption,
+) (*adminservice.DescribeMutableStateResponse, error) {
+	if c.describeErr != nil {
+		return nil, c.describeErr
+	}
+	return &adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: c.mutableState,
+		ShardId:              "3",
+		HistoryAddr:          "127.0.0.1:7234",
+	}, nil
+}
+
+func (c *diagnoseAdminClient) ListHistoryTasks(
+	_ context.Context,
+	req *adminservice.ListHistoryTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.ListHistoryTasksResponse, error) {
+	return &adminservice.ListHistoryTasksResponse{Tasks: c.historyTasks[req.GetCategory()]}, nil
+}
+
+func (c *diagnoseAdminClient) DescribeTaskQueuePartition(
+	_ context.Context,
+	req *adminservice.DescribeTaskQueuePartitionRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
+	name := req.GetTaskQueuePartition().GetTaskQueue()
+	if stickyName := req.GetTaskQueuePartition().GetStickyName(); stickyName != "" {
+		name = stickyName
+	}
+	info, ok := c.taskQueues[name]
+	if !ok {
+		return nil, errors.New("task queue not found")
+	}
+	return &adminservice.DescribeTaskQueuePartitionResponse{
+		VersionsInfoInternal: map[string]*taskqueuespb.TaskQueueVersionInfoInternal{
+			"": {PhysicalTaskQueueInfo: info},
+		},
+	}, nil
+}
+
+func (c *diagnoseAdminClient) ListQueues(
+	context.Context,
+	*adminservice.ListQueuesRequest,
+	...grpc.CallOption,
+) (*adminservice.ListQueuesResponse, error) {
+	if c.listQueuesErr != nil {
+		return nil, c.listQueuesErr
+	}
+	resp := &adminservice.ListQueuesResponse{}
+	for name, dlqTasks := range c.dlqs {
+		resp.Queues = append(resp.Queues, &adminservice.ListQueuesResponse_QueueInfo{
+			QueueName:    name,
+			MessageCount: int64(len(dlqTasks)),
+		})
+	}
+	return resp, nil
+}
+
+func (c *diagnoseAdminClient) GetDLQTasks(
+	_ context.Context,
+	req *adminservice.GetDLQTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.GetDLQTasksResponse, error) {
+	c.dlqRequests = append(c.dlqRequests, req)
+	key := req.GetDlqKey()
+	name := persistence.GetHistoryTaskQueueName(int(key.GetTaskCategory()), key.GetSourceCluster(), key.GetTargetCluster())
+	return &adminservice.GetDLQTasksResponse{DlqTasks: c.dlqs[name]}, nil
+}
+
+func (c *diagnoseAdminClient) GetReplicationLag(
+	context.Context,
+	*adminservice.GetReplicationLagRequest,
+	...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	if c.replicationErr != nil {
+		return nil, c.replicationErr
+	}
+	if c.replicationLag == nil {
+		return &adminservice.GetReplicationLagResponse{SafeToFailover: true}, nil
+	}
+	return c.replicationLag, nil
+}
+
+func diagnoseMutableState(status enumspb.WorkflowExecutionStatus, lastUpdate time.Time) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:      "ns-id",
+			WorkflowId:       "wf",
+			WorkflowTypeName: "my-workflow",
+			TaskQueue:        "my-tq",
+			LastUpdateTime:   timestamppb.New(lastUpdate),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     "run",
+			Status:    status,
+			StartTime: timestamppb.New(lastUpdate),
+		},
+		NextEventId: 5,
+	}
+}
+
+func diagnoseDLQTask(t *testing.T, messageID int64, runID string) *commonspb.HistoryDLQTask {
+	t.Helper()
+	blob, err := serialization.NewSerializer().SerializeTask(&tasks.WorkflowTask{
+		WorkflowKey: definition.NewWorkflowKey("ns-id", "wf", runID),
+		TaskID:      messageID,
+		TaskQueue:   "my-tq",
+	})
+	require.NoError(t, err)
+	return &commonspb.HistoryDLQTask{
+		Metadata: &commonspb.HistoryDLQTaskMetadata{MessageId: messageID},
+		Payload:  &commonspb.HistoryTask{ShardId: 3, Blob: blob},
+	}
+}
+
+func TestWorkflowDiagnose(t *testing.T) {
+	hourAgo := time.Now().Add(-time.Hour)
+	mutableState := diagnoseMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, hourAgo)
+	mutableState.ExecutionInfo.WorkflowTaskScheduledEventId = 4
+	mutableState.ExecutionInfo.WorkflowTaskScheduledTime = timestamppb.New(hourAgo)
+	mutableState.ExecutionInfo.WorkflowTaskAttempt = 1
+	mutableState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{
+		3: {ActivityId: "act", TaskQueue: "act-tq", ScheduledTime: timestamppb.New(hourAgo)},
+	}
+	dlqName := persistence.GetHistoryTaskQueueName(tasks.CategoryIDTransfer, "cluster_a", "cluster_a")
+	admin := &diagnoseAdminClient{
+		mutableState: mutableState,
+		historyTasks: map[int32][]*adminservice.Task{
+			int32(tasks.CategoryIDTransfer): {
+				{NamespaceId: "ns-id", WorkflowId: "wf", RunId: "run", TaskId: 10, TaskType: enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK},
+				{NamespaceId: "ns-id", WorkflowId: "other", RunId: "run", TaskId: 11, TaskType: enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK},
+			},
+			int32(tasks.CategoryIDTimer): {
+				{NamespaceId: "ns-id", WorkflowId: "wf", RunId: "run", TaskId: 12, TaskType: enumsspb.TASK_TYPE_USER_TIMER, FireTime: timestamppb.New(hourAgo)},
+			},
+		},
+		taskQueues: map[string]*taskqueuespb.PhysicalTaskQueueInfo{
+			"my-tq": {},
+			"act-tq": {
+				Pollers: []*taskqueuepb.PollerInfo{{Identity: "worker"}},
+				TaskQueueStats: &taskqueuepb.TaskQueueStats{
+					ApproximateBacklogCount: 42,
+					ApproximateBacklogAge:   durationpb.New(time.Hour),
+				},
+			},
+		},
+		dlqs: map[string][]*commonspb.HistoryDLQTask{
+			dlqName: {diagnoseDLQTask(t, 7, "run"), diagnoseDLQTask(t, 8, "other-run")},
+		},
+		replicationLag: &adminservice.GetReplicationLagResponse{
+			Clusters: []*adminservice.ClusterReplicationLag{{
+				ClusterName:   "cluster-b",
+				StuckShardIds: []int32{3},
+				Namespaces: []*adminservice.NamespaceReplicationLag{{
+					Namespace:                   "my-ns",
+					BacklogTaskCount:            2,
+					Lag:                         durationpb.New(time.Hour),
+					OldestUnreplicatedExecution: &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"},
+				}},
+			}},
+		},
+	}
+	wf := &migrateWorkflowClient{
+		pages: []*workflowservice.ListWorkflowExecutionsResponse{{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}},
+		}},
+	}
+	factory := migrateClientFactory{admin: admin, workflow: wf}
+
+	stdout, _, err := runMigrate(t, factory, "-n", "my-ns", "workflow", "diagnose", "--workflow-id", "wf")
+	require.NoError(t, err)
+
+	require.Contains(t, stdout, "Shard Id: 3, history service address: 127.0.0.1:7234")
+	require.Contains(t, stdout, "transfer: 1 task(s)")
+	require.Contains(t, stdout, "Activity act-tq: 1 poller(s), backlog of 42 task(s)")
+	require.Contains(t, stdout, "message ID(s) [7]")
+	require.Contains(t, stdout, "cluster-b: 2 unreplicated task(s) of the namespace")
+
+	require.Len(t, admin.dlqRequests, 1)
+	require.Equal(t, "cluster_a", admin.dlqRequests[0].GetDlqKey().GetSourceCluster())
+	require.Equal(t, "wf", admin.dlqRequests[0].GetFilter().GetWorkflowIdPrefix())
+	require.Equal(t, []string{"ns-id"}, admin.dlqRequests[0].GetFilter().GetNamespaceIds())
+	require.Equal(t, "WorkflowId = 'wf' AND RunId = 'run'", wf.requests[0].GetQuery())
+
+	for _, problem := range []string{
+		"timer task UserTimer overdue by 1h0m0s",
+		"Activity task queue act-tq has a backlog of 42 task(s) older than 1h0m0s",
+		"1 transfer task(s) of the execution in the DLQ from cluster_a to cluster_a",
+		"replication stream of shard 3 to cluster cluster-b is stuck",
+		"replication of the execution to cluster cluster-b lagging by 1h0m0s",
+		"visibility record stale: status Completed, mutable state status Running",
+		"workflow task scheduled but no pollers for 1h0m0s",
+		"activity act scheduled but not started for 1h0m0s despite 1 poller(s)",
+	} {
+		require.Contains(t, stdout, "  - "+problem+"\n")
+	}
+	require.NotContains(t, stdout, "No problem detected.")
+}
+
+func TestWorkflowDiagnose_NoProblem(t *testing.T) {
+	admin := &diagnoseAdminClient{
+		mutableState: diagnoseMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Now()),
+	}
+	wf := &migrateWorkflowClient{
+		pages: []*workflowservice.ListWorkflowExecutionsResponse{{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}},
+		}},
+	}
+	factory := migrateClientFactory{admin: admin, workflow: wf}
+
+	stdout, _, err := runMigrate(t, factory, "-n", "my-ns", "workflow", "diagnose", "--workflow-id", "wf")
+	require.NoError(t, err)
+	require.Contains(t, stdout, "No pending task waiting for a poller.")
+	require.Contains(t, stdout, "No remote cluster")
+	require.Contains(t, stdout, "No problem detected.")
+}
+
+func TestWorkflowDiagnose_StickyTaskQueue(t *testing.T) {
+	hourAgo := time.Now().Add(-time.Hour)
+	mutableState := diagnoseMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Now())
+	mutableState.ExecutionInfo.WorkflowId = "wf'quoted"
+	mutableState.ExecutionInfo.StickyTaskQueue = "sticky-tq"
+	mutableState.ExecutionInfo.WorkflowTaskScheduledEventId = 4
+	mutableState.ExecutionInfo.WorkflowTaskScheduledTime = timestamppb.New(hourAgo)
+	admin := &diagnoseAdminClient{
+		mutableState: mutableState,
+		taskQueues: map[string]*taskqueuespb.PhysicalTaskQueueInfo{
+			"my-tq":     {Pollers: []*taskqueuepb.PollerInfo{{Identity: "worker"}}},
+			"sticky-tq": {},
+		},
+	}
+	wf := &migrateWorkflowClient{
+		pages: []*workflowservice.ListWorkflowExecutionsResponse{{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}},
+		}},
+	}
+	factory := migrateClientFactory{admin: admin, workflow: wf}
+
+	stdout, _, err := runMigrate(t, factory, "-n", "my-ns", "workflow", "diagnose", "--workflow-id", "wf'quoted")
+	require.NoError(t, err)
+	require.Contains(t, stdout, "Workflow my-tq: 1 poller(s)")
+	require.Contains(t, stdout, "Workflow sticky-tq: 0 poller(s)")
+	require.Contains(t, stdout, "  - workflow task scheduled but no pollers for 1h0m0s\n")
+	require.Equal(t, `WorkflowId = 'wf\'quoted' AND RunId = 'run'`, wf.requests[0].GetQuery())
+}
+
+func TestWorkflowDiagnose_PartialFailure(t *testing.T) {
+	admin := &diagnoseAdminClient{
+		mutableState:   diagnoseMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, time.Now().Add(-time.Hour)),
+		listQueuesErr:  errors.New("queues unavailable"),
+		replicationErr: errors.New("not a global namespace"),
+	}
+	factory := migrateClientFactory{admin: admin, workflow: &migrateWorkflowClient{}}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "diagnose", "--workflow-id", "wf", "--stuck-threshold", "2h")
+	require.NoError(t, err)
+	require.Contains(t, stdout, "Skipped, the execution is not running.")
+	require.Contains(t, stdout, "  - unable to list DLQs: queues unavailable\n")
+	require.Contains(t, stdout, "  - unable to get replication lag: not a global namespace\n")
+	// The record is missing for less than the threshold, so it may not have been written yet.
+	require.Contains(t, stdout, "No record")
+	require.NotContains(t, stdout, "visibility record missing")
+}
+
+func TestWorkflowDiagnose_MutableStateError(t *testing.T) {
+	admin := &diagnoseAdminClient{describeErr: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin, workflow: &migrateWorkflowClient{}}
+
+	_, _, err := runMigrate(t, factory, "-n", "my-ns", "workflow", "diagnose", "--workflow-id", "wf")
+	require.ErrorContains(t, err, "unable to get Mutable State: boom")
+}
@@ -0,0 +1,83 @@
+package tdbg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"google.golang.org/grpc"
+)
+
+type resetDryRunAdminClient struct {
+	adminservice.AdminServiceClient
+	err error
+
+	requests []*adminservice.DryRunResetWorkflowExecutionRequest
+}
+
+func (c *resetDryRunAdminClient) DryRunResetWorkflowExecution(
+	_ context.Context,
+	req *adminservice.DryRunResetWorkflowExecutionRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DryRunResetWorkflowExecutionResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &adminservice.DryRunResetWorkflowExecutionResponse{
+		Result: &historyspb.ResetDryRunResult{
+			ResetRunId: "reset-run-id",
+			DroppedEvents: []*historyspb.ResetDryRunResult_Event{
+				{RunId: "run", EventId: 8, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED},
+			},
+		},
+	}, nil
+}
+
+func TestResetDryRun(t *testing.T) {
+	admin := &resetDryRunAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	stdout, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "reset-dry-run",
+		"--workflow-id", "wf", "--run-id", "run", "--event-id", "4",
+		"--reapply-exclude", "Signal", "--reapply-exclude", "Update")
+	require.NoError(t, err)
+
+	require.Len(t, admin.requests, 1)
+	req := admin.requests[0].GetResetRequest()
+	require.Equal(t, "my-ns", req.GetNamespace())
+	require.Equal(t, "wf", req.GetWorkflowExecution().GetWorkflowId())
+	require.Equal(t, "run", req.GetWorkflowExecution().GetRunId())
+	require.Equal(t, int64(4), req.GetWorkflowTaskFinishEventId())
+	require.Equal(t, []enumspb.ResetReapplyExcludeType{
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL,
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE,
+	}, req.GetResetReapplyExcludeTypes())
+	require.Contains(t, stdout, "reset-run-id")
+	require.Contains(t, stdout, "EVENT_TYPE_ACTIVITY_TASK_COMPLETED")
+}
+
+func TestResetDryRun_InvalidExcludeType(t *testing.T) {
+	admin := &resetDryRunAdminClient{}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "reset-dry-run", "--workflow-id", "wf", "--event-id", "4",
+		"--reapply-exclude", "Bogus")
+	require.ErrorContains(t, err, `invalid reapply exclude type "Bogus"`)
+	require.Empty(t, admin.requests)
+}
+
+func TestResetDryRun_Error(t *testing.T) {
+	admin := &resetDryRunAdminClient{err: errors.New("boom")}
+	factory := migrateClientFactory{admin: admin}
+
+	_, _, err := runMigrate(t, factory,
+		"-n", "my-ns", "workflow", "reset-dry-run", "--workflow-id", "wf", "--event-id", "4")
+	require.ErrorContains(t, err, "unable to dry run workflow reset: boom")
+}